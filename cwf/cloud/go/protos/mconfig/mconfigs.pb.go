@@ -52,6 +52,8 @@ type CwfGatewayHealthConfig struct {
 	GrePeers []*CwfGatewayHealthConfigGrePeer `protobuf:"bytes,5,rep,name=gre_peers,json=grePeers,proto3" json:"gre_peers,omitempty"`
 	// virtual IP used by AP/WLC to connect to HA cluster
 	ClusterVirtualIp string `protobuf:"bytes,6,opt,name=cluster_virtual_ip,json=clusterVirtualIp,proto3" json:"cluster_virtual_ip,omitempty"`
+	// data path probing through the GRE tunnels & the UPF
+	DataPathProbe *CwfGatewayHealthConfigDataPathProbe `protobuf:"bytes,7,opt,name=data_path_probe,json=dataPathProbe,proto3" json:"data_path_probe,omitempty"`
 }
 
 func (x *CwfGatewayHealthConfig) Reset() {
@@ -128,12 +130,21 @@ func (x *CwfGatewayHealthConfig) GetClusterVirtualIp() string {
 	return ""
 }
 
+func (x *CwfGatewayHealthConfig) GetDataPathProbe() *CwfGatewayHealthConfigDataPathProbe {
+	if x != nil {
+		return x.DataPathProbe
+	}
+	return nil
+}
+
 type CwfGatewayHealthConfigGrePeer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	// GRE key of the tunnel to the peer, 0 if the tunnel is not keyed
+	Key uint32 `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
 func (x *CwfGatewayHealthConfigGrePeer) Reset() {
@@ -175,13 +186,122 @@ func (x *CwfGatewayHealthConfigGrePeer) GetIp() string {
 	return ""
 }
 
+func (x *CwfGatewayHealthConfigGrePeer) GetKey() uint32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+type CwfGatewayHealthConfigDataPathProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// probe the data path through the GRE tunnels instead of pinging the peers
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// IP address beyond the UPF which answers the synthetic UE packets
+	TargetIp string `protobuf:"bytes,2,opt,name=target_ip,json=targetIp,proto3" json:"target_ip,omitempty"`
+	// source IP address of the synthetic UE packets
+	UeIp string `protobuf:"bytes,3,opt,name=ue_ip,json=ueIp,proto3" json:"ue_ip,omitempty"`
+	// number of most recent probes the loss & latency are computed over
+	WindowSize uint32 `protobuf:"varint,4,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// loss ratio (0-1) over the window above which a path is degraded
+	MaxLossPct float32 `protobuf:"fixed32,5,opt,name=max_loss_pct,json=maxLossPct,proto3" json:"max_loss_pct,omitempty"`
+	// average latency over the window above which a path is degraded
+	MaxLatencyMs uint32 `protobuf:"varint,6,opt,name=max_latency_ms,json=maxLatencyMs,proto3" json:"max_latency_ms,omitempty"`
+	// time to wait for a probe reply before counting it as lost
+	TimeoutMs uint32 `protobuf:"varint,7,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) Reset() {
+	*x = CwfGatewayHealthConfigDataPathProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwf_protos_mconfig_mconfigs_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CwfGatewayHealthConfigDataPathProbe) ProtoMessage() {}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_cwf_protos_mconfig_mconfigs_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CwfGatewayHealthConfigDataPathProbe.ProtoReflect.Descriptor instead.
+func (*CwfGatewayHealthConfigDataPathProbe) Descriptor() ([]byte, []int) {
+	return file_cwf_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) GetTargetIp() string {
+	if x != nil {
+		return x.TargetIp
+	}
+	return ""
+}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) GetUeIp() string {
+	if x != nil {
+		return x.UeIp
+	}
+	return ""
+}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) GetWindowSize() uint32 {
+	if x != nil {
+		return x.WindowSize
+	}
+	return 0
+}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) GetMaxLossPct() float32 {
+	if x != nil {
+		return x.MaxLossPct
+	}
+	return 0
+}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) GetMaxLatencyMs() uint32 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+func (x *CwfGatewayHealthConfigDataPathProbe) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
 var File_cwf_protos_mconfig_mconfigs_proto protoreflect.FileDescriptor
 
 var file_cwf_protos_mconfig_mconfigs_proto_rawDesc = []byte{
 	0x0a, 0x21, 0x63, 0x77, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x22, 0xf6, 0x02, 0x0a, 0x16, 0x43, 0x77, 0x66, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x69, 0x67, 0x22, 0xcb, 0x05, 0x0a, 0x16, 0x43, 0x77, 0x66, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
 	0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a,
 	0x16, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
 	0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x63,
@@ -203,11 +323,32 @@ var file_cwf_protos_mconfig_mconfigs_proto_rawDesc = []byte{
 	0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x76, 0x69,
 	0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
 	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x70,
-	0x1a, 0x19, 0x0a, 0x07, 0x67, 0x72, 0x65, 0x50, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x42, 0x23, 0x5a, 0x21, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2f, 0x63, 0x77, 0x66, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67,
-	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x5b, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x77, 0x66, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x0d,
+	0x64, 0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x1a, 0x2b, 0x0a,
+	0x07, 0x67, 0x72, 0x65, 0x50, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0xe3, 0x01, 0x0a, 0x0d, 0x64,
+	0x61, 0x74, 0x61, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x49, 0x70, 0x12, 0x13, 0x0a, 0x05, 0x75, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x65, 0x49, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d,
+	0x61, 0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73,
+	0x42, 0x23, 0x5a, 0x21, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x63, 0x77, 0x66, 0x2f, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -222,18 +363,20 @@ func file_cwf_protos_mconfig_mconfigs_proto_rawDescGZIP() []byte {
 	return file_cwf_protos_mconfig_mconfigs_proto_rawDescData
 }
 
-var file_cwf_protos_mconfig_mconfigs_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cwf_protos_mconfig_mconfigs_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cwf_protos_mconfig_mconfigs_proto_goTypes = []interface{}{
-	(*CwfGatewayHealthConfig)(nil),        // 0: magma.mconfig.CwfGatewayHealthConfig
-	(*CwfGatewayHealthConfigGrePeer)(nil), // 1: magma.mconfig.CwfGatewayHealthConfig.grePeer
+	(*CwfGatewayHealthConfig)(nil),              // 0: magma.mconfig.CwfGatewayHealthConfig
+	(*CwfGatewayHealthConfigGrePeer)(nil),       // 1: magma.mconfig.CwfGatewayHealthConfig.grePeer
+	(*CwfGatewayHealthConfigDataPathProbe)(nil), // 2: magma.mconfig.CwfGatewayHealthConfig.dataPathProbe
 }
 var file_cwf_protos_mconfig_mconfigs_proto_depIdxs = []int32{
 	1, // 0: magma.mconfig.CwfGatewayHealthConfig.gre_peers:type_name -> magma.mconfig.CwfGatewayHealthConfig.grePeer
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: magma.mconfig.CwfGatewayHealthConfig.data_path_probe:type_name -> magma.mconfig.CwfGatewayHealthConfig.dataPathProbe
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_cwf_protos_mconfig_mconfigs_proto_init() }
@@ -266,6 +409,18 @@ func file_cwf_protos_mconfig_mconfigs_proto_init() {
 				return nil
 			}
 		}
+		file_cwf_protos_mconfig_mconfigs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CwfGatewayHealthConfigDataPathProbe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -273,7 +428,7 @@ func file_cwf_protos_mconfig_mconfigs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cwf_protos_mconfig_mconfigs_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
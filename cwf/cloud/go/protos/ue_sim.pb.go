@@ -702,6 +702,582 @@ func (x *TrafficSummary) GetRetransmits() int32 {
 	return 0
 }
 
+// --------------------------------------------------------------------------
+// Load scenarios
+// --------------------------------------------------------------------------
+type UEPopulation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the population, used in load reports
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// IMSIs of already added UEs which belong to the population
+	Imsis []string `protobuf:"bytes,2,rep,name=imsis,proto3" json:"imsis,omitempty"`
+	// Template of UEs generated for the population, count UEs with consecutive
+	// IMSIs starting from template.imsi are added before the scenario starts
+	Template *UEConfig `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	Count    uint32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// Session arrival rate of the population in sessions per second,
+	// inter-arrival times are exponentially distributed
+	ArrivalRate float64 `protobuf:"fixed64,5,opt,name=arrival_rate,json=arrivalRate,proto3" json:"arrival_rate,omitempty"`
+	// Session duration and its uniformly distributed +/- jitter, in seconds
+	SessionDurationSecs       uint32 `protobuf:"varint,6,opt,name=session_duration_secs,json=sessionDurationSecs,proto3" json:"session_duration_secs,omitempty"`
+	SessionDurationJitterSecs uint32 `protobuf:"varint,7,opt,name=session_duration_jitter_secs,json=sessionDurationJitterSecs,proto3" json:"session_duration_jitter_secs,omitempty"`
+	// Interval of interim accounting updates in seconds, 0 disables interim updates
+	InterimIntervalSecs uint32 `protobuf:"varint,8,opt,name=interim_interval_secs,json=interimIntervalSecs,proto3" json:"interim_interval_secs,omitempty"`
+	// Called-Station-Id of the population sessions
+	CalledStationId string `protobuf:"bytes,9,opt,name=called_station_id,json=calledStationId,proto3" json:"called_station_id,omitempty"`
+}
+
+func (x *UEPopulation) Reset() {
+	*x = UEPopulation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwf_protos_ue_sim_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UEPopulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UEPopulation) ProtoMessage() {}
+
+func (x *UEPopulation) ProtoReflect() protoreflect.Message {
+	mi := &file_cwf_protos_ue_sim_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UEPopulation.ProtoReflect.Descriptor instead.
+func (*UEPopulation) Descriptor() ([]byte, []int) {
+	return file_cwf_protos_ue_sim_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UEPopulation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UEPopulation) GetImsis() []string {
+	if x != nil {
+		return x.Imsis
+	}
+	return nil
+}
+
+func (x *UEPopulation) GetTemplate() *UEConfig {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *UEPopulation) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UEPopulation) GetArrivalRate() float64 {
+	if x != nil {
+		return x.ArrivalRate
+	}
+	return 0
+}
+
+func (x *UEPopulation) GetSessionDurationSecs() uint32 {
+	if x != nil {
+		return x.SessionDurationSecs
+	}
+	return 0
+}
+
+func (x *UEPopulation) GetSessionDurationJitterSecs() uint32 {
+	if x != nil {
+		return x.SessionDurationJitterSecs
+	}
+	return 0
+}
+
+func (x *UEPopulation) GetInterimIntervalSecs() uint32 {
+	if x != nil {
+		return x.InterimIntervalSecs
+	}
+	return 0
+}
+
+func (x *UEPopulation) GetCalledStationId() string {
+	if x != nil {
+		return x.CalledStationId
+	}
+	return ""
+}
+
+type LoadScenario struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Populations []*UEPopulation `protobuf:"bytes,1,rep,name=populations,proto3" json:"populations,omitempty"`
+	// Duration of the scenario in seconds, sessions still active at its end are stopped
+	DurationSecs uint32 `protobuf:"varint,2,opt,name=duration_secs,json=durationSecs,proto3" json:"duration_secs,omitempty"`
+	// Maximum number of concurrent RADIUS transactions, 0 for unlimited
+	MaxConcurrentRequests uint32 `protobuf:"varint,3,opt,name=max_concurrent_requests,json=maxConcurrentRequests,proto3" json:"max_concurrent_requests,omitempty"`
+	// RADIUS transaction timeout in milliseconds, defaults to 3000
+	RequestTimeoutMs uint32 `protobuf:"varint,4,opt,name=request_timeout_ms,json=requestTimeoutMs,proto3" json:"request_timeout_ms,omitempty"`
+}
+
+func (x *LoadScenario) Reset() {
+	*x = LoadScenario{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwf_protos_ue_sim_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadScenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadScenario) ProtoMessage() {}
+
+func (x *LoadScenario) ProtoReflect() protoreflect.Message {
+	mi := &file_cwf_protos_ue_sim_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadScenario.ProtoReflect.Descriptor instead.
+func (*LoadScenario) Descriptor() ([]byte, []int) {
+	return file_cwf_protos_ue_sim_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LoadScenario) GetPopulations() []*UEPopulation {
+	if x != nil {
+		return x.Populations
+	}
+	return nil
+}
+
+func (x *LoadScenario) GetDurationSecs() uint32 {
+	if x != nil {
+		return x.DurationSecs
+	}
+	return 0
+}
+
+func (x *LoadScenario) GetMaxConcurrentRequests() uint32 {
+	if x != nil {
+		return x.MaxConcurrentRequests
+	}
+	return 0
+}
+
+func (x *LoadScenario) GetRequestTimeoutMs() uint32 {
+	if x != nil {
+		return x.RequestTimeoutMs
+	}
+	return 0
+}
+
+type LatencyBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Upper bound of the bucket in milliseconds, 0 for the overflow bucket
+	UpperBoundMs float64 `protobuf:"fixed64,1,opt,name=upper_bound_ms,json=upperBoundMs,proto3" json:"upper_bound_ms,omitempty"`
+	Count        uint64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *LatencyBucket) Reset() {
+	*x = LatencyBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwf_protos_ue_sim_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatencyBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatencyBucket) ProtoMessage() {}
+
+func (x *LatencyBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_cwf_protos_ue_sim_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatencyBucket.ProtoReflect.Descriptor instead.
+func (*LatencyBucket) Descriptor() ([]byte, []int) {
+	return file_cwf_protos_ue_sim_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LatencyBucket) GetUpperBoundMs() float64 {
+	if x != nil {
+		return x.UpperBoundMs
+	}
+	return 0
+}
+
+func (x *LatencyBucket) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type RequestStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests uint64 `protobuf:"varint,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	// Access-Accept or Accounting-Response received
+	Accepts uint64 `protobuf:"varint,2,opt,name=accepts,proto3" json:"accepts,omitempty"`
+	// Access-Reject received
+	Rejects       uint64  `protobuf:"varint,3,opt,name=rejects,proto3" json:"rejects,omitempty"`
+	Timeouts      uint64  `protobuf:"varint,4,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	Errors        uint64  `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"`
+	MeanLatencyMs float64 `protobuf:"fixed64,6,opt,name=mean_latency_ms,json=meanLatencyMs,proto3" json:"mean_latency_ms,omitempty"`
+	MaxLatencyMs  float64 `protobuf:"fixed64,7,opt,name=max_latency_ms,json=maxLatencyMs,proto3" json:"max_latency_ms,omitempty"`
+	// Percentiles are estimated by the upper bound of the histogram bucket they fall into
+	P50LatencyMs     float64          `protobuf:"fixed64,8,opt,name=p50_latency_ms,json=p50LatencyMs,proto3" json:"p50_latency_ms,omitempty"`
+	P95LatencyMs     float64          `protobuf:"fixed64,9,opt,name=p95_latency_ms,json=p95LatencyMs,proto3" json:"p95_latency_ms,omitempty"`
+	P99LatencyMs     float64          `protobuf:"fixed64,10,opt,name=p99_latency_ms,json=p99LatencyMs,proto3" json:"p99_latency_ms,omitempty"`
+	LatencyHistogram []*LatencyBucket `protobuf:"bytes,11,rep,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+}
+
+func (x *RequestStats) Reset() {
+	*x = RequestStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwf_protos_ue_sim_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestStats) ProtoMessage() {}
+
+func (x *RequestStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cwf_protos_ue_sim_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestStats.ProtoReflect.Descriptor instead.
+func (*RequestStats) Descriptor() ([]byte, []int) {
+	return file_cwf_protos_ue_sim_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RequestStats) GetRequests() uint64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *RequestStats) GetAccepts() uint64 {
+	if x != nil {
+		return x.Accepts
+	}
+	return 0
+}
+
+func (x *RequestStats) GetRejects() uint64 {
+	if x != nil {
+		return x.Rejects
+	}
+	return 0
+}
+
+func (x *RequestStats) GetTimeouts() uint64 {
+	if x != nil {
+		return x.Timeouts
+	}
+	return 0
+}
+
+func (x *RequestStats) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *RequestStats) GetMeanLatencyMs() float64 {
+	if x != nil {
+		return x.MeanLatencyMs
+	}
+	return 0
+}
+
+func (x *RequestStats) GetMaxLatencyMs() float64 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+func (x *RequestStats) GetP50LatencyMs() float64 {
+	if x != nil {
+		return x.P50LatencyMs
+	}
+	return 0
+}
+
+func (x *RequestStats) GetP95LatencyMs() float64 {
+	if x != nil {
+		return x.P95LatencyMs
+	}
+	return 0
+}
+
+func (x *RequestStats) GetP99LatencyMs() float64 {
+	if x != nil {
+		return x.P99LatencyMs
+	}
+	return 0
+}
+
+func (x *RequestStats) GetLatencyHistogram() []*LatencyBucket {
+	if x != nil {
+		return x.LatencyHistogram
+	}
+	return nil
+}
+
+type PopulationReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SessionsStarted   uint64 `protobuf:"varint,2,opt,name=sessions_started,json=sessionsStarted,proto3" json:"sessions_started,omitempty"`
+	SessionsCompleted uint64 `protobuf:"varint,3,opt,name=sessions_completed,json=sessionsCompleted,proto3" json:"sessions_completed,omitempty"`
+	// Sessions which failed authentication
+	SessionsFailed uint64 `protobuf:"varint,4,opt,name=sessions_failed,json=sessionsFailed,proto3" json:"sessions_failed,omitempty"`
+	// Arrivals dropped because all UEs of the population had an active session
+	ArrivalsDropped uint64        `protobuf:"varint,5,opt,name=arrivals_dropped,json=arrivalsDropped,proto3" json:"arrivals_dropped,omitempty"`
+	ActiveSessions  uint64        `protobuf:"varint,6,opt,name=active_sessions,json=activeSessions,proto3" json:"active_sessions,omitempty"`
+	Auth            *RequestStats `protobuf:"bytes,7,opt,name=auth,proto3" json:"auth,omitempty"`
+	AcctStart       *RequestStats `protobuf:"bytes,8,opt,name=acct_start,json=acctStart,proto3" json:"acct_start,omitempty"`
+	AcctInterim     *RequestStats `protobuf:"bytes,9,opt,name=acct_interim,json=acctInterim,proto3" json:"acct_interim,omitempty"`
+	AcctStop        *RequestStats `protobuf:"bytes,10,opt,name=acct_stop,json=acctStop,proto3" json:"acct_stop,omitempty"`
+}
+
+func (x *PopulationReport) Reset() {
+	*x = PopulationReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwf_protos_ue_sim_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopulationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopulationReport) ProtoMessage() {}
+
+func (x *PopulationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_cwf_protos_ue_sim_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopulationReport.ProtoReflect.Descriptor instead.
+func (*PopulationReport) Descriptor() ([]byte, []int) {
+	return file_cwf_protos_ue_sim_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PopulationReport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PopulationReport) GetSessionsStarted() uint64 {
+	if x != nil {
+		return x.SessionsStarted
+	}
+	return 0
+}
+
+func (x *PopulationReport) GetSessionsCompleted() uint64 {
+	if x != nil {
+		return x.SessionsCompleted
+	}
+	return 0
+}
+
+func (x *PopulationReport) GetSessionsFailed() uint64 {
+	if x != nil {
+		return x.SessionsFailed
+	}
+	return 0
+}
+
+func (x *PopulationReport) GetArrivalsDropped() uint64 {
+	if x != nil {
+		return x.ArrivalsDropped
+	}
+	return 0
+}
+
+func (x *PopulationReport) GetActiveSessions() uint64 {
+	if x != nil {
+		return x.ActiveSessions
+	}
+	return 0
+}
+
+func (x *PopulationReport) GetAuth() *RequestStats {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *PopulationReport) GetAcctStart() *RequestStats {
+	if x != nil {
+		return x.AcctStart
+	}
+	return nil
+}
+
+func (x *PopulationReport) GetAcctInterim() *RequestStats {
+	if x != nil {
+		return x.AcctInterim
+	}
+	return nil
+}
+
+func (x *PopulationReport) GetAcctStop() *RequestStats {
+	if x != nil {
+		return x.AcctStop
+	}
+	return nil
+}
+
+type LoadReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Running     bool                `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	ElapsedSecs float64             `protobuf:"fixed64,2,opt,name=elapsed_secs,json=elapsedSecs,proto3" json:"elapsed_secs,omitempty"`
+	Populations []*PopulationReport `protobuf:"bytes,3,rep,name=populations,proto3" json:"populations,omitempty"`
+	// Totals of all populations
+	Total *PopulationReport `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *LoadReport) Reset() {
+	*x = LoadReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cwf_protos_ue_sim_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadReport) ProtoMessage() {}
+
+func (x *LoadReport) ProtoReflect() protoreflect.Message {
+	mi := &file_cwf_protos_ue_sim_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadReport.ProtoReflect.Descriptor instead.
+func (*LoadReport) Descriptor() ([]byte, []int) {
+	return file_cwf_protos_ue_sim_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoadReport) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *LoadReport) GetElapsedSecs() float64 {
+	if x != nil {
+		return x.ElapsedSecs
+	}
+	return 0
+}
+
+func (x *LoadReport) GetPopulations() []*PopulationReport {
+	if x != nil {
+		return x.Populations
+	}
+	return nil
+}
+
+func (x *LoadReport) GetTotal() *PopulationReport {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
 var File_cwf_protos_ue_sim_proto protoreflect.FileDescriptor
 
 var file_cwf_protos_ue_sim_proto_rawDesc = []byte{
@@ -796,28 +1372,148 @@ var file_cwf_protos_ue_sim_proto_rawDesc = []byte{
 	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x62,
 	0x69, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b,
 	0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x73, 0x32, 0xa7,
-	0x02, 0x0a, 0x05, 0x55, 0x45, 0x53, 0x69, 0x6d, 0x12, 0x31, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x55,
-	0x45, 0x12, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x55, 0x45,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
-	0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x41,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b,
-	0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x47,
-	0x65, 0x6e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x47, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x63, 0x77, 0x66, 0x2e, 0x47, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2f, 0x63, 0x77, 0x66, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xf7,
+	0x02, 0x0a, 0x0c, 0x55, 0x45, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x73, 0x69, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x74, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x55, 0x45, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x69, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x69, 0x6d,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x61,
+	0x64, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x39, 0x0a, 0x0b, 0x70, 0x6f, 0x70,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x55, 0x45, 0x50, 0x6f, 0x70,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22,
+	0x4b, 0x0a, 0x0d, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x75, 0x70, 0x70, 0x65, 0x72, 0x42,
+	0x6f, 0x75, 0x6e, 0x64, 0x4d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x99, 0x03, 0x0a,
+	0x0c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x65, 0x61, 0x6e,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x70, 0x35, 0x30, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x35, 0x30, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x39, 0x35, 0x5f, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x70,
+	0x39, 0x35, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70,
+	0x39, 0x39, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x39, 0x39, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d,
+	0x73, 0x12, 0x45, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x22, 0xd4, 0x03, 0x0a, 0x10, 0x50, 0x6f, 0x70,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x73,
+	0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63,
+	0x77, 0x66, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x09, 0x61, 0x63, 0x63, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3a, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x69, 0x6d, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x69, 0x6d, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x63, 0x63,
+	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x61, 0x63, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x22,
+	0xbb, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x53, 0x65, 0x63, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x70,
+	0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x50, 0x6f, 0x70,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0b, 0x70,
+	0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x32, 0xd7, 0x03,
+	0x0a, 0x05, 0x55, 0x45, 0x53, 0x69, 0x6d, 0x12, 0x31, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x55, 0x45,
+	0x12, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x55, 0x45, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
+	0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x47, 0x65,
+	0x6e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x63, 0x77, 0x66, 0x2e, 0x47, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63,
+	0x77, 0x66, 0x2e, 0x47, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x4c, 0x6f, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x1a, 0x11, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
+	0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63,
+	0x77, 0x66, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x08, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x11, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x15,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x63, 0x77, 0x66, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2f, 0x63, 0x77, 0x66, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -832,7 +1528,7 @@ func file_cwf_protos_ue_sim_proto_rawDescGZIP() []byte {
 	return file_cwf_protos_ue_sim_proto_rawDescData
 }
 
-var file_cwf_protos_ue_sim_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cwf_protos_ue_sim_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_cwf_protos_ue_sim_proto_goTypes = []interface{}{
 	(*AuthenticateRequestHssLess)(nil), // 0: magma.cwf.AuthenticateRequestHssLess
 	(*UEConfig)(nil),                   // 1: magma.cwf.UEConfig
@@ -844,29 +1540,50 @@ var file_cwf_protos_ue_sim_proto_goTypes = []interface{}{
 	(*GenTrafficResponse)(nil),         // 7: magma.cwf.GenTrafficResponse
 	(*TrafficOutput)(nil),              // 8: magma.cwf.TrafficOutput
 	(*TrafficSummary)(nil),             // 9: magma.cwf.TrafficSummary
-	(*wrappers.StringValue)(nil),       // 10: google.protobuf.StringValue
-	(*protos.Void)(nil),                // 11: magma.orc8r.Void
+	(*UEPopulation)(nil),               // 10: magma.cwf.UEPopulation
+	(*LoadScenario)(nil),               // 11: magma.cwf.LoadScenario
+	(*LatencyBucket)(nil),              // 12: magma.cwf.LatencyBucket
+	(*RequestStats)(nil),               // 13: magma.cwf.RequestStats
+	(*PopulationReport)(nil),           // 14: magma.cwf.PopulationReport
+	(*LoadReport)(nil),                 // 15: magma.cwf.LoadReport
+	(*wrappers.StringValue)(nil),       // 16: google.protobuf.StringValue
+	(*protos.Void)(nil),                // 17: magma.orc8r.Void
 }
 var file_cwf_protos_ue_sim_proto_depIdxs = []int32{
 	0,  // 0: magma.cwf.UEConfig.hssless_cfg:type_name -> magma.cwf.AuthenticateRequestHssLess
-	10, // 1: magma.cwf.GenTrafficRequest.volume:type_name -> google.protobuf.StringValue
-	10, // 2: magma.cwf.GenTrafficRequest.bitrate:type_name -> google.protobuf.StringValue
+	16, // 1: magma.cwf.GenTrafficRequest.volume:type_name -> google.protobuf.StringValue
+	16, // 2: magma.cwf.GenTrafficRequest.bitrate:type_name -> google.protobuf.StringValue
 	8,  // 3: magma.cwf.GenTrafficResponse.end_output:type_name -> magma.cwf.TrafficOutput
 	9,  // 4: magma.cwf.TrafficOutput.sum_sent:type_name -> magma.cwf.TrafficSummary
 	9,  // 5: magma.cwf.TrafficOutput.sum_received:type_name -> magma.cwf.TrafficSummary
-	1,  // 6: magma.cwf.UESim.AddUE:input_type -> magma.cwf.UEConfig
-	2,  // 7: magma.cwf.UESim.Authenticate:input_type -> magma.cwf.AuthenticateRequest
-	4,  // 8: magma.cwf.UESim.Disconnect:input_type -> magma.cwf.DisconnectRequest
-	6,  // 9: magma.cwf.UESim.GenTraffic:input_type -> magma.cwf.GenTrafficRequest
-	11, // 10: magma.cwf.UESim.AddUE:output_type -> magma.orc8r.Void
-	3,  // 11: magma.cwf.UESim.Authenticate:output_type -> magma.cwf.AuthenticateResponse
-	5,  // 12: magma.cwf.UESim.Disconnect:output_type -> magma.cwf.DisconnectResponse
-	7,  // 13: magma.cwf.UESim.GenTraffic:output_type -> magma.cwf.GenTrafficResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	1,  // 6: magma.cwf.UEPopulation.template:type_name -> magma.cwf.UEConfig
+	10, // 7: magma.cwf.LoadScenario.populations:type_name -> magma.cwf.UEPopulation
+	12, // 8: magma.cwf.RequestStats.latency_histogram:type_name -> magma.cwf.LatencyBucket
+	13, // 9: magma.cwf.PopulationReport.auth:type_name -> magma.cwf.RequestStats
+	13, // 10: magma.cwf.PopulationReport.acct_start:type_name -> magma.cwf.RequestStats
+	13, // 11: magma.cwf.PopulationReport.acct_interim:type_name -> magma.cwf.RequestStats
+	13, // 12: magma.cwf.PopulationReport.acct_stop:type_name -> magma.cwf.RequestStats
+	14, // 13: magma.cwf.LoadReport.populations:type_name -> magma.cwf.PopulationReport
+	14, // 14: magma.cwf.LoadReport.total:type_name -> magma.cwf.PopulationReport
+	1,  // 15: magma.cwf.UESim.AddUE:input_type -> magma.cwf.UEConfig
+	2,  // 16: magma.cwf.UESim.Authenticate:input_type -> magma.cwf.AuthenticateRequest
+	4,  // 17: magma.cwf.UESim.Disconnect:input_type -> magma.cwf.DisconnectRequest
+	6,  // 18: magma.cwf.UESim.GenTraffic:input_type -> magma.cwf.GenTrafficRequest
+	11, // 19: magma.cwf.UESim.StartLoad:input_type -> magma.cwf.LoadScenario
+	17, // 20: magma.cwf.UESim.GetLoadReport:input_type -> magma.orc8r.Void
+	17, // 21: magma.cwf.UESim.StopLoad:input_type -> magma.orc8r.Void
+	17, // 22: magma.cwf.UESim.AddUE:output_type -> magma.orc8r.Void
+	3,  // 23: magma.cwf.UESim.Authenticate:output_type -> magma.cwf.AuthenticateResponse
+	5,  // 24: magma.cwf.UESim.Disconnect:output_type -> magma.cwf.DisconnectResponse
+	7,  // 25: magma.cwf.UESim.GenTraffic:output_type -> magma.cwf.GenTrafficResponse
+	17, // 26: magma.cwf.UESim.StartLoad:output_type -> magma.orc8r.Void
+	15, // 27: magma.cwf.UESim.GetLoadReport:output_type -> magma.cwf.LoadReport
+	15, // 28: magma.cwf.UESim.StopLoad:output_type -> magma.cwf.LoadReport
+	22, // [22:29] is the sub-list for method output_type
+	15, // [15:22] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_cwf_protos_ue_sim_proto_init() }
@@ -995,6 +1712,78 @@ func file_cwf_protos_ue_sim_proto_init() {
 				return nil
 			}
 		}
+		file_cwf_protos_ue_sim_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UEPopulation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cwf_protos_ue_sim_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadScenario); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cwf_protos_ue_sim_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatencyBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cwf_protos_ue_sim_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cwf_protos_ue_sim_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopulationReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cwf_protos_ue_sim_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1002,7 +1791,7 @@ func file_cwf_protos_ue_sim_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cwf_protos_ue_sim_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1029,12 +1818,21 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type UESimClient interface {
 	// Adds a new UE to the store.
+	//
 	AddUE(ctx context.Context, in *UEConfig, opts ...grpc.CallOption) (*protos.Void, error)
 	// Triggers an authentication for the UE with the specified imsi.
+	//
 	Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...grpc.CallOption) (*AuthenticateResponse, error)
 	Disconnect(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*DisconnectResponse, error)
 	// Triggers iperf traffic towards the CWAG
 	GenTraffic(ctx context.Context, in *GenTrafficRequest, opts ...grpc.CallOption) (*GenTrafficResponse, error)
+	// Starts a load scenario driving RADIUS authentication & accounting of UE populations,
+	// only a single scenario may run at a time
+	StartLoad(ctx context.Context, in *LoadScenario, opts ...grpc.CallOption) (*protos.Void, error)
+	// Returns the report of the running or the last completed load scenario
+	GetLoadReport(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*LoadReport, error)
+	// Stops the running load scenario and returns its final report
+	StopLoad(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*LoadReport, error)
 }
 
 type uESimClient struct {
@@ -1081,15 +1879,51 @@ func (c *uESimClient) GenTraffic(ctx context.Context, in *GenTrafficRequest, opt
 	return out, nil
 }
 
+func (c *uESimClient) StartLoad(ctx context.Context, in *LoadScenario, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.cwf.UESim/StartLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uESimClient) GetLoadReport(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*LoadReport, error) {
+	out := new(LoadReport)
+	err := c.cc.Invoke(ctx, "/magma.cwf.UESim/GetLoadReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uESimClient) StopLoad(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*LoadReport, error) {
+	out := new(LoadReport)
+	err := c.cc.Invoke(ctx, "/magma.cwf.UESim/StopLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UESimServer is the server API for UESim service.
 type UESimServer interface {
 	// Adds a new UE to the store.
+	//
 	AddUE(context.Context, *UEConfig) (*protos.Void, error)
 	// Triggers an authentication for the UE with the specified imsi.
+	//
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
 	Disconnect(context.Context, *DisconnectRequest) (*DisconnectResponse, error)
 	// Triggers iperf traffic towards the CWAG
 	GenTraffic(context.Context, *GenTrafficRequest) (*GenTrafficResponse, error)
+	// Starts a load scenario driving RADIUS authentication & accounting of UE populations,
+	// only a single scenario may run at a time
+	StartLoad(context.Context, *LoadScenario) (*protos.Void, error)
+	// Returns the report of the running or the last completed load scenario
+	GetLoadReport(context.Context, *protos.Void) (*LoadReport, error)
+	// Stops the running load scenario and returns its final report
+	StopLoad(context.Context, *protos.Void) (*LoadReport, error)
 }
 
 // UnimplementedUESimServer can be embedded to have forward compatible implementations.
@@ -1108,6 +1942,15 @@ func (*UnimplementedUESimServer) Disconnect(context.Context, *DisconnectRequest)
 func (*UnimplementedUESimServer) GenTraffic(context.Context, *GenTrafficRequest) (*GenTrafficResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenTraffic not implemented")
 }
+func (*UnimplementedUESimServer) StartLoad(context.Context, *LoadScenario) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartLoad not implemented")
+}
+func (*UnimplementedUESimServer) GetLoadReport(context.Context, *protos.Void) (*LoadReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadReport not implemented")
+}
+func (*UnimplementedUESimServer) StopLoad(context.Context, *protos.Void) (*LoadReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopLoad not implemented")
+}
 
 func RegisterUESimServer(s *grpc.Server, srv UESimServer) {
 	s.RegisterService(&_UESim_serviceDesc, srv)
@@ -1185,6 +2028,60 @@ func _UESim_GenTraffic_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UESim_StartLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadScenario)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UESimServer).StartLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.cwf.UESim/StartLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UESimServer).StartLoad(ctx, req.(*LoadScenario))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UESim_GetLoadReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UESimServer).GetLoadReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.cwf.UESim/GetLoadReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UESimServer).GetLoadReport(ctx, req.(*protos.Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UESim_StopLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UESimServer).StopLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.cwf.UESim/StopLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UESimServer).StopLoad(ctx, req.(*protos.Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UESim_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.cwf.UESim",
 	HandlerType: (*UESimServer)(nil),
@@ -1205,6 +2102,18 @@ var _UESim_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenTraffic",
 			Handler:    _UESim_GenTraffic_Handler,
 		},
+		{
+			MethodName: "StartLoad",
+			Handler:    _UESim_StartLoad_Handler,
+		},
+		{
+			MethodName: "GetLoadReport",
+			Handler:    _UESim_GetLoadReport_Handler,
+		},
+		{
+			MethodName: "StopLoad",
+			Handler:    _UESim_StopLoad_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cwf/protos/ue_sim.proto",
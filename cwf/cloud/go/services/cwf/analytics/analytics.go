@@ -14,14 +14,21 @@
 package analytics
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"magma/cwf/cloud/go/cwf"
+	"magma/cwf/cloud/go/serdes"
 	cwf_calculations "magma/cwf/cloud/go/services/cwf/analytics/calculations"
+	cwf_models "magma/cwf/cloud/go/services/cwf/obsidian/models"
 	"magma/orc8r/cloud/go/services/analytics"
 	"magma/orc8r/cloud/go/services/analytics/calculations"
+	"magma/orc8r/cloud/go/services/analytics/query_api"
+	"magma/orc8r/cloud/go/services/configurator"
+	orc8r_models "magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
 	"magma/orc8r/lib/go/metrics"
 )
 
@@ -32,6 +39,7 @@ const (
 	userConsumptionHourlyMetricName = "user_consumption_hourly"
 	apThroughputMetricName          = "throughput_per_ap"
 	authenticationsMetricName       = "authentications_over_time"
+	venueAnalyticsMetricName        = "venue_analytics"
 )
 
 var (
@@ -49,6 +57,7 @@ var (
 	hourlyUserConsumptionLabels = []string{"hours", metrics.NetworkLabelName, calculations.DirectionLabel}
 	apThroughputLabels          = []string{calculations.DaysLabel, metrics.NetworkLabelName, calculations.DirectionLabel, calculations.APNLabel}
 	authenticationsLabels       = []string{calculations.DaysLabel, metrics.NetworkLabelName, calculations.AuthCodeLabel}
+	venueAnalyticsLabels        = []string{calculations.DaysLabel, metrics.NetworkLabelName, cwf_calculations.VenueLabel, cwf_calculations.APGroupLabel, cwf_calculations.KPILabel}
 )
 
 // GetAnalyticsCalculations ..
@@ -63,9 +72,10 @@ func GetAnalyticsCalculations(config *calculations.AnalyticsConfig) []calculatio
 	hourlyUserConsumptionGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: userConsumptionHourlyMetricName}, hourlyUserConsumptionLabels)
 	apThroughputGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: apThroughputMetricName}, apThroughputLabels)
 	authenticationsGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: authenticationsMetricName}, authenticationsLabels)
+	venueAnalyticsGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: venueAnalyticsMetricName}, venueAnalyticsLabels)
 
 	prometheus.MustRegister(xapGauge, userThroughputGauge, userConsumptionGauge,
-		hourlyUserConsumptionGauge, apThroughputGauge, authenticationsGauge)
+		hourlyUserConsumptionGauge, apThroughputGauge, authenticationsGauge, venueAnalyticsGauge)
 
 	calcs := make([]calculations.Calculation, 0)
 
@@ -85,6 +95,9 @@ func GetAnalyticsCalculations(config *calculations.AnalyticsConfig) []calculatio
 	// Authentication Calculations
 	calcs = append(calcs, getAuthenticationCalculations(daysToCalculate, authenticationsGauge, authenticationsMetricName)...)
 
+	// Venue & AP Group Calculations
+	calcs = append(calcs, getVenueCalculations(daysToCalculate, venueAnalyticsGauge, venueAnalyticsMetricName, loadNetworkVenues)...)
+
 	// Raw Metrics
 	calcs = append(calcs, analytics.GetRawMetricsCalculations(config)...)
 
@@ -207,3 +220,50 @@ func getAuthenticationCalculations(daysList []uint, gauge *prometheus.GaugeVec,
 	}
 	return calcs
 }
+
+func getVenueCalculations(daysList []uint, gauge *prometheus.GaugeVec, metricName string, loader cwf_calculations.VenueLoader) []calculations.Calculation {
+	calcs := make([]calculations.Calculation, 0)
+	for _, dayParam := range daysList {
+		calcs = append(calcs, &cwf_calculations.VenueCalculation{
+			BaseCalculation: calculations.BaseCalculation{
+				CalculationParams: calculations.CalculationParams{
+					Days:                dayParam,
+					RegisteredGauge:     gauge,
+					Labels:              prometheus.Labels{calculations.DaysLabel: fmt.Sprint(dayParam)},
+					Name:                metricName,
+					ExpectedGaugeLabels: venueAnalyticsLabels,
+				},
+			},
+			QueryStepSize: daysToQueryStepSize[dayParam],
+			LoadVenues:    loader,
+		})
+	}
+	return calcs
+}
+
+// GetNetworkVenueAnalytics returns the analytics of the network over the past
+// days broken down per venue and AP group. Days must be one of 1, 7 or 30.
+func GetNetworkVenueAnalytics(prometheusClient query_api.PrometheusAPI, networkID string, days uint, venues cwf_models.CwfVenues) (*cwf_models.CwfNetworkVenueAnalytics, error) {
+	stepSize, ok := daysToQueryStepSize[days]
+	if !ok {
+		return nil, fmt.Errorf("unsupported number of days %d", days)
+	}
+	return cwf_calculations.GetVenueAnalytics(prometheusClient, networkID, days, stepSize, venues)
+}
+
+// loadNetworkVenues returns the venues of all CWF networks keyed by network ID
+func loadNetworkVenues() (map[string]cwf_models.CwfVenues, error) {
+	networks, err := configurator.LoadNetworksOfType(context.Background(), cwf.CwfNetworkType, false, true, serdes.Network)
+	if err != nil {
+		return nil, err
+	}
+	ret := map[string]cwf_models.CwfVenues{}
+	for _, network := range networks {
+		networkConfig := orc8r_models.GetNetworkConfig(network, cwf.CwfNetworkType)
+		if networkConfig == nil {
+			continue
+		}
+		ret[network.ID] = networkConfig.(*cwf_models.NetworkCarrierWifiConfigs).Venues
+	}
+	return ret, nil
+}
@@ -83,3 +83,14 @@ func TestGetAuthenticationCalculations(t *testing.T) {
 		assert.Equal(t, fmt.Sprint(c.Days), c.Labels[calculations.DaysLabel])
 	}
 }
+
+func TestGetVenueCalculations(t *testing.T) {
+	venueAnalyticsGauge := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: venueAnalyticsMetricName}, venueAnalyticsLabels)
+	calcs := getVenueCalculations(daysToCalculate, venueAnalyticsGauge, "metricName", loadNetworkVenues)
+	assert.Len(t, calcs, 3)
+	for _, calc := range calcs {
+		c := calc.(*cwf_calculations.VenueCalculation)
+		assert.Equal(t, fmt.Sprint(c.Days), c.Labels[calculations.DaysLabel])
+		assert.Equal(t, daysToQueryStepSize[c.Days], c.QueryStepSize)
+	}
+}
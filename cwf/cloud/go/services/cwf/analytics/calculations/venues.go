@@ -0,0 +1,315 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package calculations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/common/model"
+
+	"magma/cwf/cloud/go/services/cwf/obsidian/models"
+	"magma/orc8r/cloud/go/services/analytics/calculations"
+	"magma/orc8r/cloud/go/services/analytics/protos"
+	"magma/orc8r/cloud/go/services/analytics/query_api"
+	"magma/orc8r/lib/go/metrics"
+)
+
+const (
+	// VenueLabel defines label string literal for the venue ID
+	VenueLabel = "venue"
+	// APGroupLabel defines label string literal for the AP group ID
+	APGroupLabel = "ap_group"
+	// KPILabel defines label string literal for the name of the venue KPI
+	KPILabel = "kpi"
+
+	// AllAPGroups is the AP group label value of the venue wide results
+	AllAPGroups = "all"
+	// UnassignedVenue is the venue label value of the APs which don't belong to any venue
+	UnassignedVenue = "unassigned"
+
+	imsiLabel = "imsi"
+)
+
+// Names of the KPIs computed per venue and AP group, they match the JSON
+// field names of models.CwfSiteAnalytics
+const (
+	KPIAuthenticationsSucceeded = "authentications_succeeded"
+	KPIAuthenticationsFailed    = "authentications_failed"
+	KPIThroughputIn             = "throughput_in"
+	KPIThroughputOut            = "throughput_out"
+	KPIActiveUsers              = "active_users"
+)
+
+// VenueLoader returns the venues of all CWF networks keyed by network ID
+type VenueLoader func() (map[string]models.CwfVenues, error)
+
+// VenueCalculation holds the parameters needed to break down authentications,
+// throughput & active users of all CWF networks per venue and AP group
+type VenueCalculation struct {
+	calculations.BaseCalculation
+	QueryStepSize time.Duration
+	LoadVenues    VenueLoader
+}
+
+// Calculate returns the venue KPIs over the past X days segmented by networkID,
+// venue, AP group and KPI name. Venue wide results have the AllAPGroups AP group.
+func (x *VenueCalculation) Calculate(prometheusClient query_api.PrometheusAPI) ([]*protos.CalculationResult, error) {
+	glog.Infof("Calculating Venue Analytics. Days: %d", x.Days)
+
+	venues, err := x.LoadVenues()
+	if err != nil {
+		return nil, fmt.Errorf("venue analytics load error: %s", err)
+	}
+	sitesPerNetwork, err := queryVenueSites(prometheusClient, "", x.Days, x.QueryStepSize, venues)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*protos.CalculationResult, 0)
+	for nID, sites := range sitesPerNetwork {
+		for key, site := range sites {
+			for kpi, value := range site.kpis() {
+				labels := calculations.CombineLabels(x.Labels, map[string]string{
+					metrics.NetworkLabelName: nID,
+					VenueLabel:               key.venue,
+					APGroupLabel:             key.apGroup,
+					KPILabel:                 kpi,
+				})
+				results = append(results, calculations.NewResult(value, x.Name, labels))
+			}
+		}
+	}
+	return results, nil
+}
+
+// GetVenueAnalytics returns the authentications, throughput & active users
+// of the network over the past days, broken down per venue and AP group
+func GetVenueAnalytics(
+	prometheusClient query_api.PrometheusAPI,
+	networkID string,
+	days uint,
+	queryStepSize time.Duration,
+	venues models.CwfVenues,
+) (*models.CwfNetworkVenueAnalytics, error) {
+	sitesPerNetwork, err := queryVenueSites(prometheusClient, networkID, days, queryStepSize, map[string]models.CwfVenues{networkID: venues})
+	if err != nil {
+		return nil, err
+	}
+	sites := sitesPerNetwork[networkID]
+	get := func(venue, apGroup string) *models.CwfSiteAnalytics {
+		return sites[siteKey{venue: venue, apGroup: apGroup}].toModel()
+	}
+
+	ret := &models.CwfNetworkVenueAnalytics{
+		Days:       uint32(days),
+		Venues:     make([]*models.CwfVenueAnalytics, 0, len(venues)),
+		Unassigned: get(UnassignedVenue, AllAPGroups),
+	}
+	for _, venue := range venues {
+		venueAnalytics := &models.CwfVenueAnalytics{
+			ID:        venue.ID,
+			Name:      venue.Name,
+			Analytics: get(venue.ID, AllAPGroups),
+			ApGroups:  make([]*models.CwfApGroupAnalytics, 0, len(venue.ApGroups)),
+		}
+		for _, group := range venue.ApGroups {
+			venueAnalytics.ApGroups = append(venueAnalytics.ApGroups, &models.CwfApGroupAnalytics{
+				ID:        group.ID,
+				Name:      group.Name,
+				Analytics: get(venue.ID, group.ID),
+			})
+		}
+		ret.Venues = append(ret.Venues, venueAnalytics)
+	}
+	return ret, nil
+}
+
+type siteKey struct {
+	venue, apGroup string
+}
+
+// siteStats accumulates the KPIs of a venue or an AP group
+type siteStats struct {
+	authSucceeded, authFailed   float64
+	throughputIn, throughputOut float64
+	users                       map[string]struct{}
+}
+
+func (s *siteStats) kpis() map[string]float64 {
+	return map[string]float64{
+		KPIAuthenticationsSucceeded: s.authSucceeded,
+		KPIAuthenticationsFailed:    s.authFailed,
+		KPIThroughputIn:             s.throughputIn,
+		KPIThroughputOut:            s.throughputOut,
+		KPIActiveUsers:              float64(len(s.users)),
+	}
+}
+
+func (s *siteStats) toModel() *models.CwfSiteAnalytics {
+	if s == nil {
+		return &models.CwfSiteAnalytics{}
+	}
+	return &models.CwfSiteAnalytics{
+		AuthenticationsSucceeded: s.authSucceeded,
+		AuthenticationsFailed:    s.authFailed,
+		ThroughputIn:             s.throughputIn,
+		ThroughputOut:            s.throughputOut,
+		ActiveUsers:              uint32(len(s.users)),
+	}
+}
+
+// venueIndex maps normalized AP MACs of a network to their venue & AP group
+type venueIndex map[string]siteKey
+
+func newVenueIndex(venues models.CwfVenues) venueIndex {
+	idx := venueIndex{}
+	for _, venue := range venues {
+		for _, group := range venue.ApGroups {
+			for _, ap := range group.AccessPoints {
+				idx[models.NormalizeAPMac(ap)] = siteKey{venue: venue.ID, apGroup: group.ID}
+			}
+		}
+	}
+	return idx
+}
+
+// siteAccumulator adds samples of a network to both the AP group & the venue wide stats
+type siteAccumulator map[siteKey]*siteStats
+
+func (acc siteAccumulator) add(idx venueIndex, apn string, update func(*siteStats)) {
+	group, ok := idx[apMacFromCalledStationID(apn)]
+	if !ok {
+		group = siteKey{venue: UnassignedVenue, apGroup: AllAPGroups}
+	}
+	keys := []siteKey{group}
+	if group.apGroup != AllAPGroups {
+		keys = append(keys, siteKey{venue: group.venue, apGroup: AllAPGroups})
+	}
+	for _, key := range keys {
+		stats, ok := acc[key]
+		if !ok {
+			stats = &siteStats{users: map[string]struct{}{}}
+			acc[key] = stats
+		}
+		update(stats)
+	}
+}
+
+// queryVenueSites queries the venue KPIs of the given network (or all networks
+// if networkID is empty) and aggregates them per networkID, venue and AP group
+func queryVenueSites(
+	prometheusClient query_api.PrometheusAPI,
+	networkID string,
+	days uint,
+	queryStepSize time.Duration,
+	venues map[string]models.CwfVenues,
+) (map[string]siteAccumulator, error) {
+	selector := ""
+	if networkID != "" {
+		selector = fmt.Sprintf(`{%s=%q}`, metrics.NetworkLabelName, networkID)
+	}
+	authVec, err := queryVector(prometheusClient, fmt.Sprintf(
+		`sum(increase(eap_auth%s[%dd])) by (%s, %s, %s)`,
+		selector, days, calculations.APNLabel, calculations.AuthCodeLabel, metrics.NetworkLabelName))
+	if err != nil {
+		return nil, fmt.Errorf("venue authentications query error: %s", err)
+	}
+	throughputVecs := map[calculations.ConsumptionDirection]model.Vector{}
+	for _, dir := range []calculations.ConsumptionDirection{calculations.ConsumptionIn, calculations.ConsumptionOut} {
+		// Average of the non-zero AP throughput, same as APNThroughputCalculation
+		throughputVecs[dir], err = queryVector(prometheusClient, fmt.Sprintf(
+			`avg_over_time((sum(rate(octets_%s%s[3m])) by (%s, %s) > 0)[%dd:%s])`,
+			dir, selector, calculations.APNLabel, metrics.NetworkLabelName, days, model.Duration(queryStepSize)))
+		if err != nil {
+			return nil, fmt.Errorf("venue throughput query error: %s", err)
+		}
+	}
+	usersVec, err := queryVector(prometheusClient, fmt.Sprintf(
+		`count(max_over_time(active_sessions%s[%dd]) > 0) by (%s, %s, %s)`,
+		selector, days, calculations.APNLabel, imsiLabel, metrics.NetworkLabelName))
+	if err != nil {
+		return nil, fmt.Errorf("venue active users query error: %s", err)
+	}
+
+	indexes := map[string]venueIndex{}
+	ret := map[string]siteAccumulator{}
+	forEachSample := func(vec model.Vector, update func(*model.Sample, *siteStats)) {
+		for _, sample := range vec {
+			nID := string(sample.Metric[metrics.NetworkLabelName])
+			apn := string(sample.Metric[calculations.APNLabel])
+			if nID == "" || apn == "" {
+				glog.Errorf("Missing tags from Venue Calculation: APN: %s, NetworkID: %s", apn, nID)
+				continue
+			}
+			if _, ok := ret[nID]; !ok {
+				ret[nID] = siteAccumulator{}
+				indexes[nID] = newVenueIndex(venues[nID])
+			}
+			ret[nID].add(indexes[nID], apn, func(stats *siteStats) { update(sample, stats) })
+		}
+	}
+	forEachSample(authVec, func(sample *model.Sample, stats *siteStats) {
+		switch sample.Metric[calculations.AuthCodeLabel] {
+		case "Success":
+			stats.authSucceeded += float64(sample.Value)
+		case "Failure":
+			stats.authFailed += float64(sample.Value)
+		}
+	})
+	forEachSample(throughputVecs[calculations.ConsumptionIn], func(sample *model.Sample, stats *siteStats) {
+		stats.throughputIn += float64(sample.Value)
+	})
+	forEachSample(throughputVecs[calculations.ConsumptionOut], func(sample *model.Sample, stats *siteStats) {
+		stats.throughputOut += float64(sample.Value)
+	})
+	forEachSample(usersVec, func(sample *model.Sample, stats *siteStats) {
+		if imsi := string(sample.Metric[imsiLabel]); imsi != "" {
+			stats.users[imsi] = struct{}{}
+		}
+	})
+	return ret, nil
+}
+
+// queryVector is similar to query_api.QueryPrometheusVector, but an empty
+// result is valid for venue KPIs, e.g. a network with no failed authentications
+func queryVector(prometheusClient query_api.PrometheusAPI, query string) (model.Vector, error) {
+	val, _, err := prometheusClient.Query(context.Background(), query, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	vec, ok := val.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("unexpected ValueType: %v", val.Type())
+	}
+	return vec, nil
+}
+
+// apMacFromCalledStationID returns the normalized AP MAC of a Called-Station-Id
+// formatted as "<AP MAC>:<SSID>", see RFC 3580, section 3.20
+func apMacFromCalledStationID(calledStationID string) string {
+	mac := calledStationID
+	if len(mac) > 2 && mac[2] == ':' {
+		// colon separated MAC address, SSID starts after the 6th octet
+		if len(mac) > 17 {
+			mac = mac[:17]
+		}
+	} else if i := strings.IndexByte(mac, ':'); i >= 0 {
+		mac = mac[:i]
+	}
+	return models.NormalizeAPMac(mac)
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package calculations
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"magma/cwf/cloud/go/services/cwf/obsidian/models"
+	"magma/orc8r/cloud/go/services/analytics/calculations"
+	"magma/orc8r/cloud/go/services/analytics/query_api/mocks"
+)
+
+var testVenues = models.CwfVenues{
+	{
+		ID:   "station",
+		Name: "Central Station",
+		ApGroups: []*models.CwfApGroup{
+			{ID: "concourse", AccessPoints: []string{"98-DE-D0-84-B5-47", "98:de:d0:84:b5:48"}},
+			{ID: "platforms", AccessPoints: []string{"98DED084B549"}},
+		},
+	},
+	{
+		ID:       "airport",
+		ApGroups: []*models.CwfApGroup{{ID: "terminal", AccessPoints: []string{"98-DE-D0-84-B5-50"}}},
+	},
+}
+
+func venueSample(value float64, labels ...string) *model.Sample {
+	metric := model.Metric{"networkID": "n1"}
+	for i := 0; i+1 < len(labels); i += 2 {
+		metric[model.LabelName(labels[i])] = model.LabelValue(labels[i+1])
+	}
+	return &model.Sample{Metric: metric, Value: model.SampleValue(value)}
+}
+
+func newVenueClient() *mocks.PrometheusAPI {
+	client := &mocks.PrometheusAPI{}
+	queryContains := func(s string) interface{} {
+		return mock.MatchedBy(func(query string) bool { return strings.Contains(query, s) })
+	}
+	client.On("Query", mock.Anything, queryContains("eap_auth"), mock.Anything).Return(model.Vector{
+		venueSample(10, "apn", "98-DE-D0-84-B5-47:cwf", "code", "Success"),
+		venueSample(2, "apn", "98-DE-D0-84-B5-47:cwf", "code", "Failure"),
+		venueSample(5, "apn", "98:DE:D0:84:B5:48:cwf", "code", "Success"),
+		venueSample(4, "apn", "98-DE-D0-84-B5-49:cwf", "code", "Failure"),
+		venueSample(7, "apn", "00-11-22-33-44-55:cwf", "code", "Success"),
+	}, nil, nil)
+	client.On("Query", mock.Anything, queryContains("octets_in"), mock.Anything).Return(model.Vector{
+		venueSample(100, "apn", "98-DE-D0-84-B5-47:cwf"),
+		venueSample(50, "apn", "98-DE-D0-84-B5-49:cwf"),
+	}, nil, nil)
+	client.On("Query", mock.Anything, queryContains("octets_out"), mock.Anything).Return(model.Vector{
+		venueSample(30, "apn", "98-DE-D0-84-B5-50:cwf"),
+	}, nil, nil)
+	client.On("Query", mock.Anything, queryContains("active_sessions"), mock.Anything).Return(model.Vector{
+		venueSample(1, "apn", "98-DE-D0-84-B5-47:cwf", "imsi", "IMSI1"),
+		venueSample(1, "apn", "98-DE-D0-84-B5-48:cwf", "imsi", "IMSI1"),
+		venueSample(1, "apn", "98-DE-D0-84-B5-49:cwf", "imsi", "IMSI1"),
+		venueSample(1, "apn", "98-DE-D0-84-B5-49:cwf", "imsi", "IMSI2"),
+	}, nil, nil)
+	return client
+}
+
+func TestGetVenueAnalytics(t *testing.T) {
+	analytics, err := GetVenueAnalytics(newVenueClient(), "n1", 7, 0, testVenues)
+	assert.NoError(t, err)
+
+	expected := &models.CwfNetworkVenueAnalytics{
+		Days: 7,
+		Venues: []*models.CwfVenueAnalytics{
+			{
+				ID:   "station",
+				Name: "Central Station",
+				Analytics: &models.CwfSiteAnalytics{
+					AuthenticationsSucceeded: 15,
+					AuthenticationsFailed:    6,
+					ThroughputIn:             150,
+					ActiveUsers:              2,
+				},
+				ApGroups: []*models.CwfApGroupAnalytics{
+					{
+						ID: "concourse",
+						Analytics: &models.CwfSiteAnalytics{
+							AuthenticationsSucceeded: 15,
+							AuthenticationsFailed:    2,
+							ThroughputIn:             100,
+							ActiveUsers:              1,
+						},
+					},
+					{
+						ID: "platforms",
+						Analytics: &models.CwfSiteAnalytics{
+							AuthenticationsFailed: 4,
+							ThroughputIn:          50,
+							ActiveUsers:           2,
+						},
+					},
+				},
+			},
+			{
+				ID:        "airport",
+				Analytics: &models.CwfSiteAnalytics{ThroughputOut: 30},
+				ApGroups: []*models.CwfApGroupAnalytics{
+					{ID: "terminal", Analytics: &models.CwfSiteAnalytics{ThroughputOut: 30}},
+				},
+			},
+		},
+		Unassigned: &models.CwfSiteAnalytics{AuthenticationsSucceeded: 7},
+	}
+	assert.Equal(t, expected, analytics)
+
+	_, err = GetVenueAnalytics(errClient, "n1", 7, 0, testVenues)
+	assert.EqualError(t, err, "venue authentications query error: query error")
+	_, err = GetVenueAnalytics(matrixReturnClient, "n1", 7, 0, testVenues)
+	assert.EqualError(t, err, "venue authentications query error: unexpected ValueType: matrix")
+
+	// No data is not an error
+	analytics, err = GetVenueAnalytics(vectorReturnClient, "n1", 1, 0, testVenues)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.CwfSiteAnalytics{}, analytics.Unassigned)
+	assert.Len(t, analytics.Venues, 2)
+}
+
+func TestVenueCalculation(t *testing.T) {
+	calc := &VenueCalculation{
+		BaseCalculation: calculations.BaseCalculation{
+			CalculationParams: calculations.CalculationParams{
+				Days:   7,
+				Labels: basicLabels,
+				Name:   testMetricName,
+			},
+		},
+		LoadVenues: func() (map[string]models.CwfVenues, error) {
+			return map[string]models.CwfVenues{"n1": testVenues}, nil
+		},
+	}
+	results, err := calc.Calculate(newVenueClient())
+	assert.NoError(t, err)
+
+	values := map[string]float64{}
+	for _, res := range results {
+		assert.Equal(t, testMetricName, res.MetricName)
+		assert.True(t, calculations.CheckLabelsMatch(
+			[]string{"days", "networkID", VenueLabel, APGroupLabel, KPILabel}, prometheus.Labels(res.Labels)))
+		values[fmt.Sprintf("%s/%s/%s", res.Labels[VenueLabel], res.Labels[APGroupLabel], res.Labels[KPILabel])] = res.Value
+	}
+	// 3 AP groups + 2 venues + unassigned, 5 KPIs each
+	assert.Len(t, values, 30)
+	assert.Equal(t, 15.0, values["station/all/authentications_succeeded"])
+	assert.Equal(t, 2.0, values["station/all/active_users"])
+	assert.Equal(t, 2.0, values["station/platforms/active_users"])
+	assert.Equal(t, 30.0, values["airport/terminal/throughput_out"])
+	assert.Equal(t, 7.0, values["unassigned/all/authentications_succeeded"])
+
+	calc.LoadVenues = func() (map[string]models.CwfVenues, error) { return nil, fmt.Errorf("load error") }
+	_, err = calc.Calculate(newVenueClient())
+	assert.EqualError(t, err, "venue analytics load error: load error")
+}
+
+func TestAPMacFromCalledStationID(t *testing.T) {
+	assert.Equal(t, "98DED084B547", apMacFromCalledStationID("98-DE-D0-84-B5-47:cwf"))
+	assert.Equal(t, "98DED084B547", apMacFromCalledStationID("98:de:d0:84:b5:47:cwf"))
+	assert.Equal(t, "98DED084B547", apMacFromCalledStationID("98:de:d0:84:b5:47"))
+	assert.Equal(t, "98DED084B547", apMacFromCalledStationID("98ded084b547:my:ssid"))
+}
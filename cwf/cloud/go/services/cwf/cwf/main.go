@@ -52,6 +52,7 @@ func main() {
 		return
 	}
 	promQLClient := analytics.GetPrometheusClient()
+	obsidian.AttachHandlers(srv.EchoServer, handlers.GetAnalyticsHandlers(promQLClient))
 	calcs := cwf_analytics.GetAnalyticsCalculations(&serviceConfig.Analytics)
 	userStateManager := calculations.NewUserStateManager(promQLClient, "active_sessions")
 	collectorServicer := analytics_servicer.NewCollectorServicer(&serviceConfig.Analytics, promQLClient, calcs, userStateManager)
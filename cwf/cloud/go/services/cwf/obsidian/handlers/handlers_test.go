@@ -63,6 +63,8 @@ func TestCwfNetworks(t *testing.T) {
 	getSubscriberDirectory := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/cwf/:network_id/subscribers/:subscriber_id/directory_record", obsidian.GET).HandlerFunc
 	getCarrierWifiLiUes := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/cwf/:network_id/:li_ues", obsidian.GET).HandlerFunc
 	updateCarrierWifiLiUes := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/cwf/:network_id/:li_ues", obsidian.PUT).HandlerFunc
+	getVenues := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/cwf/:network_id/venues", obsidian.GET).HandlerFunc
+	updateVenues := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/cwf/:network_id/venues", obsidian.PUT).HandlerFunc
 
 	// Test ListNetworks
 	tc := tests.Test{
@@ -293,6 +295,75 @@ func TestCwfNetworks(t *testing.T) {
 	}
 	tests.RunUnitTest(t, e, tc)
 
+	// Test Venues
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/cwf/n1/venues",
+		Handler:        getVenues,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler(models2.CwfVenues{}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	venues := models2.CwfVenues{
+		{
+			ID:   "central_station",
+			Name: "Central Station",
+			ApGroups: []*models2.CwfApGroup{
+				{ID: "concourse", AccessPoints: []string{"98-DE-D0-84-B5-47", "98:de:d0:84:b5:48"}},
+				{ID: "platforms", AccessPoints: []string{"98DED084B549"}},
+			},
+		},
+		{
+			ID:       "airport",
+			ApGroups: []*models2.CwfApGroup{{ID: "terminal_1", AccessPoints: []string{"98-DE-D0-84-B5-50"}}},
+		},
+	}
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            "/magma/v1/cwf/n1/venues",
+		Handler:        updateVenues,
+		Payload:        tests.JSONMarshaler(venues),
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/cwf/n1/venues",
+		Handler:        getVenues,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler(venues),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// AP assigned to two groups
+	invalidVenues := models2.CwfVenues{
+		{
+			ID: "central_station",
+			ApGroups: []*models2.CwfApGroup{
+				{ID: "concourse", AccessPoints: []string{"98-DE-D0-84-B5-47"}},
+				{ID: "platforms", AccessPoints: []string{"98:DE:D0:84:B5:47"}},
+			},
+		},
+	}
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            "/magma/v1/cwf/n1/venues",
+		Handler:        updateVenues,
+		Payload:        tests.JSONMarshaler(invalidVenues),
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 400,
+		ExpectedError:  "Access point 98:DE:D0:84:B5:47 is assigned to both central_station/concourse and central_station/platforms",
+	}
+	tests.RunUnitTest(t, e, tc)
+
 	// Test DeleteNetwork
 	tc = tests.Test{
 		Method:         "DELETE",
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"magma/cwf/cloud/go/cwf"
+	"magma/cwf/cloud/go/serdes"
+	cwfAnalytics "magma/cwf/cloud/go/services/cwf/analytics"
+	cwfModels "magma/cwf/cloud/go/services/cwf/obsidian/models"
+	"magma/orc8r/cloud/go/services/analytics/query_api"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/obsidian"
+	"magma/orc8r/lib/go/merrors"
+)
+
+const (
+	defaultVenueAnalyticsDays = 1
+	venueAnalyticsDaysParam   = "days"
+)
+
+// GetAnalyticsHandlers returns the CWF handlers which query prometheus
+// through the given client
+func GetAnalyticsHandlers(prometheusClient query_api.PrometheusAPI) []obsidian.Handler {
+	return []obsidian.Handler{
+		{Path: NetworkVenueAnalyticsPath, Methods: obsidian.GET, HandlerFunc: getVenueAnalyticsHandler(prometheusClient)},
+	}
+}
+
+func getVenueAnalyticsHandler(prometheusClient query_api.PrometheusAPI) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		networkID, nerr := obsidian.GetNetworkId(c)
+		if nerr != nil {
+			return nerr
+		}
+		days := uint64(defaultVenueAnalyticsDays)
+		if daysStr := c.QueryParam(venueAnalyticsDaysParam); daysStr != "" {
+			var err error
+			days, err = strconv.ParseUint(daysStr, 10, 32)
+			if err != nil {
+				return obsidian.MakeHTTPError(err, http.StatusBadRequest)
+			}
+		}
+		switch days {
+		case 1, 7, 30:
+		default:
+			return obsidian.MakeHTTPError(fmt.Errorf("days must be one of 1, 7 or 30"), http.StatusBadRequest)
+		}
+
+		networkConfig, err := configurator.LoadNetworkConfig(c.Request().Context(), networkID, cwf.CwfNetworkType, serdes.Network)
+		if err == merrors.ErrNotFound {
+			return obsidian.MakeHTTPError(err, http.StatusNotFound)
+		} else if err != nil {
+			return obsidian.MakeHTTPError(err, http.StatusInternalServerError)
+		}
+		venues := networkConfig.(*cwfModels.NetworkCarrierWifiConfigs).Venues
+
+		ret, err := cwfAnalytics.GetNetworkVenueAnalytics(prometheusClient, networkID, uint(days), venues)
+		if err != nil {
+			return obsidian.MakeHTTPError(err, http.StatusInternalServerError)
+		}
+		return c.JSON(http.StatusOK, ret)
+	}
+}
@@ -230,6 +230,27 @@ func (m *LiUes) ValidateModel(context.Context) error {
 	return m.Validate(strfmt.Default)
 }
 
+func (m *CwfVenues) ToUpdateCriteria(network configurator.Network) (configurator.NetworkUpdateCriteria, error) {
+	networkConfig := orc8rModels.GetNetworkConfig(network, cwf.CwfNetworkType)
+	if networkConfig == nil {
+		return configurator.NetworkUpdateCriteria{}, merrors.ErrNotFound
+	}
+	networkConfig.(*NetworkCarrierWifiConfigs).Venues = *m
+	return orc8rModels.GetNetworkConfigUpdateCriteria(network.ID, cwf.CwfNetworkType, networkConfig), nil
+}
+
+func (m *CwfVenues) GetFromNetwork(network configurator.Network) interface{} {
+	networkConfig := orc8rModels.GetNetworkConfig(network, cwf.CwfNetworkType)
+	if networkConfig == nil {
+		return nil
+	}
+	venues := networkConfig.(*NetworkCarrierWifiConfigs).Venues
+	if venues == nil {
+		return CwfVenues{}
+	}
+	return venues
+}
+
 func (m *CwfHaPair) ToEntity() configurator.NetworkEntity {
 	return configurator.NetworkEntity{
 		Type:   cwf.CwfHAPairType,
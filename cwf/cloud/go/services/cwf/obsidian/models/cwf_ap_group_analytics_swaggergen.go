@@ -0,0 +1,127 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CwfApGroupAnalytics cwf ap group analytics
+//
+// swagger:model cwf_ap_group_analytics
+type CwfApGroupAnalytics struct {
+
+	// analytics
+	// Required: true
+	Analytics *CwfSiteAnalytics `json:"analytics"`
+
+	// id
+	// Required: true
+	ID string `json:"id"`
+
+	// name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this cwf ap group analytics
+func (m *CwfApGroupAnalytics) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAnalytics(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfApGroupAnalytics) validateAnalytics(formats strfmt.Registry) error {
+
+	if err := validate.Required("analytics", "body", m.Analytics); err != nil {
+		return err
+	}
+
+	if m.Analytics != nil {
+		if err := m.Analytics.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("analytics")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("analytics")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CwfApGroupAnalytics) validateID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cwf ap group analytics based on the context it is used
+func (m *CwfApGroupAnalytics) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAnalytics(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfApGroupAnalytics) contextValidateAnalytics(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Analytics != nil {
+		if err := m.Analytics.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("analytics")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("analytics")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CwfApGroupAnalytics) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CwfApGroupAnalytics) UnmarshalBinary(b []byte) error {
+	var res CwfApGroupAnalytics
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
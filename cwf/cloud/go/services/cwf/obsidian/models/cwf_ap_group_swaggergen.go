@@ -0,0 +1,108 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CwfApGroup cwf ap group
+//
+// swagger:model cwf_ap_group
+type CwfApGroup struct {
+
+	// MAC addresses of the group APs, as sent in the Called-Station-Id RADIUS attribute
+	// Example: ["98-DE-D0-84-B5-47"]
+	// Required: true
+	AccessPoints []string `json:"access_points"`
+
+	// id
+	// Example: concourse
+	// Required: true
+	// Min Length: 1
+	ID string `json:"id"`
+
+	// name
+	// Example: Main concourse
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this cwf ap group
+func (m *CwfApGroup) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAccessPoints(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfApGroup) validateAccessPoints(formats strfmt.Registry) error {
+
+	if err := validate.Required("access_points", "body", m.AccessPoints); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.AccessPoints); i++ {
+
+		if err := validate.Pattern("access_points"+"."+strconv.Itoa(i), "body", m.AccessPoints[i], `^([0-9a-fA-F]{2}[:-]?){5}[0-9a-fA-F]{2}$`); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CwfApGroup) validateID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("id", "body", m.ID, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cwf ap group based on context it is used
+func (m *CwfApGroup) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CwfApGroup) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CwfApGroup) UnmarshalBinary(b []byte) error {
+	var res CwfApGroup
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
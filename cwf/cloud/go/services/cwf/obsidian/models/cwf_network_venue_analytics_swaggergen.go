@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CwfNetworkVenueAnalytics cwf network venue analytics
+//
+// swagger:model cwf_network_venue_analytics
+type CwfNetworkVenueAnalytics struct {
+
+	// days
+	// Required: true
+	Days uint32 `json:"days"`
+
+	// Analytics of the APs which don't belong to any venue
+	// Required: true
+	Unassigned *CwfSiteAnalytics `json:"unassigned"`
+
+	// venues
+	// Required: true
+	Venues []*CwfVenueAnalytics `json:"venues"`
+}
+
+// Validate validates this cwf network venue analytics
+func (m *CwfNetworkVenueAnalytics) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDays(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUnassigned(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVenues(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfNetworkVenueAnalytics) validateDays(formats strfmt.Registry) error {
+
+	if err := validate.Required("days", "body", uint32(m.Days)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CwfNetworkVenueAnalytics) validateUnassigned(formats strfmt.Registry) error {
+
+	if err := validate.Required("unassigned", "body", m.Unassigned); err != nil {
+		return err
+	}
+
+	if m.Unassigned != nil {
+		if err := m.Unassigned.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("unassigned")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("unassigned")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CwfNetworkVenueAnalytics) validateVenues(formats strfmt.Registry) error {
+
+	if err := validate.Required("venues", "body", m.Venues); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Venues); i++ {
+		if swag.IsZero(m.Venues[i]) { // not required
+			continue
+		}
+
+		if m.Venues[i] != nil {
+			if err := m.Venues[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("venues" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("venues" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cwf network venue analytics based on the context it is used
+func (m *CwfNetworkVenueAnalytics) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateUnassigned(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVenues(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfNetworkVenueAnalytics) contextValidateUnassigned(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Unassigned != nil {
+		if err := m.Unassigned.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("unassigned")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("unassigned")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CwfNetworkVenueAnalytics) contextValidateVenues(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Venues); i++ {
+
+		if m.Venues[i] != nil {
+			if err := m.Venues[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("venues" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("venues" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CwfNetworkVenueAnalytics) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CwfNetworkVenueAnalytics) UnmarshalBinary(b []byte) error {
+	var res CwfNetworkVenueAnalytics
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
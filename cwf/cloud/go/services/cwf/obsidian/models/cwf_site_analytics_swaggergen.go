@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CwfSiteAnalytics cwf site analytics
+//
+// swagger:model cwf_site_analytics
+type CwfSiteAnalytics struct {
+
+	// Number of unique users which had a session
+	// Required: true
+	ActiveUsers uint32 `json:"active_users"`
+
+	// authentications failed
+	// Required: true
+	AuthenticationsFailed float64 `json:"authentications_failed"`
+
+	// authentications succeeded
+	// Required: true
+	AuthenticationsSucceeded float64 `json:"authentications_succeeded"`
+
+	// Average inbound throughput, in bytes per second
+	// Required: true
+	ThroughputIn float64 `json:"throughput_in"`
+
+	// Average outbound throughput, in bytes per second
+	// Required: true
+	ThroughputOut float64 `json:"throughput_out"`
+}
+
+// Validate validates this cwf site analytics
+func (m *CwfSiteAnalytics) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateActiveUsers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAuthenticationsFailed(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAuthenticationsSucceeded(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateThroughputIn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateThroughputOut(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfSiteAnalytics) validateActiveUsers(formats strfmt.Registry) error {
+
+	if err := validate.Required("active_users", "body", uint32(m.ActiveUsers)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CwfSiteAnalytics) validateAuthenticationsFailed(formats strfmt.Registry) error {
+
+	if err := validate.Required("authentications_failed", "body", float64(m.AuthenticationsFailed)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CwfSiteAnalytics) validateAuthenticationsSucceeded(formats strfmt.Registry) error {
+
+	if err := validate.Required("authentications_succeeded", "body", float64(m.AuthenticationsSucceeded)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CwfSiteAnalytics) validateThroughputIn(formats strfmt.Registry) error {
+
+	if err := validate.Required("throughput_in", "body", float64(m.ThroughputIn)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CwfSiteAnalytics) validateThroughputOut(formats strfmt.Registry) error {
+
+	if err := validate.Required("throughput_out", "body", float64(m.ThroughputOut)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cwf site analytics based on context it is used
+func (m *CwfSiteAnalytics) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CwfSiteAnalytics) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CwfSiteAnalytics) UnmarshalBinary(b []byte) error {
+	var res CwfSiteAnalytics
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
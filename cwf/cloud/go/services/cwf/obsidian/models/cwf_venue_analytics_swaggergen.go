@@ -0,0 +1,187 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CwfVenueAnalytics cwf venue analytics
+//
+// swagger:model cwf_venue_analytics
+type CwfVenueAnalytics struct {
+
+	// analytics
+	// Required: true
+	Analytics *CwfSiteAnalytics `json:"analytics"`
+
+	// ap groups
+	// Required: true
+	ApGroups []*CwfApGroupAnalytics `json:"ap_groups"`
+
+	// id
+	// Required: true
+	ID string `json:"id"`
+
+	// name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this cwf venue analytics
+func (m *CwfVenueAnalytics) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAnalytics(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateApGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfVenueAnalytics) validateAnalytics(formats strfmt.Registry) error {
+
+	if err := validate.Required("analytics", "body", m.Analytics); err != nil {
+		return err
+	}
+
+	if m.Analytics != nil {
+		if err := m.Analytics.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("analytics")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("analytics")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CwfVenueAnalytics) validateApGroups(formats strfmt.Registry) error {
+
+	if err := validate.Required("ap_groups", "body", m.ApGroups); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.ApGroups); i++ {
+		if swag.IsZero(m.ApGroups[i]) { // not required
+			continue
+		}
+
+		if m.ApGroups[i] != nil {
+			if err := m.ApGroups[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ap_groups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ap_groups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CwfVenueAnalytics) validateID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cwf venue analytics based on the context it is used
+func (m *CwfVenueAnalytics) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAnalytics(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateApGroups(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfVenueAnalytics) contextValidateAnalytics(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Analytics != nil {
+		if err := m.Analytics.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("analytics")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("analytics")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CwfVenueAnalytics) contextValidateApGroups(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ApGroups); i++ {
+
+		if m.ApGroups[i] != nil {
+			if err := m.ApGroups[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ap_groups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ap_groups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CwfVenueAnalytics) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CwfVenueAnalytics) UnmarshalBinary(b []byte) error {
+	var res CwfVenueAnalytics
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
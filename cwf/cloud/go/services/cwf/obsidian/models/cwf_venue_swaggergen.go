@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CwfVenue cwf venue
+//
+// swagger:model cwf_venue
+type CwfVenue struct {
+
+	// ap groups
+	// Required: true
+	ApGroups []*CwfApGroup `json:"ap_groups"`
+
+	// id
+	// Example: central_station
+	// Required: true
+	// Min Length: 1
+	ID string `json:"id"`
+
+	// name
+	// Example: Central Station
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this cwf venue
+func (m *CwfVenue) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateApGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfVenue) validateApGroups(formats strfmt.Registry) error {
+
+	if err := validate.Required("ap_groups", "body", m.ApGroups); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.ApGroups); i++ {
+		if swag.IsZero(m.ApGroups[i]) { // not required
+			continue
+		}
+
+		if m.ApGroups[i] != nil {
+			if err := m.ApGroups[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ap_groups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ap_groups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CwfVenue) validateID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("id", "body", m.ID, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cwf venue based on the context it is used
+func (m *CwfVenue) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateApGroups(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CwfVenue) contextValidateApGroups(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ApGroups); i++ {
+
+		if m.ApGroups[i] != nil {
+			if err := m.ApGroups[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("ap_groups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("ap_groups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CwfVenue) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CwfVenue) UnmarshalBinary(b []byte) error {
+	var res CwfVenue
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GatewayDataPathProbeConfigs Probing of the data path with synthetic UE packets sent through the GRE tunnels and the UPF. A path whose loss or latency exceeds the thresholds is reported as unhealthy.
+//
+// swagger:model gateway_data_path_probe_configs
+type GatewayDataPathProbeConfigs struct {
+
+	// enabled
+	// Required: true
+	Enabled bool `json:"enabled"`
+
+	// Average latency over the window above which a path is degraded
+	// Example: 200
+	MaxLatencyMs uint32 `json:"max_latency_ms,omitempty"`
+
+	// Loss ratio over the window above which a path is degraded
+	// Example: 0.2
+	// Maximum: 1
+	// Minimum: 0
+	MaxLossPct float32 `json:"max_loss_pct,omitempty"`
+
+	// IP address beyond the UPF which answers the probe packets
+	// Example: 10.0.2.1
+	// Format: ipv4
+	TargetIP strfmt.IPv4 `json:"target_ip,omitempty"`
+
+	// Time to wait for a probe reply before counting it as lost
+	// Example: 1000
+	TimeoutMs uint32 `json:"timeout_ms,omitempty"`
+
+	// Source IP address of the synthetic UE packets
+	// Example: 192.168.128.254
+	// Format: ipv4
+	UeIP strfmt.IPv4 `json:"ue_ip,omitempty"`
+
+	// Number of most recent probes the loss and latency are computed over
+	// Example: 30
+	// Minimum: 1
+	WindowSize uint32 `json:"window_size,omitempty"`
+}
+
+// Validate validates this gateway data path probe configs
+func (m *GatewayDataPathProbeConfigs) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEnabled(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxLossPct(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTargetIP(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUeIP(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWindowSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GatewayDataPathProbeConfigs) validateEnabled(formats strfmt.Registry) error {
+
+	if err := validate.Required("enabled", "body", bool(m.Enabled)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GatewayDataPathProbeConfigs) validateMaxLossPct(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxLossPct) { // not required
+		return nil
+	}
+
+	if err := validate.Minimum("max_loss_pct", "body", float64(m.MaxLossPct), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("max_loss_pct", "body", float64(m.MaxLossPct), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GatewayDataPathProbeConfigs) validateTargetIP(formats strfmt.Registry) error {
+	if swag.IsZero(m.TargetIP) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("target_ip", "body", "ipv4", m.TargetIP.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GatewayDataPathProbeConfigs) validateUeIP(formats strfmt.Registry) error {
+	if swag.IsZero(m.UeIP) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("ue_ip", "body", "ipv4", m.UeIP.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GatewayDataPathProbeConfigs) validateWindowSize(formats strfmt.Registry) error {
+	if swag.IsZero(m.WindowSize) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumUint("window_size", "body", uint64(m.WindowSize), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this gateway data path probe configs based on context it is used
+func (m *GatewayDataPathProbeConfigs) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GatewayDataPathProbeConfigs) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GatewayDataPathProbeConfigs) UnmarshalBinary(b []byte) error {
+	var res GatewayDataPathProbeConfigs
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
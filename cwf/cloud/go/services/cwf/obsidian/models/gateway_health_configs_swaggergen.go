@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -21,6 +22,9 @@ type GatewayHealthConfigs struct {
 	// Example: 0.9
 	CPUUtilThresholdPct float32 `json:"cpu_util_threshold_pct,omitempty"`
 
+	// data path probe
+	DataPathProbe *GatewayDataPathProbeConfigs `json:"data_path_probe,omitempty"`
+
 	// gre probe interval secs
 	// Example: 5
 	GreProbeIntervalSecs uint32 `json:"gre_probe_interval_secs,omitempty"`
@@ -36,11 +40,64 @@ type GatewayHealthConfigs struct {
 
 // Validate validates this gateway health configs
 func (m *GatewayHealthConfigs) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDataPathProbe(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GatewayHealthConfigs) validateDataPathProbe(formats strfmt.Registry) error {
+	if swag.IsZero(m.DataPathProbe) { // not required
+		return nil
+	}
+
+	if m.DataPathProbe != nil {
+		if err := m.DataPathProbe.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("data_path_probe")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("data_path_probe")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this gateway health configs based on context it is used
+// ContextValidate validate this gateway health configs based on the context it is used
 func (m *GatewayHealthConfigs) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDataPathProbe(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GatewayHealthConfigs) contextValidateDataPathProbe(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DataPathProbe != nil {
+		if err := m.DataPathProbe.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("data_path_probe")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("data_path_probe")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -45,6 +45,9 @@ type NetworkCarrierWifiConfigs struct {
 	// Example: ["dpi","policy_enforcement"]
 	// Required: true
 	NetworkServices []string `json:"network_services"`
+
+	// venues
+	Venues CwfVenues `json:"venues,omitempty"`
 }
 
 // Validate validates this network carrier wifi configs
@@ -75,6 +78,10 @@ func (m *NetworkCarrierWifiConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVenues(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -205,6 +212,23 @@ func (m *NetworkCarrierWifiConfigs) validateNetworkServices(formats strfmt.Regis
 	return nil
 }
 
+func (m *NetworkCarrierWifiConfigs) validateVenues(formats strfmt.Registry) error {
+	if swag.IsZero(m.Venues) { // not required
+		return nil
+	}
+
+	if err := m.Venues.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("venues")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("venues")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this network carrier wifi configs based on the context it is used
 func (m *NetworkCarrierWifiConfigs) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -225,6 +249,10 @@ func (m *NetworkCarrierWifiConfigs) ContextValidate(ctx context.Context, formats
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateVenues(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -295,6 +323,20 @@ func (m *NetworkCarrierWifiConfigs) contextValidateLiUes(ctx context.Context, fo
 	return nil
 }
 
+func (m *NetworkCarrierWifiConfigs) contextValidateVenues(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Venues.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("venues")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("venues")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *NetworkCarrierWifiConfigs) MarshalBinary() ([]byte, error) {
 	if m == nil {
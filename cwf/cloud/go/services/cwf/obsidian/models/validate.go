@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -26,6 +27,9 @@ func (m *CwfNetwork) ValidateModel(context.Context) error {
 	if err := m.Validate(strfmt.Default); err != nil {
 		return err
 	}
+	if m.CarrierWifi != nil {
+		return m.CarrierWifi.Venues.ValidateModel(context.Background())
+	}
 	return nil
 }
 
@@ -33,7 +37,7 @@ func (m *NetworkCarrierWifiConfigs) ValidateModel(context.Context) error {
 	if err := m.Validate(strfmt.Default); err != nil {
 		return err
 	}
-	return nil
+	return m.Venues.ValidateModel(context.Background())
 }
 
 func (m *GatewayCwfConfigs) ValidateModel(context.Context) error {
@@ -49,9 +53,70 @@ func (m *GatewayCwfConfigs) ValidateModel(context.Context) error {
 		}
 		set[peer.IP] = append(set[peer.IP], swag.Uint32Value(peer.Key))
 	}
+	if m.GatewayHealthConfigs != nil {
+		return m.GatewayHealthConfigs.DataPathProbe.ValidateModel(context.Background())
+	}
+	return nil
+}
+
+func (m *GatewayDataPathProbeConfigs) ValidateModel(context.Context) error {
+	if m == nil || !m.Enabled {
+		return nil
+	}
+	if err := m.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	if m.TargetIP == "" || m.UeIP == "" {
+		return fmt.Errorf("target_ip and ue_ip are required to enable the data path probe")
+	}
 	return nil
 }
 
+func (m *CwfVenues) ValidateModel(context.Context) error {
+	if m == nil {
+		return nil
+	}
+	if err := m.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	venueIDs := map[string]struct{}{}
+	apGroups := map[string]string{}
+	for _, venue := range *m {
+		if venue == nil {
+			return fmt.Errorf("venue cannot be null")
+		}
+		if _, found := venueIDs[venue.ID]; found {
+			return fmt.Errorf("Found duplicate venue %s", venue.ID)
+		}
+		venueIDs[venue.ID] = struct{}{}
+		groupIDs := map[string]struct{}{}
+		for _, group := range venue.ApGroups {
+			if group == nil {
+				return fmt.Errorf("AP group of venue %s cannot be null", venue.ID)
+			}
+			if _, found := groupIDs[group.ID]; found {
+				return fmt.Errorf("Found duplicate AP group %s in venue %s", group.ID, venue.ID)
+			}
+			groupIDs[group.ID] = struct{}{}
+			for _, ap := range group.AccessPoints {
+				key := NormalizeAPMac(ap)
+				where := venue.ID + "/" + group.ID
+				if prev, found := apGroups[key]; found {
+					return fmt.Errorf("Access point %s is assigned to both %s and %s", ap, prev, where)
+				}
+				apGroups[key] = where
+			}
+		}
+	}
+	return nil
+}
+
+// NormalizeAPMac returns the given AP MAC address in its canonical form,
+// upper case hex digits without separators
+func NormalizeAPMac(mac string) string {
+	return strings.ToUpper(strings.NewReplacer(":", "", "-", "", ".", "").Replace(mac))
+}
+
 func (m *CwfSubscriberDirectoryRecord) ValidateModel(context.Context) error {
 	if err := m.Validate(strfmt.Default); err != nil {
 		return err
@@ -216,7 +216,8 @@ func getHealthServiceGrePeers(pipelinedPeers []*lte_mconfig.PipelineD_AllowedGre
 	}
 	for _, peer := range pipelinedPeers {
 		healthPeer := &cwf_mconfig.CwfGatewayHealthConfigGrePeer{
-			Ip: peer.Ip,
+			Ip:  peer.Ip,
+			Key: peer.Key,
 		}
 		healthPeers = append(healthPeers, healthPeer)
 	}
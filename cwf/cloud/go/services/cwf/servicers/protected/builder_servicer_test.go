@@ -155,9 +155,18 @@ func TestBuilder_Build(t *testing.T) {
 				IcmpProbePktCount:   0,
 				GrePeers: []*cwf_mconfig.CwfGatewayHealthConfigGrePeer{
 					{Ip: "1.2.3.4/24"},
-					{Ip: "1.1.1.1/24"},
+					{Ip: "1.1.1.1/24", Key: 111},
 				},
 				ClusterVirtualIp: "10.10.10.11",
+				DataPathProbe: &cwf_mconfig.CwfGatewayHealthConfigDataPathProbe{
+					Enabled:      true,
+					TargetIp:     "10.0.2.1",
+					UeIp:         "192.168.128.254",
+					WindowSize:   30,
+					MaxLossPct:   0.2,
+					MaxLatencyMs: 200,
+					TimeoutMs:    1000,
+				},
 			},
 		}
 
@@ -225,4 +234,15 @@ var defaultgwConfig = &models.GatewayCwfConfigs{
 		IP:   "192.168.128.88",
 		Port: 2040,
 	},
+	GatewayHealthConfigs: &models.GatewayHealthConfigs{
+		DataPathProbe: &models.GatewayDataPathProbeConfigs{
+			Enabled:      true,
+			TargetIP:     "10.0.2.1",
+			UeIP:         "192.168.128.254",
+			WindowSize:   30,
+			MaxLossPct:   0.2,
+			MaxLatencyMs: 200,
+			TimeoutMs:    1000,
+		},
+	},
 }
@@ -30,6 +30,8 @@ const (
 	GatewayPromotionFailedEvent    = "gateway_promotion_failed"
 	GatewayDemotionSucceededEvent  = "gateway_demotion_succeeded"
 	GatewayDemotionFailedEvent     = "gateway_demotion_failed"
+	GREPathDegradedEvent           = "gre_path_degraded"
+	GREPathRecoveredEvent          = "gre_path_recovered"
 )
 
 // GatewayHealthFailed event
@@ -56,6 +58,25 @@ func LogGatewayHealthFailedEvent(eventType string, failureReason string) {
 	go logEvent(eventType, fmt.Sprintf("%s", serializedHealthEvent))
 }
 
+// GREPathStatus event
+type GREPathStatus struct {
+	Peer      string  `json:"peer"`
+	LossRatio float64 `json:"loss_ratio"`
+	LatencyMs float64 `json:"latency_ms"`
+	Reason    string  `json:"reason,omitempty"`
+}
+
+// LogGREPathEvent logs a degradation/recovery event of the data path through
+// a GRE peer.
+func LogGREPathEvent(eventType string, pathStatus *GREPathStatus) {
+	serializedPathEvent, err := json.Marshal(pathStatus)
+	if err != nil {
+		glog.Errorf("Could not serialize %s event: %s", eventType, err)
+		return
+	}
+	go logEvent(eventType, string(serializedPathEvent))
+}
+
 func logEvent(eventType string, eventValue string) {
 	hwid := status.GetHwId()
 	event := &orcprotos.Event{
@@ -17,6 +17,7 @@ import (
 	"flag"
 	"net"
 	"strings"
+	"time"
 
 	mconfigprotos "magma/cwf/cloud/go/protos/mconfig"
 	"magma/cwf/gateway/registry"
@@ -41,6 +42,11 @@ const (
 	defaultGREProbeInterval = 10
 	defaultICMPPktCount     = 3
 	defaultInterface        = "eth1"
+
+	defaultDataPathWindowSize   = 30
+	defaultDataPathMaxLossPct   = 0.2
+	defaultDataPathMaxLatencyMs = 500
+	defaultDataPathTimeoutMs    = 1000
 )
 
 func main() {
@@ -50,7 +56,7 @@ func main() {
 		glog.Fatalf("Error creating %s service: %s", registry.GatewayHealth, err)
 	}
 	cfg := getHealthMconfig()
-	probe := gre_probe.NewICMPProbe(cfg.GrePeers, cfg.GreProbeInterval, int(cfg.IcmpProbePktCount))
+	probe := getGREProbe(cfg)
 
 	transportVIP := cfg.GetClusterVirtualIp()
 	if len(transportVIP) == 0 {
@@ -81,6 +87,44 @@ func main() {
 	}
 }
 
+// getGREProbe returns a data path probe if enabled and the probe could be
+// created, otherwise an ICMP probe of the GRE peers.
+func getGREProbe(cfg *mconfigprotos.CwfGatewayHealthConfig) gre_probe.GREProbe {
+	dataPathCfg := cfg.GetDataPathProbe()
+	if !dataPathCfg.GetEnabled() {
+		return gre_probe.NewICMPProbe(cfg.GrePeers, cfg.GreProbeInterval, int(cfg.IcmpProbePktCount))
+	}
+	prober, err := gre_probe.NewGREEchoProber(dataPathCfg.UeIp, dataPathCfg.TargetIp)
+	if err != nil {
+		glog.Errorf("Error creating data path prober, falling back to ICMP probe: %s", err)
+		return gre_probe.NewICMPProbe(cfg.GrePeers, cfg.GreProbeInterval, int(cfg.IcmpProbePktCount))
+	}
+	if dataPathCfg.WindowSize == 0 {
+		dataPathCfg.WindowSize = defaultDataPathWindowSize
+	}
+	if dataPathCfg.MaxLossPct == 0 {
+		dataPathCfg.MaxLossPct = defaultDataPathMaxLossPct
+	}
+	if dataPathCfg.MaxLatencyMs == 0 {
+		dataPathCfg.MaxLatencyMs = defaultDataPathMaxLatencyMs
+	}
+	if dataPathCfg.TimeoutMs == 0 {
+		dataPathCfg.TimeoutMs = defaultDataPathTimeoutMs
+	}
+	glog.Infof("Using data path probe: %v", dataPathCfg)
+	return gre_probe.NewDataPathProbe(
+		cfg.GrePeers,
+		time.Duration(cfg.GreProbeInterval)*time.Second,
+		time.Duration(dataPathCfg.TimeoutMs)*time.Millisecond,
+		gre_probe.PathThresholds{
+			WindowSize:   int(dataPathCfg.WindowSize),
+			MaxLossRatio: float64(dataPathCfg.MaxLossPct),
+			MaxLatency:   time.Duration(dataPathCfg.MaxLatencyMs) * time.Millisecond,
+		},
+		prober,
+	)
+}
+
 func getHealthMconfig() *mconfigprotos.CwfGatewayHealthConfig {
 	ret := &mconfigprotos.CwfGatewayHealthConfig{}
 	err := mconfig.GetServiceConfigs(strings.ToLower(registry.GatewayHealth), ret)
@@ -118,7 +162,8 @@ func removeSubnetEndpoints(endpoints []*mconfigprotos.CwfGatewayHealthConfigGreP
 		}
 		if !strings.HasSuffix(parsedIP.String(), ".0") {
 			parsedGrePeer := &mconfigprotos.CwfGatewayHealthConfigGrePeer{
-				Ip: parsedIP.String(),
+				Ip:  parsedIP.String(),
+				Key: endpoint.Key,
 			}
 			ret = append(ret, parsedGrePeer)
 			continue
@@ -0,0 +1,296 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gre_probe
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"magma/cwf/cloud/go/protos/mconfig"
+	"magma/cwf/gateway/services/gateway_health/events"
+	"magma/cwf/gateway/services/gateway_health/metrics"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// A degraded path recovers once its loss & latency drop below
+	// recoveryFactor * threshold, to avoid flapping around the thresholds
+	recoveryFactor = 0.5
+
+	probeResultSuccess = "success"
+	probeResultLost    = "lost"
+)
+
+// PathProber sends a single synthetic UE packet through the data path of a
+// GRE peer and returns its round trip time, or an error if no reply was
+// received within the timeout. seq identifies the probe.
+type PathProber interface {
+	Probe(peer *mconfig.CwfGatewayHealthConfigGrePeer, seq uint16, timeout time.Duration) (time.Duration, error)
+}
+
+// PathThresholds defines when the data path of a GRE peer is degraded.
+type PathThresholds struct {
+	// WindowSize is the number of most recent probes loss & latency are computed over
+	WindowSize int
+	// MaxLossRatio is the ratio (0-1) of lost probes above which a path is degraded
+	MaxLossRatio float64
+	// MaxLatency is the average round trip time above which a path is
+	// degraded, 0 disables the latency threshold
+	MaxLatency time.Duration
+}
+
+// PathStats contains the loss & latency of a data path over the probe window.
+type PathStats struct {
+	Samples   int
+	LossRatio float64
+	Latency   time.Duration
+	Degraded  bool
+}
+
+func (p PathStats) String() string {
+	return fmt.Sprintf("loss: %.2f, latency: %v over %d probes", p.LossRatio, p.Latency, p.Samples)
+}
+
+// DataPathProbe implements the GRE probe interface by sending synthetic UE
+// packets through the GRE tunnels and the UPF. Loss and latency are measured
+// over a sliding window of probes, a path exceeding the thresholds is
+// reported as unreachable so the gateway health reflects a broken data path
+// even when the GRE peer itself still answers pings.
+type DataPathProbe struct {
+	Endpoints  []*mconfig.CwfGatewayHealthConfigGrePeer
+	Interval   time.Duration
+	Timeout    time.Duration
+	Thresholds PathThresholds
+
+	prober   PathProber
+	logEvent func(eventType string, pathStatus *events.GREPathStatus)
+	seq      uint16
+
+	// Maps endpoints IPs to the sliding window of their probe results
+	paths        map[string]*pathWindow
+	sync.RWMutex // R/W lock synchronizing paths access
+	stop         chan bool
+}
+
+// NewDataPathProbe creates a new DataPathProbe sending a probe through each
+// endpoint every interval with the given prober.
+func NewDataPathProbe(
+	endpoints []*mconfig.CwfGatewayHealthConfigGrePeer,
+	interval, timeout time.Duration,
+	thresholds PathThresholds,
+	prober PathProber,
+) *DataPathProbe {
+	if thresholds.WindowSize < 1 {
+		thresholds.WindowSize = 1
+	}
+	return &DataPathProbe{
+		Endpoints:  endpoints,
+		Interval:   interval,
+		Timeout:    timeout,
+		Thresholds: thresholds,
+		prober:     prober,
+		logEvent:   events.LogGREPathEvent,
+		paths:      map[string]*pathWindow{},
+		stop:       make(chan bool),
+	}
+}
+
+// Start begins the data path probes of the DataPathProbe's endpoints.
+func (d *DataPathProbe) Start() error {
+	startProbe := func() {
+		for {
+			select {
+			case <-d.stop:
+				return
+			case <-time.After(d.Interval):
+				d.executeProbe()
+			}
+		}
+	}
+	go startProbe()
+	return nil
+}
+
+// Stop stops the data path probes of the DataPathProbe's endpoints.
+func (d *DataPathProbe) Stop() {
+	d.stop <- true
+	d.Lock()
+	d.paths = map[string]*pathWindow{}
+	d.Unlock()
+}
+
+// GetStatus returns the endpoints with a healthy data path as reachable and
+// the ones with a degraded data path as unreachable.
+func (d *DataPathProbe) GetStatus() *GREProbeStatus {
+	var reachable []string
+	var unreachable []string
+	details := map[string]string{}
+	d.RLock()
+	defer d.RUnlock()
+	for ip, path := range d.paths {
+		stats := path.stats()
+		if stats.Degraded {
+			unreachable = append(unreachable, ip)
+		} else {
+			reachable = append(reachable, ip)
+		}
+		details[ip] = stats.String()
+	}
+	return &GREProbeStatus{
+		Reachable:   reachable,
+		Unreachable: unreachable,
+		Details:     details,
+	}
+}
+
+// GetPathStats returns the data path stats of an endpoint.
+func (d *DataPathProbe) GetPathStats(ip string) (PathStats, bool) {
+	d.RLock()
+	defer d.RUnlock()
+	path, ok := d.paths[ip]
+	if !ok {
+		return PathStats{}, false
+	}
+	return path.stats(), true
+}
+
+func (d *DataPathProbe) executeProbe() {
+	for _, endpoint := range d.Endpoints {
+		// sequence numbers are unique across endpoints so that a late reply
+		// through a peer is never matched with the probe of another one
+		d.seq++
+		rtt, err := d.prober.Probe(endpoint, d.seq, d.Timeout)
+		if err != nil {
+			glog.V(2).Infof("Data path probe %d through GRE peer %s failed: %s", d.seq, endpoint.Ip, err)
+			metrics.GrePathProbes.WithLabelValues(endpoint.Ip, probeResultLost).Inc()
+		} else {
+			metrics.GrePathProbes.WithLabelValues(endpoint.Ip, probeResultSuccess).Inc()
+		}
+		d.recordResult(endpoint.Ip, rtt, err == nil)
+	}
+}
+
+func (d *DataPathProbe) recordResult(ip string, rtt time.Duration, success bool) {
+	d.Lock()
+	path, ok := d.paths[ip]
+	if !ok {
+		path = newPathWindow(d.Thresholds.WindowSize)
+		d.paths[ip] = path
+	}
+	path.add(rtt, success)
+	transition, reason := path.evaluate(d.Thresholds)
+	stats := path.stats()
+	d.Unlock()
+
+	metrics.GrePathLossRatio.WithLabelValues(ip).Set(stats.LossRatio)
+	metrics.GrePathLatencyMs.WithLabelValues(ip).Set(float64(stats.Latency) / float64(time.Millisecond))
+	if stats.Degraded {
+		metrics.GrePathDegraded.WithLabelValues(ip).Set(1)
+	} else {
+		metrics.GrePathDegraded.WithLabelValues(ip).Set(0)
+	}
+
+	if !transition {
+		return
+	}
+	eventType := events.GREPathRecoveredEvent
+	if stats.Degraded {
+		eventType = events.GREPathDegradedEvent
+		glog.Warningf("Data path through GRE peer %s is degraded: %s", ip, reason)
+	} else {
+		glog.Infof("Data path through GRE peer %s recovered: %s", ip, stats)
+	}
+	d.logEvent(eventType, &events.GREPathStatus{
+		Peer:      ip,
+		LossRatio: stats.LossRatio,
+		LatencyMs: float64(stats.Latency) / float64(time.Millisecond),
+		Reason:    reason,
+	})
+}
+
+type pathSample struct {
+	rtt     time.Duration
+	success bool
+}
+
+// pathWindow is a ring buffer of the most recent probe results of a path.
+type pathWindow struct {
+	samples  []pathSample
+	next     int
+	count    int
+	degraded bool
+}
+
+func newPathWindow(size int) *pathWindow {
+	return &pathWindow{samples: make([]pathSample, size)}
+}
+
+func (w *pathWindow) add(rtt time.Duration, success bool) {
+	w.samples[w.next] = pathSample{rtt: rtt, success: success}
+	w.next = (w.next + 1) % len(w.samples)
+	if w.count < len(w.samples) {
+		w.count++
+	}
+}
+
+func (w *pathWindow) stats() PathStats {
+	var lost int
+	var totalRtt time.Duration
+	for i := 0; i < w.count; i++ {
+		if w.samples[i].success {
+			totalRtt += w.samples[i].rtt
+		} else {
+			lost++
+		}
+	}
+	ret := PathStats{Samples: w.count, Degraded: w.degraded}
+	if w.count > 0 {
+		ret.LossRatio = float64(lost) / float64(w.count)
+	}
+	if received := w.count - lost; received > 0 {
+		ret.Latency = totalRtt / time.Duration(received)
+	}
+	return ret
+}
+
+// evaluate updates the degraded state of the path and returns whether it
+// changed, along with the reason of a degradation. A path is only degraded
+// once half of the window is filled, so that a single lost probe after a
+// restart doesn't trigger a failover.
+func (w *pathWindow) evaluate(thresholds PathThresholds) (bool, string) {
+	stats := w.stats()
+	if !w.degraded {
+		if stats.Samples*2 < len(w.samples) {
+			return false, ""
+		}
+		reason := ""
+		if stats.LossRatio > thresholds.MaxLossRatio {
+			reason = fmt.Sprintf("loss %.2f exceeds threshold %.2f", stats.LossRatio, thresholds.MaxLossRatio)
+		} else if thresholds.MaxLatency > 0 && stats.Latency > thresholds.MaxLatency {
+			reason = fmt.Sprintf("latency %v exceeds threshold %v", stats.Latency, thresholds.MaxLatency)
+		}
+		w.degraded = reason != ""
+		return w.degraded, reason
+	}
+	lossRecovered := stats.LossRatio <= thresholds.MaxLossRatio*recoveryFactor
+	latencyRecovered := thresholds.MaxLatency <= 0 ||
+		(stats.Latency <= time.Duration(float64(thresholds.MaxLatency)*recoveryFactor) && stats.LossRatio < 1)
+	if lossRecovered && latencyRecovered {
+		w.degraded = false
+		return true, ""
+	}
+	return false, ""
+}
@@ -0,0 +1,229 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gre_probe
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"magma/cwf/cloud/go/protos/mconfig"
+	"magma/cwf/gateway/services/gateway_health/events"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type probeResult struct {
+	rtt  time.Duration
+	lost bool
+}
+
+// scriptedProber returns the next scripted result of each peer on each probe
+type scriptedProber struct {
+	results map[string][]probeResult
+	seqs    []uint16
+}
+
+func (p *scriptedProber) Probe(peer *mconfig.CwfGatewayHealthConfigGrePeer, seq uint16, timeout time.Duration) (time.Duration, error) {
+	p.seqs = append(p.seqs, seq)
+	results := p.results[peer.Ip]
+	if len(results) == 0 {
+		return 0, fmt.Errorf("no result")
+	}
+	res := results[0]
+	p.results[peer.Ip] = results[1:]
+	if res.lost {
+		return 0, fmt.Errorf("no reply within %v", timeout)
+	}
+	return res.rtt, nil
+}
+
+func repeatResult(res probeResult, n int) []probeResult {
+	ret := make([]probeResult, n)
+	for i := range ret {
+		ret[i] = res
+	}
+	return ret
+}
+
+func TestDataPathProbe(t *testing.T) {
+	ok := probeResult{rtt: 10 * time.Millisecond}
+	slow := probeResult{rtt: 300 * time.Millisecond}
+	lost := probeResult{lost: true}
+	prober := &scriptedProber{results: map[string][]probeResult{
+		// 2 probes lost out of 4, then healthy again
+		"10.0.0.1": append([]probeResult{ok, lost, ok, lost}, repeatResult(ok, 4)...),
+		// always healthy
+		"10.0.0.2": repeatResult(ok, 8),
+		// latency degraded
+		"10.0.0.3": repeatResult(slow, 8),
+	}}
+	probe := NewDataPathProbe(
+		[]*mconfig.CwfGatewayHealthConfigGrePeer{{Ip: "10.0.0.1"}, {Ip: "10.0.0.2"}, {Ip: "10.0.0.3", Key: 100}},
+		time.Second,
+		time.Second,
+		PathThresholds{WindowSize: 4, MaxLossRatio: 0.25, MaxLatency: 200 * time.Millisecond},
+		prober,
+	)
+	var loggedEvents []string
+	probe.logEvent = func(eventType string, pathStatus *events.GREPathStatus) {
+		loggedEvents = append(loggedEvents, fmt.Sprintf("%s:%s", eventType, pathStatus.Peer))
+	}
+
+	// Paths are not degraded before half of the window is filled
+	probe.executeProbe()
+	status := probe.GetStatus()
+	assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, status.Reachable)
+	assert.Empty(t, status.Unreachable)
+	assert.Empty(t, loggedEvents)
+
+	probe.executeProbe()
+	status = probe.GetStatus()
+	assert.ElementsMatch(t, []string{"10.0.0.2"}, status.Reachable)
+	assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.3"}, status.Unreachable)
+	assert.Equal(t, []string{"gre_path_degraded:10.0.0.1", "gre_path_degraded:10.0.0.3"}, loggedEvents)
+	stats, found := probe.GetPathStats("10.0.0.1")
+	assert.True(t, found)
+	assert.Equal(t, PathStats{Samples: 2, LossRatio: 0.5, Latency: 10 * time.Millisecond, Degraded: true}, stats)
+	assert.Equal(t, "loss: 0.50, latency: 10ms over 2 probes", status.Details["10.0.0.1"])
+
+	// 10.0.0.1 stays degraded until the loss drops below half the threshold
+	for i := 0; i < 5; i++ {
+		probe.executeProbe()
+	}
+	stats, _ = probe.GetPathStats("10.0.0.1")
+	assert.True(t, stats.Degraded)
+	assert.Equal(t, 0.25, stats.LossRatio)
+	probe.executeProbe()
+	stats, _ = probe.GetPathStats("10.0.0.1")
+	assert.False(t, stats.Degraded)
+	assert.Equal(t, 0.0, stats.LossRatio)
+	assert.Equal(t, "gre_path_recovered:10.0.0.1", loggedEvents[len(loggedEvents)-1])
+
+	status = probe.GetStatus()
+	assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.2"}, status.Reachable)
+	assert.ElementsMatch(t, []string{"10.0.0.3"}, status.Unreachable)
+
+	// Sequence numbers are unique across peers
+	seen := map[uint16]bool{}
+	for _, seq := range prober.seqs {
+		assert.False(t, seen[seq])
+		seen[seq] = true
+	}
+
+	_, found = probe.GetPathStats("10.0.0.4")
+	assert.False(t, found)
+}
+
+func TestGREEchoProberPacketPath(t *testing.T) {
+	ue, target := net.ParseIP("192.168.128.254").To4(), net.ParseIP("10.0.2.1").To4()
+	local, peer, otherPeer := net.ParseIP("10.0.0.1").To4(), net.ParseIP("10.0.0.2").To4(), net.ParseIP("10.0.0.3").To4()
+	sockets := &fakeSockets{injected: make(chan []byte, 1), captured: make(chan []byte, 3)}
+	prober, err := newGREEchoProber(ue.String(), target.String(), sockets, func(ip net.IP) (net.IP, error) {
+		assert.Equal(t, peer, ip)
+		return local, nil
+	})
+	assert.NoError(t, err)
+
+	for _, key := range []uint32{0, 100} {
+		done := make(chan error)
+		go func() {
+			_, err := prober.Probe(&mconfig.CwfGatewayHealthConfigGrePeer{Ip: peer.String(), Key: key}, 7, time.Second)
+			done <- err
+		}()
+
+		// The request is injected at the local GRE ingress, as if the peer sent it
+		pkt := <-sockets.injected
+		assert.Equal(t, uint16(0), checksum(pkt[:ipv4HeaderLen]))
+		assert.Equal(t, byte(ipProtoGRE), pkt[9])
+		assert.Equal(t, peer, net.IP(pkt[12:16]))
+		assert.Equal(t, local, net.IP(pkt[16:20]))
+		gre := pkt[ipv4HeaderLen:]
+		greLen := 4
+		if key != 0 {
+			greLen = 8
+			assert.Equal(t, uint32(100), binary.BigEndian.Uint32(gre[4:]))
+		}
+		inner := gre[greLen:]
+		assert.Equal(t, uint16(0), checksum(inner[:ipv4HeaderLen]))
+		assert.Equal(t, uint16(0), checksum(inner[ipv4HeaderLen:]))
+		assert.Equal(t, ue, net.IP(inner[12:16]))
+		assert.Equal(t, target, net.IP(inner[16:20]))
+		assert.Equal(t, byte(icmpEcho), inner[ipv4HeaderLen])
+
+		// The UPF answers the request through the tunnel towards the peer
+		reply := func(from, to net.IP) []byte {
+			reply := make([]byte, len(pkt))
+			copy(reply, pkt)
+			copy(reply[12:16], from)
+			copy(reply[16:20], to)
+			inner := reply[ipv4HeaderLen+greLen:]
+			copy(inner[12:16], target)
+			copy(inner[16:20], ue)
+			inner[ipv4HeaderLen] = icmpEchoReply
+			return reply
+		}
+		// the captured request itself & a reply tunneled to another peer are ignored
+		sockets.captured <- pkt
+		sockets.captured <- reply(local, otherPeer)
+		select {
+		case err := <-done:
+			t.Fatalf("probe completed without reply: %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+		sockets.captured <- reply(local, peer)
+		assert.NoError(t, <-done)
+	}
+}
+
+func TestGREEchoReply(t *testing.T) {
+	ue, target := net.ParseIP("192.168.128.254"), net.ParseIP("10.0.2.1")
+	local, peer := net.ParseIP("10.0.0.1"), net.ParseIP("10.0.0.2")
+	gre := buildGREEcho(100, target, ue, 42, 7)
+	ip := gre[8:]
+	ip[ipv4HeaderLen] = icmpEchoReply
+	reply := buildIPv4(ipProtoGRE, local, peer, 1, gre)
+
+	replyPeer, seq, ok := parseGREEchoReply(reply, ue, target, 42)
+	assert.True(t, ok)
+	assert.True(t, peer.Equal(replyPeer))
+	assert.Equal(t, uint16(7), seq)
+
+	_, _, ok = parseGREEchoReply(reply, ue, target, 43)
+	assert.False(t, ok)
+	_, _, ok = parseGREEchoReply(reply[:ipv4HeaderLen+18], ue, target, 42)
+	assert.False(t, ok)
+	_, _, ok = parseGREEchoReply(gre, ue, target, 42)
+	assert.False(t, ok)
+}
+
+// fakeSockets records the injected packets & returns the queued captured ones
+type fakeSockets struct {
+	injected chan []byte
+	captured chan []byte
+}
+
+func (s *fakeSockets) inject(pkt []byte, dst net.IP) error {
+	if !dst.Equal(net.IP(pkt[16:20])) {
+		return fmt.Errorf("packet to %s sent to %s", net.IP(pkt[16:20]), dst)
+	}
+	s.injected <- pkt
+	return nil
+}
+
+func (s *fakeSockets) capture(buf []byte) (int, error) {
+	return copy(buf, <-s.captured), nil
+}
@@ -0,0 +1,274 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gre_probe
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"magma/cwf/cloud/go/protos/mconfig"
+
+	"github.com/golang/glog"
+)
+
+const (
+	greProtoIPv4  = 0x0800
+	greFlagKey    = 0x2000
+	ipv4HeaderLen = 20
+	icmpHeaderLen = 8
+	icmpEcho      = 8
+	icmpEchoReply = 0
+	ipProtoICMP   = 1
+	ipProtoGRE    = 47
+	probeTTL      = 64
+	maxPacketLen  = 1500
+)
+
+// probeSockets injects the probes into the host's network stack & captures
+// the packets carrying their replies.
+type probeSockets interface {
+	// inject sends an IPv4 packet, its header included, to dst
+	inject(pkt []byte, dst net.IP) error
+	// capture reads the next IPv4 packet received or sent by the host
+	capture(buf []byte) (int, error)
+}
+
+// GREEchoProber is a PathProber sending an ICMP echo request from a UE IP to
+// a target IP, GRE encapsulated as if it was sent by a UE behind the peer. The
+// request is injected at the local GRE ingress, i.e. addressed from the peer
+// to the local tunnel endpoint, so it's decapsulated & goes through the UPF
+// like the uplink traffic of the peer's UEs. The reply traverses the UPF &
+// the tunnel back to the peer like downlink traffic & is matched by capturing
+// the GRE packets the host sends to the peer.
+type GREEchoProber struct {
+	UeIP     net.IP
+	TargetIP net.IP
+
+	sockets probeSockets
+	// Returns the local GRE endpoint IP the peer sends its tunneled packets to
+	localIP func(peer net.IP) (net.IP, error)
+	id      uint16
+
+	// Maps the sequence number of the in-flight probes to their peer & reply channel
+	pending map[uint16]*pendingProbe
+	sync.Mutex
+}
+
+type pendingProbe struct {
+	peer    net.IP
+	replyCh chan time.Time
+}
+
+// NewGREEchoProber opens the raw sockets used by the prober, it needs
+// CAP_NET_RAW.
+func NewGREEchoProber(ueIP, targetIP string) (*GREEchoProber, error) {
+	sockets, err := openProbeSockets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open raw sockets: %s", err)
+	}
+	return newGREEchoProber(ueIP, targetIP, sockets, localIPTowards)
+}
+
+func newGREEchoProber(
+	ueIP, targetIP string,
+	sockets probeSockets,
+	localIP func(peer net.IP) (net.IP, error),
+) (*GREEchoProber, error) {
+	ue, target := net.ParseIP(ueIP).To4(), net.ParseIP(targetIP).To4()
+	if ue == nil || target == nil {
+		return nil, fmt.Errorf("invalid UE IP '%s' or target IP '%s'", ueIP, targetIP)
+	}
+	p := &GREEchoProber{
+		UeIP:     ue,
+		TargetIP: target,
+		sockets:  sockets,
+		localIP:  localIP,
+		id:       uint16(os.Getpid()),
+		pending:  map[uint16]*pendingProbe{},
+	}
+	go p.receive()
+	return p, nil
+}
+
+// Probe injects the echo request at the local ingress of the peer's tunnel
+// and waits for its reply to be sent to the peer.
+func (p *GREEchoProber) Probe(peer *mconfig.CwfGatewayHealthConfigGrePeer, seq uint16, timeout time.Duration) (time.Duration, error) {
+	peerIP := net.ParseIP(peer.Ip).To4()
+	if peerIP == nil {
+		return 0, fmt.Errorf("invalid GRE peer IP '%s'", peer.Ip)
+	}
+	localIP, err := p.localIP(peerIP)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the local GRE endpoint of peer %s: %s", peer.Ip, err)
+	}
+	probe := &pendingProbe{peer: peerIP, replyCh: make(chan time.Time, 1)}
+	p.Lock()
+	p.pending[seq] = probe
+	p.Unlock()
+	defer func() {
+		p.Lock()
+		delete(p.pending, seq)
+		p.Unlock()
+	}()
+
+	pkt := buildIPv4(ipProtoGRE, peerIP, localIP, seq, buildGREEcho(peer.Key, p.UeIP, p.TargetIP, p.id, seq))
+	sent := time.Now()
+	if err := p.sockets.inject(pkt, localIP); err != nil {
+		return 0, err
+	}
+	select {
+	case received := <-probe.replyCh:
+		return received.Sub(sent), nil
+	case <-time.After(timeout):
+		return 0, fmt.Errorf("no reply within %v", timeout)
+	}
+}
+
+func (p *GREEchoProber) receive() {
+	buf := make([]byte, maxPacketLen)
+	for {
+		n, err := p.sockets.capture(buf)
+		if err != nil {
+			glog.Errorf("GRE echo prober read error: %s", err)
+			return
+		}
+		received := time.Now()
+		peer, seq, ok := parseGREEchoReply(buf[:n], p.UeIP, p.TargetIP, p.id)
+		if !ok {
+			continue
+		}
+		p.Lock()
+		if probe, found := p.pending[seq]; found && probe.peer.Equal(peer) {
+			select {
+			case probe.replyCh <- received:
+			default:
+			}
+		}
+		p.Unlock()
+	}
+}
+
+// localIPTowards returns the local IP the host sends packets to peer from,
+// which is the tunnel endpoint the peer sends its GRE packets to.
+func localIPTowards(peer net.IP) (net.IP, error) {
+	// connecting a UDP socket only selects the route, nothing is sent
+	conn, err := net.DialUDP("udp4", nil, &net.UDPAddr{IP: peer, Port: 9})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).IP.To4(), nil
+}
+
+// buildIPv4 returns an IPv4 packet with the given protocol & payload.
+func buildIPv4(proto byte, src, dst net.IP, id uint16, payload []byte) []byte {
+	pkt := make([]byte, ipv4HeaderLen+len(payload))
+	ip := pkt[:ipv4HeaderLen]
+	ip[0] = 0x45 // version 4, header length 5 words
+	binary.BigEndian.PutUint16(ip[2:], uint16(len(pkt)))
+	binary.BigEndian.PutUint16(ip[4:], id)
+	ip[8] = probeTTL
+	ip[9] = proto
+	copy(ip[12:16], src.To4())
+	copy(ip[16:20], dst.To4())
+	binary.BigEndian.PutUint16(ip[10:], checksum(ip))
+	copy(pkt[ipv4HeaderLen:], payload)
+	return pkt
+}
+
+// buildGREEcho returns a GRE packet encapsulating an IPv4 ICMP echo request
+// from src to dst, see RFC 2784 & RFC 2890 for the GRE header.
+func buildGREEcho(key uint32, src, dst net.IP, id, seq uint16) []byte {
+	greLen := 4
+	if key != 0 {
+		greLen += 4
+	}
+	payload := make([]byte, 8)
+	binary.BigEndian.PutUint64(payload, uint64(time.Now().UnixNano()))
+	icmp := make([]byte, icmpHeaderLen+len(payload))
+	icmp[0] = icmpEcho
+	binary.BigEndian.PutUint16(icmp[4:], id)
+	binary.BigEndian.PutUint16(icmp[6:], seq)
+	copy(icmp[icmpHeaderLen:], payload)
+	binary.BigEndian.PutUint16(icmp[2:], checksum(icmp))
+
+	gre := make([]byte, greLen)
+	binary.BigEndian.PutUint16(gre[2:], greProtoIPv4)
+	if key != 0 {
+		binary.BigEndian.PutUint16(gre[0:], greFlagKey)
+		binary.BigEndian.PutUint32(gre[4:], key)
+	}
+	return append(gre, buildIPv4(ipProtoICMP, src, dst, seq, icmp)...)
+}
+
+// parseGREEchoReply returns the GRE peer & the sequence number of an IPv4
+// packet tunneling an ICMP echo reply from target to ue with the given id
+// towards the peer.
+func parseGREEchoReply(pkt []byte, ue, target net.IP, id uint16) (net.IP, uint16, bool) {
+	if len(pkt) < ipv4HeaderLen || pkt[0]>>4 != 4 || pkt[9] != ipProtoGRE {
+		return nil, 0, false
+	}
+	peer := net.IP(pkt[16:20])
+	outerLen := int(pkt[0]&0x0f) * 4
+	if len(pkt) < outerLen+4 {
+		return nil, 0, false
+	}
+	gre := pkt[outerLen:]
+	if binary.BigEndian.Uint16(gre[2:]) != greProtoIPv4 {
+		return nil, 0, false
+	}
+	flags := binary.BigEndian.Uint16(gre)
+	greLen := 4
+	if flags&0x8000 != 0 { // checksum present
+		greLen += 4
+	}
+	if flags&greFlagKey != 0 {
+		greLen += 4
+	}
+	if flags&0x1000 != 0 { // sequence number present
+		greLen += 4
+	}
+	if len(gre) < greLen+ipv4HeaderLen {
+		return nil, 0, false
+	}
+	ip := gre[greLen:]
+	ihl := int(ip[0]&0x0f) * 4
+	if ip[0]>>4 != 4 || ip[9] != ipProtoICMP || len(ip) < ihl+icmpHeaderLen ||
+		!net.IP(ip[12:16]).Equal(target) || !net.IP(ip[16:20]).Equal(ue) {
+		return nil, 0, false
+	}
+	icmp := ip[ihl:]
+	if icmp[0] != icmpEchoReply || binary.BigEndian.Uint16(icmp[4:]) != id {
+		return nil, 0, false
+	}
+	return peer, binary.BigEndian.Uint16(icmp[6:]), true
+}
+
+func checksum(b []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(b); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(b[i:]))
+	}
+	if len(b)%2 == 1 {
+		sum += uint32(b[len(b)-1]) << 8
+	}
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return ^uint16(sum)
+}
@@ -30,6 +30,9 @@ type GREProbe interface {
 type GREProbeStatus struct {
 	Reachable   []string
 	Unreachable []string
+	// Details optionally maps endpoint IPs to a description of their last
+	// probe results, e.g. the loss & latency of their data path
+	Details map[string]string
 }
 
 type GREEndpointStatus uint
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gre_probe
+
+import (
+	"net"
+	"syscall"
+)
+
+// rawSockets injects the probes with a raw IPv4 socket writing their header,
+// so that they can be addressed from the GRE peer, & captures the packets the
+// host sends & receives with a packet socket.
+type rawSockets struct {
+	injectFd  int
+	captureFd int
+}
+
+func openProbeSockets() (probeSockets, error) {
+	// IPPROTO_RAW implies IP_HDRINCL
+	injectFd, err := syscall.Socket(syscall.AF_INET, syscall.SOCK_RAW, syscall.IPPROTO_RAW)
+	if err != nil {
+		return nil, err
+	}
+	// SOCK_DGRAM packet sockets strip the link layer header
+	captureFd, err := syscall.Socket(syscall.AF_PACKET, syscall.SOCK_DGRAM, int(htons(syscall.ETH_P_IP)))
+	if err != nil {
+		syscall.Close(injectFd)
+		return nil, err
+	}
+	return &rawSockets{injectFd: injectFd, captureFd: captureFd}, nil
+}
+
+func (s *rawSockets) inject(pkt []byte, dst net.IP) error {
+	addr := &syscall.SockaddrInet4{}
+	copy(addr.Addr[:], dst.To4())
+	return syscall.Sendto(s.injectFd, pkt, 0, addr)
+}
+
+func (s *rawSockets) capture(buf []byte) (int, error) {
+	n, _, err := syscall.Recvfrom(s.captureFd, buf, 0)
+	return n, err
+}
+
+func htons(v uint16) uint16 {
+	return v<<8 | v>>8
+}
@@ -0,0 +1,23 @@
+//go:build !linux
+// +build !linux
+
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gre_probe
+
+import "errors"
+
+func openProbeSockets() (probeSockets, error) {
+	return nil, errors.New("GRE data path probes are only supported on linux")
+}
@@ -23,8 +23,36 @@ var (
 		},
 		[]string{"ip_addr"},
 	)
+	GrePathProbes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gre_path_probes",
+			Help: "Data path probes sent through the GRE tunnel, by result",
+		},
+		[]string{"ip_addr", "result"},
+	)
+	GrePathLossRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gre_path_loss_ratio",
+			Help: "Ratio of lost data path probes over the probe window",
+		},
+		[]string{"ip_addr"},
+	)
+	GrePathLatencyMs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gre_path_latency_ms",
+			Help: "Average round trip time of the data path probes over the probe window",
+		},
+		[]string{"ip_addr"},
+	)
+	GrePathDegraded = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gre_path_degraded",
+			Help: "Data path through the GRE tunnel exceeds the loss or latency thresholds",
+		},
+		[]string{"ip_addr"},
+	)
 )
 
 func init() {
-	prometheus.MustRegister(GreEndpointReachable)
+	prometheus.MustRegister(GreEndpointReachable, GrePathProbes, GrePathLossRatio, GrePathLatencyMs, GrePathDegraded)
 }
@@ -138,9 +138,13 @@ func (s *GatewayHealthServicer) getGREHealth() *protos.HealthStatus {
 	// Current approach is to be conservative for GRE health. As long as we have
 	// a reachable peer, determine to be healthy
 	if len(probeStatus.Reachable) == 0 && len(probeStatus.Unreachable) > 0 {
+		msg := fmt.Sprintf("All GRE peers are detected as unreachable; unreachable: %v", probeStatus.Unreachable)
+		if len(probeStatus.Details) > 0 {
+			msg = fmt.Sprintf("%s; details: %v", msg, probeStatus.Details)
+		}
 		return &protos.HealthStatus{
 			Health:        protos.HealthStatus_UNHEALTHY,
-			HealthMessage: fmt.Sprintf("All GRE peers are detected as unreachable; unreachable: %v", probeStatus.Unreachable),
+			HealthMessage: msg,
 		}
 	}
 	return &protos.HealthStatus{
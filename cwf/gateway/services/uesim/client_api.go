@@ -24,6 +24,7 @@ import (
 
 	cwfprotos "magma/cwf/cloud/go/protos"
 	"magma/cwf/gateway/registry"
+	"magma/orc8r/lib/go/protos"
 
 	"github.com/golang/glog"
 	"google.golang.org/grpc"
@@ -114,3 +115,31 @@ func GenTraffic(req *cwfprotos.GenTrafficRequest) (*cwfprotos.GenTrafficResponse
 	return cli.GenTraffic(context.Background(), req)
 
 }
+
+// StartLoad starts a load scenario driving RADIUS authentication & accounting of the scenario UE populations.
+func StartLoad(scenario *cwfprotos.LoadScenario) error {
+	cli, err := getUESimClient()
+	if err != nil {
+		return err
+	}
+	_, err = cli.StartLoad(context.Background(), scenario)
+	return err
+}
+
+// GetLoadReport returns the report of the running or the last completed load scenario.
+func GetLoadReport() (*cwfprotos.LoadReport, error) {
+	cli, err := getUESimClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.GetLoadReport(context.Background(), &protos.Void{})
+}
+
+// StopLoad stops the running load scenario and returns its final report.
+func StopLoad() (*cwfprotos.LoadReport, error) {
+	cli, err := getUESimClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.StopLoad(context.Background(), &protos.Void{})
+}
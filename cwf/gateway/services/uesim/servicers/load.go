@@ -0,0 +1,561 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cwfprotos "magma/cwf/cloud/go/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+)
+
+const (
+	defaultLoadRequestTimeout = 3 * time.Second
+	// maxAuthRounds bounds the number of Access-Request/Access-Challenge round trips of a single authentication
+	maxAuthRounds = 8
+)
+
+// latencyBucketsMs are the upper bounds of the latency histogram buckets, in milliseconds
+var latencyBucketsMs = []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000}
+
+type requestOutcome int
+
+const (
+	outcomeAccept requestOutcome = iota
+	outcomeReject
+	outcomeTimeout
+	outcomeError
+)
+
+// requestStats accumulates outcomes & latencies of a single RADIUS transaction type
+type requestStats struct {
+	sync.Mutex
+	requestCounts
+}
+
+type requestCounts struct {
+	requests, accepts, rejects, timeouts, errors uint64
+	buckets                                      [13]uint64 // len(latencyBucketsMs) + overflow bucket
+	sumMs, maxMs                                 float64
+}
+
+func (s *requestStats) record(outcome requestOutcome, latency time.Duration) {
+	ms := float64(latency) / float64(time.Millisecond)
+	s.Lock()
+	defer s.Unlock()
+	s.requests++
+	switch outcome {
+	case outcomeAccept:
+		s.accepts++
+	case outcomeReject:
+		s.rejects++
+	case outcomeTimeout:
+		s.timeouts++
+		return
+	default:
+		s.errors++
+		return
+	}
+	// only answered transactions contribute to latency
+	i := 0
+	for i < len(latencyBucketsMs) && ms > latencyBucketsMs[i] {
+		i++
+	}
+	s.buckets[i]++
+	s.sumMs += ms
+	s.maxMs = math.Max(s.maxMs, ms)
+}
+
+func (s *requestStats) add(other *requestStats) {
+	other.Lock()
+	o := other.requestCounts
+	other.Unlock()
+	s.Lock()
+	defer s.Unlock()
+	s.requests += o.requests
+	s.accepts += o.accepts
+	s.rejects += o.rejects
+	s.timeouts += o.timeouts
+	s.errors += o.errors
+	for i := range s.buckets {
+		s.buckets[i] += o.buckets[i]
+	}
+	s.sumMs += o.sumMs
+	s.maxMs = math.Max(s.maxMs, o.maxMs)
+}
+
+func (s *requestStats) toProto() *cwfprotos.RequestStats {
+	s.Lock()
+	defer s.Unlock()
+	res := &cwfprotos.RequestStats{
+		Requests:     s.requests,
+		Accepts:      s.accepts,
+		Rejects:      s.rejects,
+		Timeouts:     s.timeouts,
+		Errors:       s.errors,
+		MaxLatencyMs: s.maxMs,
+	}
+	var answered uint64
+	for i, count := range s.buckets {
+		var upperBound float64
+		if i < len(latencyBucketsMs) {
+			upperBound = latencyBucketsMs[i]
+		}
+		res.LatencyHistogram = append(res.LatencyHistogram, &cwfprotos.LatencyBucket{UpperBoundMs: upperBound, Count: count})
+		answered += count
+	}
+	if answered == 0 {
+		return res
+	}
+	res.MeanLatencyMs = s.sumMs / float64(answered)
+	res.P50LatencyMs = s.percentile(0.5, answered)
+	res.P95LatencyMs = s.percentile(0.95, answered)
+	res.P99LatencyMs = s.percentile(0.99, answered)
+	return res
+}
+
+// percentile returns the upper bound of the bucket the percentile falls into, the max latency for the overflow bucket
+func (s *requestStats) percentile(p float64, total uint64) float64 {
+	rank := uint64(math.Ceil(p * float64(total)))
+	var cumulative uint64
+	for i, count := range s.buckets {
+		cumulative += count
+		if cumulative >= rank && i < len(latencyBucketsMs) {
+			return math.Min(latencyBucketsMs[i], s.maxMs)
+		}
+	}
+	return s.maxMs
+}
+
+// populationRun tracks the sessions of a single UE population
+type populationRun struct {
+	cfg  *cwfprotos.UEPopulation
+	idle chan *cwfprotos.UEConfig
+
+	started, completed, failed, dropped, active uint64
+	auth, acctStart, acctInterim, acctStop      requestStats
+}
+
+func (p *populationRun) toProto() *cwfprotos.PopulationReport {
+	return &cwfprotos.PopulationReport{
+		Name:              p.cfg.GetName(),
+		SessionsStarted:   atomic.LoadUint64(&p.started),
+		SessionsCompleted: atomic.LoadUint64(&p.completed),
+		SessionsFailed:    atomic.LoadUint64(&p.failed),
+		ArrivalsDropped:   atomic.LoadUint64(&p.dropped),
+		ActiveSessions:    atomic.LoadUint64(&p.active),
+		Auth:              p.auth.toProto(),
+		AcctStart:         p.acctStart.toProto(),
+		AcctInterim:       p.acctInterim.toProto(),
+		AcctStop:          p.acctStop.toProto(),
+	}
+}
+
+func (p *populationRun) add(other *populationRun) {
+	for _, c := range []struct{ dst, src *uint64 }{
+		{&p.started, &other.started}, {&p.completed, &other.completed}, {&p.failed, &other.failed},
+		{&p.dropped, &other.dropped}, {&p.active, &other.active},
+	} {
+		*c.dst += atomic.LoadUint64(c.src)
+	}
+	p.auth.add(&other.auth)
+	p.acctStart.add(&other.acctStart)
+	p.acctInterim.add(&other.acctInterim)
+	p.acctStop.add(&other.acctStop)
+}
+
+// loadRun drives a load scenario, see StartLoad
+type loadRun struct {
+	srv         *UESimServer
+	duration    time.Duration
+	timeout     time.Duration
+	sem         chan struct{} // limits concurrent RADIUS transactions, nil if unlimited
+	populations []*populationRun
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	started  time.Time
+	finished int64 // UnixNano of the scenario end, 0 while running
+	sessions sync.WaitGroup
+	done     chan struct{}
+}
+
+// StartLoad starts a load scenario: UE sessions arrive to every population at its arrival rate, each session
+// authenticates with EAP-AKA, sends Accounting Start, periodic Interim Updates & Accounting Stop at the end
+// of its duration. Every simulated UE uses its own Calling-Station-Id, derived from its IMSI, so its sessions
+// are distinct from other UEs' sessions on the RADIUS server.
+func (srv *UESimServer) StartLoad(ctx context.Context, scenario *cwfprotos.LoadScenario) (*protos.Void, error) {
+	if err := validateLoadScenario(scenario); err != nil {
+		return &protos.Void{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	srv.loadMu.Lock()
+	defer srv.loadMu.Unlock()
+	if srv.load != nil && srv.load.running() {
+		return &protos.Void{}, status.Error(codes.FailedPrecondition, "a load scenario is already running")
+	}
+
+	run := &loadRun{
+		srv:      srv,
+		duration: time.Duration(scenario.GetDurationSecs()) * time.Second,
+		timeout:  time.Duration(scenario.GetRequestTimeoutMs()) * time.Millisecond,
+		done:     make(chan struct{}),
+	}
+	if run.timeout == 0 {
+		run.timeout = defaultLoadRequestTimeout
+	}
+	if scenario.GetMaxConcurrentRequests() > 0 {
+		run.sem = make(chan struct{}, scenario.GetMaxConcurrentRequests())
+	}
+	for _, pop := range scenario.GetPopulations() {
+		ues, err := srv.populationUEs(pop)
+		if err != nil {
+			return &protos.Void{}, status.Errorf(codes.InvalidArgument, "population '%s': %v", pop.GetName(), err)
+		}
+		p := &populationRun{cfg: pop, idle: make(chan *cwfprotos.UEConfig, len(ues))}
+		for _, ue := range ues {
+			p.idle <- ue
+		}
+		run.populations = append(run.populations, p)
+	}
+	run.ctx, run.cancel = context.WithCancel(context.Background())
+	run.started = time.Now()
+	srv.load = run
+	go run.run()
+	glog.Infof("Started load scenario with %d populations for %s", len(run.populations), run.duration)
+	return &protos.Void{}, nil
+}
+
+// GetLoadReport returns the report of the running or the last completed load scenario
+func (srv *UESimServer) GetLoadReport(ctx context.Context, _ *protos.Void) (*cwfprotos.LoadReport, error) {
+	srv.loadMu.Lock()
+	run := srv.load
+	srv.loadMu.Unlock()
+	if run == nil {
+		return &cwfprotos.LoadReport{}, status.Error(codes.NotFound, "no load scenario was started")
+	}
+	return run.report(), nil
+}
+
+// StopLoad stops the running load scenario, waits for its active sessions to be stopped & returns its final report
+func (srv *UESimServer) StopLoad(ctx context.Context, _ *protos.Void) (*cwfprotos.LoadReport, error) {
+	srv.loadMu.Lock()
+	run := srv.load
+	srv.loadMu.Unlock()
+	if run == nil {
+		return &cwfprotos.LoadReport{}, status.Error(codes.NotFound, "no load scenario was started")
+	}
+	run.cancel()
+	select {
+	case <-run.done:
+	case <-ctx.Done():
+		return &cwfprotos.LoadReport{}, status.FromContextError(ctx.Err()).Err()
+	}
+	return run.report(), nil
+}
+
+func validateLoadScenario(scenario *cwfprotos.LoadScenario) error {
+	if scenario == nil {
+		return errors.New("nil load scenario")
+	}
+	if scenario.GetDurationSecs() == 0 {
+		return errors.New("scenario duration must be positive")
+	}
+	if len(scenario.GetPopulations()) == 0 {
+		return errors.New("scenario has no UE populations")
+	}
+	for _, pop := range scenario.GetPopulations() {
+		if pop.GetArrivalRate() <= 0 {
+			return fmt.Errorf("population '%s': arrival rate must be positive", pop.GetName())
+		}
+		if len(pop.GetImsis()) == 0 && pop.GetCount() == 0 {
+			return fmt.Errorf("population '%s' has no UEs", pop.GetName())
+		}
+		if pop.GetCount() > 0 && pop.GetTemplate() == nil {
+			return fmt.Errorf("population '%s': UE template is required with count", pop.GetName())
+		}
+	}
+	return nil
+}
+
+// populationUEs returns the UEs of the population, UEs generated from the population template are added to the store
+func (srv *UESimServer) populationUEs(pop *cwfprotos.UEPopulation) ([]*cwfprotos.UEConfig, error) {
+	var ues []*cwfprotos.UEConfig
+	for _, imsi := range pop.GetImsis() {
+		ue, err := getUE(srv.store, imsi)
+		if err != nil {
+			return nil, err
+		}
+		ues = append(ues, ue)
+	}
+	if pop.GetCount() == 0 {
+		return ues, nil
+	}
+	template := pop.GetTemplate()
+	if err := validateUEData(template); err != nil {
+		return nil, err
+	}
+	first, err := strconv.ParseUint(template.GetImsi(), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	width := len(template.GetImsi())
+	for i := uint64(0); i < uint64(pop.GetCount()); i++ {
+		ue := proto.Clone(template).(*cwfprotos.UEConfig)
+		ue.Imsi = fmt.Sprintf("%0*d", width, first+i)
+		if err = validateUEIMSI(ue.Imsi); err != nil {
+			return nil, err
+		}
+		addUeToStore(srv.store, ue)
+		ues = append(ues, ue)
+	}
+	return ues, nil
+}
+
+func (l *loadRun) running() bool {
+	return atomic.LoadInt64(&l.finished) == 0
+}
+
+func (l *loadRun) report() *cwfprotos.LoadReport {
+	end := time.Now()
+	if finished := atomic.LoadInt64(&l.finished); finished != 0 {
+		end = time.Unix(0, finished)
+	}
+	res := &cwfprotos.LoadReport{Running: l.running(), ElapsedSecs: end.Sub(l.started).Seconds()}
+	total := &populationRun{cfg: &cwfprotos.UEPopulation{Name: "total"}}
+	for _, p := range l.populations {
+		res.Populations = append(res.Populations, p.toProto())
+		total.add(p)
+	}
+	res.Total = total.toProto()
+	return res
+}
+
+func (l *loadRun) run() {
+	timer := time.NewTimer(l.duration)
+	defer timer.Stop()
+	var arrivals sync.WaitGroup
+	for _, p := range l.populations {
+		arrivals.Add(1)
+		go func(p *populationRun) {
+			defer arrivals.Done()
+			l.arrivals(p)
+		}(p)
+	}
+	select {
+	case <-timer.C:
+		l.cancel()
+	case <-l.ctx.Done():
+	}
+	arrivals.Wait()
+	l.sessions.Wait()
+	atomic.StoreInt64(&l.finished, time.Now().UnixNano())
+	close(l.done)
+	glog.Infof("Load scenario completed after %s", time.Since(l.started))
+}
+
+// arrivals starts the population sessions with exponentially distributed inter-arrival times until the run ends
+func (l *loadRun) arrivals(p *populationRun) {
+	for {
+		wait := time.Duration(rand.ExpFloat64() / p.cfg.GetArrivalRate() * float64(time.Second))
+		select {
+		case <-l.ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+		select {
+		case ue := <-p.idle:
+			l.sessions.Add(1)
+			go func() {
+				defer l.sessions.Done()
+				l.session(p, ue)
+				p.idle <- ue
+			}()
+		default:
+			atomic.AddUint64(&p.dropped, 1)
+		}
+	}
+}
+
+func (l *loadRun) session(p *populationRun, ue *cwfprotos.UEConfig) {
+	atomic.AddUint64(&p.started, 1)
+	atomic.AddUint64(&p.active, 1)
+	defer atomic.AddUint64(&p.active, ^uint64(0))
+
+	mac := ueMAC(ue.GetImsi())
+	if !l.authenticate(p, ue, mac) {
+		atomic.AddUint64(&p.failed, 1)
+		return
+	}
+	acctSessionID := fmt.Sprintf("%s-%08X", ue.GetImsi(), rand.Uint32())
+	sessionStart := time.Now()
+	l.account(p, &p.acctStart, rfc2866.AcctStatusType_Value_Start, ue, mac, acctSessionID, sessionStart)
+
+	end := time.NewTimer(sessionDuration(p.cfg))
+	defer end.Stop()
+	var interim <-chan time.Time
+	if p.cfg.GetInterimIntervalSecs() > 0 {
+		ticker := time.NewTicker(time.Duration(p.cfg.GetInterimIntervalSecs()) * time.Second)
+		defer ticker.Stop()
+		interim = ticker.C
+	}
+	for active := true; active; {
+		select {
+		case <-interim:
+			l.account(p, &p.acctInterim, rfc2866.AcctStatusType_Value_InterimUpdate, ue, mac, acctSessionID, sessionStart)
+		case <-end.C:
+			active = false
+		case <-l.ctx.Done():
+			active = false
+		}
+	}
+	l.account(p, &p.acctStop, rfc2866.AcctStatusType_Value_Stop, ue, mac, acctSessionID, sessionStart)
+	atomic.AddUint64(&p.completed, 1)
+}
+
+// authenticate runs a full EAP authentication of the UE & returns true if the UE was accepted
+func (l *loadRun) authenticate(p *populationRun, ue *cwfprotos.UEConfig, mac string) bool {
+	calledStationID := p.cfg.GetCalledStationId()
+	eapRes, err := l.srv.HandleEap(ue, eap.Packet(EapIdentityRequestPacket))
+	if err != nil {
+		glog.Errorf("Error creating EAP Identity Response for IMSI %s: %v", ue.GetImsi(), err)
+		p.auth.record(outcomeError, 0)
+		return false
+	}
+	req, err := l.srv.eapToRadius(eapRes, ue.GetImsi(), mac, calledStationID, 0)
+	if err != nil {
+		p.auth.record(outcomeError, 0)
+		return false
+	}
+	var latency time.Duration
+	for round := 0; round < maxAuthRounds; round++ {
+		res, rtt, err := l.exchange(req, l.srv.cfg.radiusAuthAddress)
+		latency += rtt
+		if err != nil {
+			p.auth.record(exchangeErrorOutcome(err), latency)
+			return false
+		}
+		switch res.Code {
+		case radius.CodeAccessAccept:
+			p.auth.record(outcomeAccept, latency)
+			return true
+		case radius.CodeAccessReject:
+			p.auth.record(outcomeReject, latency)
+			return false
+		case radius.CodeAccessChallenge:
+			eapBytes, err := eapFromRadius(res)
+			if err == nil {
+				req, err = l.srv.handleEapFromRadius(ue, mac, calledStationID, res.Identifier+1, eapBytes)
+			}
+			if err != nil {
+				glog.Errorf("Error handling Access-Challenge for IMSI %s: %v", ue.GetImsi(), err)
+				p.auth.record(outcomeError, latency)
+				return false
+			}
+		default:
+			p.auth.record(outcomeError, latency)
+			return false
+		}
+	}
+	p.auth.record(outcomeError, latency)
+	return false
+}
+
+func (l *loadRun) account(
+	p *populationRun,
+	stats *requestStats,
+	statusType rfc2866.AcctStatusType,
+	ue *cwfprotos.UEConfig,
+	mac, acctSessionID string,
+	sessionStart time.Time,
+) {
+	req, err := l.srv.makeAccountingRequest(statusType, mac, p.cfg.GetCalledStationId())
+	if err == nil {
+		err = rfc2866.AcctSessionID_SetString(req, acctSessionID)
+	}
+	if err == nil {
+		err = rfc2865.UserName_SetString(req, ue.GetImsi()+IdentityPostfix)
+	}
+	if err == nil && statusType != rfc2866.AcctStatusType_Value_Start {
+		err = rfc2866.AcctSessionTime_Set(req, rfc2866.AcctSessionTime(time.Since(sessionStart)/time.Second))
+	}
+	if err != nil {
+		stats.record(outcomeError, 0)
+		return
+	}
+	res, latency, err := l.exchange(req, l.srv.cfg.radiusAcctAddress)
+	switch {
+	case err != nil:
+		stats.record(exchangeErrorOutcome(err), latency)
+	case res.Code == radius.CodeAccountingResponse:
+		stats.record(outcomeAccept, latency)
+	default:
+		stats.record(outcomeError, latency)
+	}
+}
+
+// exchange sends the packet & waits for its response, it returns the transaction latency excluding time spent
+// waiting for the concurrency limit
+func (l *loadRun) exchange(p *radius.Packet, addr string) (*radius.Packet, time.Duration, error) {
+	if l.sem != nil {
+		l.sem <- struct{}{}
+		defer func() { <-l.sem }()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+	defer cancel()
+	start := time.Now()
+	res, err := radius.Exchange(ctx, p, addr)
+	return res, time.Since(start), err
+}
+
+func exchangeErrorOutcome(err error) requestOutcome {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return outcomeTimeout
+	}
+	return outcomeError
+}
+
+func sessionDuration(pop *cwfprotos.UEPopulation) time.Duration {
+	d := float64(pop.GetSessionDurationSecs())
+	if jitter := float64(pop.GetSessionDurationJitterSecs()); jitter > 0 {
+		d += (rand.Float64()*2 - 1) * jitter
+	}
+	return time.Duration(math.Max(d, 0) * float64(time.Second))
+}
+
+// ueMAC returns a locally administered MAC address derived from the IMSI
+func ueMAC(imsi string) string {
+	h := fnv.New64a()
+	h.Write([]byte(imsi))
+	sum := h.Sum64()
+	return fmt.Sprintf("02-%02X-%02X-%02X-%02X-%02X",
+		byte(sum>>32), byte(sum>>24), byte(sum>>16), byte(sum>>8), byte(sum))
+}
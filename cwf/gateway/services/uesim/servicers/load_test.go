@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	cwfprotos "magma/cwf/cloud/go/protos"
+	"magma/orc8r/cloud/go/test_utils"
+	"magma/orc8r/lib/go/protos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+const (
+	rejectedIMSI      = "001010000000200"
+	unansweredStation = "98-DE-D0-84-B5-47:NO-ACCT"
+)
+
+func TestLoadScenario(t *testing.T) {
+	srv, err := NewUESimServer(test_utils.NewSQLBlobstore(t, "uesim_load_test_blobstore"))
+	require.NoError(t, err)
+	srv.cfg.radiusAuthAddress = startRadiusServer(t, func(w radius.ResponseWriter, r *radius.Request) {
+		user, _ := rfc2865.UserName_LookupString(r.Packet)
+		if user == rejectedIMSI+IdentityPostfix {
+			w.Write(r.Response(radius.CodeAccessReject))
+			return
+		}
+		w.Write(r.Response(radius.CodeAccessAccept))
+	})
+	srv.cfg.radiusAcctAddress = startRadiusServer(t, func(w radius.ResponseWriter, r *radius.Request) {
+		if called, _ := rfc2865.CalledStationID_LookupString(r.Packet); called != unansweredStation {
+			w.Write(r.Response(radius.CodeAccountingResponse))
+		}
+	})
+	ueKey := make([]byte, 16)
+	_, err = srv.AddUE(context.Background(), &cwfprotos.UEConfig{Imsi: rejectedIMSI, AuthKey: ueKey, AuthOpc: ueKey})
+	require.NoError(t, err)
+
+	_, err = srv.GetLoadReport(context.Background(), &protos.Void{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = srv.StartLoad(context.Background(), &cwfprotos.LoadScenario{DurationSecs: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	scenario := &cwfprotos.LoadScenario{
+		DurationSecs:     2,
+		RequestTimeoutMs: 200,
+		Populations: []*cwfprotos.UEPopulation{
+			{
+				Name:                "accepted",
+				Template:            &cwfprotos.UEConfig{Imsi: "001010000000100", AuthKey: ueKey, AuthOpc: ueKey},
+				Count:               3,
+				ArrivalRate:         20,
+				SessionDurationSecs: 10,
+				InterimIntervalSecs: 1,
+				CalledStationId:     "98-DE-D0-84-B5-47:CWF",
+			},
+			{
+				Name:        "rejected",
+				Imsis:       []string{rejectedIMSI},
+				ArrivalRate: 10,
+			},
+			{
+				Name:            "unanswered",
+				Template:        &cwfprotos.UEConfig{Imsi: "001010000000300", AuthKey: ueKey, AuthOpc: ueKey},
+				Count:           1,
+				ArrivalRate:     10,
+				CalledStationId: unansweredStation,
+			},
+		},
+	}
+	_, err = srv.StartLoad(context.Background(), scenario)
+	require.NoError(t, err)
+	_, err = srv.StartLoad(context.Background(), scenario)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	report, err := srv.GetLoadReport(context.Background(), &protos.Void{})
+	require.NoError(t, err)
+	assert.True(t, report.Running)
+
+	require.Eventually(t, func() bool {
+		report, err = srv.GetLoadReport(context.Background(), &protos.Void{})
+		return err == nil && !report.Running
+	}, 10*time.Second, 50*time.Millisecond)
+	require.Len(t, report.Populations, 3)
+
+	accepted := report.Populations[0]
+	assert.Equal(t, uint64(3), accepted.SessionsStarted)
+	assert.Equal(t, uint64(3), accepted.SessionsCompleted)
+	assert.Equal(t, uint64(0), accepted.ActiveSessions)
+	assert.NotZero(t, accepted.ArrivalsDropped)
+	assert.Equal(t, uint64(3), accepted.Auth.Accepts)
+	assert.Equal(t, uint64(3), accepted.AcctStart.Accepts)
+	assert.Equal(t, uint64(3), accepted.AcctStop.Accepts)
+	assert.NotZero(t, accepted.AcctInterim.Accepts)
+	assert.Len(t, accepted.Auth.LatencyHistogram, len(latencyBucketsMs)+1)
+	assert.NotZero(t, accepted.Auth.P99LatencyMs)
+
+	rejected := report.Populations[1]
+	assert.NotZero(t, rejected.SessionsStarted)
+	assert.Equal(t, rejected.SessionsStarted, rejected.SessionsFailed)
+	assert.Equal(t, rejected.SessionsStarted, rejected.Auth.Rejects)
+	assert.Zero(t, rejected.AcctStart.Requests)
+
+	unanswered := report.Populations[2]
+	assert.NotZero(t, unanswered.AcctStart.Timeouts)
+	assert.Equal(t, unanswered.AcctStart.Requests, unanswered.AcctStart.Timeouts)
+
+	assert.Equal(t, accepted.Auth.Requests+rejected.Auth.Requests+unanswered.Auth.Requests, report.Total.Auth.Requests)
+
+	// A stopped scenario keeps its report
+	final, err := srv.StopLoad(context.Background(), &protos.Void{})
+	require.NoError(t, err)
+	assert.Equal(t, report.ElapsedSecs, final.ElapsedSecs)
+}
+
+func TestRequestStats(t *testing.T) {
+	s := &requestStats{}
+	for i := 0; i < 98; i++ {
+		s.record(outcomeAccept, 3*time.Millisecond)
+	}
+	s.record(outcomeReject, 150*time.Millisecond)
+	s.record(outcomeAccept, 7*time.Second)
+	s.record(outcomeTimeout, time.Second)
+	s.record(outcomeError, 0)
+
+	res := s.toProto()
+	assert.Equal(t, uint64(102), res.Requests)
+	assert.Equal(t, uint64(99), res.Accepts)
+	assert.Equal(t, uint64(1), res.Rejects)
+	assert.Equal(t, uint64(1), res.Timeouts)
+	assert.Equal(t, uint64(1), res.Errors)
+	assert.Equal(t, 5.0, res.P50LatencyMs)
+	assert.Equal(t, 5.0, res.P95LatencyMs)
+	assert.Equal(t, 200.0, res.P99LatencyMs)
+	assert.Equal(t, 7000.0, res.MaxLatencyMs)
+	assert.Equal(t, uint64(1), res.LatencyHistogram[len(latencyBucketsMs)].Count)
+}
+
+func startRadiusServer(t *testing.T, handler radius.HandlerFunc) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &radius.PacketServer{
+		Handler:      handler,
+		SecretSource: radius.StaticSecretSource([]byte(defaultRadiusSecret)),
+	}
+	go server.Serve(conn)
+	t.Cleanup(func() { server.Shutdown(context.Background()) })
+	return conn.LocalAddr().String()
+}
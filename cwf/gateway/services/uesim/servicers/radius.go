@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"fbc/cwf/radius/modules/eap/packet"
+	cwfprotos "magma/cwf/cloud/go/protos"
 	"magma/feg/gateway/services/eap"
 
 	"layeh.com/radius"
@@ -39,14 +40,8 @@ func (srv *UESimServer) HandleRadius(imsi string, calledStationID string, p *rad
 	// todo Validate the packet. (Requires keeping state)
 
 	// Extract EAP packet.
-	eapMessage, err := packet.NewPacketFromRadius(p)
-	if err != nil {
-		err = fmt.Errorf("Error extracting EAP message from Radius packet: %w", err)
-		return nil, err
-	}
-	eapBytes, err := eapMessage.Bytes()
+	eapBytes, err := eapFromRadius(p)
 	if err != nil {
-		err = fmt.Errorf("Error converting EAP packet to bytes: %w", err)
 		return nil, err
 	}
 
@@ -55,7 +50,13 @@ func (srv *UESimServer) HandleRadius(imsi string, calledStationID string, p *rad
 	if err != nil {
 		return nil, err
 	}
+	return srv.handleEapFromRadius(ue, srv.cfg.brMac, calledStationID, p.Identifier+1, eapBytes)
+}
 
+// handleEapFromRadius generates the UE's EAP response & wraps it in a Radius packet.
+func (srv *UESimServer) handleEapFromRadius(
+	ue *cwfprotos.UEConfig, callingStationID, calledStationID string, identifier uint8, eapBytes []byte,
+) (*radius.Packet, error) {
 	// Generate EAP response.
 	eapRes, err := srv.HandleEap(ue, eapBytes)
 	if err != nil {
@@ -63,16 +64,30 @@ func (srv *UESimServer) HandleRadius(imsi string, calledStationID string, p *rad
 	}
 
 	// Wrap EAP response in Radius packet.
-	res, err := srv.EapToRadius(eapRes, imsi, calledStationID, p.Identifier+1)
+	return srv.eapToRadius(eapRes, ue.GetImsi(), callingStationID, calledStationID, identifier)
+}
+
+// eapFromRadius extracts the EAP message from a Radius packet.
+func eapFromRadius(p *radius.Packet) ([]byte, error) {
+	eapMessage, err := packet.NewPacketFromRadius(p)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("Error extracting EAP message from Radius packet: %w", err)
 	}
-
-	return res, err
+	eapBytes, err := eapMessage.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("Error converting EAP packet to bytes: %w", err)
+	}
+	return eapBytes, nil
 }
 
 // EapToRadius puts an Eap packet payload in a Radius packet.
 func (srv *UESimServer) EapToRadius(eapP eap.Packet, imsi string, calledStationID string, identifier uint8) (*radius.Packet, error) {
+	return srv.eapToRadius(eapP, imsi, srv.cfg.brMac, calledStationID, identifier)
+}
+
+func (srv *UESimServer) eapToRadius(
+	eapP eap.Packet, imsi, callingStationID, calledStationID string, identifier uint8,
+) (*radius.Packet, error) {
 	radiusP := radius.New(radius.CodeAccessRequest, []byte(srv.cfg.radiusSecret))
 	radiusP.Identifier = identifier
 
@@ -82,8 +97,7 @@ func (srv *UESimServer) EapToRadius(eapP eap.Packet, imsi string, calledStationI
 		rfc2865.UserName_Type,
 		radius.Attribute(imsi+IdentityPostfix),
 	)
-	// TODO: Fetch UE MAC addr and use as CallingStationID
-	err := rfc2865.CallingStationID_SetString(radiusP, srv.cfg.brMac)
+	err := rfc2865.CallingStationID_SetString(radiusP, callingStationID)
 	if err != nil {
 		return nil, err
 	}
@@ -115,12 +129,19 @@ func (srv *UESimServer) EapToRadius(eapP eap.Packet, imsi string, calledStationI
 
 // MakeAccountStopRequest creates an Accounting Stop radius packet
 func (srv *UESimServer) MakeAccountingStopRequest(calledStationID string) (*radius.Packet, error) {
+	return srv.makeAccountingRequest(rfc2866.AcctStatusType_Value_Stop, srv.cfg.brMac, calledStationID)
+}
+
+// makeAccountingRequest creates an Accounting radius packet of the given status type
+func (srv *UESimServer) makeAccountingRequest(
+	statusType rfc2866.AcctStatusType, callingStationID, calledStationID string,
+) (*radius.Packet, error) {
 	radiusP := radius.New(radius.CodeAccountingRequest, []byte(srv.cfg.radiusSecret))
-	err := rfc2866.AcctStatusType_Set(radiusP, rfc2866.AcctStatusType_Value_Stop)
+	err := rfc2866.AcctStatusType_Set(radiusP, statusType)
 	if err != nil {
 		return nil, err
 	}
-	err = rfc2865.CallingStationID_SetString(radiusP, srv.cfg.brMac)
+	err = rfc2865.CallingStationID_SetString(radiusP, callingStationID)
 	if err != nil {
 		return nil, err
 	}
@@ -21,6 +21,7 @@ import (
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	cwfprotos "magma/cwf/cloud/go/protos"
@@ -49,6 +50,9 @@ const (
 type UESimServer struct {
 	store blobstore.StoreFactory
 	cfg   *UESimConfig
+
+	loadMu sync.Mutex
+	load   *loadRun
 }
 
 type UESimConfig struct {
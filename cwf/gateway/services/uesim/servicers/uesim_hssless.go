@@ -25,6 +25,8 @@ import (
 	"context"
 
 	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -138,6 +140,18 @@ func (srv *UESimServerHssLess) GenTraffic(ctx context.Context, req *cwfprotos.Ge
 	return nil, nil
 }
 
+func (srv *UESimServerHssLess) StartLoad(ctx context.Context, scenario *cwfprotos.LoadScenario) (*protos.Void, error) {
+	return &protos.Void{}, status.Error(codes.Unimplemented, "load scenarios require HSS authentication")
+}
+
+func (srv *UESimServerHssLess) GetLoadReport(ctx context.Context, _ *protos.Void) (*cwfprotos.LoadReport, error) {
+	return &cwfprotos.LoadReport{}, status.Error(codes.Unimplemented, "load scenarios require HSS authentication")
+}
+
+func (srv *UESimServerHssLess) StopLoad(ctx context.Context, _ *protos.Void) (*cwfprotos.LoadReport, error) {
+	return &cwfprotos.LoadReport{}, status.Error(codes.Unimplemented, "load scenarios require HSS authentication")
+}
+
 func makeSubscriberId(imsi string) *lte_protos.SubscriberID {
 	if !strings.HasPrefix(imsi, IMSI_PREFIX) {
 		imsi = IMSI_PREFIX + imsi
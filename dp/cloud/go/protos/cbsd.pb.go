@@ -933,17 +933,18 @@ type CbsdData struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId                    string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FccId                     string                `protobuf:"bytes,2,opt,name=fcc_id,json=fccId,proto3" json:"fcc_id,omitempty"`
-	SerialNumber              string                `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
-	CbsdCategory              string                `protobuf:"bytes,4,opt,name=cbsd_category,json=cbsdCategory,proto3" json:"cbsd_category,omitempty"`
-	SingleStepEnabled         bool                  `protobuf:"varint,5,opt,name=single_step_enabled,json=singleStepEnabled,proto3" json:"single_step_enabled,omitempty"`
-	DesiredState              string                `protobuf:"bytes,6,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`
-	CarrierAggregationEnabled bool                  `protobuf:"varint,7,opt,name=carrier_aggregation_enabled,json=carrierAggregationEnabled,proto3" json:"carrier_aggregation_enabled,omitempty"`
-	GrantRedundancy           bool                  `protobuf:"varint,8,opt,name=grant_redundancy,json=grantRedundancy,proto3" json:"grant_redundancy,omitempty"`
-	Capabilities              *Capabilities         `protobuf:"bytes,9,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
-	Preferences               *FrequencyPreferences `protobuf:"bytes,10,opt,name=preferences,proto3" json:"preferences,omitempty"`
-	InstallationParam         *InstallationParam    `protobuf:"bytes,11,opt,name=installation_param,json=installationParam,proto3" json:"installation_param,omitempty"`
+	UserId                    string                    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FccId                     string                    `protobuf:"bytes,2,opt,name=fcc_id,json=fccId,proto3" json:"fcc_id,omitempty"`
+	SerialNumber              string                    `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	CbsdCategory              string                    `protobuf:"bytes,4,opt,name=cbsd_category,json=cbsdCategory,proto3" json:"cbsd_category,omitempty"`
+	SingleStepEnabled         bool                      `protobuf:"varint,5,opt,name=single_step_enabled,json=singleStepEnabled,proto3" json:"single_step_enabled,omitempty"`
+	DesiredState              string                    `protobuf:"bytes,6,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`
+	CarrierAggregationEnabled bool                      `protobuf:"varint,7,opt,name=carrier_aggregation_enabled,json=carrierAggregationEnabled,proto3" json:"carrier_aggregation_enabled,omitempty"`
+	GrantRedundancy           bool                      `protobuf:"varint,8,opt,name=grant_redundancy,json=grantRedundancy,proto3" json:"grant_redundancy,omitempty"`
+	Capabilities              *Capabilities             `protobuf:"bytes,9,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Preferences               *FrequencyPreferences     `protobuf:"bytes,10,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	InstallationParam         *InstallationParam        `protobuf:"bytes,11,opt,name=installation_param,json=installationParam,proto3" json:"installation_param,omitempty"`
+	CarrierAggregationParams  *CarrierAggregationParams `protobuf:"bytes,12,opt,name=carrier_aggregation_params,json=carrierAggregationParams,proto3" json:"carrier_aggregation_params,omitempty"`
 }
 
 func (x *CbsdData) Reset() {
@@ -1055,6 +1056,84 @@ func (x *CbsdData) GetInstallationParam() *InstallationParam {
 	return nil
 }
 
+func (x *CbsdData) GetCarrierAggregationParams() *CarrierAggregationParams {
+	if x != nil {
+		return x.CarrierAggregationParams
+	}
+	return nil
+}
+
+type CarrierAggregationParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxCarriers          int64 `protobuf:"varint,1,opt,name=max_carriers,json=maxCarriers,proto3" json:"max_carriers,omitempty"`
+	MinTotalBandwidthMhz int64 `protobuf:"varint,2,opt,name=min_total_bandwidth_mhz,json=minTotalBandwidthMhz,proto3" json:"min_total_bandwidth_mhz,omitempty"`
+	MaxTotalBandwidthMhz int64 `protobuf:"varint,3,opt,name=max_total_bandwidth_mhz,json=maxTotalBandwidthMhz,proto3" json:"max_total_bandwidth_mhz,omitempty"`
+	Contiguous           bool  `protobuf:"varint,4,opt,name=contiguous,proto3" json:"contiguous,omitempty"`
+}
+
+func (x *CarrierAggregationParams) Reset() {
+	*x = CarrierAggregationParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarrierAggregationParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarrierAggregationParams) ProtoMessage() {}
+
+func (x *CarrierAggregationParams) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarrierAggregationParams.ProtoReflect.Descriptor instead.
+func (*CarrierAggregationParams) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CarrierAggregationParams) GetMaxCarriers() int64 {
+	if x != nil {
+		return x.MaxCarriers
+	}
+	return 0
+}
+
+func (x *CarrierAggregationParams) GetMinTotalBandwidthMhz() int64 {
+	if x != nil {
+		return x.MinTotalBandwidthMhz
+	}
+	return 0
+}
+
+func (x *CarrierAggregationParams) GetMaxTotalBandwidthMhz() int64 {
+	if x != nil {
+		return x.MaxTotalBandwidthMhz
+	}
+	return 0
+}
+
+func (x *CarrierAggregationParams) GetContiguous() bool {
+	if x != nil {
+		return x.Contiguous
+	}
+	return false
+}
+
 type InstallationParam struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1071,7 +1150,7 @@ type InstallationParam struct {
 func (x *InstallationParam) Reset() {
 	*x = InstallationParam{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dp_protos_cbsd_proto_msgTypes[18]
+		mi := &file_dp_protos_cbsd_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1084,7 +1163,7 @@ func (x *InstallationParam) String() string {
 func (*InstallationParam) ProtoMessage() {}
 
 func (x *InstallationParam) ProtoReflect() protoreflect.Message {
-	mi := &file_dp_protos_cbsd_proto_msgTypes[18]
+	mi := &file_dp_protos_cbsd_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,7 +1176,7 @@ func (x *InstallationParam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallationParam.ProtoReflect.Descriptor instead.
 func (*InstallationParam) Descriptor() ([]byte, []int) {
-	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{18}
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *InstallationParam) GetLatitudeDeg() *wrappers.DoubleValue {
@@ -1156,7 +1235,7 @@ type Capabilities struct {
 func (x *Capabilities) Reset() {
 	*x = Capabilities{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dp_protos_cbsd_proto_msgTypes[19]
+		mi := &file_dp_protos_cbsd_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1169,7 +1248,7 @@ func (x *Capabilities) String() string {
 func (*Capabilities) ProtoMessage() {}
 
 func (x *Capabilities) ProtoReflect() protoreflect.Message {
-	mi := &file_dp_protos_cbsd_proto_msgTypes[19]
+	mi := &file_dp_protos_cbsd_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1182,7 +1261,7 @@ func (x *Capabilities) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
 func (*Capabilities) Descriptor() ([]byte, []int) {
-	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{19}
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Capabilities) GetMinPower() float64 {
@@ -1225,7 +1304,7 @@ type FrequencyPreferences struct {
 func (x *FrequencyPreferences) Reset() {
 	*x = FrequencyPreferences{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dp_protos_cbsd_proto_msgTypes[20]
+		mi := &file_dp_protos_cbsd_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1238,7 +1317,7 @@ func (x *FrequencyPreferences) String() string {
 func (*FrequencyPreferences) ProtoMessage() {}
 
 func (x *FrequencyPreferences) ProtoReflect() protoreflect.Message {
-	mi := &file_dp_protos_cbsd_proto_msgTypes[20]
+	mi := &file_dp_protos_cbsd_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +1330,7 @@ func (x *FrequencyPreferences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FrequencyPreferences.ProtoReflect.Descriptor instead.
 func (*FrequencyPreferences) Descriptor() ([]byte, []int) {
-	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{20}
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *FrequencyPreferences) GetBandwidthMhz() int64 {
@@ -1273,18 +1352,21 @@ type CbsdDetails struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Data     *CbsdData       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	CbsdId   string          `protobuf:"bytes,3,opt,name=cbsd_id,json=cbsdId,proto3" json:"cbsd_id,omitempty"`
-	State    string          `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	IsActive bool            `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Grants   []*GrantDetails `protobuf:"bytes,6,rep,name=grants,proto3" json:"grants,omitempty"`
+	Id           int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data         *CbsdData       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	CbsdId       string          `protobuf:"bytes,3,opt,name=cbsd_id,json=cbsdId,proto3" json:"cbsd_id,omitempty"`
+	State        string          `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	IsActive     bool            `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Grants       []*GrantDetails `protobuf:"bytes,6,rep,name=grants,proto3" json:"grants,omitempty"`
+	CpiSignature *CpiSignature   `protobuf:"bytes,7,opt,name=cpi_signature,json=cpiSignature,proto3" json:"cpi_signature,omitempty"`
+	// frequency preferences computed by network channel planner
+	PlannedFrequenciesMhz []int64 `protobuf:"varint,8,rep,packed,name=planned_frequencies_mhz,json=plannedFrequenciesMhz,proto3" json:"planned_frequencies_mhz,omitempty"`
 }
 
 func (x *CbsdDetails) Reset() {
 	*x = CbsdDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dp_protos_cbsd_proto_msgTypes[21]
+		mi := &file_dp_protos_cbsd_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1297,7 +1379,7 @@ func (x *CbsdDetails) String() string {
 func (*CbsdDetails) ProtoMessage() {}
 
 func (x *CbsdDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_dp_protos_cbsd_proto_msgTypes[21]
+	mi := &file_dp_protos_cbsd_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1310,7 +1392,7 @@ func (x *CbsdDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CbsdDetails.ProtoReflect.Descriptor instead.
 func (*CbsdDetails) Descriptor() ([]byte, []int) {
-	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{21}
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CbsdDetails) GetId() int64 {
@@ -1355,6 +1437,75 @@ func (x *CbsdDetails) GetGrants() []*GrantDetails {
 	return nil
 }
 
+func (x *CbsdDetails) GetCpiSignature() *CpiSignature {
+	if x != nil {
+		return x.CpiSignature
+	}
+	return nil
+}
+
+func (x *CbsdDetails) GetPlannedFrequenciesMhz() []int64 {
+	if x != nil {
+		return x.PlannedFrequenciesMhz
+	}
+	return nil
+}
+
+type CpiSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpiId                         string `protobuf:"bytes,1,opt,name=cpi_id,json=cpiId,proto3" json:"cpi_id,omitempty"`
+	InstallCertificationTimestamp int64  `protobuf:"varint,2,opt,name=install_certification_timestamp,json=installCertificationTimestamp,proto3" json:"install_certification_timestamp,omitempty"`
+}
+
+func (x *CpiSignature) Reset() {
+	*x = CpiSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpiSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpiSignature) ProtoMessage() {}
+
+func (x *CpiSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpiSignature.ProtoReflect.Descriptor instead.
+func (*CpiSignature) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CpiSignature) GetCpiId() string {
+	if x != nil {
+		return x.CpiId
+	}
+	return ""
+}
+
+func (x *CpiSignature) GetInstallCertificationTimestamp() int64 {
+	if x != nil {
+		return x.InstallCertificationTimestamp
+	}
+	return 0
+}
+
 type GrantDetails struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1371,7 +1522,7 @@ type GrantDetails struct {
 func (x *GrantDetails) Reset() {
 	*x = GrantDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dp_protos_cbsd_proto_msgTypes[22]
+		mi := &file_dp_protos_cbsd_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1384,7 +1535,7 @@ func (x *GrantDetails) String() string {
 func (*GrantDetails) ProtoMessage() {}
 
 func (x *GrantDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_dp_protos_cbsd_proto_msgTypes[22]
+	mi := &file_dp_protos_cbsd_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,7 +1548,7 @@ func (x *GrantDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrantDetails.ProtoReflect.Descriptor instead.
 func (*GrantDetails) Descriptor() ([]byte, []int) {
-	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{22}
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GrantDetails) GetBandwidthMhz() int64 {
@@ -1454,7 +1605,7 @@ type Pagination struct {
 func (x *Pagination) Reset() {
 	*x = Pagination{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dp_protos_cbsd_proto_msgTypes[23]
+		mi := &file_dp_protos_cbsd_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1467,7 +1618,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_dp_protos_cbsd_proto_msgTypes[23]
+	mi := &file_dp_protos_cbsd_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1480,7 +1631,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{23}
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Pagination) GetLimit() *wrappers.Int64Value {
@@ -1508,7 +1659,7 @@ type CbsdFilter struct {
 func (x *CbsdFilter) Reset() {
 	*x = CbsdFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dp_protos_cbsd_proto_msgTypes[24]
+		mi := &file_dp_protos_cbsd_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1521,7 +1672,7 @@ func (x *CbsdFilter) String() string {
 func (*CbsdFilter) ProtoMessage() {}
 
 func (x *CbsdFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_dp_protos_cbsd_proto_msgTypes[24]
+	mi := &file_dp_protos_cbsd_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,7 +1685,7 @@ func (x *CbsdFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CbsdFilter.ProtoReflect.Descriptor instead.
 func (*CbsdFilter) Descriptor() ([]byte, []int) {
-	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{24}
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CbsdFilter) GetSerialNumber() string {
@@ -1544,118 +1695,760 @@ func (x *CbsdFilter) GetSerialNumber() string {
 	return ""
 }
 
-var File_dp_protos_cbsd_proto protoreflect.FileDescriptor
+type CreateCpiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_dp_protos_cbsd_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x64, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x62, 0x73, 0x64,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70,
-	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xd8, 0x01, 0x0a, 0x0f, 0x43, 0x42, 0x53, 0x44, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64,
-	0x70, 0x2e, 0x4c, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x5f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72,
-	0x61, 0x64, 0x69, 0x6f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x1b, 0x63,
-	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x19, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x4c, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x8b, 0x01, 0x0a, 0x0a,
-	0x4c, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f,
-	0x77, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x6f, 0x77, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x79, 0x48, 0x7a, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x66, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0f, 0x68, 0x69, 0x67, 0x68, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a,
-	0x12, 0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x69, 0x72, 0x70, 0x5f, 0x64, 0x62, 0x6d,
-	0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x45,
-	0x69, 0x72, 0x70, 0x44, 0x62, 0x6d, 0x4d, 0x68, 0x7a, 0x22, 0x5a, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x62, 0x73, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x62, 0x73, 0x64, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x45, 0x6e, 0x6f, 0x64,
-	0x65, 0x62, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x12, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70,
-	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x62, 0x73, 0x64, 0x5f, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x62,
-	0x73, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x42, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x62,
-	0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x10, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a,
-	0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43,
-	0x62, 0x73, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x62, 0x73, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x06,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x62, 0x73, 0x64, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
-	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x62, 0x73, 0x64, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x46, 0x0a, 0x15, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x62,
-	0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x46, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x73, 0x68,
-	0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65,
-	0x6c, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x73, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x04, 0x0a, 0x08, 0x43, 0x62, 0x73, 0x64, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x63,
-	0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x63, 0x63, 0x49,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x62, 0x73, 0x64, 0x5f, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
-	0x62, 0x73, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x73,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64,
-	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	NetworkId string   `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Data      *CpiData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CreateCpiRequest) Reset() {
+	*x = CreateCpiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCpiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCpiRequest) ProtoMessage() {}
+
+func (x *CreateCpiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCpiRequest.ProtoReflect.Descriptor instead.
+func (*CreateCpiRequest) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateCpiRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *CreateCpiRequest) GetData() *CpiData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateCpiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateCpiResponse) Reset() {
+	*x = CreateCpiResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCpiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCpiResponse) ProtoMessage() {}
+
+func (x *CreateCpiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCpiResponse.ProtoReflect.Descriptor instead.
+func (*CreateCpiResponse) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{28}
+}
+
+type ListCpisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+}
+
+func (x *ListCpisRequest) Reset() {
+	*x = ListCpisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCpisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCpisRequest) ProtoMessage() {}
+
+func (x *ListCpisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCpisRequest.ProtoReflect.Descriptor instead.
+func (*ListCpisRequest) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCpisRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+type ListCpisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Details []*CpiDetails `protobuf:"bytes,1,rep,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *ListCpisResponse) Reset() {
+	*x = ListCpisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCpisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCpisResponse) ProtoMessage() {}
+
+func (x *ListCpisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCpisResponse.ProtoReflect.Descriptor instead.
+func (*ListCpisResponse) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListCpisResponse) GetDetails() []*CpiDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type DeleteCpiRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	CpiId     string `protobuf:"bytes,2,opt,name=cpi_id,json=cpiId,proto3" json:"cpi_id,omitempty"`
+}
+
+func (x *DeleteCpiRequest) Reset() {
+	*x = DeleteCpiRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCpiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCpiRequest) ProtoMessage() {}
+
+func (x *DeleteCpiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCpiRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCpiRequest) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteCpiRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *DeleteCpiRequest) GetCpiId() string {
+	if x != nil {
+		return x.CpiId
+	}
+	return ""
+}
+
+type DeleteCpiResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCpiResponse) Reset() {
+	*x = DeleteCpiResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCpiResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCpiResponse) ProtoMessage() {}
+
+func (x *DeleteCpiResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCpiResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCpiResponse) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{32}
+}
+
+type FetchCpiSignedDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	CbsdId    int64  `protobuf:"varint,2,opt,name=cbsd_id,json=cbsdId,proto3" json:"cbsd_id,omitempty"`
+}
+
+func (x *FetchCpiSignedDataRequest) Reset() {
+	*x = FetchCpiSignedDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchCpiSignedDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchCpiSignedDataRequest) ProtoMessage() {}
+
+func (x *FetchCpiSignedDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchCpiSignedDataRequest.ProtoReflect.Descriptor instead.
+func (*FetchCpiSignedDataRequest) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FetchCpiSignedDataRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *FetchCpiSignedDataRequest) GetCbsdId() int64 {
+	if x != nil {
+		return x.CbsdId
+	}
+	return 0
+}
+
+type FetchCpiSignedDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FccId             string             `protobuf:"bytes,1,opt,name=fcc_id,json=fccId,proto3" json:"fcc_id,omitempty"`
+	SerialNumber      string             `protobuf:"bytes,2,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	CbsdCategory      string             `protobuf:"bytes,3,opt,name=cbsd_category,json=cbsdCategory,proto3" json:"cbsd_category,omitempty"`
+	InstallationParam *InstallationParam `protobuf:"bytes,4,opt,name=installation_param,json=installationParam,proto3" json:"installation_param,omitempty"`
+	CpiSignature      *CpiSignature      `protobuf:"bytes,5,opt,name=cpi_signature,json=cpiSignature,proto3" json:"cpi_signature,omitempty"`
+}
+
+func (x *FetchCpiSignedDataResponse) Reset() {
+	*x = FetchCpiSignedDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchCpiSignedDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchCpiSignedDataResponse) ProtoMessage() {}
+
+func (x *FetchCpiSignedDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchCpiSignedDataResponse.ProtoReflect.Descriptor instead.
+func (*FetchCpiSignedDataResponse) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FetchCpiSignedDataResponse) GetFccId() string {
+	if x != nil {
+		return x.FccId
+	}
+	return ""
+}
+
+func (x *FetchCpiSignedDataResponse) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *FetchCpiSignedDataResponse) GetCbsdCategory() string {
+	if x != nil {
+		return x.CbsdCategory
+	}
+	return ""
+}
+
+func (x *FetchCpiSignedDataResponse) GetInstallationParam() *InstallationParam {
+	if x != nil {
+		return x.InstallationParam
+	}
+	return nil
+}
+
+func (x *FetchCpiSignedDataResponse) GetCpiSignature() *CpiSignature {
+	if x != nil {
+		return x.CpiSignature
+	}
+	return nil
+}
+
+type SignCbsdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	CbsdId    int64  `protobuf:"varint,2,opt,name=cbsd_id,json=cbsdId,proto3" json:"cbsd_id,omitempty"`
+	CpiId     string `protobuf:"bytes,3,opt,name=cpi_id,json=cpiId,proto3" json:"cpi_id,omitempty"`
+}
+
+func (x *SignCbsdRequest) Reset() {
+	*x = SignCbsdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignCbsdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignCbsdRequest) ProtoMessage() {}
+
+func (x *SignCbsdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignCbsdRequest.ProtoReflect.Descriptor instead.
+func (*SignCbsdRequest) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SignCbsdRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *SignCbsdRequest) GetCbsdId() int64 {
+	if x != nil {
+		return x.CbsdId
+	}
+	return 0
+}
+
+func (x *SignCbsdRequest) GetCpiId() string {
+	if x != nil {
+		return x.CpiId
+	}
+	return ""
+}
+
+type SignCbsdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SignCbsdResponse) Reset() {
+	*x = SignCbsdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignCbsdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignCbsdResponse) ProtoMessage() {}
+
+func (x *SignCbsdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignCbsdResponse.ProtoReflect.Descriptor instead.
+func (*SignCbsdResponse) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{36}
+}
+
+type CpiData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpiId   string `protobuf:"bytes,1,opt,name=cpi_id,json=cpiId,proto3" json:"cpi_id,omitempty"`
+	CpiName string `protobuf:"bytes,2,opt,name=cpi_name,json=cpiName,proto3" json:"cpi_name,omitempty"`
+	// PEM encoded RSA or EC private key, generated if empty
+	PrivateKey string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+}
+
+func (x *CpiData) Reset() {
+	*x = CpiData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpiData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpiData) ProtoMessage() {}
+
+func (x *CpiData) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpiData.ProtoReflect.Descriptor instead.
+func (*CpiData) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CpiData) GetCpiId() string {
+	if x != nil {
+		return x.CpiId
+	}
+	return ""
+}
+
+func (x *CpiData) GetCpiName() string {
+	if x != nil {
+		return x.CpiName
+	}
+	return ""
+}
+
+func (x *CpiData) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+type CpiDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpiId     string `protobuf:"bytes,1,opt,name=cpi_id,json=cpiId,proto3" json:"cpi_id,omitempty"`
+	CpiName   string `protobuf:"bytes,2,opt,name=cpi_name,json=cpiName,proto3" json:"cpi_name,omitempty"`
+	PublicKey string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (x *CpiDetails) Reset() {
+	*x = CpiDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dp_protos_cbsd_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpiDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpiDetails) ProtoMessage() {}
+
+func (x *CpiDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_dp_protos_cbsd_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpiDetails.ProtoReflect.Descriptor instead.
+func (*CpiDetails) Descriptor() ([]byte, []int) {
+	return file_dp_protos_cbsd_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CpiDetails) GetCpiId() string {
+	if x != nil {
+		return x.CpiId
+	}
+	return ""
+}
+
+func (x *CpiDetails) GetCpiName() string {
+	if x != nil {
+		return x.CpiName
+	}
+	return ""
+}
+
+func (x *CpiDetails) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+var File_dp_protos_cbsd_proto protoreflect.FileDescriptor
+
+var file_dp_protos_cbsd_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x64, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x63, 0x62, 0x73, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xd8, 0x01, 0x0a, 0x0f, 0x43, 0x42, 0x53, 0x44, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64,
+	0x70, 0x2e, 0x4c, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x64, 0x69, 0x6f, 0x5f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72,
+	0x61, 0x64, 0x69, 0x6f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x1b, 0x63,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x19, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x4c, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x8b, 0x01, 0x0a, 0x0a,
+	0x4c, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f,
+	0x77, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x6f, 0x77, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x48, 0x7a, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x66, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x68, 0x69, 0x67, 0x68, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a,
+	0x12, 0x27, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x69, 0x72, 0x70, 0x5f, 0x64, 0x62, 0x6d,
+	0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x45,
+	0x69, 0x72, 0x70, 0x44, 0x62, 0x6d, 0x4d, 0x68, 0x7a, 0x22, 0x5a, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x62, 0x73, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x62, 0x73, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x45, 0x6e, 0x6f, 0x64,
+	0x65, 0x62, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x12, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x62, 0x73, 0x64, 0x5f, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x62,
+	0x73, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x42, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x62,
+	0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x0a, 0x10, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a,
+	0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43,
+	0x62, 0x73, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x62, 0x73, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x62, 0x73, 0x64, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x62, 0x73, 0x64, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x46, 0x0a, 0x15, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x62,
+	0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x46, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x73, 0x68,
+	0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65,
+	0x6c, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x73, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf0, 0x04, 0x0a, 0x08, 0x43, 0x62, 0x73, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x63,
+	0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x63, 0x63, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x62, 0x73, 0x64, 0x5f, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x62, 0x73, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x73,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
 	0x12, 0x3e, 0x0a, 0x1b, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x67, 0x72,
 	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
 	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x41, 0x67,
@@ -1675,7 +2468,26 @@ var file_dp_protos_cbsd_proto_rawDesc = []byte{
 	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70,
 	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x22, 0x99, 0x03, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x60, 0x0a, 0x1a, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72,
+	0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x18, 0x63,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x18, 0x43, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x43,
+	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x69, 0x6e, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6d,
+	0x68, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4d, 0x68, 0x7a, 0x12, 0x35,
+	0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x14, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x4d, 0x68, 0x7a, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x67, 0x75,
+	0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x67, 0x75, 0x6f, 0x75, 0x73, 0x22, 0x99, 0x03, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
 	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x3f, 0x0a, 0x0c, 0x6c,
 	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -1717,7 +2529,7 @@ var file_dp_protos_cbsd_proto_rawDesc = []byte{
 	0x69, 0x64, 0x74, 0x68, 0x4d, 0x68, 0x7a, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x71, 0x75,
 	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03,
 	0x52, 0x0e, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4d, 0x68, 0x7a,
-	0x22, 0xc1, 0x01, 0x0a, 0x0b, 0x43, 0x62, 0x73, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x22, 0xb6, 0x02, 0x0a, 0x0b, 0x43, 0x62, 0x73, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
 	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x62, 0x73, 0x64, 0x44, 0x61,
@@ -1729,76 +2541,177 @@ var file_dp_protos_cbsd_proto_rawDesc = []byte{
 	0x74, 0x69, 0x76, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x06,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e,
 	0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x06, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x61,
-	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4d, 0x68, 0x7a, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x68, 0x7a, 0x12,
-	0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x69, 0x72, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x45, 0x69, 0x72, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x3a, 0x0a, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x5f, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a, 0x16,
-	0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x67, 0x72,
-	0x61, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0x74, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x31, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x61, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x70, 0x69, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x70, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x0c, 0x63, 0x70, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x15, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x46, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4d, 0x68, 0x7a, 0x22, 0x6d, 0x0a, 0x0c, 0x43, 0x70, 0x69,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x70, 0x69,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x70, 0x69, 0x49, 0x64,
+	0x12, 0x46, 0x0a, 0x1f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1d, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xfb, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x6e,
+	0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4d, 0x68, 0x7a, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x68, 0x7a, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x4d, 0x68, 0x7a, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x69, 0x72, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x45, 0x69, 0x72, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x34, 0x0a, 0x16, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x14, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x74, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x31, 0x0a, 0x0a, 0x43, 0x62, 0x73, 0x64,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x32, 0x87, 0x05, 0x0a, 0x0e,
-	0x43, 0x62, 0x73, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x49,
-	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x12, 0x1b, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x62,
-	0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x55, 0x73, 0x65,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x12, 0x1b, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x64, 0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x45, 0x6e, 0x6f, 0x64,
-	0x65, 0x62, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x12, 0x22, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x45, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x64,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x31, 0x0a, 0x0a,
+	0x43, 0x62, 0x73, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0x58, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x70, 0x69, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x70, 0x69, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x70, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64,
+	0x22, 0x42, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x70, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70,
+	0x2e, 0x43, 0x70, 0x69, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0x48, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x70,
+	0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x70, 0x69, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x70, 0x69, 0x49, 0x64, 0x22, 0x13,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x19, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x70, 0x69, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x63, 0x62, 0x73, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x63, 0x62, 0x73, 0x64, 0x49, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x1a, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x43, 0x70, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x63, 0x63, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x63, 0x63, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x62, 0x73, 0x64, 0x5f, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x62, 0x73, 0x64,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x4a, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x70, 0x69, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x70, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x0c, 0x63, 0x70, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x60, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x62, 0x73, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x62, 0x73, 0x64, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x63, 0x70, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x70,
+	0x69, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x62, 0x73, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x07, 0x43, 0x70, 0x69, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x70, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x70, 0x69, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x70, 0x69,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x70, 0x69,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x5d, 0x0a, 0x0a, 0x43, 0x70, 0x69, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x63, 0x70, 0x69, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x70, 0x69, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x70,
+	0x69, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x70,
+	0x69, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x32, 0x87, 0x05, 0x0a, 0x0e, 0x43, 0x62, 0x73, 0x64, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x62, 0x73, 0x64, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x62, 0x73, 0x64, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e,
 	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x42, 0x53,
-	0x44, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x49,
-	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x12, 0x1b, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x62,
-	0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x64, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x43, 0x62, 0x73, 0x64, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64,
-	0x70, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x62, 0x73, 0x64, 0x73, 0x12, 0x19,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x62,
-	0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x64, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x44, 0x65, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x62, 0x73, 0x64, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x64, 0x70, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x54, 0x0a, 0x11, 0x45, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x64, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64,
+	0x70, 0x2e, 0x45, 0x6e, 0x6f, 0x64, 0x65, 0x62, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x42, 0x53, 0x44, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x62, 0x73, 0x64, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x62, 0x73, 0x64, 0x12,
+	0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x62, 0x73, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x62, 0x73, 0x64, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x64, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x0e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x62,
+	0x73, 0x64, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x44, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x69, 0x6e,
+	0x71, 0x75, 0x69, 0x73, 0x68, 0x43, 0x62, 0x73, 0x64, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x64, 0x70, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x73, 0x68, 0x43,
 	0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55,
-	0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x73, 0x68, 0x43, 0x62, 0x73, 0x64,
-	0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x52, 0x65, 0x6c, 0x69,
-	0x6e, 0x71, 0x75, 0x69, 0x73, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x52, 0x65, 0x6c,
-	0x69, 0x6e, 0x71, 0x75, 0x69, 0x73, 0x68, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1a, 0x5a, 0x18, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x64,
-	0x70, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x52, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x73, 0x68,
+	0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x8c,
+	0x03, 0x0a, 0x0d, 0x43, 0x70, 0x69, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x46, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x70, 0x69, 0x12, 0x1a, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x70, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x64, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x70, 0x69, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x70, 0x69, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x70, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x70, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x70, 0x69, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x70, 0x69, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64,
+	0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x12, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x70,
+	0x69, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x43, 0x70, 0x69, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x43, 0x70, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e,
+	0x43, 0x62, 0x73, 0x64, 0x12, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x43, 0x62, 0x73, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x64, 0x70, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x43,
+	0x62, 0x73, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x1a, 0x5a,
+	0x18, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x64, 0x70, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f,
+	0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1813,82 +2726,112 @@ func file_dp_protos_cbsd_proto_rawDescGZIP() []byte {
 	return file_dp_protos_cbsd_proto_rawDescData
 }
 
-var file_dp_protos_cbsd_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_dp_protos_cbsd_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_dp_protos_cbsd_proto_goTypes = []interface{}{
-	(*CBSDStateResult)(nil),          // 0: magma.dp.CBSDStateResult
-	(*LteChannel)(nil),               // 1: magma.dp.LteChannel
-	(*CreateCbsdRequest)(nil),        // 2: magma.dp.CreateCbsdRequest
-	(*CreateCbsdResponse)(nil),       // 3: magma.dp.CreateCbsdResponse
-	(*UpdateCbsdRequest)(nil),        // 4: magma.dp.UpdateCbsdRequest
-	(*EnodebdUpdateCbsdRequest)(nil), // 5: magma.dp.EnodebdUpdateCbsdRequest
-	(*UpdateCbsdResponse)(nil),       // 6: magma.dp.UpdateCbsdResponse
-	(*DeleteCbsdRequest)(nil),        // 7: magma.dp.DeleteCbsdRequest
-	(*DeleteCbsdResponse)(nil),       // 8: magma.dp.DeleteCbsdResponse
-	(*FetchCbsdRequest)(nil),         // 9: magma.dp.FetchCbsdRequest
-	(*FetchCbsdResponse)(nil),        // 10: magma.dp.FetchCbsdResponse
-	(*ListCbsdRequest)(nil),          // 11: magma.dp.ListCbsdRequest
-	(*ListCbsdResponse)(nil),         // 12: magma.dp.ListCbsdResponse
-	(*DeregisterCbsdRequest)(nil),    // 13: magma.dp.DeregisterCbsdRequest
-	(*DeregisterCbsdResponse)(nil),   // 14: magma.dp.DeregisterCbsdResponse
-	(*RelinquishCbsdRequest)(nil),    // 15: magma.dp.RelinquishCbsdRequest
-	(*RelinquishCbsdResponse)(nil),   // 16: magma.dp.RelinquishCbsdResponse
-	(*CbsdData)(nil),                 // 17: magma.dp.CbsdData
-	(*InstallationParam)(nil),        // 18: magma.dp.InstallationParam
-	(*Capabilities)(nil),             // 19: magma.dp.Capabilities
-	(*FrequencyPreferences)(nil),     // 20: magma.dp.FrequencyPreferences
-	(*CbsdDetails)(nil),              // 21: magma.dp.CbsdDetails
-	(*GrantDetails)(nil),             // 22: magma.dp.GrantDetails
-	(*Pagination)(nil),               // 23: magma.dp.Pagination
-	(*CbsdFilter)(nil),               // 24: magma.dp.CbsdFilter
-	(*wrappers.DoubleValue)(nil),     // 25: google.protobuf.DoubleValue
-	(*wrappers.BoolValue)(nil),       // 26: google.protobuf.BoolValue
-	(*wrappers.StringValue)(nil),     // 27: google.protobuf.StringValue
-	(*wrappers.Int64Value)(nil),      // 28: google.protobuf.Int64Value
+	(*CBSDStateResult)(nil),            // 0: magma.dp.CBSDStateResult
+	(*LteChannel)(nil),                 // 1: magma.dp.LteChannel
+	(*CreateCbsdRequest)(nil),          // 2: magma.dp.CreateCbsdRequest
+	(*CreateCbsdResponse)(nil),         // 3: magma.dp.CreateCbsdResponse
+	(*UpdateCbsdRequest)(nil),          // 4: magma.dp.UpdateCbsdRequest
+	(*EnodebdUpdateCbsdRequest)(nil),   // 5: magma.dp.EnodebdUpdateCbsdRequest
+	(*UpdateCbsdResponse)(nil),         // 6: magma.dp.UpdateCbsdResponse
+	(*DeleteCbsdRequest)(nil),          // 7: magma.dp.DeleteCbsdRequest
+	(*DeleteCbsdResponse)(nil),         // 8: magma.dp.DeleteCbsdResponse
+	(*FetchCbsdRequest)(nil),           // 9: magma.dp.FetchCbsdRequest
+	(*FetchCbsdResponse)(nil),          // 10: magma.dp.FetchCbsdResponse
+	(*ListCbsdRequest)(nil),            // 11: magma.dp.ListCbsdRequest
+	(*ListCbsdResponse)(nil),           // 12: magma.dp.ListCbsdResponse
+	(*DeregisterCbsdRequest)(nil),      // 13: magma.dp.DeregisterCbsdRequest
+	(*DeregisterCbsdResponse)(nil),     // 14: magma.dp.DeregisterCbsdResponse
+	(*RelinquishCbsdRequest)(nil),      // 15: magma.dp.RelinquishCbsdRequest
+	(*RelinquishCbsdResponse)(nil),     // 16: magma.dp.RelinquishCbsdResponse
+	(*CbsdData)(nil),                   // 17: magma.dp.CbsdData
+	(*CarrierAggregationParams)(nil),   // 18: magma.dp.CarrierAggregationParams
+	(*InstallationParam)(nil),          // 19: magma.dp.InstallationParam
+	(*Capabilities)(nil),               // 20: magma.dp.Capabilities
+	(*FrequencyPreferences)(nil),       // 21: magma.dp.FrequencyPreferences
+	(*CbsdDetails)(nil),                // 22: magma.dp.CbsdDetails
+	(*CpiSignature)(nil),               // 23: magma.dp.CpiSignature
+	(*GrantDetails)(nil),               // 24: magma.dp.GrantDetails
+	(*Pagination)(nil),                 // 25: magma.dp.Pagination
+	(*CbsdFilter)(nil),                 // 26: magma.dp.CbsdFilter
+	(*CreateCpiRequest)(nil),           // 27: magma.dp.CreateCpiRequest
+	(*CreateCpiResponse)(nil),          // 28: magma.dp.CreateCpiResponse
+	(*ListCpisRequest)(nil),            // 29: magma.dp.ListCpisRequest
+	(*ListCpisResponse)(nil),           // 30: magma.dp.ListCpisResponse
+	(*DeleteCpiRequest)(nil),           // 31: magma.dp.DeleteCpiRequest
+	(*DeleteCpiResponse)(nil),          // 32: magma.dp.DeleteCpiResponse
+	(*FetchCpiSignedDataRequest)(nil),  // 33: magma.dp.FetchCpiSignedDataRequest
+	(*FetchCpiSignedDataResponse)(nil), // 34: magma.dp.FetchCpiSignedDataResponse
+	(*SignCbsdRequest)(nil),            // 35: magma.dp.SignCbsdRequest
+	(*SignCbsdResponse)(nil),           // 36: magma.dp.SignCbsdResponse
+	(*CpiData)(nil),                    // 37: magma.dp.CpiData
+	(*CpiDetails)(nil),                 // 38: magma.dp.CpiDetails
+	(*wrappers.DoubleValue)(nil),       // 39: google.protobuf.DoubleValue
+	(*wrappers.BoolValue)(nil),         // 40: google.protobuf.BoolValue
+	(*wrappers.StringValue)(nil),       // 41: google.protobuf.StringValue
+	(*wrappers.Int64Value)(nil),        // 42: google.protobuf.Int64Value
 }
 var file_dp_protos_cbsd_proto_depIdxs = []int32{
 	1,  // 0: magma.dp.CBSDStateResult.channels:type_name -> magma.dp.LteChannel
 	1,  // 1: magma.dp.CBSDStateResult.channel:type_name -> magma.dp.LteChannel
 	17, // 2: magma.dp.CreateCbsdRequest.data:type_name -> magma.dp.CbsdData
 	17, // 3: magma.dp.UpdateCbsdRequest.data:type_name -> magma.dp.CbsdData
-	18, // 4: magma.dp.EnodebdUpdateCbsdRequest.installation_param:type_name -> magma.dp.InstallationParam
-	21, // 5: magma.dp.FetchCbsdResponse.details:type_name -> magma.dp.CbsdDetails
-	23, // 6: magma.dp.ListCbsdRequest.pagination:type_name -> magma.dp.Pagination
-	24, // 7: magma.dp.ListCbsdRequest.filter:type_name -> magma.dp.CbsdFilter
-	21, // 8: magma.dp.ListCbsdResponse.details:type_name -> magma.dp.CbsdDetails
-	19, // 9: magma.dp.CbsdData.capabilities:type_name -> magma.dp.Capabilities
-	20, // 10: magma.dp.CbsdData.preferences:type_name -> magma.dp.FrequencyPreferences
-	18, // 11: magma.dp.CbsdData.installation_param:type_name -> magma.dp.InstallationParam
-	25, // 12: magma.dp.InstallationParam.latitude_deg:type_name -> google.protobuf.DoubleValue
-	25, // 13: magma.dp.InstallationParam.longitude_deg:type_name -> google.protobuf.DoubleValue
-	26, // 14: magma.dp.InstallationParam.indoor_deployment:type_name -> google.protobuf.BoolValue
-	25, // 15: magma.dp.InstallationParam.height_m:type_name -> google.protobuf.DoubleValue
-	27, // 16: magma.dp.InstallationParam.height_type:type_name -> google.protobuf.StringValue
-	25, // 17: magma.dp.InstallationParam.antenna_gain:type_name -> google.protobuf.DoubleValue
-	17, // 18: magma.dp.CbsdDetails.data:type_name -> magma.dp.CbsdData
-	22, // 19: magma.dp.CbsdDetails.grants:type_name -> magma.dp.GrantDetails
-	28, // 20: magma.dp.Pagination.limit:type_name -> google.protobuf.Int64Value
-	28, // 21: magma.dp.Pagination.offset:type_name -> google.protobuf.Int64Value
-	2,  // 22: magma.dp.CbsdManagement.CreateCbsd:input_type -> magma.dp.CreateCbsdRequest
-	4,  // 23: magma.dp.CbsdManagement.UserUpdateCbsd:input_type -> magma.dp.UpdateCbsdRequest
-	5,  // 24: magma.dp.CbsdManagement.EnodebdUpdateCbsd:input_type -> magma.dp.EnodebdUpdateCbsdRequest
-	7,  // 25: magma.dp.CbsdManagement.DeleteCbsd:input_type -> magma.dp.DeleteCbsdRequest
-	9,  // 26: magma.dp.CbsdManagement.FetchCbsd:input_type -> magma.dp.FetchCbsdRequest
-	11, // 27: magma.dp.CbsdManagement.ListCbsds:input_type -> magma.dp.ListCbsdRequest
-	13, // 28: magma.dp.CbsdManagement.DeregisterCbsd:input_type -> magma.dp.DeregisterCbsdRequest
-	15, // 29: magma.dp.CbsdManagement.RelinquishCbsd:input_type -> magma.dp.RelinquishCbsdRequest
-	3,  // 30: magma.dp.CbsdManagement.CreateCbsd:output_type -> magma.dp.CreateCbsdResponse
-	6,  // 31: magma.dp.CbsdManagement.UserUpdateCbsd:output_type -> magma.dp.UpdateCbsdResponse
-	0,  // 32: magma.dp.CbsdManagement.EnodebdUpdateCbsd:output_type -> magma.dp.CBSDStateResult
-	8,  // 33: magma.dp.CbsdManagement.DeleteCbsd:output_type -> magma.dp.DeleteCbsdResponse
-	10, // 34: magma.dp.CbsdManagement.FetchCbsd:output_type -> magma.dp.FetchCbsdResponse
-	12, // 35: magma.dp.CbsdManagement.ListCbsds:output_type -> magma.dp.ListCbsdResponse
-	14, // 36: magma.dp.CbsdManagement.DeregisterCbsd:output_type -> magma.dp.DeregisterCbsdResponse
-	16, // 37: magma.dp.CbsdManagement.RelinquishCbsd:output_type -> magma.dp.RelinquishCbsdResponse
-	30, // [30:38] is the sub-list for method output_type
-	22, // [22:30] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	19, // 4: magma.dp.EnodebdUpdateCbsdRequest.installation_param:type_name -> magma.dp.InstallationParam
+	22, // 5: magma.dp.FetchCbsdResponse.details:type_name -> magma.dp.CbsdDetails
+	25, // 6: magma.dp.ListCbsdRequest.pagination:type_name -> magma.dp.Pagination
+	26, // 7: magma.dp.ListCbsdRequest.filter:type_name -> magma.dp.CbsdFilter
+	22, // 8: magma.dp.ListCbsdResponse.details:type_name -> magma.dp.CbsdDetails
+	20, // 9: magma.dp.CbsdData.capabilities:type_name -> magma.dp.Capabilities
+	21, // 10: magma.dp.CbsdData.preferences:type_name -> magma.dp.FrequencyPreferences
+	19, // 11: magma.dp.CbsdData.installation_param:type_name -> magma.dp.InstallationParam
+	18, // 12: magma.dp.CbsdData.carrier_aggregation_params:type_name -> magma.dp.CarrierAggregationParams
+	39, // 13: magma.dp.InstallationParam.latitude_deg:type_name -> google.protobuf.DoubleValue
+	39, // 14: magma.dp.InstallationParam.longitude_deg:type_name -> google.protobuf.DoubleValue
+	40, // 15: magma.dp.InstallationParam.indoor_deployment:type_name -> google.protobuf.BoolValue
+	39, // 16: magma.dp.InstallationParam.height_m:type_name -> google.protobuf.DoubleValue
+	41, // 17: magma.dp.InstallationParam.height_type:type_name -> google.protobuf.StringValue
+	39, // 18: magma.dp.InstallationParam.antenna_gain:type_name -> google.protobuf.DoubleValue
+	17, // 19: magma.dp.CbsdDetails.data:type_name -> magma.dp.CbsdData
+	24, // 20: magma.dp.CbsdDetails.grants:type_name -> magma.dp.GrantDetails
+	23, // 21: magma.dp.CbsdDetails.cpi_signature:type_name -> magma.dp.CpiSignature
+	42, // 22: magma.dp.Pagination.limit:type_name -> google.protobuf.Int64Value
+	42, // 23: magma.dp.Pagination.offset:type_name -> google.protobuf.Int64Value
+	37, // 24: magma.dp.CreateCpiRequest.data:type_name -> magma.dp.CpiData
+	38, // 25: magma.dp.ListCpisResponse.details:type_name -> magma.dp.CpiDetails
+	19, // 26: magma.dp.FetchCpiSignedDataResponse.installation_param:type_name -> magma.dp.InstallationParam
+	23, // 27: magma.dp.FetchCpiSignedDataResponse.cpi_signature:type_name -> magma.dp.CpiSignature
+	2,  // 28: magma.dp.CbsdManagement.CreateCbsd:input_type -> magma.dp.CreateCbsdRequest
+	4,  // 29: magma.dp.CbsdManagement.UserUpdateCbsd:input_type -> magma.dp.UpdateCbsdRequest
+	5,  // 30: magma.dp.CbsdManagement.EnodebdUpdateCbsd:input_type -> magma.dp.EnodebdUpdateCbsdRequest
+	7,  // 31: magma.dp.CbsdManagement.DeleteCbsd:input_type -> magma.dp.DeleteCbsdRequest
+	9,  // 32: magma.dp.CbsdManagement.FetchCbsd:input_type -> magma.dp.FetchCbsdRequest
+	11, // 33: magma.dp.CbsdManagement.ListCbsds:input_type -> magma.dp.ListCbsdRequest
+	13, // 34: magma.dp.CbsdManagement.DeregisterCbsd:input_type -> magma.dp.DeregisterCbsdRequest
+	15, // 35: magma.dp.CbsdManagement.RelinquishCbsd:input_type -> magma.dp.RelinquishCbsdRequest
+	27, // 36: magma.dp.CpiManagement.CreateCpi:input_type -> magma.dp.CreateCpiRequest
+	29, // 37: magma.dp.CpiManagement.ListCpis:input_type -> magma.dp.ListCpisRequest
+	31, // 38: magma.dp.CpiManagement.DeleteCpi:input_type -> magma.dp.DeleteCpiRequest
+	33, // 39: magma.dp.CpiManagement.FetchCpiSignedData:input_type -> magma.dp.FetchCpiSignedDataRequest
+	35, // 40: magma.dp.CpiManagement.SignCbsd:input_type -> magma.dp.SignCbsdRequest
+	3,  // 41: magma.dp.CbsdManagement.CreateCbsd:output_type -> magma.dp.CreateCbsdResponse
+	6,  // 42: magma.dp.CbsdManagement.UserUpdateCbsd:output_type -> magma.dp.UpdateCbsdResponse
+	0,  // 43: magma.dp.CbsdManagement.EnodebdUpdateCbsd:output_type -> magma.dp.CBSDStateResult
+	8,  // 44: magma.dp.CbsdManagement.DeleteCbsd:output_type -> magma.dp.DeleteCbsdResponse
+	10, // 45: magma.dp.CbsdManagement.FetchCbsd:output_type -> magma.dp.FetchCbsdResponse
+	12, // 46: magma.dp.CbsdManagement.ListCbsds:output_type -> magma.dp.ListCbsdResponse
+	14, // 47: magma.dp.CbsdManagement.DeregisterCbsd:output_type -> magma.dp.DeregisterCbsdResponse
+	16, // 48: magma.dp.CbsdManagement.RelinquishCbsd:output_type -> magma.dp.RelinquishCbsdResponse
+	28, // 49: magma.dp.CpiManagement.CreateCpi:output_type -> magma.dp.CreateCpiResponse
+	30, // 50: magma.dp.CpiManagement.ListCpis:output_type -> magma.dp.ListCpisResponse
+	32, // 51: magma.dp.CpiManagement.DeleteCpi:output_type -> magma.dp.DeleteCpiResponse
+	34, // 52: magma.dp.CpiManagement.FetchCpiSignedData:output_type -> magma.dp.FetchCpiSignedDataResponse
+	36, // 53: magma.dp.CpiManagement.SignCbsd:output_type -> magma.dp.SignCbsdResponse
+	41, // [41:54] is the sub-list for method output_type
+	28, // [28:41] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_dp_protos_cbsd_proto_init() }
@@ -1909,8 +2852,128 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LteChannel); i {
+		file_dp_protos_cbsd_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LteChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCbsdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCbsdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCbsdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnodebdUpdateCbsdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCbsdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCbsdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCbsdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchCbsdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchCbsdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCbsdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1921,8 +2984,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCbsdRequest); i {
+		file_dp_protos_cbsd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCbsdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1933,8 +2996,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCbsdResponse); i {
+		file_dp_protos_cbsd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterCbsdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1945,8 +3008,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCbsdRequest); i {
+		file_dp_protos_cbsd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterCbsdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1957,8 +3020,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnodebdUpdateCbsdRequest); i {
+		file_dp_protos_cbsd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelinquishCbsdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1969,8 +3032,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCbsdResponse); i {
+		file_dp_protos_cbsd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelinquishCbsdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1981,8 +3044,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCbsdRequest); i {
+		file_dp_protos_cbsd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CbsdData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1993,8 +3056,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCbsdResponse); i {
+		file_dp_protos_cbsd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarrierAggregationParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2005,8 +3068,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchCbsdRequest); i {
+		file_dp_protos_cbsd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallationParam); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2017,8 +3080,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchCbsdResponse); i {
+		file_dp_protos_cbsd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Capabilities); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2029,8 +3092,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCbsdRequest); i {
+		file_dp_protos_cbsd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrequencyPreferences); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2041,8 +3104,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCbsdResponse); i {
+		file_dp_protos_cbsd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CbsdDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2053,8 +3116,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeregisterCbsdRequest); i {
+		file_dp_protos_cbsd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpiSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2065,8 +3128,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeregisterCbsdResponse); i {
+		file_dp_protos_cbsd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2077,8 +3140,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelinquishCbsdRequest); i {
+		file_dp_protos_cbsd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2089,8 +3152,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelinquishCbsdResponse); i {
+		file_dp_protos_cbsd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CbsdFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2101,8 +3164,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CbsdData); i {
+		file_dp_protos_cbsd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCpiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2113,8 +3176,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstallationParam); i {
+		file_dp_protos_cbsd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCpiResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2125,8 +3188,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Capabilities); i {
+		file_dp_protos_cbsd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCpisRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2137,8 +3200,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrequencyPreferences); i {
+		file_dp_protos_cbsd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCpisResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2149,8 +3212,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CbsdDetails); i {
+		file_dp_protos_cbsd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCpiRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2161,8 +3224,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GrantDetails); i {
+		file_dp_protos_cbsd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCpiResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2173,8 +3236,8 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pagination); i {
+		file_dp_protos_cbsd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchCpiSignedDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2185,8 +3248,56 @@ func file_dp_protos_cbsd_proto_init() {
 				return nil
 			}
 		}
-		file_dp_protos_cbsd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CbsdFilter); i {
+		file_dp_protos_cbsd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchCpiSignedDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignCbsdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignCbsdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpiData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dp_protos_cbsd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpiDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2204,9 +3315,9 @@ func file_dp_protos_cbsd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dp_protos_cbsd_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   39,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_dp_protos_cbsd_proto_goTypes,
 		DependencyIndexes: file_dp_protos_cbsd_proto_depIdxs,
@@ -2549,3 +3660,219 @@ var _CbsdManagement_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dp/protos/cbsd.proto",
 }
+
+// CpiManagementClient is the client API for CpiManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CpiManagementClient interface {
+	CreateCpi(ctx context.Context, in *CreateCpiRequest, opts ...grpc.CallOption) (*CreateCpiResponse, error)
+	ListCpis(ctx context.Context, in *ListCpisRequest, opts ...grpc.CallOption) (*ListCpisResponse, error)
+	DeleteCpi(ctx context.Context, in *DeleteCpiRequest, opts ...grpc.CallOption) (*DeleteCpiResponse, error)
+	FetchCpiSignedData(ctx context.Context, in *FetchCpiSignedDataRequest, opts ...grpc.CallOption) (*FetchCpiSignedDataResponse, error)
+	SignCbsd(ctx context.Context, in *SignCbsdRequest, opts ...grpc.CallOption) (*SignCbsdResponse, error)
+}
+
+type cpiManagementClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCpiManagementClient(cc grpc.ClientConnInterface) CpiManagementClient {
+	return &cpiManagementClient{cc}
+}
+
+func (c *cpiManagementClient) CreateCpi(ctx context.Context, in *CreateCpiRequest, opts ...grpc.CallOption) (*CreateCpiResponse, error) {
+	out := new(CreateCpiResponse)
+	err := c.cc.Invoke(ctx, "/magma.dp.CpiManagement/CreateCpi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cpiManagementClient) ListCpis(ctx context.Context, in *ListCpisRequest, opts ...grpc.CallOption) (*ListCpisResponse, error) {
+	out := new(ListCpisResponse)
+	err := c.cc.Invoke(ctx, "/magma.dp.CpiManagement/ListCpis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cpiManagementClient) DeleteCpi(ctx context.Context, in *DeleteCpiRequest, opts ...grpc.CallOption) (*DeleteCpiResponse, error) {
+	out := new(DeleteCpiResponse)
+	err := c.cc.Invoke(ctx, "/magma.dp.CpiManagement/DeleteCpi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cpiManagementClient) FetchCpiSignedData(ctx context.Context, in *FetchCpiSignedDataRequest, opts ...grpc.CallOption) (*FetchCpiSignedDataResponse, error) {
+	out := new(FetchCpiSignedDataResponse)
+	err := c.cc.Invoke(ctx, "/magma.dp.CpiManagement/FetchCpiSignedData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cpiManagementClient) SignCbsd(ctx context.Context, in *SignCbsdRequest, opts ...grpc.CallOption) (*SignCbsdResponse, error) {
+	out := new(SignCbsdResponse)
+	err := c.cc.Invoke(ctx, "/magma.dp.CpiManagement/SignCbsd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CpiManagementServer is the server API for CpiManagement service.
+type CpiManagementServer interface {
+	CreateCpi(context.Context, *CreateCpiRequest) (*CreateCpiResponse, error)
+	ListCpis(context.Context, *ListCpisRequest) (*ListCpisResponse, error)
+	DeleteCpi(context.Context, *DeleteCpiRequest) (*DeleteCpiResponse, error)
+	FetchCpiSignedData(context.Context, *FetchCpiSignedDataRequest) (*FetchCpiSignedDataResponse, error)
+	SignCbsd(context.Context, *SignCbsdRequest) (*SignCbsdResponse, error)
+}
+
+// UnimplementedCpiManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedCpiManagementServer struct {
+}
+
+func (*UnimplementedCpiManagementServer) CreateCpi(context.Context, *CreateCpiRequest) (*CreateCpiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCpi not implemented")
+}
+func (*UnimplementedCpiManagementServer) ListCpis(context.Context, *ListCpisRequest) (*ListCpisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCpis not implemented")
+}
+func (*UnimplementedCpiManagementServer) DeleteCpi(context.Context, *DeleteCpiRequest) (*DeleteCpiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCpi not implemented")
+}
+func (*UnimplementedCpiManagementServer) FetchCpiSignedData(context.Context, *FetchCpiSignedDataRequest) (*FetchCpiSignedDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchCpiSignedData not implemented")
+}
+func (*UnimplementedCpiManagementServer) SignCbsd(context.Context, *SignCbsdRequest) (*SignCbsdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignCbsd not implemented")
+}
+
+func RegisterCpiManagementServer(s *grpc.Server, srv CpiManagementServer) {
+	s.RegisterService(&_CpiManagement_serviceDesc, srv)
+}
+
+func _CpiManagement_CreateCpi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCpiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CpiManagementServer).CreateCpi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.dp.CpiManagement/CreateCpi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CpiManagementServer).CreateCpi(ctx, req.(*CreateCpiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CpiManagement_ListCpis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCpisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CpiManagementServer).ListCpis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.dp.CpiManagement/ListCpis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CpiManagementServer).ListCpis(ctx, req.(*ListCpisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CpiManagement_DeleteCpi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCpiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CpiManagementServer).DeleteCpi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.dp.CpiManagement/DeleteCpi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CpiManagementServer).DeleteCpi(ctx, req.(*DeleteCpiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CpiManagement_FetchCpiSignedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchCpiSignedDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CpiManagementServer).FetchCpiSignedData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.dp.CpiManagement/FetchCpiSignedData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CpiManagementServer).FetchCpiSignedData(ctx, req.(*FetchCpiSignedDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CpiManagement_SignCbsd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignCbsdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CpiManagementServer).SignCbsd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.dp.CpiManagement/SignCbsd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CpiManagementServer).SignCbsd(ctx, req.(*SignCbsdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CpiManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.dp.CpiManagement",
+	HandlerType: (*CpiManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCpi",
+			Handler:    _CpiManagement_CreateCpi_Handler,
+		},
+		{
+			MethodName: "ListCpis",
+			Handler:    _CpiManagement_ListCpis_Handler,
+		},
+		{
+			MethodName: "DeleteCpi",
+			Handler:    _CpiManagement_DeleteCpi_Handler,
+		},
+		{
+			MethodName: "FetchCpiSignedData",
+			Handler:    _CpiManagement_FetchCpiSignedData_Handler,
+		},
+		{
+			MethodName: "SignCbsd",
+			Handler:    _CpiManagement_SignCbsd_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dp/protos/cbsd.proto",
+}
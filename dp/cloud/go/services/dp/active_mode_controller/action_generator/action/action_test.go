@@ -100,6 +100,10 @@ func (s *stubAmcManager) GetState(_ squirrel.BaseRunner) ([]*storage.DetailedCbs
 	return nil, nil
 }
 
+func (s *stubAmcManager) GetPlanningState(_ squirrel.BaseRunner) ([]*storage.DBCbsd, error) {
+	return nil, nil
+}
+
 func (s *stubAmcManager) CreateRequest(_ squirrel.BaseRunner, request *storage.MutableRequest) error {
 	s.action = createRequest
 	s.request = request
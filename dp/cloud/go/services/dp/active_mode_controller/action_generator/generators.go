@@ -91,7 +91,12 @@ type grantManager struct {
 
 func (g *grantManager) GenerateRequests(cbsd *storage.DetailedCbsd) []*storage.MutableRequest {
 	grants := grant.GetFrequencyGrantMapping(cbsd.Grants)
-	calc := eirp.NewCalculator(cbsd.Cbsd)
+	dbGrants := make([]*storage.DBGrant, len(cbsd.Grants))
+	for i, gt := range cbsd.Grants {
+		dbGrants[i] = gt.Grant
+	}
+	selection := grant.SelectGrants(cbsd.Cbsd, dbGrants, g.rng.Int())
+	calc := eirp.NewCalculator(cbsd.Cbsd).WithCarriers(selection.Carriers)
 	processors := grant.Processors[*storage.MutableRequest]{
 		Del: &sas.RelinquishmentProcessor{
 			CbsdId: cbsd.Cbsd.CbsdId.String,
@@ -108,11 +113,7 @@ func (g *grantManager) GenerateRequests(cbsd *storage.DetailedCbsd) []*storage.M
 			Channels: cbsd.Cbsd.Channels,
 		},
 	}
-	dbGrants := make([]*storage.DBGrant, len(cbsd.Grants))
-	for i, gt := range cbsd.Grants {
-		dbGrants[i] = gt.Grant
-	}
-	requests := grant.ProcessGrants(cbsd.Cbsd, dbGrants, processors, g.rng.Int())
+	requests := grant.ProcessSelection(selection, processors)
 	if len(requests) > 0 {
 		return requests
 	}
@@ -0,0 +1,250 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package planner computes frequency preferences for all cbsds in a network,
+// so that neighboring radios do not pick the same channel.
+package planner
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+
+	"golang.org/x/exp/slices"
+
+	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator/action"
+	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator/sas/frequency"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+)
+
+const (
+	unitMHz           = 5
+	minBandwidthMHz   = 5
+	maxBandwidthMHz   = 20
+	earthRadiusMeters = 6371e3
+)
+
+type Planner struct {
+	reuseDistanceM float64
+	neighbors      map[string]map[string]bool
+}
+
+// NewPlanner creates planner which considers two cbsds in the same network
+// to be neighbors if they are closer than reuseDistanceM (0 disables it)
+// or if either of them lists the other one in neighbors (by serial number).
+func NewPlanner(reuseDistanceM float64, neighbors map[string][]string) *Planner {
+	n := map[string]map[string]bool{}
+	for serial, others := range neighbors {
+		for _, other := range others {
+			addNeighbor(n, serial, other)
+			addNeighbor(n, other, serial)
+		}
+	}
+	return &Planner{reuseDistanceM: reuseDistanceM, neighbors: n}
+}
+
+func addNeighbor(n map[string]map[string]bool, a string, b string) {
+	if n[a] == nil {
+		n[a] = map[string]bool{}
+	}
+	n[a][b] = true
+}
+
+// Plan returns ordered frequency preferences (channel midpoints in MHz) per cbsd id.
+// Channels which overlap with the fewest first choices of neighbors come first,
+// ties are resolved using frequency preferences of cbsd.
+// Cbsds without available frequencies (no spectrum inquiry yet) get no plan.
+func (p *Planner) Plan(cbsds []*storage.DBCbsd) map[int64][]int64 {
+	plans := make(map[int64][]int64, len(cbsds))
+	for _, network := range groupByNetwork(cbsds) {
+		p.planNetwork(network, plans)
+	}
+	return plans
+}
+
+func groupByNetwork(cbsds []*storage.DBCbsd) [][]*storage.DBCbsd {
+	var networks [][]*storage.DBCbsd
+	index := map[string]int{}
+	for _, c := range cbsds {
+		i, ok := index[c.NetworkId.String]
+		if !ok {
+			i = len(networks)
+			index[c.NetworkId.String] = i
+			networks = append(networks, nil)
+		}
+		networks[i] = append(networks[i], c)
+	}
+	return networks
+}
+
+type channel struct {
+	frequencyMHz int64
+	bandwidthMHz int64
+}
+
+func (c *channel) overlaps(other *channel) bool {
+	d := c.frequencyMHz - other.frequencyMHz
+	if d < 0 {
+		d = -d
+	}
+	return 2*d < c.bandwidthMHz+other.bandwidthMHz
+}
+
+func (p *Planner) planNetwork(cbsds []*storage.DBCbsd, plans map[int64][]int64) {
+	graph := p.buildConflictGraph(cbsds)
+	order := make([]int, len(cbsds))
+	for i := range order {
+		order[i] = i
+	}
+	// most constrained cbsds are planned first
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(graph[order[i]]) > len(graph[order[j]])
+	})
+	firstChoice := make([]*channel, len(cbsds))
+	for _, i := range order {
+		var occupied []*channel
+		for _, j := range graph[i] {
+			if firstChoice[j] != nil {
+				occupied = append(occupied, firstChoice[j])
+			}
+		}
+		candidates := rankCandidates(cbsds[i], occupied)
+		if len(candidates) == 0 {
+			continue
+		}
+		plan := make([]int64, len(candidates))
+		for k, c := range candidates {
+			plan[k] = c.frequencyMHz
+		}
+		plans[cbsds[i].Id.Int64] = plan
+		firstChoice[i] = candidates[0]
+	}
+}
+
+func (p *Planner) buildConflictGraph(cbsds []*storage.DBCbsd) [][]int {
+	graph := make([][]int, len(cbsds))
+	for i := range cbsds {
+		for j := i + 1; j < len(cbsds); j++ {
+			if p.areNeighbors(cbsds[i], cbsds[j]) {
+				graph[i] = append(graph[i], j)
+				graph[j] = append(graph[j], i)
+			}
+		}
+	}
+	return graph
+}
+
+func (p *Planner) areNeighbors(a *storage.DBCbsd, b *storage.DBCbsd) bool {
+	if p.neighbors[a.CbsdSerialNumber.String][b.CbsdSerialNumber.String] {
+		return true
+	}
+	if p.reuseDistanceM <= 0 || !hasLocation(a) || !hasLocation(b) {
+		return false
+	}
+	return distanceMeters(a, b) < p.reuseDistanceM
+}
+
+func hasLocation(c *storage.DBCbsd) bool {
+	return c.LatitudeDeg.Valid && c.LongitudeDeg.Valid
+}
+
+// distanceMeters uses haversine formula to calculate great-circle distance.
+func distanceMeters(a *storage.DBCbsd, b *storage.DBCbsd) float64 {
+	lat1, lat2 := toRadians(a.LatitudeDeg.Float64), toRadians(b.LatitudeDeg.Float64)
+	dLat := lat2 - lat1
+	dLon := toRadians(b.LongitudeDeg.Float64 - a.LongitudeDeg.Float64)
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func rankCandidates(cbsd *storage.DBCbsd, occupied []*channel) []*channel {
+	bandwidth := getBandwidthMHz(cbsd)
+	index := int(bandwidth/unitMHz - 1)
+	if index >= len(cbsd.AvailableFrequencies) {
+		return nil
+	}
+	available := cbsd.AvailableFrequencies[index]
+	candidates := make([]*channel, 0, bits.OnesCount32(available))
+	for available != 0 {
+		x := bits.TrailingZeros32(available)
+		available &= available - 1
+		candidates = append(candidates, &channel{
+			frequencyMHz: int64(frequency.LowestHz/1e6) + int64(x)*unitMHz,
+			bandwidthMHz: bandwidth,
+		})
+	}
+	conflicts := make(map[*channel]int, len(candidates))
+	for _, c := range candidates {
+		for _, o := range occupied {
+			if c.overlaps(o) {
+				conflicts[c]++
+			}
+		}
+	}
+	rank := func(c *channel) int {
+		if i := slices.Index(cbsd.PreferredFrequenciesMHz, c.frequencyMHz); i >= 0 {
+			return i
+		}
+		return len(cbsd.PreferredFrequenciesMHz)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ci, cj := conflicts[candidates[i]], conflicts[candidates[j]]
+		if ci != cj {
+			return ci < cj
+		}
+		return rank(candidates[i]) < rank(candidates[j])
+	})
+	return candidates
+}
+
+func getBandwidthMHz(cbsd *storage.DBCbsd) int64 {
+	bw := cbsd.PreferredBandwidthMHz.Int64 / unitMHz * unitMHz
+	if bw < minBandwidthMHz {
+		return minBandwidthMHz
+	}
+	if bw > maxBandwidthMHz {
+		return maxBandwidthMHz
+	}
+	return bw
+}
+
+// GenerateActions returns actions which store plans that differ from those already stored.
+// Cbsds missing in plans have their stored plan removed.
+func GenerateActions(cbsds []*storage.DBCbsd, plans map[int64][]int64) []action.Action {
+	var actions []action.Action
+	for _, c := range cbsds {
+		plan := plans[c.Id.Int64]
+		if slices.Equal(plan, c.PlannedFrequenciesMHz) {
+			continue
+		}
+		data := &storage.DBCbsd{
+			Id:                    c.Id,
+			PlannedFrequenciesMHz: plan,
+		}
+		mask := db.NewIncludeMask("planned_frequencies_mhz")
+		actions = append(actions, &action.UpdateCbsd{Data: data, Mask: mask})
+	}
+	return actions
+}
+
+// ApplyPlans makes plans visible to grant selection of cbsds processed in current iteration.
+func ApplyPlans(cbsds []*storage.DetailedCbsd, plans map[int64][]int64) {
+	for _, c := range cbsds {
+		c.Cbsd.PlannedFrequenciesMHz = plans[c.Cbsd.Id.Int64]
+	}
+}
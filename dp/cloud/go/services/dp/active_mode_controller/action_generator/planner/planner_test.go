@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planner_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator/action"
+	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator/planner"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+)
+
+const (
+	someNetwork    = "some_network"
+	otherNetwork   = "other_network"
+	reuseDistanceM = 500
+)
+
+// channels with midpoints 3560, 3580 and 3600 MHz are available for 20 MHz bandwidth
+var available = []uint32{0, 0, 0, 1<<2 | 1<<6 | 1<<10}
+
+func TestPlan(t *testing.T) {
+	testCases := []struct {
+		name      string
+		neighbors map[string][]string
+		cbsds     []*storage.DBCbsd
+		expected  map[int64][]int64
+	}{{
+		name: "Should follow preferences when there are no neighbors",
+		cbsds: []*storage.DBCbsd{
+			newCbsd(1, someNetwork).at(50, 20).prefer(3600).cbsd,
+			newCbsd(2, someNetwork).at(51, 20).prefer(3600).cbsd,
+		},
+		expected: map[int64][]int64{
+			1: {3600, 3560, 3580},
+			2: {3600, 3560, 3580},
+		},
+	}, {
+		name: "Should plan different channels for cbsds within reuse distance",
+		cbsds: []*storage.DBCbsd{
+			newCbsd(1, someNetwork).at(50, 20).prefer(3600).cbsd,
+			newCbsd(2, someNetwork).at(50.001, 20).prefer(3600).cbsd,
+		},
+		expected: map[int64][]int64{
+			1: {3600, 3560, 3580},
+			2: {3560, 3580, 3600},
+		},
+	}, {
+		name: "Should not consider cbsds from different networks as neighbors",
+		cbsds: []*storage.DBCbsd{
+			newCbsd(1, someNetwork).at(50, 20).prefer(3600).cbsd,
+			newCbsd(2, otherNetwork).at(50, 20).prefer(3600).cbsd,
+		},
+		expected: map[int64][]int64{
+			1: {3600, 3560, 3580},
+			2: {3600, 3560, 3580},
+		},
+	}, {
+		name:      "Should use configured neighbors",
+		neighbors: map[string][]string{"serial2": {"serial1"}},
+		cbsds: []*storage.DBCbsd{
+			newCbsd(1, someNetwork).prefer(3580).cbsd,
+			newCbsd(2, someNetwork).prefer(3580).cbsd,
+		},
+		expected: map[int64][]int64{
+			1: {3580, 3560, 3600},
+			2: {3560, 3600, 3580},
+		},
+	}, {
+		name: "Should plan most constrained cbsd first",
+		cbsds: []*storage.DBCbsd{
+			newCbsd(1, someNetwork).at(50, 20).prefer(3560).cbsd,
+			newCbsd(2, someNetwork).at(50.003, 20).prefer(3560).cbsd,
+			newCbsd(3, someNetwork).at(50.006, 20).prefer(3560).cbsd,
+		},
+		expected: map[int64][]int64{
+			1: {3580, 3600, 3560},
+			2: {3560, 3580, 3600},
+			3: {3580, 3600, 3560},
+		},
+	}, {
+		name: "Should take bandwidth into account when checking overlap",
+		cbsds: []*storage.DBCbsd{
+			newCbsd(1, someNetwork).at(50, 20).prefer(3560).cbsd,
+			newCbsd(2, someNetwork).at(50, 20).bandwidth(10, []uint32{0, 1<<4 | 1<<7}).cbsd,
+		},
+		expected: map[int64][]int64{
+			1: {3560, 3580, 3600},
+			2: {3585, 3570},
+		},
+	}, {
+		name: "Should not plan cbsd without available frequencies",
+		cbsds: []*storage.DBCbsd{
+			newCbsd(1, someNetwork).at(50, 20).bandwidth(20, nil).cbsd,
+			newCbsd(2, someNetwork).at(50, 20).prefer(3560).cbsd,
+		},
+		expected: map[int64][]int64{
+			2: {3560, 3580, 3600},
+		},
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := planner.NewPlanner(reuseDistanceM, tc.neighbors)
+			actual := p.Plan(tc.cbsds)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestGenerateActions(t *testing.T) {
+	cbsds := []*storage.DBCbsd{
+		newCbsd(1, someNetwork).planned(3560, 3580).cbsd,
+		newCbsd(2, someNetwork).planned(3560, 3580).cbsd,
+		newCbsd(3, someNetwork).planned(3560).cbsd,
+		newCbsd(4, someNetwork).cbsd,
+	}
+	plans := map[int64][]int64{
+		1: {3560, 3580},
+		2: {3580, 3560},
+	}
+
+	actual := planner.GenerateActions(cbsds, plans)
+
+	mask := db.NewIncludeMask("planned_frequencies_mhz")
+	expected := []action.Action{
+		&action.UpdateCbsd{
+			Data: &storage.DBCbsd{Id: db.MakeInt(2), PlannedFrequenciesMHz: []int64{3580, 3560}},
+			Mask: mask,
+		},
+		&action.UpdateCbsd{
+			Data: &storage.DBCbsd{Id: db.MakeInt(3)},
+			Mask: mask,
+		},
+	}
+	assert.Equal(t, expected, actual)
+}
+
+func TestApplyPlans(t *testing.T) {
+	cbsds := []*storage.DetailedCbsd{
+		{Cbsd: newCbsd(1, someNetwork).cbsd},
+		{Cbsd: newCbsd(2, someNetwork).planned(3560).cbsd},
+	}
+
+	planner.ApplyPlans(cbsds, map[int64][]int64{1: {3580}})
+
+	assert.Equal(t, []int64{3580}, cbsds[0].Cbsd.PlannedFrequenciesMHz)
+	assert.Nil(t, cbsds[1].Cbsd.PlannedFrequenciesMHz)
+}
+
+type cbsdBuilder struct {
+	cbsd *storage.DBCbsd
+}
+
+func newCbsd(id int64, networkId string) *cbsdBuilder {
+	return &cbsdBuilder{cbsd: &storage.DBCbsd{
+		Id:                    db.MakeInt(id),
+		NetworkId:             db.MakeString(networkId),
+		CbsdSerialNumber:      db.MakeString("serial" + string(rune('0'+id))),
+		PreferredBandwidthMHz: db.MakeInt(20),
+		AvailableFrequencies:  available,
+	}}
+}
+
+func (b *cbsdBuilder) at(lat float64, lon float64) *cbsdBuilder {
+	b.cbsd.LatitudeDeg = db.MakeFloat(lat)
+	b.cbsd.LongitudeDeg = db.MakeFloat(lon)
+	return b
+}
+
+func (b *cbsdBuilder) prefer(frequenciesMHz ...int64) *cbsdBuilder {
+	b.cbsd.PreferredFrequenciesMHz = frequenciesMHz
+	return b
+}
+
+func (b *cbsdBuilder) bandwidth(bandwidthMHz int64, available []uint32) *cbsdBuilder {
+	b.cbsd.PreferredBandwidthMHz = db.MakeInt(bandwidthMHz)
+	b.cbsd.AvailableFrequencies = available
+	return b
+}
+
+func (b *cbsdBuilder) planned(frequenciesMHz ...int64) *cbsdBuilder {
+	b.cbsd.PlannedFrequenciesMHz = frequenciesMHz
+	return b
+}
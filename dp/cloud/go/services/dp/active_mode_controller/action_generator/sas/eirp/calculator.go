@@ -26,6 +26,7 @@ type calculator struct {
 	maxPower    float64
 	antennaGain float64
 	noPorts     float64
+	carriers    int
 }
 
 func NewCalculator(cbsd *storage.DBCbsd) *calculator {
@@ -34,9 +35,19 @@ func NewCalculator(cbsd *storage.DBCbsd) *calculator {
 		maxPower:    cbsd.MaxPower.Float64,
 		antennaGain: cbsd.AntennaGainDbi.Float64,
 		noPorts:     float64(cbsd.NumberOfPorts.Int64),
+		carriers:    1,
 	}
 }
 
+// WithCarriers makes upper bound take into account
+// that max power is shared by given number of carriers.
+func (c *calculator) WithCarriers(carriers int) *calculator {
+	if carriers > 1 {
+		c.carriers = carriers
+	}
+	return c
+}
+
 func (c *calculator) CalcLowerBound(bandwidthHz int) float64 {
 	return math.Ceil(c.calcEirp(c.minPower, bandwidthHz))
 }
@@ -52,7 +63,7 @@ func (c *calculator) calcEirp(power float64, bandwidthHz int) float64 {
 }
 
 func (c *calculator) calcUpperBound(bandwidthHz int) float64 {
-	return math.Floor(c.calcEirp(c.maxPower, bandwidthHz))
+	return math.Floor(c.calcEirp(c.maxPower, bandwidthHz*c.carriers))
 }
 
 const (
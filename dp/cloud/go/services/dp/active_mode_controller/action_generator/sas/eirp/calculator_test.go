@@ -36,6 +36,26 @@ func TestCalcLowerBound(t *testing.T) {
 	assert.Equal(t, 9.0, actual)
 }
 
+func TestCalcUpperBoundWithCarriers(t *testing.T) {
+	cbsd := &storage.DBCbsd{
+		MinPower:       db.MakeFloat(0),
+		MaxPower:       db.MakeFloat(20),
+		AntennaGainDbi: db.MakeFloat(15),
+		NumberOfPorts:  db.MakeInt(2),
+	}
+	channels := []storage.Channel{{
+		LowFrequencyHz:  3550e6,
+		HighFrequencyHz: 3700e6,
+		MaxEirp:         37,
+	}}
+
+	single := eirp.NewCalculator(cbsd).CalcUpperBoundForRange(channels, 3550e6, 3560e6)
+	assert.Equal(t, 28.0, single)
+
+	multiple := eirp.NewCalculator(cbsd).WithCarriers(4).CalcUpperBoundForRange(channels, 3550e6, 3560e6)
+	assert.Equal(t, 21.0, multiple)
+}
+
 func TestCalculateUpperBoundForRange(t *testing.T) {
 	data := []struct {
 		name            string
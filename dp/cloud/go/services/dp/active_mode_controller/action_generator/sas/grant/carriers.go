@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grant
+
+import (
+	"math/bits"
+	"sort"
+
+	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator/sas/eirp"
+	"magma/dp/cloud/go/services/dp/storage"
+)
+
+// usesMultipleCarriers tells if cbsd has carrier aggregation parameters,
+// in which case up to MaxCarriers grants are selected instead of at most two.
+func usesMultipleCarriers(cbsd *storage.DBCbsd) bool {
+	return cbsd.CarrierAggregationEnabled.Bool && cbsd.MaxCarriers.Valid && cbsd.MaxCarriers.Int64 > 0
+}
+
+var carrierBandwidthsHz = []int64{20e6, 15e6, 10e6, 5e6}
+
+type carrierOption struct {
+	bandwidthHz int64
+	count       int
+}
+
+func (c *carrierOption) totalHz() int64 {
+	return c.bandwidthHz * int64(c.count)
+}
+
+// carrierOptions returns combinations of bandwidth and number of carriers
+// allowed for cbsd, from the largest aggregated bandwidth.
+// Bandwidth of existing grants is kept, the same way as with at most two grants.
+func carrierOptions(cbsd *storage.DBCbsd, oldBandwidthHz int64) []*carrierOption {
+	bandwidths := filterCarrierBandwidths(cbsd.PreferredBandwidthMHz.Int64 * 1e6)
+	if oldBandwidthHz != 0 {
+		bandwidths = []int64{oldBandwidthHz}
+	}
+	minTotalHz := cbsd.MinTotalBandwidthMHz.Int64 * 1e6
+	maxTotalHz := cbsd.MaxTotalBandwidthMHz.Int64 * 1e6
+	maxIbwHz := cbsd.MaxIbwMhx.Int64 * 1e6
+	var options []*carrierOption
+	for _, bw := range bandwidths {
+		for n := 1; n <= int(cbsd.MaxCarriers.Int64); n++ {
+			o := &carrierOption{bandwidthHz: bw, count: n}
+			total := o.totalHz()
+			if total > maxIbwHz || (minTotalHz > 0 && total < minTotalHz) || (maxTotalHz > 0 && total > maxTotalHz) {
+				continue
+			}
+			options = append(options, o)
+		}
+	}
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].totalHz() > options[j].totalHz()
+	})
+	return options
+}
+
+func filterCarrierBandwidths(maxBandwidthHz int64) []int64 {
+	var bandwidths []int64
+	for _, bw := range carrierBandwidthsHz {
+		if bw <= maxBandwidthHz {
+			bandwidths = append(bandwidths, bw)
+		}
+	}
+	return bandwidths
+}
+
+func selectCarriers(cbsd *storage.DBCbsd, oldGrants uint32, oldBandwidthHz int64, index int) (int64, uint32) {
+	pref := preferencesToMask(frequencyPreferences(cbsd))
+	for _, o := range carrierOptions(cbsd, oldBandwidthHz) {
+		calc := eirp.NewCalculator(cbsd).WithCarriers(o.count)
+		newGrants := selectCarriersForOption(o, cbsd, oldGrants, pref, calc, index)
+		if newGrants != 0 {
+			return o.bandwidthHz, newGrants
+		}
+	}
+	return 0, 0
+}
+
+type carrierEirpCalculator interface {
+	eirpCalculator
+	CalcUpperBoundForRange(channels []storage.Channel, low int64, high int64) float64
+}
+
+// selectCarriersForOption picks non overlapping channels which fit into max ibw.
+// Channels on which max eirp, with power shared by all carriers of the option,
+// would be below eirp at min power are skipped.
+// Selections keeping the most existing grants are preferred,
+// then ones matching the most (and the most preferred) frequencies.
+func selectCarriersForOption(o *carrierOption, cbsd *storage.DBCbsd, grants uint32, pref []uint32, calc carrierEirpCalculator, index int) uint32 {
+	width := int(o.bandwidthHz / unitToHz)
+	if width > len(cbsd.AvailableFrequencies) {
+		return 0
+	}
+	s := &carrierSearch{
+		width:      width,
+		maxSpan:    int(cbsd.MaxIbwMhx.Int64 * 1e6 / unitToHz),
+		contiguous: cbsd.ContiguousCarriers.Bool,
+		grants:     grants,
+		pref:       pref,
+	}
+	minEirp := calc.CalcLowerBound(int(o.bandwidthHz))
+	candidates := cbsd.AvailableFrequencies[width-1] | grants
+	for candidates != 0 {
+		p := bits.TrailingZeros32(candidates)
+		candidates &= candidates - 1
+		low := maskToHz(1<<p) - o.bandwidthHz/2
+		if calc.CalcUpperBoundForRange(cbsd.Channels, low, low+o.bandwidthHz) < minEirp {
+			continue
+		}
+		s.positions = append(s.positions, p)
+	}
+	s.search(0, o.count, 0, 0)
+	if len(s.ties) == 0 {
+		return 0
+	}
+	return s.ties[index%len(s.ties)]
+}
+
+type carrierSearch struct {
+	positions  []int
+	width      int
+	maxSpan    int
+	contiguous bool
+	grants     uint32
+	pref       []uint32
+
+	bestKept  int
+	bestScore int
+	ties      []uint32
+}
+
+func (s *carrierSearch) search(from int, left int, first int, mask uint32) {
+	if left == 0 {
+		s.consider(mask)
+		return
+	}
+	last := bits.Len32(mask) - 1
+	for i := from; i < len(s.positions); i++ {
+		p := s.positions[i]
+		if mask != 0 {
+			d := p - last
+			if d < s.width {
+				continue
+			}
+			if (s.contiguous && d > s.width) || p-first+s.width > s.maxSpan {
+				break
+			}
+		} else {
+			first = p
+		}
+		s.search(i+1, left-1, first, mask|1<<p)
+	}
+}
+
+func (s *carrierSearch) consider(mask uint32) {
+	kept := bits.OnesCount32(mask & s.grants)
+	score := 0
+	for i, p := range s.pref {
+		if mask&p != 0 {
+			score += len(s.pref) - i
+		}
+	}
+	if len(s.ties) != 0 && (kept < s.bestKept || (kept == s.bestKept && score < s.bestScore)) {
+		return
+	}
+	if len(s.ties) == 0 || kept > s.bestKept || score > s.bestScore {
+		s.bestKept, s.bestScore, s.ties = kept, score, nil
+	}
+	s.ties = append(s.ties, mask)
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator/sas/grant"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+)
+
+func TestSelectGrantsWithCarrierAggregationParams(t *testing.T) {
+	testData := []struct {
+		name             string
+		cbsd             *storage.DBCbsd
+		grants           []*storage.DBGrant
+		expected         []grantData
+		expectedCarriers int
+	}{{
+		name:             "Should select up to max carriers",
+		cbsd:             newCarriersCbsd(3, allAvailable),
+		expected:         addGrants(20e6, 3560e6, 3580e6, 3600e6),
+		expectedCarriers: 3,
+	}, {
+		name: "Should limit total bandwidth",
+		cbsd: withCbsd(newCarriersCbsd(4, allAvailable), func(c *storage.DBCbsd) {
+			c.MaxTotalBandwidthMHz = db.MakeInt(40)
+		}),
+		expected:         addGrants(20e6, 3560e6, 3580e6),
+		expectedCarriers: 2,
+	}, {
+		name: "Should select fewer carriers when shared power is too low",
+		cbsd: withCbsd(newCarriersCbsd(3, allAvailable), func(c *storage.DBCbsd) {
+			c.MinPower = db.MakeFloat(0)
+			c.MaxPower = db.MakeFloat(4)
+			c.NumberOfPorts = db.MakeInt(1)
+			c.Channels = []storage.Channel{{
+				LowFrequencyHz:  3550e6,
+				HighFrequencyHz: 3700e6,
+				MaxEirp:         37,
+			}}
+		}),
+		expected:         addGrants(20e6, 3560e6, 3580e6),
+		expectedCarriers: 2,
+	}, {
+		name: "Should not select grants below min total bandwidth",
+		cbsd: withCbsd(newCarriersCbsd(2, []uint32{0, 0, 0, 1 << 10}), func(c *storage.DBCbsd) {
+			c.MinTotalBandwidthMHz = db.MakeInt(40)
+		}),
+		expected:         []grantData{},
+		expectedCarriers: 0,
+	}, {
+		name: "Should select only adjacent channels in contiguous mode",
+		cbsd: withCbsd(newCarriersCbsd(2, []uint32{0, 1<<1 | 1<<5 | 1<<7, 0, 0}), func(c *storage.DBCbsd) {
+			c.PreferredBandwidthMHz = db.MakeInt(10)
+			c.ContiguousCarriers = db.MakeBool(true)
+		}),
+		expected:         addGrants(10e6, 3575e6, 3585e6),
+		expectedCarriers: 2,
+	}, {
+		name: "Should fit carriers into max ibw",
+		cbsd: withCbsd(newCarriersCbsd(3, []uint32{0, 0, 0, 1<<2 | 1<<10 | 1<<14}), func(c *storage.DBCbsd) {
+			c.MaxIbwMhx = db.MakeInt(40)
+		}),
+		expected:         addGrants(20e6, 3600e6, 3620e6),
+		expectedCarriers: 2,
+	}, {
+		name: "Should keep existing grants",
+		cbsd: newCarriersCbsd(3, allAvailable),
+		grants: []*storage.DBGrant{{
+			LowFrequencyHz:  db.MakeInt(3590e6),
+			HighFrequencyHz: db.MakeInt(3610e6),
+		}},
+		expected: append(
+			addGrants(20e6, 3560e6, 3580e6),
+			grantData{action: keep, frequency: 3600e6, bandwidth: 20e6},
+		),
+		expectedCarriers: 3,
+	}, {
+		name: "Should follow frequency preferences",
+		cbsd: withCbsd(newCarriersCbsd(2, allAvailable), func(c *storage.DBCbsd) {
+			c.PreferredFrequenciesMHz = []int64{3620, 3600}
+		}),
+		expected:         addGrants(20e6, 3600e6, 3620e6),
+		expectedCarriers: 2,
+	}, {
+		name: "Should ignore carrier aggregation params when carrier aggregation is disabled",
+		cbsd: withCbsd(newCarriersCbsd(3, allAvailable), func(c *storage.DBCbsd) {
+			c.CarrierAggregationEnabled = db.MakeBool(false)
+		}),
+		expected:         addGrants(20e6, 3560e6, 3580e6),
+		expectedCarriers: 1,
+	}}
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			p := grant.Processors[grantData]{
+				Keep: &stubGrantProcessor{action: keep},
+				Del:  &stubGrantProcessor{action: del},
+				Add:  &stubGrantProcessor{action: add},
+			}
+			selection := grant.SelectGrants(tt.cbsd, tt.grants, 0)
+			actual := grant.ProcessSelection[grantData](selection, p)
+			assert.Equal(t, tt.expected, actual)
+			assert.Equal(t, tt.expectedCarriers, selection.Carriers)
+		})
+	}
+}
+
+func newCarriersCbsd(maxCarriers int64, available []uint32) *storage.DBCbsd {
+	return &storage.DBCbsd{
+		GrantRedundancy:           db.MakeBool(true),
+		CarrierAggregationEnabled: db.MakeBool(true),
+		PreferredBandwidthMHz:     db.MakeInt(20),
+		MaxIbwMhx:                 db.MakeInt(150),
+		AvailableFrequencies:      available,
+		MaxCarriers:               db.MakeInt(maxCarriers),
+	}
+}
+
+func withCbsd(cbsd *storage.DBCbsd, f func(*storage.DBCbsd)) *storage.DBCbsd {
+	f(cbsd)
+	return cbsd
+}
+
+func addGrants(bandwidthHz int64, frequenciesHz ...int64) []grantData {
+	grants := make([]grantData, len(frequenciesHz))
+	for i, f := range frequenciesHz {
+		grants[i] = grantData{action: add, frequency: f, bandwidth: bandwidthHz}
+	}
+	return grants
+}
@@ -35,9 +35,40 @@ type Processors[T any] struct {
 }
 
 func ProcessGrants[T any](cbsd *storage.DBCbsd, grants []*storage.DBGrant, processors Processors[T], index int) []T {
+	return ProcessSelection(SelectGrants(cbsd, grants, index), processors)
+}
+
+// Selection describes grants which should be kept, removed or requested for cbsd.
+type Selection struct {
+	BandwidthHz int64
+	OldGrants   uint32
+	NewGrants   uint32
+	// Carriers is number of carriers sharing cbsd power
+	Carriers int
+}
+
+func SelectGrants(cbsd *storage.DBCbsd, grants []*storage.DBGrant, index int) *Selection {
 	oldBw, oldGrants := calculateOldGrants(grants)
+	if usesMultipleCarriers(cbsd) {
+		bw, newGrants := selectCarriers(cbsd, oldGrants, oldBw, index)
+		return &Selection{
+			BandwidthHz: bw,
+			OldGrants:   oldGrants,
+			NewGrants:   newGrants,
+			Carriers:    bits.OnesCount32(newGrants),
+		}
+	}
 	bw, newGrants := selectGrants(cbsd, oldGrants, oldBw, index)
-	return processGrants(processors, oldGrants, newGrants, bw)
+	return &Selection{
+		BandwidthHz: bw,
+		OldGrants:   oldGrants,
+		NewGrants:   newGrants,
+		Carriers:    1,
+	}
+}
+
+func ProcessSelection[T any](selection *Selection, processors Processors[T]) []T {
+	return processGrants(processors, selection.OldGrants, selection.NewGrants, selection.BandwidthHz)
 }
 
 func calculateOldGrants(grants []*storage.DBGrant) (int64, uint32) {
@@ -59,7 +90,7 @@ func hzToMask(hz int64) uint32 {
 const unitToHz = 5e6
 
 func selectGrants(cbsd *storage.DBCbsd, oldGrants uint32, oldBandwidthHz int64, index int) (int64, uint32) {
-	prefMask := preferencesToMask(cbsd.PreferredFrequenciesMHz)
+	prefMask := preferencesToMask(frequencyPreferences(cbsd))
 	order := PickBandwidthSelectionOrder(cbsd, cbsd.PreferredBandwidthMHz.Int64*1e6, oldBandwidthHz)
 	for _, o := range order {
 		newGrants := selectGrantsForBandwidth(o, cbsd, oldGrants, prefMask, index)
@@ -70,6 +101,15 @@ func selectGrants(cbsd *storage.DBCbsd, oldGrants uint32, oldBandwidthHz int64,
 	return 0, 0
 }
 
+// frequencyPreferences returns preferences computed by network channel planner
+// if there are any, otherwise falls back to ones configured for cbsd.
+func frequencyPreferences(cbsd *storage.DBCbsd) []int64 {
+	if len(cbsd.PlannedFrequenciesMHz) != 0 {
+		return cbsd.PlannedFrequenciesMHz
+	}
+	return cbsd.PreferredFrequenciesMHz
+}
+
 func preferencesToMask(frequenciesMHz []int64) []uint32 {
 	masks := make([]uint32, len(frequenciesMHz))
 	for i, f := range frequenciesMHz {
@@ -123,6 +123,21 @@ func TestProcessGrants(t *testing.T) {
 			frequency: 3585e6,
 			bandwidth: 15e6,
 		}},
+	}, {
+		name: "Should prefer planned frequencies over configured ones",
+		cbsd: &storage.DBCbsd{
+			PreferredBandwidthMHz:   db.MakeInt(15),
+			PreferredFrequenciesMHz: []int64{3570},
+			PlannedFrequenciesMHz:   []int64{3630, 3570},
+			MaxIbwMhx:               db.MakeInt(150),
+			AvailableFrequencies:    allAvailable,
+		},
+		grants: nil,
+		expected: []grantData{{
+			action:    add,
+			frequency: 3630e6,
+			bandwidth: 15e6,
+		}},
 	}, {
 		name: "Should add only one grant if only available in standard redundancy",
 		cbsd: &storage.DBCbsd{
@@ -29,6 +29,11 @@ func (*RegistrationRequestGenerator) GenerateRequests(cbsd *storage.DetailedCbsd
 }
 
 func buildRegistrationRequest(cbsd *storage.DBCbsd) *RegistrationRequest {
+	if cbsd.CpiSignatureData != nil {
+		req := buildSingleStepRegistrationRequest(cbsd)
+		req.CpiSignatureData = cbsd.CpiSignatureData
+		return req
+	}
 	if !cbsd.SingleStepEnabled.Bool {
 		return &RegistrationRequest{
 			UserId:           cbsd.UserId.String,
@@ -36,6 +41,10 @@ func buildRegistrationRequest(cbsd *storage.DBCbsd) *RegistrationRequest {
 			CbsdSerialNumber: cbsd.CbsdSerialNumber.String,
 		}
 	}
+	return buildSingleStepRegistrationRequest(cbsd)
+}
+
+func buildSingleStepRegistrationRequest(cbsd *storage.DBCbsd) *RegistrationRequest {
 	return &RegistrationRequest{
 		UserId:           cbsd.UserId.String,
 		FccId:            cbsd.FccId.String,
@@ -55,13 +64,14 @@ func buildRegistrationRequest(cbsd *storage.DBCbsd) *RegistrationRequest {
 }
 
 type RegistrationRequest struct {
-	UserId            string             `json:"userId"`
-	FccId             string             `json:"fccId"`
-	CbsdSerialNumber  string             `json:"cbsdSerialNumber"`
-	CbsdCategory      string             `json:"cbsdCategory,omitempty"`
-	AirInterface      *AirInterface      `json:"airInterface,omitempty"`
-	InstallationParam *InstallationParam `json:"installationParam,omitempty"`
-	MeasCapability    json.RawMessage    `json:"measCapability,omitempty"`
+	UserId            string                    `json:"userId"`
+	FccId             string                    `json:"fccId"`
+	CbsdSerialNumber  string                    `json:"cbsdSerialNumber"`
+	CbsdCategory      string                    `json:"cbsdCategory,omitempty"`
+	AirInterface      *AirInterface             `json:"airInterface,omitempty"`
+	InstallationParam *InstallationParam        `json:"installationParam,omitempty"`
+	MeasCapability    json.RawMessage           `json:"measCapability,omitempty"`
+	CpiSignatureData  *storage.CpiSignatureData `json:"cpiSignatureData,omitempty"`
 }
 
 type AirInterface struct {
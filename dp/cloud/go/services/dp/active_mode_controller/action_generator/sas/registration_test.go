@@ -74,6 +74,48 @@ func TestRegistrationRequestGenerator(t *testing.T) {
 		"antennaGain": 15
 	},
 	"measCapability": []
+}`,
+	}, {
+		name: "Should generate registration request with cpi signature",
+		cbsd: &storage.DBCbsd{
+			CbsdCategory:     db.MakeString("b"),
+			CbsdSerialNumber: db.MakeString("some_serial_number"),
+			FccId:            db.MakeString("some_fcc_id"),
+			UserId:           db.MakeString("some_user_id"),
+			LatitudeDeg:      db.MakeFloat(12),
+			LongitudeDeg:     db.MakeFloat(34),
+			HeightM:          db.MakeFloat(5),
+			HeightType:       db.MakeString("agl"),
+			IndoorDeployment: db.MakeBool(false),
+			AntennaGainDbi:   db.MakeFloat(15),
+			CpiSignatureData: &storage.CpiSignatureData{
+				ProtectedHeader:      "some_header",
+				EncodedCpiSignedData: "some_data",
+				DigitalSignature:     "some_signature",
+			},
+		},
+		expected: `{
+	"userId": "some_user_id",
+	"fccId": "some_fcc_id",
+	"cbsdSerialNumber": "some_serial_number",
+	"cbsdCategory": "B",
+	"airInterface": {
+		"radioTechnology": "E_UTRA"
+	},
+	"installationParam": {
+		"latitude": 12,
+		"longitude": 34,
+		"height": 5,
+		"heightType": "AGL",
+		"indoorDeployment": false,
+		"antennaGain": 15
+	},
+	"measCapability": [],
+	"cpiSignatureData": {
+		"protectedHeader": "some_header",
+		"encodedCpiSignedData": "some_data",
+		"digitalSignature": "some_signature"
+	}
 }`,
 	}}
 	g := &sas.RegistrationRequestGenerator{}
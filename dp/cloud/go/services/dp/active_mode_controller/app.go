@@ -21,6 +21,9 @@ import (
 	"github.com/golang/glog"
 
 	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator"
+	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator/action"
+	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator/planner"
+	"magma/dp/cloud/go/services/dp/metrics"
 	"magma/dp/cloud/go/services/dp/storage"
 )
 
@@ -32,6 +35,7 @@ type App struct {
 	pollingInterval       time.Duration
 	cbsdInactivityTimeout time.Duration
 	amcManager            storage.AmcManager
+	planner               *planner.Planner
 }
 
 func NewApp(options ...Option) *App {
@@ -77,6 +81,12 @@ func WithCbsdInactivityTimeout(timeout time.Duration) Option {
 	return func(a *App) { a.cbsdInactivityTimeout = timeout }
 }
 
+// WithChannelPlanner enables network level channel planning,
+// without it previously stored plans are removed.
+func WithChannelPlanner(p *planner.Planner) Option {
+	return func(a *App) { a.planner = p }
+}
+
 func (a *App) Run(ctx context.Context) error {
 	ticker := a.clock.Tick(a.pollingInterval)
 	defer ticker.Stop()
@@ -85,8 +95,11 @@ func (a *App) Run(ctx context.Context) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-ticker.C:
+			start := a.clock.Now()
 			_, err := storage.WithinTx(a.db, a.getStateAndProcessData)
+			metrics.AmcLoopDuration.Observe(a.clock.Now().Sub(start).Seconds())
 			if err != nil {
+				metrics.AmcLoopErrors.Inc()
 				glog.Errorf("failed to process data: %s", err)
 			}
 		}
@@ -104,8 +117,12 @@ func (a *App) getStateAndProcessData(tx *sql.Tx) (any, error) {
 		InactivityTimeout: a.cbsdInactivityTimeout,
 		Rng:               a.rng,
 	}
+	actions, err := a.planChannels(tx, state)
+	if err != nil {
+		return nil, err
+	}
 	now := a.clock.Now()
-	actions := generator.GenerateActions(state, now)
+	actions = append(actions, generator.GenerateActions(state, now)...)
 	for _, act := range actions {
 		if err := act.Do(tx, a.amcManager); err != nil {
 			return nil, err
@@ -113,3 +130,22 @@ func (a *App) getStateAndProcessData(tx *sql.Tx) (any, error) {
 	}
 	return nil, nil
 }
+
+func (a *App) planChannels(tx *sql.Tx, state []*storage.DetailedCbsd) ([]action.Action, error) {
+	if a.planner == nil {
+		cbsds := make([]*storage.DBCbsd, len(state))
+		for i, c := range state {
+			cbsds[i] = c.Cbsd
+		}
+		actions := planner.GenerateActions(cbsds, nil)
+		planner.ApplyPlans(state, nil)
+		return actions, nil
+	}
+	cbsds, err := a.amcManager.GetPlanningState(tx)
+	if err != nil {
+		return nil, err
+	}
+	plans := a.planner.Plan(cbsds)
+	planner.ApplyPlans(state, plans)
+	return planner.GenerateActions(cbsds, plans), nil
+}
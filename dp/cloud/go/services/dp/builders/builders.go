@@ -151,6 +151,11 @@ func (b *DBCbsdBuilder) WithAvailableFrequencies(frequenciesMhz []uint32) *DBCbs
 	return b
 }
 
+func (b *DBCbsdBuilder) WithPlannedFrequencies(frequenciesMhz []int64) *DBCbsdBuilder {
+	b.Cbsd.PlannedFrequenciesMHz = frequenciesMhz
+	return b
+}
+
 func (b *DBCbsdBuilder) WithSerialNumber(serial string) *DBCbsdBuilder {
 	b.Cbsd.CbsdSerialNumber = db.MakeString(serial)
 	return b
@@ -207,6 +212,14 @@ func (b *DBCbsdBuilder) WithMaxIbwMhx(ibw int64) *DBCbsdBuilder {
 	return b
 }
 
+func (b *DBCbsdBuilder) WithCarrierAggregationParams(maxCarriers int64, minTotalBandwidthMhz int64, maxTotalBandwidthMhz int64, contiguous bool) *DBCbsdBuilder {
+	b.Cbsd.MaxCarriers = db.MakeInt(maxCarriers)
+	b.Cbsd.MinTotalBandwidthMHz = db.MakeInt(minTotalBandwidthMhz)
+	b.Cbsd.MaxTotalBandwidthMHz = db.MakeInt(maxTotalBandwidthMhz)
+	b.Cbsd.ContiguousCarriers = db.MakeBool(contiguous)
+	return b
+}
+
 func (b *DBCbsdBuilder) WithShouldDeregister(should bool) *DBCbsdBuilder {
 	b.Cbsd.ShouldDeregister = db.MakeBool(should)
 	return b
@@ -222,6 +235,13 @@ func (b *DBCbsdBuilder) WithCbsdCategory(cat string) *DBCbsdBuilder {
 	return b
 }
 
+func (b *DBCbsdBuilder) WithCpiSignature(cpiId string, certificationTime int64, data *storage.CpiSignatureData) *DBCbsdBuilder {
+	b.Cbsd.CpiId = db.MakeString(cpiId)
+	b.Cbsd.InstallCertificationTime = db.MakeTime(time.Unix(certificationTime, 0).UTC())
+	b.Cbsd.CpiSignatureData = data
+	return b
+}
+
 type DBGrantBuilder struct {
 	Grant *storage.DBGrant
 }
@@ -360,6 +380,11 @@ func (b *CbsdProtoPayloadBuilder) WithCbsdCategory(c string) *CbsdProtoPayloadBu
 	return b
 }
 
+func (b *CbsdProtoPayloadBuilder) WithCarrierAggregationParams(params *protos.CarrierAggregationParams) *CbsdProtoPayloadBuilder {
+	b.Payload.CarrierAggregationParams = params
+	return b
+}
+
 func (b *CbsdProtoPayloadBuilder) WithEmptyInstallationParam() *CbsdProtoPayloadBuilder {
 	b.Payload.InstallationParam = &protos.InstallationParam{}
 	return b
@@ -551,6 +576,7 @@ func NewCbsdModelPayloadBuilder() *CbsdModelPayloadBuilder {
 		State:                     registered,
 		CarrierAggregationEnabled: false,
 		GrantRedundancy:           true,
+		PlannedFrequenciesMhz:     []int64{},
 	}}
 }
 
@@ -687,6 +713,11 @@ func (b *MutableCbsdModelBuilder) WithGrantRedundancy(enabled *bool) *MutableCbs
 	return b
 }
 
+func (b *MutableCbsdModelBuilder) WithCarrierAggregationParams(params *models.CarrierAggregationParams) *MutableCbsdModelBuilder {
+	b.Payload.CarrierAggregationParams = params
+	return b
+}
+
 func (b *MutableCbsdModelBuilder) WithMinPower(power *float64) *MutableCbsdModelBuilder {
 	if power == nil {
 		b.Payload.Capabilities.MinPower = nil
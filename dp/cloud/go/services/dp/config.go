@@ -15,22 +15,66 @@ package dp
 
 type Config struct {
 	// TODO cleanup config (common fields, separate packages, etc...)
-	DpBackend            *BackendConfig `yaml:"dp_backend"`
-	ActiveModeController *AmcConfig     `yaml:"active_mode_controller"`
+	DpBackend            *BackendConfig   `yaml:"dp_backend"`
+	ActiveModeController *AmcConfig       `yaml:"active_mode_controller"`
+	SasClient            *SasClientConfig `yaml:"sas_client"`
+	Metrics              *MetricsConfig   `yaml:"metrics"`
 }
 
 type BackendConfig struct {
-	CbsdInactivityIntervalSec int    `yaml:"cbsd_inactivity_interval_sec"`
-	LogConsumerUrl            string `yaml:"log_consumer_url"`
+	CbsdInactivityIntervalSec int             `yaml:"cbsd_inactivity_interval_sec"`
+	LogConsumerUrl            string          `yaml:"log_consumer_url"`
+	LogStore                  *LogStoreConfig `yaml:"log_store"`
+}
+
+const (
+	ElasticsearchLogBackend = "elasticsearch"
+	DatabaseLogBackend      = "database"
+)
+
+type LogStoreConfig struct {
+	// Backend is either "elasticsearch" (logs are pushed to log consumer)
+	// or "database" (logs are stored in and served from dp database).
+	// It is recorded in dp database, python components follow it.
+	Backend            string `yaml:"backend"`
+	RetentionDays      int    `yaml:"retention_days"`
+	CleanupIntervalSec int    `yaml:"cleanup_interval_sec"`
 }
 
 type AmcConfig struct {
-	DialTimeoutSec               int    `yaml:"dial_timeout_sec"`
-	HeartbeatSendTimeoutSec      int    `yaml:"heartbeat_send_timeout_sec"`
+	DialTimeoutSec               int                   `yaml:"dial_timeout_sec"`
+	HeartbeatSendTimeoutSec      int                   `yaml:"heartbeat_send_timeout_sec"`
+	RequestTimeoutSec            int                   `yaml:"request_timeout_sec"`
+	RequestProcessingIntervalSec int                   `yaml:"request_processing_interval_sec"`
+	PollingIntervalSec           int                   `yaml:"polling_interval"` // TODO add sec to deployment scripts
+	GrpcService                  string                `yaml:"grpc_service"`
+	GrpcPort                     int                   `yaml:"grpc_port"`
+	CbsdInactivityTimeoutSec     int                   `yaml:"cbsd_inactivity_interval_sec"` // TODO temporary fix to make integration tests pass
+	ChannelPlanner               *ChannelPlannerConfig `yaml:"channel_planner"`
+}
+
+type ChannelPlannerConfig struct {
+	Enabled        bool    `yaml:"enabled"`
+	ReuseDistanceM float64 `yaml:"reuse_distance_m"`
+	// Neighbors maps cbsd serial number to serial numbers of its neighbors
+	Neighbors map[string][]string `yaml:"neighbors"`
+}
+
+type SasClientConfig struct {
+	Enabled                      bool   `yaml:"enabled"`
+	SasUrl                       string `yaml:"sas_url"`
+	CertPath                     string `yaml:"cert_path"`
+	KeyPath                      string `yaml:"key_path"`
+	SasCertPath                  string `yaml:"sas_cert_path"`
 	RequestTimeoutSec            int    `yaml:"request_timeout_sec"`
 	RequestProcessingIntervalSec int    `yaml:"request_processing_interval_sec"`
-	PollingIntervalSec           int    `yaml:"polling_interval"` // TODO add sec to deployment scripts
-	GrpcService                  string `yaml:"grpc_service"`
-	GrpcPort                     int    `yaml:"grpc_port"`
-	CbsdInactivityTimeoutSec     int    `yaml:"cbsd_inactivity_interval_sec"` // TODO temporary fix to make integration tests pass
+	RequestProcessingLimit       int    `yaml:"request_processing_limit"`
+	MaxRetries                   int    `yaml:"max_retries"`
+	InitialBackoffMs             int    `yaml:"initial_backoff_ms"`
+	MaxBackoffMs                 int    `yaml:"max_backoff_ms"`
+}
+
+type MetricsConfig struct {
+	Enabled               bool `yaml:"enabled"`
+	CollectionIntervalSec int  `yaml:"collection_interval_sec"`
 }
@@ -0,0 +1,243 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cpi implements signing of cbsd installation parameters
+// by Certified Professional Installer as described in WINNF-TS-0016 8.4.1.
+package cpi
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"magma/dp/cloud/go/services/dp/storage"
+)
+
+const (
+	rsaKeySize = 2048
+	timeFormat = "2006-01-02T15:04:05Z"
+)
+
+// GenerateKey creates new RSA private key encoded as PKCS#8 PEM.
+func GenerateKey() (string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
+	if err != nil {
+		return "", err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// ParsePrivateKey decodes PEM encoded RSA or EC private key
+// in either PKCS#1, SEC 1 or PKCS#8 format.
+func ParsePrivateKey(privateKey string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, errors.New("private key is not pem encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		return k, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// PublicKey returns PEM encoded public part of given private key,
+// so that signatures made by cpi can be verified.
+func PublicKey(privateKey string) (string, error) {
+	key, err := ParsePrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// Sign creates cpiSignatureData for installation parameters of cbsd.
+// Cpi id and certification time are taken from cbsd.
+// It can be used as storage.SignFunc.
+func Sign(cbsd *storage.DBCbsd, cpi *storage.DBCpi) (*storage.CpiSignatureData, error) {
+	key, err := ParsePrivateKey(cpi.PrivateKey.String)
+	if err != nil {
+		return nil, err
+	}
+	alg, err := algorithm(key)
+	if err != nil {
+		return nil, err
+	}
+	header, err := json.Marshal(&protectedHeader{Typ: "JWT", Alg: alg})
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(NewSignedData(cbsd, cpi))
+	if err != nil {
+		return nil, err
+	}
+	encodedHeader := encode(header)
+	encodedData := encode(data)
+	signature, err := sign(key, encodedHeader+"."+encodedData)
+	if err != nil {
+		return nil, err
+	}
+	return &storage.CpiSignatureData{
+		ProtectedHeader:      encodedHeader,
+		EncodedCpiSignedData: encodedData,
+		DigitalSignature:     encode(signature),
+	}, nil
+}
+
+// Verify checks signature of cpiSignatureData against PEM encoded public key.
+func Verify(data *storage.CpiSignatureData, publicKey string) error {
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return errors.New("public key is not pem encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(data.DigitalSignature)
+	if err != nil {
+		return err
+	}
+	digest := sha256.Sum256([]byte(data.ProtectedHeader + "." + data.EncodedCpiSignedData))
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature)
+	case *ecdsa.PublicKey:
+		size := len(signature) / 2
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(k, digest[:], r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+type protectedHeader struct {
+	Typ string `json:"typ"`
+	Alg string `json:"alg"`
+}
+
+type SignedData struct {
+	FccId                     string                     `json:"fccId"`
+	CbsdSerialNumber          string                     `json:"cbsdSerialNumber"`
+	InstallationParam         *InstallationParam         `json:"installationParam"`
+	ProfessionalInstallerData *ProfessionalInstallerData `json:"professionalInstallerData"`
+}
+
+type InstallationParam struct {
+	Latitude         float64 `json:"latitude"`
+	Longitude        float64 `json:"longitude"`
+	Height           float64 `json:"height"`
+	HeightType       string  `json:"heightType"`
+	IndoorDeployment bool    `json:"indoorDeployment"`
+	AntennaGain      float64 `json:"antennaGain"`
+}
+
+type ProfessionalInstallerData struct {
+	CpiId                    string `json:"cpiId"`
+	CpiName                  string `json:"cpiName"`
+	InstallCertificationTime string `json:"installCertificationTime"`
+}
+
+// NewSignedData builds data which is attested by cpi signature.
+func NewSignedData(cbsd *storage.DBCbsd, cpi *storage.DBCpi) *SignedData {
+	return &SignedData{
+		FccId:            cbsd.FccId.String,
+		CbsdSerialNumber: cbsd.CbsdSerialNumber.String,
+		InstallationParam: &InstallationParam{
+			Latitude:         cbsd.LatitudeDeg.Float64,
+			Longitude:        cbsd.LongitudeDeg.Float64,
+			Height:           cbsd.HeightM.Float64,
+			HeightType:       strings.ToUpper(cbsd.HeightType.String),
+			IndoorDeployment: cbsd.IndoorDeployment.Bool,
+			AntennaGain:      cbsd.AntennaGainDbi.Float64,
+		},
+		ProfessionalInstallerData: &ProfessionalInstallerData{
+			CpiId:                    cpi.CpiId.String,
+			CpiName:                  cpi.CpiName.String,
+			InstallCertificationTime: cbsd.InstallCertificationTime.Time.UTC().Format(timeFormat),
+		},
+	}
+}
+
+func algorithm(key crypto.Signer) (string, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return "RS256", nil
+	case *ecdsa.PrivateKey:
+		if k.Curve.Params().BitSize != 256 {
+			return "", fmt.Errorf("unsupported ec curve %s", k.Curve.Params().Name)
+		}
+		return "ES256", nil
+	default:
+		return "", fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+func sign(key crypto.Signer, input string) ([]byte, error) {
+	digest := sha256.Sum256([]byte(input))
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			return nil, err
+		}
+		// JWS requires fixed size concatenation of r and s (RFC 7518 3.4)
+		signature := make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+		return signature, nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cpi_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/dp/cloud/go/services/dp/cpi"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+)
+
+func TestSignWithRsaKey(t *testing.T) {
+	key, err := cpi.GenerateKey()
+	require.NoError(t, err)
+
+	actual, err := cpi.Sign(getCbsd(), getCpi(key))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"typ":"JWT","alg":"RS256"}`, decode(t, actual.ProtectedHeader))
+	assert.JSONEq(t, expectedSignedData, decode(t, actual.EncodedCpiSignedData))
+	publicKey, err := cpi.PublicKey(key)
+	require.NoError(t, err)
+	assert.NoError(t, cpi.Verify(actual, publicKey))
+}
+
+func TestSignWithEcKey(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+	key := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+
+	actual, err := cpi.Sign(getCbsd(), getCpi(key))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"typ":"JWT","alg":"ES256"}`, decode(t, actual.ProtectedHeader))
+	assert.JSONEq(t, expectedSignedData, decode(t, actual.EncodedCpiSignedData))
+	publicKey, err := cpi.PublicKey(key)
+	require.NoError(t, err)
+	assert.NoError(t, cpi.Verify(actual, publicKey))
+}
+
+func TestVerifyFailsForTamperedData(t *testing.T) {
+	key, err := cpi.GenerateKey()
+	require.NoError(t, err)
+	actual, err := cpi.Sign(getCbsd(), getCpi(key))
+	require.NoError(t, err)
+
+	other := getCbsd()
+	other.HeightM = db.MakeFloat(100)
+	tampered, err := cpi.Sign(other, getCpi(key))
+	require.NoError(t, err)
+	actual.EncodedCpiSignedData = tampered.EncodedCpiSignedData
+
+	publicKey, err := cpi.PublicKey(key)
+	require.NoError(t, err)
+	assert.Error(t, cpi.Verify(actual, publicKey))
+}
+
+func TestSignWithInvalidKey(t *testing.T) {
+	_, err := cpi.Sign(getCbsd(), getCpi("invalid key"))
+	assert.Error(t, err)
+}
+
+const expectedSignedData = `{
+	"fccId": "some_fcc_id",
+	"cbsdSerialNumber": "some_serial_number",
+	"installationParam": {
+		"latitude": 12,
+		"longitude": 34,
+		"height": 5,
+		"heightType": "AGL",
+		"indoorDeployment": true,
+		"antennaGain": 15
+	},
+	"professionalInstallerData": {
+		"cpiId": "some_cpi_id",
+		"cpiName": "some cpi name",
+		"installCertificationTime": "2022-09-27T10:00:00Z"
+	}
+}`
+
+func getCbsd() *storage.DBCbsd {
+	return &storage.DBCbsd{
+		FccId:                    db.MakeString("some_fcc_id"),
+		CbsdSerialNumber:         db.MakeString("some_serial_number"),
+		LatitudeDeg:              db.MakeFloat(12),
+		LongitudeDeg:             db.MakeFloat(34),
+		HeightM:                  db.MakeFloat(5),
+		HeightType:               db.MakeString("agl"),
+		IndoorDeployment:         db.MakeBool(true),
+		AntennaGainDbi:           db.MakeFloat(15),
+		CpiId:                    db.MakeString("some_cpi_id"),
+		InstallCertificationTime: db.MakeTime(time.Date(2022, 9, 27, 10, 0, 0, 0, time.UTC)),
+	}
+}
+
+func getCpi(key string) *storage.DBCpi {
+	return &storage.DBCpi{
+		CpiId:      db.MakeString("some_cpi_id"),
+		CpiName:    db.MakeString("some cpi name"),
+		PrivateKey: db.MakeString(key),
+	}
+}
+
+func decode(t *testing.T, s string) string {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	require.NoError(t, err)
+	return string(b)
+}
@@ -24,10 +24,13 @@ import (
 	"magma/dp/cloud/go/protos"
 	dp_service "magma/dp/cloud/go/services/dp"
 	"magma/dp/cloud/go/services/dp/active_mode_controller"
+	"magma/dp/cloud/go/services/dp/active_mode_controller/action_generator/planner"
 	amc_time "magma/dp/cloud/go/services/dp/active_mode_controller/time"
 	"magma/dp/cloud/go/services/dp/logs_pusher"
+	"magma/dp/cloud/go/services/dp/metrics"
 	"magma/dp/cloud/go/services/dp/obsidian/cbsd"
 	dp_log "magma/dp/cloud/go/services/dp/obsidian/log"
+	"magma/dp/cloud/go/services/dp/sas_client"
 	"magma/dp/cloud/go/services/dp/servicers"
 	dp_storage "magma/dp/cloud/go/services/dp/storage"
 	"magma/orc8r/cloud/go/service"
@@ -45,7 +48,6 @@ func main() {
 		glog.Fatalf("Error creating %s service: %s", dp_service.ServiceName, err)
 	}
 	obsidian.AttachHandlers(srv.EchoServer, cbsd.GetHandlers())
-	obsidian.AttachHandlers(srv.EchoServer, dp_log.NewHandlersGetter(dp_log.GetElasticClient, "").GetHandlers())
 	swagger_protos.RegisterSwaggerSpecServer(srv.ProtectedGrpcServer, swagger_servicers.NewSpecServicerFromFile(dp_service.ServiceName))
 
 	var serviceConfig dp_service.Config
@@ -61,23 +63,48 @@ func main() {
 	interval := time.Second * time.Duration(dpCfg.CbsdInactivityIntervalSec)
 	logConsumerUrl := dpCfg.LogConsumerUrl
 
-	protos.RegisterCbsdManagementServer(srv.GrpcServer, servicers.NewCbsdManager(cbsdStore, interval, logConsumerUrl, logs_pusher.PushDPLog))
+	logStore := dp_storage.NewLogManager(db, sqorc.GetSqlBuilder(), sqorc.GetErrorChecker(), sqorc.GetSqlLocker())
+	logBackend := getLogBackend(dpCfg.LogStore)
+	if err := logStore.SetBackend(logBackend); err != nil {
+		glog.Fatalf("Error storing log backend: %s", err)
+	}
+	logPusher := logs_pusher.LogPusher(logs_pusher.PushDPLog)
+	if logBackend == dp_service.DatabaseLogBackend {
+		logPusher = logs_pusher.NewDbLogPusher(logStore)
+		obsidian.AttachHandlers(srv.EchoServer, dp_log.NewDbHandlersGetter(logStore).GetHandlers())
+		retentionCancel, retentionErrs := startLogRetention(logStore, dpCfg.LogStore)
+		defer stopApp("log retention", retentionCancel, retentionErrs)
+	} else {
+		obsidian.AttachHandlers(srv.EchoServer, dp_log.NewHandlersGetter(dp_log.GetElasticClient, "").GetHandlers())
+	}
+
+	protos.RegisterCbsdManagementServer(srv.GrpcServer, servicers.NewCbsdManager(cbsdStore, interval, logConsumerUrl, logPusher))
+	cpiStore := dp_storage.NewCpiManager(db, sqorc.GetSqlBuilder(), sqorc.GetErrorChecker(), sqorc.GetSqlLocker())
+	protos.RegisterCpiManagementServer(srv.GrpcServer, servicers.NewCpiManager(cpiStore))
 
 	cancel, errs := startAmc(db, serviceConfig.ActiveModeController)
+	if cfg := serviceConfig.SasClient; cfg != nil && cfg.Enabled {
+		sasCancel, sasErrs := startSasClient(db, cfg, logPusher, logConsumerUrl)
+		defer stopApp("sas client", sasCancel, sasErrs)
+	}
+	if cfg := serviceConfig.Metrics; cfg != nil && cfg.Enabled {
+		metricsCancel, metricsErrs := startMetricsCollector(db, cfg)
+		defer stopApp("metrics collector", metricsCancel, metricsErrs)
+	}
 
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("Error while running %s service amd echo server: %s", dp_service.ServiceName, err)
 	}
 
-	stopAmc(cancel, errs)
+	stopApp("amc", cancel, errs)
 }
 
 func startAmc(db *sql.DB, cfg *dp_service.AmcConfig) (context.CancelFunc, chan error) {
 	clock := &amc_time.Clock{}
 	seed := rand.NewSource(clock.Now().Unix())
 	amcManager := dp_storage.NewAmcManager(db, sqorc.GetSqlBuilder(), sqorc.GetErrorChecker(), sqorc.GetSqlLocker())
-	app := active_mode_controller.NewApp(
+	options := []active_mode_controller.Option{
 		active_mode_controller.WithDb(db),
 		active_mode_controller.WithAmcManager(amcManager),
 		active_mode_controller.WithClock(clock),
@@ -87,6 +114,37 @@ func startAmc(db *sql.DB, cfg *dp_service.AmcConfig) (context.CancelFunc, chan e
 			secToDuration(cfg.RequestProcessingIntervalSec)),
 		active_mode_controller.WithPollingInterval(secToDuration(cfg.PollingIntervalSec)),
 		active_mode_controller.WithCbsdInactivityTimeout(secToDuration(cfg.CbsdInactivityTimeoutSec)),
+	}
+	if p := cfg.ChannelPlanner; p != nil && p.Enabled {
+		options = append(options, active_mode_controller.WithChannelPlanner(planner.NewPlanner(p.ReuseDistanceM, p.Neighbors)))
+	}
+	app := active_mode_controller.NewApp(options...)
+	errs := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { errs <- app.Run(ctx) }()
+	return cancel, errs
+}
+
+func startSasClient(db *sql.DB, cfg *dp_service.SasClientConfig, logPusher logs_pusher.LogPusher, logConsumerUrl string) (context.CancelFunc, chan error) {
+	tlsConfig, err := sas_client.LoadTLSConfig(cfg.CertPath, cfg.KeyPath, cfg.SasCertPath)
+	if err != nil {
+		glog.Fatalf("Error loading sas client certificates: %s", err)
+	}
+	backoff := sas_client.Backoff{
+		MaxRetries: cfg.MaxRetries,
+		Initial:    time.Millisecond * time.Duration(cfg.InitialBackoffMs),
+		Max:        time.Millisecond * time.Duration(cfg.MaxBackoffMs),
+	}
+	client := sas_client.NewClient(cfg.SasUrl, tlsConfig, secToDuration(cfg.RequestTimeoutSec), backoff)
+	sasManager := dp_storage.NewSasManager(db, sqorc.GetSqlBuilder(), sqorc.GetErrorChecker(), sqorc.GetSqlLocker())
+	app := sas_client.NewApp(
+		sas_client.WithDb(db),
+		sas_client.WithSasManager(sasManager),
+		sas_client.WithClient(client),
+		sas_client.WithClock(&amc_time.Clock{}),
+		sas_client.WithPollingInterval(secToDuration(cfg.RequestProcessingIntervalSec)),
+		sas_client.WithRequestLimit(int64(cfg.RequestProcessingLimit)),
+		sas_client.WithLogPusher(logPusher, logConsumerUrl),
 	)
 	errs := make(chan error, 1)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -94,14 +152,66 @@ func startAmc(db *sql.DB, cfg *dp_service.AmcConfig) (context.CancelFunc, chan e
 	return cancel, errs
 }
 
+func startMetricsCollector(db *sql.DB, cfg *dp_service.MetricsConfig) (context.CancelFunc, chan error) {
+	metricsManager := dp_storage.NewMetricsManager(db, sqorc.GetSqlBuilder(), sqorc.GetErrorChecker(), sqorc.GetSqlLocker())
+	collector := metrics.NewCollector(metricsManager, &amc_time.Clock{}, secToDuration(cfg.CollectionIntervalSec))
+	errs := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { errs <- collector.Run(ctx) }()
+	return cancel, errs
+}
+
+// getLogBackend returns configured log backend, elasticsearch by default.
+// Database backend requires positive retention period and cleanup interval.
+func getLogBackend(cfg *dp_service.LogStoreConfig) string {
+	if cfg == nil || cfg.Backend == "" {
+		return dp_service.ElasticsearchLogBackend
+	}
+	if cfg.Backend != dp_service.ElasticsearchLogBackend && cfg.Backend != dp_service.DatabaseLogBackend {
+		glog.Fatalf("Unknown log backend: %s", cfg.Backend)
+	}
+	if cfg.Backend == dp_service.DatabaseLogBackend {
+		if cfg.RetentionDays <= 0 {
+			glog.Fatalf("Invalid retention_days for %s log backend: %d, must be positive", cfg.Backend, cfg.RetentionDays)
+		}
+		if cfg.CleanupIntervalSec <= 0 {
+			glog.Fatalf("Invalid cleanup_interval_sec for %s log backend: %d, must be positive", cfg.Backend, cfg.CleanupIntervalSec)
+		}
+	}
+	return cfg.Backend
+}
+
+// startLogRetention periodically removes logs older than configured retention period.
+func startLogRetention(store dp_storage.LogManager, cfg *dp_service.LogStoreConfig) (context.CancelFunc, chan error) {
+	retention := time.Hour * 24 * time.Duration(cfg.RetentionDays)
+	ticker := time.NewTicker(secToDuration(cfg.CleanupIntervalSec))
+	errs := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			case now := <-ticker.C:
+				if err := store.DeleteLogsOlderThan(now.Add(-retention)); err != nil {
+					glog.Errorf("Error while removing old logs: %s", err)
+				}
+			}
+		}
+	}()
+	return cancel, errs
+}
+
 func secToDuration(s int) time.Duration {
 	return time.Second * time.Duration(s)
 }
 
-func stopAmc(cancel context.CancelFunc, errs chan error) {
+func stopApp(name string, cancel context.CancelFunc, errs chan error) {
 	cancel()
 	err := <-errs
 	if err != nil && err != context.Canceled {
-		glog.Fatalf("Error while shutting down amc: %s", err)
+		glog.Fatalf("Error while shutting down %s: %s", name, err)
 	}
 }
@@ -4,7 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
+
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
 )
 
 type DPLog struct {
@@ -16,6 +21,7 @@ type DPLog struct {
 	CbsdSerialNumber string `json:"cbsd_serial_number"`
 	NetworkId        string `json:"network_id"`
 	FccId            string `json:"fcc_id"`
+	ResponseCode     string `json:"response_code,omitempty"`
 }
 
 type LogPusher func(ctx context.Context, log *DPLog, consumerUrl string) error
@@ -31,3 +37,28 @@ func PushDPLog(ctx context.Context, log *DPLog, consumerUrl string) error {
 	}
 	return nil
 }
+
+// NewDbLogPusher returns LogPusher which stores logs in the database
+// instead of sending them to the consumer, consumerUrl is ignored.
+func NewDbLogPusher(store storage.LogManager) LogPusher {
+	return func(_ context.Context, log *DPLog, _ string) error {
+		dbLog := &storage.DBLog{
+			NetworkId:        db.MakeString(log.NetworkId),
+			LogFrom:          db.MakeString(log.LogFrom),
+			LogTo:            db.MakeString(log.LogTo),
+			LogName:          db.MakeString(log.LogName),
+			LogMessage:       db.MakeString(log.LogMessage),
+			CbsdSerialNumber: db.MakeString(log.CbsdSerialNumber),
+			FccId:            db.MakeString(log.FccId),
+			EventTime:        db.MakeTime(time.Unix(log.EventTimestamp, 0).UTC()),
+		}
+		if log.ResponseCode != "" {
+			code, err := strconv.ParseInt(log.ResponseCode, 10, 64)
+			if err != nil {
+				return err
+			}
+			dbLog.ResponseCode = db.MakeInt(code)
+		}
+		return store.CreateLog(dbLog)
+	}
+}
@@ -7,8 +7,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
 )
 
 type stubFluentdServer struct {
@@ -42,3 +46,54 @@ func TestLogsPusher(t *testing.T) {
 	err := PushDPLog(context.Background(), log, fmt.Sprintf("%s/%s", testServer.URL, "dp"))
 	assert.NoError(t, err)
 }
+
+func TestDbLogPusher(t *testing.T) {
+	store := &stubLogManager{}
+	log := &DPLog{
+		EventTimestamp:   12345,
+		LogFrom:          "SAS",
+		LogTo:            "DP",
+		LogName:          "someLogName",
+		LogMessage:       "some log message",
+		CbsdSerialNumber: "cbsdId1234",
+		NetworkId:        "someNetwork",
+		FccId:            "someFccId",
+		ResponseCode:     "501",
+	}
+	err := NewDbLogPusher(store)(context.Background(), log, "")
+	assert.NoError(t, err)
+
+	expected := &storage.DBLog{
+		NetworkId:        db.MakeString("someNetwork"),
+		LogFrom:          db.MakeString("SAS"),
+		LogTo:            db.MakeString("DP"),
+		LogName:          db.MakeString("someLogName"),
+		LogMessage:       db.MakeString("some log message"),
+		CbsdSerialNumber: db.MakeString("cbsdId1234"),
+		FccId:            db.MakeString("someFccId"),
+		ResponseCode:     db.MakeInt(501),
+		EventTime:        db.MakeTime(time.Unix(12345, 0).UTC()),
+	}
+	assert.Equal(t, expected, store.log)
+}
+
+type stubLogManager struct {
+	log *storage.DBLog
+}
+
+func (s *stubLogManager) CreateLog(data *storage.DBLog) error {
+	s.log = data
+	return nil
+}
+
+func (s *stubLogManager) ListLogs(_ string, _ *storage.Pagination, _ *storage.LogFilter) (*storage.LogList, error) {
+	return nil, nil
+}
+
+func (s *stubLogManager) DeleteLogsOlderThan(_ time.Time) error {
+	return nil
+}
+
+func (s *stubLogManager) SetBackend(_ string) error {
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"magma/dp/cloud/go/services/dp/storage"
+)
+
+const (
+	authorized = "authorized"
+	registered = "registered"
+)
+
+type Clock interface {
+	Now() time.Time
+	Tick(duration time.Duration) *time.Ticker
+}
+
+// Collector periodically exports per network state of cbsds and grants.
+type Collector struct {
+	manager  storage.MetricsManager
+	clock    Clock
+	interval time.Duration
+}
+
+func NewCollector(manager storage.MetricsManager, clock Clock, interval time.Duration) *Collector {
+	return &Collector{manager: manager, clock: clock, interval: interval}
+}
+
+func (c *Collector) Run(ctx context.Context) error {
+	ticker := c.clock.Tick(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := c.Collect(); err != nil {
+				glog.Errorf("failed to collect metrics: %s", err)
+			}
+		}
+	}
+}
+
+// Collect replaces previously exported state,
+// so that removed cbsds and networks are no longer reported.
+func (c *Collector) Collect() error {
+	cbsds, err := c.manager.GetMetricsState()
+	if err != nil {
+		return err
+	}
+	cbsdCount := newCounts(cbsdCountDesc)
+	authorizedCbsdCount := newCounts(authorizedCbsdCountDesc)
+	grantCount := newCounts(grantCountDesc)
+	heartbeatOverdueGrantCount := newCounts(heartbeatOverdueGrantCountDesc)
+	now := c.clock.Now()
+	for _, cbsd := range cbsds {
+		network := cbsd.Cbsd.NetworkId.String
+		state := cbsd.CbsdState.Name.String
+		cbsdCount.add(1, network, state)
+		// make sure all series are present so that alerts can compare them
+		authorizedCbsdCount.add(0, network)
+		heartbeatOverdueGrantCount.add(0, network)
+		isAuthorized := false
+		for _, g := range cbsd.Grants {
+			grantState := g.GrantState.Name.String
+			grantCount.add(1, network, grantState, frequencyMHz(g.Grant))
+			if grantState != authorized {
+				continue
+			}
+			isAuthorized = true
+			if isHeartbeatOverdue(g.Grant, now) {
+				heartbeatOverdueGrantCount.add(1, network)
+			}
+		}
+		if state == registered && isAuthorized {
+			authorizedCbsdCount.add(1, network)
+		}
+	}
+	var metrics []prometheus.Metric
+	for _, cnt := range []*counts{cbsdCount, authorizedCbsdCount, grantCount, heartbeatOverdueGrantCount} {
+		metrics = append(metrics, cnt.metrics()...)
+	}
+	State.set(metrics)
+	return nil
+}
+
+type counts struct {
+	desc   *prometheus.Desc
+	labels [][]string
+	values map[string]float64
+}
+
+func newCounts(desc *prometheus.Desc) *counts {
+	return &counts{desc: desc, values: map[string]float64{}}
+}
+
+func (c *counts) add(value float64, labels ...string) {
+	key := strings.Join(labels, "\x00")
+	if _, ok := c.values[key]; !ok {
+		c.labels = append(c.labels, labels)
+	}
+	c.values[key] += value
+}
+
+func (c *counts) metrics() []prometheus.Metric {
+	metrics := make([]prometheus.Metric, len(c.labels))
+	for i, labels := range c.labels {
+		value := c.values[strings.Join(labels, "\x00")]
+		metrics[i] = prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, value, labels...)
+	}
+	return metrics
+}
+
+func frequencyMHz(g *storage.DBGrant) string {
+	midpoint := (g.LowFrequencyHz.Int64 + g.HighFrequencyHz.Int64) / 2
+	return strconv.FormatInt(midpoint/1e6, 10)
+}
+
+func isHeartbeatOverdue(g *storage.DBGrant, now time.Time) bool {
+	if !g.LastHeartbeatRequestTime.Valid {
+		return false
+	}
+	interval := time.Duration(g.HeartbeatIntervalSec.Int64) * time.Second
+	return now.Sub(g.LastHeartbeatRequestTime.Time) > 2*interval
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/dp/cloud/go/services/dp/metrics"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+)
+
+const (
+	someNetwork  = "some_network"
+	otherNetwork = "other_network"
+)
+
+func TestCollect(t *testing.T) {
+	now := time.Unix(1e9, 0).UTC()
+	manager := &stubMetricsManager{cbsds: []*storage.DetailedCbsd{
+		newCbsd(someNetwork, "registered",
+			newGrant("authorized", 3560, now.Add(-time.Second)),
+			newGrant("granted", 3580, time.Time{})),
+		newCbsd(someNetwork, "registered",
+			newGrant("authorized", 3560, now.Add(-time.Minute))),
+		newCbsd(someNetwork, "registered",
+			newGrant("granted", 3600, time.Time{})),
+		newCbsd(otherNetwork, "unregistered"),
+	}}
+	collector := metrics.NewCollector(manager, &stubClock{now: now}, time.Second)
+
+	require.NoError(t, collector.Collect())
+
+	expected := `
+# HELP dp_authorized_cbsd_count Number of registered cbsds in the network with at least one authorized grant
+# TYPE dp_authorized_cbsd_count gauge
+dp_authorized_cbsd_count{networkID="other_network"} 0
+dp_authorized_cbsd_count{networkID="some_network"} 2
+# HELP dp_cbsd_count Number of cbsds in the network by SAS state
+# TYPE dp_cbsd_count gauge
+dp_cbsd_count{networkID="other_network",state="unregistered"} 1
+dp_cbsd_count{networkID="some_network",state="registered"} 3
+# HELP dp_grant_count Number of grants in the network by state and channel midpoint
+# TYPE dp_grant_count gauge
+dp_grant_count{frequency_mhz="3560",networkID="some_network",state="authorized"} 2
+dp_grant_count{frequency_mhz="3580",networkID="some_network",state="granted"} 1
+dp_grant_count{frequency_mhz="3600",networkID="some_network",state="granted"} 1
+# HELP dp_heartbeat_overdue_grant_count Number of authorized grants in the network without heartbeat request for more than two heartbeat intervals
+# TYPE dp_heartbeat_overdue_grant_count gauge
+dp_heartbeat_overdue_grant_count{networkID="other_network"} 0
+dp_heartbeat_overdue_grant_count{networkID="some_network"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(metrics.State, strings.NewReader(expected)))
+}
+
+func TestCollectRemovesStaleSeries(t *testing.T) {
+	manager := &stubMetricsManager{cbsds: []*storage.DetailedCbsd{
+		newCbsd(someNetwork, "registered"),
+		newCbsd(otherNetwork, "registered"),
+	}}
+	collector := metrics.NewCollector(manager, &stubClock{}, time.Second)
+	require.NoError(t, collector.Collect())
+
+	manager.cbsds = manager.cbsds[:1]
+	require.NoError(t, collector.Collect())
+
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.State, "dp_cbsd_count"))
+}
+
+func TestCollectError(t *testing.T) {
+	manager := &stubMetricsManager{err: errors.New("some error")}
+	collector := metrics.NewCollector(manager, &stubClock{}, time.Second)
+
+	assert.Error(t, collector.Collect())
+}
+
+func newCbsd(networkId string, state string, grants ...*storage.DetailedGrant) *storage.DetailedCbsd {
+	return &storage.DetailedCbsd{
+		Cbsd:      &storage.DBCbsd{NetworkId: db.MakeString(networkId)},
+		CbsdState: &storage.DBCbsdState{Name: db.MakeString(state)},
+		Grants:    grants,
+	}
+}
+
+func newGrant(state string, frequencyMHz int64, lastHeartbeat time.Time) *storage.DetailedGrant {
+	grant := &storage.DBGrant{
+		LowFrequencyHz:       db.MakeInt((frequencyMHz - 10) * 1e6),
+		HighFrequencyHz:      db.MakeInt((frequencyMHz + 10) * 1e6),
+		HeartbeatIntervalSec: db.MakeInt(10),
+	}
+	if !lastHeartbeat.IsZero() {
+		grant.LastHeartbeatRequestTime = db.MakeTime(lastHeartbeat)
+	}
+	return &storage.DetailedGrant{
+		Grant:      grant,
+		GrantState: &storage.DBGrantState{Name: db.MakeString(state)},
+	}
+}
+
+type stubMetricsManager struct {
+	cbsds []*storage.DetailedCbsd
+	err   error
+}
+
+func (s *stubMetricsManager) GetMetricsState() ([]*storage.DetailedCbsd, error) {
+	return s.cbsds, s.err
+}
+
+type stubClock struct {
+	now time.Time
+}
+
+func (c *stubClock) Now() time.Time {
+	return c.now
+}
+
+func (c *stubClock) Tick(time.Duration) *time.Ticker {
+	return &time.Ticker{}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines prometheus metrics exported by Domain Proxy.
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"magma/orc8r/lib/go/metrics"
+)
+
+const (
+	StateLabelName        = "state"
+	FrequencyLabelName    = "frequency_mhz"
+	RequestTypeLabelName  = "request_type"
+	ResponseCodeLabelName = "response_code"
+)
+
+var (
+	cbsdCountDesc = prometheus.NewDesc(
+		"dp_cbsd_count",
+		"Number of cbsds in the network by SAS state",
+		[]string{metrics.NetworkLabelName, StateLabelName}, nil,
+	)
+	authorizedCbsdCountDesc = prometheus.NewDesc(
+		"dp_authorized_cbsd_count",
+		"Number of registered cbsds in the network with at least one authorized grant",
+		[]string{metrics.NetworkLabelName}, nil,
+	)
+	grantCountDesc = prometheus.NewDesc(
+		"dp_grant_count",
+		"Number of grants in the network by state and channel midpoint",
+		[]string{metrics.NetworkLabelName, StateLabelName, FrequencyLabelName}, nil,
+	)
+	heartbeatOverdueGrantCountDesc = prometheus.NewDesc(
+		"dp_heartbeat_overdue_grant_count",
+		"Number of authorized grants in the network without heartbeat request for more than two heartbeat intervals",
+		[]string{metrics.NetworkLabelName}, nil,
+	)
+)
+
+// State exports per network state of cbsds and grants last computed by Collector.
+var State = &stateMetrics{}
+
+func init() {
+	prometheus.MustRegister(State)
+}
+
+// stateMetrics is replaced as a whole, so that a scrape
+// never sees a partially computed state.
+type stateMetrics struct {
+	mu      sync.RWMutex
+	metrics []prometheus.Metric
+}
+
+func (s *stateMetrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cbsdCountDesc
+	ch <- authorizedCbsdCountDesc
+	ch <- grantCountDesc
+	ch <- heartbeatOverdueGrantCountDesc
+}
+
+func (s *stateMetrics) Collect(ch chan<- prometheus.Metric) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, m := range s.metrics {
+		ch <- m
+	}
+}
+
+func (s *stateMetrics) set(metrics []prometheus.Metric) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.metrics = metrics
+}
+
+var (
+	SasResponses = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dp_sas_responses_total",
+			Help: "Number of SAS responses by request type and response code",
+		},
+		[]string{RequestTypeLabelName, ResponseCodeLabelName},
+	)
+	SasRequestErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "dp_sas_request_errors_total",
+			Help: "Number of SAS requests which failed without a response",
+		},
+		[]string{RequestTypeLabelName},
+	)
+	SasRequestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "dp_sas_request_duration_seconds",
+			Help:    "Latency of SAS requests (including heartbeats) by request type",
+			Buckets: []float64{.05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+		[]string{RequestTypeLabelName},
+	)
+	AmcLoopDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "dp_amc_loop_duration_seconds",
+			Help:    "Duration of a single active mode controller iteration",
+			Buckets: []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		},
+	)
+	AmcLoopErrors = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "dp_amc_loop_errors_total",
+			Help: "Number of failed active mode controller iterations",
+		},
+	)
+)
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cbsd
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/labstack/echo/v4"
+
+	"magma/dp/cloud/go/protos"
+	"magma/dp/cloud/go/services/dp/obsidian/models"
+	"magma/orc8r/cloud/go/services/obsidian"
+)
+
+const (
+	ManageCpisPath   = ManageNetworkPath + obsidian.UrlSep + "cpis"
+	ManageCpiPath    = ManageCpisPath + obsidian.UrlSep + ":cpi_id"
+	CpiSignaturePath = ManageCbsdPath + obsidian.UrlSep + "cpi_signature"
+)
+
+func getCpiHandlers() []obsidian.Handler {
+	return []obsidian.Handler{
+		{Path: ManageCpisPath, Methods: obsidian.GET, HandlerFunc: withNetworkIdAndCpiClient(listCpis)},
+		{Path: ManageCpisPath, Methods: obsidian.POST, HandlerFunc: withNetworkIdAndCpiClient(createCpi)},
+		{Path: ManageCpiPath, Methods: obsidian.DELETE, HandlerFunc: withNetworkIdAndCpiClient(deleteCpi)},
+		{Path: CpiSignaturePath, Methods: obsidian.GET, HandlerFunc: withNetworkIdAndCpiClient(fetchCpiSignedData)},
+		{Path: CpiSignaturePath, Methods: obsidian.POST, HandlerFunc: withNetworkIdAndCpiClient(signCbsd)},
+	}
+}
+
+func withNetworkIdAndCpiClient(handler func(c echo.Context, networkId string, client protos.CpiManagementClient) error) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		networkId, nerr := obsidian.GetNetworkId(c)
+		if nerr != nil {
+			return nerr
+		}
+		client, err := getCpiManagerClient()
+		if err != nil {
+			return err
+		}
+		return handler(c, networkId, client)
+	}
+}
+
+func listCpis(c echo.Context, networkId string, client protos.CpiManagementClient) error {
+	req := protos.ListCpisRequest{NetworkId: networkId}
+	ctx := c.Request().Context()
+	cpis, err := client.ListCpis(ctx, &req)
+	if err != nil {
+		return getHttpError(err)
+	}
+	payload := make([]*models.Cpi, len(cpis.Details))
+	for i, details := range cpis.Details {
+		payload[i] = models.CpiFromBackend(details)
+	}
+	return c.JSON(http.StatusOK, payload)
+}
+
+func createCpi(c echo.Context, networkId string, client protos.CpiManagementClient) error {
+	payload := &models.MutableCpi{}
+	if err := c.Bind(payload); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := payload.Validate(strfmt.Default); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	req := protos.CreateCpiRequest{NetworkId: networkId, Data: models.CpiToBackend(payload)}
+	ctx := c.Request().Context()
+	if _, err := client.CreateCpi(ctx, &req); err != nil {
+		return getHttpError(err)
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func deleteCpi(c echo.Context, networkId string, client protos.CpiManagementClient) error {
+	cpiId := c.Param("cpi_id")
+	if cpiId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "missing Cpi ID")
+	}
+	req := protos.DeleteCpiRequest{NetworkId: networkId, CpiId: cpiId}
+	ctx := c.Request().Context()
+	if _, err := client.DeleteCpi(ctx, &req); err != nil {
+		return getHttpError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func fetchCpiSignedData(c echo.Context, networkId string, client protos.CpiManagementClient) error {
+	cbsdId, nerr := getCbsdId(c)
+	if nerr != nil {
+		return nerr
+	}
+	id, err := strconv.Atoi(cbsdId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	req := protos.FetchCpiSignedDataRequest{NetworkId: networkId, CbsdId: int64(id)}
+	ctx := c.Request().Context()
+	data, err := client.FetchCpiSignedData(ctx, &req)
+	if err != nil {
+		return getHttpError(err)
+	}
+	return c.JSON(http.StatusOK, models.CpiSignedDataFromBackend(data))
+}
+
+func signCbsd(c echo.Context, networkId string, client protos.CpiManagementClient) error {
+	payload := &models.CpiSignRequest{}
+	if err := c.Bind(payload); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := payload.Validate(strfmt.Default); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	cbsdId, nerr := getCbsdId(c)
+	if nerr != nil {
+		return nerr
+	}
+	id, err := strconv.Atoi(cbsdId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	req := protos.SignCbsdRequest{NetworkId: networkId, CbsdId: int64(id), CpiId: payload.CpiID}
+	ctx := c.Request().Context()
+	if _, err := client.SignCbsd(ctx, &req); err != nil {
+		return getHttpError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func getCpiManagerClient() (protos.CpiManagementClient, error) {
+	conn, err := getConn()
+	if err != nil {
+		return nil, err
+	}
+	return protos.NewCpiManagementClient(conn), nil
+}
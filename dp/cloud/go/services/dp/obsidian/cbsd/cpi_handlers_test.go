@@ -0,0 +1,279 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cbsd_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/dp/cloud/go/dp"
+	"magma/dp/cloud/go/protos"
+	dp_service "magma/dp/cloud/go/services/dp"
+	b "magma/dp/cloud/go/services/dp/builders"
+	"magma/dp/cloud/go/services/dp/obsidian/cbsd"
+	"magma/dp/cloud/go/services/dp/obsidian/models"
+	"magma/orc8r/cloud/go/services/obsidian"
+	"magma/orc8r/cloud/go/services/obsidian/tests"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+func TestCpiHandlers(t *testing.T) {
+	suite.Run(t, &CpiHandlersTestSuite{})
+}
+
+type CpiHandlersTestSuite struct {
+	suite.Suite
+	cpiServer *stubCpiServer
+}
+
+func (s *CpiHandlersTestSuite) SetupTest() {
+	s.cpiServer = &stubCpiServer{t: s.T()}
+	srv, lis, _ := test_utils.NewTestService(s.T(), dp.ModuleName, dp_service.ServiceName)
+	protos.RegisterCpiManagementServer(srv.GrpcServer, s.cpiServer)
+	go srv.RunTest(lis, nil)
+}
+
+func (s *CpiHandlersTestSuite) TestListCpis() {
+	e := echo.New()
+	obsidianHandlers := cbsd.GetHandlers()
+	s.cpiServer.expectedListRequest = &protos.ListCpisRequest{NetworkId: "n1"}
+	s.cpiServer.listResponse = &protos.ListCpisResponse{Details: []*protos.CpiDetails{{
+		CpiId:     "some_cpi_id",
+		CpiName:   "some name",
+		PublicKey: "some key",
+	}}}
+	listCpis := tests.GetHandlerByPathAndMethod(s.T(), obsidianHandlers, cbsd.ManageCpisPath, obsidian.GET).HandlerFunc
+	expectedResult := []*models.Cpi{{CpiID: "some_cpi_id", CpiName: "some name", PublicKey: "some key"}}
+	tc := tests.Test{
+		Method:         http.MethodGet,
+		URL:            "/magma/v1/dp/n1/cpis",
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		Handler:        listCpis,
+		ExpectedStatus: http.StatusOK,
+		ExpectedResult: tests.JSONMarshaler(expectedResult),
+	}
+	tests.RunUnitTest(s.T(), e, tc)
+}
+
+func (s *CpiHandlersTestSuite) TestCreateCpi() {
+	testCases := []struct {
+		name                   string
+		payload                *models.MutableCpi
+		err                    error
+		expectedStatus         int
+		expectedErrorSubstring string
+	}{{
+		name:           "test create cpi",
+		payload:        &models.MutableCpi{CpiID: "some_cpi_id", CpiName: "some name", PrivateKey: "some key"},
+		expectedStatus: http.StatusCreated,
+	}, {
+		name:                   "test create cpi without required params",
+		payload:                &models.MutableCpi{CpiID: "some_cpi_id"},
+		expectedStatus:         http.StatusBadRequest,
+		expectedErrorSubstring: "validation failure list",
+	}, {
+		name:                   "test create cpi with invalid key",
+		payload:                &models.MutableCpi{CpiID: "some_cpi_id", CpiName: "some name", PrivateKey: "some key"},
+		err:                    status.Error(codes.InvalidArgument, "some error"),
+		expectedStatus:         http.StatusBadRequest,
+		expectedErrorSubstring: "some error",
+	}, {
+		name:                   "test create duplicate cpi",
+		payload:                &models.MutableCpi{CpiID: "some_cpi_id", CpiName: "some name"},
+		err:                    status.Error(codes.AlreadyExists, "some error"),
+		expectedStatus:         http.StatusConflict,
+		expectedErrorSubstring: "some error",
+	}}
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			e := echo.New()
+			obsidianHandlers := cbsd.GetHandlers()
+			s.cpiServer.err = tt.err
+			s.cpiServer.expectedCreateRequest = &protos.CreateCpiRequest{
+				NetworkId: "n1",
+				Data:      models.CpiToBackend(tt.payload),
+			}
+			createCpi := tests.GetHandlerByPathAndMethod(s.T(), obsidianHandlers, cbsd.ManageCpisPath, obsidian.POST).HandlerFunc
+			tc := tests.Test{
+				Method:                 http.MethodPost,
+				URL:                    "/magma/v1/dp/n1/cpis",
+				Payload:                tt.payload,
+				ParamNames:             []string{"network_id"},
+				ParamValues:            []string{"n1"},
+				Handler:                createCpi,
+				ExpectedStatus:         tt.expectedStatus,
+				ExpectedErrorSubstring: tt.expectedErrorSubstring,
+			}
+			tests.RunUnitTest(s.T(), e, tc)
+		})
+	}
+}
+
+func (s *CpiHandlersTestSuite) TestDeleteCpi() {
+	testCases := []struct {
+		name                   string
+		err                    error
+		expectedStatus         int
+		expectedErrorSubstring string
+	}{{
+		name:           "test delete cpi",
+		expectedStatus: http.StatusNoContent,
+	}, {
+		name:                   "test delete non existent cpi",
+		err:                    status.Error(codes.NotFound, "some error"),
+		expectedStatus:         http.StatusNotFound,
+		expectedErrorSubstring: "some error",
+	}}
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			e := echo.New()
+			obsidianHandlers := cbsd.GetHandlers()
+			s.cpiServer.err = tt.err
+			s.cpiServer.expectedDeleteRequest = &protos.DeleteCpiRequest{NetworkId: "n1", CpiId: "some_cpi_id"}
+			deleteCpi := tests.GetHandlerByPathAndMethod(s.T(), obsidianHandlers, cbsd.ManageCpiPath, obsidian.DELETE).HandlerFunc
+			tc := tests.Test{
+				Method:                 http.MethodDelete,
+				URL:                    "/magma/v1/dp/n1/cpis/some_cpi_id",
+				ParamNames:             []string{"network_id", "cpi_id"},
+				ParamValues:            []string{"n1", "some_cpi_id"},
+				Handler:                deleteCpi,
+				ExpectedStatus:         tt.expectedStatus,
+				ExpectedErrorSubstring: tt.expectedErrorSubstring,
+			}
+			tests.RunUnitTest(s.T(), e, tc)
+		})
+	}
+}
+
+func (s *CpiHandlersTestSuite) TestFetchCpiSignedData() {
+	e := echo.New()
+	obsidianHandlers := cbsd.GetHandlers()
+	installationParam := b.NewCbsdProtoPayloadBuilder().WithFullInstallationParam().Payload.InstallationParam
+	s.cpiServer.expectedFetchRequest = &protos.FetchCpiSignedDataRequest{NetworkId: "n1", CbsdId: 1}
+	s.cpiServer.fetchResponse = &protos.FetchCpiSignedDataResponse{
+		FccId:             "some_fcc_id",
+		SerialNumber:      "some_serial_number",
+		CbsdCategory:      "b",
+		InstallationParam: installationParam,
+	}
+	fetchCpiSignedData := tests.GetHandlerByPathAndMethod(s.T(), obsidianHandlers, cbsd.CpiSignaturePath, obsidian.GET).HandlerFunc
+	tc := tests.Test{
+		Method:         http.MethodGet,
+		URL:            "/magma/v1/dp/n1/cbsds/1/cpi_signature",
+		ParamNames:     []string{"network_id", "cbsd_id"},
+		ParamValues:    []string{"n1", "1"},
+		Handler:        fetchCpiSignedData,
+		ExpectedStatus: http.StatusOK,
+		ExpectedResult: tests.JSONMarshaler(models.CpiSignedDataFromBackend(s.cpiServer.fetchResponse)),
+	}
+	tests.RunUnitTest(s.T(), e, tc)
+}
+
+func (s *CpiHandlersTestSuite) TestSignCbsd() {
+	testCases := []struct {
+		name                   string
+		payload                *models.CpiSignRequest
+		err                    error
+		expectedStatus         int
+		expectedErrorSubstring string
+	}{{
+		name:           "test sign cbsd",
+		payload:        &models.CpiSignRequest{CpiID: "some_cpi_id"},
+		expectedStatus: http.StatusNoContent,
+	}, {
+		name:                   "test sign cbsd without cpi id",
+		payload:                &models.CpiSignRequest{},
+		expectedStatus:         http.StatusBadRequest,
+		expectedErrorSubstring: "validation failure list",
+	}, {
+		name:                   "test sign cbsd with non existent cpi",
+		payload:                &models.CpiSignRequest{CpiID: "some_cpi_id"},
+		err:                    status.Error(codes.NotFound, "some error"),
+		expectedStatus:         http.StatusNotFound,
+		expectedErrorSubstring: "some error",
+	}}
+	for _, tt := range testCases {
+		s.Run(tt.name, func() {
+			e := echo.New()
+			obsidianHandlers := cbsd.GetHandlers()
+			s.cpiServer.err = tt.err
+			s.cpiServer.expectedSignRequest = &protos.SignCbsdRequest{NetworkId: "n1", CbsdId: 1, CpiId: tt.payload.CpiID}
+			signCbsd := tests.GetHandlerByPathAndMethod(s.T(), obsidianHandlers, cbsd.CpiSignaturePath, obsidian.POST).HandlerFunc
+			tc := tests.Test{
+				Method:                 http.MethodPost,
+				URL:                    "/magma/v1/dp/n1/cbsds/1/cpi_signature",
+				Payload:                tt.payload,
+				ParamNames:             []string{"network_id", "cbsd_id"},
+				ParamValues:            []string{"n1", "1"},
+				Handler:                signCbsd,
+				ExpectedStatus:         tt.expectedStatus,
+				ExpectedErrorSubstring: tt.expectedErrorSubstring,
+			}
+			tests.RunUnitTest(s.T(), e, tc)
+		})
+	}
+}
+
+type stubCpiServer struct {
+	protos.UnimplementedCpiManagementServer
+	expectedListRequest   *protos.ListCpisRequest
+	listResponse          *protos.ListCpisResponse
+	expectedCreateRequest *protos.CreateCpiRequest
+	expectedDeleteRequest *protos.DeleteCpiRequest
+	expectedFetchRequest  *protos.FetchCpiSignedDataRequest
+	fetchResponse         *protos.FetchCpiSignedDataResponse
+	expectedSignRequest   *protos.SignCbsdRequest
+	err                   error
+	t                     *testing.T
+}
+
+func (s *stubCpiServer) ListCpis(_ context.Context, request *protos.ListCpisRequest) (*protos.ListCpisResponse, error) {
+	assert.Equal(s.t, s.expectedListRequest.NetworkId, request.NetworkId)
+	return s.listResponse, s.err
+}
+
+func (s *stubCpiServer) CreateCpi(_ context.Context, request *protos.CreateCpiRequest) (*protos.CreateCpiResponse, error) {
+	assert.Equal(s.t, s.expectedCreateRequest.NetworkId, request.NetworkId)
+	assert.Equal(s.t, s.expectedCreateRequest.Data.CpiId, request.Data.CpiId)
+	assert.Equal(s.t, s.expectedCreateRequest.Data.CpiName, request.Data.CpiName)
+	assert.Equal(s.t, s.expectedCreateRequest.Data.PrivateKey, request.Data.PrivateKey)
+	return &protos.CreateCpiResponse{}, s.err
+}
+
+func (s *stubCpiServer) DeleteCpi(_ context.Context, request *protos.DeleteCpiRequest) (*protos.DeleteCpiResponse, error) {
+	assert.Equal(s.t, s.expectedDeleteRequest.NetworkId, request.NetworkId)
+	assert.Equal(s.t, s.expectedDeleteRequest.CpiId, request.CpiId)
+	return &protos.DeleteCpiResponse{}, s.err
+}
+
+func (s *stubCpiServer) FetchCpiSignedData(_ context.Context, request *protos.FetchCpiSignedDataRequest) (*protos.FetchCpiSignedDataResponse, error) {
+	assert.Equal(s.t, s.expectedFetchRequest.NetworkId, request.NetworkId)
+	assert.Equal(s.t, s.expectedFetchRequest.CbsdId, request.CbsdId)
+	return s.fetchResponse, s.err
+}
+
+func (s *stubCpiServer) SignCbsd(_ context.Context, request *protos.SignCbsdRequest) (*protos.SignCbsdResponse, error) {
+	assert.Equal(s.t, s.expectedSignRequest.NetworkId, request.NetworkId)
+	assert.Equal(s.t, s.expectedSignRequest.CbsdId, request.CbsdId)
+	assert.Equal(s.t, s.expectedSignRequest.CpiId, request.CpiId)
+	return &protos.SignCbsdResponse{}, s.err
+}
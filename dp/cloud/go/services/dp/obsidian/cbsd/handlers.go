@@ -49,7 +49,7 @@ const (
 const baseWrongValMsg = "'%s' is not a proper value for %s"
 
 func GetHandlers() []obsidian.Handler {
-	return []obsidian.Handler{
+	handlers := []obsidian.Handler{
 		{Path: ManageCbsdsPath, Methods: obsidian.GET, HandlerFunc: withNetworkIdAndCbsdClient(listCbsds)},
 		{Path: ManageCbsdsPath, Methods: obsidian.POST, HandlerFunc: withNetworkIdAndCbsdClient(createCbsd)},
 		{Path: ManageCbsdPath, Methods: obsidian.GET, HandlerFunc: withNetworkIdAndCbsdClient(fetchCbsd)},
@@ -58,6 +58,7 @@ func GetHandlers() []obsidian.Handler {
 		{Path: DeregisterCbsdPath, Methods: obsidian.POST, HandlerFunc: withNetworkIdAndCbsdClient(deregisterCbsd)},
 		{Path: RelinquishCbsdPath, Methods: obsidian.POST, HandlerFunc: withNetworkIdAndCbsdClient(relinquishCbsd)},
 	}
+	return append(handlers, getCpiHandlers()...)
 }
 
 func withNetworkIdAndCbsdClient(handler func(c echo.Context, networkId string, client protos.CbsdManagementClient) error) echo.HandlerFunc {
@@ -246,6 +247,8 @@ func getHttpError(err error) error {
 		return echo.NewHTTPError(http.StatusNotFound, err.Error())
 	case codes.AlreadyExists:
 		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case codes.InvalidArgument:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	default:
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
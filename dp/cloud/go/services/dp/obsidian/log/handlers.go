@@ -14,18 +14,21 @@ limitations under the License.
 package dp_log
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/golang/glog"
 	"github.com/labstack/echo/v4"
 	"github.com/olivere/elastic/v7"
 
 	"magma/dp/cloud/go/services/dp/obsidian/models"
 	"magma/dp/cloud/go/services/dp/obsidian/to_pointer"
+	"magma/dp/cloud/go/services/dp/storage"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/services/obsidian"
 	"magma/orc8r/lib/go/service/config"
@@ -110,6 +113,61 @@ func getListLogsHandler(client *elastic.Client) func(c echo.Context) error {
 	}
 }
 
+// DbHandlersGetter serves logs stored in the database by Domain Proxy,
+// so that they can be queried without Elasticsearch.
+type DbHandlersGetter struct {
+	store storage.LogManager
+}
+
+func NewDbHandlersGetter(store storage.LogManager) *DbHandlersGetter {
+	return &DbHandlersGetter{store: store}
+}
+
+func (g *DbHandlersGetter) GetHandlers() []obsidian.Handler {
+	return []obsidian.Handler{
+		{Path: ManageLogsPath, Methods: obsidian.GET, HandlerFunc: g.listLogs},
+	}
+}
+
+func (g *DbHandlersGetter) listLogs(c echo.Context) error {
+	networkId, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	filter, err := getLogsFilter(c)
+	if err != nil {
+		return err
+	}
+	pagination, err := getPagination(c)
+	if err != nil {
+		return err
+	}
+	result, err := g.store.ListLogs(networkId, pagination.toStorage(), filter.toStorage())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	resp := models.PaginatedLogs{
+		Logs:       make([]*models.Log, len(result.Logs)),
+		TotalCount: result.Count,
+	}
+	for i, l := range result.Logs {
+		resp.Logs[i] = dbLogToLog(l)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+func dbLogToLog(l *storage.DBLog) *models.Log {
+	return &models.Log{
+		Body:         l.LogMessage.String,
+		FccID:        l.FccId.String,
+		From:         l.LogFrom.String,
+		SerialNumber: l.CbsdSerialNumber.String,
+		Time:         strfmt.DateTime(l.EventTime.Time),
+		To:           l.LogTo.String,
+		Type:         l.LogName.String,
+	}
+}
+
 type LogsFilter struct {
 	LogFrom           string
 	LogTo             string
@@ -126,6 +184,37 @@ type Pagination struct {
 	From *int
 }
 
+func (f *LogsFilter) toStorage() *storage.LogFilter {
+	filter := &storage.LogFilter{
+		From:         f.LogFrom,
+		To:           f.LogTo,
+		Name:         f.Name,
+		SerialNumber: f.SerialNumber,
+		FccId:        f.FccId,
+	}
+	if f.ResponseCode != nil {
+		filter.ResponseCode = sql.NullInt64{Int64: *f.ResponseCode, Valid: true}
+	}
+	if f.BeginTimestampSec != nil {
+		filter.Begin = sql.NullTime{Time: time.Unix(*f.BeginTimestampSec, 0).UTC(), Valid: true}
+	}
+	if f.EndTimestampSec != nil {
+		filter.End = sql.NullTime{Time: time.Unix(*f.EndTimestampSec, 0).UTC(), Valid: true}
+	}
+	return filter
+}
+
+func (p *Pagination) toStorage() *storage.Pagination {
+	pagination := &storage.Pagination{}
+	if p.Size != nil {
+		pagination.Limit = sql.NullInt64{Int64: int64(*p.Size), Valid: true}
+	}
+	if p.From != nil {
+		pagination.Offset = sql.NullInt64{Int64: int64(*p.From), Valid: true}
+	}
+	return pagination
+}
+
 type ListLogsRequest struct {
 	Index      string
 	NetworkId  string
@@ -16,6 +16,7 @@ package dp_log
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -32,6 +33,7 @@ import (
 	dp_service "magma/dp/cloud/go/services/dp"
 	"magma/dp/cloud/go/services/dp/obsidian/models"
 	"magma/dp/cloud/go/services/dp/obsidian/to_pointer"
+	"magma/dp/cloud/go/services/dp/storage"
 	"magma/dp/cloud/go/services/dp/storage/db"
 	"magma/orc8r/cloud/go/services/obsidian"
 	"magma/orc8r/cloud/go/services/obsidian/tests"
@@ -589,6 +591,144 @@ func (s *HandlersTestSuite) TestListLogs() {
 	}
 }
 
+func (s *HandlersTestSuite) TestListLogsFromDatabase() {
+	testCases := []struct {
+		testName          string
+		queryParamsString url.Values
+		storeErr          error
+		expectedStatus    int
+		expectedResult    *models.PaginatedLogs
+		expectedError     string
+		expectedFilter    *storage.LogFilter
+		expectedPaging    *storage.Pagination
+	}{
+		{
+			testName:       "test list logs without query params",
+			expectedStatus: http.StatusOK,
+			expectedResult: getPaginatedLogs(),
+			expectedFilter: &storage.LogFilter{},
+			expectedPaging: &storage.Pagination{},
+		},
+		{
+			testName: "test list logs with query params",
+			queryParamsString: url.Values{
+				"from":          {"SAS"},
+				"to":            {"DP"},
+				"type":          {"grantResponse"},
+				"serial_number": {"some_serial_number"},
+				"fcc_id":        {"some_fcc_id"},
+				"response_code": {"0"},
+				"begin":         {"2022-01-14T10:23:49Z"},
+				"end":           {"2022-01-15T10:23:49Z"},
+				"limit":         {"2"},
+				"offset":        {"1"},
+			},
+			expectedStatus: http.StatusOK,
+			expectedResult: getPaginatedLogs(),
+			expectedFilter: &storage.LogFilter{
+				From:         "SAS",
+				To:           "DP",
+				Name:         "grantResponse",
+				SerialNumber: "some_serial_number",
+				FccId:        "some_fcc_id",
+				ResponseCode: db.MakeInt(0),
+				Begin:        db.MakeTime(time.Unix(1642155829, 0).UTC()),
+				End:          db.MakeTime(time.Unix(1642242229, 0).UTC()),
+			},
+			expectedPaging: &storage.Pagination{
+				Limit:  db.MakeInt(2),
+				Offset: db.MakeInt(1),
+			},
+		},
+		{
+			testName:          "test list logs with incorrect limit value",
+			queryParamsString: url.Values{"limit": {"incorrect_limit_value"}},
+			expectedStatus:    http.StatusBadRequest,
+			expectedError:     "'incorrect_limit_value' is not a proper value for limit",
+		},
+		{
+			testName:       "test list logs with database error",
+			storeErr:       errors.New("some error"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "some error",
+			expectedFilter: &storage.LogFilter{},
+			expectedPaging: &storage.Pagination{},
+		},
+	}
+	e := echo.New()
+
+	for _, t := range testCases {
+		s.Run(t.testName, func() {
+			store := &stubLogManager{err: t.storeErr, logs: getDBLogs()}
+			obsidianHandlers := NewDbHandlersGetter(store).GetHandlers()
+			listLogs := tests.GetHandlerByPathAndMethod(s.T(), obsidianHandlers, ManageLogsPath, obsidian.GET).HandlerFunc
+			tc := tests.Test{
+				Method:         http.MethodGet,
+				URL:            ManageLogsPath + "?" + t.queryParamsString.Encode(),
+				ParamNames:     []string{"network_id"},
+				ParamValues:    []string{"n1"},
+				Handler:        listLogs,
+				ExpectedStatus: t.expectedStatus,
+				ExpectedResult: tests.JSONMarshaler(t.expectedResult),
+				ExpectedError:  t.expectedError,
+			}
+			tests.RunUnitTest(s.T(), e, tc)
+			s.Equal(t.expectedFilter, store.filter)
+			s.Equal(t.expectedPaging, store.pagination)
+			if t.expectedFilter != nil {
+				s.Equal("n1", store.networkId)
+			}
+		})
+	}
+}
+
+type stubLogManager struct {
+	networkId  string
+	pagination *storage.Pagination
+	filter     *storage.LogFilter
+	logs       *storage.LogList
+	err        error
+}
+
+func (s *stubLogManager) CreateLog(_ *storage.DBLog) error {
+	return s.err
+}
+
+func (s *stubLogManager) ListLogs(networkId string, pagination *storage.Pagination, filter *storage.LogFilter) (*storage.LogList, error) {
+	s.networkId = networkId
+	s.pagination = pagination
+	s.filter = filter
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.logs, nil
+}
+
+func (s *stubLogManager) DeleteLogsOlderThan(_ time.Time) error {
+	return s.err
+}
+
+func (s *stubLogManager) SetBackend(_ string) error {
+	return s.err
+}
+
+func getDBLogs() *storage.LogList {
+	logs := make([]*storage.DBLog, 2)
+	for i, message := range []string{"some message1", "some message2"} {
+		logs[i] = &storage.DBLog{
+			NetworkId:        db.MakeString("n1"),
+			LogFrom:          db.MakeString("SAS"),
+			LogTo:            db.MakeString("DP"),
+			LogName:          db.MakeString("grantResponse"),
+			LogMessage:       db.MakeString(message),
+			CbsdSerialNumber: db.MakeString("some_serial_number"),
+			FccId:            db.MakeString("some_fcc_id"),
+			EventTime:        db.MakeTime(time.Unix(1642155829, 0).UTC()),
+		}
+	}
+	return &storage.LogList{Logs: logs, Count: 10000}
+}
+
 func (s *HandlersTestSuite) TestGetLogsFilter() {
 	testCases := []struct {
 		testName             string
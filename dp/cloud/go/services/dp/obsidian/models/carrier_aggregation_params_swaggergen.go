@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CarrierAggregationParams enables carrier aggregation with up to max_carriers grants of the same bandwidth, when not set Domain Proxy will maintain at most 2 grants
+//
+// swagger:model carrier_aggregation_params
+type CarrierAggregationParams struct {
+
+	// if enabled, only adjacent channels will be aggregated
+	Contiguous bool `json:"contiguous,omitempty"`
+
+	// maximum number of grants used for carrier aggregation
+	// Example: 3
+	// Required: true
+	// Maximum: 4
+	// Minimum: 1
+	MaxCarriers int64 `json:"max_carriers"`
+
+	// maximum aggregated bandwidth of all grants, 0 means no limit
+	// Minimum: 0
+	// Multiple Of: 5
+	MaxTotalBandwidthMhz int64 `json:"max_total_bandwidth_mhz,omitempty"`
+
+	// minimum aggregated bandwidth of all grants, 0 means no limit
+	// Minimum: 0
+	// Multiple Of: 5
+	MinTotalBandwidthMhz int64 `json:"min_total_bandwidth_mhz,omitempty"`
+}
+
+// Validate validates this carrier aggregation params
+func (m *CarrierAggregationParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMaxCarriers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxTotalBandwidthMhz(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinTotalBandwidthMhz(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CarrierAggregationParams) validateMaxCarriers(formats strfmt.Registry) error {
+
+	if err := validate.Required("max_carriers", "body", int64(m.MaxCarriers)); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("max_carriers", "body", m.MaxCarriers, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("max_carriers", "body", m.MaxCarriers, 4, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CarrierAggregationParams) validateMaxTotalBandwidthMhz(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxTotalBandwidthMhz) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_total_bandwidth_mhz", "body", m.MaxTotalBandwidthMhz, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MultipleOfInt("max_total_bandwidth_mhz", "body", m.MaxTotalBandwidthMhz, 5); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CarrierAggregationParams) validateMinTotalBandwidthMhz(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinTotalBandwidthMhz) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("min_total_bandwidth_mhz", "body", m.MinTotalBandwidthMhz, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MultipleOfInt("min_total_bandwidth_mhz", "body", m.MinTotalBandwidthMhz, 5); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this carrier aggregation params based on context it is used
+func (m *CarrierAggregationParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CarrierAggregationParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CarrierAggregationParams) UnmarshalBinary(b []byte) error {
+	var res CarrierAggregationParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -29,6 +29,9 @@ type Cbsd struct {
 	// Required: true
 	CarrierAggregationEnabled bool `json:"carrier_aggregation_enabled"`
 
+	// carrier aggregation params
+	CarrierAggregationParams *CarrierAggregationParams `json:"carrier_aggregation_params,omitempty"`
+
 	// is the radio type A (only) or B (also applies to A/B type radios)
 	// Required: true
 	// Enum: [a b]
@@ -38,6 +41,9 @@ type Cbsd struct {
 	// Example: some_cbsd_id
 	CbsdID string `json:"cbsd_id,omitempty"`
 
+	// cpi signature
+	CpiSignature *CpiSignature `json:"cpi_signature,omitempty"`
+
 	// desired state of cbsd in SAS
 	// Required: true
 	// Enum: [unregistered registered]
@@ -72,6 +78,9 @@ type Cbsd struct {
 	// Required: true
 	IsActive bool `json:"is_active"`
 
+	// frequency preferences (midpoints of sas channels) computed by network channel planner, they take precedence over frequency_preferences
+	PlannedFrequenciesMhz []int64 `json:"planned_frequencies_mhz"`
+
 	// serial number
 	// Example: some_serial_number
 	// Required: true
@@ -106,10 +115,18 @@ func (m *Cbsd) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCarrierAggregationParams(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCbsdCategory(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateCpiSignature(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDesiredState(formats); err != nil {
 		res = append(res, err)
 	}
@@ -187,6 +204,25 @@ func (m *Cbsd) validateCarrierAggregationEnabled(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *Cbsd) validateCarrierAggregationParams(formats strfmt.Registry) error {
+	if swag.IsZero(m.CarrierAggregationParams) { // not required
+		return nil
+	}
+
+	if m.CarrierAggregationParams != nil {
+		if err := m.CarrierAggregationParams.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("carrier_aggregation_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("carrier_aggregation_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var cbsdTypeCbsdCategoryPropEnum []interface{}
 
 func init() {
@@ -230,6 +266,25 @@ func (m *Cbsd) validateCbsdCategory(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cbsd) validateCpiSignature(formats strfmt.Registry) error {
+	if swag.IsZero(m.CpiSignature) { // not required
+		return nil
+	}
+
+	if m.CpiSignature != nil {
+		if err := m.CpiSignature.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cpi_signature")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cpi_signature")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var cbsdTypeDesiredStatePropEnum []interface{}
 
 func init() {
@@ -453,6 +508,14 @@ func (m *Cbsd) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateCarrierAggregationParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateCpiSignature(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateFrequencyPreferences(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -485,6 +548,38 @@ func (m *Cbsd) contextValidateCapabilities(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *Cbsd) contextValidateCarrierAggregationParams(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CarrierAggregationParams != nil {
+		if err := m.CarrierAggregationParams.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("carrier_aggregation_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("carrier_aggregation_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Cbsd) contextValidateCpiSignature(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CpiSignature != nil {
+		if err := m.CpiSignature.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cpi_signature")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cpi_signature")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Cbsd) contextValidateFrequencyPreferences(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.FrequencyPreferences.ContextValidate(ctx, formats); err != nil {
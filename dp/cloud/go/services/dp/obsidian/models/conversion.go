@@ -45,9 +45,22 @@ func CbsdToBackend(m *MutableCbsd) (*protos.CbsdData, error) {
 		InstallationParam: &protos.InstallationParam{
 			AntennaGain: to_pointer.FloatToDoubleValue(m.InstallationParam.AntennaGain),
 		},
+		CarrierAggregationParams: carrierAggregationParamsToBackend(m.CarrierAggregationParams),
 	}, nil
 }
 
+func carrierAggregationParamsToBackend(p *CarrierAggregationParams) *protos.CarrierAggregationParams {
+	if p == nil {
+		return nil
+	}
+	return &protos.CarrierAggregationParams{
+		MaxCarriers:          p.MaxCarriers,
+		MinTotalBandwidthMhz: p.MinTotalBandwidthMhz,
+		MaxTotalBandwidthMhz: p.MaxTotalBandwidthMhz,
+		Contiguous:           p.Contiguous,
+	}
+}
+
 func CbsdFromBackend(details *protos.CbsdDetails) *Cbsd {
 	return &Cbsd{
 		Capabilities: Capabilities{
@@ -74,6 +87,57 @@ func CbsdFromBackend(details *protos.CbsdDetails) *Cbsd {
 		SingleStepEnabled:         details.Data.SingleStepEnabled,
 		CbsdCategory:              details.Data.CbsdCategory,
 		InstallationParam:         getModelInstallationParam(details.Data.InstallationParam),
+		CpiSignature:              getCpiSignature(details.CpiSignature),
+		PlannedFrequenciesMhz:     makeSliceNotNil(details.PlannedFrequenciesMhz),
+		CarrierAggregationParams:  getCarrierAggregationParams(details.Data.CarrierAggregationParams),
+	}
+}
+
+func getCarrierAggregationParams(p *protos.CarrierAggregationParams) *CarrierAggregationParams {
+	if p == nil {
+		return nil
+	}
+	return &CarrierAggregationParams{
+		MaxCarriers:          p.MaxCarriers,
+		MinTotalBandwidthMhz: p.MinTotalBandwidthMhz,
+		MaxTotalBandwidthMhz: p.MaxTotalBandwidthMhz,
+		Contiguous:           p.Contiguous,
+	}
+}
+
+func getCpiSignature(signature *protos.CpiSignature) *CpiSignature {
+	if signature == nil {
+		return nil
+	}
+	return &CpiSignature{
+		CpiID:                    signature.CpiId,
+		InstallCertificationTime: strfmt.DateTime(time.Unix(signature.InstallCertificationTimestamp, 0).UTC()),
+	}
+}
+
+func CpiToBackend(m *MutableCpi) *protos.CpiData {
+	return &protos.CpiData{
+		CpiId:      m.CpiID,
+		CpiName:    m.CpiName,
+		PrivateKey: m.PrivateKey,
+	}
+}
+
+func CpiFromBackend(details *protos.CpiDetails) *Cpi {
+	return &Cpi{
+		CpiID:     details.CpiId,
+		CpiName:   details.CpiName,
+		PublicKey: details.PublicKey,
+	}
+}
+
+func CpiSignedDataFromBackend(data *protos.FetchCpiSignedDataResponse) *CpiSignedData {
+	return &CpiSignedData{
+		CbsdCategory:      data.CbsdCategory,
+		CpiSignature:      getCpiSignature(data.CpiSignature),
+		FccID:             data.FccId,
+		InstallationParam: getModelInstallationParam(data.InstallationParam),
+		SerialNumber:      data.SerialNumber,
 	}
 }
 
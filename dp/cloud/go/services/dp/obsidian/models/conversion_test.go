@@ -15,9 +15,12 @@ package models_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
 
+	"magma/dp/cloud/go/protos"
 	b "magma/dp/cloud/go/services/dp/builders"
 	"magma/dp/cloud/go/services/dp/obsidian/models"
 	"magma/dp/cloud/go/services/dp/obsidian/to_pointer"
@@ -118,3 +121,80 @@ func TestCbsdFromBackendWithEmptyFrequencies(t *testing.T) {
 	assert.Equal(t, []int64{}, data.FrequencyPreferences.FrequenciesMhz)
 	assert.Equal(t, int64(0), data.FrequencyPreferences.BandwidthMhz)
 }
+
+func TestCbsdFromBackendWithoutCpiSignature(t *testing.T) {
+	details := b.NewDetailedProtoCbsdBuilder(
+		b.NewCbsdProtoPayloadBuilder()).Details
+	data := models.CbsdFromBackend(details)
+	assert.Nil(t, data.CpiSignature)
+}
+
+func TestCbsdFromBackendWithCpiSignature(t *testing.T) {
+	details := b.NewDetailedProtoCbsdBuilder(
+		b.NewCbsdProtoPayloadBuilder()).Details
+	details.CpiSignature = &protos.CpiSignature{CpiId: "some_cpi_id", InstallCertificationTimestamp: 1234}
+	data := models.CbsdFromBackend(details)
+	expected := &models.CpiSignature{
+		CpiID:                    "some_cpi_id",
+		InstallCertificationTime: strfmt.DateTime(time.Unix(1234, 0).UTC()),
+	}
+	assert.Equal(t, expected, data.CpiSignature)
+}
+
+func TestCbsdFromBackendWithPlannedFrequencies(t *testing.T) {
+	details := b.NewDetailedProtoCbsdBuilder(
+		b.NewCbsdProtoPayloadBuilder()).Details
+	details.PlannedFrequenciesMhz = []int64{3580, 3560}
+	data := models.CbsdFromBackend(details)
+	assert.Equal(t, []int64{3580, 3560}, data.PlannedFrequenciesMhz)
+}
+
+func TestCbsdToBackendWithCarrierAggregationParams(t *testing.T) {
+	cbsd := b.NewMutableCbsdModelPayloadBuilder().
+		WithCarrierAggregationParams(&models.CarrierAggregationParams{
+			MaxCarriers:          3,
+			MinTotalBandwidthMhz: 20,
+			MaxTotalBandwidthMhz: 60,
+			Contiguous:           true,
+		}).
+		Payload
+	data, _ := models.CbsdToBackend(cbsd)
+	expected := &protos.CarrierAggregationParams{
+		MaxCarriers:          3,
+		MinTotalBandwidthMhz: 20,
+		MaxTotalBandwidthMhz: 60,
+		Contiguous:           true,
+	}
+	assert.Equal(t, expected, data.CarrierAggregationParams)
+}
+
+func TestCbsdFromBackendWithCarrierAggregationParams(t *testing.T) {
+	details := b.NewDetailedProtoCbsdBuilder(
+		b.NewCbsdProtoPayloadBuilder().
+			WithCarrierAggregationParams(&protos.CarrierAggregationParams{MaxCarriers: 4})).
+		Details
+	data := models.CbsdFromBackend(details)
+	assert.Equal(t, &models.CarrierAggregationParams{MaxCarriers: 4}, data.CarrierAggregationParams)
+}
+
+func TestCpiToBackend(t *testing.T) {
+	cpi := &models.MutableCpi{CpiID: "some_cpi_id", CpiName: "some name", PrivateKey: "some key"}
+	data := models.CpiToBackend(cpi)
+	expected := &protos.CpiData{CpiId: "some_cpi_id", CpiName: "some name", PrivateKey: "some key"}
+	assert.Equal(t, expected, data)
+}
+
+func TestCpiSignedDataFromBackend(t *testing.T) {
+	installationParam := b.NewCbsdProtoPayloadBuilder().WithFullInstallationParam().Payload.InstallationParam
+	data := models.CpiSignedDataFromBackend(&protos.FetchCpiSignedDataResponse{
+		FccId:             "some_fcc_id",
+		SerialNumber:      "some_serial_number",
+		CbsdCategory:      "b",
+		InstallationParam: installationParam,
+	})
+	assert.Equal(t, "some_fcc_id", data.FccID)
+	assert.Equal(t, "some_serial_number", data.SerialNumber)
+	assert.Equal(t, "b", data.CbsdCategory)
+	assert.Equal(t, &installationParam.LatitudeDeg.Value, data.InstallationParam.LatitudeDeg)
+	assert.Nil(t, data.CpiSignature)
+}
@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CpiSignRequest cpi sign request
+//
+// swagger:model cpi_sign_request
+type CpiSignRequest struct {
+
+	// id of cpi which certifies installation parameters
+	// Example: some_cpi_id
+	// Required: true
+	// Min Length: 1
+	CpiID string `json:"cpi_id"`
+}
+
+// Validate validates this cpi sign request
+func (m *CpiSignRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCpiID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CpiSignRequest) validateCpiID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("cpi_id", "body", m.CpiID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("cpi_id", "body", m.CpiID, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cpi sign request based on context it is used
+func (m *CpiSignRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CpiSignRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CpiSignRequest) UnmarshalBinary(b []byte) error {
+	var res CpiSignRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CpiSignature information about cpi signature of installation parameters
+//
+// swagger:model cpi_signature
+type CpiSignature struct {
+
+	// cpi id
+	// Example: some_cpi_id
+	// Required: true
+	CpiID string `json:"cpi_id"`
+
+	// install certification time
+	// Required: true
+	// Format: date-time
+	InstallCertificationTime strfmt.DateTime `json:"install_certification_time"`
+}
+
+// Validate validates this cpi signature
+func (m *CpiSignature) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCpiID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInstallCertificationTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CpiSignature) validateCpiID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("cpi_id", "body", m.CpiID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CpiSignature) validateInstallCertificationTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("install_certification_time", "body", strfmt.DateTime(m.InstallCertificationTime)); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("install_certification_time", "body", "date-time", m.InstallCertificationTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cpi signature based on context it is used
+func (m *CpiSignature) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CpiSignature) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CpiSignature) UnmarshalBinary(b []byte) error {
+	var res CpiSignature
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,234 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CpiSignedData cbsd data which is signed by cpi
+//
+// swagger:model cpi_signed_data
+type CpiSignedData struct {
+
+	// cbsd category
+	// Required: true
+	// Enum: [a b]
+	CbsdCategory string `json:"cbsd_category"`
+
+	// cpi signature
+	CpiSignature *CpiSignature `json:"cpi_signature,omitempty"`
+
+	// fcc id
+	// Example: some_fcc_id
+	// Required: true
+	FccID string `json:"fcc_id"`
+
+	// installation param
+	// Required: true
+	InstallationParam InstallationParam `json:"installation_param"`
+
+	// serial number
+	// Example: some_serial_number
+	// Required: true
+	SerialNumber string `json:"serial_number"`
+}
+
+// Validate validates this cpi signed data
+func (m *CpiSignedData) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCbsdCategory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCpiSignature(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFccID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInstallationParam(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSerialNumber(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var cpiSignedDataTypeCbsdCategoryPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["a","b"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		cpiSignedDataTypeCbsdCategoryPropEnum = append(cpiSignedDataTypeCbsdCategoryPropEnum, v)
+	}
+}
+
+const (
+
+	// CpiSignedDataCbsdCategoryA captures enum value "a"
+	CpiSignedDataCbsdCategoryA string = "a"
+
+	// CpiSignedDataCbsdCategoryB captures enum value "b"
+	CpiSignedDataCbsdCategoryB string = "b"
+)
+
+// prop value enum
+func (m *CpiSignedData) validateCbsdCategoryEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, cpiSignedDataTypeCbsdCategoryPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CpiSignedData) validateCbsdCategory(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("cbsd_category", "body", m.CbsdCategory); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateCbsdCategoryEnum("cbsd_category", "body", m.CbsdCategory); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CpiSignedData) validateCpiSignature(formats strfmt.Registry) error {
+	if swag.IsZero(m.CpiSignature) { // not required
+		return nil
+	}
+
+	if m.CpiSignature != nil {
+		if err := m.CpiSignature.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cpi_signature")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cpi_signature")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CpiSignedData) validateFccID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("fcc_id", "body", m.FccID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CpiSignedData) validateInstallationParam(formats strfmt.Registry) error {
+
+	if err := m.InstallationParam.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("installation_param")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("installation_param")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *CpiSignedData) validateSerialNumber(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("serial_number", "body", m.SerialNumber); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this cpi signed data based on the context it is used
+func (m *CpiSignedData) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCpiSignature(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateInstallationParam(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CpiSignedData) contextValidateCpiSignature(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CpiSignature != nil {
+		if err := m.CpiSignature.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cpi_signature")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("cpi_signature")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CpiSignedData) contextValidateInstallationParam(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.InstallationParam.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("installation_param")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("installation_param")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CpiSignedData) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CpiSignedData) UnmarshalBinary(b []byte) error {
+	var res CpiSignedData
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
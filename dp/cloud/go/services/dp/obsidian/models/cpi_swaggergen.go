@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Cpi Certified Professional Installer
+//
+// swagger:model cpi
+type Cpi struct {
+
+	// cpi id
+	// Example: some_cpi_id
+	// Required: true
+	// Min Length: 1
+	CpiID string `json:"cpi_id"`
+
+	// cpi name
+	// Example: John Doe
+	// Required: true
+	// Min Length: 1
+	CpiName string `json:"cpi_name"`
+
+	// PEM encoded public key which can be used to verify cpi signatures
+	// Required: true
+	PublicKey string `json:"public_key"`
+}
+
+// Validate validates this cpi
+func (m *Cpi) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCpiID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCpiName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePublicKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Cpi) validateCpiID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("cpi_id", "body", m.CpiID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("cpi_id", "body", m.CpiID, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cpi) validateCpiName(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("cpi_name", "body", m.CpiName); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("cpi_name", "body", m.CpiName, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cpi) validatePublicKey(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("public_key", "body", m.PublicKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this cpi based on context it is used
+func (m *Cpi) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Cpi) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Cpi) UnmarshalBinary(b []byte) error {
+	var res Cpi
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -28,6 +28,9 @@ type MutableCbsd struct {
 	// Required: true
 	CarrierAggregationEnabled *bool `json:"carrier_aggregation_enabled"`
 
+	// carrier aggregation params
+	CarrierAggregationParams *CarrierAggregationParams `json:"carrier_aggregation_params,omitempty"`
+
 	// is the radio type A (only) or B (also applies to A/B type radios)
 	// Required: true
 	// Enum: [a b]
@@ -84,6 +87,10 @@ func (m *MutableCbsd) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCarrierAggregationParams(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCbsdCategory(formats); err != nil {
 		res = append(res, err)
 	}
@@ -149,6 +156,25 @@ func (m *MutableCbsd) validateCarrierAggregationEnabled(formats strfmt.Registry)
 	return nil
 }
 
+func (m *MutableCbsd) validateCarrierAggregationParams(formats strfmt.Registry) error {
+	if swag.IsZero(m.CarrierAggregationParams) { // not required
+		return nil
+	}
+
+	if m.CarrierAggregationParams != nil {
+		if err := m.CarrierAggregationParams.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("carrier_aggregation_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("carrier_aggregation_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var mutableCbsdTypeCbsdCategoryPropEnum []interface{}
 
 func init() {
@@ -331,6 +357,10 @@ func (m *MutableCbsd) ContextValidate(ctx context.Context, formats strfmt.Regist
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateCarrierAggregationParams(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateFrequencyPreferences(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -359,6 +389,22 @@ func (m *MutableCbsd) contextValidateCapabilities(ctx context.Context, formats s
 	return nil
 }
 
+func (m *MutableCbsd) contextValidateCarrierAggregationParams(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CarrierAggregationParams != nil {
+		if err := m.CarrierAggregationParams.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("carrier_aggregation_params")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("carrier_aggregation_params")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *MutableCbsd) contextValidateFrequencyPreferences(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.FrequencyPreferences.ContextValidate(ctx, formats); err != nil {
@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// MutableCpi mutable cpi
+//
+// swagger:model mutable_cpi
+type MutableCpi struct {
+
+	// cpi id
+	// Example: some_cpi_id
+	// Required: true
+	// Min Length: 1
+	CpiID string `json:"cpi_id"`
+
+	// cpi name
+	// Example: John Doe
+	// Required: true
+	// Min Length: 1
+	CpiName string `json:"cpi_name"`
+
+	// PEM encoded RSA or EC (P-256) private key of cpi, new RSA key is generated if not provided
+	PrivateKey string `json:"private_key,omitempty"`
+}
+
+// Validate validates this mutable cpi
+func (m *MutableCpi) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCpiID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCpiName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MutableCpi) validateCpiID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("cpi_id", "body", m.CpiID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("cpi_id", "body", m.CpiID, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MutableCpi) validateCpiName(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("cpi_name", "body", m.CpiName); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("cpi_name", "body", m.CpiName, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this mutable cpi based on context it is used
+func (m *MutableCpi) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *MutableCpi) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *MutableCpi) UnmarshalBinary(b []byte) error {
+	var res MutableCpi
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -21,6 +21,16 @@ func (m *MutableCbsd) ValidateModel(_ context.Context) error {
 		err := fmt.Errorf("max_ibw_mhz cannot be less than bandwidth_mhz")
 		res = append(res, err)
 	}
+	if p := m.CarrierAggregationParams; p != nil {
+		if !*m.CarrierAggregationEnabled {
+			err := fmt.Errorf("carrier_aggregation_params cannot be set when carrier_aggregation_enabled is disabled")
+			res = append(res, err)
+		}
+		if p.MinTotalBandwidthMhz > 0 && p.MaxTotalBandwidthMhz > 0 && p.MinTotalBandwidthMhz > p.MaxTotalBandwidthMhz {
+			err := fmt.Errorf("min_total_bandwidth_mhz cannot be greater than max_total_bandwidth_mhz")
+			res = append(res, err)
+		}
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -93,6 +93,18 @@ func TestMutableCbsd_Validate(t *testing.T) {
 		name:          "Should validate max ibw mhz not multiple of 5",
 		data:          b.NewMutableCbsdModelPayloadBuilder().WithMaxIbwMhz(7).Payload,
 		expectedError: "max_ibw_mhz in body should be a multiple of 5",
+	}, {
+		name: "Should validate max carriers",
+		data: b.NewMutableCbsdModelPayloadBuilder().
+			WithCarrierAggregationParams(&models.CarrierAggregationParams{MaxCarriers: 5}).
+			Payload,
+		expectedError: "max_carriers in body should be less than or equal to 4",
+	}, {
+		name: "Should validate total bandwidth not multiple of 5",
+		data: b.NewMutableCbsdModelPayloadBuilder().
+			WithCarrierAggregationParams(&models.CarrierAggregationParams{MaxCarriers: 2, MaxTotalBandwidthMhz: 12}).
+			Payload,
+		expectedError: "max_total_bandwidth_mhz in body should be a multiple of 5",
 	}}
 	for _, tt := range testData {
 		t.Run(tt.name, func(t *testing.T) {
@@ -119,6 +131,23 @@ func TestMutableCbsd_ValidateModel(t *testing.T) {
 		data: b.NewMutableCbsdModelPayloadBuilder().
 			WithMaxIbwMhz(5).WithBandwidth(10).Payload,
 		expectedError: "max_ibw_mhz cannot be less than bandwidth_mhz",
+	}, {
+		name: "Should validate carrier aggregation params with carrier aggregation disabled",
+		data: b.NewMutableCbsdModelPayloadBuilder().
+			WithCarrierAggregationParams(&models.CarrierAggregationParams{MaxCarriers: 3}).
+			Payload,
+		expectedError: "carrier_aggregation_params cannot be set when carrier_aggregation_enabled is disabled",
+	}, {
+		name: "Should validate min total bandwidth greater than max total bandwidth",
+		data: b.NewMutableCbsdModelPayloadBuilder().
+			WithCarrierAggregationEnabled(to_pointer.Bool(true)).
+			WithCarrierAggregationParams(&models.CarrierAggregationParams{
+				MaxCarriers:          3,
+				MinTotalBandwidthMhz: 40,
+				MaxTotalBandwidthMhz: 30,
+			}).
+			Payload,
+		expectedError: "min_total_bandwidth_mhz cannot be greater than max_total_bandwidth_mhz",
 	}}
 	c := context.TODO()
 	for _, tt := range testData {
@@ -0,0 +1,230 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sas_client
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	"magma/dp/cloud/go/services/dp/logs_pusher"
+	"magma/dp/cloud/go/services/dp/metrics"
+	"magma/dp/cloud/go/services/dp/storage"
+)
+
+// App periodically consumes pending requests created by active mode controller,
+// sends them to SAS in batches and applies SAS responses to the database.
+type App struct {
+	db              *sql.DB
+	clock           Clock
+	client          Client
+	sasManager      storage.SasManager
+	pollingInterval time.Duration
+	requestLimit    int64
+	logPusher       logs_pusher.LogPusher
+	logConsumerUrl  string
+}
+
+func NewApp(options ...Option) *App {
+	a := &App{}
+	for _, o := range options {
+		o(a)
+	}
+	return a
+}
+
+type Clock interface {
+	Now() time.Time
+	Tick(duration time.Duration) *time.Ticker
+}
+
+type Option func(*App)
+
+func WithDb(db *sql.DB) Option {
+	return func(a *App) { a.db = db }
+}
+
+func WithSasManager(manager storage.SasManager) Option {
+	return func(a *App) { a.sasManager = manager }
+}
+
+func WithClient(client Client) Option {
+	return func(a *App) { a.client = client }
+}
+
+func WithClock(clock Clock) Option {
+	return func(a *App) { a.clock = clock }
+}
+
+func WithPollingInterval(interval time.Duration) Option {
+	return func(a *App) { a.pollingInterval = interval }
+}
+
+func WithRequestLimit(limit int64) Option {
+	return func(a *App) { a.requestLimit = limit }
+}
+
+func WithLogPusher(pusher logs_pusher.LogPusher, consumerUrl string) Option {
+	return func(a *App) {
+		a.logPusher = pusher
+		a.logConsumerUrl = consumerUrl
+	}
+}
+
+func (a *App) Run(ctx context.Context) error {
+	ticker := a.clock.Tick(a.pollingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			a.ProcessRequests(ctx)
+		}
+	}
+}
+
+// ProcessRequests sends one batch of pending requests of each type to SAS.
+// Requests are removed only if SAS response was processed successfully,
+// otherwise they are left in the database to be retried in next iteration.
+// Requests are fetched and responses are applied in separate short
+// transactions, no transaction is held while waiting for SAS.
+func (a *App) ProcessRequests(ctx context.Context) {
+	for _, requestType := range RequestTypes {
+		if err := a.processRequestType(ctx, requestType); err != nil {
+			glog.Errorf("failed to process %s: %s", requestType, err)
+		}
+	}
+}
+
+type pendingRequests struct {
+	requests []*storage.DBRequest
+	cbsds    map[int64]*storage.DBCbsd
+}
+
+func (a *App) processRequestType(ctx context.Context, requestType string) error {
+	pending, err := storage.WithinTx(a.db, func(tx *sql.Tx) (*pendingRequests, error) {
+		return a.getPendingRequests(tx, requestType)
+	})
+	if err != nil || len(pending.requests) == 0 {
+		return err
+	}
+	requests := pending.requests
+	payloads := make([]json.RawMessage, len(requests))
+	for i, r := range requests {
+		if payloads[i], err = json.Marshal(r.Payload); err != nil {
+			return err
+		}
+		a.sendLog(ctx, requestType, string(payloads[i]), dpLogSource, sasLogSource, "", pending.cbsds[r.CbsdId.Int64])
+	}
+	start := a.clock.Now()
+	responses, err := a.client.Send(ctx, requestType, payloads)
+	metrics.SasRequestDuration.WithLabelValues(requestType).Observe(a.clock.Now().Sub(start).Seconds())
+	if err != nil {
+		metrics.SasRequestErrors.WithLabelValues(requestType).Inc()
+		return err
+	}
+	responseType := strings.TrimSuffix(requestType, "Request") + "Response"
+	for i, r := range requests {
+		code := strconv.FormatInt(responses[i].Response.ResponseCode, 10)
+		metrics.SasResponses.WithLabelValues(requestType, code).Inc()
+		msg, _ := json.Marshal(responses[i])
+		a.sendLog(ctx, responseType, string(msg), sasLogSource, dpLogSource, code, pending.cbsds[r.CbsdId.Int64])
+	}
+	_, err = storage.WithinTx(a.db, func(tx *sql.Tx) (any, error) {
+		return nil, a.applyResponses(tx, requestType, requests, responses)
+	})
+	return err
+}
+
+func (a *App) getPendingRequests(tx *sql.Tx, requestType string) (*pendingRequests, error) {
+	requests, err := a.sasManager.GetPendingRequests(tx, requestType, a.requestLimit)
+	if err != nil || len(requests) == 0 {
+		return &pendingRequests{}, err
+	}
+	cbsdIds := make([]int64, len(requests))
+	for i, r := range requests {
+		cbsdIds[i] = r.CbsdId.Int64
+	}
+	cbsds, err := a.sasManager.GetCbsds(tx, cbsdIds)
+	if err != nil {
+		return nil, err
+	}
+	return &pendingRequests{requests: requests, cbsds: cbsds}, nil
+}
+
+// applyResponses processes the responses of requests which still exist,
+// requests removed while waiting for SAS (e.g. with their CBSD) are skipped.
+func (a *App) applyResponses(tx *sql.Tx, requestType string, requests []*storage.DBRequest, responses []*Response) error {
+	ids := make([]int64, len(requests))
+	for i, r := range requests {
+		ids[i] = r.Id.Int64
+	}
+	existing, err := a.sasManager.LockRequests(tx, ids)
+	if err != nil {
+		return err
+	}
+	processor := &responseProcessor{
+		manager: a.sasManager,
+		now:     a.clock.Now(),
+	}
+	for i, r := range requests {
+		if !existing[r.Id.Int64] {
+			glog.Warningf("%s %d was removed while waiting for SAS response, skipping it", requestType, r.Id.Int64)
+			continue
+		}
+		if err := processor.process(tx, requestType, r, responses[i]); err != nil {
+			return err
+		}
+		if err := a.sasManager.DeleteRequest(tx, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const (
+	dpLogSource  = "DP"
+	sasLogSource = "SAS"
+)
+
+// sendLog emits the same DP logs of SAS requests and responses
+// as the python configuration controller does.
+func (a *App) sendLog(ctx context.Context, name string, msg string, from string, to string, code string, cbsd *storage.DBCbsd) {
+	if a.logPusher == nil {
+		return
+	}
+	if cbsd == nil {
+		cbsd = &storage.DBCbsd{}
+	}
+	log := &logs_pusher.DPLog{
+		EventTimestamp:   a.clock.Now().UTC().Unix(),
+		LogFrom:          from,
+		LogTo:            to,
+		LogName:          name,
+		LogMessage:       msg,
+		CbsdSerialNumber: cbsd.CbsdSerialNumber.String,
+		NetworkId:        cbsd.NetworkId.String,
+		FccId:            cbsd.FccId.String,
+		ResponseCode:     code,
+	}
+	if err := a.logPusher(ctx, log, a.logConsumerUrl); err != nil {
+		glog.Warningf("Failed to log %s. Details: %s", name, err)
+	}
+}
@@ -0,0 +1,455 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sas_client_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/suite"
+
+	"magma/dp/cloud/go/services/dp/logs_pusher"
+	"magma/dp/cloud/go/services/dp/metrics"
+	"magma/dp/cloud/go/services/dp/sas_client"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/dp/cloud/go/services/dp/storage/dbtest"
+	"magma/orc8r/cloud/go/sqorc"
+)
+
+const (
+	cbsdDbId     = 1
+	cbsdId       = "some_cbsd_id"
+	serialNumber = "some_serial_number"
+	grantId      = "some_grant_id"
+)
+
+func TestApp(t *testing.T) {
+	suite.Run(t, &AppTestSuite{})
+}
+
+type AppTestSuite struct {
+	suite.Suite
+	database        *sql.DB
+	resourceManager dbtest.ResourceManager
+	enumMaps        map[string]map[string]int64
+	sas             *mockSas
+	app             *sas_client.App
+	now             time.Time
+	logs            []*logs_pusher.DPLog
+	onSend          func()
+}
+
+func (s *AppTestSuite) SetupSuite() {
+	builder := sqorc.GetSqlBuilder()
+	database, err := sqorc.Open("sqlite3", ":memory:")
+	s.Require().NoError(err)
+	s.database = database
+	s.resourceManager = dbtest.NewResourceManager(s.T(), database, builder)
+	s.Require().NoError(s.resourceManager.CreateTables(
+		&storage.DBCbsdState{},
+		&storage.DBCbsd{},
+		&storage.DBGrantState{},
+		&storage.DBGrant{},
+		&storage.DBRequestType{},
+		&storage.DBRequest{},
+	))
+	enums := []db.Model{}
+	for _, name := range []string{"unregistered", "registered"} {
+		enums = append(enums, &storage.DBCbsdState{Name: db.MakeString(name)})
+	}
+	for _, name := range []string{"idle", "granted", "authorized", "unsync"} {
+		enums = append(enums, &storage.DBGrantState{Name: db.MakeString(name)})
+	}
+	for _, name := range sas_client.RequestTypes {
+		enums = append(enums, &storage.DBRequestType{Name: db.MakeString(name)})
+	}
+	s.Require().NoError(s.resourceManager.InsertResources(db.NewExcludeMask("id"), enums...))
+	s.enumMaps = map[string]map[string]int64{}
+	for _, model := range []db.Model{&storage.DBCbsdState{}, &storage.DBGrantState{}, &storage.DBRequestType{}} {
+		m := map[string]int64{}
+		for _, r := range s.getAll(model, db.NewExcludeMask()) {
+			enum := r.(storage.EnumModel)
+			m[enum.GetName()] = enum.GetId()
+		}
+		s.enumMaps[model.GetMetadata().Table] = m
+	}
+}
+
+func (s *AppTestSuite) SetupTest() {
+	s.now = time.Unix(1e9, 0).UTC()
+	s.sas = newMockSas(s.T())
+	s.logs = nil
+	s.onSend = nil
+	manager := storage.NewSasManager(s.database, sqorc.GetSqlBuilder(), sqorc.SQLiteErrorChecker{}, sqorc.GetSqlLocker())
+	s.app = sas_client.NewApp(
+		sas_client.WithDb(s.database),
+		sas_client.WithSasManager(manager),
+		sas_client.WithClient(&hookedClient{Client: s.sas.client(1), onSend: func() {
+			if s.onSend != nil {
+				s.onSend()
+			}
+		}}),
+		sas_client.WithClock(&stubClock{now: s.now}),
+		sas_client.WithRequestLimit(10),
+		sas_client.WithLogPusher(func(_ context.Context, log *logs_pusher.DPLog, consumerUrl string) error {
+			s.Assert().Equal("some_url", consumerUrl)
+			s.logs = append(s.logs, log)
+			return nil
+		}, "some_url"),
+	)
+	stateId := s.enumMaps[storage.CbsdStateTable]["unregistered"]
+	s.givenResourcesInserted(&storage.DBCbsd{
+		Id:                    db.MakeInt(cbsdDbId),
+		NetworkId:             db.MakeString("some_network"),
+		StateId:               db.MakeInt(stateId),
+		DesiredStateId:        db.MakeInt(stateId),
+		CbsdSerialNumber:      db.MakeString(serialNumber),
+		FccId:                 db.MakeString("some_fcc_id"),
+		PreferredBandwidthMHz: db.MakeInt(20),
+		Channels:              []storage.Channel{},
+	})
+}
+
+func (s *AppTestSuite) TearDownTest() {
+	s.Require().NoError(s.resourceManager.DropResources(
+		&storage.DBCbsd{},
+		&storage.DBGrant{},
+		&storage.DBRequest{},
+	))
+}
+
+func (s *AppTestSuite) TestRegistration() {
+	s.givenRequest(sas_client.Registration, map[string]any{"cbsdSerialNumber": serialNumber})
+	s.sas.respond(http.StatusOK, `{"registrationResponse":[{"cbsdId":"some_cbsd_id","response":{"responseCode":0}}]}`)
+
+	s.app.ProcessRequests(context.Background())
+
+	s.Require().Len(s.sas.received, 1)
+	s.Assert().Equal("/registration", s.sas.received[0].path)
+	s.Assert().JSONEq(`{"registrationRequest":[{"cbsdSerialNumber":"some_serial_number"}]}`, s.sas.received[0].body)
+	s.thenCbsdIs("registered", cbsdId, []storage.Channel{})
+	s.thenNoRequestsLeft()
+
+	expectedLogs := []*logs_pusher.DPLog{{
+		EventTimestamp:   s.now.Unix(),
+		LogFrom:          "DP",
+		LogTo:            "SAS",
+		LogName:          "registrationRequest",
+		LogMessage:       `{"cbsdSerialNumber":"some_serial_number"}`,
+		CbsdSerialNumber: serialNumber,
+		NetworkId:        "some_network",
+		FccId:            "some_fcc_id",
+	}, {
+		EventTimestamp:   s.now.Unix(),
+		LogFrom:          "SAS",
+		LogTo:            "DP",
+		LogName:          "registrationResponse",
+		LogMessage:       `{"cbsdId":"some_cbsd_id","response":{"responseCode":0}}`,
+		CbsdSerialNumber: serialNumber,
+		NetworkId:        "some_network",
+		FccId:            "some_fcc_id",
+		ResponseCode:     "0",
+	}}
+	s.Assert().Equal(expectedLogs, s.logs)
+}
+
+func (s *AppTestSuite) TestRequestRemovedWhileWaitingForSasIsSkipped() {
+	s.givenGrant(grantId, "granted")
+	s.givenGrant("removed_grant", "granted")
+	s.givenRequest(sas_client.Heartbeat, map[string]any{"cbsdId": cbsdId, "grantId": grantId})
+	s.givenRequest(sas_client.Heartbeat, map[string]any{"cbsdId": cbsdId, "grantId": "removed_grant"})
+	s.sas.respond(http.StatusOK, `{"heartbeatResponse":[
+		{"cbsdId":"some_cbsd_id","grantId":"some_grant_id","response":{"responseCode":0},"transmitExpireTime":"2001-09-09T01:46:40Z"},
+		{"cbsdId":"some_cbsd_id","grantId":"removed_grant","response":{"responseCode":500}}
+	]}`)
+	// no transaction is held while waiting for SAS,
+	// so the database (limited to a single connection) can be modified
+	s.onSend = func() {
+		err := s.resourceManager.InTransaction(func() {
+			err := db.NewQuery().
+				WithBuilder(s.resourceManager.GetBuilder()).
+				From(&storage.DBRequest{}).
+				Where(sq.Eq{"id": 2}).
+				Delete()
+			s.Require().NoError(err)
+		})
+		s.Require().NoError(err)
+	}
+
+	s.app.ProcessRequests(context.Background())
+
+	expected := []db.Model{
+		&storage.DBGrant{
+			StateId: db.MakeInt(s.enumMaps[storage.GrantStateTable]["authorized"]),
+			GrantId: db.MakeString(grantId),
+		},
+		&storage.DBGrant{
+			StateId: db.MakeInt(s.enumMaps[storage.GrantStateTable]["granted"]),
+			GrantId: db.MakeString("removed_grant"),
+		},
+	}
+	s.Assert().Equal(expected, s.getAll(&storage.DBGrant{}, db.NewIncludeMask("state_id", "grant_id")))
+	s.thenNoRequestsLeft()
+}
+
+func (s *AppTestSuite) TestSpectrumInquiry() {
+	s.givenRequest(sas_client.SpectrumInquiry, map[string]any{"cbsdId": cbsdId})
+	s.sas.respond(http.StatusOK, `{"spectrumInquiryResponse":[{"cbsdId":"some_cbsd_id","response":{"responseCode":0},
+		"availableChannel":[
+			{"frequencyRange":{"lowFrequency":3550000000,"highFrequency":3570000000},"maxEirp":20},
+			{"frequencyRange":{"lowFrequency":3570000000,"highFrequency":3590000000}}
+		]}]}`)
+
+	s.app.ProcessRequests(context.Background())
+
+	expected := []storage.Channel{
+		{LowFrequencyHz: 3550e6, HighFrequencyHz: 3570e6, MaxEirp: 20},
+		{LowFrequencyHz: 3570e6, HighFrequencyHz: 3590e6, MaxEirp: 37},
+	}
+	s.thenCbsdIs("unregistered", "", expected)
+	s.thenNoRequestsLeft()
+}
+
+func (s *AppTestSuite) TestGrant() {
+	s.givenRequest(sas_client.Grant, grantPayload())
+	s.givenRequest(sas_client.Grant, grantPayload())
+	s.sas.respond(http.StatusOK, `{"grantResponse":[
+		{"cbsdId":"some_cbsd_id","grantId":"some_grant_id","response":{"responseCode":0},
+		 "grantExpireTime":"2001-09-09T01:46:40Z","heartbeatInterval":60,"channelType":"GAA"},
+		{"cbsdId":"some_cbsd_id","response":{"responseCode":401,"responseData":["conflicting_grant"]}}
+	]}`)
+
+	s.app.ProcessRequests(context.Background())
+
+	expected := []db.Model{
+		&storage.DBGrant{
+			StateId:              db.MakeInt(s.enumMaps[storage.GrantStateTable]["granted"]),
+			CbsdId:               db.MakeInt(cbsdDbId),
+			GrantId:              db.MakeString(grantId),
+			GrantExpireTime:      db.MakeTime(s.now),
+			HeartbeatIntervalSec: db.MakeInt(60),
+			ChannelType:          db.MakeString("GAA"),
+			LowFrequencyHz:       db.MakeInt(3550e6),
+			HighFrequencyHz:      db.MakeInt(3570e6),
+			MaxEirp:              db.MakeFloat(24),
+		},
+		&storage.DBGrant{
+			StateId:         db.MakeInt(s.enumMaps[storage.GrantStateTable]["unsync"]),
+			CbsdId:          db.MakeInt(cbsdDbId),
+			GrantId:         db.MakeString("conflicting_grant"),
+			LowFrequencyHz:  db.MakeInt(3550e6),
+			HighFrequencyHz: db.MakeInt(3570e6),
+			MaxEirp:         db.MakeFloat(24),
+		},
+	}
+	s.Assert().Equal(expected, s.getAll(&storage.DBGrant{}, db.NewExcludeMask(
+		"id", "transmit_expire_time", "last_heartbeat_request_time",
+	)))
+	s.thenNoRequestsLeft()
+}
+
+func (s *AppTestSuite) TestHeartbeat() {
+	s.givenGrant(grantId, "granted")
+	s.givenGrant("terminated_grant", "authorized")
+	s.givenRequest(sas_client.Heartbeat, map[string]any{"cbsdId": cbsdId, "grantId": grantId})
+	s.givenRequest(sas_client.Heartbeat, map[string]any{"cbsdId": cbsdId, "grantId": "terminated_grant"})
+	s.sas.respond(http.StatusOK, `{"heartbeatResponse":[
+		{"cbsdId":"some_cbsd_id","grantId":"some_grant_id","response":{"responseCode":0},"transmitExpireTime":"2001-09-09T01:46:40Z"},
+		{"cbsdId":"some_cbsd_id","grantId":"terminated_grant","response":{"responseCode":500}}
+	]}`)
+	successes := metrics.SasResponses.WithLabelValues(sas_client.Heartbeat, "0")
+	terminations := metrics.SasResponses.WithLabelValues(sas_client.Heartbeat, "500")
+	successesBefore, terminationsBefore := testutil.ToFloat64(successes), testutil.ToFloat64(terminations)
+
+	s.app.ProcessRequests(context.Background())
+
+	s.Assert().Equal(1.0, testutil.ToFloat64(successes)-successesBefore)
+	s.Assert().Equal(1.0, testutil.ToFloat64(terminations)-terminationsBefore)
+
+	expected := []db.Model{
+		&storage.DBGrant{
+			StateId:                  db.MakeInt(s.enumMaps[storage.GrantStateTable]["authorized"]),
+			GrantId:                  db.MakeString(grantId),
+			TransmitExpireTime:       db.MakeTime(s.now),
+			LastHeartbeatRequestTime: db.MakeTime(s.now),
+		},
+		&storage.DBGrant{
+			StateId: db.MakeInt(s.enumMaps[storage.GrantStateTable]["idle"]),
+			GrantId: db.MakeString("terminated_grant"),
+		},
+	}
+	s.Assert().Equal(expected, s.getAll(&storage.DBGrant{}, db.NewIncludeMask(
+		"state_id", "grant_id", "transmit_expire_time", "last_heartbeat_request_time",
+	)))
+	s.thenNoRequestsLeft()
+}
+
+func (s *AppTestSuite) TestDeregisterResponseCodeUnregistersCbsd() {
+	s.givenCbsdRegistered()
+	s.givenGrant(grantId, "authorized")
+	s.givenRequest(sas_client.Heartbeat, map[string]any{"cbsdId": cbsdId, "grantId": grantId})
+	s.sas.respond(http.StatusOK, `{"heartbeatResponse":[{"cbsdId":"some_cbsd_id","grantId":"some_grant_id","response":{"responseCode":105}}]}`)
+
+	s.app.ProcessRequests(context.Background())
+
+	s.thenCbsdIs("unregistered", cbsdId, []storage.Channel{})
+	s.Assert().Empty(s.getAll(&storage.DBGrant{}, db.NewIncludeMask("id")))
+	s.thenNoRequestsLeft()
+}
+
+func (s *AppTestSuite) TestRelinquishmentAndDeregistration() {
+	s.givenCbsdRegistered()
+	s.givenGrant(grantId, "authorized")
+	s.givenRequest(sas_client.Relinquishment, map[string]any{"cbsdId": cbsdId, "grantId": grantId})
+	s.givenRequest(sas_client.Deregistration, map[string]any{"cbsdId": cbsdId})
+	s.sas.respond(http.StatusOK, `{"relinquishmentResponse":[{"cbsdId":"some_cbsd_id","grantId":"some_grant_id","response":{"responseCode":0}}]}`)
+	s.sas.respond(http.StatusOK, `{"deregistrationResponse":[{"cbsdId":"some_cbsd_id","response":{"responseCode":0}}]}`)
+
+	s.app.ProcessRequests(context.Background())
+
+	s.Require().Len(s.sas.received, 2)
+	s.Assert().Equal("/relinquishment", s.sas.received[0].path)
+	s.Assert().Equal("/deregistration", s.sas.received[1].path)
+	s.thenCbsdIs("unregistered", cbsdId, []storage.Channel{})
+	s.thenNoRequestsLeft()
+}
+
+func (s *AppTestSuite) TestRequestsAreKeptWhenSasIsUnavailable() {
+	s.givenRequest(sas_client.Registration, map[string]any{"cbsdSerialNumber": serialNumber})
+	s.sas.respond(http.StatusServiceUnavailable, "")
+	s.sas.respond(http.StatusServiceUnavailable, "")
+	failures := metrics.SasRequestErrors.WithLabelValues(sas_client.Registration)
+	failuresBefore := testutil.ToFloat64(failures)
+
+	s.app.ProcessRequests(context.Background())
+
+	s.Assert().Equal(1.0, testutil.ToFloat64(failures)-failuresBefore)
+
+	s.Assert().Len(s.sas.received, 2)
+	s.Assert().Len(s.getAll(&storage.DBRequest{}, db.NewIncludeMask("id")), 1)
+	s.thenCbsdIs("unregistered", "", []storage.Channel{})
+}
+
+func grantPayload() map[string]any {
+	return map[string]any{
+		"cbsdId": cbsdId,
+		"operationParam": map[string]any{
+			"maxEirp": 24,
+			"operationFrequencyRange": map[string]any{
+				"lowFrequency":  3550e6,
+				"highFrequency": 3570e6,
+			},
+		},
+	}
+}
+
+func (s *AppTestSuite) givenRequest(requestType string, payload any) {
+	s.givenResourcesInserted(&storage.DBRequest{
+		TypeId:  db.MakeInt(s.enumMaps[storage.RequestTypeTable][requestType]),
+		CbsdId:  db.MakeInt(cbsdDbId),
+		Payload: payload,
+	})
+}
+
+func (s *AppTestSuite) givenGrant(id string, state string) {
+	s.givenResourcesInserted(&storage.DBGrant{
+		StateId:         db.MakeInt(s.enumMaps[storage.GrantStateTable][state]),
+		CbsdId:          db.MakeInt(cbsdDbId),
+		GrantId:         db.MakeString(id),
+		LowFrequencyHz:  db.MakeInt(3550e6),
+		HighFrequencyHz: db.MakeInt(3570e6),
+		MaxEirp:         db.MakeFloat(24),
+	})
+}
+
+func (s *AppTestSuite) givenCbsdRegistered() {
+	err := s.resourceManager.InTransaction(func() {
+		_, err := db.NewQuery().
+			WithBuilder(s.resourceManager.GetBuilder()).
+			From(&storage.DBCbsd{
+				StateId: db.MakeInt(s.enumMaps[storage.CbsdStateTable]["registered"]),
+				CbsdId:  db.MakeString(cbsdId),
+			}).
+			Select(db.NewIncludeMask()).
+			Where(sq.Eq{"id": cbsdDbId}).
+			Update(db.NewIncludeMask("state_id", "cbsd_id"))
+		s.Require().NoError(err)
+	})
+	s.Require().NoError(err)
+}
+
+func (s *AppTestSuite) givenResourcesInserted(models ...db.Model) {
+	s.Require().NoError(s.resourceManager.InsertResources(db.NewExcludeMask("id"), models...))
+}
+
+func (s *AppTestSuite) thenCbsdIs(state string, cbsdId string, channels []storage.Channel) {
+	expected := []db.Model{&storage.DBCbsd{
+		StateId:  db.MakeInt(s.enumMaps[storage.CbsdStateTable][state]),
+		CbsdId:   sql.NullString{String: cbsdId, Valid: cbsdId != ""},
+		Channels: channels,
+	}}
+	s.Assert().Equal(expected, s.getAll(&storage.DBCbsd{}, db.NewIncludeMask("state_id", "cbsd_id", "channels")))
+}
+
+func (s *AppTestSuite) thenNoRequestsLeft() {
+	s.Assert().Empty(s.getAll(&storage.DBRequest{}, db.NewIncludeMask("id")))
+}
+
+func (s *AppTestSuite) getAll(model db.Model, mask db.FieldMask) []db.Model {
+	var models []db.Model
+	err := s.resourceManager.InTransaction(func() {
+		res, err := db.NewQuery().
+			WithBuilder(s.resourceManager.GetBuilder()).
+			From(model).
+			Select(mask).
+			OrderBy("id", db.OrderAsc).
+			List()
+		s.Require().NoError(err)
+		for _, r := range res {
+			models = append(models, r[0])
+		}
+	})
+	s.Require().NoError(err)
+	return models
+}
+
+// hookedClient calls onSend before sending requests to SAS
+type hookedClient struct {
+	sas_client.Client
+	onSend func()
+}
+
+func (c *hookedClient) Send(ctx context.Context, requestType string, payloads []json.RawMessage) ([]*sas_client.Response, error) {
+	c.onSend()
+	return c.Client.Send(ctx, requestType, payloads)
+}
+
+type stubClock struct {
+	now time.Time
+}
+
+func (c *stubClock) Now() time.Time {
+	return c.now
+}
+
+func (c *stubClock) Tick(time.Duration) *time.Ticker {
+	return &time.Ticker{}
+}
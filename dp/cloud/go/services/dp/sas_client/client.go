@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sas_client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Client sends batched requests to SAS as described in WINNF-TS-0016.
+type Client interface {
+	// Send posts all payloads as a single request of given type
+	// (e.g. "grantRequest") and returns responses in request order.
+	Send(ctx context.Context, requestType string, payloads []json.RawMessage) ([]*Response, error)
+}
+
+type Response struct {
+	CbsdId             string              `json:"cbsdId,omitempty"`
+	GrantId            string              `json:"grantId,omitempty"`
+	Response           *ResponseStatus     `json:"response"`
+	GrantExpireTime    string              `json:"grantExpireTime,omitempty"`
+	TransmitExpireTime string              `json:"transmitExpireTime,omitempty"`
+	HeartbeatInterval  int64               `json:"heartbeatInterval,omitempty"`
+	ChannelType        string              `json:"channelType,omitempty"`
+	AvailableChannel   []*AvailableChannel `json:"availableChannel,omitempty"`
+}
+
+type ResponseStatus struct {
+	ResponseCode    int64    `json:"responseCode"`
+	ResponseMessage string   `json:"responseMessage,omitempty"`
+	ResponseData    []string `json:"responseData,omitempty"`
+}
+
+type AvailableChannel struct {
+	FrequencyRange *FrequencyRange `json:"frequencyRange"`
+	MaxEirp        *float64        `json:"maxEirp,omitempty"`
+}
+
+type FrequencyRange struct {
+	LowFrequency  int64 `json:"lowFrequency"`
+	HighFrequency int64 `json:"highFrequency"`
+}
+
+// Backoff describes how failed SAS requests are retried.
+// Only transport errors and 5xx/429 statuses are retried,
+// SAS response codes are always handled by the caller.
+type Backoff struct {
+	MaxRetries int
+	Initial    time.Duration
+	Max        time.Duration
+}
+
+func (b Backoff) delay(attempt int) time.Duration {
+	d := b.Initial << attempt
+	if d <= 0 || (b.Max > 0 && d > b.Max) {
+		return b.Max
+	}
+	return d
+}
+
+type sasClient struct {
+	httpClient *http.Client
+	baseUrl    string
+	backoff    Backoff
+}
+
+func NewClient(baseUrl string, tlsConfig *tls.Config, timeout time.Duration, backoff Backoff) *sasClient {
+	return &sasClient{
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		baseUrl: strings.TrimSuffix(baseUrl, "/"),
+		backoff: backoff,
+	}
+}
+
+// LoadTLSConfig prepares mutual TLS configuration from client certificate,
+// client key and certificate of SAS certificate authority.
+func LoadTLSConfig(certPath string, keyPath string, caPath string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	ca, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sas ca certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates found in %s", caPath)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (c *sasClient) Send(ctx context.Context, requestType string, payloads []json.RawMessage) ([]*Response, error) {
+	method := strings.TrimSuffix(requestType, "Request")
+	body, err := json.Marshal(map[string][]json.RawMessage{requestType: payloads})
+	if err != nil {
+		return nil, err
+	}
+	var raw []byte
+	for attempt := 0; ; attempt++ {
+		var retry bool
+		raw, retry, err = c.post(ctx, method, body)
+		if err == nil || !retry || attempt >= c.backoff.MaxRetries {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.backoff.delay(attempt)):
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	responseType := method + "Response"
+	var decoded map[string][]*Response
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", responseType, err)
+	}
+	responses := decoded[responseType]
+	if len(responses) != len(payloads) {
+		return nil, fmt.Errorf("expected %d %s, got %d", len(payloads), responseType, len(responses))
+	}
+	for i, r := range responses {
+		if r == nil || r.Response == nil {
+			return nil, fmt.Errorf("%s %d has no response status", responseType, i)
+		}
+	}
+	return responses, nil
+}
+
+func (c *sasClient) post(ctx context.Context, method string, body []byte) ([]byte, bool, error) {
+	url := fmt.Sprintf("%s/%s", c.baseUrl, method)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		retry := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+		return nil, retry, fmt.Errorf("sas %s returned status %d: %s", method, resp.StatusCode, raw)
+	}
+	return raw, false, nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sas_client_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/dp/cloud/go/services/dp/sas_client"
+)
+
+func TestSendBatchesRequests(t *testing.T) {
+	sas := newMockSas(t)
+	sas.respond(http.StatusOK, `{"grantResponse":[
+		{"cbsdId":"cbsd1","grantId":"grant1","response":{"responseCode":0}},
+		{"cbsdId":"cbsd2","response":{"responseCode":401,"responseData":["grant2"]}}
+	]}`)
+
+	payloads := []json.RawMessage{
+		json.RawMessage(`{"cbsdId":"cbsd1"}`),
+		json.RawMessage(`{"cbsdId":"cbsd2"}`),
+	}
+	actual, err := sas.client(0).Send(context.Background(), "grantRequest", payloads)
+	require.NoError(t, err)
+
+	expected := []*sas_client.Response{{
+		CbsdId:   "cbsd1",
+		GrantId:  "grant1",
+		Response: &sas_client.ResponseStatus{ResponseCode: 0},
+	}, {
+		CbsdId:   "cbsd2",
+		Response: &sas_client.ResponseStatus{ResponseCode: 401, ResponseData: []string{"grant2"}},
+	}}
+	assert.Equal(t, expected, actual)
+	require.Len(t, sas.received, 1)
+	assert.Equal(t, "/grant", sas.received[0].path)
+	assert.JSONEq(t, `{"grantRequest":[{"cbsdId":"cbsd1"},{"cbsdId":"cbsd2"}]}`, sas.received[0].body)
+}
+
+func TestSendRetriesServerErrors(t *testing.T) {
+	sas := newMockSas(t)
+	sas.respond(http.StatusServiceUnavailable, "")
+	sas.respond(http.StatusInternalServerError, "")
+	sas.respond(http.StatusOK, `{"heartbeatResponse":[{"response":{"responseCode":0}}]}`)
+
+	payloads := []json.RawMessage{json.RawMessage(`{}`)}
+	actual, err := sas.client(2).Send(context.Background(), "heartbeatRequest", payloads)
+	require.NoError(t, err)
+
+	assert.Len(t, actual, 1)
+	assert.Len(t, sas.received, 3)
+}
+
+func TestSendGivesUpAfterMaxRetries(t *testing.T) {
+	sas := newMockSas(t)
+	sas.respond(http.StatusServiceUnavailable, "")
+	sas.respond(http.StatusServiceUnavailable, "")
+
+	payloads := []json.RawMessage{json.RawMessage(`{}`)}
+	_, err := sas.client(1).Send(context.Background(), "heartbeatRequest", payloads)
+	assert.Error(t, err)
+	assert.Len(t, sas.received, 2)
+}
+
+func TestSendDoesNotRetryClientErrors(t *testing.T) {
+	sas := newMockSas(t)
+	sas.respond(http.StatusBadRequest, "")
+
+	payloads := []json.RawMessage{json.RawMessage(`{}`)}
+	_, err := sas.client(3).Send(context.Background(), "heartbeatRequest", payloads)
+	assert.Error(t, err)
+	assert.Len(t, sas.received, 1)
+}
+
+func TestSendFailsOnResponseCountMismatch(t *testing.T) {
+	sas := newMockSas(t)
+	sas.respond(http.StatusOK, `{"registrationResponse":[{"response":{"responseCode":0}}]}`)
+
+	payloads := []json.RawMessage{json.RawMessage(`{}`), json.RawMessage(`{}`)}
+	_, err := sas.client(0).Send(context.Background(), "registrationRequest", payloads)
+	assert.Error(t, err)
+}
+
+func TestSasRejectsClientWithoutCertificate(t *testing.T) {
+	sas := newMockSas(t)
+	sas.respond(http.StatusOK, `{"registrationResponse":[{"response":{"responseCode":0}}]}`)
+
+	tlsConfig := sas.tlsConfig.Clone()
+	tlsConfig.Certificates = nil
+	client := sas_client.NewClient(sas.server.URL, tlsConfig, time.Second, sas_client.Backoff{})
+	_, err := client.Send(context.Background(), "registrationRequest", []json.RawMessage{json.RawMessage(`{}`)})
+	assert.Error(t, err)
+	assert.Empty(t, sas.received)
+}
+
+type receivedRequest struct {
+	path string
+	body string
+}
+
+type cannedResponse struct {
+	status int
+	body   string
+}
+
+// mockSas is a SAS server which requires client certificates
+// and replies with canned responses in order.
+type mockSas struct {
+	t         *testing.T
+	mu        sync.Mutex
+	server    *httptest.Server
+	tlsConfig *tls.Config
+	responses []cannedResponse
+	received  []receivedRequest
+}
+
+func newMockSas(t *testing.T) *mockSas {
+	certPath, keyPath := writeSelfSignedCert(t)
+	tlsConfig, err := sas_client.LoadTLSConfig(certPath, keyPath, certPath)
+	require.NoError(t, err)
+
+	m := &mockSas{t: t, tlsConfig: tlsConfig}
+	m.server = httptest.NewUnstartedServer(m)
+	m.server.TLS = &tls.Config{
+		Certificates: tlsConfig.Certificates,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    tlsConfig.RootCAs,
+	}
+	m.server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	m.server.StartTLS()
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+func (m *mockSas) client(maxRetries int) sas_client.Client {
+	backoff := sas_client.Backoff{MaxRetries: maxRetries, Initial: time.Millisecond, Max: time.Millisecond}
+	return sas_client.NewClient(m.server.URL, m.tlsConfig, time.Second, backoff)
+}
+
+func (m *mockSas) respond(status int, body string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.responses = append(m.responses, cannedResponse{status: status, body: body})
+}
+
+func (m *mockSas) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	body, _ := io.ReadAll(r.Body)
+	m.received = append(m.received, receivedRequest{path: r.URL.Path, body: string(body)})
+	if !assert.NotEmpty(m.t, m.responses, "unexpected request to %s", r.URL.Path) {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp := m.responses[0]
+	m.responses = m.responses[1:]
+	w.WriteHeader(resp.status)
+	_, _ = w.Write([]byte(resp.body))
+}
+
+// writeSelfSignedCert creates certificate valid for localhost
+// which is used both by SAS and the client as well as the CA.
+func writeSelfSignedCert(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:              []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "tls.crt")
+	keyPath := filepath.Join(dir, "tls.key")
+	writePem(t, certPath, "CERTIFICATE", der)
+	writePem(t, keyPath, "EC PRIVATE KEY", keyDer)
+	return certPath, keyPath
+}
+
+func writePem(t *testing.T, path string, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(path, data, 0600))
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sas_client
+
+import (
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+)
+
+const (
+	Registration    = "registrationRequest"
+	SpectrumInquiry = "spectrumInquiryRequest"
+	Grant           = "grantRequest"
+	Heartbeat       = "heartbeatRequest"
+	Relinquishment  = "relinquishmentRequest"
+	Deregistration  = "deregistrationRequest"
+)
+
+// RequestTypes lists request types in the order they are sent to SAS.
+var RequestTypes = []string{
+	Registration,
+	SpectrumInquiry,
+	Grant,
+	Heartbeat,
+	Relinquishment,
+	Deregistration,
+}
+
+// SAS response codes handled by the client.
+const (
+	Success         = 0
+	InvalidValue    = 103
+	Deregister      = 105
+	GrantConflict   = 401
+	TerminatedGrant = 500
+	SuspendedGrant  = 501
+	UnsyncOpParam   = 502
+)
+
+const (
+	idleState       = "idle"
+	grantedState    = "granted"
+	authorizedState = "authorized"
+	unsyncState     = "unsync"
+
+	defaultMaxEirp    = 37
+	operationParamKey = "operationParam"
+)
+
+type responseProcessor struct {
+	manager storage.SasManager
+	now     time.Time
+}
+
+type processFunc func(p *responseProcessor, runner sq.BaseRunner, req *storage.DBRequest, resp *Response) error
+
+var processors = map[string]processFunc{
+	Registration:    processRegistration,
+	SpectrumInquiry: processSpectrumInquiry,
+	Grant:           processGrant,
+	Heartbeat:       processHeartbeat,
+	Relinquishment:  processRelinquishment,
+	Deregistration:  processDeregistration,
+}
+
+func (p *responseProcessor) process(runner sq.BaseRunner, requestType string, req *storage.DBRequest, resp *Response) error {
+	code := resp.Response.ResponseCode
+	if requestType != Deregistration && (code == Deregister || code == InvalidValue) {
+		glog.Infof("sas response code %d implies unregistration of cbsd %d", code, req.CbsdId.Int64)
+		return p.manager.UnregisterCbsd(runner, req.CbsdId.Int64)
+	}
+	return processors[requestType](p, runner, req, resp)
+}
+
+func processRegistration(p *responseProcessor, runner sq.BaseRunner, req *storage.DBRequest, resp *Response) error {
+	if resp.Response.ResponseCode != Success || resp.CbsdId == "" {
+		return nil
+	}
+	return p.manager.RegisterCbsd(runner, req.CbsdId.Int64, resp.CbsdId)
+}
+
+func processSpectrumInquiry(p *responseProcessor, runner sq.BaseRunner, req *storage.DBRequest, resp *Response) error {
+	if resp.Response.ResponseCode != Success {
+		glog.Warningf("unsuccessful spectrum inquiry for cbsd %d: %d", req.CbsdId.Int64, resp.Response.ResponseCode)
+		return nil
+	}
+	channels := make([]storage.Channel, 0, len(resp.AvailableChannel))
+	for _, c := range resp.AvailableChannel {
+		if c.FrequencyRange == nil {
+			continue
+		}
+		maxEirp := float64(defaultMaxEirp)
+		if c.MaxEirp != nil {
+			maxEirp = *c.MaxEirp
+		}
+		channels = append(channels, storage.Channel{
+			LowFrequencyHz:  c.FrequencyRange.LowFrequency,
+			HighFrequencyHz: c.FrequencyRange.HighFrequency,
+			MaxEirp:         maxEirp,
+		})
+	}
+	return p.manager.SetChannels(runner, req.CbsdId.Int64, channels)
+}
+
+func processGrant(p *responseProcessor, runner sq.BaseRunner, req *storage.DBRequest, resp *Response) error {
+	switch resp.Response.ResponseCode {
+	case Success:
+		if resp.GrantId == "" {
+			return nil
+		}
+		grant := grantFromRequest(req, resp.GrantId)
+		mask := updateGrantFromResponse(grant, resp)
+		return p.manager.UpsertGrant(runner, makeGrant(grant, grantedState), db.NewIncludeMask(mask...))
+	case GrantConflict:
+		for _, grantId := range resp.Response.ResponseData {
+			grant := grantFromRequest(req, grantId)
+			if err := p.manager.UpsertGrant(runner, makeGrant(grant, unsyncState), nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return p.setIdle(runner, req, resp)
+	}
+}
+
+func processHeartbeat(p *responseProcessor, runner sq.BaseRunner, req *storage.DBRequest, resp *Response) error {
+	var state string
+	switch resp.Response.ResponseCode {
+	case TerminatedGrant:
+		return p.setIdle(runner, req, resp)
+	case Success:
+		state = authorizedState
+	case SuspendedGrant:
+		state = grantedState
+	case UnsyncOpParam:
+		state = unsyncState
+	default:
+		return nil
+	}
+	if resp.GrantId == "" {
+		return nil
+	}
+	grant := grantFromRequest(req, resp.GrantId)
+	mask := updateGrantFromResponse(grant, resp)
+	grant.LastHeartbeatRequestTime = db.MakeTime(p.now)
+	mask = append(mask, "last_heartbeat_request_time")
+	return p.manager.UpsertGrant(runner, makeGrant(grant, state), db.NewIncludeMask(mask...))
+}
+
+func processRelinquishment(p *responseProcessor, runner sq.BaseRunner, req *storage.DBRequest, resp *Response) error {
+	if resp.Response.ResponseCode != Success {
+		return nil
+	}
+	return p.setIdle(runner, req, resp)
+}
+
+func processDeregistration(p *responseProcessor, runner sq.BaseRunner, req *storage.DBRequest, _ *Response) error {
+	return p.manager.UnregisterCbsd(runner, req.CbsdId.Int64)
+}
+
+func (p *responseProcessor) setIdle(runner sq.BaseRunner, req *storage.DBRequest, resp *Response) error {
+	if resp.GrantId == "" {
+		return nil
+	}
+	grant := &storage.DBGrant{
+		CbsdId:  req.CbsdId,
+		GrantId: db.MakeString(resp.GrantId),
+	}
+	return p.manager.UpdateGrantState(runner, makeGrant(grant, idleState))
+}
+
+func makeGrant(grant *storage.DBGrant, state string) *storage.MutableGrant {
+	return &storage.MutableGrant{
+		Grant:      grant,
+		GrantState: &storage.DBGrantState{Name: db.MakeString(state)},
+	}
+}
+
+type operationParam struct {
+	MaxEirp                 float64         `json:"maxEirp"`
+	OperationFrequencyRange *FrequencyRange `json:"operationFrequencyRange"`
+}
+
+// grantFromRequest fills grant with operation parameters
+// that were requested from SAS.
+func grantFromRequest(req *storage.DBRequest, grantId string) *storage.DBGrant {
+	grant := &storage.DBGrant{
+		CbsdId:          req.CbsdId,
+		GrantId:         db.MakeString(grantId),
+		LowFrequencyHz:  db.MakeInt(0),
+		HighFrequencyHz: db.MakeInt(0),
+		MaxEirp:         db.MakeFloat(0),
+	}
+	var payload map[string]json.RawMessage
+	if err := remarshal(req.Payload, &payload); err != nil {
+		return grant
+	}
+	param := &operationParam{}
+	if err := json.Unmarshal(payload[operationParamKey], param); err != nil {
+		return grant
+	}
+	grant.MaxEirp = db.MakeFloat(param.MaxEirp)
+	if r := param.OperationFrequencyRange; r != nil {
+		grant.LowFrequencyHz = db.MakeInt(r.LowFrequency)
+		grant.HighFrequencyHz = db.MakeInt(r.HighFrequency)
+	}
+	return grant
+}
+
+// updateGrantFromResponse copies grant parameters present in the response
+// and returns names of modified columns.
+func updateGrantFromResponse(grant *storage.DBGrant, resp *Response) []string {
+	mask := []string{"state_id"}
+	if t, ok := parseTime(resp.GrantExpireTime); ok {
+		grant.GrantExpireTime = db.MakeTime(t)
+		mask = append(mask, "grant_expire_time")
+	}
+	if t, ok := parseTime(resp.TransmitExpireTime); ok {
+		grant.TransmitExpireTime = db.MakeTime(t)
+		mask = append(mask, "transmit_expire_time")
+	}
+	if resp.HeartbeatInterval != 0 {
+		grant.HeartbeatIntervalSec = db.MakeInt(resp.HeartbeatInterval)
+		mask = append(mask, "heartbeat_interval")
+	}
+	if resp.ChannelType != "" {
+		grant.ChannelType = db.MakeString(resp.ChannelType)
+		mask = append(mask, "channel_type")
+	}
+	return mask
+}
+
+func parseTime(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		glog.Warningf("failed to parse sas timestamp %s: %s", s, err)
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func remarshal(from any, to any) error {
+	b, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, to)
+}
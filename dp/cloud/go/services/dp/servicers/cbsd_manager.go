@@ -228,6 +228,7 @@ func buildCbsd(data *protos.CbsdData) *storage.DBCbsd {
 		MaxIbwMhx:                 db.MakeInt(data.Capabilities.GetMaxIbwMhz()),
 	}
 	setInstallationParam(cbsd, installationParam)
+	setCarrierAggregationParams(cbsd, data.GetCarrierAggregationParams())
 	return cbsd
 }
 
@@ -242,6 +243,15 @@ func setInstallationParam(cbsd *storage.DBCbsd, params *protos.InstallationParam
 	}
 }
 
+func setCarrierAggregationParams(cbsd *storage.DBCbsd, params *protos.CarrierAggregationParams) {
+	if params != nil {
+		cbsd.MaxCarriers = db.MakeInt(params.MaxCarriers)
+		cbsd.MinTotalBandwidthMHz = db.MakeInt(params.MinTotalBandwidthMhz)
+		cbsd.MaxTotalBandwidthMHz = db.MakeInt(params.MaxTotalBandwidthMhz)
+		cbsd.ContiguousCarriers = db.MakeBool(params.Contiguous)
+	}
+}
+
 func cbsdFromDatabase(data *storage.DetailedCbsd, inactivityInterval time.Duration) *protos.CbsdDetails {
 	isActive := clock.Since(data.Cbsd.LastSeen.Time) < inactivityInterval
 	return &protos.CbsdDetails{
@@ -266,11 +276,36 @@ func cbsdFromDatabase(data *storage.DetailedCbsd, inactivityInterval time.Durati
 			InstallationParam:         getInstallationParam(data.Cbsd),
 			CarrierAggregationEnabled: data.Cbsd.CarrierAggregationEnabled.Bool,
 			GrantRedundancy:           data.Cbsd.GrantRedundancy.Bool,
+			CarrierAggregationParams:  getCarrierAggregationParams(data.Cbsd),
 		},
-		CbsdId:   data.Cbsd.CbsdId.String,
-		State:    data.CbsdState.Name.String,
-		IsActive: isActive,
-		Grants:   grantsFromDatabase(data.Grants),
+		CbsdId:                data.Cbsd.CbsdId.String,
+		State:                 data.CbsdState.Name.String,
+		IsActive:              isActive,
+		Grants:                grantsFromDatabase(data.Grants),
+		CpiSignature:          cpiSignatureFromDatabase(data.Cbsd),
+		PlannedFrequenciesMhz: data.Cbsd.PlannedFrequenciesMHz,
+	}
+}
+
+func getCarrierAggregationParams(c *storage.DBCbsd) *protos.CarrierAggregationParams {
+	if !c.MaxCarriers.Valid {
+		return nil
+	}
+	return &protos.CarrierAggregationParams{
+		MaxCarriers:          c.MaxCarriers.Int64,
+		MinTotalBandwidthMhz: c.MinTotalBandwidthMHz.Int64,
+		MaxTotalBandwidthMhz: c.MaxTotalBandwidthMHz.Int64,
+		Contiguous:           c.ContiguousCarriers.Bool,
+	}
+}
+
+func cpiSignatureFromDatabase(c *storage.DBCbsd) *protos.CpiSignature {
+	if !c.CpiId.Valid || c.CpiSignatureData == nil {
+		return nil
+	}
+	return &protos.CpiSignature{
+		CpiId:                         c.CpiId.String,
+		InstallCertificationTimestamp: c.InstallCertificationTime.Time.Unix(),
 	}
 }
 
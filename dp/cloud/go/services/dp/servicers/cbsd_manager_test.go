@@ -111,6 +111,20 @@ func (s *CbsdManagerTestSuite) TestCreateCbsd() {
 				Cbsd,
 			registered,
 		),
+	}, {
+		name: "test create cbsd with carrier aggregation params",
+		input: b.NewCbsdProtoPayloadBuilder().
+			WithCarrierAggregationParams(&protos.CarrierAggregationParams{
+				MaxCarriers:          4,
+				MaxTotalBandwidthMhz: 60,
+			}).
+			Payload,
+		expected: b.GetMutableDBCbsd(
+			b.NewDBCbsdBuilder().
+				WithCarrierAggregationParams(4, 0, 60, false).
+				Cbsd,
+			registered,
+		),
 	}}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -476,6 +490,51 @@ func (s *CbsdManagerTestSuite) TestFetchCbsd() {
 	}
 }
 
+func (s *CbsdManagerTestSuite) TestFetchCbsdWithPlannedFrequencies() {
+	s.store.details = getDefaultCbsdDetails(b.NewDBCbsdBuilder().
+		WithPlannedFrequencies([]int64{3580, 3560}).
+		Cbsd)
+
+	request := &protos.FetchCbsdRequest{
+		NetworkId: networkId,
+		Id:        cbsdId,
+	}
+	actual, err := s.manager.FetchCbsd(context.Background(), request)
+	s.Require().NoError(err)
+
+	expected := b.NewDetailedProtoCbsdBuilder(
+		b.NewCbsdProtoPayloadBuilder().
+			WithEmptyInstallationParam()).
+		WithGrant().Details
+	expected.PlannedFrequenciesMhz = []int64{3580, 3560}
+	s.Assert().Equal(expected, actual.Details)
+}
+
+func (s *CbsdManagerTestSuite) TestFetchCbsdWithCarrierAggregationParams() {
+	s.store.details = getDefaultCbsdDetails(b.NewDBCbsdBuilder().
+		WithCarrierAggregationParams(3, 20, 60, true).
+		Cbsd)
+
+	request := &protos.FetchCbsdRequest{
+		NetworkId: networkId,
+		Id:        cbsdId,
+	}
+	actual, err := s.manager.FetchCbsd(context.Background(), request)
+	s.Require().NoError(err)
+
+	expected := b.NewDetailedProtoCbsdBuilder(
+		b.NewCbsdProtoPayloadBuilder().
+			WithEmptyInstallationParam().
+			WithCarrierAggregationParams(&protos.CarrierAggregationParams{
+				MaxCarriers:          3,
+				MinTotalBandwidthMhz: 20,
+				MaxTotalBandwidthMhz: 60,
+				Contiguous:           true,
+			})).
+		WithGrant().Details
+	s.Assert().Equal(expected, actual.Details)
+}
+
 func (s *CbsdManagerTestSuite) TestFetchNonActiveCbsd() {
 	now := time.Unix(lastSeenTimestamp, 0).Add(interval)
 	clock.SetAndFreezeClock(s.T(), now)
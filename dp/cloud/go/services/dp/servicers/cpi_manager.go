@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/dp/cloud/go/protos"
+	"magma/dp/cloud/go/services/dp/cpi"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+)
+
+type cpiManager struct {
+	protos.UnimplementedCpiManagementServer
+	store storage.CpiManager
+}
+
+func NewCpiManager(store storage.CpiManager) protos.CpiManagementServer {
+	return &cpiManager{store: store}
+}
+
+func (c *cpiManager) CreateCpi(_ context.Context, request *protos.CreateCpiRequest) (*protos.CreateCpiResponse, error) {
+	data := request.GetData()
+	privateKey := data.GetPrivateKey()
+	if privateKey == "" {
+		var err error
+		if privateKey, err = cpi.GenerateKey(); err != nil {
+			return nil, makeErr(err, "generate cpi key")
+		}
+	} else if _, err := cpi.ParsePrivateKey(privateKey); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "create cpi: %s", err)
+	}
+	model := &storage.DBCpi{
+		CpiId:      db.MakeString(data.GetCpiId()),
+		CpiName:    db.MakeString(data.GetCpiName()),
+		PrivateKey: db.MakeString(privateKey),
+	}
+	if err := c.store.CreateCpi(request.NetworkId, model); err != nil {
+		return nil, makeErr(err, "create cpi")
+	}
+	return &protos.CreateCpiResponse{}, nil
+}
+
+func (c *cpiManager) ListCpis(_ context.Context, request *protos.ListCpisRequest) (*protos.ListCpisResponse, error) {
+	cpis, err := c.store.ListCpis(request.NetworkId)
+	if err != nil {
+		return nil, makeErr(err, "list cpis")
+	}
+	resp := &protos.ListCpisResponse{Details: make([]*protos.CpiDetails, len(cpis))}
+	for i, data := range cpis {
+		if resp.Details[i], err = cpiFromDatabase(data); err != nil {
+			return nil, makeErr(err, "list cpis")
+		}
+	}
+	return resp, nil
+}
+
+func (c *cpiManager) DeleteCpi(_ context.Context, request *protos.DeleteCpiRequest) (*protos.DeleteCpiResponse, error) {
+	if err := c.store.DeleteCpi(request.NetworkId, request.CpiId); err != nil {
+		return nil, makeErr(err, "delete cpi")
+	}
+	return &protos.DeleteCpiResponse{}, nil
+}
+
+func (c *cpiManager) FetchCpiSignedData(_ context.Context, request *protos.FetchCpiSignedDataRequest) (*protos.FetchCpiSignedDataResponse, error) {
+	cbsd, err := c.store.FetchCbsdForSigning(request.NetworkId, request.CbsdId)
+	if err != nil {
+		return nil, makeErr(err, "fetch cpi signed data")
+	}
+	return &protos.FetchCpiSignedDataResponse{
+		FccId:             cbsd.FccId.String,
+		SerialNumber:      cbsd.CbsdSerialNumber.String,
+		CbsdCategory:      cbsd.CbsdCategory.String,
+		InstallationParam: getInstallationParam(cbsd),
+		CpiSignature:      cpiSignatureFromDatabase(cbsd),
+	}, nil
+}
+
+func (c *cpiManager) SignCbsd(_ context.Context, request *protos.SignCbsdRequest) (*protos.SignCbsdResponse, error) {
+	if err := c.store.SignCbsd(request.NetworkId, request.CbsdId, request.CpiId, cpi.Sign); err != nil {
+		return nil, makeErr(err, "sign cbsd")
+	}
+	return &protos.SignCbsdResponse{}, nil
+}
+
+func cpiFromDatabase(data *storage.DBCpi) (*protos.CpiDetails, error) {
+	publicKey, err := cpi.PublicKey(data.PrivateKey.String)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.CpiDetails{
+		CpiId:     data.CpiId.String,
+		CpiName:   data.CpiName.String,
+		PublicKey: publicKey,
+	}, nil
+}
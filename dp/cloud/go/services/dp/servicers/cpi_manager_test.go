@@ -0,0 +1,253 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"magma/dp/cloud/go/protos"
+	b "magma/dp/cloud/go/services/dp/builders"
+	"magma/dp/cloud/go/services/dp/cpi"
+	"magma/dp/cloud/go/services/dp/servicers"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/orc8r/lib/go/merrors"
+)
+
+const (
+	someCpiId   = "some_cpi_id"
+	someCpiName = "some cpi name"
+)
+
+func TestCpiManager(t *testing.T) {
+	suite.Run(t, &CpiManagerTestSuite{})
+}
+
+type CpiManagerTestSuite struct {
+	suite.Suite
+	manager protos.CpiManagementServer
+	store   *stubCpiManager
+}
+
+func (s *CpiManagerTestSuite) SetupTest() {
+	s.store = &stubCpiManager{}
+	s.manager = servicers.NewCpiManager(s.store)
+}
+
+func (s *CpiManagerTestSuite) TestCreateCpiWithGeneratedKey() {
+	request := &protos.CreateCpiRequest{
+		NetworkId: networkId,
+		Data:      &protos.CpiData{CpiId: someCpiId, CpiName: someCpiName},
+	}
+	_, err := s.manager.CreateCpi(context.Background(), request)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(networkId, s.store.networkId)
+	s.Assert().Equal(someCpiId, s.store.cpi.CpiId.String)
+	s.Assert().Equal(someCpiName, s.store.cpi.CpiName.String)
+	_, err = cpi.ParsePrivateKey(s.store.cpi.PrivateKey.String)
+	s.Assert().NoError(err)
+}
+
+func (s *CpiManagerTestSuite) TestCreateCpiWithProvidedKey() {
+	key := s.givenKey()
+	request := &protos.CreateCpiRequest{
+		NetworkId: networkId,
+		Data:      &protos.CpiData{CpiId: someCpiId, CpiName: someCpiName, PrivateKey: key},
+	}
+	_, err := s.manager.CreateCpi(context.Background(), request)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(key, s.store.cpi.PrivateKey.String)
+}
+
+func (s *CpiManagerTestSuite) TestCreateCpiWithInvalidKey() {
+	request := &protos.CreateCpiRequest{
+		NetworkId: networkId,
+		Data:      &protos.CpiData{CpiId: someCpiId, CpiName: someCpiName, PrivateKey: "invalid"},
+	}
+	_, err := s.manager.CreateCpi(context.Background(), request)
+
+	s.assertCode(codes.InvalidArgument, err)
+	s.Assert().Nil(s.store.cpi)
+}
+
+func (s *CpiManagerTestSuite) TestCreateDuplicateCpi() {
+	s.store.err = merrors.ErrAlreadyExists
+	request := &protos.CreateCpiRequest{
+		NetworkId: networkId,
+		Data:      &protos.CpiData{CpiId: someCpiId, CpiName: someCpiName},
+	}
+	_, err := s.manager.CreateCpi(context.Background(), request)
+
+	s.assertCode(codes.AlreadyExists, err)
+}
+
+func (s *CpiManagerTestSuite) TestListCpis() {
+	key := s.givenKey()
+	s.store.cpis = []*storage.DBCpi{{
+		CpiId:      db.MakeString(someCpiId),
+		CpiName:    db.MakeString(someCpiName),
+		PrivateKey: db.MakeString(key),
+	}}
+
+	actual, err := s.manager.ListCpis(context.Background(), &protos.ListCpisRequest{NetworkId: networkId})
+	s.Require().NoError(err)
+
+	publicKey, err := cpi.PublicKey(key)
+	s.Require().NoError(err)
+	expected := &protos.ListCpisResponse{Details: []*protos.CpiDetails{{
+		CpiId:     someCpiId,
+		CpiName:   someCpiName,
+		PublicKey: publicKey,
+	}}}
+	s.Assert().Equal(expected, actual)
+	s.Assert().Equal(networkId, s.store.networkId)
+}
+
+func (s *CpiManagerTestSuite) TestDeleteNonExistentCpi() {
+	s.store.err = merrors.ErrNotFound
+
+	request := &protos.DeleteCpiRequest{NetworkId: networkId, CpiId: someCpiId}
+	_, err := s.manager.DeleteCpi(context.Background(), request)
+
+	s.assertCode(codes.NotFound, err)
+	s.Assert().Equal(someCpiId, s.store.cpiId)
+}
+
+func (s *CpiManagerTestSuite) TestFetchCpiSignedData() {
+	s.store.cbsd = b.NewDBCbsdBuilder().
+		WithFullInstallationParam().
+		WithCpiSignature(someCpiId, lastSeenTimestamp, &storage.CpiSignatureData{}).
+		Cbsd
+
+	request := &protos.FetchCpiSignedDataRequest{NetworkId: networkId, CbsdId: cbsdId}
+	actual, err := s.manager.FetchCpiSignedData(context.Background(), request)
+	s.Require().NoError(err)
+
+	expected := &protos.FetchCpiSignedDataResponse{
+		FccId:        "some_fcc_id",
+		SerialNumber: someSerialNumber,
+		CbsdCategory: "b",
+		InstallationParam: &protos.InstallationParam{
+			LatitudeDeg:      wrapperspb.Double(10.5),
+			LongitudeDeg:     wrapperspb.Double(11.5),
+			IndoorDeployment: wrapperspb.Bool(true),
+			HeightM:          wrapperspb.Double(12.5),
+			HeightType:       wrapperspb.String("agl"),
+			AntennaGain:      wrapperspb.Double(4.5),
+		},
+		CpiSignature: &protos.CpiSignature{
+			CpiId:                         someCpiId,
+			InstallCertificationTimestamp: lastSeenTimestamp,
+		},
+	}
+	s.Assert().Equal(expected, actual)
+	s.Assert().Equal(cbsdId, s.store.id)
+}
+
+func (s *CpiManagerTestSuite) TestSignCbsd() {
+	key := s.givenKey()
+	request := &protos.SignCbsdRequest{NetworkId: networkId, CbsdId: cbsdId, CpiId: someCpiId}
+	_, err := s.manager.SignCbsd(context.Background(), request)
+	s.Require().NoError(err)
+
+	s.Assert().Equal(networkId, s.store.networkId)
+	s.Assert().Equal(cbsdId, s.store.id)
+	s.Assert().Equal(someCpiId, s.store.cpiId)
+
+	cbsd := b.NewDBCbsdBuilder().WithFullInstallationParam().Cbsd
+	cbsd.InstallCertificationTime = db.MakeTime(time.Unix(lastSeenTimestamp, 0))
+	signature, err := s.store.sign(cbsd, &storage.DBCpi{PrivateKey: db.MakeString(key)})
+	s.Require().NoError(err)
+	publicKey, err := cpi.PublicKey(key)
+	s.Require().NoError(err)
+	s.Assert().NoError(cpi.Verify(signature, publicKey))
+}
+
+func (s *CpiManagerTestSuite) TestSignNonExistentCbsd() {
+	s.store.err = merrors.ErrNotFound
+
+	request := &protos.SignCbsdRequest{NetworkId: networkId, CbsdId: cbsdId, CpiId: someCpiId}
+	_, err := s.manager.SignCbsd(context.Background(), request)
+
+	s.assertCode(codes.NotFound, err)
+}
+
+func (s *CpiManagerTestSuite) givenKey() string {
+	key, err := cpi.GenerateKey()
+	s.Require().NoError(err)
+	return key
+}
+
+func (s *CpiManagerTestSuite) assertCode(expected codes.Code, err error) {
+	s.Require().Error(err)
+	errStatus, _ := status.FromError(err)
+	s.Assert().Equal(expected, errStatus.Code())
+}
+
+type stubCpiManager struct {
+	networkId string
+	cpiId     string
+	id        int64
+	cpi       *storage.DBCpi
+	cpis      []*storage.DBCpi
+	cbsd      *storage.DBCbsd
+	sign      storage.SignFunc
+	err       error
+}
+
+func (s *stubCpiManager) CreateCpi(networkId string, data *storage.DBCpi) error {
+	s.networkId = networkId
+	s.cpi = data
+	return s.err
+}
+
+func (s *stubCpiManager) FetchCpi(networkId string, cpiId string) (*storage.DBCpi, error) {
+	s.networkId = networkId
+	s.cpiId = cpiId
+	return s.cpi, s.err
+}
+
+func (s *stubCpiManager) ListCpis(networkId string) ([]*storage.DBCpi, error) {
+	s.networkId = networkId
+	return s.cpis, s.err
+}
+
+func (s *stubCpiManager) DeleteCpi(networkId string, cpiId string) error {
+	s.networkId = networkId
+	s.cpiId = cpiId
+	return s.err
+}
+
+func (s *stubCpiManager) FetchCbsdForSigning(networkId string, id int64) (*storage.DBCbsd, error) {
+	s.networkId = networkId
+	s.id = id
+	return s.cbsd, s.err
+}
+
+func (s *stubCpiManager) SignCbsd(networkId string, id int64, cpiId string, sign storage.SignFunc) error {
+	s.networkId = networkId
+	s.id = id
+	s.cpiId = cpiId
+	s.sign = sign
+	return s.err
+}
@@ -36,6 +36,9 @@ type AmcManager interface {
 	//	 - it has all necessary parameters to perform sas requests (registration/grant)
 	//   - it has some pending db action (e.g. it needs to be deleted)
 	GetState(sq.BaseRunner) ([]*DetailedCbsd, error)
+	// GetPlanningState returns all not deleted cbsds (including those with pending requests)
+	// with fields required to plan channels across the whole network
+	GetPlanningState(sq.BaseRunner) ([]*DBCbsd, error)
 	CreateRequest(sq.BaseRunner, *MutableRequest) error
 	DeleteCbsd(sq.BaseRunner, *DBCbsd) error
 	UpdateCbsd(sq.BaseRunner, *DBCbsd, db.FieldMask) error
@@ -131,6 +134,12 @@ func (m *amcManager) GetState(tx sq.BaseRunner) ([]*DetailedCbsd, error) {
 	return runner.getState()
 }
 
+// GetPlanningState returns cbsds used by network level channel planner.
+func (m *amcManager) GetPlanningState(tx sq.BaseRunner) ([]*DBCbsd, error) {
+	runner := m.getQueryRunner(tx)
+	return runner.getPlanningState()
+}
+
 func (m *amcManager) DeleteGrant(tx sq.BaseRunner, grant *DBGrant) error {
 	return nil
 }
@@ -143,6 +152,28 @@ func notNull(fields ...string) sq.Sqlizer {
 	return filters
 }
 
+func (r *queryRunner) getPlanningState() ([]*DBCbsd, error) {
+	res, err := db.NewQuery().
+		WithBuilder(r.builder).
+		From(&DBCbsd{}).
+		Select(db.NewIncludeMask(
+			"id", "network_id", "cbsd_serial_number",
+			"latitude_deg", "longitude_deg",
+			"preferred_bandwidth_mhz", "preferred_frequencies_mhz",
+			"available_frequencies", "planned_frequencies_mhz")).
+		Where(sq.Eq{"is_deleted": false}).
+		OrderBy("id", db.OrderAsc).
+		List()
+	if err != nil {
+		return nil, err
+	}
+	cbsds := make([]*DBCbsd, len(res))
+	for i, models := range res {
+		cbsds[i] = models[0].(*DBCbsd)
+	}
+	return cbsds, nil
+}
+
 func (r *queryRunner) getState() ([]*DetailedCbsd, error) {
 	multiStepFields := []string{"fcc_id", "user_id", "number_of_ports", "min_power", "max_power", "antenna_gain"}
 	singleStepFields := append(multiStepFields, "latitude_deg", "longitude_deg", "height_m", "height_type")
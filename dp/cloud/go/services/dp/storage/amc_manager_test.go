@@ -1028,6 +1028,58 @@ func (s *AmcManagerTestSuite) TestGetState() {
 	}
 }
 
+func (s *AmcManagerTestSuite) TestGetPlanningState() {
+	registeredId := s.enumMaps[storage.CbsdStateTable][registered]
+	available := []uint32{0b1111, 0b110, 0b100, 0b10}
+	s.givenResourcesInserted(
+		b.NewDBCbsdBuilder().
+			WithId(1).
+			WithNetworkId(someNetwork).
+			WithSerialNumber(someSerialNumber).
+			WithStateId(registeredId).
+			WithDesiredStateId(registeredId).
+			WithLatitude(10).
+			WithLongitude(20).
+			WithPreferences(10, []int64{3560}).
+			WithAvailableFrequencies(available).
+			WithPlannedFrequencies([]int64{3565, 3560}).
+			Cbsd,
+		b.NewDBCbsdBuilder().
+			WithId(2).
+			WithNetworkId(someNetwork).
+			WithSerialNumber(someSerialNumber+"1").
+			WithStateId(registeredId).
+			WithDesiredStateId(registeredId).
+			WithIsDeleted(true).
+			Cbsd,
+		&storage.DBRequest{
+			TypeId:  db.MakeInt(s.enumMaps[storage.RequestTypeTable][grant]),
+			CbsdId:  db.MakeInt(1),
+			Payload: "{}",
+		},
+	)
+
+	actual, err := storage.WithinTx(s.database, func(tx *sql.Tx) ([]*storage.DBCbsd, error) {
+		return s.amcManager.GetPlanningState(tx)
+	})
+	s.Require().NoError(err)
+
+	expected := []*storage.DBCbsd{
+		b.NewDBCbsdBuilder().
+			Empty().
+			WithId(1).
+			WithNetworkId(someNetwork).
+			WithSerialNumber(someSerialNumber).
+			WithLatitude(10).
+			WithLongitude(20).
+			WithPreferences(10, []int64{3560}).
+			WithAvailableFrequencies(available).
+			WithPlannedFrequencies([]int64{3565, 3560}).
+			Cbsd,
+	}
+	s.Assert().Equal(expected, actual)
+}
+
 func (s *AmcManagerTestSuite) givenResourcesInserted(models ...db.Model) {
 	err := s.resourceManager.InsertResources(db.NewExcludeMask(), models...)
 	s.Require().NoError(err)
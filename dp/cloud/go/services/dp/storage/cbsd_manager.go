@@ -222,6 +222,7 @@ func getCbsdWriteFields() []string {
 		"cbsd_category", "latitude_deg", "longitude_deg", "height_m", "height_type", "horizontal_accuracy_m",
 		"antenna_azimuth_deg", "antenna_downtilt_deg", "antenna_beamwidth_deg", "antenna_model", "eirp_capability_dbm_mhz",
 		"indoor_deployment", "cpi_digital_signature", "carrier_aggregation_enabled", "max_ibw_mhz", "grant_redundancy",
+		"max_carriers", "min_total_bandwidth_mhz", "max_total_bandwidth_mhz", "contiguous_carriers",
 	}
 }
 
@@ -233,8 +234,9 @@ func getEnodebdWritableFields() []string {
 }
 
 func (r *queryRunner) updateCbsd(networkId string, id int64, data *MutableCbsd) error {
-	mask := db.NewIncludeMask("id")
-	if _, err := r.selectForUpdateIfCbsdExists(mask, getCbsdFiltersWithId(networkId, id)); err != nil {
+	mask := db.NewIncludeMask(getCpiSignedFields()...)
+	cbsd, err := r.selectForUpdateIfCbsdExists(mask, getCbsdFiltersWithId(networkId, id))
+	if err != nil {
 		return err
 	}
 	desiredState, err := r.cache.getValue(r.builder, &DBCbsdState{}, data.DesiredState.Name.String)
@@ -244,6 +246,9 @@ func (r *queryRunner) updateCbsd(networkId string, id int64, data *MutableCbsd)
 	data.Cbsd.DesiredStateId = db.MakeInt(desiredState)
 	data.Cbsd.ShouldDeregister = db.MakeBool(true)
 	columns := append(getCbsdWriteFields(), "should_deregister")
+	if cpiSignedFieldsChanged(cbsd, data.Cbsd) {
+		columns = append(columns, getCpiFields()...)
+	}
 	mask = db.NewIncludeMask(columns...)
 	_, err = db.NewQuery().
 		WithBuilder(r.builder).
@@ -270,6 +275,7 @@ func (r *queryRunner) enodebdUpdateCbsd(data *DBCbsd) error {
 	if ShouldEnodebdUpdateInstallationParams(cbsd, data) {
 		cols := append(getEnodebdWritableFields(), "should_deregister")
 		columns = append(columns, cols...)
+		columns = append(columns, getCpiFields()...)
 		data.ShouldDeregister = db.MakeBool(true)
 	}
 
@@ -217,6 +217,35 @@ func (s *CbsdManagerTestSuite) TestUpdateCbsd() {
 	s.Require().NoError(err)
 }
 
+func (s *CbsdManagerTestSuite) TestUpdateCbsdClearsCpiSignatureWhenSignedFieldsChanged() {
+	s.givenSignedCbsd()
+	m := b.GetMutableDBCbsd(b.NewDBCbsdBuilder().
+		WithFullInstallationParam().
+		WithLatitude(20).
+		Cbsd, registered)
+
+	err := s.cbsdManager.UpdateCbsd(someNetwork, someCbsdId, m)
+	s.Require().NoError(err)
+
+	s.thenCpiSignatureIs(&storage.DBCbsd{})
+}
+
+func (s *CbsdManagerTestSuite) TestUpdateCbsdKeepsCpiSignatureWhenSignedFieldsDidNotChange() {
+	s.givenSignedCbsd()
+	m := b.GetMutableDBCbsd(b.NewDBCbsdBuilder().
+		WithFullInstallationParam().
+		WithPreferences(10, []int64{3550}).
+		Cbsd, registered)
+
+	err := s.cbsdManager.UpdateCbsd(someNetwork, someCbsdId, m)
+	s.Require().NoError(err)
+
+	s.thenCpiSignatureIs(b.NewDBCbsdBuilder().
+		Empty().
+		WithCpiSignature(someCpiId, nowTimestamp, &storage.CpiSignatureData{DigitalSignature: "some_signature"}).
+		Cbsd)
+}
+
 func (s *CbsdManagerTestSuite) TestEnodebdUpdateCbsd() {
 	now := time.Unix(nowTimestamp, 0)
 	clock.SetAndFreezeClock(s.T(), now)
@@ -593,6 +622,62 @@ func (s *CbsdManagerTestSuite) TestEnodebdUpdateCbsd() {
 					WithLastSeen(nowTimestamp).
 					Cbsd, registered, registered).
 				Details,
+		}, {
+			name: "test enodebd update clears cpi signature when installation params changed",
+			inputCbsd: b.NewDBCbsdBuilder().
+				WithId(12).
+				WithNetworkId(someNetwork).
+				WithSerialNumber(fmt.Sprintf(someSerialNumber+"%d", 12)).
+				WithDesiredStateId(registeredStateId).
+				WithStateId(registeredStateId).
+				WithCpiSignature("some_cpi_id", nowTimestamp, &storage.CpiSignatureData{DigitalSignature: "some_signature"}).
+				Cbsd,
+			toUpdate: b.NewDBCbsdBuilder().
+				Empty().
+				WithSerialNumber(fmt.Sprintf(someSerialNumber+"%d", 12)).
+				WithCbsdCategory("a").
+				WithFullEnodebdAllowedInstallationParam().
+				WithLastSeen(nowTimestamp).
+				Cbsd,
+			expected: b.NewDetailedDBCbsdBuilder().
+				WithCbsd(b.NewDBCbsdBuilder().
+					WithNetworkId(someNetwork).
+					WithSerialNumber(fmt.Sprintf(someSerialNumber+"%d", 12)).
+					WithDesiredStateId(registeredStateId).
+					WithShouldDeregister(true).
+					WithCbsdCategory("a").
+					WithFullEnodebdAllowedInstallationParam().
+					WithLastSeen(nowTimestamp).
+					Cbsd, registered, registered).
+				Details,
+		}, {
+			name: "test enodebd update keeps cpi signature when installation params did not change",
+			inputCbsd: b.NewDBCbsdBuilder().
+				WithId(13).
+				WithNetworkId(someNetwork).
+				WithSerialNumber(fmt.Sprintf(someSerialNumber+"%d", 13)).
+				WithDesiredStateId(registeredStateId).
+				WithStateId(registeredStateId).
+				WithFullEnodebdAllowedInstallationParam().
+				WithCpiSignature("some_cpi_id", nowTimestamp, &storage.CpiSignatureData{DigitalSignature: "some_signature"}).
+				Cbsd,
+			toUpdate: b.NewDBCbsdBuilder().
+				Empty().
+				WithSerialNumber(fmt.Sprintf(someSerialNumber+"%d", 13)).
+				WithFullEnodebdAllowedInstallationParam().
+				WithLastSeen(nowTimestamp).
+				Cbsd,
+			expected: b.NewDetailedDBCbsdBuilder().
+				WithCbsd(b.NewDBCbsdBuilder().
+					WithNetworkId(someNetwork).
+					WithSerialNumber(fmt.Sprintf(someSerialNumber+"%d", 13)).
+					WithDesiredStateId(registeredStateId).
+					WithShouldDeregister(false).
+					WithFullEnodebdAllowedInstallationParam().
+					WithLastSeen(nowTimestamp).
+					WithCpiSignature("some_cpi_id", nowTimestamp, &storage.CpiSignatureData{DigitalSignature: "some_signature"}).
+					Cbsd, registered, registered).
+				Details,
 		},
 	}
 	for _, tc := range testCases {
@@ -1189,6 +1274,32 @@ func (s *CbsdManagerTestSuite) givenResourcesInserted(models ...db.Model) {
 	s.Require().NoError(err)
 }
 
+func (s *CbsdManagerTestSuite) givenSignedCbsd() {
+	state := s.enumMaps[storage.CbsdStateTable][registered]
+	s.givenResourcesInserted(b.NewDBCbsdBuilder().
+		WithId(someCbsdId).
+		WithNetworkId(someNetwork).
+		WithDesiredStateId(state).
+		WithStateId(state).
+		WithFullInstallationParam().
+		WithCpiSignature(someCpiId, nowTimestamp, &storage.CpiSignatureData{DigitalSignature: "some_signature"}).
+		Cbsd)
+}
+
+func (s *CbsdManagerTestSuite) thenCpiSignatureIs(expected *storage.DBCbsd) {
+	err := s.resourceManager.InTransaction(func() {
+		actual, err := db.NewQuery().
+			WithBuilder(s.resourceManager.GetBuilder()).
+			From(&storage.DBCbsd{}).
+			Select(db.NewIncludeMask("cpi_id", "install_certification_time", "cpi_signature_data")).
+			Where(sq.Eq{"id": someCbsdId}).
+			Fetch()
+		s.Require().NoError(err)
+		s.Assert().Equal([]db.Model{expected}, actual)
+	})
+	s.Require().NoError(err)
+}
+
 func (s *CbsdManagerTestSuite) givenDeletedCbsd() {
 	state := s.enumMaps[storage.CbsdStateTable][registered]
 	cbsd := b.NewDBCbsdBuilder().
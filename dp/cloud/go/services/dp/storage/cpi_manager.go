@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/lib/go/merrors"
+)
+
+type CpiManager interface {
+	CreateCpi(networkId string, data *DBCpi) error
+	FetchCpi(networkId string, cpiId string) (*DBCpi, error)
+	ListCpis(networkId string) ([]*DBCpi, error)
+	DeleteCpi(networkId string, cpiId string) error
+	FetchCbsdForSigning(networkId string, id int64) (*DBCbsd, error)
+	SignCbsd(networkId string, id int64, cpiId string, sign SignFunc) error
+}
+
+// SignFunc produces cpi signature for installation parameters of given cbsd.
+// Cbsd passed to it already has cpi id and certification time set.
+type SignFunc func(cbsd *DBCbsd, cpi *DBCpi) (*CpiSignatureData, error)
+
+func NewCpiManager(db *sql.DB, builder sqorc.StatementBuilder, errorChecker sqorc.ErrorChecker, locker sqorc.Locker) *cpiManager {
+	return &cpiManager{
+		&dpManager{
+			db:           db,
+			builder:      builder,
+			cache:        &enumCache{cache: map[string]map[string]int64{}},
+			errorChecker: errorChecker,
+			locker:       locker,
+		},
+	}
+}
+
+type cpiManager struct {
+	*dpManager
+}
+
+func (c *cpiManager) CreateCpi(networkId string, data *DBCpi) error {
+	_, err := sqorc.ExecInTx(c.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := c.getQueryRunner(tx)
+		count, err := db.NewQuery().
+			WithBuilder(runner.builder).
+			From(&DBCpi{}).
+			Where(getCpiFilters(networkId, data.CpiId.String)).
+			Count()
+		if err != nil {
+			return nil, err
+		}
+		if count != 0 {
+			return nil, merrors.ErrAlreadyExists
+		}
+		data.NetworkId = db.MakeString(networkId)
+		_, err = db.NewQuery().
+			WithBuilder(runner.builder).
+			From(data).
+			Insert(db.NewIncludeMask("network_id", "cpi_id", "cpi_name", "private_key"))
+		return nil, err
+	})
+	return makeError(err, c.errorChecker)
+}
+
+func (c *cpiManager) FetchCpi(networkId string, cpiId string) (*DBCpi, error) {
+	cpi, err := sqorc.ExecInTx(c.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := c.getQueryRunner(tx)
+		return runner.fetchCpi(networkId, cpiId)
+	})
+	if err != nil {
+		return nil, makeError(err, c.errorChecker)
+	}
+	return cpi.(*DBCpi), nil
+}
+
+func (c *cpiManager) ListCpis(networkId string) ([]*DBCpi, error) {
+	cpis, err := sqorc.ExecInTx(c.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		res, err := db.NewQuery().
+			WithBuilder(c.getQueryRunner(tx).builder).
+			From(&DBCpi{}).
+			Select(db.NewExcludeMask()).
+			Where(sq.Eq{"network_id": networkId}).
+			OrderBy("cpi_id", db.OrderAsc).
+			List()
+		if err != nil {
+			return nil, err
+		}
+		cpis := make([]*DBCpi, len(res))
+		for i, models := range res {
+			cpis[i] = models[0].(*DBCpi)
+		}
+		return cpis, nil
+	})
+	if err != nil {
+		return nil, makeError(err, c.errorChecker)
+	}
+	return cpis.([]*DBCpi), nil
+}
+
+func (c *cpiManager) DeleteCpi(networkId string, cpiId string) error {
+	_, err := sqorc.ExecInTx(c.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := c.getQueryRunner(tx)
+		if _, err := runner.fetchCpi(networkId, cpiId); err != nil {
+			return nil, err
+		}
+		err := db.NewQuery().
+			WithBuilder(runner.builder).
+			From(&DBCpi{}).
+			Where(getCpiFilters(networkId, cpiId)).
+			Delete()
+		return nil, err
+	})
+	return makeError(err, c.errorChecker)
+}
+
+func (c *cpiManager) FetchCbsdForSigning(networkId string, id int64) (*DBCbsd, error) {
+	cbsd, err := sqorc.ExecInTx(c.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := c.getQueryRunner(tx)
+		return runner.fetchCbsdForSigning(networkId, id, "")
+	})
+	if err != nil {
+		return nil, makeError(err, c.errorChecker)
+	}
+	return cbsd.(*DBCbsd), nil
+}
+
+// SignCbsd stores signature of cbsd installation parameters made by given cpi.
+// Cbsd is deregistered so that it can register again with the signature.
+func (c *cpiManager) SignCbsd(networkId string, id int64, cpiId string, sign SignFunc) error {
+	_, err := sqorc.ExecInTx(c.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := c.getQueryRunner(tx)
+		cpi, err := runner.fetchCpi(networkId, cpiId)
+		if err != nil {
+			return nil, err
+		}
+		cbsd, err := runner.fetchCbsdForSigning(networkId, id, runner.locker.WithLock())
+		if err != nil {
+			return nil, err
+		}
+		cbsd.CpiId = cpi.CpiId
+		cbsd.InstallCertificationTime = db.MakeTime(clock.Now().UTC())
+		if cbsd.CpiSignatureData, err = sign(cbsd, cpi); err != nil {
+			return nil, err
+		}
+		cbsd.ShouldDeregister = db.MakeBool(true)
+		_, err = db.NewQuery().
+			WithBuilder(runner.builder).
+			From(cbsd).
+			Select(db.NewIncludeMask()).
+			Where(sq.Eq{"id": id}).
+			Update(db.NewIncludeMask(append(getCpiFields(), "should_deregister")...))
+		return nil, err
+	})
+	return makeError(err, c.errorChecker)
+}
+
+func (r *queryRunner) fetchCpi(networkId string, cpiId string) (*DBCpi, error) {
+	res, err := db.NewQuery().
+		WithBuilder(r.builder).
+		From(&DBCpi{}).
+		Select(db.NewExcludeMask()).
+		Where(getCpiFilters(networkId, cpiId)).
+		Fetch()
+	if err != nil {
+		return nil, err
+	}
+	return res[0].(*DBCpi), nil
+}
+
+func (r *queryRunner) fetchCbsdForSigning(networkId string, id int64, lock string) (*DBCbsd, error) {
+	res, err := db.NewQuery().
+		WithBuilder(r.builder).
+		From(&DBCbsd{}).
+		Select(db.NewIncludeMask(append(append(getCpiSignedFields(), getCpiFields()...), "id")...)).
+		Where(getCbsdFiltersWithId(networkId, id)).
+		Lock(lock).
+		Fetch()
+	if err != nil {
+		return nil, err
+	}
+	return res[0].(*DBCbsd), nil
+}
+
+func getCpiFilters(networkId string, cpiId string) sq.Eq {
+	return sq.Eq{"network_id": networkId, "cpi_id": cpiId}
+}
+
+// getCpiSignedFields returns columns which are part of cpi signed data,
+// changing any of them invalidates the signature.
+func getCpiSignedFields() []string {
+	return []string{
+		"fcc_id", "cbsd_serial_number", "cbsd_category", "latitude_deg", "longitude_deg",
+		"height_m", "height_type", "indoor_deployment", "antenna_gain",
+	}
+}
+
+func getCpiFields() []string {
+	return []string{"cpi_id", "install_certification_time", "cpi_signature_data"}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/suite"
+
+	b "magma/dp/cloud/go/services/dp/builders"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/dp/cloud/go/services/dp/storage/dbtest"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/lib/go/merrors"
+)
+
+const (
+	someCpiId    = "some_cpi_id"
+	otherCpiId   = "other_cpi_id"
+	someCpiName  = "some cpi name"
+	somePemKey   = "some private key"
+	someProtHead = "some_header"
+)
+
+func TestCpiManager(t *testing.T) {
+	suite.Run(t, &CpiManagerTestSuite{})
+}
+
+type CpiManagerTestSuite struct {
+	suite.Suite
+	cpiManager      storage.CpiManager
+	resourceManager dbtest.ResourceManager
+}
+
+func (s *CpiManagerTestSuite) SetupSuite() {
+	builder := sqorc.GetSqlBuilder()
+	errorChecker := sqorc.SQLiteErrorChecker{}
+	locker := sqorc.GetSqlLocker()
+	database, err := sqorc.Open("sqlite3", ":memory:")
+	s.Require().NoError(err)
+	s.cpiManager = storage.NewCpiManager(database, builder, errorChecker, locker)
+	s.resourceManager = dbtest.NewResourceManager(s.T(), database, builder)
+	err = s.resourceManager.CreateTables(
+		&storage.DBCbsdState{},
+		&storage.DBCbsd{},
+		&storage.DBCpi{},
+	)
+	s.Require().NoError(err)
+	err = s.resourceManager.InsertResources(
+		db.NewExcludeMask("id"),
+		&storage.DBCbsdState{Name: db.MakeString(unregistered)},
+		&storage.DBCbsdState{Name: db.MakeString(registered)},
+	)
+	s.Require().NoError(err)
+}
+
+func (s *CpiManagerTestSuite) TearDownTest() {
+	clock.UnfreezeClock(s.T())
+	err := s.resourceManager.DropResources(
+		&storage.DBCbsd{},
+		&storage.DBCpi{},
+	)
+	s.Require().NoError(err)
+}
+
+func (s *CpiManagerTestSuite) TestCreateCpi() {
+	err := s.cpiManager.CreateCpi(someNetwork, getCpi(someCpiId))
+	s.Require().NoError(err)
+
+	actual, err := s.cpiManager.FetchCpi(someNetwork, someCpiId)
+	s.Require().NoError(err)
+	expected := getCpi(someCpiId)
+	expected.NetworkId = db.MakeString(someNetwork)
+	expected.Id = actual.Id
+	expected.CreatedDate = actual.CreatedDate
+	s.Assert().Equal(expected, actual)
+}
+
+func (s *CpiManagerTestSuite) TestCreateCpiWithExistingId() {
+	s.givenCpi(someNetwork, someCpiId)
+
+	err := s.cpiManager.CreateCpi(someNetwork, getCpi(someCpiId))
+	s.Assert().ErrorIs(err, merrors.ErrAlreadyExists)
+}
+
+func (s *CpiManagerTestSuite) TestCreateCpiWithIdExistingInOtherNetwork() {
+	s.givenCpi(otherNetwork, someCpiId)
+
+	err := s.cpiManager.CreateCpi(someNetwork, getCpi(someCpiId))
+	s.Assert().NoError(err)
+}
+
+func (s *CpiManagerTestSuite) TestFetchNonExistentCpi() {
+	s.givenCpi(otherNetwork, someCpiId)
+
+	_, err := s.cpiManager.FetchCpi(someNetwork, someCpiId)
+	s.Assert().ErrorIs(err, merrors.ErrNotFound)
+}
+
+func (s *CpiManagerTestSuite) TestListCpis() {
+	s.givenCpi(someNetwork, otherCpiId)
+	s.givenCpi(someNetwork, someCpiId)
+	s.givenCpi(otherNetwork, "yet_another_cpi_id")
+
+	actual, err := s.cpiManager.ListCpis(someNetwork)
+	s.Require().NoError(err)
+
+	s.Require().Len(actual, 2)
+	for i, cpiId := range []string{otherCpiId, someCpiId} {
+		s.Assert().Equal(cpiId, actual[i].CpiId.String)
+		s.Assert().Equal(someCpiName, actual[i].CpiName.String)
+		s.Assert().Equal(somePemKey, actual[i].PrivateKey.String)
+	}
+}
+
+func (s *CpiManagerTestSuite) TestDeleteCpi() {
+	s.givenCpi(someNetwork, someCpiId)
+
+	err := s.cpiManager.DeleteCpi(someNetwork, someCpiId)
+	s.Require().NoError(err)
+
+	_, err = s.cpiManager.FetchCpi(someNetwork, someCpiId)
+	s.Assert().ErrorIs(err, merrors.ErrNotFound)
+}
+
+func (s *CpiManagerTestSuite) TestDeleteNonExistentCpi() {
+	err := s.cpiManager.DeleteCpi(someNetwork, someCpiId)
+	s.Assert().ErrorIs(err, merrors.ErrNotFound)
+}
+
+func (s *CpiManagerTestSuite) TestSignCbsd() {
+	now := time.Unix(nowTimestamp, 0)
+	clock.SetAndFreezeClock(s.T(), now)
+	s.givenCpi(someNetwork, someCpiId)
+	s.givenCbsd(b.NewDBCbsdBuilder().WithFullInstallationParam())
+
+	signature := &storage.CpiSignatureData{ProtectedHeader: someProtHead}
+	var signedCbsd *storage.DBCbsd
+	var signingCpi *storage.DBCpi
+	err := s.cpiManager.SignCbsd(someNetwork, someCbsdId, someCpiId, func(cbsd *storage.DBCbsd, cpi *storage.DBCpi) (*storage.CpiSignatureData, error) {
+		signedCbsd, signingCpi = cbsd, cpi
+		return signature, nil
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(somePemKey, signingCpi.PrivateKey.String)
+	s.Assert().Equal(someSerialNumber, signedCbsd.CbsdSerialNumber.String)
+	s.Assert().Equal(someCpiId, signedCbsd.CpiId.String)
+	s.Assert().Equal(now.UTC(), signedCbsd.InstallCertificationTime.Time)
+
+	expected := b.NewDBCbsdBuilder().
+		Empty().
+		WithCpiSignature(someCpiId, nowTimestamp, signature).
+		WithShouldDeregister(true).
+		Cbsd
+	s.thenCbsdCpiFieldsAre(expected)
+}
+
+func (s *CpiManagerTestSuite) TestSignCbsdWithNonExistentCpi() {
+	s.givenCbsd(b.NewDBCbsdBuilder())
+
+	err := s.cpiManager.SignCbsd(someNetwork, someCbsdId, someCpiId, nil)
+	s.Assert().ErrorIs(err, merrors.ErrNotFound)
+}
+
+func (s *CpiManagerTestSuite) TestSignNonExistentCbsd() {
+	s.givenCpi(someNetwork, someCpiId)
+
+	err := s.cpiManager.SignCbsd(someNetwork, someCbsdId, someCpiId, nil)
+	s.Assert().ErrorIs(err, merrors.ErrNotFound)
+}
+
+func (s *CpiManagerTestSuite) TestSignCbsdFailure() {
+	s.givenCpi(someNetwork, someCpiId)
+	s.givenCbsd(b.NewDBCbsdBuilder())
+
+	signErr := errors.New("some error")
+	err := s.cpiManager.SignCbsd(someNetwork, someCbsdId, someCpiId, func(*storage.DBCbsd, *storage.DBCpi) (*storage.CpiSignatureData, error) {
+		return nil, signErr
+	})
+	s.Assert().ErrorIs(err, signErr)
+
+	expected := b.NewDBCbsdBuilder().
+		Empty().
+		WithShouldDeregister(false).
+		Cbsd
+	s.thenCbsdCpiFieldsAre(expected)
+}
+
+func (s *CpiManagerTestSuite) givenCpi(networkId string, cpiId string) {
+	cpi := getCpi(cpiId)
+	cpi.NetworkId = db.MakeString(networkId)
+	err := s.resourceManager.InsertResources(db.NewExcludeMask("id", "created_date"), cpi)
+	s.Require().NoError(err)
+}
+
+func (s *CpiManagerTestSuite) givenCbsd(builder *b.DBCbsdBuilder) {
+	state := s.getCbsdStateId(registered)
+	cbsd := builder.
+		WithId(someCbsdId).
+		WithNetworkId(someNetwork).
+		WithStateId(state).
+		WithDesiredStateId(state).
+		Cbsd
+	err := s.resourceManager.InsertResources(db.NewExcludeMask(), cbsd)
+	s.Require().NoError(err)
+}
+
+func (s *CpiManagerTestSuite) getCbsdStateId(name string) int64 {
+	var id int64
+	err := s.resourceManager.InTransaction(func() {
+		res, err := db.NewQuery().
+			WithBuilder(s.resourceManager.GetBuilder()).
+			From(&storage.DBCbsdState{}).
+			Select(db.NewIncludeMask("id")).
+			Where(sq.Eq{"name": name}).
+			Fetch()
+		s.Require().NoError(err)
+		id = res[0].(*storage.DBCbsdState).Id.Int64
+	})
+	s.Require().NoError(err)
+	return id
+}
+
+func (s *CpiManagerTestSuite) thenCbsdCpiFieldsAre(expected *storage.DBCbsd) {
+	err := s.resourceManager.InTransaction(func() {
+		actual, err := db.NewQuery().
+			WithBuilder(s.resourceManager.GetBuilder()).
+			From(&storage.DBCbsd{}).
+			Select(db.NewIncludeMask("cpi_id", "install_certification_time", "cpi_signature_data", "should_deregister")).
+			Where(sq.Eq{"id": someCbsdId}).
+			Fetch()
+		s.Require().NoError(err)
+		s.Assert().Equal([]db.Model{expected}, actual)
+	})
+	s.Require().NoError(err)
+}
+
+func getCpi(cpiId string) *storage.DBCpi {
+	return &storage.DBCpi{
+		CpiId:      db.MakeString(cpiId),
+		CpiName:    db.MakeString(someCpiName),
+		PrivateKey: db.MakeString(somePemKey),
+	}
+}
@@ -48,3 +48,12 @@ func distanceM(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
 	res := sinLat*sinLat + math.Cos(lat1)*math.Cos(lat2)*sinLon*sinLon
 	return 2 * math.Asin(math.Sqrt(res)) * radiusM
 }
+
+func cpiSignedFieldsChanged(prev *DBCbsd, next *DBCbsd) bool {
+	return prev.FccId != next.FccId ||
+		prev.CbsdSerialNumber != next.CbsdSerialNumber ||
+		prev.CbsdCategory != next.CbsdCategory ||
+		prev.LatitudeDeg != next.LatitudeDeg ||
+		prev.LongitudeDeg != next.LongitudeDeg ||
+		paramsChanges(prev, next)
+}
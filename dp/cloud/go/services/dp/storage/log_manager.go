@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/orc8r/cloud/go/sqorc"
+)
+
+// LogManager stores messages exchanged by Domain Proxy with SAS and CBSDs.
+type LogManager interface {
+	CreateLog(data *DBLog) error
+	ListLogs(networkId string, pagination *Pagination, filter *LogFilter) (*LogList, error)
+	DeleteLogsOlderThan(t time.Time) error
+	SetBackend(backend string) error
+}
+
+type LogFilter struct {
+	From         string
+	To           string
+	Name         string
+	SerialNumber string
+	FccId        string
+	ResponseCode sql.NullInt64
+	Begin        sql.NullTime
+	End          sql.NullTime
+}
+
+type LogList struct {
+	Logs  []*DBLog
+	Count int64
+}
+
+func NewLogManager(db *sql.DB, builder sqorc.StatementBuilder, errorChecker sqorc.ErrorChecker, locker sqorc.Locker) *logManager {
+	return &logManager{
+		&dpManager{
+			db:           db,
+			builder:      builder,
+			cache:        &enumCache{cache: map[string]map[string]int64{}},
+			errorChecker: errorChecker,
+			locker:       locker,
+		},
+	}
+}
+
+type logManager struct {
+	*dpManager
+}
+
+func (l *logManager) CreateLog(data *DBLog) error {
+	_, err := sqorc.ExecInTx(l.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := l.getQueryRunner(tx)
+		return db.NewQuery().
+			WithBuilder(runner.builder).
+			From(data).
+			Insert(db.NewExcludeMask("id"))
+	})
+	return makeError(err, l.errorChecker)
+}
+
+func (l *logManager) ListLogs(networkId string, pagination *Pagination, filter *LogFilter) (*LogList, error) {
+	logs, err := sqorc.ExecInTx(l.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := l.getQueryRunner(tx)
+		return runner.listLogs(networkId, pagination, filter)
+	})
+	if err != nil {
+		return nil, makeError(err, l.errorChecker)
+	}
+	return logs.(*LogList), nil
+}
+
+func (l *logManager) DeleteLogsOlderThan(t time.Time) error {
+	_, err := sqorc.ExecInTx(l.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := l.getQueryRunner(tx)
+		return nil, db.NewQuery().
+			WithBuilder(runner.builder).
+			From(&DBLog{}).
+			Where(sq.Lt{"event_time": t}).
+			Delete()
+	})
+	return makeError(err, l.errorChecker)
+}
+
+// SetBackend records where logs are stored, the python configuration
+// controller reads it instead of having its own setting.
+func (l *logManager) SetBackend(backend string) error {
+	_, err := sqorc.ExecInTx(l.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := l.getQueryRunner(tx)
+		err := db.NewQuery().
+			WithBuilder(runner.builder).
+			From(&DBLogBackend{}).
+			Where(sq.Eq{"id": logBackendId}).
+			Delete()
+		if err != nil {
+			return nil, err
+		}
+		return db.NewQuery().
+			WithBuilder(runner.builder).
+			From(&DBLogBackend{Id: db.MakeInt(logBackendId), Backend: db.MakeString(backend)}).
+			Insert(db.NewExcludeMask())
+	})
+	return makeError(err, l.errorChecker)
+}
+
+const logBackendId = 1
+
+func (r *queryRunner) listLogs(networkId string, pagination *Pagination, filter *LogFilter) (*LogList, error) {
+	filters := getLogFilters(networkId, filter)
+	count, err := db.NewQuery().
+		WithBuilder(r.builder).
+		From(&DBLog{}).
+		Where(filters).
+		Count()
+	if err != nil {
+		return nil, err
+	}
+	query := db.NewQuery().
+		WithBuilder(r.builder).
+		From(&DBLog{}).
+		Select(db.NewExcludeMask())
+	res, err := buildPagination(query, pagination).
+		Where(filters).
+		OrderBy("event_time", db.OrderDesc).
+		List()
+	if err != nil {
+		return nil, err
+	}
+	logs := make([]*DBLog, len(res))
+	for i, models := range res {
+		logs[i] = models[0].(*DBLog)
+	}
+	return &LogList{Logs: logs, Count: count}, nil
+}
+
+func getLogFilters(networkId string, filter *LogFilter) sq.And {
+	eq := sq.Eq{"network_id": networkId}
+	filters := sq.And{eq}
+	if filter == nil {
+		return filters
+	}
+	setIfNotEmpty(eq, "log_from", filter.From)
+	setIfNotEmpty(eq, "log_to", filter.To)
+	setIfNotEmpty(eq, "log_name", filter.Name)
+	setIfNotEmpty(eq, "cbsd_serial_number", filter.SerialNumber)
+	setIfNotEmpty(eq, "fcc_id", filter.FccId)
+	if filter.ResponseCode.Valid {
+		eq["response_code"] = filter.ResponseCode.Int64
+	}
+	if filter.Begin.Valid {
+		filters = append(filters, sq.GtOrEq{"event_time": filter.Begin.Time})
+	}
+	if filter.End.Valid {
+		filters = append(filters, sq.LtOrEq{"event_time": filter.End.Time})
+	}
+	return filters
+}
+
+func setIfNotEmpty(filters sq.Eq, column string, value string) {
+	if value != "" {
+		filters[column] = value
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/dp/cloud/go/services/dp/storage/dbtest"
+	"magma/orc8r/cloud/go/sqorc"
+)
+
+const (
+	logTimestamp   = 1000000
+	heartbeatName  = "heartbeatResponse"
+	grantName      = "grantResponse"
+	otherSerial    = "other_serial_number"
+	someLogMessage = "some message"
+)
+
+func TestLogManager(t *testing.T) {
+	suite.Run(t, &LogManagerTestSuite{})
+}
+
+type LogManagerTestSuite struct {
+	suite.Suite
+	logManager      storage.LogManager
+	resourceManager dbtest.ResourceManager
+}
+
+func (s *LogManagerTestSuite) SetupSuite() {
+	builder := sqorc.GetSqlBuilder()
+	database, err := sqorc.Open("sqlite3", ":memory:")
+	s.Require().NoError(err)
+	s.logManager = storage.NewLogManager(database, builder, sqorc.SQLiteErrorChecker{}, sqorc.GetSqlLocker())
+	s.resourceManager = dbtest.NewResourceManager(s.T(), database, builder)
+	err = s.resourceManager.CreateTables(&storage.DBLog{}, &storage.DBLogBackend{})
+	s.Require().NoError(err)
+}
+
+func (s *LogManagerTestSuite) TearDownTest() {
+	err := s.resourceManager.DropResources(&storage.DBLog{}, &storage.DBLogBackend{})
+	s.Require().NoError(err)
+}
+
+func (s *LogManagerTestSuite) TestCreateLog() {
+	log := getLog(someNetwork, someSerialNumber, heartbeatName, 501, 0)
+
+	err := s.logManager.CreateLog(log)
+	s.Require().NoError(err)
+
+	actual, err := s.logManager.ListLogs(someNetwork, &storage.Pagination{}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(actual.Logs, 1)
+	log.Id = actual.Logs[0].Id
+	s.Assert().Equal(int64(1), actual.Count)
+	s.Assert().Equal(log.EventTime.Time.Unix(), actual.Logs[0].EventTime.Time.Unix())
+	actual.Logs[0].EventTime = log.EventTime
+	s.Assert().Equal(log, actual.Logs[0])
+}
+
+func (s *LogManagerTestSuite) TestListLogs() {
+	s.givenLogs(
+		getLog(someNetwork, someSerialNumber, heartbeatName, 0, 1),
+		getLog(someNetwork, otherSerial, heartbeatName, 0, 2),
+		getLog(someNetwork, someSerialNumber, grantName, 400, 3),
+		getLog(someNetwork, someSerialNumber, heartbeatName, 501, 4),
+		getLog(otherNetwork, someSerialNumber, heartbeatName, 0, 5),
+	)
+
+	testCases := []struct {
+		name          string
+		filter        *storage.LogFilter
+		pagination    *storage.Pagination
+		expectedTimes []int64
+		expectedCount int64
+	}{{
+		name:          "Should list all logs from network, newest first",
+		expectedTimes: []int64{4, 3, 2, 1},
+		expectedCount: 4,
+	}, {
+		name:          "Should filter by serial number",
+		filter:        &storage.LogFilter{SerialNumber: otherSerial},
+		expectedTimes: []int64{2},
+		expectedCount: 1,
+	}, {
+		name:          "Should filter by name and response code",
+		filter:        &storage.LogFilter{Name: heartbeatName, ResponseCode: db.MakeInt(501)},
+		expectedTimes: []int64{4},
+		expectedCount: 1,
+	}, {
+		name: "Should filter by time window",
+		filter: &storage.LogFilter{
+			Begin: db.MakeTime(eventTime(2)),
+			End:   db.MakeTime(eventTime(3)),
+		},
+		expectedTimes: []int64{3, 2},
+		expectedCount: 2,
+	}, {
+		name: "Should paginate logs",
+		pagination: &storage.Pagination{
+			Limit:  db.MakeInt(2),
+			Offset: db.MakeInt(1),
+		},
+		expectedTimes: []int64{3, 2},
+		expectedCount: 4,
+	}}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			pagination := tc.pagination
+			if pagination == nil {
+				pagination = &storage.Pagination{}
+			}
+			actual, err := s.logManager.ListLogs(someNetwork, pagination, tc.filter)
+			s.Require().NoError(err)
+
+			s.Assert().Equal(tc.expectedCount, actual.Count)
+			times := make([]int64, len(actual.Logs))
+			for i, l := range actual.Logs {
+				times[i] = l.EventTime.Time.Unix() - logTimestamp
+			}
+			s.Assert().Equal(tc.expectedTimes, times)
+		})
+	}
+}
+
+func (s *LogManagerTestSuite) TestDeleteLogsOlderThan() {
+	s.givenLogs(
+		getLog(someNetwork, someSerialNumber, heartbeatName, 0, 1),
+		getLog(someNetwork, someSerialNumber, heartbeatName, 0, 2),
+		getLog(otherNetwork, someSerialNumber, heartbeatName, 0, 3),
+	)
+
+	err := s.logManager.DeleteLogsOlderThan(eventTime(2))
+	s.Require().NoError(err)
+
+	actual, err := s.logManager.ListLogs(someNetwork, &storage.Pagination{}, nil)
+	s.Require().NoError(err)
+	s.Require().Len(actual.Logs, 1)
+	s.Assert().Equal(eventTime(2).Unix(), actual.Logs[0].EventTime.Time.Unix())
+}
+
+func (s *LogManagerTestSuite) TestSetBackend() {
+	s.Require().NoError(s.logManager.SetBackend("elasticsearch"))
+	s.Require().NoError(s.logManager.SetBackend("database"))
+
+	err := s.resourceManager.InTransaction(func() {
+		actual, err := db.NewQuery().
+			WithBuilder(s.resourceManager.GetBuilder()).
+			From(&storage.DBLogBackend{}).
+			Select(db.NewExcludeMask()).
+			List()
+		s.Require().NoError(err)
+		s.Require().Len(actual, 1)
+		expected := &storage.DBLogBackend{
+			Id:      db.MakeInt(1),
+			Backend: db.MakeString("database"),
+		}
+		s.Assert().Equal(expected, actual[0][0])
+	})
+	s.Require().NoError(err)
+}
+
+func (s *LogManagerTestSuite) givenLogs(logs ...*storage.DBLog) {
+	models := make([]db.Model, len(logs))
+	for i, l := range logs {
+		models[i] = l
+	}
+	err := s.resourceManager.InsertResources(db.NewExcludeMask("id"), models...)
+	s.Require().NoError(err)
+}
+
+func getLog(networkId string, serialNumber string, name string, responseCode int64, offset int64) *storage.DBLog {
+	log := &storage.DBLog{
+		NetworkId:        db.MakeString(networkId),
+		LogFrom:          db.MakeString("SAS"),
+		LogTo:            db.MakeString("DP"),
+		LogName:          db.MakeString(name),
+		LogMessage:       db.MakeString(someLogMessage),
+		CbsdSerialNumber: db.MakeString(serialNumber),
+		FccId:            db.MakeString(someFccId),
+		EventTime:        db.MakeTime(eventTime(offset)),
+	}
+	if responseCode != 0 {
+		log.ResponseCode = db.MakeInt(responseCode)
+	}
+	return log
+}
+
+func eventTime(offset int64) time.Time {
+	return time.Unix(logTimestamp+offset, 0).UTC()
+}
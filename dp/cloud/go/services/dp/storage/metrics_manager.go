@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/orc8r/cloud/go/sqorc"
+)
+
+// MetricsManager reads state of all cbsds which is exported as metrics.
+type MetricsManager interface {
+	// GetMetricsState returns all not deleted cbsds with network id, state and grants
+	// (grant state, frequency range and heartbeat timing), idle grants are skipped.
+	GetMetricsState() ([]*DetailedCbsd, error)
+}
+
+func NewMetricsManager(db *sql.DB, builder sqorc.StatementBuilder, errorChecker sqorc.ErrorChecker, locker sqorc.Locker) *metricsManager {
+	return &metricsManager{
+		&dpManager{
+			db:           db,
+			builder:      builder,
+			cache:        &enumCache{cache: map[string]map[string]int64{}},
+			errorChecker: errorChecker,
+			locker:       locker,
+		},
+	}
+}
+
+type metricsManager struct {
+	*dpManager
+}
+
+func (m *metricsManager) GetMetricsState() ([]*DetailedCbsd, error) {
+	cbsds, err := sqorc.ExecInTx(m.db, nil, nil, func(tx *sql.Tx) (interface{}, error) {
+		runner := m.getQueryRunner(tx)
+		return runner.getMetricsState()
+	})
+	if err != nil {
+		return nil, makeError(err, m.errorChecker)
+	}
+	return cbsds.([]*DetailedCbsd), nil
+}
+
+func (r *queryRunner) getMetricsState() ([]*DetailedCbsd, error) {
+	res, err := db.NewQuery().
+		WithBuilder(r.builder).
+		From(&DBCbsd{}).
+		Select(db.NewIncludeMask("id", "network_id")).
+		Join(db.NewQuery().
+			From(&DBCbsdState{}).
+			On(db.On(CbsdTable, "state_id", CbsdStateTable, "id")).
+			Select(db.NewIncludeMask("name"))).
+		Join(db.NewQuery().
+			From(&DBGrant{}).
+			On(db.On(CbsdTable, "id", GrantTable, "cbsd_id")).
+			Select(db.NewIncludeMask("heartbeat_interval", "last_heartbeat_request_time", "low_frequency", "high_frequency")).
+			Join(db.NewQuery().
+				From(&DBGrantState{}).
+				On(sq.And{
+					db.On(GrantTable, "state_id", GrantStateTable, "id"),
+					sq.NotEq{GrantStateTable + ".name": "idle"},
+				}).
+				Select(db.NewIncludeMask("name"))).
+			Nullable()).
+		Nullable().
+		Where(sq.Eq{"is_deleted": false}).
+		OrderBy(CbsdTable+".id", db.OrderAsc).
+		List()
+	if err != nil {
+		return nil, err
+	}
+	var cbsds []*DetailedCbsd
+	for _, models := range res {
+		cbsd := models[0].(*DBCbsd)
+		if len(cbsds) == 0 || cbsds[len(cbsds)-1].Cbsd.Id != cbsd.Id {
+			cbsds = append(cbsds, &DetailedCbsd{
+				Cbsd:      cbsd,
+				CbsdState: models[1].(*DBCbsdState),
+			})
+		}
+		grant := models[2].(*DBGrant)
+		if grant.LowFrequencyHz.Valid {
+			last := cbsds[len(cbsds)-1]
+			last.Grants = append(last.Grants, &DetailedGrant{
+				Grant:      grant,
+				GrantState: models[3].(*DBGrantState),
+			})
+		}
+	}
+	return cbsds, nil
+}
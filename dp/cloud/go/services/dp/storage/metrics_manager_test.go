@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	b "magma/dp/cloud/go/services/dp/builders"
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/dp/cloud/go/services/dp/storage/dbtest"
+	"magma/orc8r/cloud/go/sqorc"
+)
+
+const (
+	registeredStateId = iota + 1
+	unregisteredStateId
+)
+
+const (
+	idleGrantStateId = iota + 1
+	grantedGrantStateId
+	authorizedGrantStateId
+)
+
+func TestMetricsManager(t *testing.T) {
+	suite.Run(t, &MetricsManagerTestSuite{})
+}
+
+type MetricsManagerTestSuite struct {
+	suite.Suite
+	metricsManager  storage.MetricsManager
+	resourceManager dbtest.ResourceManager
+}
+
+func (s *MetricsManagerTestSuite) SetupSuite() {
+	builder := sqorc.GetSqlBuilder()
+	database, err := sqorc.Open("sqlite3", ":memory:")
+	s.Require().NoError(err)
+	s.metricsManager = storage.NewMetricsManager(database, builder, sqorc.SQLiteErrorChecker{}, sqorc.GetSqlLocker())
+	s.resourceManager = dbtest.NewResourceManager(s.T(), database, builder)
+	err = s.resourceManager.CreateTables(
+		&storage.DBCbsdState{},
+		&storage.DBCbsd{},
+		&storage.DBGrantState{},
+		&storage.DBGrant{},
+	)
+	s.Require().NoError(err)
+	err = s.resourceManager.InsertResources(
+		db.NewExcludeMask(),
+		&storage.DBCbsdState{Id: db.MakeInt(registeredStateId), Name: db.MakeString(registered)},
+		&storage.DBCbsdState{Id: db.MakeInt(unregisteredStateId), Name: db.MakeString(unregistered)},
+		&storage.DBGrantState{Id: db.MakeInt(idleGrantStateId), Name: db.MakeString(idle)},
+		&storage.DBGrantState{Id: db.MakeInt(grantedGrantStateId), Name: db.MakeString(granted)},
+		&storage.DBGrantState{Id: db.MakeInt(authorizedGrantStateId), Name: db.MakeString(authorized)},
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MetricsManagerTestSuite) TearDownTest() {
+	err := s.resourceManager.DropResources(&storage.DBGrant{}, &storage.DBCbsd{})
+	s.Require().NoError(err)
+}
+
+func (s *MetricsManagerTestSuite) TestGetMetricsState() {
+	err := s.resourceManager.InsertResources(
+		db.NewExcludeMask(),
+		s.givenCbsd(1, someNetwork, registeredStateId, false),
+		s.givenCbsd(2, otherNetwork, unregisteredStateId, false),
+		s.givenCbsd(3, someNetwork, registeredStateId, true),
+		s.givenGrant(1, 1, grantedGrantStateId, 3560),
+		s.givenGrant(2, 1, authorizedGrantStateId, 3580),
+		s.givenGrant(3, 1, idleGrantStateId, 3600),
+		s.givenGrant(4, 3, authorizedGrantStateId, 3600),
+	)
+	s.Require().NoError(err)
+
+	actual, err := s.metricsManager.GetMetricsState()
+	s.Require().NoError(err)
+
+	expected := []*storage.DetailedCbsd{{
+		Cbsd:      &storage.DBCbsd{Id: db.MakeInt(1), NetworkId: db.MakeString(someNetwork)},
+		CbsdState: &storage.DBCbsdState{Name: db.MakeString(registered)},
+		Grants: []*storage.DetailedGrant{{
+			Grant:      s.expectedGrant(3560),
+			GrantState: &storage.DBGrantState{Name: db.MakeString(granted)},
+		}, {
+			Grant:      s.expectedGrant(3580),
+			GrantState: &storage.DBGrantState{Name: db.MakeString(authorized)},
+		}},
+	}, {
+		Cbsd:      &storage.DBCbsd{Id: db.MakeInt(2), NetworkId: db.MakeString(otherNetwork)},
+		CbsdState: &storage.DBCbsdState{Name: db.MakeString(unregistered)},
+	}}
+	s.Assert().Equal(expected, actual)
+}
+
+func (s *MetricsManagerTestSuite) givenCbsd(id int64, networkId string, stateId int64, isDeleted bool) *storage.DBCbsd {
+	return b.NewDBCbsdBuilder().
+		WithId(id).
+		WithNetworkId(networkId).
+		WithSerialNumber(someSerialNumber + string(rune('0'+id))).
+		WithStateId(stateId).
+		WithDesiredStateId(stateId).
+		WithIsDeleted(isDeleted).
+		Cbsd
+}
+
+func (s *MetricsManagerTestSuite) givenGrant(id int64, cbsdId int64, stateId int64, frequencyMHz int64) *storage.DBGrant {
+	return b.NewDBGrantBuilder().
+		WithDefaultTestValues().
+		WithId(id).
+		WithGrantId(someGrantId + string(rune('0'+id))).
+		WithCbsdId(cbsdId).
+		WithStateId(stateId).
+		WithFrequency(frequencyMHz).
+		Grant
+}
+
+func (s *MetricsManagerTestSuite) expectedGrant(frequencyMHz int64) *storage.DBGrant {
+	grant := b.NewDBGrantBuilder().WithFrequency(frequencyMHz).Grant
+	grant.HeartbeatIntervalSec = db.MakeInt(1)
+	return grant
+}
@@ -27,6 +27,9 @@ const (
 	GrantTable       = "grants"
 	CbsdStateTable   = "cbsd_states"
 	CbsdTable        = "cbsds"
+	CpiTable         = "cpis"
+	LogTable         = "dp_logs"
+	LogBackendTable  = "dp_log_backend"
 )
 
 type EnumModel interface {
@@ -299,6 +302,14 @@ type DBCbsd struct {
 	MaxIbwMhx                 sql.NullInt64
 	AvailableFrequencies      []uint32
 	Channels                  []Channel
+	CpiId                     sql.NullString
+	InstallCertificationTime  sql.NullTime
+	CpiSignatureData          *CpiSignatureData
+	PlannedFrequenciesMHz     []int64
+	MaxCarriers               sql.NullInt64
+	MinTotalBandwidthMHz      sql.NullInt64
+	MaxTotalBandwidthMHz      sql.NullInt64
+	ContiguousCarriers        sql.NullBool
 }
 
 type Channel struct {
@@ -308,6 +319,14 @@ type Channel struct {
 	MaxEirp         float64 `json:"max_eirp"`
 }
 
+// CpiSignatureData is the signed installation data sent to SAS
+// as cpiSignatureData of registration request (WINNF-TS-0016 8.4.1).
+type CpiSignatureData struct {
+	ProtectedHeader      string `json:"protectedHeader"`
+	EncodedCpiSignedData string `json:"encodedCpiSignedData"`
+	DigitalSignature     string `json:"digitalSignature"`
+}
+
 func (c *DBCbsd) Fields() []db.BaseType {
 	return []db.BaseType{
 		db.IntType{X: &c.Id},
@@ -340,6 +359,14 @@ func (c *DBCbsd) Fields() []db.BaseType {
 		db.IntType{X: &c.MaxIbwMhx},
 		db.JsonType{X: &c.AvailableFrequencies},
 		db.JsonType{X: &c.Channels},
+		db.StringType{X: &c.CpiId},
+		db.TimeType{X: &c.InstallCertificationTime},
+		db.JsonType{X: &c.CpiSignatureData},
+		db.JsonType{X: &c.PlannedFrequenciesMHz},
+		db.IntType{X: &c.MaxCarriers},
+		db.IntType{X: &c.MinTotalBandwidthMHz},
+		db.IntType{X: &c.MaxTotalBandwidthMHz},
+		db.BoolType{X: &c.ContiguousCarriers},
 	}
 }
 
@@ -474,9 +501,192 @@ func (c *DBCbsd) GetMetadata() *db.ModelMetadata {
 			Nullable:     false,
 			HasDefault:   true,
 			DefaultValue: "'[]'",
+		}, {
+			Name:     "cpi_id",
+			SqlType:  sqorc.ColumnTypeText,
+			Nullable: true,
+		}, {
+			Name:     "install_certification_time",
+			SqlType:  sqorc.ColumnTypeDatetime,
+			Nullable: true,
+		}, {
+			Name:     "cpi_signature_data",
+			SqlType:  sqorc.ColumnTypeText,
+			Nullable: true,
+		}, {
+			Name:     "planned_frequencies_mhz",
+			SqlType:  sqorc.ColumnTypeText,
+			Nullable: true,
+		}, {
+			Name:     "max_carriers",
+			SqlType:  sqorc.ColumnTypeInt,
+			Nullable: true,
+		}, {
+			Name:     "min_total_bandwidth_mhz",
+			SqlType:  sqorc.ColumnTypeInt,
+			Nullable: true,
+		}, {
+			Name:     "max_total_bandwidth_mhz",
+			SqlType:  sqorc.ColumnTypeInt,
+			Nullable: true,
+		}, {
+			Name:     "contiguous_carriers",
+			SqlType:  sqorc.ColumnTypeBool,
+			Nullable: true,
 		}},
 		CreateObject: func() db.Model {
 			return &DBCbsd{}
 		},
 	}
 }
+
+type DBCpi struct {
+	Id          sql.NullInt64
+	NetworkId   sql.NullString
+	CpiId       sql.NullString
+	CpiName     sql.NullString
+	PrivateKey  sql.NullString
+	CreatedDate sql.NullTime
+}
+
+func (c *DBCpi) Fields() []db.BaseType {
+	return []db.BaseType{
+		db.IntType{X: &c.Id},
+		db.StringType{X: &c.NetworkId},
+		db.StringType{X: &c.CpiId},
+		db.StringType{X: &c.CpiName},
+		db.StringType{X: &c.PrivateKey},
+		db.TimeType{X: &c.CreatedDate},
+	}
+}
+
+func (c *DBCpi) GetMetadata() *db.ModelMetadata {
+	return &db.ModelMetadata{
+		Table: CpiTable,
+		Properties: []*db.Field{{
+			Name:    "id",
+			SqlType: sqorc.ColumnTypeInt,
+		}, {
+			Name:    "network_id",
+			SqlType: sqorc.ColumnTypeText,
+		}, {
+			Name:    "cpi_id",
+			SqlType: sqorc.ColumnTypeText,
+		}, {
+			Name:    "cpi_name",
+			SqlType: sqorc.ColumnTypeText,
+		}, {
+			Name:    "private_key",
+			SqlType: sqorc.ColumnTypeText,
+		}, {
+			Name:         "created_date",
+			SqlType:      sqorc.ColumnTypeDatetime,
+			HasDefault:   true,
+			DefaultValue: "CURRENT_TIMESTAMP",
+		}},
+		CreateObject: func() db.Model {
+			return &DBCpi{}
+		},
+	}
+}
+
+type DBLog struct {
+	Id               sql.NullInt64
+	NetworkId        sql.NullString
+	LogFrom          sql.NullString
+	LogTo            sql.NullString
+	LogName          sql.NullString
+	LogMessage       sql.NullString
+	CbsdSerialNumber sql.NullString
+	FccId            sql.NullString
+	ResponseCode     sql.NullInt64
+	EventTime        sql.NullTime
+}
+
+func (l *DBLog) Fields() []db.BaseType {
+	return []db.BaseType{
+		db.IntType{X: &l.Id},
+		db.StringType{X: &l.NetworkId},
+		db.StringType{X: &l.LogFrom},
+		db.StringType{X: &l.LogTo},
+		db.StringType{X: &l.LogName},
+		db.StringType{X: &l.LogMessage},
+		db.StringType{X: &l.CbsdSerialNumber},
+		db.StringType{X: &l.FccId},
+		db.IntType{X: &l.ResponseCode},
+		db.TimeType{X: &l.EventTime},
+	}
+}
+
+func (l *DBLog) GetMetadata() *db.ModelMetadata {
+	return &db.ModelMetadata{
+		Table: LogTable,
+		Properties: []*db.Field{{
+			Name:    "id",
+			SqlType: sqorc.ColumnTypeInt,
+		}, {
+			Name:    "network_id",
+			SqlType: sqorc.ColumnTypeText,
+		}, {
+			Name:    "log_from",
+			SqlType: sqorc.ColumnTypeText,
+		}, {
+			Name:    "log_to",
+			SqlType: sqorc.ColumnTypeText,
+		}, {
+			Name:    "log_name",
+			SqlType: sqorc.ColumnTypeText,
+		}, {
+			Name:    "log_message",
+			SqlType: sqorc.ColumnTypeText,
+		}, {
+			Name:     "cbsd_serial_number",
+			SqlType:  sqorc.ColumnTypeText,
+			Nullable: true,
+		}, {
+			Name:     "fcc_id",
+			SqlType:  sqorc.ColumnTypeText,
+			Nullable: true,
+		}, {
+			Name:     "response_code",
+			SqlType:  sqorc.ColumnTypeInt,
+			Nullable: true,
+		}, {
+			Name:    "event_time",
+			SqlType: sqorc.ColumnTypeDatetime,
+		}},
+		CreateObject: func() db.Model {
+			return &DBLog{}
+		},
+	}
+}
+
+// DBLogBackend holds log_store backend of dp service,
+// so that other Domain Proxy components store their logs in the same place.
+type DBLogBackend struct {
+	Id      sql.NullInt64
+	Backend sql.NullString
+}
+
+func (b *DBLogBackend) Fields() []db.BaseType {
+	return []db.BaseType{
+		db.IntType{X: &b.Id},
+		db.StringType{X: &b.Backend},
+	}
+}
+
+func (b *DBLogBackend) GetMetadata() *db.ModelMetadata {
+	return &db.ModelMetadata{
+		Table: LogBackendTable,
+		Properties: []*db.Field{{
+			Name:    "id",
+			SqlType: sqorc.ColumnTypeInt,
+		}, {
+			Name:    "backend",
+			SqlType: sqorc.ColumnTypeText,
+		}},
+		CreateObject: func() db.Model {
+			return &DBLogBackend{}
+		},
+	}
+}
@@ -30,6 +30,9 @@ func TestFields(t *testing.T) {
 	dbCbsd := &storage.DBCbsd{}
 	dbCbsdState := &storage.DBCbsdState{}
 	dbGrantState := &storage.DBGrantState{}
+	dbCpi := &storage.DBCpi{}
+	dbLog := &storage.DBLog{}
+	dbLogBackend := &storage.DBLogBackend{}
 	testCases := []struct {
 		name     string
 		model    db.Model
@@ -115,6 +118,47 @@ func TestFields(t *testing.T) {
 			db.IntType{X: &dbCbsd.MaxIbwMhx},
 			db.JsonType{X: &dbCbsd.AvailableFrequencies},
 			db.JsonType{X: &dbCbsd.Channels},
+			db.StringType{X: &dbCbsd.CpiId},
+			db.TimeType{X: &dbCbsd.InstallCertificationTime},
+			db.JsonType{X: &dbCbsd.CpiSignatureData},
+			db.JsonType{X: &dbCbsd.PlannedFrequenciesMHz},
+			db.IntType{X: &dbCbsd.MaxCarriers},
+			db.IntType{X: &dbCbsd.MinTotalBandwidthMHz},
+			db.IntType{X: &dbCbsd.MaxTotalBandwidthMHz},
+			db.BoolType{X: &dbCbsd.ContiguousCarriers},
+		},
+	}, {
+		name:  "check field names for DBCpi",
+		model: dbCpi,
+		expected: []db.BaseType{
+			db.IntType{X: &dbCpi.Id},
+			db.StringType{X: &dbCpi.NetworkId},
+			db.StringType{X: &dbCpi.CpiId},
+			db.StringType{X: &dbCpi.CpiName},
+			db.StringType{X: &dbCpi.PrivateKey},
+			db.TimeType{X: &dbCpi.CreatedDate},
+		},
+	}, {
+		name:  "check field names for DBLog",
+		model: dbLog,
+		expected: []db.BaseType{
+			db.IntType{X: &dbLog.Id},
+			db.StringType{X: &dbLog.NetworkId},
+			db.StringType{X: &dbLog.LogFrom},
+			db.StringType{X: &dbLog.LogTo},
+			db.StringType{X: &dbLog.LogName},
+			db.StringType{X: &dbLog.LogMessage},
+			db.StringType{X: &dbLog.CbsdSerialNumber},
+			db.StringType{X: &dbLog.FccId},
+			db.IntType{X: &dbLog.ResponseCode},
+			db.TimeType{X: &dbLog.EventTime},
+		},
+	}, {
+		name:  "check field names for DBLogBackend",
+		model: dbLogBackend,
+		expected: []db.BaseType{
+			db.IntType{X: &dbLogBackend.Id},
+			db.StringType{X: &dbLogBackend.Backend},
 		},
 	}}
 	for _, tc := range testCases {
@@ -373,6 +417,118 @@ func TestGetMetadata(t *testing.T) {
 				Nullable:     false,
 				HasDefault:   true,
 				DefaultValue: "'[]'",
+			}, {
+				Name:     "cpi_id",
+				SqlType:  sqorc.ColumnTypeText,
+				Nullable: true,
+			}, {
+				Name:     "install_certification_time",
+				SqlType:  sqorc.ColumnTypeDatetime,
+				Nullable: true,
+			}, {
+				Name:     "cpi_signature_data",
+				SqlType:  sqorc.ColumnTypeText,
+				Nullable: true,
+			}, {
+				Name:     "planned_frequencies_mhz",
+				SqlType:  sqorc.ColumnTypeText,
+				Nullable: true,
+			}, {
+				Name:     "max_carriers",
+				SqlType:  sqorc.ColumnTypeInt,
+				Nullable: true,
+			}, {
+				Name:     "min_total_bandwidth_mhz",
+				SqlType:  sqorc.ColumnTypeInt,
+				Nullable: true,
+			}, {
+				Name:     "max_total_bandwidth_mhz",
+				SqlType:  sqorc.ColumnTypeInt,
+				Nullable: true,
+			}, {
+				Name:     "contiguous_carriers",
+				SqlType:  sqorc.ColumnTypeBool,
+				Nullable: true,
+			}},
+		},
+	}, {
+		name:  "check ModelMetadata structure for DBCpi",
+		model: &storage.DBCpi{},
+		expected: db.ModelMetadata{
+			Table: storage.CpiTable,
+			Properties: []*db.Field{{
+				Name:    "id",
+				SqlType: sqorc.ColumnTypeInt,
+			}, {
+				Name:    "network_id",
+				SqlType: sqorc.ColumnTypeText,
+			}, {
+				Name:    "cpi_id",
+				SqlType: sqorc.ColumnTypeText,
+			}, {
+				Name:    "cpi_name",
+				SqlType: sqorc.ColumnTypeText,
+			}, {
+				Name:    "private_key",
+				SqlType: sqorc.ColumnTypeText,
+			}, {
+				Name:         "created_date",
+				SqlType:      sqorc.ColumnTypeDatetime,
+				HasDefault:   true,
+				DefaultValue: "CURRENT_TIMESTAMP",
+			}},
+		},
+	}, {
+		name:  "check ModelMetadata structure for DBLog",
+		model: &storage.DBLog{},
+		expected: db.ModelMetadata{
+			Table: storage.LogTable,
+			Properties: []*db.Field{{
+				Name:    "id",
+				SqlType: sqorc.ColumnTypeInt,
+			}, {
+				Name:    "network_id",
+				SqlType: sqorc.ColumnTypeText,
+			}, {
+				Name:    "log_from",
+				SqlType: sqorc.ColumnTypeText,
+			}, {
+				Name:    "log_to",
+				SqlType: sqorc.ColumnTypeText,
+			}, {
+				Name:    "log_name",
+				SqlType: sqorc.ColumnTypeText,
+			}, {
+				Name:    "log_message",
+				SqlType: sqorc.ColumnTypeText,
+			}, {
+				Name:     "cbsd_serial_number",
+				SqlType:  sqorc.ColumnTypeText,
+				Nullable: true,
+			}, {
+				Name:     "fcc_id",
+				SqlType:  sqorc.ColumnTypeText,
+				Nullable: true,
+			}, {
+				Name:     "response_code",
+				SqlType:  sqorc.ColumnTypeInt,
+				Nullable: true,
+			}, {
+				Name:    "event_time",
+				SqlType: sqorc.ColumnTypeDatetime,
+			}},
+		},
+	}, {
+		name:  "check ModelMetadata structure for DBLogBackend",
+		model: &storage.DBLogBackend{},
+		expected: db.ModelMetadata{
+			Table: storage.LogBackendTable,
+			Properties: []*db.Field{{
+				Name:    "id",
+				SqlType: sqorc.ColumnTypeInt,
+			}, {
+				Name:    "backend",
+				SqlType: sqorc.ColumnTypeText,
 			}},
 		},
 	}}
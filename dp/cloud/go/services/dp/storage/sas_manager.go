@@ -0,0 +1,275 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/orc8r/cloud/go/sqorc"
+)
+
+// SasManager is an interface to database used by sas client
+// to consume pending requests and to persist results of sas responses.
+// Its methods are supposed to be used in transaction (see WithinTx).
+type SasManager interface {
+	// GetPendingRequests returns at most limit requests of given type.
+	// Returned requests are locked until the end of the transaction,
+	// requests locked by other transactions are skipped.
+	GetPendingRequests(runner sq.BaseRunner, requestType string, limit int64) ([]*DBRequest, error)
+	DeleteRequest(sq.BaseRunner, *DBRequest) error
+	// LockRequests locks requests with given ids until the end of the transaction
+	// and returns the ids of the ones which still exist.
+	LockRequests(runner sq.BaseRunner, ids []int64) (map[int64]bool, error)
+	// GetCbsds returns network id, fcc id and serial number
+	// of CBSDs with given ids, mapped by their ids.
+	GetCbsds(runner sq.BaseRunner, ids []int64) (map[int64]*DBCbsd, error)
+	// RegisterCbsd moves CBSD to registered state and saves cbsd id assigned by SAS.
+	RegisterCbsd(runner sq.BaseRunner, id int64, cbsdId string) error
+	// UnregisterCbsd moves CBSD to unregistered state,
+	// removes all its grants and clears its channels.
+	UnregisterCbsd(runner sq.BaseRunner, id int64) error
+	// SetChannels removes all CBSD grants and replaces its channels
+	// with the ones received from SAS.
+	SetChannels(runner sq.BaseRunner, id int64, channels []Channel) error
+	// UpsertGrant updates grant identified by cbsd id and grant id
+	// with fields from mask or creates it if it does not exist.
+	// Existing grant is not modified if mask is nil.
+	UpsertGrant(sq.BaseRunner, *MutableGrant, db.FieldMask) error
+	// UpdateGrantState changes state of an existing grant,
+	// missing grants are ignored.
+	UpdateGrantState(sq.BaseRunner, *MutableGrant) error
+}
+
+type MutableGrant struct {
+	Grant      *DBGrant
+	GrantState *DBGrantState
+}
+
+func NewSasManager(db *sql.DB, builder sqorc.StatementBuilder, errorChecker sqorc.ErrorChecker, locker sqorc.Locker) *sasManager {
+	return &sasManager{
+		&dpManager{
+			db:           db,
+			builder:      builder,
+			cache:        &enumCache{cache: map[string]map[string]int64{}},
+			errorChecker: errorChecker,
+			locker:       locker,
+		},
+	}
+}
+
+type sasManager struct {
+	*dpManager
+}
+
+func (m *sasManager) GetPendingRequests(tx sq.BaseRunner, requestType string, limit int64) ([]*DBRequest, error) {
+	runner := m.getQueryRunner(tx)
+	return runner.getPendingRequests(requestType, limit)
+}
+
+func (m *sasManager) DeleteRequest(tx sq.BaseRunner, request *DBRequest) error {
+	return db.NewQuery().
+		WithBuilder(m.builder.RunWith(tx)).
+		From(request).
+		Where(sq.Eq{"id": request.Id}).
+		Delete()
+}
+
+func (m *sasManager) LockRequests(tx sq.BaseRunner, ids []int64) (map[int64]bool, error) {
+	runner := m.getQueryRunner(tx)
+	res, err := db.NewQuery().
+		WithBuilder(runner.builder).
+		From(&DBRequest{}).
+		Select(db.NewIncludeMask("id")).
+		Where(sq.Eq{"id": ids}).
+		Lock(runner.locker.WithLock()).
+		List()
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[int64]bool, len(res))
+	for _, models := range res {
+		existing[models[0].(*DBRequest).Id.Int64] = true
+	}
+	return existing, nil
+}
+
+func (m *sasManager) GetCbsds(tx sq.BaseRunner, ids []int64) (map[int64]*DBCbsd, error) {
+	runner := m.getQueryRunner(tx)
+	res, err := db.NewQuery().
+		WithBuilder(runner.builder).
+		From(&DBCbsd{}).
+		Select(db.NewIncludeMask("id", "network_id", "fcc_id", "cbsd_serial_number")).
+		Where(sq.Eq{"id": ids}).
+		List()
+	if err != nil {
+		return nil, err
+	}
+	cbsds := make(map[int64]*DBCbsd, len(res))
+	for _, models := range res {
+		cbsd := models[0].(*DBCbsd)
+		cbsds[cbsd.Id.Int64] = cbsd
+	}
+	return cbsds, nil
+}
+
+func (m *sasManager) RegisterCbsd(tx sq.BaseRunner, id int64, cbsdId string) error {
+	runner := m.getQueryRunner(tx)
+	stateId, err := runner.cache.getValue(runner.builder, &DBCbsdState{}, "registered")
+	if err != nil {
+		return err
+	}
+	cbsd := &DBCbsd{
+		StateId: db.MakeInt(stateId),
+		CbsdId:  db.MakeString(cbsdId),
+	}
+	return runner.updateCbsdById(id, cbsd, db.NewIncludeMask("state_id", "cbsd_id"))
+}
+
+func (m *sasManager) UnregisterCbsd(tx sq.BaseRunner, id int64) error {
+	runner := m.getQueryRunner(tx)
+	stateId, err := runner.cache.getValue(runner.builder, &DBCbsdState{}, "unregistered")
+	if err != nil {
+		return err
+	}
+	if err := runner.deleteCbsdGrants(id); err != nil {
+		return err
+	}
+	cbsd := &DBCbsd{
+		StateId:  db.MakeInt(stateId),
+		Channels: []Channel{},
+	}
+	return runner.updateCbsdById(id, cbsd, db.NewIncludeMask("state_id", "channels"))
+}
+
+func (m *sasManager) SetChannels(tx sq.BaseRunner, id int64, channels []Channel) error {
+	runner := m.getQueryRunner(tx)
+	if err := runner.deleteCbsdGrants(id); err != nil {
+		return err
+	}
+	if channels == nil {
+		channels = []Channel{}
+	}
+	cbsd := &DBCbsd{Channels: channels}
+	return runner.updateCbsdById(id, cbsd, db.NewIncludeMask("channels", "available_frequencies"))
+}
+
+func (m *sasManager) UpsertGrant(tx sq.BaseRunner, data *MutableGrant, mask db.FieldMask) error {
+	runner := m.getQueryRunner(tx)
+	stateId, err := runner.cache.getValue(runner.builder, &DBGrantState{}, data.GrantState.Name.String)
+	if err != nil {
+		return err
+	}
+	data.Grant.StateId = db.MakeInt(stateId)
+	existing, err := runner.findGrant(data.Grant)
+	if err == sql.ErrNoRows {
+		_, err = db.NewQuery().
+			WithBuilder(runner.builder).
+			From(data.Grant).
+			Insert(db.NewExcludeMask("id"))
+		return err
+	}
+	if err != nil || mask == nil {
+		return err
+	}
+	_, err = db.NewQuery().
+		WithBuilder(runner.builder).
+		From(data.Grant).
+		Select(db.NewIncludeMask()).
+		Where(sq.Eq{"id": existing.Id}).
+		Update(mask)
+	return err
+}
+
+func (m *sasManager) UpdateGrantState(tx sq.BaseRunner, data *MutableGrant) error {
+	runner := m.getQueryRunner(tx)
+	stateId, err := runner.cache.getValue(runner.builder, &DBGrantState{}, data.GrantState.Name.String)
+	if err != nil {
+		return err
+	}
+	data.Grant.StateId = db.MakeInt(stateId)
+	_, err = db.NewQuery().
+		WithBuilder(runner.builder).
+		From(data.Grant).
+		Select(db.NewIncludeMask()).
+		Where(sq.Eq{"cbsd_id": data.Grant.CbsdId, "grant_id": data.Grant.GrantId}).
+		Update(db.NewIncludeMask("state_id"))
+	return err
+}
+
+func (r *queryRunner) getPendingRequests(requestType string, limit int64) ([]*DBRequest, error) {
+	typeId, err := r.cache.getValue(r.builder, &DBRequestType{}, requestType)
+	if err != nil {
+		return nil, err
+	}
+	res, err := db.NewQuery().
+		WithBuilder(r.builder).
+		From(&DBRequest{}).
+		Select(db.NewExcludeMask()).
+		Where(sq.Eq{"type_id": typeId}).
+		OrderBy("id", db.OrderAsc).
+		Limit(limit).
+		Lock(skipLocked(r.locker)).
+		List()
+	if err != nil {
+		return nil, err
+	}
+	requests := make([]*DBRequest, len(res))
+	for i, models := range res {
+		requests[i] = models[0].(*DBRequest)
+	}
+	return requests, nil
+}
+
+func (r *queryRunner) updateCbsdById(id int64, cbsd *DBCbsd, mask db.FieldMask) error {
+	_, err := db.NewQuery().
+		WithBuilder(r.builder).
+		From(cbsd).
+		Select(db.NewIncludeMask()).
+		Where(sq.Eq{"id": id}).
+		Update(mask)
+	return err
+}
+
+func (r *queryRunner) deleteCbsdGrants(id int64) error {
+	return db.NewQuery().
+		WithBuilder(r.builder).
+		From(&DBGrant{}).
+		Where(sq.Eq{"cbsd_id": id}).
+		Delete()
+}
+
+func (r *queryRunner) findGrant(grant *DBGrant) (*DBGrant, error) {
+	res, err := db.NewQuery().
+		WithBuilder(r.builder).
+		From(&DBGrant{}).
+		Select(db.NewIncludeMask("id")).
+		Where(sq.Eq{"cbsd_id": grant.CbsdId, "grant_id": grant.GrantId}).
+		Lock(r.locker.WithLock()).
+		Fetch()
+	if err != nil {
+		return nil, err
+	}
+	return res[0].(*DBGrant), nil
+}
+
+func skipLocked(locker sqorc.Locker) string {
+	lock := locker.WithLock()
+	if lock == "" {
+		return ""
+	}
+	return lock + " SKIP LOCKED"
+}
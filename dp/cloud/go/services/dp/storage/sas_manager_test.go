@@ -0,0 +1,340 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storage_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"magma/dp/cloud/go/services/dp/storage"
+	"magma/dp/cloud/go/services/dp/storage/db"
+	"magma/dp/cloud/go/services/dp/storage/dbtest"
+	"magma/orc8r/cloud/go/sqorc"
+)
+
+const (
+	grantRequest     = "grantRequest"
+	heartbeatRequest = "heartbeatRequest"
+	unsync           = "unsync"
+)
+
+func TestSasManager(t *testing.T) {
+	suite.Run(t, &SasManagerTestSuite{})
+}
+
+type SasManagerTestSuite struct {
+	suite.Suite
+	database        *sql.DB
+	sasManager      storage.SasManager
+	resourceManager dbtest.ResourceManager
+	enumMaps        map[string]map[string]int64
+}
+
+func (s *SasManagerTestSuite) SetupSuite() {
+	builder := sqorc.GetSqlBuilder()
+	database, err := sqorc.Open("sqlite3", ":memory:")
+	s.Require().NoError(err)
+	s.database = database
+	s.sasManager = storage.NewSasManager(database, builder, sqorc.SQLiteErrorChecker{}, sqorc.GetSqlLocker())
+
+	s.resourceManager = dbtest.NewResourceManager(s.T(), s.database, builder)
+	err = s.resourceManager.CreateTables(
+		&storage.DBCbsdState{},
+		&storage.DBCbsd{},
+		&storage.DBGrantState{},
+		&storage.DBGrant{},
+		&storage.DBRequest{},
+		&storage.DBRequestType{},
+	)
+	s.Require().NoError(err)
+	err = s.resourceManager.InsertResources(
+		db.NewExcludeMask("id"),
+		&storage.DBCbsdState{Name: db.MakeString(unregistered)},
+		&storage.DBCbsdState{Name: db.MakeString(registered)},
+		&storage.DBGrantState{Name: db.MakeString(idle)},
+		&storage.DBGrantState{Name: db.MakeString(granted)},
+		&storage.DBGrantState{Name: db.MakeString(authorized)},
+		&storage.DBGrantState{Name: db.MakeString(unsync)},
+		&storage.DBRequestType{Name: db.MakeString(grantRequest)},
+		&storage.DBRequestType{Name: db.MakeString(heartbeatRequest)},
+	)
+	s.Require().NoError(err)
+	s.enumMaps = map[string]map[string]int64{}
+	for _, model := range []db.Model{
+		&storage.DBCbsdState{},
+		&storage.DBGrantState{},
+		&storage.DBRequestType{},
+	} {
+		s.enumMaps[model.GetMetadata().Table] = s.getNameIdMapping(model)
+	}
+}
+
+func (s *SasManagerTestSuite) SetupTest() {
+	stateId := s.enumMaps[storage.CbsdStateTable][unregistered]
+	s.givenResourcesInserted(&storage.DBCbsd{
+		Id:                    db.MakeInt(someCbsdId),
+		NetworkId:             db.MakeString(someNetwork),
+		StateId:               db.MakeInt(stateId),
+		DesiredStateId:        db.MakeInt(stateId),
+		PreferredBandwidthMHz: db.MakeInt(20),
+		AvailableFrequencies:  []uint32{1, 2, 3},
+		Channels:              []storage.Channel{{LowFrequencyHz: 1, HighFrequencyHz: 2, MaxEirp: 3}},
+	})
+}
+
+func (s *SasManagerTestSuite) TearDownTest() {
+	err := s.resourceManager.DropResources(
+		&storage.DBCbsd{},
+		&storage.DBGrant{},
+		&storage.DBRequest{},
+	)
+	s.Require().NoError(err)
+}
+
+func (s *SasManagerTestSuite) TestGetPendingRequests() {
+	grantTypeId := s.enumMaps[storage.RequestTypeTable][grantRequest]
+	heartbeatTypeId := s.enumMaps[storage.RequestTypeTable][heartbeatRequest]
+	s.givenResourcesInserted(
+		s.newRequest(1, grantTypeId),
+		s.newRequest(2, heartbeatTypeId),
+		s.newRequest(3, grantTypeId),
+		s.newRequest(4, grantTypeId),
+	)
+
+	actual, err := storage.WithinTx(s.database, func(tx *sql.Tx) ([]*storage.DBRequest, error) {
+		return s.sasManager.GetPendingRequests(tx, grantRequest, 2)
+	})
+	s.Require().NoError(err)
+
+	expected := []*storage.DBRequest{
+		s.newRequest(1, grantTypeId),
+		s.newRequest(3, grantTypeId),
+	}
+	s.Assert().Equal(expected, actual)
+}
+
+func (s *SasManagerTestSuite) TestDeleteRequest() {
+	typeId := s.enumMaps[storage.RequestTypeTable][grantRequest]
+	s.givenResourcesInserted(s.newRequest(1, typeId), s.newRequest(2, typeId))
+
+	_, err := storage.WithinTx(s.database, func(tx *sql.Tx) (any, error) {
+		return nil, s.sasManager.DeleteRequest(tx, &storage.DBRequest{Id: db.MakeInt(1)})
+	})
+	s.Require().NoError(err)
+
+	actual := s.getAll(&storage.DBRequest{}, db.NewIncludeMask("id"))
+	s.Assert().Equal([]db.Model{&storage.DBRequest{Id: db.MakeInt(2)}}, actual)
+}
+
+func (s *SasManagerTestSuite) TestLockRequests() {
+	typeId := s.enumMaps[storage.RequestTypeTable][grantRequest]
+	s.givenResourcesInserted(s.newRequest(1, typeId), s.newRequest(2, typeId))
+
+	actual, err := storage.WithinTx(s.database, func(tx *sql.Tx) (map[int64]bool, error) {
+		return s.sasManager.LockRequests(tx, []int64{1, 3})
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(map[int64]bool{1: true}, actual)
+}
+
+func (s *SasManagerTestSuite) TestGetCbsds() {
+	actual, err := storage.WithinTx(s.database, func(tx *sql.Tx) (map[int64]*storage.DBCbsd, error) {
+		return s.sasManager.GetCbsds(tx, []int64{someCbsdId, someCbsdId + 1})
+	})
+	s.Require().NoError(err)
+
+	expected := map[int64]*storage.DBCbsd{someCbsdId: {
+		Id:        db.MakeInt(someCbsdId),
+		NetworkId: db.MakeString(someNetwork),
+	}}
+	s.Assert().Equal(expected, actual)
+}
+
+func (s *SasManagerTestSuite) TestRegisterCbsd() {
+	_, err := storage.WithinTx(s.database, func(tx *sql.Tx) (any, error) {
+		return nil, s.sasManager.RegisterCbsd(tx, someCbsdId, someCbsdIdStr)
+	})
+	s.Require().NoError(err)
+
+	actual := s.getAll(&storage.DBCbsd{}, db.NewIncludeMask("state_id", "cbsd_id"))
+	expected := []db.Model{&storage.DBCbsd{
+		StateId: db.MakeInt(s.enumMaps[storage.CbsdStateTable][registered]),
+		CbsdId:  db.MakeString(someCbsdIdStr),
+	}}
+	s.Assert().Equal(expected, actual)
+}
+
+func (s *SasManagerTestSuite) TestUnregisterCbsd() {
+	s.givenResourcesInserted(s.newGrant("some_grant", granted))
+
+	_, err := storage.WithinTx(s.database, func(tx *sql.Tx) (any, error) {
+		return nil, s.sasManager.UnregisterCbsd(tx, someCbsdId)
+	})
+	s.Require().NoError(err)
+
+	actual := s.getAll(&storage.DBCbsd{}, db.NewIncludeMask("state_id", "channels"))
+	expected := []db.Model{&storage.DBCbsd{
+		StateId:  db.MakeInt(s.enumMaps[storage.CbsdStateTable][unregistered]),
+		Channels: []storage.Channel{},
+	}}
+	s.Assert().Equal(expected, actual)
+	s.Assert().Empty(s.getAll(&storage.DBGrant{}, db.NewIncludeMask("id")))
+}
+
+func (s *SasManagerTestSuite) TestSetChannels() {
+	s.givenResourcesInserted(s.newGrant("some_grant", granted))
+	channels := []storage.Channel{{
+		LowFrequencyHz:  3550e6,
+		HighFrequencyHz: 3570e6,
+		MaxEirp:         37,
+	}}
+
+	_, err := storage.WithinTx(s.database, func(tx *sql.Tx) (any, error) {
+		return nil, s.sasManager.SetChannels(tx, someCbsdId, channels)
+	})
+	s.Require().NoError(err)
+
+	actual := s.getAll(&storage.DBCbsd{}, db.NewIncludeMask("channels", "available_frequencies"))
+	expected := []db.Model{&storage.DBCbsd{Channels: channels}}
+	s.Assert().Equal(expected, actual)
+	s.Assert().Empty(s.getAll(&storage.DBGrant{}, db.NewIncludeMask("id")))
+}
+
+func (s *SasManagerTestSuite) TestUpsertGrant() {
+	s.givenResourcesInserted(s.newGrant("existing_grant", idle))
+	expireTime := time.Unix(1e9, 0).UTC()
+
+	_, err := storage.WithinTx(s.database, func(tx *sql.Tx) (any, error) {
+		existing := s.newMutableGrant("existing_grant", authorized)
+		existing.Grant.GrantExpireTime = db.MakeTime(expireTime)
+		mask := db.NewIncludeMask("state_id", "grant_expire_time")
+		if err := s.sasManager.UpsertGrant(tx, existing, mask); err != nil {
+			return nil, err
+		}
+		return nil, s.sasManager.UpsertGrant(tx, s.newMutableGrant("new_grant", granted), mask)
+	})
+	s.Require().NoError(err)
+
+	actual := s.getAll(&storage.DBGrant{}, db.NewIncludeMask("state_id", "grant_id", "grant_expire_time", "low_frequency"))
+	expected := []db.Model{&storage.DBGrant{
+		StateId:         db.MakeInt(s.enumMaps[storage.GrantStateTable][authorized]),
+		GrantId:         db.MakeString("existing_grant"),
+		GrantExpireTime: db.MakeTime(expireTime),
+		LowFrequencyHz:  db.MakeInt(3550e6),
+	}, &storage.DBGrant{
+		StateId:        db.MakeInt(s.enumMaps[storage.GrantStateTable][granted]),
+		GrantId:        db.MakeString("new_grant"),
+		LowFrequencyHz: db.MakeInt(3550e6),
+	}}
+	s.Assert().Equal(expected, actual)
+}
+
+func (s *SasManagerTestSuite) TestUpsertGrantWithoutMaskDoesNotModifyExistingGrant() {
+	s.givenResourcesInserted(s.newGrant("existing_grant", authorized))
+
+	_, err := storage.WithinTx(s.database, func(tx *sql.Tx) (any, error) {
+		return nil, s.sasManager.UpsertGrant(tx, s.newMutableGrant("existing_grant", unsync), nil)
+	})
+	s.Require().NoError(err)
+
+	actual := s.getAll(&storage.DBGrant{}, db.NewIncludeMask("state_id"))
+	expected := []db.Model{&storage.DBGrant{
+		StateId: db.MakeInt(s.enumMaps[storage.GrantStateTable][authorized]),
+	}}
+	s.Assert().Equal(expected, actual)
+}
+
+func (s *SasManagerTestSuite) TestUpdateGrantState() {
+	s.givenResourcesInserted(s.newGrant("existing_grant", authorized))
+
+	_, err := storage.WithinTx(s.database, func(tx *sql.Tx) (any, error) {
+		if err := s.sasManager.UpdateGrantState(tx, s.newMutableGrant("existing_grant", idle)); err != nil {
+			return nil, err
+		}
+		return nil, s.sasManager.UpdateGrantState(tx, s.newMutableGrant("missing_grant", idle))
+	})
+	s.Require().NoError(err)
+
+	actual := s.getAll(&storage.DBGrant{}, db.NewIncludeMask("state_id", "grant_id"))
+	expected := []db.Model{&storage.DBGrant{
+		StateId: db.MakeInt(s.enumMaps[storage.GrantStateTable][idle]),
+		GrantId: db.MakeString("existing_grant"),
+	}}
+	s.Assert().Equal(expected, actual)
+}
+
+func (s *SasManagerTestSuite) newRequest(id int64, typeId int64) *storage.DBRequest {
+	return &storage.DBRequest{
+		Id:      db.MakeInt(id),
+		TypeId:  db.MakeInt(typeId),
+		CbsdId:  db.MakeInt(someCbsdId),
+		Payload: map[string]any{"cbsdId": someCbsdIdStr},
+	}
+}
+
+func (s *SasManagerTestSuite) newGrant(grantId string, state string) *storage.DBGrant {
+	return &storage.DBGrant{
+		StateId:         db.MakeInt(s.enumMaps[storage.GrantStateTable][state]),
+		CbsdId:          db.MakeInt(someCbsdId),
+		GrantId:         db.MakeString(grantId),
+		LowFrequencyHz:  db.MakeInt(3550e6),
+		HighFrequencyHz: db.MakeInt(3570e6),
+		MaxEirp:         db.MakeFloat(20),
+	}
+}
+
+func (s *SasManagerTestSuite) newMutableGrant(grantId string, state string) *storage.MutableGrant {
+	grant := s.newGrant(grantId, state)
+	grant.StateId = sql.NullInt64{}
+	return &storage.MutableGrant{
+		Grant:      grant,
+		GrantState: &storage.DBGrantState{Name: db.MakeString(state)},
+	}
+}
+
+func (s *SasManagerTestSuite) givenResourcesInserted(models ...db.Model) {
+	err := s.resourceManager.InsertResources(db.NewExcludeMask(), models...)
+	s.Require().NoError(err)
+}
+
+func (s *SasManagerTestSuite) getAll(model db.Model, mask db.FieldMask) []db.Model {
+	var models []db.Model
+	err := s.resourceManager.InTransaction(func() {
+		res, err := db.NewQuery().
+			WithBuilder(s.resourceManager.GetBuilder()).
+			From(model).
+			Select(mask).
+			OrderBy("id", db.OrderAsc).
+			List()
+		s.Require().NoError(err)
+		for _, r := range res {
+			models = append(models, r[0])
+		}
+	})
+	s.Require().NoError(err)
+	return models
+}
+
+func (s *SasManagerTestSuite) getNameIdMapping(model db.Model) map[string]int64 {
+	m := map[string]int64{}
+	for _, r := range s.getAll(model, db.NewExcludeMask()) {
+		enum := r.(storage.EnumModel)
+		m[enum.GetName()] = enum.GetId()
+	}
+	return m
+}
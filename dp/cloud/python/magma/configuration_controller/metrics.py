@@ -11,7 +11,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 """
 
-from prometheus_client import Histogram
+from prometheus_client import Counter, Histogram
 
 # Metrics for current configuration controller status
 SAS_REQUEST_PROCESSING_TIME = Histogram(
@@ -28,3 +28,23 @@ PENDING_REQUESTS_FETCH_TIME = Histogram(
     'dp_cc_pending_requests_fetching_seconds',
     'Time spent fetching pending requests from the database',
 )
+
+# Same SAS metrics as exported by Go configuration controller (sas_client)
+SAS_RESPONSES = Counter(
+    'dp_sas_responses_total',
+    'Number of SAS responses by request type and response code',
+    ['request_type', 'response_code'],
+)
+
+SAS_REQUEST_ERRORS = Counter(
+    'dp_sas_request_errors_total',
+    'Number of SAS requests which failed without a response',
+    ['request_type'],
+)
+
+SAS_REQUEST_DURATION = Histogram(
+    'dp_sas_request_duration_seconds',
+    'Latency of SAS requests (including heartbeats) by request type',
+    ['request_type'],
+    buckets=(.05, .1, .25, .5, 1, 2.5, 5, 10),
+)
@@ -17,7 +17,11 @@ import requests
 from magma.configuration_controller.crl_validator.crl_validator import (
     CRLValidator,
 )
-from magma.configuration_controller.metrics import SAS_REQUEST_PROCESSING_TIME
+from magma.configuration_controller.metrics import (
+    SAS_REQUEST_DURATION,
+    SAS_REQUEST_ERRORS,
+    SAS_REQUEST_PROCESSING_TIME,
+)
 from magma.configuration_controller.request_router.exceptions import (
     RequestRouterError,
 )
@@ -81,13 +85,15 @@ class RequestRouter(object):
             if self.crl_validator:
                 self.crl_validator.is_valid(url=self.sas_url)
 
-            sas_response = requests.post(
-                f'{self.sas_url}/{sas_method}',
-                json=request_dict,
-                cert=(self.cert_path, self.ssl_key_path),
-                verify=self.ssl_verify,
-            )
+            with SAS_REQUEST_DURATION.labels(request_name).time():
+                sas_response = requests.post(
+                    f'{self.sas_url}/{sas_method}',
+                    json=request_dict,
+                    cert=(self.cert_path, self.ssl_key_path),
+                    verify=self.ssl_verify,
+                )
         except Exception as e:
+            SAS_REQUEST_ERRORS.labels(request_name).inc()
             raise RequestRouterError(str(e))
 
         return sas_response
@@ -17,7 +17,10 @@ from typing import Callable, List
 import requests
 from magma.configuration_controller.config import get_config
 from magma.configuration_controller.custom_types.custom_types import DBResponse
-from magma.configuration_controller.metrics import SAS_RESPONSE_PROCESSING_TIME
+from magma.configuration_controller.metrics import (
+    SAS_RESPONSE_PROCESSING_TIME,
+    SAS_RESPONSES,
+)
 from magma.db_service.models import DBGrantState, DBRequest
 from magma.db_service.session_manager import Session
 from magma.fluentd_client.client import FluentdClient, FluentdClientException
@@ -102,6 +105,7 @@ class ResponseDBProcessor(object):
             logger.info(
                 f"[{self.response_type}] Adding Response: {db_response} for Request {db_request}",
             )
+            SAS_RESPONSES.labels(db_request.type.name, str(db_response.response_code)).inc()
             self._log_response(response_type, db_response)
             logger.debug(
                 f'[{self.response_type}] About to process Response: {db_response}',
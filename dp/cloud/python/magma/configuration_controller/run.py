@@ -42,7 +42,11 @@ from magma.configuration_controller.response_processor.strategies.strategies_map
     processor_strategies,
 )
 from magma.db_service.session_manager import SessionManager
-from magma.fluentd_client.client import FluentdClient, FluentdClientException
+from magma.fluentd_client.client import (
+    FluentdClient,
+    FluentdClientException,
+    get_dp_log_client,
+)
 from magma.fluentd_client.dp_logs import make_dp_log
 from magma.mappings.request_mapping import request_mapping
 from magma.mappings.request_response_mapping import request_response
@@ -93,7 +97,7 @@ def run():
         ssl_verify=config.SAS_CERT_PATH,
         crl_validator=ssl_validator,
     )
-    fluentd_client = FluentdClient()
+    fluentd_client = get_dp_log_client(session_manager)
     metricsd_client = get_metricsd_client()
 
     for request_type in RequestTypes:
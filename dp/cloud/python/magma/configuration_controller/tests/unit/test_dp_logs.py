@@ -11,17 +11,22 @@ See the License for the specific language governing permissions and
 limitations under the License.
 """
 import datetime
+from unittest import mock
 
 from freezegun import freeze_time
 from magma.configuration_controller.custom_types.custom_types import DBResponse
 from magma.db_service.models import (
     DBCbsd,
     DBCbsdState,
+    DBLog,
+    DBLogBackend,
     DBRequest,
     DBRequestType,
 )
+from magma.db_service.session_manager import SessionManager
 from magma.db_service.tests.local_db_test_case import LocalDBTestCase
-from magma.fluentd_client.client import DPLog
+from magma.fluentd_client.client import DBLogClient, DPLog, DPLogClient
+from magma.fluentd_client.config import TestConfig
 from magma.fluentd_client.dp_logs import make_dp_log, now
 from parameterized import parameterized
 
@@ -124,3 +129,76 @@ class DPLogsTestCase(LocalDBTestCase):
 
     def test_datetime_now(self):
         self.assertEqual(SOME_TIMESTAMP, now())
+
+
+class DBLogClientTestCase(LocalDBTestCase):
+
+    def test_dp_log_is_stored_in_database(self):
+        # Given
+        client = DBLogClient(SessionManager(db_engine=self.engine))
+        log = DPLog(
+            event_timestamp=SOME_TIMESTAMP,
+            cbsd_serial_number=SOME_SERIAL_NUMBER,
+            fcc_id=SOME_FCC_ID,
+            log_from=SAS,
+            log_message=SOME_MESSAGE,
+            log_name='heartbeatResponse',
+            log_to=DP,
+            network_id=SOME_NETWORK_ID,
+            response_code="501",
+        )
+
+        # When
+        client.send_dp_log(log)
+
+        # Then
+        actual = self.session.query(DBLog).one()
+        self.assertEqual(SOME_NETWORK_ID, actual.network_id)
+        self.assertEqual(SAS, actual.log_from)
+        self.assertEqual(DP, actual.log_to)
+        self.assertEqual('heartbeatResponse', actual.log_name)
+        self.assertEqual(SOME_MESSAGE, actual.log_message)
+        self.assertEqual(SOME_SERIAL_NUMBER, actual.cbsd_serial_number)
+        self.assertEqual(SOME_FCC_ID, actual.fcc_id)
+        self.assertEqual(501, actual.response_code)
+        self.assertEqual(SOME_TIMESTAMP, int(actual.event_time.timestamp()))
+
+
+class DPLogClientTestCase(LocalDBTestCase):
+
+    def setUp(self):
+        super().setUp()
+        config = TestConfig()
+        config.DP_LOG_BACKEND_REFRESH_INTERVAL = 0
+        self.client = DPLogClient(SessionManager(db_engine=self.engine), config)
+        self.client.fluentd_client = mock.MagicMock()
+        self.log = DPLog(
+            event_timestamp=SOME_TIMESTAMP,
+            cbsd_serial_number=SOME_SERIAL_NUMBER,
+            fcc_id=SOME_FCC_ID,
+            log_from=DP,
+            log_message=SOME_MESSAGE,
+            log_name=HEARTBEAT_REQUEST,
+            log_to=SAS,
+            network_id=SOME_NETWORK_ID,
+        )
+
+    def test_dp_log_is_sent_to_fluentd_by_default(self):
+        # When
+        self.client.send_dp_log(self.log)
+
+        # Then
+        self.client.fluentd_client.send_dp_log.assert_called_once_with(self.log)
+        self.assertEqual(0, self.session.query(DBLog).count())
+
+    def test_dp_log_follows_backend_of_dp_service(self):
+        # Given
+        self.session.add(DBLogBackend(id=1, backend='database'))
+        self.session.commit()
+
+        # When
+        self.client.send_dp_log(self.log)
+
+        # Then
+        self.client.fluentd_client.send_dp_log.assert_not_called()
+        self.assertEqual(1, self.session.query(DBLog).count())
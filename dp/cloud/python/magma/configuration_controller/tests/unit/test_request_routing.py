@@ -13,6 +13,7 @@ limitations under the License.
 import os
 from unittest import TestCase
 
+import requests
 import requests_mock
 from magma.configuration_controller.request_router.exceptions import (
     RequestRouterError,
@@ -21,6 +22,7 @@ from magma.configuration_controller.request_router.request_router import (
     RequestRouter,
 )
 from magma.mappings.request_mapping import request_mapping
+from prometheus_client import REGISTRY
 
 
 @requests_mock.Mocker()
@@ -81,6 +83,38 @@ class RequestRouterTestCase(TestCase):
                 request_dict={"nonExistingSasMethod": [{}]},
             )
 
+    def test_sas_request_duration_is_recorded(self, mocker):
+        # Given
+        self._register_test_post_endpoints(
+            mocker, f'{self.sas_url}/registration',
+        )
+        before = _get_sample_value('dp_sas_request_duration_seconds_count')
+
+        # When
+        self.router.post_to_sas(
+            request_dict={"registrationRequest": [{"foo": "bar"}]},
+        )
+
+        # Then
+        self.assertEqual(before + 1, _get_sample_value('dp_sas_request_duration_seconds_count'))
+
+    def test_sas_request_error_is_recorded(self, mocker):
+        # Given
+        mocker.register_uri(
+            'POST', f'{self.sas_url}/registration',
+            exc=requests.exceptions.ConnectTimeout,
+        )
+        before = _get_sample_value('dp_sas_request_errors_total')
+
+        # When
+        with self.assertRaises(RequestRouterError):
+            self.router.post_to_sas(
+                request_dict={"registrationRequest": [{"foo": "bar"}]},
+            )
+
+        # Then
+        self.assertEqual(before + 1, _get_sample_value('dp_sas_request_errors_total'))
+
     def _register_test_post_endpoints(self, mocker, url):
         mocker.register_uri('POST', url, json=self._response_callback)
 
@@ -92,3 +126,8 @@ class RequestRouterTestCase(TestCase):
     def _response_callback(request, context):
         context.status_code = 200
         return request.text
+
+
+def _get_sample_value(name):
+    value = REGISTRY.get_sample_value(name, {'request_type': 'registrationRequest'})
+    return value or 0
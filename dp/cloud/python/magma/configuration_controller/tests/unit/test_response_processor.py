@@ -60,6 +60,7 @@ from magma.mappings.types import (
     ResponseCodes,
 )
 from parameterized import parameterized
+from prometheus_client import REGISTRY
 
 CBSD_SERIAL_NR = "cbsdSerialNumber"
 FCC_ID = "fccId"
@@ -108,6 +109,8 @@ class DefaultResponseDBProcessorTestCase(LocalDBTestCase):
             request_type_name, requests_fixtures,
         )
         response = self._prepare_response_from_db_requests(db_requests=db_requests)
+        labels = {'request_type': request_type_name, 'response_code': str(ResponseCodes.SUCCESS.value)}
+        responses_before = REGISTRY.get_sample_value('dp_sas_responses_total', labels) or 0
 
         # When
         self._process_response(
@@ -116,6 +119,10 @@ class DefaultResponseDBProcessorTestCase(LocalDBTestCase):
 
         # Then
         self._verify_processed_requests_were_deleted()
+        self.assertEqual(
+            responses_before + len(db_requests),
+            REGISTRY.get_sample_value('dp_sas_responses_total', labels),
+        )
         self.assertEqual(
             1, self.session.query(DBRequestType).filter(
                 DBRequestType.name == request_type_name,
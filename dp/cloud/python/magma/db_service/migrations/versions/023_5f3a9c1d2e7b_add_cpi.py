@@ -0,0 +1,49 @@
+"""add_cpi
+
+Revision ID: 5f3a9c1d2e7b
+Revises: cbcd01d5edce
+Create Date: 2022-09-27 10:42:18.551203
+
+"""
+import sqlalchemy as sa
+from alembic import op
+
+# revision identifiers, used by Alembic.
+revision = '5f3a9c1d2e7b'
+down_revision = 'cbcd01d5edce'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    """
+    Run upgrade
+    """
+    op.create_table(
+        'cpis',
+        sa.Column('id', sa.Integer(), autoincrement=True, nullable=False),
+        sa.Column('network_id', sa.String(), nullable=False),
+        sa.Column('cpi_id', sa.String(), nullable=False),
+        sa.Column('cpi_name', sa.String(), nullable=False),
+        sa.Column('private_key', sa.Text(), nullable=False),
+        sa.Column(
+            'created_date', sa.DateTime(timezone=True), server_default=sa.text(
+                'statement_timestamp()',
+            ), nullable=False,
+        ),
+        sa.PrimaryKeyConstraint('id'),
+        sa.UniqueConstraint('network_id', 'cpi_id'),
+    )
+    op.add_column('cbsds', sa.Column('cpi_id', sa.String(), nullable=True))
+    op.add_column('cbsds', sa.Column('install_certification_time', sa.DateTime(timezone=True), nullable=True))
+    op.add_column('cbsds', sa.Column('cpi_signature_data', sa.JSON(), nullable=True))
+
+
+def downgrade():
+    """
+    Run downgrade
+    """
+    op.drop_column('cbsds', 'cpi_signature_data')
+    op.drop_column('cbsds', 'install_certification_time')
+    op.drop_column('cbsds', 'cpi_id')
+    op.drop_table('cpis')
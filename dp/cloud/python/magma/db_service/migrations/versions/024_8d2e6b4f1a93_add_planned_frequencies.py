@@ -0,0 +1,29 @@
+"""add_planned_frequencies
+
+Revision ID: 8d2e6b4f1a93
+Revises: 5f3a9c1d2e7b
+Create Date: 2022-10-04 09:12:40.318027
+
+"""
+import sqlalchemy as sa
+from alembic import op
+
+# revision identifiers, used by Alembic.
+revision = '8d2e6b4f1a93'
+down_revision = '5f3a9c1d2e7b'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    """
+    Run upgrade
+    """
+    op.add_column('cbsds', sa.Column('planned_frequencies_mhz', sa.JSON(), nullable=True))
+
+
+def downgrade():
+    """
+    Run downgrade
+    """
+    op.drop_column('cbsds', 'planned_frequencies_mhz')
@@ -0,0 +1,35 @@
+"""add_carrier_aggregation_params
+
+Revision ID: 3b7f1e9c4a52
+Revises: 8d2e6b4f1a93
+Create Date: 2022-10-11 14:37:02.581944
+
+"""
+import sqlalchemy as sa
+from alembic import op
+
+# revision identifiers, used by Alembic.
+revision = '3b7f1e9c4a52'
+down_revision = '8d2e6b4f1a93'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    """
+    Run upgrade
+    """
+    op.add_column('cbsds', sa.Column('max_carriers', sa.Integer(), nullable=True))
+    op.add_column('cbsds', sa.Column('min_total_bandwidth_mhz', sa.Integer(), nullable=True))
+    op.add_column('cbsds', sa.Column('max_total_bandwidth_mhz', sa.Integer(), nullable=True))
+    op.add_column('cbsds', sa.Column('contiguous_carriers', sa.Boolean(), nullable=True))
+
+
+def downgrade():
+    """
+    Run downgrade
+    """
+    op.drop_column('cbsds', 'contiguous_carriers')
+    op.drop_column('cbsds', 'max_total_bandwidth_mhz')
+    op.drop_column('cbsds', 'min_total_bandwidth_mhz')
+    op.drop_column('cbsds', 'max_carriers')
@@ -0,0 +1,46 @@
+"""add_dp_logs
+
+Revision ID: c41d8e2a7f05
+Revises: 3b7f1e9c4a52
+Create Date: 2022-10-14 11:05:27.093418
+
+"""
+import sqlalchemy as sa
+from alembic import op
+
+# revision identifiers, used by Alembic.
+revision = 'c41d8e2a7f05'
+down_revision = '3b7f1e9c4a52'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    """
+    Run upgrade
+    """
+    op.create_table(
+        'dp_logs',
+        sa.Column('id', sa.BigInteger(), autoincrement=True, nullable=False),
+        sa.Column('network_id', sa.String(), nullable=False),
+        sa.Column('log_from', sa.String(), nullable=False),
+        sa.Column('log_to', sa.String(), nullable=False),
+        sa.Column('log_name', sa.String(), nullable=False),
+        sa.Column('log_message', sa.Text(), nullable=False),
+        sa.Column('cbsd_serial_number', sa.String(), nullable=True),
+        sa.Column('fcc_id', sa.String(), nullable=True),
+        sa.Column('response_code', sa.Integer(), nullable=True),
+        sa.Column('event_time', sa.DateTime(timezone=True), nullable=False),
+        sa.PrimaryKeyConstraint('id'),
+    )
+    op.create_index('ix_dp_logs_event_time', 'dp_logs', ['event_time'])
+    op.create_index('ix_dp_logs_network_id_event_time', 'dp_logs', ['network_id', 'event_time'])
+
+
+def downgrade():
+    """
+    Run downgrade
+    """
+    op.drop_index('ix_dp_logs_network_id_event_time', table_name='dp_logs')
+    op.drop_index('ix_dp_logs_event_time', table_name='dp_logs')
+    op.drop_table('dp_logs')
@@ -0,0 +1,34 @@
+"""add_dp_log_backend
+
+Revision ID: 7a4e2c9b1f36
+Revises: c41d8e2a7f05
+Create Date: 2022-10-21 09:12:44.518203
+
+"""
+import sqlalchemy as sa
+from alembic import op
+
+# revision identifiers, used by Alembic.
+revision = '7a4e2c9b1f36'
+down_revision = 'c41d8e2a7f05'
+branch_labels = None
+depends_on = None
+
+
+def upgrade():
+    """
+    Run upgrade
+    """
+    op.create_table(
+        'dp_log_backend',
+        sa.Column('id', sa.Integer(), nullable=False),
+        sa.Column('backend', sa.String(), nullable=False),
+        sa.PrimaryKeyConstraint('id'),
+    )
+
+
+def downgrade():
+    """
+    Run downgrade
+    """
+    op.drop_table('dp_log_backend')
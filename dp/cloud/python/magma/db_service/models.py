@@ -19,8 +19,11 @@ from sqlalchemy import (
     DateTime,
     Float,
     ForeignKey,
+    Index,
     Integer,
     String,
+    Text,
+    UniqueConstraint,
 )
 from sqlalchemy import text as sa_text
 from sqlalchemy.ext.declarative import declarative_base
@@ -222,6 +225,14 @@ class DBCbsd(Base):
     max_ibw_mhz = Column(Integer, nullable=False, server_default='150')
     grant_redundancy = Column(Boolean, nullable=False, server_default='true')
     available_frequencies = Column(JSON)
+    cpi_id = Column(String)
+    install_certification_time = Column(DateTime(timezone=True))
+    cpi_signature_data = Column(JSON)
+    planned_frequencies_mhz = Column(JSON)
+    max_carriers = Column(Integer)
+    min_total_bandwidth_mhz = Column(Integer)
+    max_total_bandwidth_mhz = Column(Integer)
+    contiguous_carriers = Column(Boolean)
     created_date = Column(
         DateTime(timezone=True),
         nullable=False, server_default=now(),
@@ -250,3 +261,76 @@ class DBCbsd(Base):
                f"cbsd_serial_number='{self.cbsd_serial_number}', " \
                f"created_date='{self.created_date}' " \
                f"updated_date='{self.updated_date}')>"
+
+
+class DBCpi(Base):
+    """
+    Certified Professional Installer class
+    """
+    __tablename__ = "cpis"
+    __table_args__ = (UniqueConstraint("network_id", "cpi_id"),)
+    id = Column(Integer, primary_key=True, autoincrement=True)
+    network_id = Column(String, nullable=False)
+    cpi_id = Column(String, nullable=False)
+    cpi_name = Column(String, nullable=False)
+    private_key = Column(Text, nullable=False)
+    created_date = Column(
+        DateTime(timezone=True),
+        nullable=False, server_default=now(),
+    )
+
+    def __repr__(self):
+        """
+        Return string representation of DB object
+        """
+        class_name = self.__class__.__name__
+        return f"<{class_name}(id='{self.id}', " \
+               f"network_id='{self.network_id}', " \
+               f"cpi_id='{self.cpi_id}', " \
+               f"cpi_name='{self.cpi_name}')>"
+
+
+class DBLog(Base):
+    """
+    Domain Proxy transaction log class (messages exchanged with SAS and CBSDs)
+    """
+    __tablename__ = "dp_logs"
+    __table_args__ = (Index("ix_dp_logs_network_id_event_time", "network_id", "event_time"),)
+    id = Column(BigInteger, primary_key=True, autoincrement=True)
+    network_id = Column(String, nullable=False)
+    log_from = Column(String, nullable=False)
+    log_to = Column(String, nullable=False)
+    log_name = Column(String, nullable=False)
+    log_message = Column(Text, nullable=False)
+    cbsd_serial_number = Column(String)
+    fcc_id = Column(String)
+    response_code = Column(Integer)
+    event_time = Column(DateTime(timezone=True), nullable=False, index=True)
+
+    def __repr__(self):
+        """
+        Return string representation of DB object
+        """
+        class_name = self.__class__.__name__
+        return f"<{class_name}(id='{self.id}', " \
+               f"network_id='{self.network_id}', " \
+               f"log_name='{self.log_name}', " \
+               f"cbsd_serial_number='{self.cbsd_serial_number}', " \
+               f"event_time='{self.event_time}')>"
+
+
+class DBLogBackend(Base):
+    """
+    Log store backend of dp service, which DP logs of other components follow
+    """
+    __tablename__ = "dp_log_backend"
+    id = Column(Integer, primary_key=True)
+    backend = Column(String, nullable=False)
+
+    def __repr__(self):
+        """
+        Return string representation of DB object
+        """
+        class_name = self.__class__.__name__
+        return f"<{class_name}(id='{self.id}', " \
+               f"backend='{self.backend}')>"
@@ -0,0 +1,42 @@
+"""
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+"""
+from magma.db_service.tests.alembic_testcase import AlembicTestCase
+from sqlalchemy.exc import NoSuchTableError
+
+CBSDS_TABLE = 'cbsds'
+CPIS_TABLE = 'cpis'
+COLUMNS = ['cpi_id', 'install_certification_time', 'cpi_signature_data']
+
+
+class TestAddCpi(AlembicTestCase):
+    down_revision = 'cbcd01d5edce'
+    up_revision = '5f3a9c1d2e7b'
+
+    def setUp(self) -> None:
+        super().setUp()
+        self.upgrade(self.down_revision)
+
+    def test_upgrade(self):
+        self.upgrade()
+        cbsds = self.get_table(CBSDS_TABLE)
+        self.assertTrue(self.has_columns(cbsds, COLUMNS))
+        cpis = self.get_table(CPIS_TABLE)
+        self.assertTrue(self.has_columns(cpis, ['network_id', 'cpi_id', 'cpi_name', 'private_key']))
+
+    def test_downgrade(self):
+        self.upgrade()
+        self.downgrade()
+        cbsds = self.get_table(CBSDS_TABLE)
+        self.assertFalse(any(self.has_column(cbsds, c) for c in COLUMNS))
+        with self.assertRaises(NoSuchTableError):
+            self.get_table(CPIS_TABLE)
@@ -0,0 +1,43 @@
+"""
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+"""
+from magma.db_service.tests.alembic_testcase import AlembicTestCase
+
+CBSDS_TABLE = 'cbsds'
+COLUMNS = [
+    'max_carriers',
+    'min_total_bandwidth_mhz',
+    'max_total_bandwidth_mhz',
+    'contiguous_carriers',
+]
+
+
+class TestAddCarrierAggregationParams(AlembicTestCase):
+    down_revision = '8d2e6b4f1a93'
+    up_revision = '3b7f1e9c4a52'
+
+    def setUp(self) -> None:
+        super().setUp()
+        self.upgrade(self.down_revision)
+
+    def test_upgrade(self):
+        self.upgrade()
+        cbsds = self.get_table(CBSDS_TABLE)
+        for column in COLUMNS:
+            self.assertTrue(self.has_column(cbsds, column))
+
+    def test_downgrade(self):
+        self.upgrade()
+        self.downgrade()
+        cbsds = self.get_table(CBSDS_TABLE)
+        for column in COLUMNS:
+            self.assertFalse(self.has_column(cbsds, column))
@@ -0,0 +1,40 @@
+"""
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+"""
+from magma.db_service.tests.alembic_testcase import AlembicTestCase
+from sqlalchemy.exc import NoSuchTableError
+
+DP_LOGS_TABLE = 'dp_logs'
+COLUMNS = [
+    'network_id', 'log_from', 'log_to', 'log_name', 'log_message',
+    'cbsd_serial_number', 'fcc_id', 'response_code', 'event_time',
+]
+
+
+class TestAddDPLogs(AlembicTestCase):
+    down_revision = '3b7f1e9c4a52'
+    up_revision = 'c41d8e2a7f05'
+
+    def setUp(self) -> None:
+        super().setUp()
+        self.upgrade(self.down_revision)
+
+    def test_upgrade(self):
+        self.upgrade()
+        dp_logs = self.get_table(DP_LOGS_TABLE)
+        self.assertTrue(self.has_columns(dp_logs, COLUMNS))
+
+    def test_downgrade(self):
+        self.upgrade()
+        self.downgrade()
+        with self.assertRaises(NoSuchTableError):
+            self.get_table(DP_LOGS_TABLE)
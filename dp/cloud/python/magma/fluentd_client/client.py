@@ -14,11 +14,16 @@ limitations under the License.
 from __future__ import annotations
 
 import logging
+import time
 from dataclasses import asdict, dataclass
+from datetime import datetime, timezone
 from typing import Optional
 
 import requests
+from magma.db_service.models import DBLog, DBLogBackend
+from magma.db_service.session_manager import SessionManager
 from magma.fluentd_client.config import Config, get_config
+from sqlalchemy.exc import SQLAlchemyError
 
 logging.basicConfig(
     level=logging.DEBUG,
@@ -27,6 +32,9 @@ logging.basicConfig(
 )
 logger = logging.getLogger("fluentd_client.client")
 
+DB_LOG_BACKEND = 'database'
+DEFAULT_LOG_BACKEND = 'elasticsearch'
+
 
 class FluentdClientException(Exception):
     """Generic Fluentd Client Exception"""
@@ -80,3 +88,110 @@ class FluentdClient(object):
             logging.error(msg)
             raise FluentdClientException(msg)
         logger.debug(f"Sent {log.log_name=} to Fluentd. Response code = {resp.status_code}")
+
+
+class DBLogClient(object):
+    """
+    Client class storing DP logs in Domain Proxy database,
+    so that they are available without Elasticsearch.
+    """
+
+    def __init__(self, session_manager: SessionManager):
+        self.session_manager = session_manager
+
+    def send_dp_log(self, log: DPLog):
+        """
+        Store DP log in database
+
+        Args:
+            log (DPLog): DP Log
+
+        Raises:
+            FluentdClientException: Generic Fluentd Client Exception
+        """
+        logger.debug(f"Storing {log.log_name=} in database")
+        response_code = None
+        if log.response_code is not None:
+            response_code = int(log.response_code)
+        try:
+            with self.session_manager.session_scope() as session:
+                session.add(
+                    DBLog(
+                        network_id=log.network_id,
+                        log_from=log.log_from,
+                        log_to=log.log_to,
+                        log_name=log.log_name,
+                        log_message=log.log_message,
+                        cbsd_serial_number=log.cbsd_serial_number,
+                        fcc_id=log.fcc_id,
+                        response_code=response_code,
+                        event_time=datetime.fromtimestamp(log.event_timestamp, timezone.utc),
+                    ),
+                )
+                session.commit()
+        except SQLAlchemyError as err:
+            msg = f"Failed to store {log.log_name} log. {err}"
+            logging.error(msg)
+            raise FluentdClientException(msg)
+
+
+class DPLogClient(object):
+    """
+    Client sending DP logs where dp service stores them,
+    i.e. to the database or to Fluentd depending on its log_store backend.
+    """
+
+    def __init__(self, session_manager: SessionManager, config: Optional[Config] = None):
+        self.config = config or get_config()
+        self.session_manager = session_manager
+        self.db_client = DBLogClient(session_manager)
+        self.fluentd_client = FluentdClient(self.config)
+        self.backend = None
+        self.backend_read_at = 0.0
+
+    def send_dp_log(self, log: DPLog):
+        """
+        Send DP log to the backend used by dp service
+
+        Args:
+            log (DPLog): DP Log
+
+        Raises:
+            FluentdClientException: Generic Fluentd Client Exception
+        """
+        if self._get_backend() == DB_LOG_BACKEND:
+            self.db_client.send_dp_log(log)
+        else:
+            self.fluentd_client.send_dp_log(log)
+
+    def _get_backend(self) -> str:
+        now = time.monotonic()
+        if self.backend is None or now - self.backend_read_at >= self.config.DP_LOG_BACKEND_REFRESH_INTERVAL:
+            self.backend = self._read_backend()
+            self.backend_read_at = now
+        return self.backend
+
+    def _read_backend(self) -> str:
+        try:
+            with self.session_manager.session_scope() as session:
+                log_backend = session.query(DBLogBackend).first()
+                if log_backend:
+                    return log_backend.backend
+        except SQLAlchemyError as err:
+            logging.error(f"Failed to read DP log backend. {err}")
+            return self.backend or DEFAULT_LOG_BACKEND
+        return DEFAULT_LOG_BACKEND
+
+
+def get_dp_log_client(session_manager: SessionManager, config: Optional[Config] = None):
+    """
+    Get DP log client following log backend of dp service
+
+    Args:
+        session_manager (SessionManager): database session manager
+        config (Optional[Config]): fluentd client configuration
+
+    Returns:
+        DPLogClient
+    """
+    return DPLogClient(session_manager, config)
@@ -34,6 +34,10 @@ class Config(object):
     FLUENTD_PROTOCOL = 'https' if FLUENTD_TLS_ENABLED else 'http'
     FLUENTD_URL = f'{FLUENTD_PROTOCOL}://{FLUENTD_SERVICE_HOST}:{FLUENTD_SERVICE_PORT}/{ELASTICSEARCH_INDEX}'
 
+    # DP logs follow log_store backend recorded in the database by dp service,
+    # it is read again after this many seconds
+    DP_LOG_BACKEND_REFRESH_INTERVAL = int(os.environ.get('DP_LOG_BACKEND_REFRESH_INTERVAL', 60))
+
 
 class TestConfig(Config):
     """
@@ -841,11 +841,14 @@ type EapAkaConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LogLevel protos.LogLevel        `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=magma.orc8r.LogLevel" json:"log_level,omitempty"`
-	Timeout  *EapAkaConfig_Timeouts `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	PlmnIds  []string               `protobuf:"bytes,3,rep,name=PlmnIds,proto3" json:"PlmnIds,omitempty"`
-	UseS6A   bool                   `protobuf:"varint,4,opt,name=UseS6a,proto3" json:"UseS6a,omitempty"`
-	MncLen   int32                  `protobuf:"varint,5,opt,name=MncLen,proto3" json:"MncLen,omitempty"`
+	LogLevel   protos.LogLevel                `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=magma.orc8r.LogLevel" json:"log_level,omitempty"`
+	Timeout    *EapAkaConfig_Timeouts         `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	PlmnIds    []string                       `protobuf:"bytes,3,rep,name=PlmnIds,proto3" json:"PlmnIds,omitempty"`
+	UseS6A     bool                           `protobuf:"varint,4,opt,name=UseS6a,proto3" json:"UseS6a,omitempty"`
+	MncLen     int32                          `protobuf:"varint,5,opt,name=MncLen,proto3" json:"MncLen,omitempty"`
+	FastReauth *EapAkaConfig_FastReauthConfig `protobuf:"bytes,6,opt,name=FastReauth,proto3" json:"FastReauth,omitempty"`
+	// Include AT_BIDDING in EAP-AKA Challenges to let peers know that EAP-AKA' is supported (RFC 5448, section 4)
+	AkaPrimeBidding bool `protobuf:"varint,7,opt,name=AkaPrimeBidding,proto3" json:"AkaPrimeBidding,omitempty"`
 }
 
 func (x *EapAkaConfig) Reset() {
@@ -915,6 +918,20 @@ func (x *EapAkaConfig) GetMncLen() int32 {
 	return 0
 }
 
+func (x *EapAkaConfig) GetFastReauth() *EapAkaConfig_FastReauthConfig {
+	if x != nil {
+		return x.FastReauth
+	}
+	return nil
+}
+
+func (x *EapAkaConfig) GetAkaPrimeBidding() bool {
+	if x != nil {
+		return x.AkaPrimeBidding
+	}
+	return false
+}
+
 // EapProviderTimeouts is a generic EAP provider timeout config for all new providers
 // TODO: It should eventually replace EapAkaConfig as well, but due to the braking nature
 // of the switch farther planning is required
@@ -1068,6 +1085,86 @@ func (x *EapSimConfig) GetMncLen() int32 {
 	return 0
 }
 
+type EapAkaPrimeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogLevel protos.LogLevel      `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=magma.orc8r.LogLevel" json:"log_level,omitempty"`
+	Timeout  *EapProviderTimeouts `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	PlmnIds  []string             `protobuf:"bytes,3,rep,name=PlmnIds,proto3" json:"PlmnIds,omitempty"`
+	MncLen   int32                `protobuf:"varint,5,opt,name=MncLen,proto3" json:"MncLen,omitempty"`
+	// Access Network Identity sent to the HSS for CK' & IK' derivation (TS 24.302, section 8.1.1.1), "WLAN" if empty
+	NetworkName string `protobuf:"bytes,6,opt,name=NetworkName,proto3" json:"NetworkName,omitempty"`
+}
+
+func (x *EapAkaPrimeConfig) Reset() {
+	*x = EapAkaPrimeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EapAkaPrimeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EapAkaPrimeConfig) ProtoMessage() {}
+
+func (x *EapAkaPrimeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EapAkaPrimeConfig.ProtoReflect.Descriptor instead.
+func (*EapAkaPrimeConfig) Descriptor() ([]byte, []int) {
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EapAkaPrimeConfig) GetLogLevel() protos.LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return protos.LogLevel(0)
+}
+
+func (x *EapAkaPrimeConfig) GetTimeout() *EapProviderTimeouts {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *EapAkaPrimeConfig) GetPlmnIds() []string {
+	if x != nil {
+		return x.PlmnIds
+	}
+	return nil
+}
+
+func (x *EapAkaPrimeConfig) GetMncLen() int32 {
+	if x != nil {
+		return x.MncLen
+	}
+	return 0
+}
+
+func (x *EapAkaPrimeConfig) GetNetworkName() string {
+	if x != nil {
+		return x.NetworkName
+	}
+	return ""
+}
+
 type AAAConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1090,7 +1187,7 @@ type AAAConfig struct {
 func (x *AAAConfig) Reset() {
 	*x = AAAConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[11]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1103,7 +1200,7 @@ func (x *AAAConfig) String() string {
 func (*AAAConfig) ProtoMessage() {}
 
 func (x *AAAConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[11]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,7 +1213,7 @@ func (x *AAAConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AAAConfig.ProtoReflect.Descriptor instead.
 func (*AAAConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{11}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AAAConfig) GetLogLevel() protos.LogLevel {
@@ -1188,7 +1285,7 @@ type RadiusConfig struct {
 func (x *RadiusConfig) Reset() {
 	*x = RadiusConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[12]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1201,7 +1298,7 @@ func (x *RadiusConfig) String() string {
 func (*RadiusConfig) ProtoMessage() {}
 
 func (x *RadiusConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[12]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,7 +1311,7 @@ func (x *RadiusConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RadiusConfig.ProtoReflect.Descriptor instead.
 func (*RadiusConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{12}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RadiusConfig) GetSecret() []byte {
@@ -1267,7 +1364,7 @@ type GatewayHealthConfig struct {
 func (x *GatewayHealthConfig) Reset() {
 	*x = GatewayHealthConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[13]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1280,7 +1377,7 @@ func (x *GatewayHealthConfig) String() string {
 func (*GatewayHealthConfig) ProtoMessage() {}
 
 func (x *GatewayHealthConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[13]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,7 +1390,7 @@ func (x *GatewayHealthConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayHealthConfig.ProtoReflect.Descriptor instead.
 func (*GatewayHealthConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{13}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GatewayHealthConfig) GetRequiredServices() []string {
@@ -1352,7 +1449,7 @@ type HSSConfig struct {
 func (x *HSSConfig) Reset() {
 	*x = HSSConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[14]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1365,7 +1462,7 @@ func (x *HSSConfig) String() string {
 func (*HSSConfig) ProtoMessage() {}
 
 func (x *HSSConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[14]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1378,7 +1475,7 @@ func (x *HSSConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HSSConfig.ProtoReflect.Descriptor instead.
 func (*HSSConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{14}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *HSSConfig) GetServer() *DiamServerConfig {
@@ -1441,7 +1538,7 @@ type RadiusdConfig struct {
 func (x *RadiusdConfig) Reset() {
 	*x = RadiusdConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[15]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1454,7 +1551,7 @@ func (x *RadiusdConfig) String() string {
 func (*RadiusdConfig) ProtoMessage() {}
 
 func (x *RadiusdConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[15]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1467,7 +1564,7 @@ func (x *RadiusdConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RadiusdConfig.ProtoReflect.Descriptor instead.
 func (*RadiusdConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{15}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RadiusdConfig) GetRadiusMetricsPort() uint32 {
@@ -1510,7 +1607,7 @@ type SCTPClientConfig struct {
 func (x *SCTPClientConfig) Reset() {
 	*x = SCTPClientConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[16]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1523,7 +1620,7 @@ func (x *SCTPClientConfig) String() string {
 func (*SCTPClientConfig) ProtoMessage() {}
 
 func (x *SCTPClientConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[16]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,7 +1633,7 @@ func (x *SCTPClientConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SCTPClientConfig.ProtoReflect.Descriptor instead.
 func (*SCTPClientConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{16}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SCTPClientConfig) GetServerAddress() string {
@@ -1565,7 +1662,7 @@ type CsfbConfig struct {
 func (x *CsfbConfig) Reset() {
 	*x = CsfbConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[17]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1578,7 +1675,7 @@ func (x *CsfbConfig) String() string {
 func (*CsfbConfig) ProtoMessage() {}
 
 func (x *CsfbConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[17]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1591,7 +1688,7 @@ func (x *CsfbConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CsfbConfig.ProtoReflect.Descriptor instead.
 func (*CsfbConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{17}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CsfbConfig) GetLogLevel() protos.LogLevel {
@@ -1619,7 +1716,7 @@ type EnvoyControllerConfig struct {
 func (x *EnvoyControllerConfig) Reset() {
 	*x = EnvoyControllerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[18]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1632,7 +1729,7 @@ func (x *EnvoyControllerConfig) String() string {
 func (*EnvoyControllerConfig) ProtoMessage() {}
 
 func (x *EnvoyControllerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[18]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1645,7 +1742,7 @@ func (x *EnvoyControllerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvoyControllerConfig.ProtoReflect.Descriptor instead.
 func (*EnvoyControllerConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{18}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *EnvoyControllerConfig) GetLogLevel() protos.LogLevel {
@@ -1673,7 +1770,7 @@ type S8Config struct {
 func (x *S8Config) Reset() {
 	*x = S8Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[19]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1686,7 +1783,7 @@ func (x *S8Config) String() string {
 func (*S8Config) ProtoMessage() {}
 
 func (x *S8Config) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[19]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +1796,7 @@ func (x *S8Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S8Config.ProtoReflect.Descriptor instead.
 func (*S8Config) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{19}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *S8Config) GetLogLevel() protos.LogLevel {
@@ -1762,7 +1859,7 @@ type SbiServerConfig struct {
 func (x *SbiServerConfig) Reset() {
 	*x = SbiServerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[20]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1775,7 +1872,7 @@ func (x *SbiServerConfig) String() string {
 func (*SbiServerConfig) ProtoMessage() {}
 
 func (x *SbiServerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[20]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,7 +1885,7 @@ func (x *SbiServerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SbiServerConfig.ProtoReflect.Descriptor instead.
 func (*SbiServerConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{20}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SbiServerConfig) GetApiRoot() string {
@@ -1833,7 +1930,7 @@ type N7ClientConfig struct {
 func (x *N7ClientConfig) Reset() {
 	*x = N7ClientConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[21]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1846,7 +1943,7 @@ func (x *N7ClientConfig) String() string {
 func (*N7ClientConfig) ProtoMessage() {}
 
 func (x *N7ClientConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[21]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1859,7 +1956,7 @@ func (x *N7ClientConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use N7ClientConfig.ProtoReflect.Descriptor instead.
 func (*N7ClientConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{21}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *N7ClientConfig) GetLocalAddr() string {
@@ -1892,7 +1989,7 @@ type N7Config struct {
 func (x *N7Config) Reset() {
 	*x = N7Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[22]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1905,7 +2002,7 @@ func (x *N7Config) String() string {
 func (*N7Config) ProtoMessage() {}
 
 func (x *N7Config) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[22]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +2015,7 @@ func (x *N7Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use N7Config.ProtoReflect.Descriptor instead.
 func (*N7Config) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{22}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *N7Config) GetDisableN7() bool {
@@ -1960,7 +2057,7 @@ type N7N40ProxyConfig struct {
 func (x *N7N40ProxyConfig) Reset() {
 	*x = N7N40ProxyConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[23]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1973,7 +2070,7 @@ func (x *N7N40ProxyConfig) String() string {
 func (*N7N40ProxyConfig) ProtoMessage() {}
 
 func (x *N7N40ProxyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[23]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1986,7 +2083,7 @@ func (x *N7N40ProxyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use N7N40ProxyConfig.ProtoReflect.Descriptor instead.
 func (*N7N40ProxyConfig) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{23}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *N7N40ProxyConfig) GetLogLevel() protos.LogLevel {
@@ -2031,7 +2128,7 @@ type EapAkaConfig_Timeouts struct {
 func (x *EapAkaConfig_Timeouts) Reset() {
 	*x = EapAkaConfig_Timeouts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[24]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2044,7 +2141,7 @@ func (x *EapAkaConfig_Timeouts) String() string {
 func (*EapAkaConfig_Timeouts) ProtoMessage() {}
 
 func (x *EapAkaConfig_Timeouts) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[24]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2088,6 +2185,89 @@ func (x *EapAkaConfig_Timeouts) GetSessionAuthenticatedMs() uint32 {
 	return 0
 }
 
+// Fast re-authentication & pseudonym identities (RFC 4187, sections 4.1.1.7 & 5)
+type EapAkaConfig_FastReauthConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	// Max number of consecutive fast re-authentications before a full authentication is required
+	MaxCounter uint32 `protobuf:"varint,2,opt,name=MaxCounter,proto3" json:"MaxCounter,omitempty"`
+	// Lifetime of a fast re-authentication identity & its context
+	ContextTimeoutMs uint32 `protobuf:"varint,3,opt,name=ContextTimeoutMs,proto3" json:"ContextTimeoutMs,omitempty"`
+	Pseudonyms       bool   `protobuf:"varint,4,opt,name=Pseudonyms,proto3" json:"Pseudonyms,omitempty"`
+	// Hex encoded AES key used to encrypt pseudonyms, a random key is generated if empty
+	PseudonymKey string `protobuf:"bytes,5,opt,name=PseudonymKey,proto3" json:"PseudonymKey,omitempty"`
+}
+
+func (x *EapAkaConfig_FastReauthConfig) Reset() {
+	*x = EapAkaConfig_FastReauthConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EapAkaConfig_FastReauthConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EapAkaConfig_FastReauthConfig) ProtoMessage() {}
+
+func (x *EapAkaConfig_FastReauthConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EapAkaConfig_FastReauthConfig.ProtoReflect.Descriptor instead.
+func (*EapAkaConfig_FastReauthConfig) Descriptor() ([]byte, []int) {
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *EapAkaConfig_FastReauthConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EapAkaConfig_FastReauthConfig) GetMaxCounter() uint32 {
+	if x != nil {
+		return x.MaxCounter
+	}
+	return 0
+}
+
+func (x *EapAkaConfig_FastReauthConfig) GetContextTimeoutMs() uint32 {
+	if x != nil {
+		return x.ContextTimeoutMs
+	}
+	return 0
+}
+
+func (x *EapAkaConfig_FastReauthConfig) GetPseudonyms() bool {
+	if x != nil {
+		return x.Pseudonyms
+	}
+	return false
+}
+
+func (x *EapAkaConfig_FastReauthConfig) GetPseudonymKey() string {
+	if x != nil {
+		return x.PseudonymKey
+	}
+	return ""
+}
+
 type HSSConfig_SubscriptionProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2102,7 +2282,7 @@ type HSSConfig_SubscriptionProfile struct {
 func (x *HSSConfig_SubscriptionProfile) Reset() {
 	*x = HSSConfig_SubscriptionProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[25]
+		mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2115,7 +2295,7 @@ func (x *HSSConfig_SubscriptionProfile) String() string {
 func (*HSSConfig_SubscriptionProfile) ProtoMessage() {}
 
 func (x *HSSConfig_SubscriptionProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[25]
+	mi := &file_feg_protos_mconfig_mconfigs_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2128,7 +2308,7 @@ func (x *HSSConfig_SubscriptionProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HSSConfig_SubscriptionProfile.ProtoReflect.Descriptor instead.
 func (*HSSConfig_SubscriptionProfile) Descriptor() ([]byte, []int) {
-	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{14, 0}
+	return file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *HSSConfig_SubscriptionProfile) GetMaxUlBitRate() uint64 {
@@ -2309,7 +2489,7 @@ var file_feg_protos_mconfig_mconfigs_proto_rawDesc = []byte{
 	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
 	0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x43, 0x6c, 0x69, 0x65,
 	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x73, 0x22, 0x83, 0x03, 0x0a, 0x0c, 0x45, 0x61, 0x70, 0x41, 0x6b, 0x61, 0x43, 0x6f, 0x6e, 0x66,
+	0x73, 0x22, 0xba, 0x05, 0x0a, 0x0c, 0x45, 0x61, 0x70, 0x41, 0x6b, 0x61, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
 	0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f,
@@ -2322,227 +2502,261 @@ var file_feg_protos_mconfig_mconfigs_proto_rawDesc = []byte{
 	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x6e, 0x63, 0x4c,
 	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e,
-	0x1a, 0xb4, 0x01, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x12,
-	0x30, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12,
-	0x36, 0x0a, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x13, 0x45, 0x61, 0x70, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12,
-	0x20, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d,
-	0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d,
-	0x73, 0x12, 0x36, 0x0a, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
-	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x0c, 0x45, 0x61,
-	0x70, 0x53, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f,
-	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3c,
-	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x45, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x50,
-	0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61, 0x12, 0x16,
-	0x0a, 0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x22, 0xfa, 0x02, 0x0a, 0x09, 0x41, 0x41, 0x41, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08,
-	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x49, 0x64, 0x6c, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x49, 0x64, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x11,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x41, 0x75, 0x74,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x13,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3f,
-	0x0a, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x32, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x41,
-	0x63, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x63, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x44, 0x41, 0x45, 0x41, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x44, 0x41, 0x45, 0x41, 0x64, 0x64, 0x72, 0x22, 0xb0, 0x02, 0x0a, 0x13, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x12, 0x4c, 0x0a, 0x0a, 0x46, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x61, 0x70, 0x41, 0x6b, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x46, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0a, 0x46, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x12, 0x28,
+	0x0a, 0x0f, 0x41, 0x6b, 0x61, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x42, 0x69, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x41, 0x6b, 0x61, 0x50, 0x72, 0x69, 0x6d,
+	0x65, 0x42, 0x69, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0xb4, 0x01, 0x0a, 0x08, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73, 0x1a,
+	0xbc, 0x01, 0x0a, 0x10, 0x46, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x2a,
+	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x73,
+	0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x50, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x73,
+	0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x50, 0x73, 0x65, 0x75, 0x64, 0x6f, 0x6e, 0x79, 0x6d, 0x4b, 0x65, 0x79, 0x22, 0xbf,
+	0x01, 0x0a, 0x13, 0x45, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x4d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x73,
+	0x22, 0xca, 0x01, 0x0a, 0x0c, 0x45, 0x61, 0x70, 0x53, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
+	0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x53, 0x36, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x53, 0x36, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x22, 0xdf, 0x01,
+	0x0a, 0x11, 0x45, 0x61, 0x70, 0x41, 0x6b, 0x61, 0x50, 0x72, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
+	0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x50, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x4d, 0x6e, 0x63, 0x4c, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22,
+	0xfa, 0x02, 0x0a, 0x09, 0x41, 0x41, 0x41, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a,
+	0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x32, 0x0a, 0x14, 0x49, 0x64, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x14, 0x49, 0x64, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f,
+	0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x52, 0x61, 0x64, 0x69, 0x75,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x52, 0x61, 0x64, 0x69,
+	0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x41, 0x63, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x92, 0x01, 0x0a,
+	0x0c, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x41,
+	0x63, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41,
+	0x63, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x41, 0x45, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x41, 0x45, 0x41, 0x64, 0x64,
+	0x72, 0x22, 0xb0, 0x02, 0x0a, 0x13, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65,
+	0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53,
+	0x65, 0x63, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
+	0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x53, 0x65, 0x63, 0x73, 0x22, 0xb4, 0x04, 0x0a, 0x09, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x6c,
+	0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x6c, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x4f, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x6c,
+	0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x61, 0x6d, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x6c, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x41, 0x6d, 0x66, 0x12, 0x4c, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x75,
+	0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x13, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53,
+	0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x63, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x25, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6c, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x55, 0x6c, 0x42,
+	0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x6c,
+	0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x44, 0x6c, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x1a, 0x6c, 0x0a,
+	0x10, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x0d,
+	0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a,
+	0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x61, 0x64, 0x69,
+	0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x61, 0x64, 0x69,
+	0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x30, 0x0a,
 	0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x70, 0x64,
+	0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x75, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x73, 0x12,
-	0x38, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
-	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
-	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x19, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x73, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x19, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x73, 0x22, 0xb4, 0x04, 0x0a, 0x09,
-	0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6f,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6c, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x4f, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x61,
-	0x6d, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6c, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x41, 0x6d, 0x66, 0x12, 0x4c, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x12, 0x5c, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x75,
-	0x62, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x11, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73, 0x1a,
-	0x63, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6c,
-	0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x6d, 0x61, 0x78, 0x55, 0x6c, 0x42, 0x69, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a,
-	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x6c, 0x5f, 0x62, 0x69, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x6c, 0x42, 0x69, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x1a, 0x6c, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x53, 0x53, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x0d, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x64, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x11, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x53, 0x65, 0x63, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73,
-	0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x10, 0x53, 0x43, 0x54, 0x50, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x79, 0x0a, 0x0a, 0x43, 0x73, 0x66, 0x62, 0x43, 0x6f,
+	0x2e, 0x0a, 0x13, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x22,
+	0x5e, 0x0a, 0x10, 0x53, 0x43, 0x54, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x79, 0x0a, 0x0a, 0x43, 0x73, 0x66, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a,
+	0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x53, 0x43, 0x54, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x15, 0x45, 0x6e,
+	0x76, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
+	0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xac, 0x02, 0x0a, 0x08, 0x53, 0x38, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
 	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
 	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08,
-	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x43, 0x54, 0x50, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x22, 0x4b, 0x0a, 0x15, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f,
-	0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xac,
-	0x02, 0x0a, 0x08, 0x53, 0x38, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c,
-	0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x67, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x67, 0x77, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x70, 0x6e, 0x5f, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x61, 0x70, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53,
-	0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
-	0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x8b, 0x01,
-	0x0a, 0x0f, 0x53, 0x62, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x69, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x57, 0x0a, 0x0e, 0x4e,
-	0x37, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x26, 0x0a, 0x0f,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x70, 0x69,
-	0x52, 0x6f, 0x6f, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x08, 0x4e, 0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x37, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x37,
-	0x12, 0x36, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x53, 0x62, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x37, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22,
-	0xf4, 0x01, 0x0a, 0x10, 0x4e, 0x37, 0x4e, 0x34, 0x30, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08,
-	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x34, 0x0a, 0x09, 0x6e, 0x37, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x37, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x6e, 0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a,
+	0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x67, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x61, 0x70, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x70, 0x6e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x3a,
 	0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
-	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x02, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
 	0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69,
 	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d,
 	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x2a, 0x3a, 0x0a, 0x0c, 0x47, 0x79, 0x49, 0x6e, 0x69, 0x74,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53,
-	0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x52, 0x5f, 0x4b, 0x45, 0x59,
-	0x10, 0x02, 0x42, 0x23, 0x5a, 0x21, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
-	0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x8b, 0x01, 0x0a, 0x0f, 0x53, 0x62, 0x69, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70,
+	0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70,
+	0x69, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55,
+	0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x57, 0x0a, 0x0e, 0x4e, 0x37, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f,
+	0x61, 0x70, 0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x70, 0x69, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x98, 0x01,
+	0x0a, 0x08, 0x4e, 0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x37, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x37, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x62, 0x69, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x4e, 0x37, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xf4, 0x01, 0x0a, 0x10, 0x4e, 0x37, 0x4e,
+	0x34, 0x30, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a,
+	0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x4c,
+	0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x34, 0x0a, 0x09, 0x6e, 0x37, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x6e,
+	0x37, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x2a,
+	0x3a, 0x0a, 0x0c, 0x47, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x45, 0x52, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x02, 0x42, 0x23, 0x5a, 0x21, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67,
+	0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2558,7 +2772,7 @@ func file_feg_protos_mconfig_mconfigs_proto_rawDescGZIP() []byte {
 }
 
 var file_feg_protos_mconfig_mconfigs_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_feg_protos_mconfig_mconfigs_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_feg_protos_mconfig_mconfigs_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_feg_protos_mconfig_mconfigs_proto_goTypes = []interface{}{
 	(GyInitMethod)(0),                     // 0: magma.mconfig.GyInitMethod
 	(*DiamClientConfig)(nil),              // 1: magma.mconfig.DiamClientConfig
@@ -2572,26 +2786,28 @@ var file_feg_protos_mconfig_mconfigs_proto_goTypes = []interface{}{
 	(*EapAkaConfig)(nil),                  // 9: magma.mconfig.EapAkaConfig
 	(*EapProviderTimeouts)(nil),           // 10: magma.mconfig.EapProviderTimeouts
 	(*EapSimConfig)(nil),                  // 11: magma.mconfig.EapSimConfig
-	(*AAAConfig)(nil),                     // 12: magma.mconfig.AAAConfig
-	(*RadiusConfig)(nil),                  // 13: magma.mconfig.RadiusConfig
-	(*GatewayHealthConfig)(nil),           // 14: magma.mconfig.GatewayHealthConfig
-	(*HSSConfig)(nil),                     // 15: magma.mconfig.HSSConfig
-	(*RadiusdConfig)(nil),                 // 16: magma.mconfig.RadiusdConfig
-	(*SCTPClientConfig)(nil),              // 17: magma.mconfig.SCTPClientConfig
-	(*CsfbConfig)(nil),                    // 18: magma.mconfig.CsfbConfig
-	(*EnvoyControllerConfig)(nil),         // 19: magma.mconfig.EnvoyControllerConfig
-	(*S8Config)(nil),                      // 20: magma.mconfig.S8Config
-	(*SbiServerConfig)(nil),               // 21: magma.mconfig.SbiServerConfig
-	(*N7ClientConfig)(nil),                // 22: magma.mconfig.N7ClientConfig
-	(*N7Config)(nil),                      // 23: magma.mconfig.N7Config
-	(*N7N40ProxyConfig)(nil),              // 24: magma.mconfig.N7N40ProxyConfig
-	(*EapAkaConfig_Timeouts)(nil),         // 25: magma.mconfig.EapAkaConfig.Timeouts
-	(*HSSConfig_SubscriptionProfile)(nil), // 26: magma.mconfig.HSSConfig.SubscriptionProfile
-	nil,                                   // 27: magma.mconfig.HSSConfig.SubProfilesEntry
-	(protos.LogLevel)(0),                  // 28: magma.orc8r.LogLevel
+	(*EapAkaPrimeConfig)(nil),             // 12: magma.mconfig.EapAkaPrimeConfig
+	(*AAAConfig)(nil),                     // 13: magma.mconfig.AAAConfig
+	(*RadiusConfig)(nil),                  // 14: magma.mconfig.RadiusConfig
+	(*GatewayHealthConfig)(nil),           // 15: magma.mconfig.GatewayHealthConfig
+	(*HSSConfig)(nil),                     // 16: magma.mconfig.HSSConfig
+	(*RadiusdConfig)(nil),                 // 17: magma.mconfig.RadiusdConfig
+	(*SCTPClientConfig)(nil),              // 18: magma.mconfig.SCTPClientConfig
+	(*CsfbConfig)(nil),                    // 19: magma.mconfig.CsfbConfig
+	(*EnvoyControllerConfig)(nil),         // 20: magma.mconfig.EnvoyControllerConfig
+	(*S8Config)(nil),                      // 21: magma.mconfig.S8Config
+	(*SbiServerConfig)(nil),               // 22: magma.mconfig.SbiServerConfig
+	(*N7ClientConfig)(nil),                // 23: magma.mconfig.N7ClientConfig
+	(*N7Config)(nil),                      // 24: magma.mconfig.N7Config
+	(*N7N40ProxyConfig)(nil),              // 25: magma.mconfig.N7N40ProxyConfig
+	(*EapAkaConfig_Timeouts)(nil),         // 26: magma.mconfig.EapAkaConfig.Timeouts
+	(*EapAkaConfig_FastReauthConfig)(nil), // 27: magma.mconfig.EapAkaConfig.FastReauthConfig
+	(*HSSConfig_SubscriptionProfile)(nil), // 28: magma.mconfig.HSSConfig.SubscriptionProfile
+	nil,                                   // 29: magma.mconfig.HSSConfig.SubProfilesEntry
+	(protos.LogLevel)(0),                  // 30: magma.orc8r.LogLevel
 }
 var file_feg_protos_mconfig_mconfigs_proto_depIdxs = []int32{
-	28, // 0: magma.mconfig.S6aConfig.log_level:type_name -> magma.orc8r.LogLevel
+	30, // 0: magma.mconfig.S6aConfig.log_level:type_name -> magma.orc8r.LogLevel
 	1,  // 1: magma.mconfig.S6aConfig.server:type_name -> magma.mconfig.DiamClientConfig
 	1,  // 2: magma.mconfig.GxConfig.server:type_name -> magma.mconfig.DiamClientConfig
 	1,  // 3: magma.mconfig.GxConfig.servers:type_name -> magma.mconfig.DiamClientConfig
@@ -2600,35 +2816,38 @@ var file_feg_protos_mconfig_mconfigs_proto_depIdxs = []int32{
 	0,  // 6: magma.mconfig.GyConfig.init_method:type_name -> magma.mconfig.GyInitMethod
 	1,  // 7: magma.mconfig.GyConfig.servers:type_name -> magma.mconfig.DiamClientConfig
 	4,  // 8: magma.mconfig.GyConfig.virtual_apn_rules:type_name -> magma.mconfig.VirtualApnRule
-	28, // 9: magma.mconfig.SessionProxyConfig.log_level:type_name -> magma.orc8r.LogLevel
+	30, // 9: magma.mconfig.SessionProxyConfig.log_level:type_name -> magma.orc8r.LogLevel
 	5,  // 10: magma.mconfig.SessionProxyConfig.gx:type_name -> magma.mconfig.GxConfig
 	6,  // 11: magma.mconfig.SessionProxyConfig.gy:type_name -> magma.mconfig.GyConfig
-	28, // 12: magma.mconfig.SwxConfig.log_level:type_name -> magma.orc8r.LogLevel
+	30, // 12: magma.mconfig.SwxConfig.log_level:type_name -> magma.orc8r.LogLevel
 	1,  // 13: magma.mconfig.SwxConfig.server:type_name -> magma.mconfig.DiamClientConfig
 	1,  // 14: magma.mconfig.SwxConfig.servers:type_name -> magma.mconfig.DiamClientConfig
-	28, // 15: magma.mconfig.EapAkaConfig.log_level:type_name -> magma.orc8r.LogLevel
-	25, // 16: magma.mconfig.EapAkaConfig.timeout:type_name -> magma.mconfig.EapAkaConfig.Timeouts
-	28, // 17: magma.mconfig.EapSimConfig.log_level:type_name -> magma.orc8r.LogLevel
-	10, // 18: magma.mconfig.EapSimConfig.timeout:type_name -> magma.mconfig.EapProviderTimeouts
-	28, // 19: magma.mconfig.AAAConfig.log_level:type_name -> magma.orc8r.LogLevel
-	13, // 20: magma.mconfig.AAAConfig.RadiusConfig:type_name -> magma.mconfig.RadiusConfig
-	2,  // 21: magma.mconfig.HSSConfig.server:type_name -> magma.mconfig.DiamServerConfig
-	27, // 22: magma.mconfig.HSSConfig.sub_profiles:type_name -> magma.mconfig.HSSConfig.SubProfilesEntry
-	26, // 23: magma.mconfig.HSSConfig.default_sub_profile:type_name -> magma.mconfig.HSSConfig.SubscriptionProfile
-	28, // 24: magma.mconfig.CsfbConfig.log_level:type_name -> magma.orc8r.LogLevel
-	17, // 25: magma.mconfig.CsfbConfig.client:type_name -> magma.mconfig.SCTPClientConfig
-	28, // 26: magma.mconfig.EnvoyControllerConfig.log_level:type_name -> magma.orc8r.LogLevel
-	28, // 27: magma.mconfig.S8Config.log_level:type_name -> magma.orc8r.LogLevel
-	21, // 28: magma.mconfig.N7Config.server:type_name -> magma.mconfig.SbiServerConfig
-	22, // 29: magma.mconfig.N7Config.client:type_name -> magma.mconfig.N7ClientConfig
-	28, // 30: magma.mconfig.N7N40ProxyConfig.log_level:type_name -> magma.orc8r.LogLevel
-	23, // 31: magma.mconfig.N7N40ProxyConfig.n7_config:type_name -> magma.mconfig.N7Config
-	26, // 32: magma.mconfig.HSSConfig.SubProfilesEntry.value:type_name -> magma.mconfig.HSSConfig.SubscriptionProfile
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	30, // 15: magma.mconfig.EapAkaConfig.log_level:type_name -> magma.orc8r.LogLevel
+	26, // 16: magma.mconfig.EapAkaConfig.timeout:type_name -> magma.mconfig.EapAkaConfig.Timeouts
+	27, // 17: magma.mconfig.EapAkaConfig.FastReauth:type_name -> magma.mconfig.EapAkaConfig.FastReauthConfig
+	30, // 18: magma.mconfig.EapSimConfig.log_level:type_name -> magma.orc8r.LogLevel
+	10, // 19: magma.mconfig.EapSimConfig.timeout:type_name -> magma.mconfig.EapProviderTimeouts
+	30, // 20: magma.mconfig.EapAkaPrimeConfig.log_level:type_name -> magma.orc8r.LogLevel
+	10, // 21: magma.mconfig.EapAkaPrimeConfig.timeout:type_name -> magma.mconfig.EapProviderTimeouts
+	30, // 22: magma.mconfig.AAAConfig.log_level:type_name -> magma.orc8r.LogLevel
+	14, // 23: magma.mconfig.AAAConfig.RadiusConfig:type_name -> magma.mconfig.RadiusConfig
+	2,  // 24: magma.mconfig.HSSConfig.server:type_name -> magma.mconfig.DiamServerConfig
+	29, // 25: magma.mconfig.HSSConfig.sub_profiles:type_name -> magma.mconfig.HSSConfig.SubProfilesEntry
+	28, // 26: magma.mconfig.HSSConfig.default_sub_profile:type_name -> magma.mconfig.HSSConfig.SubscriptionProfile
+	30, // 27: magma.mconfig.CsfbConfig.log_level:type_name -> magma.orc8r.LogLevel
+	18, // 28: magma.mconfig.CsfbConfig.client:type_name -> magma.mconfig.SCTPClientConfig
+	30, // 29: magma.mconfig.EnvoyControllerConfig.log_level:type_name -> magma.orc8r.LogLevel
+	30, // 30: magma.mconfig.S8Config.log_level:type_name -> magma.orc8r.LogLevel
+	22, // 31: magma.mconfig.N7Config.server:type_name -> magma.mconfig.SbiServerConfig
+	23, // 32: magma.mconfig.N7Config.client:type_name -> magma.mconfig.N7ClientConfig
+	30, // 33: magma.mconfig.N7N40ProxyConfig.log_level:type_name -> magma.orc8r.LogLevel
+	24, // 34: magma.mconfig.N7N40ProxyConfig.n7_config:type_name -> magma.mconfig.N7Config
+	28, // 35: magma.mconfig.HSSConfig.SubProfilesEntry.value:type_name -> magma.mconfig.HSSConfig.SubscriptionProfile
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_feg_protos_mconfig_mconfigs_proto_init() }
@@ -2770,7 +2989,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AAAConfig); i {
+			switch v := v.(*EapAkaPrimeConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2782,7 +3001,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RadiusConfig); i {
+			switch v := v.(*AAAConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2794,7 +3013,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayHealthConfig); i {
+			switch v := v.(*RadiusConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2806,7 +3025,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HSSConfig); i {
+			switch v := v.(*GatewayHealthConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2818,7 +3037,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RadiusdConfig); i {
+			switch v := v.(*HSSConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2830,7 +3049,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SCTPClientConfig); i {
+			switch v := v.(*RadiusdConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2842,7 +3061,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CsfbConfig); i {
+			switch v := v.(*SCTPClientConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2854,7 +3073,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnvoyControllerConfig); i {
+			switch v := v.(*CsfbConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2866,7 +3085,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S8Config); i {
+			switch v := v.(*EnvoyControllerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2878,7 +3097,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SbiServerConfig); i {
+			switch v := v.(*S8Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2890,7 +3109,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*N7ClientConfig); i {
+			switch v := v.(*SbiServerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2902,7 +3121,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*N7Config); i {
+			switch v := v.(*N7ClientConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2914,7 +3133,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*N7N40ProxyConfig); i {
+			switch v := v.(*N7Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2926,7 +3145,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EapAkaConfig_Timeouts); i {
+			switch v := v.(*N7N40ProxyConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2938,6 +3157,30 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			}
 		}
 		file_feg_protos_mconfig_mconfigs_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EapAkaConfig_Timeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_mconfig_mconfigs_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EapAkaConfig_FastReauthConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_mconfig_mconfigs_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HSSConfig_SubscriptionProfile); i {
 			case 0:
 				return &v.state
@@ -2956,7 +3199,7 @@ func file_feg_protos_mconfig_mconfigs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feg_protos_mconfig_mconfigs_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   27,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
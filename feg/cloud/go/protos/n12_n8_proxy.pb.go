@@ -0,0 +1,1468 @@
+//
+//Copyright 2022 The Magma Authors.
+//
+//This source code is licensed under the BSD-style license found in the
+//LICENSE file in the root directory of this source tree.
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+//
+// This is a proxy for the 5G SA authentication (N12, AMF - AUSF) and
+// registration (N8, AMF - UDM) reference points. It lets the AGW AMF use a
+// partner's AUSF/UDM in the same way s6a_proxy lets the MME use a partner's HSS.
+//
+// For details about fields see:
+// 3GPP TS 29.509 Nausf_UEAuthentication
+// 3GPP TS 29.503 Nudm_UECM
+// 3GPP TS 33.501 Security architecture and procedures for 5G system
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.10.0
+// source: feg/protos/n12_n8_proxy.proto
+
+package protos
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Authentication method selected by the AUSF/UDM for the subscriber (TS 29.509, 6.1.6.3.3)
+type AuthType5G int32
+
+const (
+	AuthType5G_FIVE_G_AKA           AuthType5G = 0
+	AuthType5G_FIVE_G_EAP_AKA_PRIME AuthType5G = 1
+)
+
+// Enum value maps for AuthType5G.
+var (
+	AuthType5G_name = map[int32]string{
+		0: "FIVE_G_AKA",
+		1: "FIVE_G_EAP_AKA_PRIME",
+	}
+	AuthType5G_value = map[string]int32{
+		"FIVE_G_AKA":           0,
+		"FIVE_G_EAP_AKA_PRIME": 1,
+	}
+)
+
+func (x AuthType5G) Enum() *AuthType5G {
+	p := new(AuthType5G)
+	*p = x
+	return p
+}
+
+func (x AuthType5G) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuthType5G) Descriptor() protoreflect.EnumDescriptor {
+	return file_feg_protos_n12_n8_proxy_proto_enumTypes[0].Descriptor()
+}
+
+func (AuthType5G) Type() protoreflect.EnumType {
+	return &file_feg_protos_n12_n8_proxy_proto_enumTypes[0]
+}
+
+func (x AuthType5G) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuthType5G.Descriptor instead.
+func (AuthType5G) EnumDescriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{0}
+}
+
+// Result of the authentication as seen by the AUSF (TS 29.509, 6.1.6.3.2)
+type AuthResult5G int32
+
+const (
+	AuthResult5G_AUTHENTICATION_ONGOING AuthResult5G = 0
+	AuthResult5G_AUTHENTICATION_SUCCESS AuthResult5G = 1
+	AuthResult5G_AUTHENTICATION_FAILURE AuthResult5G = 2
+)
+
+// Enum value maps for AuthResult5G.
+var (
+	AuthResult5G_name = map[int32]string{
+		0: "AUTHENTICATION_ONGOING",
+		1: "AUTHENTICATION_SUCCESS",
+		2: "AUTHENTICATION_FAILURE",
+	}
+	AuthResult5G_value = map[string]int32{
+		"AUTHENTICATION_ONGOING": 0,
+		"AUTHENTICATION_SUCCESS": 1,
+		"AUTHENTICATION_FAILURE": 2,
+	}
+)
+
+func (x AuthResult5G) Enum() *AuthResult5G {
+	p := new(AuthResult5G)
+	*p = x
+	return p
+}
+
+func (x AuthResult5G) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuthResult5G) Descriptor() protoreflect.EnumDescriptor {
+	return file_feg_protos_n12_n8_proxy_proto_enumTypes[1].Descriptor()
+}
+
+func (AuthResult5G) Type() protoreflect.EnumType {
+	return &file_feg_protos_n12_n8_proxy_proto_enumTypes[1]
+}
+
+func (x AuthResult5G) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuthResult5G.Descriptor instead.
+func (AuthResult5G) EnumDescriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{1}
+}
+
+// Globally Unique AMF Identifier (TS 29.571, 5.4.4.3)
+type Guami struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mcc string `protobuf:"bytes,1,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	Mnc string `protobuf:"bytes,2,opt,name=mnc,proto3" json:"mnc,omitempty"`
+	// AMF Region ID + AMF Set ID + AMF Pointer as 6 hex digits
+	AmfId string `protobuf:"bytes,3,opt,name=amf_id,json=amfId,proto3" json:"amf_id,omitempty"`
+}
+
+func (x *Guami) Reset() {
+	*x = Guami{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Guami) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guami) ProtoMessage() {}
+
+func (x *Guami) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guami.ProtoReflect.Descriptor instead.
+func (*Guami) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Guami) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *Guami) GetMnc() string {
+	if x != nil {
+		return x.Mnc
+	}
+	return ""
+}
+
+func (x *Guami) GetAmfId() string {
+	if x != nil {
+		return x.AmfId
+	}
+	return ""
+}
+
+// AuthenticationInfo (TS 29.509, 6.1.6.2.2)
+type UEAuthenticationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SUPI (imsi-<IMSI>) or SUCI of the subscriber
+	SupiOrSuci string `protobuf:"bytes,1,opt,name=supi_or_suci,json=supiOrSuci,proto3" json:"supi_or_suci,omitempty"`
+	// Serving network name, 5G:mnc<MNC>.mcc<MCC>.3gppnetwork.org
+	ServingNetworkName string `protobuf:"bytes,2,opt,name=serving_network_name,json=servingNetworkName,proto3" json:"serving_network_name,omitempty"`
+	// Permanent Equipment Identifier (optional)
+	Pei string `protobuf:"bytes,3,opt,name=pei,proto3" json:"pei,omitempty"`
+	// RAND and AUTS in the case of a resync registration (optional)
+	ResyncRand []byte `protobuf:"bytes,4,opt,name=resync_rand,json=resyncRand,proto3" json:"resync_rand,omitempty"`
+	ResyncAuts []byte `protobuf:"bytes,5,opt,name=resync_auts,json=resyncAuts,proto3" json:"resync_auts,omitempty"`
+}
+
+func (x *UEAuthenticationRequest) Reset() {
+	*x = UEAuthenticationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UEAuthenticationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UEAuthenticationRequest) ProtoMessage() {}
+
+func (x *UEAuthenticationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UEAuthenticationRequest.ProtoReflect.Descriptor instead.
+func (*UEAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UEAuthenticationRequest) GetSupiOrSuci() string {
+	if x != nil {
+		return x.SupiOrSuci
+	}
+	return ""
+}
+
+func (x *UEAuthenticationRequest) GetServingNetworkName() string {
+	if x != nil {
+		return x.ServingNetworkName
+	}
+	return ""
+}
+
+func (x *UEAuthenticationRequest) GetPei() string {
+	if x != nil {
+		return x.Pei
+	}
+	return ""
+}
+
+func (x *UEAuthenticationRequest) GetResyncRand() []byte {
+	if x != nil {
+		return x.ResyncRand
+	}
+	return nil
+}
+
+func (x *UEAuthenticationRequest) GetResyncAuts() []byte {
+	if x != nil {
+		return x.ResyncAuts
+	}
+	return nil
+}
+
+// UEAuthenticationCtx (TS 29.509, 6.1.6.2.3)
+type UEAuthenticationAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the authentication context created by the AUSF
+	AuthCtxId string `protobuf:"bytes,1,opt,name=auth_ctx_id,json=authCtxId,proto3" json:"auth_ctx_id,omitempty"`
+	// Selected authentication method
+	AuthType AuthType5G `protobuf:"varint,2,opt,name=auth_type,json=authType,proto3,enum=magma.feg.AuthType5G" json:"auth_type,omitempty"`
+	// 5G HE AV (FIVE_G_AKA only)
+	Rand      []byte `protobuf:"bytes,3,opt,name=rand,proto3" json:"rand,omitempty"`
+	Autn      []byte `protobuf:"bytes,4,opt,name=autn,proto3" json:"autn,omitempty"`
+	HxresStar []byte `protobuf:"bytes,5,opt,name=hxres_star,json=hxresStar,proto3" json:"hxres_star,omitempty"`
+	// Initial EAP-Request/AKA'-Challenge (EAP_AKA_PRIME only)
+	EapPayload []byte `protobuf:"bytes,6,opt,name=eap_payload,json=eapPayload,proto3" json:"eap_payload,omitempty"`
+}
+
+func (x *UEAuthenticationAnswer) Reset() {
+	*x = UEAuthenticationAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UEAuthenticationAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UEAuthenticationAnswer) ProtoMessage() {}
+
+func (x *UEAuthenticationAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UEAuthenticationAnswer.ProtoReflect.Descriptor instead.
+func (*UEAuthenticationAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UEAuthenticationAnswer) GetAuthCtxId() string {
+	if x != nil {
+		return x.AuthCtxId
+	}
+	return ""
+}
+
+func (x *UEAuthenticationAnswer) GetAuthType() AuthType5G {
+	if x != nil {
+		return x.AuthType
+	}
+	return AuthType5G_FIVE_G_AKA
+}
+
+func (x *UEAuthenticationAnswer) GetRand() []byte {
+	if x != nil {
+		return x.Rand
+	}
+	return nil
+}
+
+func (x *UEAuthenticationAnswer) GetAutn() []byte {
+	if x != nil {
+		return x.Autn
+	}
+	return nil
+}
+
+func (x *UEAuthenticationAnswer) GetHxresStar() []byte {
+	if x != nil {
+		return x.HxresStar
+	}
+	return nil
+}
+
+func (x *UEAuthenticationAnswer) GetEapPayload() []byte {
+	if x != nil {
+		return x.EapPayload
+	}
+	return nil
+}
+
+// ConfirmationData (TS 29.509, 6.1.6.2.4)
+type AuthConfirmationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthCtxId string `protobuf:"bytes,1,opt,name=auth_ctx_id,json=authCtxId,proto3" json:"auth_ctx_id,omitempty"`
+	// RES* received from the UE
+	ResStar []byte `protobuf:"bytes,2,opt,name=res_star,json=resStar,proto3" json:"res_star,omitempty"`
+}
+
+func (x *AuthConfirmationRequest) Reset() {
+	*x = AuthConfirmationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthConfirmationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthConfirmationRequest) ProtoMessage() {}
+
+func (x *AuthConfirmationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthConfirmationRequest.ProtoReflect.Descriptor instead.
+func (*AuthConfirmationRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuthConfirmationRequest) GetAuthCtxId() string {
+	if x != nil {
+		return x.AuthCtxId
+	}
+	return ""
+}
+
+func (x *AuthConfirmationRequest) GetResStar() []byte {
+	if x != nil {
+		return x.ResStar
+	}
+	return nil
+}
+
+// ConfirmationDataResponse (TS 29.509, 6.1.6.2.5)
+type AuthConfirmationAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthResult AuthResult5G `protobuf:"varint,1,opt,name=auth_result,json=authResult,proto3,enum=magma.feg.AuthResult5G" json:"auth_result,omitempty"`
+	// SUPI, present on success
+	Supi string `protobuf:"bytes,2,opt,name=supi,proto3" json:"supi,omitempty"`
+	// K_SEAF, present on success
+	Kseaf []byte `protobuf:"bytes,3,opt,name=kseaf,proto3" json:"kseaf,omitempty"`
+}
+
+func (x *AuthConfirmationAnswer) Reset() {
+	*x = AuthConfirmationAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthConfirmationAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthConfirmationAnswer) ProtoMessage() {}
+
+func (x *AuthConfirmationAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthConfirmationAnswer.ProtoReflect.Descriptor instead.
+func (*AuthConfirmationAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthConfirmationAnswer) GetAuthResult() AuthResult5G {
+	if x != nil {
+		return x.AuthResult
+	}
+	return AuthResult5G_AUTHENTICATION_ONGOING
+}
+
+func (x *AuthConfirmationAnswer) GetSupi() string {
+	if x != nil {
+		return x.Supi
+	}
+	return ""
+}
+
+func (x *AuthConfirmationAnswer) GetKseaf() []byte {
+	if x != nil {
+		return x.Kseaf
+	}
+	return nil
+}
+
+// EapSession (TS 29.509, 6.1.6.2.6)
+type EapSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthCtxId string `protobuf:"bytes,1,opt,name=auth_ctx_id,json=authCtxId,proto3" json:"auth_ctx_id,omitempty"`
+	// EAP-Response received from the UE
+	EapPayload []byte `protobuf:"bytes,2,opt,name=eap_payload,json=eapPayload,proto3" json:"eap_payload,omitempty"`
+}
+
+func (x *EapSessionRequest) Reset() {
+	*x = EapSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EapSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EapSessionRequest) ProtoMessage() {}
+
+func (x *EapSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EapSessionRequest.ProtoReflect.Descriptor instead.
+func (*EapSessionRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EapSessionRequest) GetAuthCtxId() string {
+	if x != nil {
+		return x.AuthCtxId
+	}
+	return ""
+}
+
+func (x *EapSessionRequest) GetEapPayload() []byte {
+	if x != nil {
+		return x.EapPayload
+	}
+	return nil
+}
+
+type EapSessionAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Next EAP-Request, or the final EAP-Success/EAP-Failure
+	EapPayload []byte       `protobuf:"bytes,1,opt,name=eap_payload,json=eapPayload,proto3" json:"eap_payload,omitempty"`
+	AuthResult AuthResult5G `protobuf:"varint,2,opt,name=auth_result,json=authResult,proto3,enum=magma.feg.AuthResult5G" json:"auth_result,omitempty"`
+	// SUPI, present on success
+	Supi string `protobuf:"bytes,3,opt,name=supi,proto3" json:"supi,omitempty"`
+	// K_SEAF, present on success
+	Kseaf []byte `protobuf:"bytes,4,opt,name=kseaf,proto3" json:"kseaf,omitempty"`
+}
+
+func (x *EapSessionAnswer) Reset() {
+	*x = EapSessionAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EapSessionAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EapSessionAnswer) ProtoMessage() {}
+
+func (x *EapSessionAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EapSessionAnswer.ProtoReflect.Descriptor instead.
+func (*EapSessionAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EapSessionAnswer) GetEapPayload() []byte {
+	if x != nil {
+		return x.EapPayload
+	}
+	return nil
+}
+
+func (x *EapSessionAnswer) GetAuthResult() AuthResult5G {
+	if x != nil {
+		return x.AuthResult
+	}
+	return AuthResult5G_AUTHENTICATION_ONGOING
+}
+
+func (x *EapSessionAnswer) GetSupi() string {
+	if x != nil {
+		return x.Supi
+	}
+	return ""
+}
+
+func (x *EapSessionAnswer) GetKseaf() []byte {
+	if x != nil {
+		return x.Kseaf
+	}
+	return nil
+}
+
+// Amf3GppAccessRegistration (TS 29.503, 6.2.6.2.2)
+type AmfRegistrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SUPI (imsi-<IMSI>) of the subscriber
+	Supi string `protobuf:"bytes,1,opt,name=supi,proto3" json:"supi,omitempty"`
+	// NF Instance ID of the serving AMF
+	AmfInstanceId string `protobuf:"bytes,2,opt,name=amf_instance_id,json=amfInstanceId,proto3" json:"amf_instance_id,omitempty"`
+	Guami         *Guami `protobuf:"bytes,3,opt,name=guami,proto3" json:"guami,omitempty"`
+	// URI the UDM uses to notify the AMF of deregistration
+	DeregCallbackUri string `protobuf:"bytes,4,opt,name=dereg_callback_uri,json=deregCallbackUri,proto3" json:"dereg_callback_uri,omitempty"`
+	// RAT type, NR if not set
+	RatType string `protobuf:"bytes,5,opt,name=rat_type,json=ratType,proto3" json:"rat_type,omitempty"`
+	// Permanent Equipment Identifier (optional)
+	Pei string `protobuf:"bytes,6,opt,name=pei,proto3" json:"pei,omitempty"`
+	// Indicates an initial registration
+	InitialRegistration bool `protobuf:"varint,7,opt,name=initial_registration,json=initialRegistration,proto3" json:"initial_registration,omitempty"`
+}
+
+func (x *AmfRegistrationRequest) Reset() {
+	*x = AmfRegistrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmfRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmfRegistrationRequest) ProtoMessage() {}
+
+func (x *AmfRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmfRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*AmfRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AmfRegistrationRequest) GetSupi() string {
+	if x != nil {
+		return x.Supi
+	}
+	return ""
+}
+
+func (x *AmfRegistrationRequest) GetAmfInstanceId() string {
+	if x != nil {
+		return x.AmfInstanceId
+	}
+	return ""
+}
+
+func (x *AmfRegistrationRequest) GetGuami() *Guami {
+	if x != nil {
+		return x.Guami
+	}
+	return nil
+}
+
+func (x *AmfRegistrationRequest) GetDeregCallbackUri() string {
+	if x != nil {
+		return x.DeregCallbackUri
+	}
+	return ""
+}
+
+func (x *AmfRegistrationRequest) GetRatType() string {
+	if x != nil {
+		return x.RatType
+	}
+	return ""
+}
+
+func (x *AmfRegistrationRequest) GetPei() string {
+	if x != nil {
+		return x.Pei
+	}
+	return ""
+}
+
+func (x *AmfRegistrationRequest) GetInitialRegistration() bool {
+	if x != nil {
+		return x.InitialRegistration
+	}
+	return false
+}
+
+type AmfRegistrationAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// True if the UDM created a new registration, false if an existing one was replaced
+	Created bool `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *AmfRegistrationAnswer) Reset() {
+	*x = AmfRegistrationAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmfRegistrationAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmfRegistrationAnswer) ProtoMessage() {}
+
+func (x *AmfRegistrationAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmfRegistrationAnswer.ProtoReflect.Descriptor instead.
+func (*AmfRegistrationAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AmfRegistrationAnswer) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// Amf3GppAccessRegistrationModification (TS 29.503, 6.2.6.2.7)
+type AmfDeregistrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SUPI (imsi-<IMSI>) of the subscriber
+	Supi  string `protobuf:"bytes,1,opt,name=supi,proto3" json:"supi,omitempty"`
+	Guami *Guami `protobuf:"bytes,2,opt,name=guami,proto3" json:"guami,omitempty"`
+}
+
+func (x *AmfDeregistrationRequest) Reset() {
+	*x = AmfDeregistrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmfDeregistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmfDeregistrationRequest) ProtoMessage() {}
+
+func (x *AmfDeregistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmfDeregistrationRequest.ProtoReflect.Descriptor instead.
+func (*AmfDeregistrationRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AmfDeregistrationRequest) GetSupi() string {
+	if x != nil {
+		return x.Supi
+	}
+	return ""
+}
+
+func (x *AmfDeregistrationRequest) GetGuami() *Guami {
+	if x != nil {
+		return x.Guami
+	}
+	return nil
+}
+
+type AmfDeregistrationAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AmfDeregistrationAnswer) Reset() {
+	*x = AmfDeregistrationAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmfDeregistrationAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmfDeregistrationAnswer) ProtoMessage() {}
+
+func (x *AmfDeregistrationAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_n12_n8_proxy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmfDeregistrationAnswer.ProtoReflect.Descriptor instead.
+func (*AmfDeregistrationAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_n12_n8_proxy_proto_rawDescGZIP(), []int{10}
+}
+
+var File_feg_protos_n12_n8_proxy_proto protoreflect.FileDescriptor
+
+var file_feg_protos_n12_n8_proxy_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x66, 0x65, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6e, 0x31, 0x32,
+	0x5f, 0x6e, 0x38, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x09, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x22, 0x42, 0x0a, 0x05, 0x47, 0x75,
+	0x61, 0x6d, 0x69, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x63, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x63, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x6e, 0x63, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x6d, 0x66, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6d, 0x66, 0x49, 0x64, 0x22, 0xc1,
+	0x01, 0x0a, 0x17, 0x55, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x75,
+	0x70, 0x69, 0x5f, 0x6f, 0x72, 0x5f, 0x73, 0x75, 0x63, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x75, 0x70, 0x69, 0x4f, 0x72, 0x53, 0x75, 0x63, 0x69, 0x12, 0x30, 0x0a, 0x14,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x6e, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x65, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x65, 0x69,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x61, 0x6e,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x41, 0x75,
+	0x74, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x16, 0x55, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x63, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x43, 0x74, 0x78, 0x49, 0x64, 0x12, 0x32, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x54, 0x79, 0x70, 0x65, 0x35, 0x47, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x78, 0x72,
+	0x65, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x68,
+	0x78, 0x72, 0x65, 0x73, 0x53, 0x74, 0x61, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x61, 0x70, 0x5f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65,
+	0x61, 0x70, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x54, 0x0a, 0x17, 0x41, 0x75, 0x74,
+	0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x63, 0x74, 0x78,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x43,
+	0x74, 0x78, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x65, 0x73, 0x53, 0x74, 0x61, 0x72, 0x22,
+	0x7c, 0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x35, 0x47, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x75, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x75, 0x70, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x73, 0x65, 0x61, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6b, 0x73, 0x65, 0x61, 0x66, 0x22, 0x54, 0x0a,
+	0x11, 0x45, 0x61, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x63, 0x74, 0x78, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x43, 0x74, 0x78,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x61, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65, 0x61, 0x70, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x45, 0x61, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x61, 0x70, 0x5f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x65,
+	0x61, 0x70, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x35, 0x47, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x75, 0x70, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x75, 0x70, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x73, 0x65, 0x61, 0x66,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x6b, 0x73, 0x65, 0x61, 0x66, 0x22, 0x8a, 0x02,
+	0x0a, 0x16, 0x41, 0x6d, 0x66, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x75, 0x70, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x75, 0x70, 0x69, 0x12, 0x26, 0x0a, 0x0f,
+	0x61, 0x6d, 0x66, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6d, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x67, 0x75, 0x61, 0x6d, 0x69, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e,
+	0x47, 0x75, 0x61, 0x6d, 0x69, 0x52, 0x05, 0x67, 0x75, 0x61, 0x6d, 0x69, 0x12, 0x2c, 0x0a, 0x12,
+	0x64, 0x65, 0x72, 0x65, 0x67, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x72, 0x65, 0x67, 0x43,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x65, 0x69, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x70, 0x65, 0x69, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x31, 0x0a, 0x15, 0x41, 0x6d,
+	0x66, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x56, 0x0a,
+	0x18, 0x41, 0x6d, 0x66, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x75, 0x70,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x75, 0x70, 0x69, 0x12, 0x26, 0x0a,
+	0x05, 0x67, 0x75, 0x61, 0x6d, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x47, 0x75, 0x61, 0x6d, 0x69, 0x52, 0x05,
+	0x67, 0x75, 0x61, 0x6d, 0x69, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x6d, 0x66, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x2a, 0x36, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x35, 0x47, 0x12, 0x0e,
+	0x0a, 0x0a, 0x46, 0x49, 0x56, 0x45, 0x5f, 0x47, 0x5f, 0x41, 0x4b, 0x41, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x46, 0x49, 0x56, 0x45, 0x5f, 0x47, 0x5f, 0x45, 0x41, 0x50, 0x5f, 0x41, 0x4b, 0x41,
+	0x5f, 0x50, 0x52, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x2a, 0x62, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x35, 0x47, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x48,
+	0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x4e, 0x47, 0x4f, 0x49,
+	0x4e, 0x47, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x54, 0x48, 0x45, 0x4e, 0x54, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x32, 0xc4, 0x03, 0x0a,
+	0x0a, 0x4e, 0x31, 0x32, 0x4e, 0x38, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x57, 0x0a, 0x0c, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x45, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x45, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x41,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x45, 0x61, 0x70, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
+	0x2e, 0x45, 0x61, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x45,
+	0x61, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22,
+	0x00, 0x12, 0x54, 0x0a, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6d, 0x66,
+	0x12, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x6d, 0x66,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e,
+	0x41, 0x6d, 0x66, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6d, 0x66, 0x12, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x6d, 0x66, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x6d, 0x66, 0x44, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67,
+	0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_feg_protos_n12_n8_proxy_proto_rawDescOnce sync.Once
+	file_feg_protos_n12_n8_proxy_proto_rawDescData = file_feg_protos_n12_n8_proxy_proto_rawDesc
+)
+
+func file_feg_protos_n12_n8_proxy_proto_rawDescGZIP() []byte {
+	file_feg_protos_n12_n8_proxy_proto_rawDescOnce.Do(func() {
+		file_feg_protos_n12_n8_proxy_proto_rawDescData = protoimpl.X.CompressGZIP(file_feg_protos_n12_n8_proxy_proto_rawDescData)
+	})
+	return file_feg_protos_n12_n8_proxy_proto_rawDescData
+}
+
+var file_feg_protos_n12_n8_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_feg_protos_n12_n8_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_feg_protos_n12_n8_proxy_proto_goTypes = []interface{}{
+	(AuthType5G)(0),                  // 0: magma.feg.AuthType5G
+	(AuthResult5G)(0),                // 1: magma.feg.AuthResult5G
+	(*Guami)(nil),                    // 2: magma.feg.Guami
+	(*UEAuthenticationRequest)(nil),  // 3: magma.feg.UEAuthenticationRequest
+	(*UEAuthenticationAnswer)(nil),   // 4: magma.feg.UEAuthenticationAnswer
+	(*AuthConfirmationRequest)(nil),  // 5: magma.feg.AuthConfirmationRequest
+	(*AuthConfirmationAnswer)(nil),   // 6: magma.feg.AuthConfirmationAnswer
+	(*EapSessionRequest)(nil),        // 7: magma.feg.EapSessionRequest
+	(*EapSessionAnswer)(nil),         // 8: magma.feg.EapSessionAnswer
+	(*AmfRegistrationRequest)(nil),   // 9: magma.feg.AmfRegistrationRequest
+	(*AmfRegistrationAnswer)(nil),    // 10: magma.feg.AmfRegistrationAnswer
+	(*AmfDeregistrationRequest)(nil), // 11: magma.feg.AmfDeregistrationRequest
+	(*AmfDeregistrationAnswer)(nil),  // 12: magma.feg.AmfDeregistrationAnswer
+}
+var file_feg_protos_n12_n8_proxy_proto_depIdxs = []int32{
+	0,  // 0: magma.feg.UEAuthenticationAnswer.auth_type:type_name -> magma.feg.AuthType5G
+	1,  // 1: magma.feg.AuthConfirmationAnswer.auth_result:type_name -> magma.feg.AuthResult5G
+	1,  // 2: magma.feg.EapSessionAnswer.auth_result:type_name -> magma.feg.AuthResult5G
+	2,  // 3: magma.feg.AmfRegistrationRequest.guami:type_name -> magma.feg.Guami
+	2,  // 4: magma.feg.AmfDeregistrationRequest.guami:type_name -> magma.feg.Guami
+	3,  // 5: magma.feg.N12N8Proxy.Authenticate:input_type -> magma.feg.UEAuthenticationRequest
+	5,  // 6: magma.feg.N12N8Proxy.ConfirmAuthentication:input_type -> magma.feg.AuthConfirmationRequest
+	7,  // 7: magma.feg.N12N8Proxy.EapSession:input_type -> magma.feg.EapSessionRequest
+	9,  // 8: magma.feg.N12N8Proxy.RegisterAmf:input_type -> magma.feg.AmfRegistrationRequest
+	11, // 9: magma.feg.N12N8Proxy.DeregisterAmf:input_type -> magma.feg.AmfDeregistrationRequest
+	4,  // 10: magma.feg.N12N8Proxy.Authenticate:output_type -> magma.feg.UEAuthenticationAnswer
+	6,  // 11: magma.feg.N12N8Proxy.ConfirmAuthentication:output_type -> magma.feg.AuthConfirmationAnswer
+	8,  // 12: magma.feg.N12N8Proxy.EapSession:output_type -> magma.feg.EapSessionAnswer
+	10, // 13: magma.feg.N12N8Proxy.RegisterAmf:output_type -> magma.feg.AmfRegistrationAnswer
+	12, // 14: magma.feg.N12N8Proxy.DeregisterAmf:output_type -> magma.feg.AmfDeregistrationAnswer
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_feg_protos_n12_n8_proxy_proto_init() }
+func file_feg_protos_n12_n8_proxy_proto_init() {
+	if File_feg_protos_n12_n8_proxy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Guami); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UEAuthenticationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UEAuthenticationAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthConfirmationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthConfirmationAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EapSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EapSessionAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmfRegistrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmfRegistrationAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmfDeregistrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_n12_n8_proxy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AmfDeregistrationAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_feg_protos_n12_n8_proxy_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_feg_protos_n12_n8_proxy_proto_goTypes,
+		DependencyIndexes: file_feg_protos_n12_n8_proxy_proto_depIdxs,
+		EnumInfos:         file_feg_protos_n12_n8_proxy_proto_enumTypes,
+		MessageInfos:      file_feg_protos_n12_n8_proxy_proto_msgTypes,
+	}.Build()
+	File_feg_protos_n12_n8_proxy_proto = out.File
+	file_feg_protos_n12_n8_proxy_proto_rawDesc = nil
+	file_feg_protos_n12_n8_proxy_proto_goTypes = nil
+	file_feg_protos_n12_n8_proxy_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// N12N8ProxyClient is the client API for N12N8Proxy service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type N12N8ProxyClient interface {
+	// Nausf_UEAuthentication_Authenticate (POST /ue-authentications)
+	Authenticate(ctx context.Context, in *UEAuthenticationRequest, opts ...grpc.CallOption) (*UEAuthenticationAnswer, error)
+	// Nausf_UEAuthentication_Authenticate 5G-AKA confirmation
+	// (PUT /ue-authentications/{authCtxId}/5g-aka-confirmation)
+	ConfirmAuthentication(ctx context.Context, in *AuthConfirmationRequest, opts ...grpc.CallOption) (*AuthConfirmationAnswer, error)
+	// Nausf_UEAuthentication_Authenticate EAP-AKA' round
+	// (POST /ue-authentications/{authCtxId}/eap-session)
+	EapSession(ctx context.Context, in *EapSessionRequest, opts ...grpc.CallOption) (*EapSessionAnswer, error)
+	// Nudm_UECM_Registration (PUT /{ueId}/registrations/amf-3gpp-access)
+	RegisterAmf(ctx context.Context, in *AmfRegistrationRequest, opts ...grpc.CallOption) (*AmfRegistrationAnswer, error)
+	// Nudm_UECM_Update with purge flag set (PATCH /{ueId}/registrations/amf-3gpp-access)
+	DeregisterAmf(ctx context.Context, in *AmfDeregistrationRequest, opts ...grpc.CallOption) (*AmfDeregistrationAnswer, error)
+}
+
+type n12N8ProxyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewN12N8ProxyClient(cc grpc.ClientConnInterface) N12N8ProxyClient {
+	return &n12N8ProxyClient{cc}
+}
+
+func (c *n12N8ProxyClient) Authenticate(ctx context.Context, in *UEAuthenticationRequest, opts ...grpc.CallOption) (*UEAuthenticationAnswer, error) {
+	out := new(UEAuthenticationAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.N12N8Proxy/Authenticate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *n12N8ProxyClient) ConfirmAuthentication(ctx context.Context, in *AuthConfirmationRequest, opts ...grpc.CallOption) (*AuthConfirmationAnswer, error) {
+	out := new(AuthConfirmationAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.N12N8Proxy/ConfirmAuthentication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *n12N8ProxyClient) EapSession(ctx context.Context, in *EapSessionRequest, opts ...grpc.CallOption) (*EapSessionAnswer, error) {
+	out := new(EapSessionAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.N12N8Proxy/EapSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *n12N8ProxyClient) RegisterAmf(ctx context.Context, in *AmfRegistrationRequest, opts ...grpc.CallOption) (*AmfRegistrationAnswer, error) {
+	out := new(AmfRegistrationAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.N12N8Proxy/RegisterAmf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *n12N8ProxyClient) DeregisterAmf(ctx context.Context, in *AmfDeregistrationRequest, opts ...grpc.CallOption) (*AmfDeregistrationAnswer, error) {
+	out := new(AmfDeregistrationAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.N12N8Proxy/DeregisterAmf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// N12N8ProxyServer is the server API for N12N8Proxy service.
+type N12N8ProxyServer interface {
+	// Nausf_UEAuthentication_Authenticate (POST /ue-authentications)
+	Authenticate(context.Context, *UEAuthenticationRequest) (*UEAuthenticationAnswer, error)
+	// Nausf_UEAuthentication_Authenticate 5G-AKA confirmation
+	// (PUT /ue-authentications/{authCtxId}/5g-aka-confirmation)
+	ConfirmAuthentication(context.Context, *AuthConfirmationRequest) (*AuthConfirmationAnswer, error)
+	// Nausf_UEAuthentication_Authenticate EAP-AKA' round
+	// (POST /ue-authentications/{authCtxId}/eap-session)
+	EapSession(context.Context, *EapSessionRequest) (*EapSessionAnswer, error)
+	// Nudm_UECM_Registration (PUT /{ueId}/registrations/amf-3gpp-access)
+	RegisterAmf(context.Context, *AmfRegistrationRequest) (*AmfRegistrationAnswer, error)
+	// Nudm_UECM_Update with purge flag set (PATCH /{ueId}/registrations/amf-3gpp-access)
+	DeregisterAmf(context.Context, *AmfDeregistrationRequest) (*AmfDeregistrationAnswer, error)
+}
+
+// UnimplementedN12N8ProxyServer can be embedded to have forward compatible implementations.
+type UnimplementedN12N8ProxyServer struct {
+}
+
+func (*UnimplementedN12N8ProxyServer) Authenticate(context.Context, *UEAuthenticationRequest) (*UEAuthenticationAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+}
+func (*UnimplementedN12N8ProxyServer) ConfirmAuthentication(context.Context, *AuthConfirmationRequest) (*AuthConfirmationAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmAuthentication not implemented")
+}
+func (*UnimplementedN12N8ProxyServer) EapSession(context.Context, *EapSessionRequest) (*EapSessionAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EapSession not implemented")
+}
+func (*UnimplementedN12N8ProxyServer) RegisterAmf(context.Context, *AmfRegistrationRequest) (*AmfRegistrationAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAmf not implemented")
+}
+func (*UnimplementedN12N8ProxyServer) DeregisterAmf(context.Context, *AmfDeregistrationRequest) (*AmfDeregistrationAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAmf not implemented")
+}
+
+func RegisterN12N8ProxyServer(s *grpc.Server, srv N12N8ProxyServer) {
+	s.RegisterService(&_N12N8Proxy_serviceDesc, srv)
+}
+
+func _N12N8Proxy_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UEAuthenticationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(N12N8ProxyServer).Authenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.N12N8Proxy/Authenticate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(N12N8ProxyServer).Authenticate(ctx, req.(*UEAuthenticationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _N12N8Proxy_ConfirmAuthentication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthConfirmationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(N12N8ProxyServer).ConfirmAuthentication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.N12N8Proxy/ConfirmAuthentication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(N12N8ProxyServer).ConfirmAuthentication(ctx, req.(*AuthConfirmationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _N12N8Proxy_EapSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EapSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(N12N8ProxyServer).EapSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.N12N8Proxy/EapSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(N12N8ProxyServer).EapSession(ctx, req.(*EapSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _N12N8Proxy_RegisterAmf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmfRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(N12N8ProxyServer).RegisterAmf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.N12N8Proxy/RegisterAmf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(N12N8ProxyServer).RegisterAmf(ctx, req.(*AmfRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _N12N8Proxy_DeregisterAmf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmfDeregistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(N12N8ProxyServer).DeregisterAmf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.N12N8Proxy/DeregisterAmf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(N12N8ProxyServer).DeregisterAmf(ctx, req.(*AmfDeregistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _N12N8Proxy_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.feg.N12N8Proxy",
+	HandlerType: (*N12N8ProxyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Authenticate",
+			Handler:    _N12N8Proxy_Authenticate_Handler,
+		},
+		{
+			MethodName: "ConfirmAuthentication",
+			Handler:    _N12N8Proxy_ConfirmAuthentication_Handler,
+		},
+		{
+			MethodName: "EapSession",
+			Handler:    _N12N8Proxy_EapSession_Handler,
+		},
+		{
+			MethodName: "RegisterAmf",
+			Handler:    _N12N8Proxy_RegisterAmf_Handler,
+		},
+		{
+			MethodName: "DeregisterAmf",
+			Handler:    _N12N8Proxy_DeregisterAmf_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feg/protos/n12_n8_proxy.proto",
+}
@@ -1779,6 +1779,542 @@ func (x *DeleteBearerResponsePgw) GetCause() uint32 {
 	return 0
 }
 
+// 3GPP TS 29.274 7.2.7 (sent by AGW on handover, TAU or idle to active transitions)
+type ModifyBearerRequestPgw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PgwAddrs string `protobuf:"bytes,1,opt,name=pgwAddrs,proto3" json:"pgwAddrs,omitempty"`
+	Imsi     string `protobuf:"bytes,2,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	// AGW control plane TEID
+	CAgwTeid uint32 `protobuf:"varint,3,opt,name=c_agw_teid,json=cAgwTeid,proto3" json:"c_agw_teid,omitempty"`
+	// control plane TEID given by PGW during CreateSession
+	CPgwTeid uint32 `protobuf:"varint,4,opt,name=c_pgw_teid,json=cPgwTeid,proto3" json:"c_pgw_teid,omitempty"`
+	// bearer to modify, contains the new AGW user plane F-TEID
+	BearerContext  *BearerContext           `protobuf:"bytes,5,opt,name=bearer_context,json=bearerContext,proto3" json:"bearer_context,omitempty"`
+	ServingNetwork *ServingNetwork          `protobuf:"bytes,6,opt,name=serving_network,json=servingNetwork,proto3" json:"serving_network,omitempty"`
+	Uli            *UserLocationInformation `protobuf:"bytes,7,opt,name=uli,proto3" json:"uli,omitempty"`
+	RatType        RATType                  `protobuf:"varint,8,opt,name=rat_type,json=ratType,proto3,enum=magma.feg.RATType" json:"rat_type,omitempty"`
+	TimeZone       *TimeZone                `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *ModifyBearerRequestPgw) Reset() {
+	*x = ModifyBearerRequestPgw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s8_proxy_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyBearerRequestPgw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyBearerRequestPgw) ProtoMessage() {}
+
+func (x *ModifyBearerRequestPgw) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s8_proxy_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyBearerRequestPgw.ProtoReflect.Descriptor instead.
+func (*ModifyBearerRequestPgw) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ModifyBearerRequestPgw) GetPgwAddrs() string {
+	if x != nil {
+		return x.PgwAddrs
+	}
+	return ""
+}
+
+func (x *ModifyBearerRequestPgw) GetImsi() string {
+	if x != nil {
+		return x.Imsi
+	}
+	return ""
+}
+
+func (x *ModifyBearerRequestPgw) GetCAgwTeid() uint32 {
+	if x != nil {
+		return x.CAgwTeid
+	}
+	return 0
+}
+
+func (x *ModifyBearerRequestPgw) GetCPgwTeid() uint32 {
+	if x != nil {
+		return x.CPgwTeid
+	}
+	return 0
+}
+
+func (x *ModifyBearerRequestPgw) GetBearerContext() *BearerContext {
+	if x != nil {
+		return x.BearerContext
+	}
+	return nil
+}
+
+func (x *ModifyBearerRequestPgw) GetServingNetwork() *ServingNetwork {
+	if x != nil {
+		return x.ServingNetwork
+	}
+	return nil
+}
+
+func (x *ModifyBearerRequestPgw) GetUli() *UserLocationInformation {
+	if x != nil {
+		return x.Uli
+	}
+	return nil
+}
+
+func (x *ModifyBearerRequestPgw) GetRatType() RATType {
+	if x != nil {
+		return x.RatType
+	}
+	return RATType_RESERVED
+}
+
+func (x *ModifyBearerRequestPgw) GetTimeZone() *TimeZone {
+	if x != nil {
+		return x.TimeZone
+	}
+	return nil
+}
+
+type ModifyBearerResponsePgw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// AGW control plane TEID
+	CAgwTeid                     uint32                        `protobuf:"varint,1,opt,name=c_agw_teid,json=cAgwTeid,proto3" json:"c_agw_teid,omitempty"`
+	Msisdn                       string                        `protobuf:"bytes,2,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	BearerContext                *BearerContext                `protobuf:"bytes,3,opt,name=bearer_context,json=bearerContext,proto3" json:"bearer_context,omitempty"`
+	ProtocolConfigurationOptions *ProtocolConfigurationOptions `protobuf:"bytes,4,opt,name=protocol_configuration_options,json=protocolConfigurationOptions,proto3" json:"protocol_configuration_options,omitempty"`
+	GtpError                     *GtpError                     `protobuf:"bytes,5,opt,name=gtp_error,json=gtpError,proto3" json:"gtp_error,omitempty"`
+}
+
+func (x *ModifyBearerResponsePgw) Reset() {
+	*x = ModifyBearerResponsePgw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s8_proxy_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyBearerResponsePgw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyBearerResponsePgw) ProtoMessage() {}
+
+func (x *ModifyBearerResponsePgw) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s8_proxy_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyBearerResponsePgw.ProtoReflect.Descriptor instead.
+func (*ModifyBearerResponsePgw) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ModifyBearerResponsePgw) GetCAgwTeid() uint32 {
+	if x != nil {
+		return x.CAgwTeid
+	}
+	return 0
+}
+
+func (x *ModifyBearerResponsePgw) GetMsisdn() string {
+	if x != nil {
+		return x.Msisdn
+	}
+	return ""
+}
+
+func (x *ModifyBearerResponsePgw) GetBearerContext() *BearerContext {
+	if x != nil {
+		return x.BearerContext
+	}
+	return nil
+}
+
+func (x *ModifyBearerResponsePgw) GetProtocolConfigurationOptions() *ProtocolConfigurationOptions {
+	if x != nil {
+		return x.ProtocolConfigurationOptions
+	}
+	return nil
+}
+
+func (x *ModifyBearerResponsePgw) GetGtpError() *GtpError {
+	if x != nil {
+		return x.GtpError
+	}
+	return nil
+}
+
+// 3GPP TS 29.274 7.2.21 (sent by AGW when UE goes idle)
+type ReleaseAccessBearersRequestPgw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PgwAddrs string `protobuf:"bytes,1,opt,name=pgwAddrs,proto3" json:"pgwAddrs,omitempty"`
+	Imsi     string `protobuf:"bytes,2,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	// AGW control plane TEID
+	CAgwTeid uint32 `protobuf:"varint,3,opt,name=c_agw_teid,json=cAgwTeid,proto3" json:"c_agw_teid,omitempty"`
+	// control plane TEID given by PGW during CreateSession
+	CPgwTeid uint32 `protobuf:"varint,4,opt,name=c_pgw_teid,json=cPgwTeid,proto3" json:"c_pgw_teid,omitempty"`
+	// ids of the bearers to release, all bearers of the PDN connection if empty
+	EpsBearerId []uint32 `protobuf:"varint,5,rep,packed,name=eps_bearer_id,json=epsBearerId,proto3" json:"eps_bearer_id,omitempty"`
+}
+
+func (x *ReleaseAccessBearersRequestPgw) Reset() {
+	*x = ReleaseAccessBearersRequestPgw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s8_proxy_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseAccessBearersRequestPgw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseAccessBearersRequestPgw) ProtoMessage() {}
+
+func (x *ReleaseAccessBearersRequestPgw) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s8_proxy_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseAccessBearersRequestPgw.ProtoReflect.Descriptor instead.
+func (*ReleaseAccessBearersRequestPgw) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReleaseAccessBearersRequestPgw) GetPgwAddrs() string {
+	if x != nil {
+		return x.PgwAddrs
+	}
+	return ""
+}
+
+func (x *ReleaseAccessBearersRequestPgw) GetImsi() string {
+	if x != nil {
+		return x.Imsi
+	}
+	return ""
+}
+
+func (x *ReleaseAccessBearersRequestPgw) GetCAgwTeid() uint32 {
+	if x != nil {
+		return x.CAgwTeid
+	}
+	return 0
+}
+
+func (x *ReleaseAccessBearersRequestPgw) GetCPgwTeid() uint32 {
+	if x != nil {
+		return x.CPgwTeid
+	}
+	return 0
+}
+
+func (x *ReleaseAccessBearersRequestPgw) GetEpsBearerId() []uint32 {
+	if x != nil {
+		return x.EpsBearerId
+	}
+	return nil
+}
+
+type ReleaseAccessBearersResponsePgw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GtpError *GtpError `protobuf:"bytes,1,opt,name=gtp_error,json=gtpError,proto3" json:"gtp_error,omitempty"`
+}
+
+func (x *ReleaseAccessBearersResponsePgw) Reset() {
+	*x = ReleaseAccessBearersResponsePgw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s8_proxy_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseAccessBearersResponsePgw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseAccessBearersResponsePgw) ProtoMessage() {}
+
+func (x *ReleaseAccessBearersResponsePgw) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s8_proxy_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseAccessBearersResponsePgw.ProtoReflect.Descriptor instead.
+func (*ReleaseAccessBearersResponsePgw) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReleaseAccessBearersResponsePgw) GetGtpError() *GtpError {
+	if x != nil {
+		return x.GtpError
+	}
+	return nil
+}
+
+// 3GPP TS 29.274 7.2.15 (PGW initiated bearer QoS/TFT modification)
+type UpdateBearerRequestPgw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PgwAddrs       string `protobuf:"bytes,1,opt,name=pgwAddrs,proto3" json:"pgwAddrs,omitempty"`
+	SequenceNumber uint32 `protobuf:"varint,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// control plane TEID given by AGW during CreateSession
+	CAgwTeid uint32 `protobuf:"varint,3,opt,name=c_agw_teid,json=cAgwTeid,proto3" json:"c_agw_teid,omitempty"`
+	// contains the new QoS and TFT of the bearer
+	BearerContext                *BearerContext                `protobuf:"bytes,4,opt,name=bearer_context,json=bearerContext,proto3" json:"bearer_context,omitempty"`
+	ApnAmbr                      *Ambr                         `protobuf:"bytes,5,opt,name=apn_ambr,json=apnAmbr,proto3" json:"apn_ambr,omitempty"`
+	ProtocolConfigurationOptions *ProtocolConfigurationOptions `protobuf:"bytes,6,opt,name=protocol_configuration_options,json=protocolConfigurationOptions,proto3" json:"protocol_configuration_options,omitempty"`
+}
+
+func (x *UpdateBearerRequestPgw) Reset() {
+	*x = UpdateBearerRequestPgw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s8_proxy_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBearerRequestPgw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBearerRequestPgw) ProtoMessage() {}
+
+func (x *UpdateBearerRequestPgw) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s8_proxy_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBearerRequestPgw.ProtoReflect.Descriptor instead.
+func (*UpdateBearerRequestPgw) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateBearerRequestPgw) GetPgwAddrs() string {
+	if x != nil {
+		return x.PgwAddrs
+	}
+	return ""
+}
+
+func (x *UpdateBearerRequestPgw) GetSequenceNumber() uint32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *UpdateBearerRequestPgw) GetCAgwTeid() uint32 {
+	if x != nil {
+		return x.CAgwTeid
+	}
+	return 0
+}
+
+func (x *UpdateBearerRequestPgw) GetBearerContext() *BearerContext {
+	if x != nil {
+		return x.BearerContext
+	}
+	return nil
+}
+
+func (x *UpdateBearerRequestPgw) GetApnAmbr() *Ambr {
+	if x != nil {
+		return x.ApnAmbr
+	}
+	return nil
+}
+
+func (x *UpdateBearerRequestPgw) GetProtocolConfigurationOptions() *ProtocolConfigurationOptions {
+	if x != nil {
+		return x.ProtocolConfigurationOptions
+	}
+	return nil
+}
+
+type UpdateBearerResponsePgw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// same as pgwAddr as in UpdateBearerRequestPgw.pgwAddrs
+	PgwAddrs       string `protobuf:"bytes,1,opt,name=pgwAddrs,proto3" json:"pgwAddrs,omitempty"`
+	Imsi           string `protobuf:"bytes,2,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	SequenceNumber uint32 `protobuf:"varint,3,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	// control plane TEID given by PGW during CreateSession
+	CPgwTeid                     uint32                        `protobuf:"varint,4,opt,name=c_pgw_teid,json=cPgwTeid,proto3" json:"c_pgw_teid,omitempty"`
+	Cause                        uint32                        `protobuf:"varint,5,opt,name=cause,proto3" json:"cause,omitempty"`
+	BearerContext                *BearerContext                `protobuf:"bytes,6,opt,name=bearer_context,json=bearerContext,proto3" json:"bearer_context,omitempty"`
+	ProtocolConfigurationOptions *ProtocolConfigurationOptions `protobuf:"bytes,7,opt,name=protocol_configuration_options,json=protocolConfigurationOptions,proto3" json:"protocol_configuration_options,omitempty"`
+	ServingNetwork               *ServingNetwork               `protobuf:"bytes,8,opt,name=serving_network,json=servingNetwork,proto3" json:"serving_network,omitempty"`
+	Uli                          *UserLocationInformation      `protobuf:"bytes,9,opt,name=uli,proto3" json:"uli,omitempty"`
+	TimeZone                     *TimeZone                     `protobuf:"bytes,10,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *UpdateBearerResponsePgw) Reset() {
+	*x = UpdateBearerResponsePgw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_s8_proxy_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBearerResponsePgw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBearerResponsePgw) ProtoMessage() {}
+
+func (x *UpdateBearerResponsePgw) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_s8_proxy_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBearerResponsePgw.ProtoReflect.Descriptor instead.
+func (*UpdateBearerResponsePgw) Descriptor() ([]byte, []int) {
+	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateBearerResponsePgw) GetPgwAddrs() string {
+	if x != nil {
+		return x.PgwAddrs
+	}
+	return ""
+}
+
+func (x *UpdateBearerResponsePgw) GetImsi() string {
+	if x != nil {
+		return x.Imsi
+	}
+	return ""
+}
+
+func (x *UpdateBearerResponsePgw) GetSequenceNumber() uint32 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *UpdateBearerResponsePgw) GetCPgwTeid() uint32 {
+	if x != nil {
+		return x.CPgwTeid
+	}
+	return 0
+}
+
+func (x *UpdateBearerResponsePgw) GetCause() uint32 {
+	if x != nil {
+		return x.Cause
+	}
+	return 0
+}
+
+func (x *UpdateBearerResponsePgw) GetBearerContext() *BearerContext {
+	if x != nil {
+		return x.BearerContext
+	}
+	return nil
+}
+
+func (x *UpdateBearerResponsePgw) GetProtocolConfigurationOptions() *ProtocolConfigurationOptions {
+	if x != nil {
+		return x.ProtocolConfigurationOptions
+	}
+	return nil
+}
+
+func (x *UpdateBearerResponsePgw) GetServingNetwork() *ServingNetwork {
+	if x != nil {
+		return x.ServingNetwork
+	}
+	return nil
+}
+
+func (x *UpdateBearerResponsePgw) GetUli() *UserLocationInformation {
+	if x != nil {
+		return x.Uli
+	}
+	return nil
+}
+
+func (x *UpdateBearerResponsePgw) GetTimeZone() *TimeZone {
+	if x != nil {
+		return x.TimeZone
+	}
+	return nil
+}
+
 type EchoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1793,7 +2329,7 @@ type EchoRequest struct {
 func (x *EchoRequest) Reset() {
 	*x = EchoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s8_proxy_proto_msgTypes[18]
+		mi := &file_feg_protos_s8_proxy_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1806,7 +2342,7 @@ func (x *EchoRequest) String() string {
 func (*EchoRequest) ProtoMessage() {}
 
 func (x *EchoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s8_proxy_proto_msgTypes[18]
+	mi := &file_feg_protos_s8_proxy_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1819,7 +2355,7 @@ func (x *EchoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EchoRequest.ProtoReflect.Descriptor instead.
 func (*EchoRequest) Descriptor() ([]byte, []int) {
-	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{18}
+	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *EchoRequest) GetPgwAddrs() string {
@@ -1845,7 +2381,7 @@ type EchoResponse struct {
 func (x *EchoResponse) Reset() {
 	*x = EchoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s8_proxy_proto_msgTypes[19]
+		mi := &file_feg_protos_s8_proxy_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1858,7 +2394,7 @@ func (x *EchoResponse) String() string {
 func (*EchoResponse) ProtoMessage() {}
 
 func (x *EchoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s8_proxy_proto_msgTypes[19]
+	mi := &file_feg_protos_s8_proxy_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1871,7 +2407,7 @@ func (x *EchoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EchoResponse.ProtoReflect.Descriptor instead.
 func (*EchoResponse) Descriptor() ([]byte, []int) {
-	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{19}
+	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{25}
 }
 
 type GtpError struct {
@@ -1886,7 +2422,7 @@ type GtpError struct {
 func (x *GtpError) Reset() {
 	*x = GtpError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_s8_proxy_proto_msgTypes[20]
+		mi := &file_feg_protos_s8_proxy_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1899,7 +2435,7 @@ func (x *GtpError) String() string {
 func (*GtpError) ProtoMessage() {}
 
 func (x *GtpError) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_s8_proxy_proto_msgTypes[20]
+	mi := &file_feg_protos_s8_proxy_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,7 +2448,7 @@ func (x *GtpError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GtpError.ProtoReflect.Descriptor instead.
 func (*GtpError) Descriptor() ([]byte, []int) {
-	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{20}
+	return file_feg_protos_s8_proxy_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GtpError) GetCause() uint32 {
@@ -2237,76 +2773,215 @@ var file_feg_protos_s8_proxy_proto_rawDesc = []byte{
 	0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a,
 	0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x61,
-	0x75, 0x73, 0x65, 0x22, 0x3d, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d,
-	0x73, 0x69, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x32, 0x0a, 0x08, 0x47, 0x74, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
-	0x61, 0x75, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x2a, 0x40, 0x0a, 0x07, 0x50, 0x44, 0x4e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x49, 0x50, 0x56, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10,
-	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x50, 0x56, 0x34, 0x56, 0x36, 0x10, 0x03, 0x12, 0x09, 0x0a,
-	0x05, 0x4e, 0x6f, 0x6e, 0x49, 0x50, 0x10, 0x05, 0x2a, 0x89, 0x01, 0x0a, 0x07, 0x52, 0x41, 0x54,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x54, 0x52, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x09, 0x0a,
-	0x05, 0x47, 0x45, 0x52, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x4c, 0x41, 0x4e,
-	0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x48,
-	0x53, 0x50, 0x41, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x55, 0x54, 0x52, 0x41, 0x4e, 0x10,
-	0x06, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x10, 0x07, 0x12, 0x11,
-	0x0a, 0x0d, 0x45, 0x55, 0x54, 0x52, 0x41, 0x4e, 0x5f, 0x4e, 0x42, 0x5f, 0x49, 0x4f, 0x54, 0x10,
-	0x08, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x54, 0x45, 0x5f, 0x4d, 0x10, 0x09, 0x12, 0x06, 0x0a, 0x02,
-	0x4e, 0x52, 0x10, 0x0a, 0x2a, 0x8c, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x50,
-	0x4e, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x6d, 0x73, 0x5f, 0x41, 0x50, 0x4e, 0x5f, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x5f, 0x41, 0x50, 0x4e, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x10, 0x02, 0x32, 0xa2, 0x03, 0x0a, 0x07, 0x53, 0x38, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12,
-	0x5a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x67, 0x77, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x67, 0x77, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77,
-	0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x50, 0x67, 0x77, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x45,
-	0x63, 0x68, 0x6f, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e,
-	0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x75, 0x73, 0x65, 0x22, 0xa0, 0x03, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d,
+	0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x1c,
+	0x0a, 0x0a, 0x63, 0x5f, 0x61, 0x67, 0x77, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x63, 0x41, 0x67, 0x77, 0x54, 0x65, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x0a,
+	0x63, 0x5f, 0x70, 0x67, 0x77, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x63, 0x50, 0x67, 0x77, 0x54, 0x65, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x42,
+	0x65, 0x61, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x62, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x34, 0x0a, 0x03, 0x75, 0x6c, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x03, 0x75, 0x6c, 0x69, 0x12, 0x2d, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x52, 0x41, 0x54, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x72, 0x61, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0xb1, 0x02, 0x0a, 0x17, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50,
+	0x67, 0x77, 0x12, 0x1c, 0x0a, 0x0a, 0x63, 0x5f, 0x61, 0x67, 0x77, 0x5f, 0x74, 0x65, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x41, 0x67, 0x77, 0x54, 0x65, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x6d, 0x0a, 0x1e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x1c, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x67, 0x74, 0x70, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x47, 0x74, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x08, 0x67, 0x74, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb0, 0x01, 0x0a, 0x1e, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65, 0x61, 0x72,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73,
+	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x1c, 0x0a,
+	0x0a, 0x63, 0x5f, 0x61, 0x67, 0x77, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x63, 0x41, 0x67, 0x77, 0x54, 0x65, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x63,
+	0x5f, 0x70, 0x67, 0x77, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x63, 0x50, 0x67, 0x77, 0x54, 0x65, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x70, 0x73,
+	0x5f, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x0b, 0x65, 0x70, 0x73, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x49, 0x64, 0x22, 0x53, 0x0a,
+	0x1f, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x67, 0x77,
+	0x12, 0x30, 0x0a, 0x09, 0x67, 0x74, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e,
+	0x47, 0x74, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x08, 0x67, 0x74, 0x70, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xd7, 0x02, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x0a, 0x63, 0x5f, 0x61, 0x67, 0x77, 0x5f, 0x74, 0x65, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x41, 0x67, 0x77, 0x54, 0x65, 0x69, 0x64,
+	0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x2a, 0x0a, 0x08, 0x61, 0x70, 0x6e, 0x5f, 0x61, 0x6d, 0x62, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e,
+	0x41, 0x6d, 0x62, 0x72, 0x52, 0x07, 0x61, 0x70, 0x6e, 0x41, 0x6d, 0x62, 0x72, 0x12, 0x6d, 0x0a,
+	0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65,
+	0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x1c,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x82, 0x04, 0x0a,
+	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x67, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x67, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x67, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1c, 0x0a, 0x0a, 0x63, 0x5f, 0x70, 0x67, 0x77, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x50, 0x67, 0x77, 0x54, 0x65, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x63, 0x61, 0x75, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x6d, 0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x6e, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x6e, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x34, 0x0a, 0x03, 0x75, 0x6c, 0x69,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x75, 0x6c, 0x69, 0x12,
+	0x30, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e,
+	0x65, 0x22, 0x3d, 0x0a, 0x0b, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x6d, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6d, 0x73, 0x69,
+	0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x32, 0x0a, 0x08, 0x47, 0x74, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x61, 0x75,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x2a, 0x40, 0x0a, 0x07, 0x50, 0x44, 0x4e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49,
+	0x50, 0x56, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x02, 0x12,
+	0x0a, 0x0a, 0x06, 0x49, 0x50, 0x56, 0x34, 0x56, 0x36, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4e,
+	0x6f, 0x6e, 0x49, 0x50, 0x10, 0x05, 0x2a, 0x89, 0x01, 0x0a, 0x07, 0x52, 0x41, 0x54, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x55, 0x54, 0x52, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47,
+	0x45, 0x52, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x4c, 0x41, 0x4e, 0x10, 0x03,
+	0x12, 0x07, 0x0a, 0x03, 0x47, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x53, 0x50,
+	0x41, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x55, 0x54, 0x52, 0x41, 0x4e, 0x10, 0x06, 0x12,
+	0x0b, 0x0a, 0x07, 0x56, 0x49, 0x52, 0x54, 0x55, 0x41, 0x4c, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d,
+	0x45, 0x55, 0x54, 0x52, 0x41, 0x4e, 0x5f, 0x4e, 0x42, 0x5f, 0x49, 0x4f, 0x54, 0x10, 0x08, 0x12,
+	0x09, 0x0a, 0x05, 0x4c, 0x54, 0x45, 0x5f, 0x4d, 0x10, 0x09, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x52,
+	0x10, 0x0a, 0x2a, 0x8c, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x50, 0x4e, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00,
+	0x12, 0x24, 0x0a, 0x20, 0x6d, 0x73, 0x5f, 0x41, 0x50, 0x4e, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x41, 0x50, 0x4e, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10,
+	0x02, 0x32, 0xbd, 0x05, 0x0a, 0x07, 0x53, 0x38, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x5a, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22,
 	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50,
-	0x67, 0x77, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x50, 0x67, 0x77, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
-	0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x32, 0xa9, 0x01, 0x0a, 0x10, 0x53, 0x38, 0x50,
-	0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x46, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x21, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77,
-	0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77, 0x1a,
-	0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65,
-	0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x67, 0x77, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x50, 0x67, 0x77, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77, 0x1a, 0x23,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x50, 0x67, 0x77, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x63, 0x68,
+	0x6f, 0x12, 0x16, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x45, 0x63,
+	0x68, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
+	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77, 0x1a, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x67, 0x77, 0x22, 0x00, 0x12, 0x6f, 0x0a,
+	0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65,
+	0x67, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42,
+	0x65, 0x61, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77,
+	0x1a, 0x2a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x67, 0x77, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x67, 0x77, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x67, 0x77, 0x1a, 0x11, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x50, 0x67, 0x77, 0x1a, 0x11, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x00, 0x32, 0xf8, 0x01, 0x0a, 0x10, 0x53, 0x38, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
+	0x65, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4d,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65,
+	0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4d, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x67, 0x77, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f,
+	0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2322,34 +2997,40 @@ func file_feg_protos_s8_proxy_proto_rawDescGZIP() []byte {
 }
 
 var file_feg_protos_s8_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_feg_protos_s8_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_feg_protos_s8_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_feg_protos_s8_proxy_proto_goTypes = []interface{}{
-	(PDNType)(0),                         // 0: magma.feg.PDNType
-	(RATType)(0),                         // 1: magma.feg.RATType
-	(SelectionModeType)(0),               // 2: magma.feg.SelectionModeType
-	(*CreateSessionRequestPgw)(nil),      // 3: magma.feg.CreateSessionRequestPgw
-	(*UserLocationInformation)(nil),      // 4: magma.feg.UserLocationInformation
-	(*ServingNetwork)(nil),               // 5: magma.feg.ServingNetwork
-	(*ProtocolConfigurationOptions)(nil), // 6: magma.feg.ProtocolConfigurationOptions
-	(*PcoProtocolOrContainerId)(nil),     // 7: magma.feg.PcoProtocolOrContainerId
-	(*BearerContext)(nil),                // 8: magma.feg.BearerContext
-	(*QosInformation)(nil),               // 9: magma.feg.QosInformation
-	(*Ambr)(nil),                         // 10: magma.feg.Ambr
-	(*PdnAddressAllocation)(nil),         // 11: magma.feg.PdnAddressAllocation
-	(*TimeZone)(nil),                     // 12: magma.feg.TimeZone
-	(*Fteid)(nil),                        // 13: magma.feg.Fteid
-	(*CreateSessionResponsePgw)(nil),     // 14: magma.feg.CreateSessionResponsePgw
-	(*DeleteSessionRequestPgw)(nil),      // 15: magma.feg.DeleteSessionRequestPgw
-	(*DeleteSessionResponsePgw)(nil),     // 16: magma.feg.DeleteSessionResponsePgw
-	(*CreateBearerRequestPgw)(nil),       // 17: magma.feg.CreateBearerRequestPgw
-	(*CreateBearerResponsePgw)(nil),      // 18: magma.feg.CreateBearerResponsePgw
-	(*DeleteBearerRequestPgw)(nil),       // 19: magma.feg.DeleteBearerRequestPgw
-	(*DeleteBearerResponsePgw)(nil),      // 20: magma.feg.DeleteBearerResponsePgw
-	(*EchoRequest)(nil),                  // 21: magma.feg.EchoRequest
-	(*EchoResponse)(nil),                 // 22: magma.feg.EchoResponse
-	(*GtpError)(nil),                     // 23: magma.feg.GtpError
-	(*oai.TrafficFlowTemplate)(nil),      // 24: magma.lte.oai.TrafficFlowTemplate
-	(*protos.Void)(nil),                  // 25: magma.orc8r.Void
+	(PDNType)(0),                            // 0: magma.feg.PDNType
+	(RATType)(0),                            // 1: magma.feg.RATType
+	(SelectionModeType)(0),                  // 2: magma.feg.SelectionModeType
+	(*CreateSessionRequestPgw)(nil),         // 3: magma.feg.CreateSessionRequestPgw
+	(*UserLocationInformation)(nil),         // 4: magma.feg.UserLocationInformation
+	(*ServingNetwork)(nil),                  // 5: magma.feg.ServingNetwork
+	(*ProtocolConfigurationOptions)(nil),    // 6: magma.feg.ProtocolConfigurationOptions
+	(*PcoProtocolOrContainerId)(nil),        // 7: magma.feg.PcoProtocolOrContainerId
+	(*BearerContext)(nil),                   // 8: magma.feg.BearerContext
+	(*QosInformation)(nil),                  // 9: magma.feg.QosInformation
+	(*Ambr)(nil),                            // 10: magma.feg.Ambr
+	(*PdnAddressAllocation)(nil),            // 11: magma.feg.PdnAddressAllocation
+	(*TimeZone)(nil),                        // 12: magma.feg.TimeZone
+	(*Fteid)(nil),                           // 13: magma.feg.Fteid
+	(*CreateSessionResponsePgw)(nil),        // 14: magma.feg.CreateSessionResponsePgw
+	(*DeleteSessionRequestPgw)(nil),         // 15: magma.feg.DeleteSessionRequestPgw
+	(*DeleteSessionResponsePgw)(nil),        // 16: magma.feg.DeleteSessionResponsePgw
+	(*CreateBearerRequestPgw)(nil),          // 17: magma.feg.CreateBearerRequestPgw
+	(*CreateBearerResponsePgw)(nil),         // 18: magma.feg.CreateBearerResponsePgw
+	(*DeleteBearerRequestPgw)(nil),          // 19: magma.feg.DeleteBearerRequestPgw
+	(*DeleteBearerResponsePgw)(nil),         // 20: magma.feg.DeleteBearerResponsePgw
+	(*ModifyBearerRequestPgw)(nil),          // 21: magma.feg.ModifyBearerRequestPgw
+	(*ModifyBearerResponsePgw)(nil),         // 22: magma.feg.ModifyBearerResponsePgw
+	(*ReleaseAccessBearersRequestPgw)(nil),  // 23: magma.feg.ReleaseAccessBearersRequestPgw
+	(*ReleaseAccessBearersResponsePgw)(nil), // 24: magma.feg.ReleaseAccessBearersResponsePgw
+	(*UpdateBearerRequestPgw)(nil),          // 25: magma.feg.UpdateBearerRequestPgw
+	(*UpdateBearerResponsePgw)(nil),         // 26: magma.feg.UpdateBearerResponsePgw
+	(*EchoRequest)(nil),                     // 27: magma.feg.EchoRequest
+	(*EchoResponse)(nil),                    // 28: magma.feg.EchoResponse
+	(*GtpError)(nil),                        // 29: magma.feg.GtpError
+	(*oai.TrafficFlowTemplate)(nil),         // 30: magma.lte.oai.TrafficFlowTemplate
+	(*protos.Void)(nil),                     // 31: magma.orc8r.Void
 }
 var file_feg_protos_s8_proxy_proto_depIdxs = []int32{
 	5,  // 0: magma.feg.CreateSessionRequestPgw.serving_network:type_name -> magma.feg.ServingNetwork
@@ -2365,7 +3046,7 @@ var file_feg_protos_s8_proxy_proto_depIdxs = []int32{
 	7,  // 10: magma.feg.ProtocolConfigurationOptions.proto_or_container_id:type_name -> magma.feg.PcoProtocolOrContainerId
 	13, // 11: magma.feg.BearerContext.user_plane_fteid:type_name -> magma.feg.Fteid
 	9,  // 12: magma.feg.BearerContext.qos:type_name -> magma.feg.QosInformation
-	24, // 13: magma.feg.BearerContext.tft:type_name -> magma.lte.oai.TrafficFlowTemplate
+	30, // 13: magma.feg.BearerContext.tft:type_name -> magma.lte.oai.TrafficFlowTemplate
 	10, // 14: magma.feg.QosInformation.gbr:type_name -> magma.feg.Ambr
 	10, // 15: magma.feg.QosInformation.mbr:type_name -> magma.feg.Ambr
 	0,  // 16: magma.feg.CreateSessionResponsePgw.pdn_type:type_name -> magma.feg.PDNType
@@ -2373,10 +3054,10 @@ var file_feg_protos_s8_proxy_proto_depIdxs = []int32{
 	13, // 18: magma.feg.CreateSessionResponsePgw.c_pgw_fteid:type_name -> magma.feg.Fteid
 	8,  // 19: magma.feg.CreateSessionResponsePgw.bearer_context:type_name -> magma.feg.BearerContext
 	6,  // 20: magma.feg.CreateSessionResponsePgw.protocol_configuration_options:type_name -> magma.feg.ProtocolConfigurationOptions
-	23, // 21: magma.feg.CreateSessionResponsePgw.gtp_error:type_name -> magma.feg.GtpError
+	29, // 21: magma.feg.CreateSessionResponsePgw.gtp_error:type_name -> magma.feg.GtpError
 	5,  // 22: magma.feg.DeleteSessionRequestPgw.serving_network:type_name -> magma.feg.ServingNetwork
 	4,  // 23: magma.feg.DeleteSessionRequestPgw.uli:type_name -> magma.feg.UserLocationInformation
-	23, // 24: magma.feg.DeleteSessionResponsePgw.gtp_error:type_name -> magma.feg.GtpError
+	29, // 24: magma.feg.DeleteSessionResponsePgw.gtp_error:type_name -> magma.feg.GtpError
 	6,  // 25: magma.feg.CreateBearerRequestPgw.protocol_configuration_options:type_name -> magma.feg.ProtocolConfigurationOptions
 	8,  // 26: magma.feg.CreateBearerRequestPgw.bearer_context:type_name -> magma.feg.BearerContext
 	13, // 27: magma.feg.CreateBearerResponsePgw.u_pgw_fteid:type_name -> magma.feg.Fteid
@@ -2388,25 +3069,50 @@ var file_feg_protos_s8_proxy_proto_depIdxs = []int32{
 	6,  // 33: magma.feg.DeleteBearerRequestPgw.protocol_configuration_options:type_name -> magma.feg.ProtocolConfigurationOptions
 	8,  // 34: magma.feg.DeleteBearerResponsePgw.bearer_context:type_name -> magma.feg.BearerContext
 	6,  // 35: magma.feg.DeleteBearerResponsePgw.protocol_configuration_options:type_name -> magma.feg.ProtocolConfigurationOptions
-	3,  // 36: magma.feg.S8Proxy.CreateSession:input_type -> magma.feg.CreateSessionRequestPgw
-	15, // 37: magma.feg.S8Proxy.DeleteSession:input_type -> magma.feg.DeleteSessionRequestPgw
-	21, // 38: magma.feg.S8Proxy.SendEcho:input_type -> magma.feg.EchoRequest
-	18, // 39: magma.feg.S8Proxy.CreateBearerResponse:input_type -> magma.feg.CreateBearerResponsePgw
-	20, // 40: magma.feg.S8Proxy.DeleteBearerResponse:input_type -> magma.feg.DeleteBearerResponsePgw
-	17, // 41: magma.feg.S8ProxyResponder.CreateBearer:input_type -> magma.feg.CreateBearerRequestPgw
-	19, // 42: magma.feg.S8ProxyResponder.DeleteBearerRequest:input_type -> magma.feg.DeleteBearerRequestPgw
-	14, // 43: magma.feg.S8Proxy.CreateSession:output_type -> magma.feg.CreateSessionResponsePgw
-	16, // 44: magma.feg.S8Proxy.DeleteSession:output_type -> magma.feg.DeleteSessionResponsePgw
-	22, // 45: magma.feg.S8Proxy.SendEcho:output_type -> magma.feg.EchoResponse
-	25, // 46: magma.feg.S8Proxy.CreateBearerResponse:output_type -> magma.orc8r.Void
-	25, // 47: magma.feg.S8Proxy.DeleteBearerResponse:output_type -> magma.orc8r.Void
-	25, // 48: magma.feg.S8ProxyResponder.CreateBearer:output_type -> magma.orc8r.Void
-	25, // 49: magma.feg.S8ProxyResponder.DeleteBearerRequest:output_type -> magma.orc8r.Void
-	43, // [43:50] is the sub-list for method output_type
-	36, // [36:43] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	8,  // 36: magma.feg.ModifyBearerRequestPgw.bearer_context:type_name -> magma.feg.BearerContext
+	5,  // 37: magma.feg.ModifyBearerRequestPgw.serving_network:type_name -> magma.feg.ServingNetwork
+	4,  // 38: magma.feg.ModifyBearerRequestPgw.uli:type_name -> magma.feg.UserLocationInformation
+	1,  // 39: magma.feg.ModifyBearerRequestPgw.rat_type:type_name -> magma.feg.RATType
+	12, // 40: magma.feg.ModifyBearerRequestPgw.time_zone:type_name -> magma.feg.TimeZone
+	8,  // 41: magma.feg.ModifyBearerResponsePgw.bearer_context:type_name -> magma.feg.BearerContext
+	6,  // 42: magma.feg.ModifyBearerResponsePgw.protocol_configuration_options:type_name -> magma.feg.ProtocolConfigurationOptions
+	29, // 43: magma.feg.ModifyBearerResponsePgw.gtp_error:type_name -> magma.feg.GtpError
+	29, // 44: magma.feg.ReleaseAccessBearersResponsePgw.gtp_error:type_name -> magma.feg.GtpError
+	8,  // 45: magma.feg.UpdateBearerRequestPgw.bearer_context:type_name -> magma.feg.BearerContext
+	10, // 46: magma.feg.UpdateBearerRequestPgw.apn_ambr:type_name -> magma.feg.Ambr
+	6,  // 47: magma.feg.UpdateBearerRequestPgw.protocol_configuration_options:type_name -> magma.feg.ProtocolConfigurationOptions
+	8,  // 48: magma.feg.UpdateBearerResponsePgw.bearer_context:type_name -> magma.feg.BearerContext
+	6,  // 49: magma.feg.UpdateBearerResponsePgw.protocol_configuration_options:type_name -> magma.feg.ProtocolConfigurationOptions
+	5,  // 50: magma.feg.UpdateBearerResponsePgw.serving_network:type_name -> magma.feg.ServingNetwork
+	4,  // 51: magma.feg.UpdateBearerResponsePgw.uli:type_name -> magma.feg.UserLocationInformation
+	12, // 52: magma.feg.UpdateBearerResponsePgw.time_zone:type_name -> magma.feg.TimeZone
+	3,  // 53: magma.feg.S8Proxy.CreateSession:input_type -> magma.feg.CreateSessionRequestPgw
+	15, // 54: magma.feg.S8Proxy.DeleteSession:input_type -> magma.feg.DeleteSessionRequestPgw
+	27, // 55: magma.feg.S8Proxy.SendEcho:input_type -> magma.feg.EchoRequest
+	21, // 56: magma.feg.S8Proxy.ModifyBearer:input_type -> magma.feg.ModifyBearerRequestPgw
+	23, // 57: magma.feg.S8Proxy.ReleaseAccessBearers:input_type -> magma.feg.ReleaseAccessBearersRequestPgw
+	18, // 58: magma.feg.S8Proxy.CreateBearerResponse:input_type -> magma.feg.CreateBearerResponsePgw
+	20, // 59: magma.feg.S8Proxy.DeleteBearerResponse:input_type -> magma.feg.DeleteBearerResponsePgw
+	26, // 60: magma.feg.S8Proxy.UpdateBearerResponse:input_type -> magma.feg.UpdateBearerResponsePgw
+	17, // 61: magma.feg.S8ProxyResponder.CreateBearer:input_type -> magma.feg.CreateBearerRequestPgw
+	19, // 62: magma.feg.S8ProxyResponder.DeleteBearerRequest:input_type -> magma.feg.DeleteBearerRequestPgw
+	25, // 63: magma.feg.S8ProxyResponder.UpdateBearerRequest:input_type -> magma.feg.UpdateBearerRequestPgw
+	14, // 64: magma.feg.S8Proxy.CreateSession:output_type -> magma.feg.CreateSessionResponsePgw
+	16, // 65: magma.feg.S8Proxy.DeleteSession:output_type -> magma.feg.DeleteSessionResponsePgw
+	28, // 66: magma.feg.S8Proxy.SendEcho:output_type -> magma.feg.EchoResponse
+	22, // 67: magma.feg.S8Proxy.ModifyBearer:output_type -> magma.feg.ModifyBearerResponsePgw
+	24, // 68: magma.feg.S8Proxy.ReleaseAccessBearers:output_type -> magma.feg.ReleaseAccessBearersResponsePgw
+	31, // 69: magma.feg.S8Proxy.CreateBearerResponse:output_type -> magma.orc8r.Void
+	31, // 70: magma.feg.S8Proxy.DeleteBearerResponse:output_type -> magma.orc8r.Void
+	31, // 71: magma.feg.S8Proxy.UpdateBearerResponse:output_type -> magma.orc8r.Void
+	31, // 72: magma.feg.S8ProxyResponder.CreateBearer:output_type -> magma.orc8r.Void
+	31, // 73: magma.feg.S8ProxyResponder.DeleteBearerRequest:output_type -> magma.orc8r.Void
+	31, // 74: magma.feg.S8ProxyResponder.UpdateBearerRequest:output_type -> magma.orc8r.Void
+	64, // [64:75] is the sub-list for method output_type
+	53, // [53:64] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_feg_protos_s8_proxy_proto_init() }
@@ -2632,7 +3338,7 @@ func file_feg_protos_s8_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s8_proxy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoRequest); i {
+			switch v := v.(*ModifyBearerRequestPgw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2644,7 +3350,7 @@ func file_feg_protos_s8_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s8_proxy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EchoResponse); i {
+			switch v := v.(*ModifyBearerResponsePgw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2656,6 +3362,78 @@ func file_feg_protos_s8_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_s8_proxy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseAccessBearersRequestPgw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s8_proxy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleaseAccessBearersResponsePgw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s8_proxy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBearerRequestPgw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s8_proxy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBearerResponsePgw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s8_proxy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s8_proxy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_s8_proxy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GtpError); i {
 			case 0:
 				return &v.state
@@ -2674,7 +3452,7 @@ func file_feg_protos_s8_proxy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feg_protos_s8_proxy_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   21,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -2704,8 +3482,11 @@ type S8ProxyClient interface {
 	CreateSession(ctx context.Context, in *CreateSessionRequestPgw, opts ...grpc.CallOption) (*CreateSessionResponsePgw, error)
 	DeleteSession(ctx context.Context, in *DeleteSessionRequestPgw, opts ...grpc.CallOption) (*DeleteSessionResponsePgw, error)
 	SendEcho(ctx context.Context, in *EchoRequest, opts ...grpc.CallOption) (*EchoResponse, error)
+	ModifyBearer(ctx context.Context, in *ModifyBearerRequestPgw, opts ...grpc.CallOption) (*ModifyBearerResponsePgw, error)
+	ReleaseAccessBearers(ctx context.Context, in *ReleaseAccessBearersRequestPgw, opts ...grpc.CallOption) (*ReleaseAccessBearersResponsePgw, error)
 	CreateBearerResponse(ctx context.Context, in *CreateBearerResponsePgw, opts ...grpc.CallOption) (*protos.Void, error)
 	DeleteBearerResponse(ctx context.Context, in *DeleteBearerResponsePgw, opts ...grpc.CallOption) (*protos.Void, error)
+	UpdateBearerResponse(ctx context.Context, in *UpdateBearerResponsePgw, opts ...grpc.CallOption) (*protos.Void, error)
 }
 
 type s8ProxyClient struct {
@@ -2743,6 +3524,24 @@ func (c *s8ProxyClient) SendEcho(ctx context.Context, in *EchoRequest, opts ...g
 	return out, nil
 }
 
+func (c *s8ProxyClient) ModifyBearer(ctx context.Context, in *ModifyBearerRequestPgw, opts ...grpc.CallOption) (*ModifyBearerResponsePgw, error) {
+	out := new(ModifyBearerResponsePgw)
+	err := c.cc.Invoke(ctx, "/magma.feg.S8Proxy/ModifyBearer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *s8ProxyClient) ReleaseAccessBearers(ctx context.Context, in *ReleaseAccessBearersRequestPgw, opts ...grpc.CallOption) (*ReleaseAccessBearersResponsePgw, error) {
+	out := new(ReleaseAccessBearersResponsePgw)
+	err := c.cc.Invoke(ctx, "/magma.feg.S8Proxy/ReleaseAccessBearers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *s8ProxyClient) CreateBearerResponse(ctx context.Context, in *CreateBearerResponsePgw, opts ...grpc.CallOption) (*protos.Void, error) {
 	out := new(protos.Void)
 	err := c.cc.Invoke(ctx, "/magma.feg.S8Proxy/CreateBearerResponse", in, out, opts...)
@@ -2761,13 +3560,25 @@ func (c *s8ProxyClient) DeleteBearerResponse(ctx context.Context, in *DeleteBear
 	return out, nil
 }
 
+func (c *s8ProxyClient) UpdateBearerResponse(ctx context.Context, in *UpdateBearerResponsePgw, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.S8Proxy/UpdateBearerResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S8ProxyServer is the server API for S8Proxy service.
 type S8ProxyServer interface {
 	CreateSession(context.Context, *CreateSessionRequestPgw) (*CreateSessionResponsePgw, error)
 	DeleteSession(context.Context, *DeleteSessionRequestPgw) (*DeleteSessionResponsePgw, error)
 	SendEcho(context.Context, *EchoRequest) (*EchoResponse, error)
+	ModifyBearer(context.Context, *ModifyBearerRequestPgw) (*ModifyBearerResponsePgw, error)
+	ReleaseAccessBearers(context.Context, *ReleaseAccessBearersRequestPgw) (*ReleaseAccessBearersResponsePgw, error)
 	CreateBearerResponse(context.Context, *CreateBearerResponsePgw) (*protos.Void, error)
 	DeleteBearerResponse(context.Context, *DeleteBearerResponsePgw) (*protos.Void, error)
+	UpdateBearerResponse(context.Context, *UpdateBearerResponsePgw) (*protos.Void, error)
 }
 
 // UnimplementedS8ProxyServer can be embedded to have forward compatible implementations.
@@ -2783,12 +3594,21 @@ func (*UnimplementedS8ProxyServer) DeleteSession(context.Context, *DeleteSession
 func (*UnimplementedS8ProxyServer) SendEcho(context.Context, *EchoRequest) (*EchoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEcho not implemented")
 }
+func (*UnimplementedS8ProxyServer) ModifyBearer(context.Context, *ModifyBearerRequestPgw) (*ModifyBearerResponsePgw, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyBearer not implemented")
+}
+func (*UnimplementedS8ProxyServer) ReleaseAccessBearers(context.Context, *ReleaseAccessBearersRequestPgw) (*ReleaseAccessBearersResponsePgw, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseAccessBearers not implemented")
+}
 func (*UnimplementedS8ProxyServer) CreateBearerResponse(context.Context, *CreateBearerResponsePgw) (*protos.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBearerResponse not implemented")
 }
 func (*UnimplementedS8ProxyServer) DeleteBearerResponse(context.Context, *DeleteBearerResponsePgw) (*protos.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBearerResponse not implemented")
 }
+func (*UnimplementedS8ProxyServer) UpdateBearerResponse(context.Context, *UpdateBearerResponsePgw) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBearerResponse not implemented")
+}
 
 func RegisterS8ProxyServer(s *grpc.Server, srv S8ProxyServer) {
 	s.RegisterService(&_S8Proxy_serviceDesc, srv)
@@ -2848,6 +3668,42 @@ func _S8Proxy_SendEcho_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S8Proxy_ModifyBearer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyBearerRequestPgw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S8ProxyServer).ModifyBearer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S8Proxy/ModifyBearer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S8ProxyServer).ModifyBearer(ctx, req.(*ModifyBearerRequestPgw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _S8Proxy_ReleaseAccessBearers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAccessBearersRequestPgw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S8ProxyServer).ReleaseAccessBearers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S8Proxy/ReleaseAccessBearers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S8ProxyServer).ReleaseAccessBearers(ctx, req.(*ReleaseAccessBearersRequestPgw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _S8Proxy_CreateBearerResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBearerResponsePgw)
 	if err := dec(in); err != nil {
@@ -2884,6 +3740,24 @@ func _S8Proxy_DeleteBearerResponse_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S8Proxy_UpdateBearerResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBearerResponsePgw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S8ProxyServer).UpdateBearerResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S8Proxy/UpdateBearerResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S8ProxyServer).UpdateBearerResponse(ctx, req.(*UpdateBearerResponsePgw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S8Proxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S8Proxy",
 	HandlerType: (*S8ProxyServer)(nil),
@@ -2900,6 +3774,14 @@ var _S8Proxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendEcho",
 			Handler:    _S8Proxy_SendEcho_Handler,
 		},
+		{
+			MethodName: "ModifyBearer",
+			Handler:    _S8Proxy_ModifyBearer_Handler,
+		},
+		{
+			MethodName: "ReleaseAccessBearers",
+			Handler:    _S8Proxy_ReleaseAccessBearers_Handler,
+		},
 		{
 			MethodName: "CreateBearerResponse",
 			Handler:    _S8Proxy_CreateBearerResponse_Handler,
@@ -2908,6 +3790,10 @@ var _S8Proxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBearerResponse",
 			Handler:    _S8Proxy_DeleteBearerResponse_Handler,
 		},
+		{
+			MethodName: "UpdateBearerResponse",
+			Handler:    _S8Proxy_UpdateBearerResponse_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s8_proxy.proto",
@@ -2919,6 +3805,7 @@ var _S8Proxy_serviceDesc = grpc.ServiceDesc{
 type S8ProxyResponderClient interface {
 	CreateBearer(ctx context.Context, in *CreateBearerRequestPgw, opts ...grpc.CallOption) (*protos.Void, error)
 	DeleteBearerRequest(ctx context.Context, in *DeleteBearerRequestPgw, opts ...grpc.CallOption) (*protos.Void, error)
+	UpdateBearerRequest(ctx context.Context, in *UpdateBearerRequestPgw, opts ...grpc.CallOption) (*protos.Void, error)
 }
 
 type s8ProxyResponderClient struct {
@@ -2947,10 +3834,20 @@ func (c *s8ProxyResponderClient) DeleteBearerRequest(ctx context.Context, in *De
 	return out, nil
 }
 
+func (c *s8ProxyResponderClient) UpdateBearerRequest(ctx context.Context, in *UpdateBearerRequestPgw, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.S8ProxyResponder/UpdateBearerRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S8ProxyResponderServer is the server API for S8ProxyResponder service.
 type S8ProxyResponderServer interface {
 	CreateBearer(context.Context, *CreateBearerRequestPgw) (*protos.Void, error)
 	DeleteBearerRequest(context.Context, *DeleteBearerRequestPgw) (*protos.Void, error)
+	UpdateBearerRequest(context.Context, *UpdateBearerRequestPgw) (*protos.Void, error)
 }
 
 // UnimplementedS8ProxyResponderServer can be embedded to have forward compatible implementations.
@@ -2963,6 +3860,9 @@ func (*UnimplementedS8ProxyResponderServer) CreateBearer(context.Context, *Creat
 func (*UnimplementedS8ProxyResponderServer) DeleteBearerRequest(context.Context, *DeleteBearerRequestPgw) (*protos.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBearerRequest not implemented")
 }
+func (*UnimplementedS8ProxyResponderServer) UpdateBearerRequest(context.Context, *UpdateBearerRequestPgw) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBearerRequest not implemented")
+}
 
 func RegisterS8ProxyResponderServer(s *grpc.Server, srv S8ProxyResponderServer) {
 	s.RegisterService(&_S8ProxyResponder_serviceDesc, srv)
@@ -3004,6 +3904,24 @@ func _S8ProxyResponder_DeleteBearerRequest_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S8ProxyResponder_UpdateBearerRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBearerRequestPgw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S8ProxyResponderServer).UpdateBearerRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S8ProxyResponder/UpdateBearerRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S8ProxyResponderServer).UpdateBearerRequest(ctx, req.(*UpdateBearerRequestPgw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S8ProxyResponder_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S8ProxyResponder",
 	HandlerType: (*S8ProxyResponderServer)(nil),
@@ -3016,6 +3934,10 @@ var _S8ProxyResponder_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBearerRequest",
 			Handler:    _S8ProxyResponder_DeleteBearerRequest_Handler,
 		},
+		{
+			MethodName: "UpdateBearerRequest",
+			Handler:    _S8ProxyResponder_UpdateBearerRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s8_proxy.proto",
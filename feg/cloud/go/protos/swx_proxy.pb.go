@@ -205,6 +205,8 @@ type AuthenticationRequest struct {
 	ResyncInfo []byte `protobuf:"bytes,4,opt,name=resync_info,json=resyncInfo,proto3" json:"resync_info,omitempty"`
 	// Send an additional SAR message to the HSS to retrieve user profile params
 	RetrieveUserProfile bool `protobuf:"varint,5,opt,name=retrieve_user_profile,json=retrieveUserProfile,proto3" json:"retrieve_user_profile,omitempty"`
+	// Access Network Identity, mandatory for EAP-AKA': the HSS binds CK' & IK' to it (Section 5.2.3.7)
+	AccessNetworkIdentity string `protobuf:"bytes,6,opt,name=access_network_identity,json=accessNetworkIdentity,proto3" json:"access_network_identity,omitempty"`
 }
 
 func (x *AuthenticationRequest) Reset() {
@@ -274,6 +276,13 @@ func (x *AuthenticationRequest) GetRetrieveUserProfile() bool {
 	return false
 }
 
+func (x *AuthenticationRequest) GetAccessNetworkIdentity() string {
+	if x != nil {
+		return x.AccessNetworkIdentity
+	}
+	return ""
+}
+
 // MultimediaAuthenticationAnswer (Section 8.2.2.1)
 type AuthenticationAnswer struct {
 	state         protoimpl.MessageState
@@ -528,6 +537,120 @@ func (x *RegistrationTerminationRequest) GetSessionId() string {
 	return ""
 }
 
+// PushProfileRequest, see: http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.3
+type PushProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Updated user profile received from HSS
+	UserProfile *AuthenticationAnswer_UserProfile `protobuf:"bytes,2,opt,name=user_profile,json=userProfile,proto3" json:"user_profile,omitempty"`
+	SessionId   string                            `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *PushProfileRequest) Reset() {
+	*x = PushProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_swx_proxy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushProfileRequest) ProtoMessage() {}
+
+func (x *PushProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_swx_proxy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushProfileRequest.ProtoReflect.Descriptor instead.
+func (*PushProfileRequest) Descriptor() ([]byte, []int) {
+	return file_feg_protos_swx_proxy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PushProfileRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *PushProfileRequest) GetUserProfile() *AuthenticationAnswer_UserProfile {
+	if x != nil {
+		return x.UserProfile
+	}
+	return nil
+}
+
+func (x *PushProfileRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// PushProfileAnswer, see: http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.3
+type PushProfileAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *PushProfileAnswer) Reset() {
+	*x = PushProfileAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_swx_proxy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushProfileAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushProfileAnswer) ProtoMessage() {}
+
+func (x *PushProfileAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_swx_proxy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushProfileAnswer.ProtoReflect.Descriptor instead.
+func (*PushProfileAnswer) Descriptor() ([]byte, []int) {
+	return file_feg_protos_swx_proxy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PushProfileAnswer) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
 // Only for EAP-AKA/EAP-AKA'
 type AuthenticationAnswer_SIPAuthVector struct {
 	state         protoimpl.MessageState
@@ -549,7 +672,7 @@ type AuthenticationAnswer_SIPAuthVector struct {
 func (x *AuthenticationAnswer_SIPAuthVector) Reset() {
 	*x = AuthenticationAnswer_SIPAuthVector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_swx_proxy_proto_msgTypes[5]
+		mi := &file_feg_protos_swx_proxy_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -562,7 +685,7 @@ func (x *AuthenticationAnswer_SIPAuthVector) String() string {
 func (*AuthenticationAnswer_SIPAuthVector) ProtoMessage() {}
 
 func (x *AuthenticationAnswer_SIPAuthVector) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_swx_proxy_proto_msgTypes[5]
+	mi := &file_feg_protos_swx_proxy_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,12 +743,19 @@ type AuthenticationAnswer_UserProfile struct {
 
 	// MSISDN from HSS
 	Msisdn string `protobuf:"bytes,1,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	// Non-3GPP-IP-Access, true if non 3GPP access is barred for the user
+	Non_3GppIpAccessBarred bool `protobuf:"varint,2,opt,name=non_3gpp_ip_access_barred,json=non3gppIpAccessBarred,proto3" json:"non_3gpp_ip_access_barred,omitempty"`
+	// Non-3GPP-IP-Access-APN, true if all non 3GPP APNs are disabled for the user
+	Non_3GppIpAccessApnDisabled bool `protobuf:"varint,3,opt,name=non_3gpp_ip_access_apn_disabled,json=non3gppIpAccessApnDisabled,proto3" json:"non_3gpp_ip_access_apn_disabled,omitempty"`
+	// Subscribed UE AMBR
+	Ambr              *AuthenticationAnswer_UserProfile_AMBR               `protobuf:"bytes,4,opt,name=ambr,proto3" json:"ambr,omitempty"`
+	ApnConfigurations []*AuthenticationAnswer_UserProfile_APNConfiguration `protobuf:"bytes,5,rep,name=apn_configurations,json=apnConfigurations,proto3" json:"apn_configurations,omitempty"`
 }
 
 func (x *AuthenticationAnswer_UserProfile) Reset() {
 	*x = AuthenticationAnswer_UserProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_feg_protos_swx_proxy_proto_msgTypes[6]
+		mi := &file_feg_protos_swx_proxy_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -638,7 +768,7 @@ func (x *AuthenticationAnswer_UserProfile) String() string {
 func (*AuthenticationAnswer_UserProfile) ProtoMessage() {}
 
 func (x *AuthenticationAnswer_UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_feg_protos_swx_proxy_proto_msgTypes[6]
+	mi := &file_feg_protos_swx_proxy_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -661,12 +791,168 @@ func (x *AuthenticationAnswer_UserProfile) GetMsisdn() string {
 	return ""
 }
 
+func (x *AuthenticationAnswer_UserProfile) GetNon_3GppIpAccessBarred() bool {
+	if x != nil {
+		return x.Non_3GppIpAccessBarred
+	}
+	return false
+}
+
+func (x *AuthenticationAnswer_UserProfile) GetNon_3GppIpAccessApnDisabled() bool {
+	if x != nil {
+		return x.Non_3GppIpAccessApnDisabled
+	}
+	return false
+}
+
+func (x *AuthenticationAnswer_UserProfile) GetAmbr() *AuthenticationAnswer_UserProfile_AMBR {
+	if x != nil {
+		return x.Ambr
+	}
+	return nil
+}
+
+func (x *AuthenticationAnswer_UserProfile) GetApnConfigurations() []*AuthenticationAnswer_UserProfile_APNConfiguration {
+	if x != nil {
+		return x.ApnConfigurations
+	}
+	return nil
+}
+
+type AuthenticationAnswer_UserProfile_AMBR struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxBandwidthUl uint32 `protobuf:"varint,1,opt,name=max_bandwidth_ul,json=maxBandwidthUl,proto3" json:"max_bandwidth_ul,omitempty"`
+	MaxBandwidthDl uint32 `protobuf:"varint,2,opt,name=max_bandwidth_dl,json=maxBandwidthDl,proto3" json:"max_bandwidth_dl,omitempty"`
+}
+
+func (x *AuthenticationAnswer_UserProfile_AMBR) Reset() {
+	*x = AuthenticationAnswer_UserProfile_AMBR{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_swx_proxy_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticationAnswer_UserProfile_AMBR) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticationAnswer_UserProfile_AMBR) ProtoMessage() {}
+
+func (x *AuthenticationAnswer_UserProfile_AMBR) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_swx_proxy_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticationAnswer_UserProfile_AMBR.ProtoReflect.Descriptor instead.
+func (*AuthenticationAnswer_UserProfile_AMBR) Descriptor() ([]byte, []int) {
+	return file_feg_protos_swx_proxy_proto_rawDescGZIP(), []int{1, 1, 0}
+}
+
+func (x *AuthenticationAnswer_UserProfile_AMBR) GetMaxBandwidthUl() uint32 {
+	if x != nil {
+		return x.MaxBandwidthUl
+	}
+	return 0
+}
+
+func (x *AuthenticationAnswer_UserProfile_AMBR) GetMaxBandwidthDl() uint32 {
+	if x != nil {
+		return x.MaxBandwidthDl
+	}
+	return 0
+}
+
+type AuthenticationAnswer_UserProfile_APNConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextId uint32 `protobuf:"varint,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	// Service-Selection (APN name)
+	ServiceSelection string `protobuf:"bytes,2,opt,name=service_selection,json=serviceSelection,proto3" json:"service_selection,omitempty"`
+	PdnType          int32  `protobuf:"varint,3,opt,name=pdn_type,json=pdnType,proto3" json:"pdn_type,omitempty"`
+	// Subscribed APN AMBR
+	Ambr *AuthenticationAnswer_UserProfile_AMBR `protobuf:"bytes,4,opt,name=ambr,proto3" json:"ambr,omitempty"`
+}
+
+func (x *AuthenticationAnswer_UserProfile_APNConfiguration) Reset() {
+	*x = AuthenticationAnswer_UserProfile_APNConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feg_protos_swx_proxy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthenticationAnswer_UserProfile_APNConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticationAnswer_UserProfile_APNConfiguration) ProtoMessage() {}
+
+func (x *AuthenticationAnswer_UserProfile_APNConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_feg_protos_swx_proxy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticationAnswer_UserProfile_APNConfiguration.ProtoReflect.Descriptor instead.
+func (*AuthenticationAnswer_UserProfile_APNConfiguration) Descriptor() ([]byte, []int) {
+	return file_feg_protos_swx_proxy_proto_rawDescGZIP(), []int{1, 1, 1}
+}
+
+func (x *AuthenticationAnswer_UserProfile_APNConfiguration) GetContextId() uint32 {
+	if x != nil {
+		return x.ContextId
+	}
+	return 0
+}
+
+func (x *AuthenticationAnswer_UserProfile_APNConfiguration) GetServiceSelection() string {
+	if x != nil {
+		return x.ServiceSelection
+	}
+	return ""
+}
+
+func (x *AuthenticationAnswer_UserProfile_APNConfiguration) GetPdnType() int32 {
+	if x != nil {
+		return x.PdnType
+	}
+	return 0
+}
+
+func (x *AuthenticationAnswer_UserProfile_APNConfiguration) GetAmbr() *AuthenticationAnswer_UserProfile_AMBR {
+	if x != nil {
+		return x.Ambr
+	}
+	return nil
+}
+
 var File_feg_protos_swx_proxy_proto protoreflect.FileDescriptor
 
 var file_feg_protos_swx_proxy_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x66, 0x65, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x77, 0x78,
 	0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x22, 0x90, 0x02, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68,
+	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x22, 0xc8, 0x02, 0x0a, 0x15, 0x41, 0x75, 0x74, 0x68,
 	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f,
@@ -683,69 +969,123 @@ var file_feg_protos_swx_proxy_proto_rawDesc = []byte{
 	0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
 	0x76, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
 	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x91, 0x04, 0x0a, 0x14, 0x41,
+	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0xe2, 0x08, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x73, 0x69, 0x70, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41,
 	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x57, 0x0a, 0x10, 0x73, 0x69, 0x70, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x76, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x53, 0x49, 0x50, 0x41,
-	0x75, 0x74, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0e, 0x73, 0x69, 0x70, 0x41, 0x75,
-	0x74, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x77, 0x65, 0x72, 0x2e, 0x53, 0x49, 0x50, 0x41, 0x75, 0x74, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x0e, 0x73, 0x69, 0x70, 0x41, 0x75, 0x74, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x1a, 0xec, 0x01, 0x0a, 0x0d, 0x53, 0x49, 0x50, 0x41, 0x75, 0x74, 0x68, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x54, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x64,
+	0x5f, 0x61, 0x75, 0x74, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x6e,
+	0x64, 0x41, 0x75, 0x74, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x78, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x78, 0x72, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x1a,
+	0xf5, 0x04, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x12, 0x38, 0x0a, 0x19, 0x6e, 0x6f, 0x6e, 0x5f, 0x33,
+	0x67, 0x70, 0x70, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x62, 0x61,
+	0x72, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6e, 0x6f, 0x6e, 0x33,
+	0x67, 0x70, 0x70, 0x49, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x42, 0x61, 0x72, 0x72, 0x65,
+	0x64, 0x12, 0x43, 0x0a, 0x1f, 0x6e, 0x6f, 0x6e, 0x5f, 0x33, 0x67, 0x70, 0x70, 0x5f, 0x69, 0x70,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x70, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x6e, 0x6f, 0x6e, 0x33,
+	0x67, 0x70, 0x70, 0x49, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x70, 0x6e, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x04, 0x61, 0x6d, 0x62, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x41, 0x4d, 0x42, 0x52, 0x52, 0x04, 0x61, 0x6d, 0x62, 0x72, 0x12, 0x6b, 0x0a, 0x12,
+	0x61, 0x70, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x50, 0x4e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x61, 0x70, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x5a, 0x0a, 0x04, 0x41, 0x4d, 0x42,
+	0x52, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x5f, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78,
+	0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6d,
+	0x61, 0x78, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x64, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x44, 0x6c, 0x1a, 0xbf, 0x01, 0x0a, 0x10, 0x41, 0x50, 0x4e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x64, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x64, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x44, 0x0a, 0x04, 0x61, 0x6d, 0x62, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68,
 	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0b, 0x75, 0x73,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0xec, 0x01, 0x0a, 0x0d, 0x53, 0x49, 0x50,
-	0x41, 0x75, 0x74, 0x68, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x54, 0x0a, 0x15, 0x61, 0x75,
-	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x14, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x75, 0x74, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x64, 0x41, 0x75, 0x74, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x78, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x78, 0x72, 0x65,
-	0x73, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4b,
-	0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x69, 0x74, 0x79, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x25, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x22, 0x51,
-	0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x22, 0x33, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xbe, 0x02, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x55, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x68, 0x0a,
-	0x0a, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x50,
-	0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x45, 0x57, 0x5f, 0x53, 0x45,
-	0x52, 0x56, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10,
-	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e,
-	0x47, 0x45, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x53,
-	0x5f, 0x43, 0x53, 0x43, 0x46, 0x10, 0x03, 0x2a, 0x69, 0x0a, 0x0c, 0x53, 0x77, 0x78, 0x45, 0x72,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x41, 0x4d, 0x42,
+	0x52, 0x52, 0x04, 0x61, 0x6d, 0x62, 0x72, 0x22, 0x51, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x12, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0xbe, 0x02, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x55, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x4e, 0x45, 0x57, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x41, 0x53,
+	0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45,
+	0x52, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12, 0x11, 0x0a,
+	0x0d, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x53, 0x5f, 0x43, 0x53, 0x43, 0x46, 0x10, 0x03,
+	0x22, 0xa0, 0x01, 0x0a, 0x12, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x2a, 0x69, 0x0a, 0x0c, 0x53, 0x77, 0x78, 0x45, 0x72,
 	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x52, 0x52, 0x4f, 0x52,
 	0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1b,
 	0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
@@ -771,17 +1111,22 @@ var file_feg_protos_swx_proxy_proto_rawDesc = []byte{
 	0x66, 0x65, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
 	0x66, 0x65, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x32, 0x78, 0x0a, 0x11, 0x53, 0x77, 0x78, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63, 0x0a,
-	0x15, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66,
-	0x65, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
-	0x22, 0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x00, 0x32, 0xc6, 0x01, 0x0a, 0x11, 0x53, 0x77, 0x78,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x63,
+	0x0a, 0x15, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x66, 0x65, 0x67, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50,
+	0x75, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x66, 0x65, 0x67, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22,
+	0x00, 0x42, 0x1b, 0x5a, 0x19, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -797,38 +1142,48 @@ func file_feg_protos_swx_proxy_proto_rawDescGZIP() []byte {
 }
 
 var file_feg_protos_swx_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_feg_protos_swx_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_feg_protos_swx_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_feg_protos_swx_proxy_proto_goTypes = []interface{}{
-	(SwxErrorCode)(0),                              // 0: magma.feg.SwxErrorCode
-	(AuthenticationScheme)(0),                      // 1: magma.feg.AuthenticationScheme
-	(RegistrationTerminationRequest_ReasonCode)(0), // 2: magma.feg.RegistrationTerminationRequest.ReasonCode
-	(*AuthenticationRequest)(nil),                  // 3: magma.feg.AuthenticationRequest
-	(*AuthenticationAnswer)(nil),                   // 4: magma.feg.AuthenticationAnswer
-	(*RegistrationRequest)(nil),                    // 5: magma.feg.RegistrationRequest
-	(*RegistrationAnswer)(nil),                     // 6: magma.feg.RegistrationAnswer
-	(*RegistrationTerminationRequest)(nil),         // 7: magma.feg.RegistrationTerminationRequest
-	(*AuthenticationAnswer_SIPAuthVector)(nil),     // 8: magma.feg.AuthenticationAnswer.SIPAuthVector
-	(*AuthenticationAnswer_UserProfile)(nil),       // 9: magma.feg.AuthenticationAnswer.UserProfile
+	(SwxErrorCode)(0),                                         // 0: magma.feg.SwxErrorCode
+	(AuthenticationScheme)(0),                                 // 1: magma.feg.AuthenticationScheme
+	(RegistrationTerminationRequest_ReasonCode)(0),            // 2: magma.feg.RegistrationTerminationRequest.ReasonCode
+	(*AuthenticationRequest)(nil),                             // 3: magma.feg.AuthenticationRequest
+	(*AuthenticationAnswer)(nil),                              // 4: magma.feg.AuthenticationAnswer
+	(*RegistrationRequest)(nil),                               // 5: magma.feg.RegistrationRequest
+	(*RegistrationAnswer)(nil),                                // 6: magma.feg.RegistrationAnswer
+	(*RegistrationTerminationRequest)(nil),                    // 7: magma.feg.RegistrationTerminationRequest
+	(*PushProfileRequest)(nil),                                // 8: magma.feg.PushProfileRequest
+	(*PushProfileAnswer)(nil),                                 // 9: magma.feg.PushProfileAnswer
+	(*AuthenticationAnswer_SIPAuthVector)(nil),                // 10: magma.feg.AuthenticationAnswer.SIPAuthVector
+	(*AuthenticationAnswer_UserProfile)(nil),                  // 11: magma.feg.AuthenticationAnswer.UserProfile
+	(*AuthenticationAnswer_UserProfile_AMBR)(nil),             // 12: magma.feg.AuthenticationAnswer.UserProfile.AMBR
+	(*AuthenticationAnswer_UserProfile_APNConfiguration)(nil), // 13: magma.feg.AuthenticationAnswer.UserProfile.APNConfiguration
 }
 var file_feg_protos_swx_proxy_proto_depIdxs = []int32{
-	1, // 0: magma.feg.AuthenticationRequest.authentication_scheme:type_name -> magma.feg.AuthenticationScheme
-	8, // 1: magma.feg.AuthenticationAnswer.sip_auth_vectors:type_name -> magma.feg.AuthenticationAnswer.SIPAuthVector
-	9, // 2: magma.feg.AuthenticationAnswer.user_profile:type_name -> magma.feg.AuthenticationAnswer.UserProfile
-	2, // 3: magma.feg.RegistrationTerminationRequest.reason_code:type_name -> magma.feg.RegistrationTerminationRequest.ReasonCode
-	1, // 4: magma.feg.AuthenticationAnswer.SIPAuthVector.authentication_scheme:type_name -> magma.feg.AuthenticationScheme
-	3, // 5: magma.feg.SwxProxy.Authenticate:input_type -> magma.feg.AuthenticationRequest
-	5, // 6: magma.feg.SwxProxy.Register:input_type -> magma.feg.RegistrationRequest
-	5, // 7: magma.feg.SwxProxy.Deregister:input_type -> magma.feg.RegistrationRequest
-	7, // 8: magma.feg.SwxGatewayService.TerminateRegistration:input_type -> magma.feg.RegistrationTerminationRequest
-	4, // 9: magma.feg.SwxProxy.Authenticate:output_type -> magma.feg.AuthenticationAnswer
-	6, // 10: magma.feg.SwxProxy.Register:output_type -> magma.feg.RegistrationAnswer
-	6, // 11: magma.feg.SwxProxy.Deregister:output_type -> magma.feg.RegistrationAnswer
-	6, // 12: magma.feg.SwxGatewayService.TerminateRegistration:output_type -> magma.feg.RegistrationAnswer
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1,  // 0: magma.feg.AuthenticationRequest.authentication_scheme:type_name -> magma.feg.AuthenticationScheme
+	10, // 1: magma.feg.AuthenticationAnswer.sip_auth_vectors:type_name -> magma.feg.AuthenticationAnswer.SIPAuthVector
+	11, // 2: magma.feg.AuthenticationAnswer.user_profile:type_name -> magma.feg.AuthenticationAnswer.UserProfile
+	2,  // 3: magma.feg.RegistrationTerminationRequest.reason_code:type_name -> magma.feg.RegistrationTerminationRequest.ReasonCode
+	11, // 4: magma.feg.PushProfileRequest.user_profile:type_name -> magma.feg.AuthenticationAnswer.UserProfile
+	1,  // 5: magma.feg.AuthenticationAnswer.SIPAuthVector.authentication_scheme:type_name -> magma.feg.AuthenticationScheme
+	12, // 6: magma.feg.AuthenticationAnswer.UserProfile.ambr:type_name -> magma.feg.AuthenticationAnswer.UserProfile.AMBR
+	13, // 7: magma.feg.AuthenticationAnswer.UserProfile.apn_configurations:type_name -> magma.feg.AuthenticationAnswer.UserProfile.APNConfiguration
+	12, // 8: magma.feg.AuthenticationAnswer.UserProfile.APNConfiguration.ambr:type_name -> magma.feg.AuthenticationAnswer.UserProfile.AMBR
+	3,  // 9: magma.feg.SwxProxy.Authenticate:input_type -> magma.feg.AuthenticationRequest
+	5,  // 10: magma.feg.SwxProxy.Register:input_type -> magma.feg.RegistrationRequest
+	5,  // 11: magma.feg.SwxProxy.Deregister:input_type -> magma.feg.RegistrationRequest
+	7,  // 12: magma.feg.SwxGatewayService.TerminateRegistration:input_type -> magma.feg.RegistrationTerminationRequest
+	8,  // 13: magma.feg.SwxGatewayService.PushProfile:input_type -> magma.feg.PushProfileRequest
+	4,  // 14: magma.feg.SwxProxy.Authenticate:output_type -> magma.feg.AuthenticationAnswer
+	6,  // 15: magma.feg.SwxProxy.Register:output_type -> magma.feg.RegistrationAnswer
+	6,  // 16: magma.feg.SwxProxy.Deregister:output_type -> magma.feg.RegistrationAnswer
+	6,  // 17: magma.feg.SwxGatewayService.TerminateRegistration:output_type -> magma.feg.RegistrationAnswer
+	9,  // 18: magma.feg.SwxGatewayService.PushProfile:output_type -> magma.feg.PushProfileAnswer
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_feg_protos_swx_proxy_proto_init() }
@@ -898,7 +1253,7 @@ func file_feg_protos_swx_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_swx_proxy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticationAnswer_SIPAuthVector); i {
+			switch v := v.(*PushProfileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -910,6 +1265,30 @@ func file_feg_protos_swx_proxy_proto_init() {
 			}
 		}
 		file_feg_protos_swx_proxy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushProfileAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_swx_proxy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationAnswer_SIPAuthVector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_swx_proxy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AuthenticationAnswer_UserProfile); i {
 			case 0:
 				return &v.state
@@ -921,6 +1300,30 @@ func file_feg_protos_swx_proxy_proto_init() {
 				return nil
 			}
 		}
+		file_feg_protos_swx_proxy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationAnswer_UserProfile_AMBR); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feg_protos_swx_proxy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthenticationAnswer_UserProfile_APNConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -928,7 +1331,7 @@ func file_feg_protos_swx_proxy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feg_protos_swx_proxy_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   7,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -1108,6 +1511,9 @@ type SwxGatewayServiceClient interface {
 	// TerminateRegistration - handler of SWx Registration-Termination,
 	// see: http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.4
 	TerminateRegistration(ctx context.Context, in *RegistrationTerminationRequest, opts ...grpc.CallOption) (*RegistrationAnswer, error)
+	// PushProfile - handler of SWx Push-Profile (HSS initiated update of user profile),
+	// see: http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.3
+	PushProfile(ctx context.Context, in *PushProfileRequest, opts ...grpc.CallOption) (*PushProfileAnswer, error)
 }
 
 type swxGatewayServiceClient struct {
@@ -1127,11 +1533,23 @@ func (c *swxGatewayServiceClient) TerminateRegistration(ctx context.Context, in
 	return out, nil
 }
 
+func (c *swxGatewayServiceClient) PushProfile(ctx context.Context, in *PushProfileRequest, opts ...grpc.CallOption) (*PushProfileAnswer, error) {
+	out := new(PushProfileAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.SwxGatewayService/PushProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SwxGatewayServiceServer is the server API for SwxGatewayService service.
 type SwxGatewayServiceServer interface {
 	// TerminateRegistration - handler of SWx Registration-Termination,
 	// see: http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.4
 	TerminateRegistration(context.Context, *RegistrationTerminationRequest) (*RegistrationAnswer, error)
+	// PushProfile - handler of SWx Push-Profile (HSS initiated update of user profile),
+	// see: http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.3
+	PushProfile(context.Context, *PushProfileRequest) (*PushProfileAnswer, error)
 }
 
 // UnimplementedSwxGatewayServiceServer can be embedded to have forward compatible implementations.
@@ -1141,6 +1559,9 @@ type UnimplementedSwxGatewayServiceServer struct {
 func (*UnimplementedSwxGatewayServiceServer) TerminateRegistration(context.Context, *RegistrationTerminationRequest) (*RegistrationAnswer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateRegistration not implemented")
 }
+func (*UnimplementedSwxGatewayServiceServer) PushProfile(context.Context, *PushProfileRequest) (*PushProfileAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushProfile not implemented")
+}
 
 func RegisterSwxGatewayServiceServer(s *grpc.Server, srv SwxGatewayServiceServer) {
 	s.RegisterService(&_SwxGatewayService_serviceDesc, srv)
@@ -1164,6 +1585,24 @@ func _SwxGatewayService_TerminateRegistration_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SwxGatewayService_PushProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwxGatewayServiceServer).PushProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.SwxGatewayService/PushProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwxGatewayServiceServer).PushProfile(ctx, req.(*PushProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SwxGatewayService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.SwxGatewayService",
 	HandlerType: (*SwxGatewayServiceServer)(nil),
@@ -1172,6 +1611,10 @@ var _SwxGatewayService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TerminateRegistration",
 			Handler:    _SwxGatewayService_TerminateRegistration_Handler,
 		},
+		{
+			MethodName: "PushProfile",
+			Handler:    _SwxGatewayService_PushProfile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/swx_proxy.proto",
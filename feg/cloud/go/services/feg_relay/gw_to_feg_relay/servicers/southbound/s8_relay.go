@@ -92,6 +92,25 @@ func (s S8RelayRouter) SendEcho(c context.Context, req *protos.EchoRequest) (*pr
 	return client.SendEcho(ctx, req)
 }
 
+func (s S8RelayRouter) ModifyBearer(c context.Context, req *protos.ModifyBearerRequestPgw) (*protos.ModifyBearerResponsePgw, error) {
+	client, ctx, cancel, err := s.getS8Client(c, req.GetImsi())
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	return client.ModifyBearer(ctx, req)
+}
+
+func (s S8RelayRouter) ReleaseAccessBearers(
+	c context.Context, req *protos.ReleaseAccessBearersRequestPgw) (*protos.ReleaseAccessBearersResponsePgw, error) {
+	client, ctx, cancel, err := s.getS8Client(c, req.GetImsi())
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	return client.ReleaseAccessBearers(ctx, req)
+}
+
 func (s S8RelayRouter) CreateBearerResponse(c context.Context, req *protos.CreateBearerResponsePgw) (*orc8r_protos.Void, error) {
 	client, ctx, cancel, err := s.getS8Client(c, req.GetImsi())
 	if err != nil {
@@ -110,6 +129,15 @@ func (s S8RelayRouter) DeleteBearerResponse(c context.Context, req *protos.Delet
 	return client.DeleteBearerResponse(ctx, req)
 }
 
+func (s S8RelayRouter) UpdateBearerResponse(c context.Context, req *protos.UpdateBearerResponsePgw) (*orc8r_protos.Void, error) {
+	client, ctx, cancel, err := s.getS8Client(c, req.GetImsi())
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	return client.UpdateBearerResponse(ctx, req)
+}
+
 func (s S8RelayRouter) getS8Client(c context.Context, imsi string) (protos.S8ProxyClient, context.Context, context.CancelFunc, error) {
 
 	conn, ctx, cancel, err := s.GetFegServiceConnection(c, imsi, FegS8Proxy)
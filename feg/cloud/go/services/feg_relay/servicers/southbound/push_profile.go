@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"magma/feg/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
+)
+
+// PushProfile relays the PushProfileRequest sent from HSS->FeG->Access Gateway
+func (srv *FegToGwRelayServer) PushProfile(
+	ctx context.Context, req *protos.PushProfileRequest) (*protos.PushProfileAnswer, error) {
+
+	if err := validateFegContext(ctx); err != nil {
+		return nil, err
+	}
+	return srv.PushProfileUnverified(ctx, req)
+}
+
+// PushProfileUnverified relays the PushProfileRequest sent from HSS->FeG->Access Gateway
+// without FeG Identity verification
+func (srv *FegToGwRelayServer) PushProfileUnverified(
+	ctx context.Context, req *protos.PushProfileRequest) (*protos.PushProfileAnswer, error) {
+
+	hwId, err := getHwIDFromIMSI(ctx, req.UserName)
+	if err != nil {
+		errmsg := fmt.Errorf("unable to get HwID from IMSI %v. err: %v", req.GetUserName(), err)
+		log.Print(errmsg)
+		return &protos.PushProfileAnswer{}, errmsg
+	}
+	conn, ctx, err := gateway_registry.GetGatewayConnection(gateway_registry.GwAAAService, hwId)
+	if err != nil {
+		errmsg := fmt.Errorf("unable to connect to GW %s to push profile for IMSI: %s. err: %v",
+			hwId, req.GetUserName(), err)
+		log.Print(errmsg)
+		return &protos.PushProfileAnswer{}, errmsg
+	}
+	client := protos.NewSwxGatewayServiceClient(conn)
+	return client.PushProfile(ctx, req)
+}
@@ -81,6 +81,28 @@ func (srv *FegToGwRelayServer) DeleteBearerRequest(
 	return client.DeleteBearerRequest(ctx, req)
 }
 
+// UpdateBearerRequest relays the UpdateBearerRequest from S8_proxy to a corresponding
+// dispatcher service instance, who will in turn relay the request to the
+// corresponding AGW gateway
+func (srv *FegToGwRelayServer) UpdateBearerRequest(
+	ctx context.Context,
+	req *fegprotos.UpdateBearerRequestPgw,
+) (*orc8r_protos.Void, error) {
+	if req == nil {
+		err := fmt.Errorf("unable to send UpdateBearerPGW, request is nil: ")
+		glog.Error(err)
+		return nil, err
+	}
+	teid := fmt.Sprint(req.CAgwTeid)
+	client, ctx, err := getS8ProxyResponderClient(ctx, teid)
+	if err != nil {
+		err = fmt.Errorf("unable to get S8ProxyResponderClient: %s", err)
+		glog.Error(err)
+		return nil, err
+	}
+	return client.UpdateBearerRequest(ctx, req)
+}
+
 func getS8ProxyResponderClient(ctx context.Context, teid string) (
 	fegprotos.S8ProxyResponderClient, context.Context, error) {
 	if err := validateFegContext(ctx); err != nil {
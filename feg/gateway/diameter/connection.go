@@ -239,6 +239,33 @@ func (c *Connection) cleanupConnection(disabled bool) {
 	}
 }
 
+// peerIdentity returns destination host & realm requests sent over the connection
+// to the given server will reach. Configured server values take precedence over
+// the identity learned from the peer during capabilities exchange
+func (c *Connection) peerIdentity(server *DiameterServerConfig) (host, realm string) {
+	if server == nil {
+		server = c.server
+	}
+	if server != nil {
+		host, realm = server.DestHost, server.DestRealm
+	}
+	if len(host) > 0 && len(realm) > 0 {
+		return
+	}
+	c.mutex.Lock()
+	metadata := c.metadata
+	c.mutex.Unlock()
+	if metadata != nil {
+		if len(host) == 0 {
+			host = string(metadata.OriginHost)
+		}
+		if len(realm) == 0 {
+			realm = string(metadata.OriginRealm)
+		}
+	}
+	return
+}
+
 func connAddrStr(conn diam.Conn) string {
 	if conn == nil {
 		return "<nil>"
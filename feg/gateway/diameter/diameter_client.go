@@ -49,6 +49,7 @@ type Client struct {
 	smClient       *sm.Client
 	connMan        *ConnectionManager
 	requestTracker *RequestTracker
+	overload       *OverloadControl
 	cfg            *DiameterClientConfig
 	originStateID  uint32
 }
@@ -173,6 +174,7 @@ func NewClient(clientCfg *DiameterClientConfig, localAddresses ...string) *Clien
 		smClient:       cli,
 		connMan:        NewConnectionManager(),
 		requestTracker: NewRequestTracker(),
+		overload:       NewOverloadControl(),
 		cfg:            clientCfg,
 		originStateID:  originStateID,
 	}
@@ -283,25 +285,39 @@ func (client *Client) DisableConnectionCreation(period time.Duration) {
 //   - message -- request to send
 //   - key     -- something to uniquely identify the request
 //
-// Output: error if message sending failed, nil otherwise
+// Output: error if message sending failed or the request was dropped due to
+// the destination's overload (ErrOverloadThrottled), nil otherwise
 func (client *Client) SendRequest(
 	server *DiameterServerConfig,
 	done chan interface{},
 	message *diam.Message,
 	key interface{},
 ) error {
-	client.requestTracker.RegisterRequest(key, done)
 	conn, err := client.connMan.GetConnection(client.smClient, server)
-	if err == nil {
-		m := client.AddOriginAVPsToMessage(message)
-		err = conn.SendRequestToServer(m, client.cfg.RetryCount, server)
-		if err != nil {
-			client.requestTracker.DeregisterRequest(key)
-		}
+	if err != nil {
+		return err
+	}
+	err = client.overload.AbateRequest(conn, server)
+	if err != nil {
+		return err
+	}
+	client.requestTracker.RegisterRequest(key, done)
+	m := client.overload.AddSupportedFeaturesToMessage(client.AddOriginAVPsToMessage(message))
+	err = conn.SendRequestToServer(m, client.cfg.RetryCount, server)
+	if err != nil {
+		client.requestTracker.DeregisterRequest(key)
 	}
 	return err
 }
 
+// ActiveOverloadReports returns DOIC overload reports currently in effect for the client's peers
+func (client *Client) ActiveOverloadReports() []OverloadReport {
+	if client == nil {
+		return nil
+	}
+	return client.overload.ActiveOverloadReports()
+}
+
 // AddOriginAVPsToMessage adds the host/realm to the message
 func (client *Client) AddOriginAVPsToMessage(message *diam.Message) *diam.Message {
 	message.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(client.cfg.Host))
@@ -339,6 +355,7 @@ func (client *Client) RegisterAnswerHandlerForAppID(command uint32, appID uint32
 			glog.Error("nil diameter message")
 			return
 		}
+		client.overload.HandleAnswer(m)
 		answerKey := handler(m)
 		if answerKey.Key == nil {
 			glog.Errorf("nil Key found in received diameter message:\n%s\n", m.String())
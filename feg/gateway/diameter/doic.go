@@ -0,0 +1,336 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diameter
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Diameter Overload Indication Conveyance (DOIC) definitions, see https://tools.ietf.org/html/rfc7683
+const (
+	// OLRDefaultAlgorithm is the OC-Feature-Vector bit of the loss abatement algorithm
+	OLRDefaultAlgorithm = uint64(1)
+
+	OCHostReport  = uint32(0) // OC-Report-Type HOST_REPORT
+	OCRealmReport = uint32(1) // OC-Report-Type REALM_REPORT
+
+	// DefaultOCValidityDurationSeconds is used when OC-OLR carries no OC-Validity-Duration
+	DefaultOCValidityDurationSeconds = 30
+	// MaxOCValidityDurationSeconds is the maximum OC-Validity-Duration allowed by RFC 7683
+	MaxOCValidityDurationSeconds = 86400
+)
+
+// ErrOverloadThrottled is returned when a request is dropped by the loss abatement
+// algorithm because of an active overload report of its destination
+var ErrOverloadThrottled = errors.New("request dropped due to diameter peer overload")
+
+var (
+	overloadReductionPercentage = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "diameter_overload_reduction_percentage",
+			Help: "Traffic reduction percentage requested by an active DOIC overload report",
+		},
+		[]string{"report_type", "reporting_node"},
+	)
+	overloadReports = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "diameter_overload_reports_total",
+			Help: "Total number of new DOIC overload reports received",
+		},
+		[]string{"report_type", "reporting_node"},
+	)
+	overloadThrottledRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "diameter_overload_throttled_requests_total",
+			Help: "Total number of diameter requests dropped due to DOIC overload abatement",
+		},
+		[]string{"report_type", "reporting_node"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(overloadReductionPercentage, overloadReports, overloadThrottledRequests)
+}
+
+// OCSupportedFeatures -> OC-Supported-Features AVP
+type OCSupportedFeatures struct {
+	OCFeatureVector uint64 `avp:"OC-Feature-Vector"`
+}
+
+// OCOLR -> OC-OLR AVP
+type OCOLR struct {
+	OCSequenceNumber      uint64  `avp:"OC-Sequence-Number"`
+	OCReportType          uint32  `avp:"OC-Report-Type"`
+	OCReductionPercentage uint32  `avp:"OC-Reduction-Percentage"`
+	OCValidityDuration    *uint32 `avp:"OC-Validity-Duration"`
+}
+
+// overloadAnswerAVPs holds DOIC related AVPs of a received answer
+type overloadAnswerAVPs struct {
+	OriginHost          datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm         datatype.DiameterIdentity `avp:"Origin-Realm"`
+	OCSupportedFeatures OCSupportedFeatures       `avp:"OC-Supported-Features"`
+	OCOLR               OCOLR                     `avp:"OC-OLR"`
+}
+
+// OverloadReport is an active overload report received from a reporting node
+type OverloadReport struct {
+	ReportType          uint32
+	ReportingNode       string // Origin-Host for host reports, Origin-Realm for realm reports
+	SequenceNumber      uint64
+	ReductionPercentage uint32
+	Expires             time.Time
+
+	timer *time.Timer
+}
+
+// String stringifies the overload report
+func (r OverloadReport) String() string {
+	return fmt.Sprintf("%s %s: reduction %d%%, seq %d, expires %s",
+		reportTypeName(r.ReportType), r.ReportingNode, r.ReductionPercentage, r.SequenceNumber,
+		r.Expires.Format(time.RFC3339))
+}
+
+// OverloadReporter is implemented by diameter clients which track DOIC overload reports
+type OverloadReporter interface {
+	// ActiveOverloadReports returns all overload reports which are currently in effect
+	ActiveOverloadReports() []OverloadReport
+}
+
+type overloadReportKey struct {
+	reportType    uint32
+	reportingNode string
+}
+
+// OverloadControl implements the reacting node side of DOIC (RFC 7683) with the
+// loss abatement algorithm. It advertises DOIC support in outgoing requests,
+// tracks OC-OLR reports received in answers and decides which requests need to
+// be dropped to honor the requested traffic reduction
+type OverloadControl struct {
+	reports map[overloadReportKey]*OverloadReport
+	mutex   sync.Mutex
+	random  func() float64
+}
+
+// NewOverloadControl returns a new OverloadControl with no active overload reports
+func NewOverloadControl() *OverloadControl {
+	return &OverloadControl{reports: map[overloadReportKey]*OverloadReport{}, random: rand.Float64}
+}
+
+// AddSupportedFeaturesToMessage adds OC-Supported-Features AVP advertising the
+// loss abatement algorithm to the request unless it is already present
+func (oc *OverloadControl) AddSupportedFeaturesToMessage(message *diam.Message) *diam.Message {
+	if oc == nil || message == nil {
+		return message
+	}
+	if a, err := message.FindAVP(avp.OCSupportedFeatures, 0); err == nil && a != nil {
+		return message
+	}
+	message.NewAVP(avp.OCSupportedFeatures, 0, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.OCFeatureVector, 0, 0, datatype.Unsigned64(OLRDefaultAlgorithm)),
+		},
+	})
+	return message
+}
+
+// HandleAnswer parses OC-Supported-Features & OC-OLR AVPs of the received answer and
+// updates overload reports accordingly. Reports from nodes which didn't select
+// the loss abatement algorithm, and reports with stale sequence numbers are ignored
+func (oc *OverloadControl) HandleAnswer(message *diam.Message) {
+	if oc == nil || message == nil {
+		return
+	}
+	if _, err := message.FindAVP(avp.OCSupportedFeatures, 0); err != nil {
+		return // reporting node doesn't support DOIC
+	}
+	if _, err := message.FindAVP(avp.OCOLR, 0); err != nil {
+		return
+	}
+	var answer overloadAnswerAVPs
+	if err := message.Unmarshal(&answer); err != nil {
+		glog.Errorf("failed to unmarshal DOIC AVPs: %v", err)
+		return
+	}
+	if answer.OCSupportedFeatures.OCFeatureVector&OLRDefaultAlgorithm == 0 {
+		glog.Warningf("ignoring OC-OLR from %s: unsupported OC-Feature-Vector %d",
+			answer.OriginHost, answer.OCSupportedFeatures.OCFeatureVector)
+		return
+	}
+	olr := answer.OCOLR
+	key := overloadReportKey{reportType: olr.OCReportType}
+	switch olr.OCReportType {
+	case OCHostReport:
+		key.reportingNode = string(answer.OriginHost)
+	case OCRealmReport:
+		key.reportingNode = string(answer.OriginRealm)
+	default:
+		glog.Warningf("ignoring OC-OLR from %s: unknown OC-Report-Type %d", answer.OriginHost, olr.OCReportType)
+		return
+	}
+	oc.updateReport(key, olr)
+}
+
+func (oc *OverloadControl) updateReport(key overloadReportKey, olr OCOLR) {
+	// an explicit OC-Validity-Duration of 0 ends the overload condition
+	validity := uint32(DefaultOCValidityDurationSeconds)
+	if olr.OCValidityDuration != nil {
+		validity = *olr.OCValidityDuration
+	}
+	if validity > MaxOCValidityDurationSeconds {
+		validity = MaxOCValidityDurationSeconds
+	}
+	reduction := olr.OCReductionPercentage
+	if reduction > 100 {
+		reduction = 100
+	}
+	oc.mutex.Lock()
+	defer oc.mutex.Unlock()
+
+	existing, found := oc.reports[key]
+	if found {
+		if olr.OCSequenceNumber <= existing.SequenceNumber {
+			return // stale or already applied report
+		}
+		existing.timer.Stop()
+		delete(oc.reports, key)
+	}
+	if validity == 0 || reduction == 0 {
+		// overload condition ended
+		if found {
+			glog.Infof("DOIC %s %s overload ended", reportTypeName(key.reportType), key.reportingNode)
+			overloadReductionPercentage.DeleteLabelValues(reportTypeName(key.reportType), key.reportingNode)
+		}
+		return
+	}
+	report := &OverloadReport{
+		ReportType:          key.reportType,
+		ReportingNode:       key.reportingNode,
+		SequenceNumber:      olr.OCSequenceNumber,
+		ReductionPercentage: reduction,
+		Expires:             time.Now().Add(time.Duration(validity) * time.Second),
+	}
+	report.timer = time.AfterFunc(time.Duration(validity)*time.Second, func() { oc.expireReport(key, report) })
+	oc.reports[key] = report
+	glog.Warningf("DOIC overload report: %s", report)
+	overloadReports.WithLabelValues(reportTypeName(key.reportType), key.reportingNode).Inc()
+	overloadReductionPercentage.WithLabelValues(
+		reportTypeName(key.reportType), key.reportingNode).Set(float64(reduction))
+}
+
+func (oc *OverloadControl) expireReport(key overloadReportKey, report *OverloadReport) {
+	oc.mutex.Lock()
+	defer oc.mutex.Unlock()
+	if oc.reports[key] != report {
+		return // report was replaced
+	}
+	delete(oc.reports, key)
+	glog.Infof("DOIC overload report expired: %s", report)
+	overloadReductionPercentage.DeleteLabelValues(reportTypeName(key.reportType), key.reportingNode)
+}
+
+// Abate applies the loss abatement algorithm to a request destined to the given
+// host & realm. Host reports apply to all requests reaching the reporting host,
+// realm reports apply only to realm routed requests (requests without Destination-Host).
+// Abate returns ErrOverloadThrottled if the request must be dropped
+func (oc *OverloadControl) Abate(destHost, destRealm string, hostRouted bool) error {
+	if oc == nil {
+		return nil
+	}
+	oc.mutex.Lock()
+	defer oc.mutex.Unlock()
+	if len(oc.reports) == 0 {
+		return nil
+	}
+	report, found := oc.reports[overloadReportKey{reportType: OCHostReport, reportingNode: destHost}]
+	if !found && !hostRouted {
+		report, found = oc.reports[overloadReportKey{reportType: OCRealmReport, reportingNode: destRealm}]
+	}
+	if !found || time.Now().After(report.Expires) {
+		return nil
+	}
+	if report.ReductionPercentage >= 100 || oc.random()*100 < float64(report.ReductionPercentage) {
+		overloadThrottledRequests.WithLabelValues(reportTypeName(report.ReportType), report.ReportingNode).Inc()
+		return ErrOverloadThrottled
+	}
+	return nil
+}
+
+// AbateRequest applies the loss abatement algorithm to a request to be sent over
+// the given connection to the given server
+func (oc *OverloadControl) AbateRequest(conn *Connection, server *DiameterServerConfig) error {
+	if oc == nil || conn == nil {
+		return nil
+	}
+	destHost, destRealm := conn.peerIdentity(server)
+	return oc.Abate(destHost, destRealm, server == nil || !server.DisableDestHost)
+}
+
+// ActiveOverloadReports returns copies of all overload reports currently in effect
+func (oc *OverloadControl) ActiveOverloadReports() []OverloadReport {
+	if oc == nil {
+		return nil
+	}
+	oc.mutex.Lock()
+	defer oc.mutex.Unlock()
+	now := time.Now()
+	var res []OverloadReport
+	for _, r := range oc.reports {
+		if now.Before(r.Expires) {
+			report := *r
+			report.timer = nil
+			res = append(res, report)
+		}
+	}
+	return res
+}
+
+// OverloadHealthMessage returns a health message describing given overload reports
+// and true if any of the reports requests a complete (100%) traffic reduction
+func OverloadHealthMessage(peerName string, reports []OverloadReport) (string, bool) {
+	if len(reports) == 0 {
+		return "", false
+	}
+	blocked := false
+	descr := make([]string, 0, len(reports))
+	for _, r := range reports {
+		if r.ReductionPercentage >= 100 {
+			blocked = true
+		}
+		descr = append(descr, r.String())
+	}
+	return fmt.Sprintf("%s overloaded; %s", peerName, strings.Join(descr, "; ")), blocked
+}
+
+func reportTypeName(reportType uint32) string {
+	switch reportType {
+	case OCHostReport:
+		return "HOST_REPORT"
+	case OCRealmReport:
+		return "REALM_REPORT"
+	}
+	return strconv.FormatUint(uint64(reportType), 10)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diameter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/diameter"
+)
+
+const (
+	testPeerHost  = "pcrf.magma.com"
+	testPeerRealm = "magma.com"
+)
+
+func newAnswerWithOLR(seq uint64, reportType, reduction, validity uint32, withSupportedFeatures bool) *diam.Message {
+	return newAnswerWithOptionalValidity(seq, reportType, reduction, &validity, withSupportedFeatures)
+}
+
+func newAnswerWithOptionalValidity(seq uint64, reportType, reduction uint32, validity *uint32, withSupportedFeatures bool) *diam.Message {
+	m := diam.NewMessage(diam.CreditControl, 0, diam.GX_CHARGING_CONTROL_APP_ID, 1, 1, dict.Default)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(testPeerHost))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(testPeerRealm))
+	if withSupportedFeatures {
+		m.NewAVP(avp.OCSupportedFeatures, 0, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.OCFeatureVector, 0, 0, datatype.Unsigned64(diameter.OLRDefaultAlgorithm)),
+			},
+		})
+	}
+	olr := []*diam.AVP{
+		diam.NewAVP(avp.OCSequenceNumber, 0, 0, datatype.Unsigned64(seq)),
+		diam.NewAVP(avp.OCReportType, 0, 0, datatype.Enumerated(reportType)),
+		diam.NewAVP(avp.OCReductionPercentage, 0, 0, datatype.Unsigned32(reduction)),
+	}
+	if validity != nil {
+		olr = append(olr, diam.NewAVP(avp.OCValidityDuration, 0, 0, datatype.Unsigned32(*validity)))
+	}
+	m.NewAVP(avp.OCOLR, 0, 0, &diam.GroupedAVP{AVP: olr})
+	return m
+}
+
+func TestOverloadControl_SupportedFeatures(t *testing.T) {
+	oc := diameter.NewOverloadControl()
+	m := diam.NewRequest(diam.CreditControl, diam.GX_CHARGING_CONTROL_APP_ID, dict.Default)
+	oc.AddSupportedFeaturesToMessage(m)
+	oc.AddSupportedFeaturesToMessage(m) // must not be added twice
+
+	var parsed struct {
+		OCSupportedFeatures []diameter.OCSupportedFeatures `avp:"OC-Supported-Features"`
+	}
+	assert.NoError(t, m.Unmarshal(&parsed))
+	assert.Len(t, parsed.OCSupportedFeatures, 1)
+	assert.Equal(t, diameter.OLRDefaultAlgorithm, parsed.OCSupportedFeatures[0].OCFeatureVector)
+}
+
+func TestOverloadControl_HostReport(t *testing.T) {
+	oc := diameter.NewOverloadControl()
+	assert.NoError(t, oc.Abate(testPeerHost, testPeerRealm, true))
+
+	// OLR without OC-Supported-Features must be ignored
+	oc.HandleAnswer(newAnswerWithOLR(1, diameter.OCHostReport, 100, 10, false))
+	assert.Empty(t, oc.ActiveOverloadReports())
+	assert.NoError(t, oc.Abate(testPeerHost, testPeerRealm, true))
+
+	oc.HandleAnswer(newAnswerWithOLR(1, diameter.OCHostReport, 100, 10, true))
+	reports := oc.ActiveOverloadReports()
+	assert.Len(t, reports, 1)
+	assert.Equal(t, testPeerHost, reports[0].ReportingNode)
+	assert.Equal(t, uint32(100), reports[0].ReductionPercentage)
+	assert.Equal(t, diameter.ErrOverloadThrottled, oc.Abate(testPeerHost, testPeerRealm, true))
+	assert.Equal(t, diameter.ErrOverloadThrottled, oc.Abate(testPeerHost, testPeerRealm, false))
+	assert.NoError(t, oc.Abate("ocs.magma.com", testPeerRealm, true))
+
+	msg, blocked := diameter.OverloadHealthMessage("PCRF", reports)
+	assert.True(t, blocked)
+	assert.Contains(t, msg, testPeerHost)
+
+	// stale sequence number must be ignored
+	oc.HandleAnswer(newAnswerWithOLR(1, diameter.OCHostReport, 0, 0, true))
+	assert.Len(t, oc.ActiveOverloadReports(), 1)
+
+	// zero validity duration ends the overload
+	oc.HandleAnswer(newAnswerWithOLR(2, diameter.OCHostReport, 0, 0, true))
+	assert.Empty(t, oc.ActiveOverloadReports())
+	assert.NoError(t, oc.Abate(testPeerHost, testPeerRealm, true))
+}
+
+func TestOverloadControl_RealmReport(t *testing.T) {
+	oc := diameter.NewOverloadControl()
+	// explicit zero validity duration doesn't start an overload
+	oc.HandleAnswer(newAnswerWithOLR(4, diameter.OCRealmReport, 100, 0, true))
+	assert.Empty(t, oc.ActiveOverloadReports())
+
+	// default validity duration is used when OC-Validity-Duration is missing
+	oc.HandleAnswer(newAnswerWithOptionalValidity(5, diameter.OCRealmReport, 100, nil, true))
+	reports := oc.ActiveOverloadReports()
+	assert.Len(t, reports, 1)
+	assert.Equal(t, testPeerRealm, reports[0].ReportingNode)
+	assert.WithinDuration(t,
+		time.Now().Add(diameter.DefaultOCValidityDurationSeconds*time.Second), reports[0].Expires, 5*time.Second)
+
+	// realm reports apply to realm routed requests only
+	assert.NoError(t, oc.Abate(testPeerHost, testPeerRealm, true))
+	assert.Equal(t, diameter.ErrOverloadThrottled, oc.Abate(testPeerHost, testPeerRealm, false))
+}
+
+func TestOverloadControl_LossAbatement(t *testing.T) {
+	oc := diameter.NewOverloadControl()
+	oc.HandleAnswer(newAnswerWithOLR(1, diameter.OCHostReport, 50, 10, true))
+	msg, blocked := diameter.OverloadHealthMessage("PCRF", oc.ActiveOverloadReports())
+	assert.False(t, blocked)
+	assert.NotEmpty(t, msg)
+
+	const total = 2000
+	dropped := 0
+	for i := 0; i < total; i++ {
+		if oc.Abate(testPeerHost, testPeerRealm, true) != nil {
+			dropped++
+		}
+	}
+	assert.InDelta(t, total/2, dropped, total/10)
+}
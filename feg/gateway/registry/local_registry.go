@@ -35,12 +35,15 @@ const (
 	HEALTH           = "HEALTH"
 	CSFB             = "CSFB"
 	N7_N40_PROXY     = "N7_N40_PROXY"
+	RX_PROXY         = "RX_PROXY"
+	N12_N8_PROXY     = "N12_N8_PROXY"
 	FEG_HELLO        = "FEG_HELLO"
 	AAA_SERVER       = "AAA_SERVER"
 	ENVOY_CONTROLLER = "ENVOY_CONTROLLER"
 	EAP              = "EAP"
 	EAP_SIM          = "EAP_SIM"
 	EAP_AKA          = "EAP_AKA"
+	EAP_AKA_PRIME    = "EAP_AKA_PRIME"
 	RADIUSD          = "RADIUSD"
 	RADIUS           = "RADIUS"
 	REDIS            = "REDIS"
@@ -96,12 +99,15 @@ func init() {
 	addLocalService(AAA_SERVER, 9109)
 	addLocalService(EAP_SIM, 9118)
 	addLocalService(EAP_AKA, 9123)
+	addLocalService(EAP_AKA_PRIME, 9124)
 	addLocalService(SWX_PROXY, 9110)
 	addLocalService(RADIUSD, 9115)
 	addLocalService(HLR_PROXY, 9116)
 	addLocalService(PIPELINED, 9117)
 	addLocalService(ENVOY_CONTROLLER, 9118)
 	addLocalService(N7_N40_PROXY, 9119)
+	addLocalService(RX_PROXY, 9120)
+	addLocalService(N12_N8_PROXY, 9121)
 
 	addLocalService(MOCK_OCS, 9201)
 	addLocalService(MOCK_PCRF, 9202)
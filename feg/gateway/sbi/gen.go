@@ -26,7 +26,8 @@ limitations under the License.
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29509NausfSoRProtection/TS29509NausfSoRProtection.gen.go --import-mapping TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29509_Nausf_SoRProtection.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29509NausfUPUProtection/TS29509NausfUPUProtection.gen.go --import-mapping TS29509_Nausf_SoRProtection.yaml:magma/feg/gateway/sbi/specs/TS29509NausfSoRProtection,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29509_Nausf_UPUProtection.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29503NudmSDM/TS29503NudmSDM.gen.go --import-mapping TS29510_Nnrf_NFManagement.yaml:magma/feg/gateway/sbi/specs/TS29510NnrfNFManagement,TS29509_Nausf_UPUProtection.yaml:magma/feg/gateway/sbi/specs/TS29509NausfUPUProtection,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData,TS29509_Nausf_SoRProtection.yaml:magma/feg/gateway/sbi/specs/TS29509NausfSoRProtection defs/TS29503_Nudm_SDM.yaml
-//go:generate oapi-codegen -generate types,skip-prune -o specs/TS29503NudmUECM/TS29503NudmUECM.gen.go --import-mapping TS29510_Nnrf_NFManagement.yaml:magma/feg/gateway/sbi/specs/TS29510NnrfNFManagement,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29503_Nudm_UECM.yaml
+//go:generate oapi-codegen -generate types,skip-prune,client -o specs/TS29503NudmUECM/TS29503NudmUECM.gen.go --import-mapping TS29510_Nnrf_NFManagement.yaml:magma/feg/gateway/sbi/specs/TS29510NnrfNFManagement,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29503_Nudm_UECM.yaml
+//go:generate oapi-codegen -generate types,skip-prune,server -o specs/TS29503NudmUECMServer/TS29503NudmUECMServer.gen.go --import-mapping TS29510_Nnrf_NFManagement.yaml:magma/feg/gateway/sbi/specs/TS29510NnrfNFManagement,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData -package=TS29503NudmUECMServer defs/TS29503_Nudm_UECM.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29503NudmEE/TS29503NudmEE.gen.go --import-mapping TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29503_Nudm_EE.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29503NudmUEAU/TS29503NudmUEAU.gen.go --import-mapping TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29503_Nudm_UEAU.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29505SubscriptionData/TS29505SubscriptionData.gen.go --import-mapping TS29503_Nudm_SDM.yaml:magma/feg/gateway/sbi/specs/TS29503NudmSDM,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData,TS29503_Nudm_PP.yaml:magma/feg/gateway/sbi/specs/TS29503NudmPP,TS29503_Nudm_UECM.yaml:magma/feg/gateway/sbi/specs/TS29503NudmUECM,TS29509_Nausf_UPUProtection.yaml:magma/feg/gateway/sbi/specs/TS29509NausfUPUProtection,TS29509_Nausf_SoRProtection.yaml:magma/feg/gateway/sbi/specs/TS29509NausfSoRProtection,TS29503_Nudm_EE.yaml:magma/feg/gateway/sbi/specs/TS29503NudmEE,TS29503_Nudm_UEAU.yaml:magma/feg/gateway/sbi/specs/TS29503NudmUEAU defs/TS29505_Subscription_Data.yaml
@@ -39,7 +40,8 @@ limitations under the License.
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29122PfdManagement/TS29122PfdManagement.gen.go --import-mapping TS29122_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29122CommonData,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29122_PfdManagement.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29122AsSessionWithQoS/TS29122AsSessionWithQoS.gen.go --import-mapping TS29122_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29122CommonData,TS29514_Npcf_PolicyAuthorization.yaml:magma/feg/gateway/sbi/specs/TS29514NpcfPolicyAuthorization,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29122_AsSessionWithQoS.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29531NnssfNSSAIAvailability/TS29531NnssfNSSAIAvailability.gen.go --import-mapping TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29531_Nnssf_NSSAIAvailability.yaml
-//go:generate oapi-codegen -generate types,skip-prune -o specs/TS29509NausfUEAuthentication/TS29509NausfUEAuthentication.gen.go --import-mapping TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData,TS29503_Nudm_UEAU.yaml:magma/feg/gateway/sbi/specs/TS29503NudmUEAU defs/TS29509_Nausf_UEAuthentication.yaml
+//go:generate oapi-codegen -generate types,skip-prune,client -o specs/TS29509NausfUEAuthentication/TS29509NausfUEAuthentication.gen.go --import-mapping TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData,TS29503_Nudm_UEAU.yaml:magma/feg/gateway/sbi/specs/TS29503NudmUEAU defs/TS29509_Nausf_UEAuthentication.yaml
+//go:generate oapi-codegen -generate types,skip-prune,server -o specs/TS29509NausfUEAuthenticationServer/TS29509NausfUEAuthenticationServer.gen.go --import-mapping TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData,TS29503_Nudm_UEAU.yaml:magma/feg/gateway/sbi/specs/TS29503NudmUEAU -package=TS29509NausfUEAuthenticationServer defs/TS29509_Nausf_UEAuthentication.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29518NamfEventExposure/TS29518NamfEventExposure.gen.go --import-mapping TS29503_Nudm_EE.yaml:magma/feg/gateway/sbi/specs/TS29503NudmEE,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29518_Namf_EventExposure.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29531NnssfNSSelection/TS29531NnssfNSSelection.gen.go --import-mapping TS29510_Nnrf_NFManagement.yaml:magma/feg/gateway/sbi/specs/TS29510NnrfNFManagement,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData defs/TS29531_Nnssf_NSSelection.yaml
 //go:generate oapi-codegen -generate types,skip-prune -o specs/TS29518NamfCommunication/TS29518NamfCommunication.gen.go --import-mapping TS29572_Nlmf_Location.yaml:magma/feg/gateway/sbi/specs/TS29572NlmfLocation,TS29502_Nsmf_PDUSession.yaml:magma/feg/gateway/sbi/specs/TS29502NsmfPDUSession,TS29571_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29571CommonData,TS29122_CommonData.yaml:magma/feg/gateway/sbi/specs/TS29122CommonData,TS29518_Namf_EventExposure.yaml:magma/feg/gateway/sbi/specs/TS29518NamfEventExposure,TS29531_Nnssf_NSSelection.yaml:magma/feg/gateway/sbi/specs/TS29531NnssfNSSelection defs/TS29518_Namf_Communication.yaml
@@ -4,11 +4,20 @@
 package TS29503NudmUECM
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
 
 	externalRef0 "magma/feg/gateway/sbi/specs/TS29510NnrfNFManagement"
 	externalRef1 "magma/feg/gateway/sbi/specs/TS29571CommonData"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
 const (
@@ -304,3 +313,1915 @@ func (a Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws) Ma
 	}
 	return json.Marshal(object)
 }
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// Get3GppRegistration request
+	Get3GppRegistration(ctx context.Context, ueId externalRef1.VarUeId, params *Get3GppRegistrationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Update3GppRegistration request with any body
+	Update3GppRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TGppRegistration request with any body
+	TGppRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TGppRegistration(ctx context.Context, ueId externalRef1.Supi, body TGppRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNon3GppRegistration request
+	GetNon3GppRegistration(ctx context.Context, ueId externalRef1.VarUeId, params *GetNon3GppRegistrationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNon3GppRegistration request with any body
+	UpdateNon3GppRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Non3GppRegistration request with any body
+	Non3GppRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Non3GppRegistration(ctx context.Context, ueId externalRef1.Supi, body Non3GppRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SmfDeregistration request
+	SmfDeregistration(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Registration request with any body
+	RegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Registration(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, body RegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TGppSmsfDeregistration request
+	TGppSmsfDeregistration(ctx context.Context, ueId externalRef1.Supi, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Get3GppSmsfRegistration request
+	Get3GppSmsfRegistration(ctx context.Context, ueId externalRef1.Gpsi, params *Get3GppSmsfRegistrationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TGppSmsfRegistration request with any body
+	TGppSmsfRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TGppSmsfRegistration(ctx context.Context, ueId externalRef1.Supi, body TGppSmsfRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Non3GppSmsfDeregistration request
+	Non3GppSmsfDeregistration(ctx context.Context, ueId externalRef1.Supi, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNon3GppSmsfRegistration request
+	GetNon3GppSmsfRegistration(ctx context.Context, ueId externalRef1.Gpsi, params *GetNon3GppSmsfRegistrationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Non3GppSmsfRegistration request with any body
+	Non3GppSmsfRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Non3GppSmsfRegistration(ctx context.Context, ueId externalRef1.Supi, body Non3GppSmsfRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) Get3GppRegistration(ctx context.Context, ueId externalRef1.VarUeId, params *Get3GppRegistrationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGet3GppRegistrationRequest(c.Server, ueId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Update3GppRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdate3GppRegistrationRequestWithBody(c.Server, ueId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TGppRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTGppRegistrationRequestWithBody(c.Server, ueId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TGppRegistration(ctx context.Context, ueId externalRef1.Supi, body TGppRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTGppRegistrationRequest(c.Server, ueId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNon3GppRegistration(ctx context.Context, ueId externalRef1.VarUeId, params *GetNon3GppRegistrationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNon3GppRegistrationRequest(c.Server, ueId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateNon3GppRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNon3GppRegistrationRequestWithBody(c.Server, ueId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Non3GppRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNon3GppRegistrationRequestWithBody(c.Server, ueId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Non3GppRegistration(ctx context.Context, ueId externalRef1.Supi, body Non3GppRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNon3GppRegistrationRequest(c.Server, ueId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SmfDeregistration(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSmfDeregistrationRequest(c.Server, ueId, pduSessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegistrationRequestWithBody(c.Server, ueId, pduSessionId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Registration(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, body RegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegistrationRequest(c.Server, ueId, pduSessionId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TGppSmsfDeregistration(ctx context.Context, ueId externalRef1.Supi, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTGppSmsfDeregistrationRequest(c.Server, ueId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Get3GppSmsfRegistration(ctx context.Context, ueId externalRef1.Gpsi, params *Get3GppSmsfRegistrationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGet3GppSmsfRegistrationRequest(c.Server, ueId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TGppSmsfRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTGppSmsfRegistrationRequestWithBody(c.Server, ueId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TGppSmsfRegistration(ctx context.Context, ueId externalRef1.Supi, body TGppSmsfRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTGppSmsfRegistrationRequest(c.Server, ueId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Non3GppSmsfDeregistration(ctx context.Context, ueId externalRef1.Supi, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNon3GppSmsfDeregistrationRequest(c.Server, ueId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNon3GppSmsfRegistration(ctx context.Context, ueId externalRef1.Gpsi, params *GetNon3GppSmsfRegistrationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNon3GppSmsfRegistrationRequest(c.Server, ueId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Non3GppSmsfRegistrationWithBody(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNon3GppSmsfRegistrationRequestWithBody(c.Server, ueId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Non3GppSmsfRegistration(ctx context.Context, ueId externalRef1.Supi, body Non3GppSmsfRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNon3GppSmsfRegistrationRequest(c.Server, ueId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGet3GppRegistrationRequest generates requests for Get3GppRegistration
+func NewGet3GppRegistrationRequest(server string, ueId externalRef1.VarUeId, params *Get3GppRegistrationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/amf-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SupportedFeatures != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "supported-features", runtime.ParamLocationQuery, *params.SupportedFeatures); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdate3GppRegistrationRequestWithBody generates requests for Update3GppRegistration with any type of body
+func NewUpdate3GppRegistrationRequestWithBody(server string, ueId externalRef1.Supi, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/amf-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTGppRegistrationRequest calls the generic TGppRegistration builder with application/json body
+func NewTGppRegistrationRequest(server string, ueId externalRef1.Supi, body TGppRegistrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTGppRegistrationRequestWithBody(server, ueId, "application/json", bodyReader)
+}
+
+// NewTGppRegistrationRequestWithBody generates requests for TGppRegistration with any type of body
+func NewTGppRegistrationRequestWithBody(server string, ueId externalRef1.Supi, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/amf-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetNon3GppRegistrationRequest generates requests for GetNon3GppRegistration
+func NewGetNon3GppRegistrationRequest(server string, ueId externalRef1.VarUeId, params *GetNon3GppRegistrationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/amf-non-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SupportedFeatures != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "supported-features", runtime.ParamLocationQuery, *params.SupportedFeatures); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNon3GppRegistrationRequestWithBody generates requests for UpdateNon3GppRegistration with any type of body
+func NewUpdateNon3GppRegistrationRequestWithBody(server string, ueId externalRef1.Supi, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/amf-non-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewNon3GppRegistrationRequest calls the generic Non3GppRegistration builder with application/json body
+func NewNon3GppRegistrationRequest(server string, ueId externalRef1.Supi, body Non3GppRegistrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNon3GppRegistrationRequestWithBody(server, ueId, "application/json", bodyReader)
+}
+
+// NewNon3GppRegistrationRequestWithBody generates requests for Non3GppRegistration with any type of body
+func NewNon3GppRegistrationRequestWithBody(server string, ueId externalRef1.Supi, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/amf-non-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSmfDeregistrationRequest generates requests for SmfDeregistration
+func NewSmfDeregistrationRequest(server string, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "pduSessionId", runtime.ParamLocationPath, pduSessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/smf-registrations/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRegistrationRequest calls the generic Registration builder with application/json body
+func NewRegistrationRequest(server string, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, body RegistrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegistrationRequestWithBody(server, ueId, pduSessionId, "application/json", bodyReader)
+}
+
+// NewRegistrationRequestWithBody generates requests for Registration with any type of body
+func NewRegistrationRequestWithBody(server string, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "pduSessionId", runtime.ParamLocationPath, pduSessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/smf-registrations/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTGppSmsfDeregistrationRequest generates requests for TGppSmsfDeregistration
+func NewTGppSmsfDeregistrationRequest(server string, ueId externalRef1.Supi) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/smsf-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGet3GppSmsfRegistrationRequest generates requests for Get3GppSmsfRegistration
+func NewGet3GppSmsfRegistrationRequest(server string, ueId externalRef1.Gpsi, params *Get3GppSmsfRegistrationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/smsf-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SupportedFeatures != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "supported-features", runtime.ParamLocationQuery, *params.SupportedFeatures); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTGppSmsfRegistrationRequest calls the generic TGppSmsfRegistration builder with application/json body
+func NewTGppSmsfRegistrationRequest(server string, ueId externalRef1.Supi, body TGppSmsfRegistrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTGppSmsfRegistrationRequestWithBody(server, ueId, "application/json", bodyReader)
+}
+
+// NewTGppSmsfRegistrationRequestWithBody generates requests for TGppSmsfRegistration with any type of body
+func NewTGppSmsfRegistrationRequestWithBody(server string, ueId externalRef1.Supi, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/smsf-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewNon3GppSmsfDeregistrationRequest generates requests for Non3GppSmsfDeregistration
+func NewNon3GppSmsfDeregistrationRequest(server string, ueId externalRef1.Supi) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/smsf-non-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNon3GppSmsfRegistrationRequest generates requests for GetNon3GppSmsfRegistration
+func NewGetNon3GppSmsfRegistrationRequest(server string, ueId externalRef1.Gpsi, params *GetNon3GppSmsfRegistrationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/smsf-non-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.SupportedFeatures != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "supported-features", runtime.ParamLocationQuery, *params.SupportedFeatures); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNon3GppSmsfRegistrationRequest calls the generic Non3GppSmsfRegistration builder with application/json body
+func NewNon3GppSmsfRegistrationRequest(server string, ueId externalRef1.Supi, body Non3GppSmsfRegistrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNon3GppSmsfRegistrationRequestWithBody(server, ueId, "application/json", bodyReader)
+}
+
+// NewNon3GppSmsfRegistrationRequestWithBody generates requests for Non3GppSmsfRegistration with any type of body
+func NewNon3GppSmsfRegistrationRequestWithBody(server string, ueId externalRef1.Supi, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/%s/registrations/smsf-non-3gpp-access", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// Get3GppRegistration request
+	Get3GppRegistrationWithResponse(ctx context.Context, ueId externalRef1.VarUeId, params *Get3GppRegistrationParams, reqEditors ...RequestEditorFn) (*Get3GppRegistrationResponse, error)
+
+	// Update3GppRegistration request with any body
+	Update3GppRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Update3GppRegistrationResponse, error)
+
+	// TGppRegistration request with any body
+	TGppRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TGppRegistrationResponse, error)
+
+	TGppRegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, body TGppRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*TGppRegistrationResponse, error)
+
+	// GetNon3GppRegistration request
+	GetNon3GppRegistrationWithResponse(ctx context.Context, ueId externalRef1.VarUeId, params *GetNon3GppRegistrationParams, reqEditors ...RequestEditorFn) (*GetNon3GppRegistrationResponse, error)
+
+	// UpdateNon3GppRegistration request with any body
+	UpdateNon3GppRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNon3GppRegistrationResponse, error)
+
+	// Non3GppRegistration request with any body
+	Non3GppRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Non3GppRegistrationResponse, error)
+
+	Non3GppRegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, body Non3GppRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*Non3GppRegistrationResponse, error)
+
+	// SmfDeregistration request
+	SmfDeregistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, reqEditors ...RequestEditorFn) (*SmfDeregistrationResponse, error)
+
+	// Registration request with any body
+	RegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegistrationResponse, error)
+
+	RegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, body RegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*RegistrationResponse, error)
+
+	// TGppSmsfDeregistration request
+	TGppSmsfDeregistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, reqEditors ...RequestEditorFn) (*TGppSmsfDeregistrationResponse, error)
+
+	// Get3GppSmsfRegistration request
+	Get3GppSmsfRegistrationWithResponse(ctx context.Context, ueId externalRef1.Gpsi, params *Get3GppSmsfRegistrationParams, reqEditors ...RequestEditorFn) (*Get3GppSmsfRegistrationResponse, error)
+
+	// TGppSmsfRegistration request with any body
+	TGppSmsfRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TGppSmsfRegistrationResponse, error)
+
+	TGppSmsfRegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, body TGppSmsfRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*TGppSmsfRegistrationResponse, error)
+
+	// Non3GppSmsfDeregistration request
+	Non3GppSmsfDeregistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, reqEditors ...RequestEditorFn) (*Non3GppSmsfDeregistrationResponse, error)
+
+	// GetNon3GppSmsfRegistration request
+	GetNon3GppSmsfRegistrationWithResponse(ctx context.Context, ueId externalRef1.Gpsi, params *GetNon3GppSmsfRegistrationParams, reqEditors ...RequestEditorFn) (*GetNon3GppSmsfRegistrationResponse, error)
+
+	// Non3GppSmsfRegistration request with any body
+	Non3GppSmsfRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Non3GppSmsfRegistrationResponse, error)
+
+	Non3GppSmsfRegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, body Non3GppSmsfRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*Non3GppSmsfRegistrationResponse, error)
+}
+
+type Get3GppRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Amf3GppAccessRegistration
+}
+
+// Status returns HTTPResponse.Status
+func (r Get3GppRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Get3GppRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Update3GppRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r Update3GppRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Update3GppRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TGppRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Amf3GppAccessRegistration
+	JSON201      *Amf3GppAccessRegistration
+}
+
+// Status returns HTTPResponse.Status
+func (r TGppRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TGppRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNon3GppRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AmfNon3GppAccessRegistration
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNon3GppRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNon3GppRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNon3GppRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNon3GppRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNon3GppRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Non3GppRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AmfNon3GppAccessRegistration
+	JSON201      *AmfNon3GppAccessRegistration
+}
+
+// Status returns HTTPResponse.Status
+func (r Non3GppRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Non3GppRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SmfDeregistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SmfDeregistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SmfDeregistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SmfRegistration
+	JSON201      *SmfRegistration
+}
+
+// Status returns HTTPResponse.Status
+func (r RegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TGppSmsfDeregistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TGppSmsfDeregistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TGppSmsfDeregistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Get3GppSmsfRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SmsfRegistration
+}
+
+// Status returns HTTPResponse.Status
+func (r Get3GppSmsfRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Get3GppSmsfRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TGppSmsfRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SmsfRegistration
+	JSON201      *SmsfRegistration
+}
+
+// Status returns HTTPResponse.Status
+func (r TGppSmsfRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TGppSmsfRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Non3GppSmsfDeregistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r Non3GppSmsfDeregistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Non3GppSmsfDeregistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNon3GppSmsfRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SmsfRegistration
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNon3GppSmsfRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNon3GppSmsfRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type Non3GppSmsfRegistrationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SmsfRegistration
+	JSON201      *SmsfRegistration
+}
+
+// Status returns HTTPResponse.Status
+func (r Non3GppSmsfRegistrationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r Non3GppSmsfRegistrationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// Get3GppRegistrationWithResponse request returning *Get3GppRegistrationResponse
+func (c *ClientWithResponses) Get3GppRegistrationWithResponse(ctx context.Context, ueId externalRef1.VarUeId, params *Get3GppRegistrationParams, reqEditors ...RequestEditorFn) (*Get3GppRegistrationResponse, error) {
+	rsp, err := c.Get3GppRegistration(ctx, ueId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGet3GppRegistrationResponse(rsp)
+}
+
+// Update3GppRegistrationWithBodyWithResponse request with arbitrary body returning *Update3GppRegistrationResponse
+func (c *ClientWithResponses) Update3GppRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Update3GppRegistrationResponse, error) {
+	rsp, err := c.Update3GppRegistrationWithBody(ctx, ueId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdate3GppRegistrationResponse(rsp)
+}
+
+// TGppRegistrationWithBodyWithResponse request with arbitrary body returning *TGppRegistrationResponse
+func (c *ClientWithResponses) TGppRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TGppRegistrationResponse, error) {
+	rsp, err := c.TGppRegistrationWithBody(ctx, ueId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTGppRegistrationResponse(rsp)
+}
+
+func (c *ClientWithResponses) TGppRegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, body TGppRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*TGppRegistrationResponse, error) {
+	rsp, err := c.TGppRegistration(ctx, ueId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTGppRegistrationResponse(rsp)
+}
+
+// GetNon3GppRegistrationWithResponse request returning *GetNon3GppRegistrationResponse
+func (c *ClientWithResponses) GetNon3GppRegistrationWithResponse(ctx context.Context, ueId externalRef1.VarUeId, params *GetNon3GppRegistrationParams, reqEditors ...RequestEditorFn) (*GetNon3GppRegistrationResponse, error) {
+	rsp, err := c.GetNon3GppRegistration(ctx, ueId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNon3GppRegistrationResponse(rsp)
+}
+
+// UpdateNon3GppRegistrationWithBodyWithResponse request with arbitrary body returning *UpdateNon3GppRegistrationResponse
+func (c *ClientWithResponses) UpdateNon3GppRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNon3GppRegistrationResponse, error) {
+	rsp, err := c.UpdateNon3GppRegistrationWithBody(ctx, ueId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNon3GppRegistrationResponse(rsp)
+}
+
+// Non3GppRegistrationWithBodyWithResponse request with arbitrary body returning *Non3GppRegistrationResponse
+func (c *ClientWithResponses) Non3GppRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Non3GppRegistrationResponse, error) {
+	rsp, err := c.Non3GppRegistrationWithBody(ctx, ueId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNon3GppRegistrationResponse(rsp)
+}
+
+func (c *ClientWithResponses) Non3GppRegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, body Non3GppRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*Non3GppRegistrationResponse, error) {
+	rsp, err := c.Non3GppRegistration(ctx, ueId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNon3GppRegistrationResponse(rsp)
+}
+
+// SmfDeregistrationWithResponse request returning *SmfDeregistrationResponse
+func (c *ClientWithResponses) SmfDeregistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, reqEditors ...RequestEditorFn) (*SmfDeregistrationResponse, error) {
+	rsp, err := c.SmfDeregistration(ctx, ueId, pduSessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSmfDeregistrationResponse(rsp)
+}
+
+// RegistrationWithBodyWithResponse request with arbitrary body returning *RegistrationResponse
+func (c *ClientWithResponses) RegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegistrationResponse, error) {
+	rsp, err := c.RegistrationWithBody(ctx, ueId, pduSessionId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegistrationResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId, body RegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*RegistrationResponse, error) {
+	rsp, err := c.Registration(ctx, ueId, pduSessionId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegistrationResponse(rsp)
+}
+
+// TGppSmsfDeregistrationWithResponse request returning *TGppSmsfDeregistrationResponse
+func (c *ClientWithResponses) TGppSmsfDeregistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, reqEditors ...RequestEditorFn) (*TGppSmsfDeregistrationResponse, error) {
+	rsp, err := c.TGppSmsfDeregistration(ctx, ueId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTGppSmsfDeregistrationResponse(rsp)
+}
+
+// Get3GppSmsfRegistrationWithResponse request returning *Get3GppSmsfRegistrationResponse
+func (c *ClientWithResponses) Get3GppSmsfRegistrationWithResponse(ctx context.Context, ueId externalRef1.Gpsi, params *Get3GppSmsfRegistrationParams, reqEditors ...RequestEditorFn) (*Get3GppSmsfRegistrationResponse, error) {
+	rsp, err := c.Get3GppSmsfRegistration(ctx, ueId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGet3GppSmsfRegistrationResponse(rsp)
+}
+
+// TGppSmsfRegistrationWithBodyWithResponse request with arbitrary body returning *TGppSmsfRegistrationResponse
+func (c *ClientWithResponses) TGppSmsfRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TGppSmsfRegistrationResponse, error) {
+	rsp, err := c.TGppSmsfRegistrationWithBody(ctx, ueId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTGppSmsfRegistrationResponse(rsp)
+}
+
+func (c *ClientWithResponses) TGppSmsfRegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, body TGppSmsfRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*TGppSmsfRegistrationResponse, error) {
+	rsp, err := c.TGppSmsfRegistration(ctx, ueId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTGppSmsfRegistrationResponse(rsp)
+}
+
+// Non3GppSmsfDeregistrationWithResponse request returning *Non3GppSmsfDeregistrationResponse
+func (c *ClientWithResponses) Non3GppSmsfDeregistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, reqEditors ...RequestEditorFn) (*Non3GppSmsfDeregistrationResponse, error) {
+	rsp, err := c.Non3GppSmsfDeregistration(ctx, ueId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNon3GppSmsfDeregistrationResponse(rsp)
+}
+
+// GetNon3GppSmsfRegistrationWithResponse request returning *GetNon3GppSmsfRegistrationResponse
+func (c *ClientWithResponses) GetNon3GppSmsfRegistrationWithResponse(ctx context.Context, ueId externalRef1.Gpsi, params *GetNon3GppSmsfRegistrationParams, reqEditors ...RequestEditorFn) (*GetNon3GppSmsfRegistrationResponse, error) {
+	rsp, err := c.GetNon3GppSmsfRegistration(ctx, ueId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNon3GppSmsfRegistrationResponse(rsp)
+}
+
+// Non3GppSmsfRegistrationWithBodyWithResponse request with arbitrary body returning *Non3GppSmsfRegistrationResponse
+func (c *ClientWithResponses) Non3GppSmsfRegistrationWithBodyWithResponse(ctx context.Context, ueId externalRef1.Supi, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*Non3GppSmsfRegistrationResponse, error) {
+	rsp, err := c.Non3GppSmsfRegistrationWithBody(ctx, ueId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNon3GppSmsfRegistrationResponse(rsp)
+}
+
+func (c *ClientWithResponses) Non3GppSmsfRegistrationWithResponse(ctx context.Context, ueId externalRef1.Supi, body Non3GppSmsfRegistrationJSONRequestBody, reqEditors ...RequestEditorFn) (*Non3GppSmsfRegistrationResponse, error) {
+	rsp, err := c.Non3GppSmsfRegistration(ctx, ueId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNon3GppSmsfRegistrationResponse(rsp)
+}
+
+// ParseGet3GppRegistrationResponse parses an HTTP response from a Get3GppRegistrationWithResponse call
+func ParseGet3GppRegistrationResponse(rsp *http.Response) (*Get3GppRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Get3GppRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Amf3GppAccessRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdate3GppRegistrationResponse parses an HTTP response from a Update3GppRegistrationWithResponse call
+func ParseUpdate3GppRegistrationResponse(rsp *http.Response) (*Update3GppRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Update3GppRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTGppRegistrationResponse parses an HTTP response from a TGppRegistrationWithResponse call
+func ParseTGppRegistrationResponse(rsp *http.Response) (*TGppRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TGppRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Amf3GppAccessRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Amf3GppAccessRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNon3GppRegistrationResponse parses an HTTP response from a GetNon3GppRegistrationWithResponse call
+func ParseGetNon3GppRegistrationResponse(rsp *http.Response) (*GetNon3GppRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNon3GppRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AmfNon3GppAccessRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateNon3GppRegistrationResponse parses an HTTP response from a UpdateNon3GppRegistrationWithResponse call
+func ParseUpdateNon3GppRegistrationResponse(rsp *http.Response) (*UpdateNon3GppRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateNon3GppRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseNon3GppRegistrationResponse parses an HTTP response from a Non3GppRegistrationWithResponse call
+func ParseNon3GppRegistrationResponse(rsp *http.Response) (*Non3GppRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Non3GppRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AmfNon3GppAccessRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AmfNon3GppAccessRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSmfDeregistrationResponse parses an HTTP response from a SmfDeregistrationWithResponse call
+func ParseSmfDeregistrationResponse(rsp *http.Response) (*SmfDeregistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SmfDeregistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRegistrationResponse parses an HTTP response from a RegistrationWithResponse call
+func ParseRegistrationResponse(rsp *http.Response) (*RegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SmfRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SmfRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTGppSmsfDeregistrationResponse parses an HTTP response from a TGppSmsfDeregistrationWithResponse call
+func ParseTGppSmsfDeregistrationResponse(rsp *http.Response) (*TGppSmsfDeregistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TGppSmsfDeregistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGet3GppSmsfRegistrationResponse parses an HTTP response from a Get3GppSmsfRegistrationWithResponse call
+func ParseGet3GppSmsfRegistrationResponse(rsp *http.Response) (*Get3GppSmsfRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Get3GppSmsfRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SmsfRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTGppSmsfRegistrationResponse parses an HTTP response from a TGppSmsfRegistrationWithResponse call
+func ParseTGppSmsfRegistrationResponse(rsp *http.Response) (*TGppSmsfRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TGppSmsfRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SmsfRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SmsfRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNon3GppSmsfDeregistrationResponse parses an HTTP response from a Non3GppSmsfDeregistrationWithResponse call
+func ParseNon3GppSmsfDeregistrationResponse(rsp *http.Response) (*Non3GppSmsfDeregistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Non3GppSmsfDeregistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetNon3GppSmsfRegistrationResponse parses an HTTP response from a GetNon3GppSmsfRegistrationWithResponse call
+func ParseGetNon3GppSmsfRegistrationResponse(rsp *http.Response) (*GetNon3GppSmsfRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNon3GppSmsfRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SmsfRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNon3GppSmsfRegistrationResponse parses an HTTP response from a Non3GppSmsfRegistrationWithResponse call
+func ParseNon3GppSmsfRegistrationResponse(rsp *http.Response) (*Non3GppSmsfRegistrationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Non3GppSmsfRegistrationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SmsfRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SmsfRegistration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,710 @@
+// Package TS29503NudmUECMServer provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.9.0 DO NOT EDIT.
+package TS29503NudmUECMServer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	externalRef0 "magma/feg/gateway/sbi/specs/TS29510NnrfNFManagement"
+	externalRef1 "magma/feg/gateway/sbi/specs/TS29571CommonData"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	OAuth2ClientCredentialsScopes = "oAuth2ClientCredentials.Scopes"
+)
+
+// Amf3GppAccessRegistration defines model for Amf3GppAccessRegistration.
+type Amf3GppAccessRegistration struct {
+	AmfEeSubscriptionId     *string                       `json:"amfEeSubscriptionId,omitempty"`
+	AmfInstanceId           externalRef1.NfInstanceId     `json:"amfInstanceId"`
+	AmfServiceNameDereg     *externalRef0.ServiceName     `json:"amfServiceNameDereg,omitempty"`
+	AmfServiceNamePcscfRest *externalRef0.ServiceName     `json:"amfServiceNamePcscfRest,omitempty"`
+	BackupAmfInfo           *[]externalRef1.BackupAmfInfo `json:"backupAmfInfo,omitempty"`
+	DeregCallbackUri        externalRef1.Uri              `json:"deregCallbackUri"`
+	DrFlag                  *DualRegistrationFlag         `json:"drFlag,omitempty"`
+	EpsInterworkingInfo     *struct {
+		// A map (list of key-value pairs where Dnn serves as key) of EpsIwkPgws
+		EpsIwkPgws *Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws `json:"epsIwkPgws,omitempty"`
+	} `json:"epsInterworkingInfo,omitempty"`
+	Guami                       externalRef1.Guami              `json:"guami"`
+	ImsVoPs                     *ImsVoPs                        `json:"imsVoPs,omitempty"`
+	InitialRegistrationInd      *bool                           `json:"initialRegistrationInd,omitempty"`
+	PcscfRestorationCallbackUri *externalRef1.Uri               `json:"pcscfRestorationCallbackUri,omitempty"`
+	Pei                         *externalRef1.Pei               `json:"pei,omitempty"`
+	PurgeFlag                   *PurgeFlag                      `json:"purgeFlag,omitempty"`
+	RatType                     externalRef1.RatType            `json:"ratType"`
+	SupportedFeatures           *externalRef1.SupportedFeatures `json:"supportedFeatures,omitempty"`
+	UrrpIndicator               *bool                           `json:"urrpIndicator,omitempty"`
+}
+
+// A map (list of key-value pairs where Dnn serves as key) of EpsIwkPgws
+type Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws struct {
+	AdditionalProperties map[string]EpsIwkPgw `json:"-"`
+}
+
+// Amf3GppAccessRegistrationModification defines model for Amf3GppAccessRegistrationModification.
+type Amf3GppAccessRegistrationModification struct {
+	BackupAmfInfo       *[]externalRef1.BackupAmfInfo `json:"backupAmfInfo,omitempty"`
+	EpsInterworkingInfo *struct {
+		// A map (list of key-value pairs where Dnn serves as key) of EpsIwkPgws
+		EpsIwkPgws *Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws `json:"epsIwkPgws,omitempty"`
+	} `json:"epsInterworkingInfo,omitempty"`
+	Guami     externalRef1.Guami `json:"guami"`
+	ImsVoPs   *ImsVoPs           `json:"imsVoPs,omitempty"`
+	Pei       *externalRef1.Pei  `json:"pei,omitempty"`
+	PurgeFlag *PurgeFlag         `json:"purgeFlag,omitempty"`
+}
+
+// A map (list of key-value pairs where Dnn serves as key) of EpsIwkPgws
+type Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws struct {
+	AdditionalProperties map[string]EpsIwkPgw `json:"-"`
+}
+
+// AmfNon3GppAccessRegistration defines model for AmfNon3GppAccessRegistration.
+type AmfNon3GppAccessRegistration struct {
+	AmfEeSubscriptionId         *string                         `json:"amfEeSubscriptionId,omitempty"`
+	AmfInstanceId               externalRef1.NfInstanceId       `json:"amfInstanceId"`
+	AmfServiceNameDereg         *externalRef0.ServiceName       `json:"amfServiceNameDereg,omitempty"`
+	AmfServiceNamePcscfRest     *externalRef0.ServiceName       `json:"amfServiceNamePcscfRest,omitempty"`
+	BackupAmfInfo               *[]externalRef1.BackupAmfInfo   `json:"backupAmfInfo,omitempty"`
+	DeregCallbackUri            externalRef1.Uri                `json:"deregCallbackUri"`
+	Guami                       externalRef1.Guami              `json:"guami"`
+	ImsVoPs                     ImsVoPs                         `json:"imsVoPs"`
+	PcscfRestorationCallbackUri *externalRef1.Uri               `json:"pcscfRestorationCallbackUri,omitempty"`
+	Pei                         *externalRef1.Pei               `json:"pei,omitempty"`
+	PurgeFlag                   *PurgeFlag                      `json:"purgeFlag,omitempty"`
+	RatType                     externalRef1.RatType            `json:"ratType"`
+	SupportedFeatures           *externalRef1.SupportedFeatures `json:"supportedFeatures,omitempty"`
+	UrrpIndicator               *bool                           `json:"urrpIndicator,omitempty"`
+}
+
+// AmfNon3GppAccessRegistrationModification defines model for AmfNon3GppAccessRegistrationModification.
+type AmfNon3GppAccessRegistrationModification struct {
+	BackupAmfInfo *[]externalRef1.BackupAmfInfo `json:"backupAmfInfo,omitempty"`
+	Guami         externalRef1.Guami            `json:"guami"`
+	ImsVoPs       *ImsVoPs                      `json:"imsVoPs,omitempty"`
+	Pei           *externalRef1.Pei             `json:"pei,omitempty"`
+	PurgeFlag     *PurgeFlag                    `json:"purgeFlag,omitempty"`
+}
+
+// DeregistrationData defines model for DeregistrationData.
+type DeregistrationData struct {
+	AccessType  externalRef1.AccessType `json:"accessType"`
+	DeregReason DeregistrationReason    `json:"deregReason"`
+}
+
+// DeregistrationReason defines model for DeregistrationReason.
+type DeregistrationReason interface{}
+
+// DualRegistrationFlag defines model for DualRegistrationFlag.
+type DualRegistrationFlag bool
+
+// E164Number defines model for E164Number.
+type E164Number string
+
+// EpsIwkPgw defines model for EpsIwkPgw.
+type EpsIwkPgw struct {
+	PgwFqdn       string                    `json:"pgwFqdn"`
+	SmfInstanceId externalRef1.NfInstanceId `json:"smfInstanceId"`
+}
+
+// ImsVoPs defines model for ImsVoPs.
+type ImsVoPs interface{}
+
+// NetworkNodeDiameterAddress defines model for NetworkNodeDiameterAddress.
+type NetworkNodeDiameterAddress struct {
+	Name  externalRef1.DiameterIdentity `json:"name"`
+	Realm externalRef1.DiameterIdentity `json:"realm"`
+}
+
+// PcscfRestorationNotification defines model for PcscfRestorationNotification.
+type PcscfRestorationNotification struct {
+	Supi externalRef1.Supi `json:"supi"`
+}
+
+// PurgeFlag defines model for PurgeFlag.
+type PurgeFlag bool
+
+// SmfRegistration defines model for SmfRegistration.
+type SmfRegistration struct {
+	Dnn                         *externalRef1.Dnn               `json:"dnn,omitempty"`
+	EmergencyServices           *bool                           `json:"emergencyServices,omitempty"`
+	PcscfRestorationCallbackUri *externalRef1.Uri               `json:"pcscfRestorationCallbackUri,omitempty"`
+	PduSessionId                externalRef1.PduSessionId       `json:"pduSessionId"`
+	PgwFqdn                     *string                         `json:"pgwFqdn,omitempty"`
+	PlmnId                      externalRef1.PlmnId             `json:"plmnId"`
+	SingleNssai                 externalRef1.Snssai             `json:"singleNssai"`
+	SmfInstanceId               externalRef1.NfInstanceId       `json:"smfInstanceId"`
+	SupportedFeatures           *externalRef1.SupportedFeatures `json:"supportedFeatures,omitempty"`
+}
+
+// SmsfRegistration defines model for SmsfRegistration.
+type SmsfRegistration struct {
+	PlmnId              externalRef1.PlmnId             `json:"plmnId"`
+	SmsfDiameterAddress *NetworkNodeDiameterAddress     `json:"smsfDiameterAddress,omitempty"`
+	SmsfInstanceId      externalRef1.NfInstanceId       `json:"smsfInstanceId"`
+	SmsfMAPAddress      *E164Number                     `json:"smsfMAPAddress,omitempty"`
+	SupportedFeatures   *externalRef1.SupportedFeatures `json:"supportedFeatures,omitempty"`
+}
+
+// Get3GppRegistrationParams defines parameters for Get3GppRegistration.
+type Get3GppRegistrationParams struct {
+	SupportedFeatures *externalRef1.SupportedFeatures `json:"supported-features,omitempty"`
+}
+
+// TGppRegistrationJSONBody defines parameters for TGppRegistration.
+type TGppRegistrationJSONBody Amf3GppAccessRegistration
+
+// GetNon3GppRegistrationParams defines parameters for GetNon3GppRegistration.
+type GetNon3GppRegistrationParams struct {
+	SupportedFeatures *externalRef1.SupportedFeatures `json:"supported-features,omitempty"`
+}
+
+// Non3GppRegistrationJSONBody defines parameters for Non3GppRegistration.
+type Non3GppRegistrationJSONBody AmfNon3GppAccessRegistration
+
+// RegistrationJSONBody defines parameters for Registration.
+type RegistrationJSONBody SmfRegistration
+
+// Get3GppSmsfRegistrationParams defines parameters for Get3GppSmsfRegistration.
+type Get3GppSmsfRegistrationParams struct {
+	SupportedFeatures *externalRef1.SupportedFeatures `json:"supported-features,omitempty"`
+}
+
+// TGppSmsfRegistrationJSONBody defines parameters for TGppSmsfRegistration.
+type TGppSmsfRegistrationJSONBody SmsfRegistration
+
+// GetNon3GppSmsfRegistrationParams defines parameters for GetNon3GppSmsfRegistration.
+type GetNon3GppSmsfRegistrationParams struct {
+	SupportedFeatures *externalRef1.SupportedFeatures `json:"supported-features,omitempty"`
+}
+
+// Non3GppSmsfRegistrationJSONBody defines parameters for Non3GppSmsfRegistration.
+type Non3GppSmsfRegistrationJSONBody SmsfRegistration
+
+// TGppRegistrationJSONRequestBody defines body for TGppRegistration for application/json ContentType.
+type TGppRegistrationJSONRequestBody TGppRegistrationJSONBody
+
+// Non3GppRegistrationJSONRequestBody defines body for Non3GppRegistration for application/json ContentType.
+type Non3GppRegistrationJSONRequestBody Non3GppRegistrationJSONBody
+
+// RegistrationJSONRequestBody defines body for Registration for application/json ContentType.
+type RegistrationJSONRequestBody RegistrationJSONBody
+
+// TGppSmsfRegistrationJSONRequestBody defines body for TGppSmsfRegistration for application/json ContentType.
+type TGppSmsfRegistrationJSONRequestBody TGppSmsfRegistrationJSONBody
+
+// Non3GppSmsfRegistrationJSONRequestBody defines body for Non3GppSmsfRegistration for application/json ContentType.
+type Non3GppSmsfRegistrationJSONRequestBody Non3GppSmsfRegistrationJSONBody
+
+// Getter for additional properties for Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws. Returns the specified
+// element and whether it was found
+func (a Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws) Get(fieldName string) (value EpsIwkPgw, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws
+func (a *Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws) Set(fieldName string, value EpsIwkPgw) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]EpsIwkPgw)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws to handle AdditionalProperties
+func (a *Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]EpsIwkPgw)
+		for fieldName, fieldBuf := range object {
+			var fieldVal EpsIwkPgw
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws to handle AdditionalProperties
+func (a Amf3GppAccessRegistration_EpsInterworkingInfo_EpsIwkPgws) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws. Returns the specified
+// element and whether it was found
+func (a Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws) Get(fieldName string) (value EpsIwkPgw, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws
+func (a *Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws) Set(fieldName string, value EpsIwkPgw) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]EpsIwkPgw)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws to handle AdditionalProperties
+func (a *Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]EpsIwkPgw)
+		for fieldName, fieldBuf := range object {
+			var fieldVal EpsIwkPgw
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws to handle AdditionalProperties
+func (a Amf3GppAccessRegistrationModification_EpsInterworkingInfo_EpsIwkPgws) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// retrieve the AMF registration for 3GPP access information
+	// (GET /{ueId}/registrations/amf-3gpp-access)
+	Get3GppRegistration(ctx echo.Context, ueId externalRef1.VarUeId, params Get3GppRegistrationParams) error
+	// Update a parameter in the AMF registration for 3GPP access
+	// (PATCH /{ueId}/registrations/amf-3gpp-access)
+	Update3GppRegistration(ctx echo.Context, ueId externalRef1.Supi) error
+	// register as AMF for 3GPP access
+	// (PUT /{ueId}/registrations/amf-3gpp-access)
+	TGppRegistration(ctx echo.Context, ueId externalRef1.Supi) error
+	// retrieve the AMF registration for non-3GPP access information
+	// (GET /{ueId}/registrations/amf-non-3gpp-access)
+	GetNon3GppRegistration(ctx echo.Context, ueId externalRef1.VarUeId, params GetNon3GppRegistrationParams) error
+	// update a parameter in the AMF registration for non-3GPP access
+	// (PATCH /{ueId}/registrations/amf-non-3gpp-access)
+	UpdateNon3GppRegistration(ctx echo.Context, ueId externalRef1.Supi) error
+	// register as AMF for non-3GPP access
+	// (PUT /{ueId}/registrations/amf-non-3gpp-access)
+	Non3GppRegistration(ctx echo.Context, ueId externalRef1.Supi) error
+	// delete an SMF registration
+	// (DELETE /{ueId}/registrations/smf-registrations/{pduSessionId})
+	SmfDeregistration(ctx echo.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId) error
+	// register as SMF
+	// (PUT /{ueId}/registrations/smf-registrations/{pduSessionId})
+	Registration(ctx echo.Context, ueId externalRef1.Supi, pduSessionId externalRef1.PduSessionId) error
+	// delete the SMSF registration for 3GPP access
+	// (DELETE /{ueId}/registrations/smsf-3gpp-access)
+	TGppSmsfDeregistration(ctx echo.Context, ueId externalRef1.Supi) error
+	// retrieve the SMSF registration for 3GPP access information
+	// (GET /{ueId}/registrations/smsf-3gpp-access)
+	Get3GppSmsfRegistration(ctx echo.Context, ueId externalRef1.Gpsi, params Get3GppSmsfRegistrationParams) error
+	// register as SMSF for 3GPP access
+	// (PUT /{ueId}/registrations/smsf-3gpp-access)
+	TGppSmsfRegistration(ctx echo.Context, ueId externalRef1.Supi) error
+	// delete SMSF registration for non 3GPP access
+	// (DELETE /{ueId}/registrations/smsf-non-3gpp-access)
+	Non3GppSmsfDeregistration(ctx echo.Context, ueId externalRef1.Supi) error
+	// retrieve the SMSF registration for non-3GPP access information
+	// (GET /{ueId}/registrations/smsf-non-3gpp-access)
+	GetNon3GppSmsfRegistration(ctx echo.Context, ueId externalRef1.Gpsi, params GetNon3GppSmsfRegistrationParams) error
+	// register as SMSF for non-3GPP access
+	// (PUT /{ueId}/registrations/smsf-non-3gpp-access)
+	Non3GppSmsfRegistration(ctx echo.Context, ueId externalRef1.Supi) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// Get3GppRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) Get3GppRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.VarUeId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params Get3GppRegistrationParams
+	// ------------- Optional query parameter "supported-features" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "supported-features", ctx.QueryParams(), &params.SupportedFeatures)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter supported-features: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Get3GppRegistration(ctx, ueId, params)
+	return err
+}
+
+// Update3GppRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) Update3GppRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Update3GppRegistration(ctx, ueId)
+	return err
+}
+
+// TGppRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) TGppRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.TGppRegistration(ctx, ueId)
+	return err
+}
+
+// GetNon3GppRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNon3GppRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.VarUeId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNon3GppRegistrationParams
+	// ------------- Optional query parameter "supported-features" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "supported-features", ctx.QueryParams(), &params.SupportedFeatures)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter supported-features: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetNon3GppRegistration(ctx, ueId, params)
+	return err
+}
+
+// UpdateNon3GppRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNon3GppRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateNon3GppRegistration(ctx, ueId)
+	return err
+}
+
+// Non3GppRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) Non3GppRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Non3GppRegistration(ctx, ueId)
+	return err
+}
+
+// SmfDeregistration converts echo context to params.
+func (w *ServerInterfaceWrapper) SmfDeregistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	// ------------- Path parameter "pduSessionId" -------------
+	var pduSessionId externalRef1.PduSessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pduSessionId", runtime.ParamLocationPath, ctx.Param("pduSessionId"), &pduSessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pduSessionId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SmfDeregistration(ctx, ueId, pduSessionId)
+	return err
+}
+
+// Registration converts echo context to params.
+func (w *ServerInterfaceWrapper) Registration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	// ------------- Path parameter "pduSessionId" -------------
+	var pduSessionId externalRef1.PduSessionId
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "pduSessionId", runtime.ParamLocationPath, ctx.Param("pduSessionId"), &pduSessionId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pduSessionId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Registration(ctx, ueId, pduSessionId)
+	return err
+}
+
+// TGppSmsfDeregistration converts echo context to params.
+func (w *ServerInterfaceWrapper) TGppSmsfDeregistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.TGppSmsfDeregistration(ctx, ueId)
+	return err
+}
+
+// Get3GppSmsfRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) Get3GppSmsfRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Gpsi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params Get3GppSmsfRegistrationParams
+	// ------------- Optional query parameter "supported-features" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "supported-features", ctx.QueryParams(), &params.SupportedFeatures)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter supported-features: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Get3GppSmsfRegistration(ctx, ueId, params)
+	return err
+}
+
+// TGppSmsfRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) TGppSmsfRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.TGppSmsfRegistration(ctx, ueId)
+	return err
+}
+
+// Non3GppSmsfDeregistration converts echo context to params.
+func (w *ServerInterfaceWrapper) Non3GppSmsfDeregistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Non3GppSmsfDeregistration(ctx, ueId)
+	return err
+}
+
+// GetNon3GppSmsfRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNon3GppSmsfRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Gpsi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNon3GppSmsfRegistrationParams
+	// ------------- Optional query parameter "supported-features" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "supported-features", ctx.QueryParams(), &params.SupportedFeatures)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter supported-features: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetNon3GppSmsfRegistration(ctx, ueId, params)
+	return err
+}
+
+// Non3GppSmsfRegistration converts echo context to params.
+func (w *ServerInterfaceWrapper) Non3GppSmsfRegistration(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ueId" -------------
+	var ueId externalRef1.Supi
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ueId", runtime.ParamLocationPath, ctx.Param("ueId"), &ueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ueId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nudm-uecm"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Non3GppSmsfRegistration(ctx, ueId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/:ueId/registrations/amf-3gpp-access", wrapper.Get3GppRegistration)
+	router.PATCH(baseURL+"/:ueId/registrations/amf-3gpp-access", wrapper.Update3GppRegistration)
+	router.PUT(baseURL+"/:ueId/registrations/amf-3gpp-access", wrapper.TGppRegistration)
+	router.GET(baseURL+"/:ueId/registrations/amf-non-3gpp-access", wrapper.GetNon3GppRegistration)
+	router.PATCH(baseURL+"/:ueId/registrations/amf-non-3gpp-access", wrapper.UpdateNon3GppRegistration)
+	router.PUT(baseURL+"/:ueId/registrations/amf-non-3gpp-access", wrapper.Non3GppRegistration)
+	router.DELETE(baseURL+"/:ueId/registrations/smf-registrations/:pduSessionId", wrapper.SmfDeregistration)
+	router.PUT(baseURL+"/:ueId/registrations/smf-registrations/:pduSessionId", wrapper.Registration)
+	router.DELETE(baseURL+"/:ueId/registrations/smsf-3gpp-access", wrapper.TGppSmsfDeregistration)
+	router.GET(baseURL+"/:ueId/registrations/smsf-3gpp-access", wrapper.Get3GppSmsfRegistration)
+	router.PUT(baseURL+"/:ueId/registrations/smsf-3gpp-access", wrapper.TGppSmsfRegistration)
+	router.DELETE(baseURL+"/:ueId/registrations/smsf-non-3gpp-access", wrapper.Non3GppSmsfDeregistration)
+	router.GET(baseURL+"/:ueId/registrations/smsf-non-3gpp-access", wrapper.GetNon3GppSmsfRegistration)
+	router.PUT(baseURL+"/:ueId/registrations/smsf-non-3gpp-access", wrapper.Non3GppSmsfRegistration)
+
+}
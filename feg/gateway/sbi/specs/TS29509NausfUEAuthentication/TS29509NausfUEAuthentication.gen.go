@@ -4,11 +4,20 @@
 package TS29509NausfUEAuthentication
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
 
 	externalRef0 "magma/feg/gateway/sbi/specs/TS29503NudmUEAU"
 	externalRef1 "magma/feg/gateway/sbi/specs/TS29571CommonData"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
 const (
@@ -222,3 +231,544 @@ func (a UEAuthenticationCtx_Links) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(object)
 }
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// PostUeAuthentications request with any body
+	PostUeAuthenticationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUeAuthentications(ctx context.Context, body PostUeAuthenticationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutUeAuthenticationsAuthCtxId5gAkaConfirmation request with any body
+	PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithBody(ctx context.Context, authCtxId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutUeAuthenticationsAuthCtxId5gAkaConfirmation(ctx context.Context, authCtxId string, body PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EapAuthMethod request with any body
+	EapAuthMethodWithBody(ctx context.Context, authCtxId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EapAuthMethod(ctx context.Context, authCtxId string, body EapAuthMethodJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) PostUeAuthenticationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUeAuthenticationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUeAuthentications(ctx context.Context, body PostUeAuthenticationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUeAuthenticationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithBody(ctx context.Context, authCtxId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUeAuthenticationsAuthCtxId5gAkaConfirmationRequestWithBody(c.Server, authCtxId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutUeAuthenticationsAuthCtxId5gAkaConfirmation(ctx context.Context, authCtxId string, body PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUeAuthenticationsAuthCtxId5gAkaConfirmationRequest(c.Server, authCtxId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EapAuthMethodWithBody(ctx context.Context, authCtxId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEapAuthMethodRequestWithBody(c.Server, authCtxId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EapAuthMethod(ctx context.Context, authCtxId string, body EapAuthMethodJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEapAuthMethodRequest(c.Server, authCtxId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPostUeAuthenticationsRequest calls the generic PostUeAuthentications builder with application/json body
+func NewPostUeAuthenticationsRequest(server string, body PostUeAuthenticationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUeAuthenticationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUeAuthenticationsRequestWithBody generates requests for PostUeAuthentications with any type of body
+func NewPostUeAuthenticationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ue-authentications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutUeAuthenticationsAuthCtxId5gAkaConfirmationRequest calls the generic PutUeAuthenticationsAuthCtxId5gAkaConfirmation builder with application/json body
+func NewPutUeAuthenticationsAuthCtxId5gAkaConfirmationRequest(server string, authCtxId string, body PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUeAuthenticationsAuthCtxId5gAkaConfirmationRequestWithBody(server, authCtxId, "application/json", bodyReader)
+}
+
+// NewPutUeAuthenticationsAuthCtxId5gAkaConfirmationRequestWithBody generates requests for PutUeAuthenticationsAuthCtxId5gAkaConfirmation with any type of body
+func NewPutUeAuthenticationsAuthCtxId5gAkaConfirmationRequestWithBody(server string, authCtxId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "authCtxId", runtime.ParamLocationPath, authCtxId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ue-authentications/%s/5g-aka-confirmation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEapAuthMethodRequest calls the generic EapAuthMethod builder with application/json body
+func NewEapAuthMethodRequest(server string, authCtxId string, body EapAuthMethodJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEapAuthMethodRequestWithBody(server, authCtxId, "application/json", bodyReader)
+}
+
+// NewEapAuthMethodRequestWithBody generates requests for EapAuthMethod with any type of body
+func NewEapAuthMethodRequestWithBody(server string, authCtxId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "authCtxId", runtime.ParamLocationPath, authCtxId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ue-authentications/%s/eap-session", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostUeAuthentications request with any body
+	PostUeAuthenticationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUeAuthenticationsResponse, error)
+
+	PostUeAuthenticationsWithResponse(ctx context.Context, body PostUeAuthenticationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUeAuthenticationsResponse, error)
+
+	// PutUeAuthenticationsAuthCtxId5gAkaConfirmation request with any body
+	PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithBodyWithResponse(ctx context.Context, authCtxId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse, error)
+
+	PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithResponse(ctx context.Context, authCtxId string, body PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse, error)
+
+	// EapAuthMethod request with any body
+	EapAuthMethodWithBodyWithResponse(ctx context.Context, authCtxId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EapAuthMethodResponse, error)
+
+	EapAuthMethodWithResponse(ctx context.Context, authCtxId string, body EapAuthMethodJSONRequestBody, reqEditors ...RequestEditorFn) (*EapAuthMethodResponse, error)
+}
+
+type PostUeAuthenticationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUeAuthenticationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUeAuthenticationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConfirmationDataResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EapAuthMethodResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EapSession
+}
+
+// Status returns HTTPResponse.Status
+func (r EapAuthMethodResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EapAuthMethodResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostUeAuthenticationsWithBodyWithResponse request with arbitrary body returning *PostUeAuthenticationsResponse
+func (c *ClientWithResponses) PostUeAuthenticationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUeAuthenticationsResponse, error) {
+	rsp, err := c.PostUeAuthenticationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUeAuthenticationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUeAuthenticationsWithResponse(ctx context.Context, body PostUeAuthenticationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUeAuthenticationsResponse, error) {
+	rsp, err := c.PostUeAuthentications(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUeAuthenticationsResponse(rsp)
+}
+
+// PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithBodyWithResponse request with arbitrary body returning *PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse
+func (c *ClientWithResponses) PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithBodyWithResponse(ctx context.Context, authCtxId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse, error) {
+	rsp, err := c.PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithBody(ctx, authCtxId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithResponse(ctx context.Context, authCtxId string, body PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse, error) {
+	rsp, err := c.PutUeAuthenticationsAuthCtxId5gAkaConfirmation(ctx, authCtxId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse(rsp)
+}
+
+// EapAuthMethodWithBodyWithResponse request with arbitrary body returning *EapAuthMethodResponse
+func (c *ClientWithResponses) EapAuthMethodWithBodyWithResponse(ctx context.Context, authCtxId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EapAuthMethodResponse, error) {
+	rsp, err := c.EapAuthMethodWithBody(ctx, authCtxId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEapAuthMethodResponse(rsp)
+}
+
+func (c *ClientWithResponses) EapAuthMethodWithResponse(ctx context.Context, authCtxId string, body EapAuthMethodJSONRequestBody, reqEditors ...RequestEditorFn) (*EapAuthMethodResponse, error) {
+	rsp, err := c.EapAuthMethod(ctx, authCtxId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEapAuthMethodResponse(rsp)
+}
+
+// ParsePostUeAuthenticationsResponse parses an HTTP response from a PostUeAuthenticationsWithResponse call
+func ParsePostUeAuthenticationsResponse(rsp *http.Response) (*PostUeAuthenticationsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUeAuthenticationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse parses an HTTP response from a PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithResponse call
+func ParsePutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse(rsp *http.Response) (*PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutUeAuthenticationsAuthCtxId5gAkaConfirmationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConfirmationDataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEapAuthMethodResponse parses an HTTP response from a EapAuthMethodWithResponse call
+func ParseEapAuthMethodResponse(rsp *http.Response) (*EapAuthMethodResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EapAuthMethodResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EapSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/3gppHal+json) unsupported
+
+	}
+
+	return response, nil
+}
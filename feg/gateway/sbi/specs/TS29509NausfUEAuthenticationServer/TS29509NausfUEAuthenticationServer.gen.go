@@ -0,0 +1,327 @@
+// Package TS29509NausfUEAuthenticationServer provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.9.0 DO NOT EDIT.
+package TS29509NausfUEAuthenticationServer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	externalRef0 "magma/feg/gateway/sbi/specs/TS29503NudmUEAU"
+	externalRef1 "magma/feg/gateway/sbi/specs/TS29571CommonData"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	OAuth2ClientCredentialsScopes = "oAuth2ClientCredentials.Scopes"
+)
+
+// Defines values for AuthResult.
+const (
+	AuthResultAUTHENTICATIONFAILURE AuthResult = "AUTHENTICATION_FAILURE"
+
+	AuthResultAUTHENTICATIONONGOING AuthResult = "AUTHENTICATION_ONGOING"
+
+	AuthResultAUTHENTICATIONSUCCESS AuthResult = "AUTHENTICATION_SUCCESS"
+)
+
+// AuthResult defines model for AuthResult.
+type AuthResult string
+
+// AuthType defines model for AuthType.
+type AuthType interface{}
+
+// AuthenticationInfo defines model for AuthenticationInfo.
+type AuthenticationInfo struct {
+	Pei                   *externalRef1.Pei                   `json:"pei,omitempty"`
+	ResynchronizationInfo *externalRef0.ResynchronizationInfo `json:"resynchronizationInfo,omitempty"`
+	ServingNetworkName    externalRef0.ServingNetworkName     `json:"servingNetworkName"`
+	SupiOrSuci            externalRef0.SupiOrSuci             `json:"supiOrSuci"`
+	TraceData             *externalRef1.TraceData             `json:"traceData"`
+}
+
+// Av5gAka defines model for Av5gAka.
+type Av5gAka struct {
+	Autn      externalRef0.Autn `json:"autn"`
+	HxresStar HxresStar         `json:"hxresStar"`
+	Rand      externalRef0.Rand `json:"rand"`
+}
+
+// ConfirmationData defines model for ConfirmationData.
+type ConfirmationData struct {
+	ResStar *ResStar `json:"resStar"`
+}
+
+// ConfirmationDataResponse defines model for ConfirmationDataResponse.
+type ConfirmationDataResponse struct {
+	AuthResult AuthResult         `json:"authResult"`
+	Kseaf      *Kseaf             `json:"kseaf,omitempty"`
+	Supi       *externalRef1.Supi `json:"supi,omitempty"`
+}
+
+// contains an EAP packet
+type EapPayload string
+
+// EapSession defines model for EapSession.
+type EapSession struct {
+	Links      *EapSession_Links `json:"_links,omitempty"`
+	AuthResult *AuthResult       `json:"authResult,omitempty"`
+
+	// contains an EAP packet
+	EapPayload EapPayload         `json:"eapPayload"`
+	KSeaf      *Kseaf             `json:"kSeaf,omitempty"`
+	Supi       *externalRef1.Supi `json:"supi,omitempty"`
+}
+
+// EapSession_Links defines model for EapSession.Links.
+type EapSession_Links struct {
+	AdditionalProperties map[string]externalRef1.LinksValueSchema `json:"-"`
+}
+
+// HxresStar defines model for HxresStar.
+type HxresStar string
+
+// Kseaf defines model for Kseaf.
+type Kseaf string
+
+// ResStar defines model for ResStar.
+type ResStar string
+
+// UEAuthenticationCtx defines model for UEAuthenticationCtx.
+type UEAuthenticationCtx struct {
+	N5gAuthData        interface{}                      `json:"5gAuthData"`
+	Links              UEAuthenticationCtx_Links        `json:"_links"`
+	AuthType           AuthType                         `json:"authType"`
+	ServingNetworkName *externalRef0.ServingNetworkName `json:"servingNetworkName,omitempty"`
+}
+
+// UEAuthenticationCtx_Links defines model for UEAuthenticationCtx.Links.
+type UEAuthenticationCtx_Links struct {
+	AdditionalProperties map[string]externalRef1.LinksValueSchema `json:"-"`
+}
+
+// PostUeAuthenticationsJSONBody defines parameters for PostUeAuthentications.
+type PostUeAuthenticationsJSONBody AuthenticationInfo
+
+// PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONBody defines parameters for PutUeAuthenticationsAuthCtxId5gAkaConfirmation.
+type PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONBody ConfirmationData
+
+// EapAuthMethodJSONBody defines parameters for EapAuthMethod.
+type EapAuthMethodJSONBody EapSession
+
+// PostUeAuthenticationsJSONRequestBody defines body for PostUeAuthentications for application/json ContentType.
+type PostUeAuthenticationsJSONRequestBody PostUeAuthenticationsJSONBody
+
+// PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONRequestBody defines body for PutUeAuthenticationsAuthCtxId5gAkaConfirmation for application/json ContentType.
+type PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONRequestBody PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONBody
+
+// EapAuthMethodJSONRequestBody defines body for EapAuthMethod for application/json ContentType.
+type EapAuthMethodJSONRequestBody EapAuthMethodJSONBody
+
+// Getter for additional properties for EapSession_Links. Returns the specified
+// element and whether it was found
+func (a EapSession_Links) Get(fieldName string) (value externalRef1.LinksValueSchema, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for EapSession_Links
+func (a *EapSession_Links) Set(fieldName string, value externalRef1.LinksValueSchema) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]externalRef1.LinksValueSchema)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for EapSession_Links to handle AdditionalProperties
+func (a *EapSession_Links) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]externalRef1.LinksValueSchema)
+		for fieldName, fieldBuf := range object {
+			var fieldVal externalRef1.LinksValueSchema
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for EapSession_Links to handle AdditionalProperties
+func (a EapSession_Links) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UEAuthenticationCtx_Links. Returns the specified
+// element and whether it was found
+func (a UEAuthenticationCtx_Links) Get(fieldName string) (value externalRef1.LinksValueSchema, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UEAuthenticationCtx_Links
+func (a *UEAuthenticationCtx_Links) Set(fieldName string, value externalRef1.LinksValueSchema) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]externalRef1.LinksValueSchema)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UEAuthenticationCtx_Links to handle AdditionalProperties
+func (a *UEAuthenticationCtx_Links) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]externalRef1.LinksValueSchema)
+		for fieldName, fieldBuf := range object {
+			var fieldVal externalRef1.LinksValueSchema
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UEAuthenticationCtx_Links to handle AdditionalProperties
+func (a UEAuthenticationCtx_Links) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /ue-authentications)
+	PostUeAuthentications(ctx echo.Context) error
+
+	// (PUT /ue-authentications/{authCtxId}/5g-aka-confirmation)
+	PutUeAuthenticationsAuthCtxId5gAkaConfirmation(ctx echo.Context, authCtxId string) error
+
+	// (POST /ue-authentications/{authCtxId}/eap-session)
+	EapAuthMethod(ctx echo.Context, authCtxId string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// PostUeAuthentications converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUeAuthentications(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nausf-auth"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostUeAuthentications(ctx)
+	return err
+}
+
+// PutUeAuthenticationsAuthCtxId5gAkaConfirmation converts echo context to params.
+func (w *ServerInterfaceWrapper) PutUeAuthenticationsAuthCtxId5gAkaConfirmation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "authCtxId" -------------
+	var authCtxId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "authCtxId", runtime.ParamLocationPath, ctx.Param("authCtxId"), &authCtxId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter authCtxId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nausf-auth"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutUeAuthenticationsAuthCtxId5gAkaConfirmation(ctx, authCtxId)
+	return err
+}
+
+// EapAuthMethod converts echo context to params.
+func (w *ServerInterfaceWrapper) EapAuthMethod(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "authCtxId" -------------
+	var authCtxId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "authCtxId", runtime.ParamLocationPath, ctx.Param("authCtxId"), &authCtxId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter authCtxId: %s", err))
+	}
+
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"nausf-auth"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.EapAuthMethod(ctx, authCtxId)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/ue-authentications", wrapper.PostUeAuthentications)
+	router.PUT(baseURL+"/ue-authentications/:authCtxId/5g-aka-confirmation", wrapper.PutUeAuthenticationsAuthCtxId5gAkaConfirmation)
+	router.POST(baseURL+"/ue-authentications/:authCtxId/eap-session", wrapper.EapAuthMethod)
+
+}
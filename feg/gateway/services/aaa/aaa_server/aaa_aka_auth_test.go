@@ -31,6 +31,8 @@ import (
 	"magma/feg/gateway/services/eap/providers/aka"
 	"magma/feg/gateway/services/eap/providers/aka/servicers"
 	_ "magma/feg/gateway/services/eap/providers/aka/servicers/handlers"
+	akaprime_servicers "magma/feg/gateway/services/eap/providers/akaprime/servicers"
+	_ "magma/feg/gateway/services/eap/providers/akaprime/servicers/handlers"
 	eap_test "magma/feg/gateway/services/eap/test"
 	"magma/orc8r/cloud/go/test_utils"
 )
@@ -118,8 +120,10 @@ func TestEAPPeerNak(t *testing.T) {
 		eap.ResponseCode, 236,
 		append([]byte{eap.MethodIdentity}, []byte("6001010000000091@wlan.mnc001.mcc001.3gppnetwork.org")...))
 	permIdReq := []byte{0x01, 237, 0x00, 0x0c, 0x17, 0x05, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00}
+	akaPrimePermIdReq := []byte{0x01, 238, 0x00, 0x0c, 0x32, 0x05, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00}
 	akaPrimeNak := []byte{0x02, 237, 0x00, 0x06, 0x03, 50}
 	akaAkaPrimeNak := []byte{0x02, 236, 0x00, 0x07, 0x03, 50, 23}
+	unsupportedNak := []byte{0x02, 236, 0x00, 0x06, 0x03, 13}
 
 	eapSrv, eapLis, _ := test_utils.NewTestService(t, registry.ModuleName, registry.EAP_AKA)
 	servicer, err := servicers.NewEapAkaService(nil)
@@ -130,6 +134,15 @@ func TestEAPPeerNak(t *testing.T) {
 	eapp.RegisterEapServiceServer(eapSrv.GrpcServer, servicer)
 	go eapSrv.RunTest(eapLis, nil)
 
+	akaPrimeSrv, akaPrimeLis, _ := test_utils.NewTestService(t, registry.ModuleName, registry.EAP_AKA_PRIME)
+	akaPrimeServicer, err := akaprime_servicers.NewEapAkaPrimeService(nil)
+	if err != nil {
+		t.Fatalf("failed to create EAP AKA' Service: %v", err)
+		return
+	}
+	eapp.RegisterEapServiceServer(akaPrimeSrv.GrpcServer, akaPrimeServicer)
+	go akaPrimeSrv.RunTest(akaPrimeLis, nil)
+
 	rtrSrv, rtrLis, _ := test_utils.NewTestService(t, registry.ModuleName, registry.AAA_SERVER)
 	protos.RegisterAuthenticatorServer(rtrSrv.GrpcServer, &testAuthenticator{supportedMethods: eap_client.SupportedTypes()})
 	go rtrSrv.RunTest(rtrLis, nil)
@@ -147,15 +160,21 @@ func TestEAPPeerNak(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unexpected Error: %v", err)
 	}
-	if !reflect.DeepEqual(peap.GetPayload(), failureEAP) {
-		t.Fatalf("Unexpected AKA' Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), failureEAP)
+	if !reflect.DeepEqual(peap.GetPayload(), akaPrimePermIdReq) {
+		t.Fatalf("Unexpected AKA' Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), akaPrimePermIdReq)
 	}
 	peap, err = aaa_client.Handle(&protos.Eap{Payload: akaAkaPrimeNak, Ctx: eapCtx})
 	if err != nil {
 		t.Fatalf("Unexpected Error: %v", err)
 	}
-	if !reflect.DeepEqual(peap.GetPayload(), permIdReq) {
-		t.Fatalf("Unexpected AKA['] Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), permIdReq)
+	akaPrimePermIdReq[eap.EapMsgIdentifier] = 237
+	if !reflect.DeepEqual(peap.GetPayload(), akaPrimePermIdReq) {
+		t.Fatalf("Unexpected AKA['] Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), akaPrimePermIdReq)
+	}
+	peap, _ = aaa_client.Handle(&protos.Eap{Payload: unsupportedNak, Ctx: eapCtx})
+	failureEAP[eap.EapMsgIdentifier] = 236
+	if !reflect.DeepEqual(peap.GetPayload(), failureEAP) {
+		t.Fatalf("Unexpected Unsupported Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), failureEAP)
 	}
 }
 
@@ -23,6 +23,7 @@ import (
 	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/cloud/go/protos/mconfig"
 	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/aaa"
 	"magma/feg/gateway/services/aaa/protos"
 	"magma/feg/gateway/services/aaa/servicers"
 	"magma/feg/gateway/services/aaa/store"
@@ -37,17 +38,27 @@ const (
 	Version                        = "0.1"
 	AccountingReportingEnabledFlag = "acct_reporting_enabled"
 	AccountingReportingEnabledEnv  = "AAA_ACCT_REPORTING_ENABLED"
+	SessionStoreRedisAddrFlag      = "session_store_redis_addr"
+	SessionStoreRedisAddrEnv       = "AAA_SESSION_STORE_REDIS_ADDR"
 )
 
 var (
 	_ = flag.Bool(AccountingReportingEnabledFlag, false, "Enable base accounting reports")
+	_ = flag.String(SessionStoreRedisAddrFlag, "",
+		"Redis address to persist AAA sessions in, sessions are kept in memory only if not set")
 )
 
 func main() {
 	flag.Parse() // for glog
 
 	// Create a shared Session Table
-	sessions := store.NewMemorySessionTable()
+	var sessions aaa.SessionTable
+	if redisAddr := utils.GetValueOrEnv(SessionStoreRedisAddrFlag, SessionStoreRedisAddrEnv, ""); len(redisAddr) > 0 {
+		glog.Infof("Using Redis session store at %s", redisAddr)
+		sessions = store.NewRedisSessionTable(redisAddr)
+	} else {
+		sessions = store.NewMemorySessionTable()
+	}
 
 	// Create the EAP AKA Provider service
 	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.AAA_SERVER)
@@ -49,6 +49,9 @@ type Context struct {
 	AuthSessionId string `protobuf:"bytes,9,opt,name=auth_session_id,json=authSessionId,proto3" json:"auth_session_id,omitempty"`
 	AcctSessionId string `protobuf:"bytes,10,opt,name=acct_session_id,json=acctSessionId,proto3" json:"acct_session_id,omitempty"`
 	CreatedTimeMs uint64 `protobuf:"varint,11,opt,name=created_time_ms,json=createdTimeMs,proto3" json:"created_time_ms,omitempty"`
+	// subscribed UE AMBR the session was authorized with
+	AmbrUl uint32 `protobuf:"varint,12,opt,name=ambr_ul,json=ambrUl,proto3" json:"ambr_ul,omitempty"`
+	AmbrDl uint32 `protobuf:"varint,13,opt,name=ambr_dl,json=ambrDl,proto3" json:"ambr_dl,omitempty"`
 }
 
 func (x *Context) Reset() {
@@ -160,6 +163,20 @@ func (x *Context) GetCreatedTimeMs() uint64 {
 	return 0
 }
 
+func (x *Context) GetAmbrUl() uint32 {
+	if x != nil {
+		return x.AmbrUl
+	}
+	return 0
+}
+
+func (x *Context) GetAmbrDl() uint32 {
+	if x != nil {
+		return x.AmbrDl
+	}
+	return 0
+}
+
 type Void struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -202,7 +219,7 @@ var File_context_proto protoreflect.FileDescriptor
 
 var file_context_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0a, 0x61, 0x61, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x07,
+	0x0a, 0x61, 0x61, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x22, 0xf2, 0x02, 0x0a, 0x07,
 	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
 	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
 	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6d, 0x73, 0x69, 0x18, 0x02,
@@ -222,11 +239,14 @@ var file_context_proto_rawDesc = []byte{
 	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x74, 0x53, 0x65, 0x73,
 	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0x06,
-	0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x42, 0x27, 0x5a, 0x25, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f,
-	0x66, 0x65, 0x67, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x61, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x61, 0x6d, 0x62, 0x72, 0x5f, 0x75, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x61, 0x6d, 0x62, 0x72, 0x55, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x6d, 0x62, 0x72, 0x5f,
+	0x64, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6d, 0x62, 0x72, 0x44, 0x6c,
+	0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x42, 0x27, 0x5a, 0x25, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2f, 0x66, 0x65, 0x67, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x61, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
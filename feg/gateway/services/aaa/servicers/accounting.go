@@ -62,6 +62,11 @@ func NewAccountingService(sessions aaa.SessionTable, cfg *mconfig.AAAConfig) (*a
 	if cfg != nil && cfg.GetAcctReportingEnabled() {
 		base_acct.StartBaseAccountingHeartbeat()
 	}
+	if persistent, ok := sessions.(aaa.PersistentSessionTable); ok {
+		if _, err := persistent.Restore(srv.timeoutSessionNotifier); err != nil {
+			glog.Errorf("failed to restore AAA sessions: %v", err)
+		}
+	}
 	return srv, nil
 }
 
@@ -37,6 +37,8 @@ const (
 	MinIMSILen = 10
 	MaxIMSILen = 16
 	ImsiPrefix = "IMSI"
+	// WildcardAPN is Service-Selection of an APN configuration matching any APN, see 3GPP TS 23.003
+	WildcardAPN = "*"
 )
 
 // AbortSession is a method of AbortSessionResponder service.
@@ -210,6 +212,101 @@ func (srv *accountingService) TerminateRegistration(
 	return res, err
 }
 
+// PushProfile is a method of SWx Gateway Service Responder service, it applies the user profile
+// pushed by HSS to the user's session & terminates the session if non 3GPP access is no longer allowed
+// or the session was authorized with a subscribed APN or AMBR the profile no longer carries, the UE
+// then re-authenticates & gets a new session with the updated profile
+func (srv *accountingService) PushProfile(
+	ctx context.Context, req *fegprotos.PushProfileRequest) (*fegprotos.PushProfileAnswer, error) {
+
+	res := &fegprotos.PushProfileAnswer{}
+	if req == nil {
+		return res, Errorf(codes.InvalidArgument, "Nil PPR Request")
+	}
+	res.SessionId = req.GetSessionId()
+	imsi := req.GetUserName()
+	if len(imsi) < MinIMSILen {
+		return res, Errorf(codes.InvalidArgument, "Invalid PPR IMSI: %s", imsi)
+	}
+	profile := req.GetUserProfile()
+	if profile == nil {
+		return res, nil // nothing to update
+	}
+	imsi = strings.TrimPrefix(imsi, ImsiPrefix)
+	sid := srv.sessions.FindSession(imsi)
+	if len(sid) == 0 {
+		return res, Errorf(codes.NotFound, "Session for PPR IMSI: %s is not found", imsi)
+	}
+	s := srv.sessions.GetSession(sid)
+	if s == nil {
+		return res, Errorf(codes.Internal, "Session for RadSID: %s and PPR IMSI: %s is not found", sid, imsi)
+	}
+	s.Lock()
+	sctx := proto.Clone(s.GetCtx()).(*protos.Context)
+	s.Unlock()
+	if reason := staleProfileReason(sctx, profile); len(reason) > 0 {
+		glog.Infof("terminating session %s of PPR IMSI: %s; %s", sid, imsi, reason)
+		code := srv.disconnectUser(imsi, "SWx PPR")
+		if code != fegprotos.ErrorCode_SUCCESS {
+			errMsg := fmt.Sprintf("failed to terminate session of PPR IMSI: %s; result: %s", imsi, code)
+			if srv.config.GetEventLoggingEnabled() {
+				events.LogSessionTerminationFailedEvent(sctx, events.ProfileUpdate, errMsg)
+			}
+			return res, Errorf(codes.Internal, errMsg)
+		}
+		if srv.config.GetEventLoggingEnabled() {
+			events.LogSessionTerminationSucceededEvent(sctx, events.ProfileUpdate)
+		}
+		return res, nil
+	}
+	s.Lock()
+	if msisdn := profile.GetMsisdn(); len(msisdn) > 0 && s.GetCtx().GetMsisdn() != msisdn {
+		glog.V(2).Infof("updating MSISDN of IMSI %s session %s to %s", imsi, sid, msisdn)
+		s.GetCtx().Msisdn = msisdn
+	}
+	if ambr := profile.GetAmbr(); ambr != nil {
+		s.GetCtx().AmbrUl = ambr.GetMaxBandwidthUl()
+		s.GetCtx().AmbrDl = ambr.GetMaxBandwidthDl()
+	}
+	s.Unlock()
+	return res, nil
+}
+
+// staleProfileReason returns why the session described by sctx can no longer be served under the pushed
+// profile or an empty string if the profile can be applied to the session in place
+func staleProfileReason(sctx *protos.Context, profile *fegprotos.AuthenticationAnswer_UserProfile) string {
+	if profile.GetNon_3GppIpAccessBarred() || profile.GetNon_3GppIpAccessApnDisabled() {
+		return "non 3GPP access is barred"
+	}
+	if apn := sctx.GetApn(); len(apn) > 0 && len(profile.GetApnConfigurations()) > 0 &&
+		!isApnSubscribed(apn, profile.GetApnConfigurations()) {
+		return fmt.Sprintf("APN %s is no longer subscribed", apn)
+	}
+	// sessions authorized without a subscribed AMBR (S6a, profile not retrieved) pick it up in place
+	if ambr := profile.GetAmbr(); ambr != nil && (sctx.GetAmbrUl() != 0 || sctx.GetAmbrDl() != 0) &&
+		(ambr.GetMaxBandwidthUl() != sctx.GetAmbrUl() || ambr.GetMaxBandwidthDl() != sctx.GetAmbrDl()) {
+		return fmt.Sprintf("subscribed AMBR changed from UL: %d, DL: %d to UL: %d, DL: %d",
+			sctx.GetAmbrUl(), sctx.GetAmbrDl(), ambr.GetMaxBandwidthUl(), ambr.GetMaxBandwidthDl())
+	}
+	return ""
+}
+
+// isApnSubscribed returns true if the session's APN (or its name part if APN is a composite
+// AP MAC:name Called-Station-Id) matches Service-Selection of one of the APN configurations
+func isApnSubscribed(apn string, configs []*fegprotos.AuthenticationAnswer_UserProfile_APNConfiguration) bool {
+	apnName := apn
+	if idx := strings.LastIndex(apn, ":"); idx >= 0 {
+		apnName = apn[idx+1:]
+	}
+	for _, cfg := range configs {
+		selection := cfg.GetServiceSelection()
+		if selection == WildcardAPN || strings.EqualFold(selection, apn) || strings.EqualFold(selection, apnName) {
+			return true
+		}
+	}
+	return false
+}
+
 // CancelLocation fulfills S6a's CLR and disconnect UE from AAA if successful
 func (srv *accountingService) CancelLocation(
 	_ context.Context, req *fegprotos.CancelLocationRequest) (*fegprotos.CancelLocationAnswer, error) {
@@ -222,7 +319,7 @@ func (srv *accountingService) CancelLocation(
 	if len(imsi) < MinIMSILen {
 		return res, Errorf(codes.InvalidArgument, "Invalid CLR IMSI: %s", imsi)
 	}
-	res.ErrorCode = srv.s6aDisconnectUser(imsi)
+	res.ErrorCode = srv.disconnectUser(imsi, "S6a")
 	return res, nil
 }
 
@@ -243,7 +340,7 @@ func (srv *accountingService) Reset(_ context.Context, req *fegprotos.ResetReque
 			glog.Errorf("Invalid RSR IMSI: %s", imsi)
 			continue
 		}
-		diamCode := srv.s6aDisconnectUser(imsi)
+		diamCode := srv.disconnectUser(imsi, "S6a")
 		switch diamCode {
 		case fegprotos.ErrorCode_SUCCESS:
 			if res.ErrorCode == fegprotos.ErrorCode_UNDEFINED {
@@ -264,16 +361,17 @@ func (srv *accountingService) Reset(_ context.Context, req *fegprotos.ResetReque
 	return res, nil
 }
 
-func (srv *accountingService) s6aDisconnectUser(imsi string) fegprotos.ErrorCode {
+// disconnectUser terminates IMSI's session on behalf of the given interface (S6a CLR/RSR, SWx PPR)
+func (srv *accountingService) disconnectUser(imsi, iface string) fegprotos.ErrorCode {
 	imsi = strings.TrimPrefix(imsi, ImsiPrefix)
 	sid := srv.sessions.FindSession(imsi)
 	if len(sid) == 0 {
-		glog.Errorf("radius session for S6a IMSI: %s is not found", imsi)
+		glog.Errorf("radius session for %s IMSI: %s is not found", iface, imsi)
 		return fegprotos.ErrorCode_USER_UNKNOWN
 	}
 	s := srv.sessions.GetSession(sid)
 	if s == nil {
-		glog.Errorf("Session for radius SID: %s and S6a IMSI: %s is not found", sid, imsi)
+		glog.Errorf("Session for radius SID: %s and %s IMSI: %s is not found", sid, iface, imsi)
 		return fegprotos.ErrorCode_UNKNOWN_SESSION_ID
 	}
 	s.Lock()
@@ -288,13 +386,13 @@ func (srv *accountingService) s6aDisconnectUser(imsi string) fegprotos.ErrorCode
 		_, err := session_manager.EndSession(&lteprotos.LocalEndSessionRequest{Sid: sid, Apn: sctx.GetApn()})
 		metrics.EndSession.WithLabelValues(sctx.GetApn(), sid.Id, sctx.GetMsisdn()).Inc()
 		if err != nil {
-			glog.Errorf("EndSession failure: %v for S6a IMSI: %s", err, imsi)
+			glog.Errorf("EndSession failure: %v for %s IMSI: %s", err, iface, imsi)
 		}
 	}
 	srv.sessions.RemoveSession(sid)
 	err := srv.dae.Disconnect(sctx)
 	if err != nil {
-		glog.Errorf("DAE failure: %v for S6a IMSI: %s", err, imsi)
+		glog.Errorf("DAE failure: %v for %s IMSI: %s", err, iface, imsi)
 		return fegprotos.ErrorCode_LIMITED_SUCCESS
 	}
 	return fegprotos.ErrorCode_SUCCESS
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package servicers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/aaa/servicers"
+)
+
+func TestPushProfileUpdatesSession(t *testing.T) {
+	aaaCtx := getAAAcontext(SESSIONID1, IMSI1)
+	sessionTable := createSessionTableWithAuthenticatedUE(t, aaaCtx)
+	accService, err := servicers.NewAccountingService(sessionTable, getAAAConfig())
+	assert.NoError(t, err)
+
+	_, err = accService.PushProfile(context.Background(), &fegprotos.PushProfileRequest{
+		UserName: imsiPrefix + IMSI1,
+		UserProfile: &fegprotos.AuthenticationAnswer_UserProfile{
+			Msisdn: "0015557654321",
+			Ambr:   &fegprotos.AuthenticationAnswer_UserProfile_AMBR{MaxBandwidthUl: 1000, MaxBandwidthDl: 2000},
+			ApnConfigurations: []*fegprotos.AuthenticationAnswer_UserProfile_APNConfiguration{
+				{ContextId: 1, ServiceSelection: "wifi-offload-hotspot20"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	s := sessionTable.GetSession(sessionTable.FindSession(IMSI1))
+	assert.NotNil(t, s)
+	s.Lock()
+	assert.Equal(t, "0015557654321", s.GetCtx().GetMsisdn())
+	assert.Equal(t, uint32(1000), s.GetCtx().GetAmbrUl())
+	assert.Equal(t, uint32(2000), s.GetCtx().GetAmbrDl())
+	s.Unlock()
+
+	// unknown IMSI
+	_, err = accService.PushProfile(context.Background(), &fegprotos.PushProfileRequest{
+		UserName:    imsiPrefix + "001010000000001",
+		UserProfile: &fegprotos.AuthenticationAnswer_UserProfile{Msisdn: "0015557654321"},
+	})
+	assert.Error(t, err)
+}
@@ -70,3 +70,11 @@ type SessionTable interface {
 	// SetTimeout - [Re]sets the session's cleanup timeout to fire after tout duration
 	SetTimeout(sid string, tout time.Duration, callback TimeoutNotifier) bool
 }
+
+// PersistentSessionTable - SessionTable which keeps its sessions across AAA server restarts
+type PersistentSessionTable interface {
+	SessionTable
+	// Restore loads persisted sessions into the table & re-arms their idle timeouts with the given notifier,
+	// returns the number of restored sessions
+	Restore(notifier TimeoutNotifier) (int, error)
+}
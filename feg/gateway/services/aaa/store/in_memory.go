@@ -15,6 +15,7 @@ limitations under the License.
 package store
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 	"sync"
@@ -35,6 +36,16 @@ type memSession struct {
 	imsi            string
 	cleanupTimerCtx unsafe.Pointer // *cleanupTimerCtx
 	mu              sync.Mutex
+	removed         int32 // set when the session is removed from its table
+
+	// persistence state of persistent tables, Context versions are written outside of the session lock
+	// in the version order, persistMu serializes the writes & removal of the session
+	persister        sessionPersister // nil for in memory only tables
+	sid              string
+	version          uint64 // last marshaled Context version, guarded by mu
+	persistMu        sync.Mutex
+	persisted        []byte // last persisted marshaled Context
+	persistedVersion uint64
 }
 
 // Lock - locks the Session's mutex
@@ -44,10 +55,74 @@ func (s *memSession) Lock() {
 	}
 }
 
-// Unlock - unlocks the Session's mutex
+// Unlock - unlocks the Session's mutex, Context changes made while the session was locked are persisted
+// by persistent tables once the mutex is released
 func (s *memSession) Unlock() {
 	if s != nil {
+		marshaled, version := s.marshalCtxLocked()
 		s.mu.Unlock()
+		s.persistCtx(marshaled, version)
+	}
+}
+
+// marshalCtxLocked returns the marshaled Context of a locked session & its version,
+// nil if the session isn't persisted or was removed
+func (s *memSession) marshalCtxLocked() ([]byte, uint64) {
+	if s.persister == nil || atomic.LoadInt32(&s.removed) != 0 {
+		return nil, 0
+	}
+	marshaled, err := s.persister.marshalCtx(s.Context)
+	if err != nil {
+		glog.Errorf("failed to marshal session '%s': %v", s.sid, err)
+		return nil, 0
+	}
+	s.version++
+	return marshaled, s.version
+}
+
+// persistCtx writes a marshaled Context unless the session was removed or a newer version was written
+func (s *memSession) persistCtx(marshaled []byte, version uint64) {
+	if marshaled == nil {
+		return
+	}
+	s.persistMu.Lock()
+	defer s.persistMu.Unlock()
+	if atomic.LoadInt32(&s.removed) != 0 || version <= s.persistedVersion {
+		return
+	}
+	if !bytes.Equal(marshaled, s.persisted) {
+		if err := s.persister.saveCtx(s.sid, marshaled); err != nil {
+			glog.Errorf("failed to persist session '%s': %v", s.sid, err)
+			return
+		}
+	}
+	s.persisted, s.persistedVersion = marshaled, version
+}
+
+// persistTimeout writes the session's idle timeout deadline unless the session was removed
+func (s *memSession) persistTimeout(deadline time.Time) {
+	if s.persister == nil {
+		return
+	}
+	s.persistMu.Lock()
+	defer s.persistMu.Unlock()
+	if atomic.LoadInt32(&s.removed) == 0 {
+		s.persister.saveTimeout(s.sid, deadline)
+	}
+}
+
+// markRemoved marks the session removed from its table, so its Context is no longer persisted, & waits for
+// an in flight write to complete. The persisted session is deleted unless deletePersisted is false, i.e. a new
+// session with the same ID overwrites it
+func (s *memSession) markRemoved(deletePersisted bool) {
+	atomic.StoreInt32(&s.removed, 1)
+	if s.persister == nil {
+		return
+	}
+	s.persistMu.Lock()
+	defer s.persistMu.Unlock()
+	if deletePersisted {
+		s.persister.remove(s.sid)
 	}
 }
 
@@ -78,11 +153,24 @@ func (s *memSession) StopTimeout() bool {
 	return false
 }
 
+// sessionPersister is a backing store which keeps session table changes across restarts
+type sessionPersister interface {
+	// marshalCtx marshals the session's Context to be persisted
+	marshalCtx(pc *protos.Context) ([]byte, error)
+	// saveCtx persists the session's marshaled Context
+	saveCtx(sid string, marshaled []byte) error
+	// saveTimeout persists the session's idle timeout deadline
+	saveTimeout(sid string, deadline time.Time)
+	// remove deletes the persisted session
+	remove(sid string)
+}
+
 // SessionTable - synchronized map of authenticated sessions
 type memSessionTable struct {
-	sm   map[string]*memSession
-	sids map[string]string // Session IDs by IMSI: SID[IMSI]
-	rwl  sync.RWMutex      // R/W lock synchronizing maps access
+	sm        map[string]*memSession
+	sids      map[string]string // Session IDs by IMSI: SID[IMSI]
+	rwl       sync.RWMutex      // R/W lock synchronizing maps access
+	persister sessionPersister  // optional, nil for in memory only tables
 }
 
 // NewSessionTable - returns a new initialized session table
@@ -112,7 +200,8 @@ func (st *memSessionTable) AddSession(
 
 	imsi := pc.GetImsi()
 	msisdn := pc.GetMsisdn()
-	s := &memSession{Context: pc, imsi: imsi}
+	s := &memSession{Context: pc, imsi: imsi, sid: sid, persister: st.persister}
+	var overwritten, oldImsiSession *memSession
 	st.rwl.Lock()
 
 	// Handle the case of old session with the same radius session ID
@@ -126,6 +215,8 @@ func (st *memSessionTable) AddSession(
 					sid, oldImsi, imsi)
 
 				oldSession.StopTimeout()
+				atomic.StoreInt32(&oldSession.removed, 1)
+				overwritten = oldSession
 
 				if oldImsi != imsi {
 					isExistingSession = false
@@ -142,9 +233,10 @@ func (st *memSessionTable) AddSession(
 	}
 	// Handle the case of old session with the same IMSI and different radius session ID (roaming?)
 	if oldSessionId, ok := st.sids[imsi]; ok && oldSessionId != sid {
-		if oldImsiSession, ok := st.sm[oldSessionId]; ok {
+		if oldImsiSession, ok = st.sm[oldSessionId]; ok {
 			if oldImsiSession != nil {
 				oldImsiSession.StopTimeout()
+				atomic.StoreInt32(&oldImsiSession.removed, 1)
 			}
 			delete(st.sids, oldSessionId)
 			updateSessionMetricsForRemovedSession(oldImsiSession.GetApn(), imsi, oldSessionId, msisdn)
@@ -158,6 +250,19 @@ func (st *memSessionTable) AddSession(
 
 	glog.V(1).Infof("setting timeout of %f seconds for session: %s", tout.Seconds(), sid)
 	setTimeoutUnsafe(st, sid, tout, s, notifier)
+	if overwritten != nil {
+		overwritten.markRemoved(false)
+	}
+	if oldImsiSession != nil {
+		oldImsiSession.markRemoved(true)
+	}
+	if st.persister != nil {
+		s.mu.Lock()
+		marshaled, version := s.marshalCtxLocked()
+		s.mu.Unlock()
+		s.persistCtx(marshaled, version)
+		s.persistTimeout(time.Now().Add(tout))
+	}
 	if !isExistingSession {
 		updateSessionMetricsForNewSession(apn, imsi, sid, msisdn)
 	}
@@ -221,6 +326,7 @@ func (st *memSessionTable) RemoveSession(sid string) aaa.Session {
 		st.rwl.Unlock()
 		if found {
 			s.StopTimeout()
+			s.markRemoved(true)
 			updateSessionMetricsForRemovedSession(apn, imsi, sid, msisdn)
 			return s
 		}
@@ -230,16 +336,18 @@ func (st *memSessionTable) RemoveSession(sid string) aaa.Session {
 
 // SetTimeout - [Re]sets the session's cleanup timeout to fire after tout duration
 func (st *memSessionTable) SetTimeout(sid string, tout time.Duration, notifier aaa.TimeoutNotifier) bool {
-	var res bool
+	var s *memSession
 	if tout > 0 && st != nil && len(sid) > 0 {
 		st.rwl.Lock()
-		if s, ok := st.sm[sid]; ok && s != nil {
+		if s = st.sm[sid]; s != nil {
 			setTimeoutUnsafe(st, sid, tout, s, notifier)
-			res = true
 		}
 		st.rwl.Unlock()
+		if s != nil {
+			s.persistTimeout(time.Now().Add(tout))
+		}
 	}
-	return res
+	return s != nil
 }
 
 type cleanupTimerCtx struct {
@@ -278,6 +386,7 @@ func cleanupTimer(ctx *cleanupTimerCtx) {
 		if deleted {
 			var notifyResult error
 			s := ctx.s
+			s.markRemoved(true)
 			if ctx.notifyRoutine != nil {
 				notifyResult = ctx.notifyRoutine(s)
 			}
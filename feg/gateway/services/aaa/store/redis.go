@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/gateway/redis"
+)
+
+const (
+	// Redis state types of persisted session contexts & their idle timeout deadlines
+	SessionStateType        = "aaa_session"
+	SessionTimeoutStateType = "aaa_session_timeout"
+)
+
+// persistedSession is a Redis record of a session's Context. The MSK is not persisted: it's only needed to
+// build the Access-Accept of the authentication which created the session, so no key material is stored
+type persistedSession struct {
+	Ctx []byte `json:"ctx"` // marshaled protos.Context
+}
+
+// persistedTimeout is a Redis record of a session's idle timeout deadline
+type persistedTimeout struct {
+	DeadlineMs int64 `json:"deadline_ms"`
+}
+
+// redisPersister implements sessionPersister, it writes sessions through to Redis
+type redisPersister struct {
+	sessions *redis.RedisStateClient
+	timeouts *redis.RedisStateClient
+}
+
+// redisSessionTable - in memory session table with sessions persisted in Redis
+type redisSessionTable struct {
+	*memSessionTable
+	persister *redisPersister
+}
+
+// NewRedisSessionTable returns a new session table which persists its sessions in Redis at redisAddr.
+// Persisted sessions are not loaded until Restore is called.
+func NewRedisSessionTable(redisAddr string) aaa.PersistentSessionTable {
+	persister := &redisPersister{
+		sessions: redis.NewDefaultRedisStateClient(
+			redisAddr, redis.NewJsonStateSerde(SessionStateType, &persistedSession{})),
+		timeouts: redis.NewDefaultRedisStateClient(
+			redisAddr, redis.NewJsonStateSerde(SessionTimeoutStateType, &persistedTimeout{})),
+	}
+	return &redisSessionTable{
+		memSessionTable: &memSessionTable{
+			sm: map[string]*memSession{}, sids: map[string]string{}, persister: persister},
+		persister: persister,
+	}
+}
+
+// Restore loads persisted sessions into the table & re-arms their idle timeouts with the given notifier.
+// Sessions which idle timeouts expired while the server was down are timed out (and notified) right away.
+func (st *redisSessionTable) Restore(notifier aaa.TimeoutNotifier) (int, error) {
+	if st == nil {
+		return 0, fmt.Errorf("Nil SessionTable")
+	}
+	persisted, err := st.persister.sessions.GetAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to load persisted sessions: %v", err)
+	}
+	deadlines, err := st.persister.timeouts.GetAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to load persisted session timeouts: %v", err)
+	}
+	now := time.Now()
+	var restored int
+	for sid, ps := range persisted {
+		pc := &protos.Context{}
+		marshaled := ps.(*persistedSession).Ctx
+		if err = proto.Unmarshal(marshaled, pc); err != nil || pc.GetSessionId() != sid {
+			glog.Errorf("invalid persisted session '%s': %v", sid, err)
+			st.persister.remove(sid)
+			continue
+		}
+		tout := aaa.DefaultSessionTimeout
+		if pt, ok := deadlines[sid]; ok {
+			tout = time.Unix(0, pt.(*persistedTimeout).DeadlineMs*int64(time.Millisecond)).Sub(now)
+		}
+		if tout < aaa.MinimalSessionTimeout {
+			tout = aaa.MinimalSessionTimeout
+		}
+		imsi := pc.GetImsi()
+		s := &memSession{Context: pc, imsi: imsi, sid: sid, persister: st.persister, persisted: marshaled}
+
+		st.rwl.Lock()
+		if _, exists := st.sm[sid]; exists {
+			st.rwl.Unlock()
+			continue
+		}
+		st.sm[sid] = s
+		// several persisted sessions of the same IMSI should not happen, if they do - index the most recent one
+		if oldSid, ok := st.sids[imsi]; !ok || st.sm[oldSid].GetCreatedTimeMs() < pc.GetCreatedTimeMs() {
+			st.sids[imsi] = sid
+		}
+		setTimeoutUnsafe(st.memSessionTable, sid, tout, s, notifier)
+		st.rwl.Unlock()
+
+		updateSessionMetricsForNewSession(pc.GetApn(), imsi, sid, pc.GetMsisdn())
+		restored++
+	}
+	glog.Infof("restored %d of %d persisted AAA sessions", restored, len(persisted))
+	return restored, nil
+}
+
+func (p *redisPersister) marshalCtx(pc *protos.Context) ([]byte, error) {
+	if len(pc.GetMsk()) > 0 {
+		pc = proto.Clone(pc).(*protos.Context)
+		pc.Msk = nil
+	}
+	return proto.Marshal(pc)
+}
+
+func (p *redisPersister) saveCtx(sid string, marshaled []byte) error {
+	return p.sessions.Set(sid, &persistedSession{Ctx: marshaled})
+}
+
+func (p *redisPersister) saveTimeout(sid string, deadline time.Time) {
+	err := p.timeouts.Set(sid, &persistedTimeout{DeadlineMs: deadline.UnixNano() / int64(time.Millisecond)})
+	if err != nil {
+		glog.Errorf("failed to persist timeout of session '%s': %v", sid, err)
+	}
+}
+
+func (p *redisPersister) remove(sid string) {
+	if _, err := p.sessions.Delete(sid); err != nil {
+		glog.Errorf("failed to remove persisted session '%s': %v", sid, err)
+	}
+	if _, err := p.timeouts.Delete(sid); err != nil {
+		glog.Errorf("failed to remove persisted timeout of session '%s': %v", sid, err)
+	}
+}
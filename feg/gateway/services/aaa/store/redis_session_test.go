@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package store_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/aaa/store"
+)
+
+func TestRedisSessionTable(t *testing.T) {
+	mockRedis, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mockRedis.Close()
+
+	st := store.NewRedisSessionTable(mockRedis.Addr())
+	n, err := st.Restore(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	sid1, sid2, sid3 := aaa.CreateSessionId(), aaa.CreateSessionId(), aaa.CreateSessionId()
+	imsi1, imsi2, imsi3 := "001010000000001", "001010000000002", "001010000000003"
+
+	s1, err := st.AddSession(&protos.Context{SessionId: sid1, Imsi: imsi1, Apn: "magma", Msk: []byte("msk")}, time.Minute, nil)
+	assert.NoError(t, err)
+	_, err = st.AddSession(&protos.Context{SessionId: sid2, Imsi: imsi2}, time.Minute, nil)
+	assert.NoError(t, err)
+	s3, err := st.AddSession(&protos.Context{SessionId: sid3, Imsi: imsi3}, time.Minute, nil)
+	assert.NoError(t, err)
+
+	// Context changes made under the session lock must be persisted
+	s1.Lock()
+	s1.GetCtx().AcctSessionId = "acct-1"
+	s1.Unlock()
+
+	// removed sessions must not be restored
+	assert.NotNil(t, st.RemoveSession(sid2))
+
+	// sessions which idle timeout expires while the server is down must time out on restore
+	assert.True(t, st.SetTimeout(sid3, time.Millisecond*20, nil))
+	assert.True(t, s3.StopTimeout())
+
+	// "Restart" AAA server
+	time.Sleep(time.Millisecond * 50)
+	restarted := store.NewRedisSessionTable(mockRedis.Addr())
+	var timedOut int32
+	n, err = restarted.Restore(func(s aaa.Session) error {
+		if s.GetCtx().GetSessionId() == sid3 {
+			atomic.StoreInt32(&timedOut, 1)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	assert.Equal(t, sid1, restarted.FindSession(imsi1))
+	rs1 := restarted.GetSessionByImsi(imsi1)
+	assert.NotNil(t, rs1)
+	rs1.Lock()
+	assert.Equal(t, "acct-1", rs1.GetCtx().GetAcctSessionId())
+	assert.Equal(t, "magma", rs1.GetCtx().GetApn())
+	// key material isn't persisted
+	assert.Empty(t, rs1.GetCtx().GetMsk())
+	rs1.Unlock()
+
+	assert.Nil(t, restarted.GetSession(sid2))
+	assert.Equal(t, "", restarted.FindSession(imsi2))
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&timedOut))
+	assert.Nil(t, restarted.GetSession(sid3))
+	assert.Equal(t, "", restarted.FindSession(imsi3))
+
+	// timed out & removed sessions must be deleted from the store
+	assert.NotNil(t, restarted.RemoveSession(sid1))
+	n, err = store.NewRedisSessionTable(mockRedis.Addr()).Restore(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestRedisSessionTableRemoveWhileUpdating(t *testing.T) {
+	mockRedis, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer mockRedis.Close()
+
+	st := store.NewRedisSessionTable(mockRedis.Addr())
+	for i := 0; i < 20; i++ {
+		sid := aaa.CreateSessionId()
+		s, err := st.AddSession(&protos.Context{SessionId: sid, Imsi: "001010000000001"}, time.Minute, nil)
+		assert.NoError(t, err)
+
+		// updates racing with the removal must not resurrect the persisted session
+		var wg sync.WaitGroup
+		for j := 0; j < 4; j++ {
+			wg.Add(1)
+			go func(j int) {
+				defer wg.Done()
+				for k := 0; k < 10; k++ {
+					s.Lock()
+					s.GetCtx().AcctSessionId = fmt.Sprintf("acct-%d-%d", j, k)
+					s.Unlock()
+				}
+			}(j)
+		}
+		assert.NotNil(t, st.RemoveSession(sid))
+		wg.Wait()
+	}
+	n, err := store.NewRedisSessionTable(mockRedis.Addr()).Restore(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
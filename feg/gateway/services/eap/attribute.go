@@ -41,7 +41,7 @@ func NewAttribute(typ AttrType, data []byte) attribute {
 	pad := (4 - l&3) & 3
 	l += pad
 	res := make([]byte, 2, l)
-	res[0], res[1] = byte(typ), byte(l<<2)
+	res[0], res[1] = byte(typ), byte(l>>2)
 	if ld > 0 {
 		res = append(res, data...)
 	}
@@ -59,6 +59,12 @@ const (
 	AT_NEXT_REAUTH_ID    eap.AttrType = 133
 	AT_CHECKCODE         eap.AttrType = 134
 	AT_RESULT_IND        eap.AttrType = 135
+	AT_BIDDING           eap.AttrType = 136
+)
+
+const (
+	// BIDDING_D_BIT is set in the first byte of AT_BIDDING value if the server supports EAP-AKA' (RFC 5448, section 4)
+	BIDDING_D_BIT = 0x80
 )
 
 const (
@@ -101,6 +107,7 @@ const (
 	StateChallenge              // Auth Challenge was returned to UE
 	StateAuthenticated          // UE is successfully authenticated
 	StateRedirected             // UE is redirected to another Auth method (SIM, AKA', etc.)
+	StateReauth                 // Fast Re-authentication Request was returned to UE
 )
 
 const (
@@ -109,6 +116,8 @@ const (
 	RAND_LEN    = 16
 	RandAutnLen = RAND_LEN + AUTN_LEN
 	MAC_LEN     = 16
+	IV_LEN      = 16
+	NONCE_S_LEN = 16
 
 	AT_RAND_ATTR_LEN = AUTN_LEN + ATT_HDR_LEN
 	AT_AUTN_ATTR_LEN = RAND_LEN + ATT_HDR_LEN
@@ -118,6 +127,15 @@ const (
 	DefaultErrorNotificationTimeout    = time.Second * 10
 	DefaultSessionTimeout              = time.Hour * 12
 	DefaultSessionAuthenticatedTimeout = time.Second * 5
+	DefaultReauthCtxTimeout            = time.Hour * 12
+	DefaultMaxReauthCounter            = 16
+)
+
+const (
+	// EAP-AKA Identity prefixes, see 3GPP TS 23.003, section 19.3.2
+	PermanentIdentityPrefix = '0'
+	PseudonymPrefix         = '2'
+	ReauthIdentityPrefix    = '4'
 )
 
 type IMSI string
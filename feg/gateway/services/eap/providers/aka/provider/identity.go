@@ -16,7 +16,8 @@ package provider
 
 import "regexp"
 
-var akaRe = regexp.MustCompile(`^0\d{6,15}@\w(?:\w|\.|-)*\w$`)
+// permanent (0<IMSI>), pseudonym (2...) & fast re-authentication (4...) EAP-AKA identities
+var akaRe = regexp.MustCompile(`^(?:0\d{6,15}|[24][\w-]{8,})@\w(?:\w|\.|-)*\w$`)
 
 // WillHandleIdentity returns true if the provider 1) recognizes the given Identity and 2) can hendle authentication
 // for this type of identity.
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aka
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+// PseudonymCodec encrypts IMSIs into EAP-AKA pseudonyms & decrypts them back. Pseudonyms are not stored,
+// so any instance sharing the key can resolve them
+type PseudonymCodec struct {
+	aead cipher.AEAD
+}
+
+// NewPseudonymCodec creates a pseudonym codec with the given AES key (16, 24 or 32 bytes long),
+// a random 16 bytes key is generated if the key is empty
+func NewPseudonymCodec(key []byte) (*PseudonymCodec, error) {
+	if len(key) == 0 {
+		key = make([]byte, 16)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pseudonym key: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &PseudonymCodec{aead: aead}, nil
+}
+
+// Pseudonym returns a new pseudonym for the IMSI in the given realm, each call returns a different pseudonym
+func (c *PseudonymCodec) Pseudonym(imsi IMSI, realm string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(imsi), []byte{PseudonymPrefix})
+	return withRealm(string(PseudonymPrefix)+base64.RawURLEncoding.EncodeToString(sealed), realm), nil
+}
+
+// IMSI returns IMSI of the given pseudonym identity
+func (c *PseudonymCodec) IMSI(pseudonym string) (IMSI, error) {
+	username, _ := SplitIdentity(pseudonym)
+	if !IsPseudonym(username) {
+		return "", fmt.Errorf("'%s' is not a pseudonym", pseudonym)
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(username[1:])
+	nonceSize := c.aead.NonceSize()
+	if err != nil || len(sealed) <= nonceSize {
+		return "", fmt.Errorf("malformed pseudonym '%s'", pseudonym)
+	}
+	plain, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte{PseudonymPrefix})
+	if err != nil {
+		return "", fmt.Errorf("unknown pseudonym '%s'", pseudonym)
+	}
+	imsi := IMSI(plain)
+	return imsi, imsi.Validate()
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aka
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"magma/feg/gateway/services/eap"
+)
+
+// MakeAKAKeysFromMK returns K_encr, K_aut, MSK, EMSK keys generated from the given Master Key (RFC 4187, section 7)
+func MakeAKAKeysFromMK(MK []byte) (K_encr, K_aut, MSK, EMSK []byte) {
+	x := XSum(MK)
+	return x[:16], x[16:32], x[32:96], x[96:160]
+}
+
+// MakeReauthKeys returns MSK & EMSK keys for Fast Re-authentication (RFC 4187, section 7):
+// XKEY' = SHA1(Identity|counter|NONCE_S|MK)
+func MakeReauthKeys(identity []byte, counter uint16, nonceS, MK []byte) (MSK, EMSK []byte) {
+	d := sha1.New()
+	d.Write(identity)
+	d.Write([]byte{byte(counter >> 8), byte(counter)})
+	d.Write(nonceS)
+	d.Write(MK)
+	x := XSum(d.Sum(nil))
+	return x[:64], x[64:128]
+}
+
+// NewIdentityAttribute creates identity carrying attribute (AT_IDENTITY, AT_NEXT_PSEUDONYM, AT_NEXT_REAUTH_ID)
+// with the given identity, see https://tools.ietf.org/html/rfc4187#section-10.11
+func NewIdentityAttribute(typ eap.AttrType, identity string) eap.Attribute {
+	l := len(identity)
+	return eap.NewAttribute(typ, append([]byte{byte(l >> 8), byte(l)}, identity...))
+}
+
+// GetIdentity returns the identity carried by an identity attribute (AT_IDENTITY, AT_NEXT_PSEUDONYM, etc.)
+func GetIdentity(a eap.Attribute) (string, error) {
+	if a.Len() <= ATT_HDR_LEN {
+		return "", fmt.Errorf("identity attribute %d is too short: %d", a.Type(), a.Len())
+	}
+	val := a.Value()
+	actualLen2 := int(val[0])<<8 + int(val[1]) + 2
+	if actualLen2 > len(val) {
+		return "", fmt.Errorf(
+			"Corrupt identity attribute %d: actual len %d > data len %d", a.Type(), actualLen2-2, len(val))
+	}
+	return string(val[2:actualLen2]), nil
+}
+
+// NewCounterAttribute creates AT_COUNTER or AT_COUNTER_TOO_SMALL attribute
+func NewCounterAttribute(typ eap.AttrType, counter uint16) eap.Attribute {
+	return eap.NewAttribute(typ, []byte{byte(counter >> 8), byte(counter)})
+}
+
+// GetCounter returns counter value of AT_COUNTER attribute
+func GetCounter(a eap.Attribute) (uint16, error) {
+	if a.Type() != AT_COUNTER || a.Len() < ATT_HDR_LEN {
+		return 0, fmt.Errorf("invalid AT_COUNTER attribute: %s", a)
+	}
+	val := a.Value()
+	return uint16(val[0])<<8 + uint16(val[1]), nil
+}
+
+// NewEncrData encrypts given attributes with K_encr & returns AT_IV & AT_ENCR_DATA attributes carrying them,
+// see https://tools.ietf.org/html/rfc4187#section-10.12
+func NewEncrData(K_encr []byte, attrs ...eap.Attribute) (atIv, atEncrData eap.Attribute, err error) {
+	var plaintext []byte
+	for _, a := range attrs {
+		plaintext = append(plaintext, a.Marshaled()...)
+	}
+	if pad := aes.BlockSize - len(plaintext)%aes.BlockSize; pad < aes.BlockSize {
+		// AT_PADDING, its value must be all zeros
+		padding := make([]byte, pad)
+		padding[0], padding[1] = byte(AT_PADDING), byte(pad>>2)
+		plaintext = append(plaintext, padding...)
+	}
+	block, err := aes.NewCipher(K_encr)
+	if err != nil {
+		return nil, nil, err
+	}
+	iv := make([]byte, IV_LEN)
+	if _, err = rand.Read(iv); err != nil {
+		return nil, nil, err
+	}
+	ciphertext := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, plaintext)
+	return eap.NewAttribute(AT_IV, append([]byte{0, 0}, iv...)),
+		eap.NewAttribute(AT_ENCR_DATA, append([]byte{0, 0}, ciphertext...)),
+		nil
+}
+
+// DecryptEncrData decrypts AT_ENCR_DATA using AT_IV & K_encr & returns the encrypted attributes,
+// AT_PADDING is omitted from the result
+func DecryptEncrData(K_encr []byte, atIv, atEncrData eap.Attribute) ([]eap.Attribute, error) {
+	if atIv == nil || atEncrData == nil {
+		return nil, fmt.Errorf("missing AT_IV or AT_ENCR_DATA")
+	}
+	ivVal, encrVal := atIv.Value(), atEncrData.Value()
+	if len(ivVal) != IV_LEN+2 {
+		return nil, fmt.Errorf("invalid AT_IV length: %d", len(ivVal))
+	}
+	ciphertext := encrVal[2:]
+	if len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("invalid AT_ENCR_DATA length: %d", len(ciphertext))
+	}
+	block, err := aes.NewCipher(K_encr)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, ivVal[2:]).CryptBlocks(plaintext, ciphertext)
+
+	var attrs []eap.Attribute
+	for len(plaintext) > 0 {
+		if len(plaintext) < ATT_HDR_LEN {
+			return nil, fmt.Errorf("truncated encrypted attribute")
+		}
+		l := int(plaintext[1]) << 2
+		if l == 0 || l > len(plaintext) {
+			return nil, fmt.Errorf("invalid encrypted attribute %d length: %d", plaintext[0], l)
+		}
+		if eap.AttrType(plaintext[0]) != AT_PADDING {
+			attrs = append(attrs, eap.NewRawAttribute(plaintext[:l]))
+		}
+		plaintext = plaintext[l:]
+	}
+	return attrs, nil
+}
+
+// NewNonce returns a new random NONCE_S
+func NewNonce() ([]byte, error) {
+	nonce := make([]byte, NONCE_S_LEN)
+	_, err := rand.Read(nonce)
+	return nonce, err
+}
+
+// NewReauthIdentity generates a new, random Fast Re-authentication identity in the given realm
+func NewReauthIdentity(realm string) (string, error) {
+	id := make([]byte, 12)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return withRealm(string(ReauthIdentityPrefix)+base64.RawURLEncoding.EncodeToString(id), realm), nil
+}
+
+// IsReauthIdentity returns true if the identity is an EAP-AKA Fast Re-authentication identity
+func IsReauthIdentity(identity string) bool {
+	return len(identity) > 1 && identity[0] == ReauthIdentityPrefix
+}
+
+// IsPseudonym returns true if the identity is an EAP-AKA pseudonym
+func IsPseudonym(identity string) bool {
+	return len(identity) > 1 && identity[0] == PseudonymPrefix
+}
+
+// SplitIdentity splits NAI into username & realm parts
+func SplitIdentity(identity string) (username, realm string) {
+	if idx := strings.Index(identity, "@"); idx >= 0 {
+		return identity[:idx], identity[idx+1:]
+	}
+	return identity, ""
+}
+
+func withRealm(username, realm string) string {
+	if len(realm) == 0 {
+		return username
+	}
+	return username + "@" + realm
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aka
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"magma/feg/gateway/services/eap"
+)
+
+func TestDecryptEncrData(t *testing.T) {
+	// testData is an EAP-Request/AKA-Challenge carrying encrypted next pseudonym & re-authentication identity
+	K_encr, _, _, _ := MakeAKAKeys([]byte(origIdentity), []byte(IK), []byte(CK))
+	scanner, err := eap.NewAttributeScanner(eap.Packet(testData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var atIv, atEncrData eap.Attribute
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case AT_IV:
+			atIv = a
+		case AT_ENCR_DATA:
+			atEncrData = a
+		}
+	}
+	attrs, err := DecryptEncrData(K_encr, atIv, atEncrData)
+	if err != nil {
+		t.Fatalf("DecryptEncrData error: %v", err)
+	}
+	if len(attrs) != 2 {
+		t.Fatalf("Unexpected number of decrypted attributes: %d", len(attrs))
+	}
+	if attrs[0].Type() != AT_NEXT_PSEUDONYM || attrs[1].Type() != AT_NEXT_REAUTH_ID {
+		t.Fatalf("Unexpected decrypted attributes: %v", attrs)
+	}
+	pseudonym, err := GetIdentity(attrs[0])
+	if err != nil || pseudonym != "2AzRkAg_obHUBSYJWFyPcun" {
+		t.Fatalf("Unexpected pseudonym: '%s', error: %v", pseudonym, err)
+	}
+	reauthId, err := GetIdentity(attrs[1])
+	if err != nil || reauthId != "4ANBaNgvXUPtwJWM.MCnNIX@wlan.mnc001.mcc001.3gppnetwork.org" {
+		t.Fatalf("Unexpected re-authentication identity: '%s', error: %v", reauthId, err)
+	}
+}
+
+func TestEncrDataRoundTrip(t *testing.T) {
+	K_encr, _, _, _ := MakeAKAKeys([]byte(origIdentity), []byte(IK), []byte(CK))
+	nonce, err := NewNonce()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reauthId, err := NewReauthIdentity("wlan.mnc001.mcc001.3gppnetwork.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsReauthIdentity(reauthId) || !strings.HasSuffix(reauthId, "@wlan.mnc001.mcc001.3gppnetwork.org") {
+		t.Fatalf("Invalid re-authentication identity: %s", reauthId)
+	}
+	atIv, atEncrData, err := NewEncrData(K_encr,
+		NewCounterAttribute(AT_COUNTER, 7),
+		eap.NewAttribute(AT_NONCE_S, append([]byte{0, 0}, nonce...)),
+		NewIdentityAttribute(AT_NEXT_REAUTH_ID, reauthId))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (atEncrData.Len()-ATT_HDR_LEN)%16 != 0 {
+		t.Fatalf("AT_ENCR_DATA is not padded: %d", atEncrData.Len())
+	}
+	// Encode in a packet to verify that attribute lengths are correct
+	p := eap.NewPacket(eap.RequestCode, 1, []byte{TYPE, byte(SubtypeReauthentication), 0, 0})
+	if p, err = p.Append(atIv); err != nil {
+		t.Fatal(err)
+	}
+	if p, err = p.Append(atEncrData); err != nil {
+		t.Fatal(err)
+	}
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	atIv, _ = scanner.Next()
+	atEncrData, _ = scanner.Next()
+
+	attrs, err := DecryptEncrData(K_encr, atIv, atEncrData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attrs) != 3 {
+		t.Fatalf("Unexpected number of decrypted attributes: %d", len(attrs))
+	}
+	counter, err := GetCounter(attrs[0])
+	if err != nil || counter != 7 {
+		t.Fatalf("Unexpected counter %d, error: %v", counter, err)
+	}
+	if attrs[1].Type() != AT_NONCE_S || !reflect.DeepEqual(attrs[1].Value()[2:], nonce) {
+		t.Fatalf("Unexpected AT_NONCE_S: %v", attrs[1])
+	}
+	id, err := GetIdentity(attrs[2])
+	if err != nil || id != reauthId {
+		t.Fatalf("Unexpected AT_NEXT_REAUTH_ID: '%s', error: %v", id, err)
+	}
+
+	// wrong key must not yield valid attributes
+	_, K_aut, _, _ := MakeAKAKeys([]byte(origIdentity), []byte(IK), []byte(CK))
+	attrs, err = DecryptEncrData(K_aut, atIv, atEncrData)
+	if err == nil && len(attrs) == 3 {
+		if id, _ := GetIdentity(attrs[2]); id == reauthId {
+			t.Fatal("Decrypted with a wrong key")
+		}
+	}
+}
+
+func TestMakeKeysFromMK(t *testing.T) {
+	K_encr, K_aut, MSK, EMSK := MakeAKAKeys([]byte(origIdentity), []byte(IK), []byte(CK))
+	mk := MK([]byte(origIdentity), []byte(IK), []byte(CK))
+	K_encr2, K_aut2, MSK2, EMSK2 := MakeAKAKeysFromMK(mk)
+	if !reflect.DeepEqual(K_encr, K_encr2) || !reflect.DeepEqual(K_aut, K_aut2) ||
+		!reflect.DeepEqual(MSK, MSK2) || !reflect.DeepEqual(EMSK, EMSK2) {
+		t.Fatal("MakeAKAKeysFromMK & MakeAKAKeys keys mismatch")
+	}
+	nonce := []byte("0123456789abcdef")
+	MSK1, EMSK1 := MakeReauthKeys([]byte("4abcdefgh@realm"), 1, nonce, mk)
+	if len(MSK1) != 64 || len(EMSK1) != 64 {
+		t.Fatalf("Invalid re-authentication key lengths: %d, %d", len(MSK1), len(EMSK1))
+	}
+	MSK2, _ = MakeReauthKeys([]byte("4abcdefgh@realm"), 2, nonce, mk)
+	if reflect.DeepEqual(MSK1, MSK2) || reflect.DeepEqual(MSK1, MSK) {
+		t.Fatal("Re-authentication MSK must change with counter")
+	}
+}
+
+func TestPseudonymCodec(t *testing.T) {
+	codec, err := NewPseudonymCodec([]byte("0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	imsi := IMSI("001010000000055")
+	p1, err := codec.Pseudonym(imsi, "wlan.mnc001.mcc001.3gppnetwork.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := codec.Pseudonym(imsi, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p1 == p2 || !IsPseudonym(p1) || !IsPseudonym(p2) || strings.Contains(p1, string(imsi)) {
+		t.Fatalf("Invalid pseudonyms: %s, %s", p1, p2)
+	}
+	for _, p := range []string{p1, p2} {
+		decoded, err := codec.IMSI(p)
+		if err != nil || decoded != imsi {
+			t.Fatalf("Unexpected IMSI '%s' for pseudonym '%s', error: %v", decoded, p, err)
+		}
+	}
+	// tampered pseudonym
+	tampered := []byte(p2)
+	tampered[5] ^= 1
+	if _, err = codec.IMSI(string(tampered)); err == nil {
+		t.Fatal("Expected error for tampered pseudonym")
+	}
+	// pseudonym of a different key
+	other, err := NewPseudonymCodec(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = other.IMSI(p1); err == nil {
+		t.Fatal("Expected error for pseudonym of a different key")
+	}
+	if _, err = codec.IMSI("0001010000000055@wlan.mnc001.mcc001.3gppnetwork.org"); err == nil {
+		t.Fatal("Expected error for permanent identity")
+	}
+}
@@ -154,11 +154,14 @@ attrLoop:
 	ctx.Imsi = string(imsi)
 	if uc.Profile != nil {
 		ctx.Msisdn = uc.Profile.Msisdn
+		ctx.AmbrUl = uc.Profile.GetAmbr().GetMaxBandwidthUl()
+		ctx.AmbrDl = uc.Profile.GetAmbr().GetMaxBandwidthDl()
 	}
 	ctx.AuthSessionId = uc.AuthSessionId
 	ctx.Msk = uc.MSK
 	ctx.Identity = uc.Identity
 	uc.SetState(aka.StateAuthenticated)
+	s.SaveReauthCtx(uc)
 
 	// Keep session & User Ctx around for some time after authentication and then clean them up
 	uc.Unlock()
@@ -60,6 +60,10 @@ func identityResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packe
 	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
 		// Find first valid AT_IDENTITY attribute to get UE IMSI
 		if a.Type() == aka.AT_IDENTITY {
+			if p, handled, ok := handleTemporaryIdentity(s, ctx, a, identifier); handled {
+				success = ok
+				return p, nil
+			}
 			identity, imsi, err := getIMSIIdentity(a)
 			if err == nil {
 				if imsi[0] != '0' {
@@ -67,37 +71,8 @@ func identityResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packe
 				} else {
 					imsi = imsi[1:]
 				}
-				if !s.CheckPlmnId(imsi) {
-					s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
-					return aka.EapErrorResPacket(
-						identifier,
-						aka.NOTIFICATION_FAILURE,
-						codes.PermissionDenied,
-						"PLMN ID of IMSI: %s is not permitted", imsi)
-				}
-				ctx.Imsi = string(imsi)                  // set IMSI
-				uc := s.InitSession(ctx.SessionId, imsi) // we have Locked User Ctx after this call
-				state, t := uc.State()
-				if state > aka.StateCreated {
-					glog.Errorf(
-						"EAP AKA IdentityResponse: Unexpected user state: %d,%s for IMSI: %s, CTX Identity: %s",
-						state, t, imsi, uc.Identity)
-					if state == aka.StateRedirected {
-						aka.EapErrorResPacket(
-							identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
-							"IMSI: %s is redirected to another method", imsi)
-					}
-				}
-				uc.Identity = identity
-				uc.SetState(aka.StateIdentity)
-				p, err := createChallengeRequest(s, uc, identifier, nil)
-				if success = err == nil; success {
-					// Update state
-					uc.SetState(aka.StateChallenge)
-					s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
-				} else {
-					s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
-				}
+				var p eap.Packet
+				p, success, err = startFullAuthentication(s, ctx, identity, imsi, identifier)
 				return p, err
 			}
 		}
@@ -110,20 +85,93 @@ func identityResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packe
 		identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition, "Missing AT_IDENTITY Attribute")
 }
 
+// startFullAuthentication creates new session for the IMSI & returns AKA-Challenge Request for it
+func startFullAuthentication(
+	s *servicers.EapAkaSrv,
+	ctx *protos.Context,
+	identity string,
+	imsi aka.IMSI,
+	identifier uint8) (p eap.Packet, success bool, err error) {
+
+	if !s.CheckPlmnId(imsi) {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		p, err = aka.EapErrorResPacket(
+			identifier,
+			aka.NOTIFICATION_FAILURE,
+			codes.PermissionDenied,
+			"PLMN ID of IMSI: %s is not permitted", imsi)
+		return p, false, err
+	}
+	ctx.Imsi = string(imsi)                  // set IMSI
+	uc := s.InitSession(ctx.SessionId, imsi) // we have Locked User Ctx after this call
+	state, t := uc.State()
+	if state > aka.StateCreated {
+		glog.Errorf(
+			"EAP AKA IdentityResponse: Unexpected user state: %d,%s for IMSI: %s, CTX Identity: %s",
+			state, t, imsi, uc.Identity)
+		if state == aka.StateRedirected {
+			s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+			p, err = aka.EapErrorResPacket(
+				identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+				"IMSI: %s is redirected to another method", imsi)
+			return p, false, err
+		}
+	}
+	uc.Identity = identity
+	uc.SetState(aka.StateIdentity)
+	p, err = createChallengeRequest(s, uc, identifier, nil)
+	if success = err == nil; success {
+		// Update state
+		uc.SetState(aka.StateChallenge)
+		s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+	} else {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+	}
+	return p, success, err
+}
+
+// handleTemporaryIdentity handles pseudonyms & Fast Re-authentication identities issued by the service,
+// handled is false if AT_IDENTITY doesn't carry a temporary identity
+func handleTemporaryIdentity(
+	s *servicers.EapAkaSrv, ctx *protos.Context, a eap.Attribute, identifier uint8) (p eap.Packet, handled, success bool) {
+
+	identity, err := aka.GetIdentity(a)
+	if err != nil {
+		return nil, false, false
+	}
+	username, _ := aka.SplitIdentity(identity)
+	switch {
+	case s.FastReauthEnabled() && aka.IsReauthIdentity(username):
+		if p, ok := s.StartReauth(ctx, identity, identifier); ok {
+			return p, true, true
+		}
+		glog.V(1).Infof("Unknown EAP-AKA Fast Re-authentication identity '%s', requesting full auth identity", identity)
+		return aka.NewIdentityReq(identifier+1, aka.AT_FULLAUTH_ID_REQ), true, true
+	case s.PseudonymsEnabled() && aka.IsPseudonym(username):
+		imsi, err := s.PseudonymIMSI(identity)
+		if err != nil {
+			glog.V(1).Infof("Invalid EAP-AKA pseudonym: %v, requesting permanent identity", err)
+			return aka.NewIdentityReq(identifier+1, aka.AT_PERMANENT_ID_REQ), true, true
+		}
+		p, success, err = startFullAuthentication(s, ctx, identity, imsi, identifier)
+		if err != nil {
+			p, _ = aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Internal, err.Error())
+			return p, true, false
+		}
+		return p, true, success
+	}
+	return nil, false, false
+}
+
 // see https://tools.ietf.org/html/rfc4187#section-4.1.1.4
 func getIMSIIdentity(a eap.Attribute) (string, aka.IMSI, error) {
 	if a.Type() != aka.AT_IDENTITY {
 		return "", "", fmt.Errorf("Unexpected Attr Type: %d, AT_IDENTITY expected", a.Type())
 	}
-	if a.Len() <= 4 {
-		return "", "", fmt.Errorf("AT_IDENTITY is too short: %d", a.Len())
-	}
-	val := a.Value()
-	actualLen2 := int(val[0])<<8 + int(val[1]) + 2
-	if actualLen2 > len(val) {
-		return "", "", fmt.Errorf("Corrupt AT_IDENTITY Attribute: actual len %d > data len %d", actualLen2-2, len(val))
+	fullIdentity, err := aka.GetIdentity(a)
+	if err != nil {
+		return "", "", err
 	}
-	fullIdentity := string(val[2:actualLen2])
 	atIdx := strings.Index(fullIdentity, "@")
 	var imsi aka.IMSI
 	if atIdx > 0 {
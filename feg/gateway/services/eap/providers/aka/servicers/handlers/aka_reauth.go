@@ -0,0 +1,197 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package handlers provided AKA Response handlers for supported AKA subtypes
+package handlers
+
+import (
+	"io"
+	"reflect"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka/metrics"
+	"magma/feg/gateway/services/eap/providers/aka/servicers"
+)
+
+func init() {
+	servicers.AddHandler(aka.SubtypeReauthentication, reauthResponse)
+}
+
+// reauthResponse implements handler for EAP-Response/AKA-Reauthentication,
+// see https://tools.ietf.org/html/rfc4187#section-9.8 for details
+func reauthResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var (
+		success    bool
+		ctxCreated time.Time
+	)
+	defer func() {
+		if !ctxCreated.IsZero() {
+			metrics.AuthLatency.Observe(time.Since(ctxCreated).Seconds())
+		}
+		if !success {
+			metrics.FailedReauthRequests.Inc()
+		}
+	}()
+
+	identifier := req.Identifier()
+	if ctx == nil {
+		return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing Session ID")
+	}
+	sessionId := ctx.SessionId
+	imsi, uc, ok := s.FindSession(sessionId)
+	if !ok {
+		return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No Session found for ID: %s", sessionId)
+	}
+	if uc == nil {
+		s.UpdateSessionTimeout(sessionId, s.NotificationTimeout())
+		return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No IMSI '%s' found for SessionID: %s", imsi, sessionId)
+	}
+	state, _ := uc.State()
+	if state != aka.StateReauth {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"AKA Re-authentication Response: Unexpected user state: %d for IMSI: %s, Session: %s",
+			state, imsi, sessionId)
+	}
+	ctxCreated = uc.CreatedTime()
+
+	p := make([]byte, len(req))
+	copy(p, req)
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Aborted, err.Error())
+	}
+
+	var a, atMac, atIv, atEncrData eap.Attribute
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case aka.AT_MAC:
+			atMac = a
+		case aka.AT_IV:
+			atIv = a
+		case aka.AT_ENCR_DATA:
+			atEncrData = a
+		case aka.AT_CHECKCODE: // Ignore CHECKCODE for now
+		default:
+			glog.Infof("Unexpected EAP-AKA Re-authentication Response Attribute type %d", a.Type())
+		}
+	}
+	if err != io.EOF {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, err.Error())
+	}
+	if atMac == nil || atIv == nil || atEncrData == nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return aka.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing AT_MAC | AT_IV | AT_ENCR_DATA")
+	}
+
+	// Verify MAC, for EAP-Response/AKA-Reauthentication it's calculated over EAP packet | NONCE_S
+	macBytes := atMac.Marshaled()
+	if len(macBytes) < aka.ATT_HDR_LEN+aka.MAC_LEN {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return aka.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Malformed AT_MAC")
+	}
+	ueMac := make([]byte, len(macBytes)-aka.ATT_HDR_LEN)
+	copy(ueMac, macBytes[aka.ATT_HDR_LEN:])
+	for i := aka.ATT_HDR_LEN; i < len(macBytes); i++ {
+		macBytes[i] = 0
+	}
+	mac := aka.GenMac(append(p, uc.NonceS...), uc.K_aut)
+	if !reflect.DeepEqual(ueMac, mac) {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		glog.Errorf(
+			"Invalid Re-authentication MAC for Session ID: %s; IMSI: %s; UE MAC: %x; Expected MAC: %x; EAP: %x",
+			sessionId, imsi, ueMac, mac, req)
+		return aka.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.Unauthenticated,
+			"Invalid MAC for Session ID: %s; IMSI: %s", sessionId, imsi)
+	}
+
+	attrs, err := aka.DecryptEncrData(uc.K_encr, atIv, atEncrData)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument,
+			"Invalid AT_ENCR_DATA for Session ID: %s; IMSI: %s: %v", sessionId, imsi, err)
+	}
+	var (
+		counterFound, counterTooSmall bool
+		counter                       uint16
+	)
+	for _, a = range attrs {
+		switch a.Type() {
+		case aka.AT_COUNTER:
+			counter, err = aka.GetCounter(a)
+			counterFound = err == nil
+		case aka.AT_COUNTER_TOO_SMALL:
+			counterTooSmall = true
+		}
+	}
+	if !counterFound || counter != uc.Counter {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return aka.EapErrorResPacketWithMac(
+			identifier, aka.NOTIFICATION_FAILURE, uc.K_aut, codes.Unauthenticated,
+			"Invalid AT_COUNTER %d (expected: %d) for Session ID: %s; IMSI: %s",
+			counter, uc.Counter, sessionId, imsi)
+	}
+	if counterTooSmall {
+		// The peer rejected the counter, fall back to full authentication (RFC 4187, section 5.5)
+		glog.Warningf("AT_COUNTER_TOO_SMALL for Session ID: %s; IMSI: %s, starting full authentication",
+			sessionId, imsi)
+		p, err := createChallengeRequest(s, uc, identifier, nil)
+		if success = err == nil; success {
+			uc.SetState(aka.StateChallenge)
+			s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+		} else {
+			s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		}
+		return p, err
+	}
+
+	// All good, set IMSI, MSK & Identity for farther use by Radius and return SuccessCode
+	success = true
+	ctx.Imsi = string(imsi)
+	if uc.Profile != nil {
+		ctx.Msisdn = uc.Profile.Msisdn
+		ctx.AmbrUl = uc.Profile.GetAmbr().GetMaxBandwidthUl()
+		ctx.AmbrDl = uc.Profile.GetAmbr().GetMaxBandwidthDl()
+	}
+	ctx.AuthSessionId = uc.AuthSessionId
+	ctx.Msk = uc.MSK
+	ctx.Identity = uc.Identity
+	uc.SetState(aka.StateAuthenticated)
+	s.SaveReauthCtx(uc)
+
+	// Keep session & User Ctx around for some time after authentication and then clean them up
+	uc.Unlock()
+	s.ResetSessionTimeout(sessionId, s.SessionAuthenticatedTimeout())
+
+	return []byte{
+			eap.SuccessCode, // Code
+			identifier,      // Identifier
+			0, 4},           // Length
+		nil
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package handlers
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	cp "magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+const (
+	testPermanentIdentity = "0001010000000055@wlan.mnc001.mcc001.3gppnetwork.org"
+	testIK                = "\xd5\x37\x0f\x13\x79\x6f\x2f\x61\x5c\xbe\x15\xef\x9f\x42\x0a\x98"
+	testCK                = "\xa8\x35\xcf\x22\xb0\xf4\x3e\x15\x19\xd6\xfd\x23\x4c\x00\xd7\x93"
+	testXres              = "\x29\x5c\x00\xea\xe3\x88\x93\x0d"
+)
+
+// testPeer keeps the peer side of EAP-AKA keys & identities
+type testPeer struct {
+	MK,
+	K_encr,
+	K_aut,
+	MSK []byte
+	pseudonym,
+	reauthId string
+}
+
+func TestAkaFastReauth(t *testing.T) {
+	os.Setenv("USE_REMOTE_SWX_PROXY", "false")
+	srv, lis, _ := test_utils.NewTestService(t, registry.ModuleName, registry.SWX_PROXY)
+	var service testSwxProxy
+	cp.RegisterSwxProxyServer(srv.GrpcServer, service)
+	go srv.RunTest(lis, nil)
+
+	akaSrv, err := servicers.NewEapAkaService(&mconfig.EapAkaConfig{
+		FastReauth: &mconfig.EapAkaConfig_FastReauthConfig{
+			Enabled:      true,
+			MaxCounter:   2,
+			Pseudonyms:   true,
+			PseudonymKey: "000102030405060708090a0b0c0d0e0f",
+		}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Full authentication with permanent identity
+	peer := &testPeer{}
+	eapCtx := &protos.Context{SessionId: eap.CreateSessionId()}
+	p, err := identityResponse(akaSrv, eapCtx, eap.Packet(testEapIdentityResp))
+	if err != nil {
+		t.Fatalf("Unexpected identityResponse error: %v", err)
+	}
+	peer.fullAuth(t, akaSrv, eapCtx, testPermanentIdentity, p)
+	if !aka.IsPseudonym(peer.pseudonym) || !aka.IsReauthIdentity(peer.reauthId) {
+		t.Fatalf("Invalid next identities: '%s', '%s'", peer.pseudonym, peer.reauthId)
+	}
+
+	// Fast re-authentication started by EAP-Response/Identity
+	var usedReauthId string
+	for counter := uint16(1); counter <= 2; counter++ {
+		eapCtx = &protos.Context{SessionId: eap.CreateSessionId()}
+		identity := peer.reauthId
+		usedReauthId = identity
+		res, err := akaSrv.HandleImpl(&protos.Eap{
+			Payload: eap.NewPacket(eap.ResponseCode, 5, append([]byte{eap.MethodIdentity}, identity...)),
+			Ctx:     eapCtx,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected HandleImpl error: %v", err)
+		}
+		if eapCtx.GetImsi() != "001010000000055" {
+			t.Fatalf("Unexpected IMSI: %s", eapCtx.GetImsi())
+		}
+		peer.reauth(t, akaSrv, eapCtx, identity, counter, eap.Packet(res.GetPayload()), false)
+		// Max counter is reached after the 2nd re-authentication, no new identity is issued
+		if counter == 2 && len(peer.reauthId) != 0 {
+			t.Fatalf("Unexpected re-authentication identity after max counter: %s", peer.reauthId)
+		}
+	}
+
+	// Used re-authentication identity is rejected, full auth identity is requested
+	res, err := akaSrv.HandleImpl(&protos.Eap{
+		Payload: eap.NewPacket(eap.ResponseCode, 5, append([]byte{eap.MethodIdentity}, usedReauthId...)),
+		Ctx:     &protos.Context{SessionId: eap.CreateSessionId()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(res.GetPayload(), []byte(aka.NewIdentityReq(6, aka.AT_FULLAUTH_ID_REQ))) {
+		t.Fatalf("Unexpected response to used re-authentication identity: %v", res.GetPayload())
+	}
+
+	// Full authentication with the pseudonym
+	eapCtx = &protos.Context{SessionId: eap.CreateSessionId()}
+	identity := peer.pseudonym
+	p, err = identityResponse(akaSrv, eapCtx, newIdentityResponse(t, 2, identity))
+	if err != nil {
+		t.Fatalf("Unexpected identityResponse error: %v", err)
+	}
+	if eapCtx.GetImsi() != "001010000000055" {
+		t.Fatalf("Unexpected IMSI for pseudonym: %s", eapCtx.GetImsi())
+	}
+	peer.fullAuth(t, akaSrv, eapCtx, identity, p)
+
+	// Peer rejects the counter, server must fall back to full authentication
+	eapCtx = &protos.Context{SessionId: eap.CreateSessionId()}
+	identity = peer.reauthId
+	p, err = identityResponse(akaSrv, eapCtx, newIdentityResponse(t, 2, identity))
+	if err != nil {
+		t.Fatalf("Unexpected identityResponse error: %v", err)
+	}
+	peer.reauth(t, akaSrv, eapCtx, identity, 1, p, true)
+
+	// Unknown pseudonym, permanent identity is requested
+	other, _ := aka.NewPseudonymCodec(nil)
+	unknown, _ := other.Pseudonym("001010000000055", "wlan.mnc001.mcc001.3gppnetwork.org")
+	p, err = identityResponse(akaSrv, &protos.Context{SessionId: eap.CreateSessionId()},
+		newIdentityResponse(t, 2, unknown))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]byte(p), []byte(aka.NewIdentityReq(3, aka.AT_PERMANENT_ID_REQ))) {
+		t.Fatalf("Unexpected response to unknown pseudonym: %v", p)
+	}
+}
+
+// fullAuth verifies AKA-Challenge Request, extracts next identities & completes the authentication
+func (peer *testPeer) fullAuth(
+	t *testing.T, s *servicers.EapAkaSrv, eapCtx *protos.Context, identity string, challenge eap.Packet) {
+
+	if challenge.Type() != aka.TYPE || challenge[eap.EapSubtype] != byte(aka.SubtypeChallenge) {
+		t.Fatalf("Expected AKA-Challenge, got: %v", challenge)
+	}
+	peer.MK = aka.MK([]byte(identity), []byte(testIK), []byte(testCK))
+	peer.K_encr, peer.K_aut, peer.MSK, _ = aka.MakeAKAKeysFromMK(peer.MK)
+	attrs := peer.verifyAndDecrypt(t, challenge)
+	peer.pseudonym, peer.reauthId = "", ""
+	for _, a := range attrs {
+		id, err := aka.GetIdentity(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch a.Type() {
+		case aka.AT_NEXT_PSEUDONYM:
+			peer.pseudonym = id
+		case aka.AT_NEXT_REAUTH_ID:
+			peer.reauthId = id
+		}
+	}
+	resp := eap.NewPacket(eap.ResponseCode, challenge.Identifier(), []byte{aka.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	resp, err := resp.Append(eap.NewAttribute(aka.AT_RES, append([]byte{0, 64}, testXres...)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = aka.AppendMac(resp, peer.K_aut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := challengeResponse(s, eapCtx, resp)
+	if err != nil {
+		t.Fatalf("Unexpected challengeResponse error: %v", err)
+	}
+	if !p.IsSuccess() {
+		t.Fatalf("Expected EAP Success, got: %v", p)
+	}
+	if !reflect.DeepEqual(eapCtx.GetMsk(), peer.MSK) {
+		t.Fatal("MSK mismatch")
+	}
+}
+
+// reauth verifies AKA-Reauthentication Request & completes the re-authentication
+func (peer *testPeer) reauth(
+	t *testing.T,
+	s *servicers.EapAkaSrv,
+	eapCtx *protos.Context,
+	identity string,
+	expectedCounter uint16,
+	req eap.Packet,
+	counterTooSmall bool) {
+
+	if req.Type() != aka.TYPE || req[eap.EapSubtype] != byte(aka.SubtypeReauthentication) {
+		t.Fatalf("Expected AKA-Reauthentication, got: %v", req)
+	}
+	attrs := peer.verifyAndDecrypt(t, req)
+	var (
+		counter uint16
+		nonceS  []byte
+		err     error
+	)
+	peer.reauthId = ""
+	for _, a := range attrs {
+		switch a.Type() {
+		case aka.AT_COUNTER:
+			counter, err = aka.GetCounter(a)
+			if err != nil {
+				t.Fatal(err)
+			}
+		case aka.AT_NONCE_S:
+			nonceS = a.Value()[2:]
+		case aka.AT_NEXT_REAUTH_ID:
+			peer.reauthId, err = aka.GetIdentity(a)
+			if err != nil {
+				t.Fatal(err)
+			}
+		case aka.AT_NEXT_PSEUDONYM:
+			t.Fatal("Unexpected AT_NEXT_PSEUDONYM in AKA-Reauthentication")
+		}
+	}
+	if counter != expectedCounter || len(nonceS) != aka.NONCE_S_LEN {
+		t.Fatalf("Unexpected AT_COUNTER %d (expected: %d) or AT_NONCE_S: %v", counter, expectedCounter, nonceS)
+	}
+	encrAttrs := []eap.Attribute{aka.NewCounterAttribute(aka.AT_COUNTER, counter)}
+	if counterTooSmall {
+		encrAttrs = append(encrAttrs, aka.NewCounterAttribute(aka.AT_COUNTER_TOO_SMALL, 0))
+	}
+	atIv, atEncrData, err := aka.NewEncrData(peer.K_encr, encrAttrs...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := eap.NewPacket(
+		eap.ResponseCode, req.Identifier(), []byte{aka.TYPE, byte(aka.SubtypeReauthentication), 0, 0})
+	if resp, err = resp.Append(atIv); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = resp.Append(atEncrData); err != nil {
+		t.Fatal(err)
+	}
+	// MAC of the response is calculated over EAP packet | NONCE_S
+	resp, err = resp.Append(eap.NewAttribute(aka.AT_MAC, make([]byte, aka.MAC_LEN+2)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	copy(resp[len(resp)-aka.MAC_LEN:], aka.GenMac(append(append([]byte{}, resp...), nonceS...), peer.K_aut))
+
+	p, err := reauthResponse(s, eapCtx, resp)
+	if err != nil {
+		t.Fatalf("Unexpected reauthResponse error: %v", err)
+	}
+	if counterTooSmall {
+		if p.Type() != aka.TYPE || p[eap.EapSubtype] != byte(aka.SubtypeChallenge) {
+			t.Fatalf("Expected AKA-Challenge after AT_COUNTER_TOO_SMALL, got: %v", p)
+		}
+		return
+	}
+	if !p.IsSuccess() {
+		t.Fatalf("Expected EAP Success, got: %v", p)
+	}
+	MSK, _ := aka.MakeReauthKeys([]byte(identity), counter, nonceS, peer.MK)
+	if !reflect.DeepEqual(eapCtx.GetMsk(), MSK) {
+		t.Fatal("Re-authentication MSK mismatch")
+	}
+}
+
+// verifyAndDecrypt verifies AT_MAC of the server request & returns its decrypted attributes
+func (peer *testPeer) verifyAndDecrypt(t *testing.T, req eap.Packet) []eap.Attribute {
+	p := append(eap.Packet{}, req...)
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var atIv, atEncrData, atMac eap.Attribute
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case aka.AT_IV:
+			atIv = a
+		case aka.AT_ENCR_DATA:
+			atEncrData = a
+		case aka.AT_MAC:
+			atMac = a
+		}
+	}
+	if atMac == nil {
+		t.Fatal("Missing AT_MAC")
+	}
+	mac := append([]byte{}, atMac.Value()[2:]...)
+	copy(atMac.Value()[2:], make([]byte, aka.MAC_LEN))
+	if !reflect.DeepEqual(mac, aka.GenMac(p, peer.K_aut)) {
+		t.Fatal("Invalid AT_MAC")
+	}
+	if atIv == nil || atEncrData == nil {
+		return nil
+	}
+	attrs, err := aka.DecryptEncrData(peer.K_encr, atIv, atEncrData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return attrs
+}
+
+func newIdentityResponse(t *testing.T, identifier uint8, identity string) eap.Packet {
+	p := eap.NewPacket(eap.ResponseCode, identifier, []byte{aka.TYPE, byte(aka.SubtypeIdentity), 0, 0})
+	p, err := p.Append(aka.NewIdentityAttribute(aka.AT_IDENTITY, identity))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
@@ -177,10 +177,57 @@ func createChallengeRequest(
 	// Set AT_AUTN
 	copy(p[atAutnOffset:], authRes.autn)
 
+	// Calculate keys, MK & K_encr are kept for Fast Re-authentication
+	lockedCtx.MK = aka.MK([]byte(lockedCtx.Identity), authRes.ik, authRes.ck)
+	lockedCtx.K_encr, lockedCtx.K_aut, lockedCtx.MSK, _ = aka.MakeAKAKeysFromMK(lockedCtx.MK)
+	lockedCtx.Counter = 0
+
+	var attrs []eap.Attribute
+	if s.AkaPrimeBidding() {
+		attrs = append(attrs, eap.NewAttribute(aka.AT_BIDDING, []byte{aka.BIDDING_D_BIT, 0}))
+	}
+	nextIds, err := s.NextIdentityAttributes(lockedCtx, true)
+	if err != nil {
+		glog.Errorf("failed to create next EAP-AKA identities for IMSI %s: %v", lockedCtx.Imsi, err)
+		lockedCtx.NextReauthId = ""
+		nextIds = nil
+	}
+	if len(attrs) > 0 || len(nextIds) > 0 {
+		return appendAttributes(p, lockedCtx.K_encr, lockedCtx.K_aut, attrs, nextIds)
+	}
 	// Calculate AT_MAC
-	_, lockedCtx.K_aut, lockedCtx.MSK, _ = aka.MakeAKAKeys([]byte(lockedCtx.Identity), authRes.ik, authRes.ck)
 	mac := aka.GenMac(p, lockedCtx.K_aut)
 	// Set AT_MAC
 	copy(p[atMacOffset:], mac)
 	return p, nil
 }
+
+// appendAttributes replaces AT_MAC of the challenge with the given attributes followed by AT_IV & AT_ENCR_DATA
+// carrying the given next identities (if any) & appends a new AT_MAC, see https://tools.ietf.org/html/rfc4187#section-9.3
+func appendAttributes(
+	challenge eap.Packet, K_encr, K_aut []byte, attrs []eap.Attribute, nextIds []eap.Attribute) (eap.Packet, error) {
+
+	if len(nextIds) > 0 {
+		atIv, atEncrData, err := aka.NewEncrData(K_encr, nextIds...)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, atIv, atEncrData)
+	}
+	attrsLen := aka.AT_MAC_ATTR_LEN
+	for _, a := range attrs {
+		attrsLen += a.Len()
+	}
+	p := eap.NewPacket(
+		eap.RequestCode,
+		challenge.Identifier(),
+		challenge[eap.EapMsgMethodType:atMacOffset-aka.ATT_HDR_LEN],
+		uint(attrsLen))
+	for _, a := range attrs {
+		var err error
+		if p, err = p.Append(a); err != nil {
+			return nil, err
+		}
+	}
+	return aka.AppendMac(p, K_aut)
+}
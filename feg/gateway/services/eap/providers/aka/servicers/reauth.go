@@ -0,0 +1,255 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/protos/mconfig"
+	aaa "magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka/metrics"
+)
+
+type reauthConfig struct {
+	enabled    bool
+	pseudonyms *aka.PseudonymCodec // nil if pseudonyms are disabled
+	maxCounter uint16
+	ctxTimeout time.Duration
+}
+
+var defaultReauthConfig = reauthConfig{
+	maxCounter: aka.DefaultMaxReauthCounter,
+	ctxTimeout: aka.DefaultReauthCtxTimeout,
+}
+
+// ReauthCtx is a Fast Re-authentication context saved after a successful authentication,
+// see https://tools.ietf.org/html/rfc4187#section-5
+type ReauthCtx struct {
+	Imsi aka.IMSI
+	MK,
+	K_encr,
+	K_aut []byte
+	Counter       uint16 // last used counter, 0 after full authentication
+	Profile       *protos.AuthenticationAnswer_UserProfile
+	AuthSessionId string
+}
+
+type reauthCtxEntry struct {
+	*ReauthCtx
+	timer *time.Timer
+}
+
+func (s *EapAkaSrv) setFastReauthConfig(config *mconfig.EapAkaConfig_FastReauthConfig) error {
+	if config == nil {
+		return nil
+	}
+	s.reauth.enabled = config.GetEnabled()
+	if maxCounter := config.GetMaxCounter(); maxCounter > 0 && maxCounter <= 0xFFFF {
+		s.reauth.maxCounter = uint16(maxCounter)
+	}
+	if config.GetContextTimeoutMs() > 0 {
+		s.reauth.ctxTimeout = time.Millisecond * time.Duration(config.GetContextTimeoutMs())
+	}
+	if config.GetPseudonyms() {
+		key, err := hex.DecodeString(config.GetPseudonymKey())
+		if err != nil {
+			return fmt.Errorf("invalid EAP-AKA pseudonym key: %v", err)
+		}
+		s.reauth.pseudonyms, err = aka.NewPseudonymCodec(key)
+		if err != nil {
+			return err
+		}
+	}
+	glog.Infof("EAP-AKA: Fast Re-authentication enabled: %t; Pseudonyms enabled: %t",
+		s.reauth.enabled, s.reauth.pseudonyms != nil)
+	return nil
+}
+
+// FastReauthEnabled returns true if the service issues & accepts Fast Re-authentication identities
+func (s *EapAkaSrv) FastReauthEnabled() bool {
+	return s != nil && s.reauth.enabled
+}
+
+// PseudonymsEnabled returns true if the service issues & accepts pseudonyms
+func (s *EapAkaSrv) PseudonymsEnabled() bool {
+	return s != nil && s.reauth.pseudonyms != nil
+}
+
+// PseudonymIMSI returns IMSI of a pseudonym issued by the service
+func (s *EapAkaSrv) PseudonymIMSI(pseudonym string) (aka.IMSI, error) {
+	if !s.PseudonymsEnabled() {
+		return "", fmt.Errorf("pseudonyms are not enabled")
+	}
+	return s.reauth.pseudonyms.IMSI(pseudonym)
+}
+
+// NextIdentityAttributes returns AT_NEXT_PSEUDONYM (if withPseudonym is set) & AT_NEXT_REAUTH_ID attributes
+// to be sent encrypted to the UE. The new Fast Re-authentication identity is saved in the CTX & becomes valid
+// after the UE authenticates successfully (see SaveReauthCtx). CTX must be locked
+func (s *EapAkaSrv) NextIdentityAttributes(lockedCtx *UserCtx, withPseudonym bool) ([]eap.Attribute, error) {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	var attrs []eap.Attribute
+	_, realm := aka.SplitIdentity(lockedCtx.Identity)
+	if withPseudonym && s.PseudonymsEnabled() {
+		pseudonym, err := s.reauth.pseudonyms.Pseudonym(lockedCtx.Imsi, realm)
+		if err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, aka.NewIdentityAttribute(aka.AT_NEXT_PSEUDONYM, pseudonym))
+	}
+	lockedCtx.NextReauthId = ""
+	if s.FastReauthEnabled() && lockedCtx.Counter < s.reauth.maxCounter {
+		reauthId, err := aka.NewReauthIdentity(realm)
+		if err != nil {
+			return nil, err
+		}
+		lockedCtx.NextReauthId = reauthId
+		attrs = append(attrs, aka.NewIdentityAttribute(aka.AT_NEXT_REAUTH_ID, reauthId))
+	}
+	return attrs, nil
+}
+
+// SaveReauthCtx saves Fast Re-authentication context of the successfully authenticated UE
+// under the Fast Re-authentication identity sent to the UE (if any). CTX must be locked
+func (s *EapAkaSrv) SaveReauthCtx(lockedCtx *UserCtx) {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	if len(lockedCtx.NextReauthId) == 0 || !s.FastReauthEnabled() {
+		return
+	}
+	s.AddReauthCtx(lockedCtx.NextReauthId, &ReauthCtx{
+		Imsi:          lockedCtx.Imsi,
+		MK:            lockedCtx.MK,
+		K_encr:        lockedCtx.K_encr,
+		K_aut:         lockedCtx.K_aut,
+		Counter:       lockedCtx.Counter,
+		Profile:       lockedCtx.Profile,
+		AuthSessionId: lockedCtx.AuthSessionId,
+	})
+	lockedCtx.NextReauthId = ""
+}
+
+// AddReauthCtx saves Fast Re-authentication context for the given re-authentication identity
+func (s *EapAkaSrv) AddReauthCtx(reauthId string, reauthCtx *ReauthCtx) {
+	key, _ := aka.SplitIdentity(reauthId)
+	entry := &reauthCtxEntry{ReauthCtx: reauthCtx}
+	entry.timer = time.AfterFunc(s.reauth.ctxTimeout, func() {
+		s.reauthMu.Lock()
+		if s.reauthCtxs[key] == entry {
+			delete(s.reauthCtxs, key)
+		}
+		s.reauthMu.Unlock()
+	})
+	s.reauthMu.Lock()
+	old := s.reauthCtxs[key]
+	s.reauthCtxs[key] = entry
+	s.reauthMu.Unlock()
+	if old != nil {
+		old.timer.Stop()
+	}
+}
+
+// TakeReauthCtx finds, removes & returns Fast Re-authentication context of the given re-authentication identity.
+// Re-authentication identities are single use, a new one is sent to the UE with every re-authentication
+func (s *EapAkaSrv) TakeReauthCtx(reauthId string) *ReauthCtx {
+	key, _ := aka.SplitIdentity(reauthId)
+	s.reauthMu.Lock()
+	entry, ok := s.reauthCtxs[key]
+	if ok {
+		delete(s.reauthCtxs, key)
+	}
+	s.reauthMu.Unlock()
+	if entry == nil {
+		return nil
+	}
+	entry.timer.Stop()
+	return entry.ReauthCtx
+}
+
+// StartReauth creates new session for the UE with the given Fast Re-authentication identity & returns
+// EAP-Request/AKA-Reauthentication for it. StartReauth returns false if the identity is unknown
+func (s *EapAkaSrv) StartReauth(ctx *aaa.Context, identity string, identifier uint8) (eap.Packet, bool) {
+	if !s.FastReauthEnabled() {
+		return nil, false
+	}
+	reauthCtx := s.TakeReauthCtx(identity)
+	if reauthCtx == nil {
+		return nil, false
+	}
+	metrics.ReauthRequests.Inc()
+	if len(ctx.SessionId) == 0 {
+		ctx.SessionId = eap.CreateSessionId()
+		glog.Warningf("Missing Session ID for EAP-AKA Re-authentication of %s; Generated new SID: %s",
+			identity, ctx.SessionId)
+	}
+	ctx.Imsi = string(reauthCtx.Imsi)
+	uc := s.InitSession(ctx.SessionId, reauthCtx.Imsi) // we have Locked User Ctx after this call
+	uc.Identity = identity
+	uc.MK, uc.K_encr, uc.K_aut = reauthCtx.MK, reauthCtx.K_encr, reauthCtx.K_aut
+	uc.Profile, uc.AuthSessionId = reauthCtx.Profile, reauthCtx.AuthSessionId
+	uc.Counter = reauthCtx.Counter + 1
+
+	p, err := s.createReauthRequest(uc, identifier+1)
+	if err != nil {
+		glog.Errorf("failed to create EAP-AKA Re-authentication Request for IMSI %s: %v", uc.Imsi, err)
+		metrics.FailedReauthRequests.Inc()
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return aka.NewAKANotificationReq(identifier, aka.NOTIFICATION_FAILURE), true
+	}
+	uc.SetState(aka.StateReauth)
+	s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+	return p, true
+}
+
+// createReauthRequest creates EAP-Request/AKA-Reauthentication, see https://tools.ietf.org/html/rfc4187#section-9.7
+func (s *EapAkaSrv) createReauthRequest(lockedCtx *UserCtx, identifier uint8) (eap.Packet, error) {
+	nonceS, err := aka.NewNonce()
+	if err != nil {
+		return nil, err
+	}
+	lockedCtx.NonceS = nonceS
+	lockedCtx.Identifier = identifier
+	lockedCtx.MSK, _ = aka.MakeReauthKeys([]byte(lockedCtx.Identity), lockedCtx.Counter, nonceS, lockedCtx.MK)
+
+	encrAttrs := []eap.Attribute{
+		aka.NewCounterAttribute(aka.AT_COUNTER, lockedCtx.Counter),
+		eap.NewAttribute(aka.AT_NONCE_S, append([]byte{0, 0}, nonceS...)),
+	}
+	nextIds, err := s.NextIdentityAttributes(lockedCtx, false)
+	if err != nil {
+		return nil, err
+	}
+	atIv, atEncrData, err := aka.NewEncrData(lockedCtx.K_encr, append(encrAttrs, nextIds...)...)
+	if err != nil {
+		return nil, err
+	}
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{aka.TYPE, byte(aka.SubtypeReauthentication), 0, 0})
+	if p, err = p.Append(atIv); err != nil {
+		return nil, err
+	}
+	if p, err = p.Append(atEncrData); err != nil {
+		return nil, err
+	}
+	return aka.AppendMac(p, lockedCtx.K_aut)
+}
@@ -60,7 +60,7 @@ func (s *EapAkaSrv) HandleImpl(req *protos.Eap) (*protos.Eap, error) {
 	identifier := p.Identifier()
 	method := p.Type()
 	if method == eap.MethodIdentity {
-		return &protos.Eap{Payload: aka.NewIdentityReq(identifier+1, aka.AT_PERMANENT_ID_REQ), Ctx: eapCtx}, nil
+		return &protos.Eap{Payload: s.identityRequest(eapCtx, string(p.TypeData()), identifier), Ctx: eapCtx}, nil
 	}
 	if method != aka.TYPE {
 		return aka.EapErrorRes(
@@ -81,3 +81,22 @@ func (s *EapAkaSrv) HandleImpl(req *protos.Eap) (*protos.Eap, error) {
 	failure = err != nil
 	return &protos.Eap{Payload: rp, Ctx: eapCtx}, err
 }
+
+// identityRequest returns EAP packet for the given EAP-Response/Identity. Known Fast Re-authentication
+// identities are re-authenticated right away, for any other identity AKA-Identity Request is returned
+// asking for an identity suitable for full authentication
+func (s *EapAkaSrv) identityRequest(eapCtx *protos.Context, identity string, identifier uint8) eap.Packet {
+	username, _ := aka.SplitIdentity(identity)
+	switch {
+	case s.FastReauthEnabled() && aka.IsReauthIdentity(username):
+		if p, ok := s.StartReauth(eapCtx, identity, identifier); ok {
+			return p
+		}
+		return aka.NewIdentityReq(identifier+1, aka.AT_FULLAUTH_ID_REQ)
+	case s.PseudonymsEnabled() && aka.IsPseudonym(username):
+		if _, err := s.PseudonymIMSI(identity); err == nil {
+			return aka.NewIdentityReq(identifier+1, aka.AT_FULLAUTH_ID_REQ)
+		}
+	}
+	return aka.NewIdentityReq(identifier+1, aka.AT_PERMANENT_ID_REQ)
+}
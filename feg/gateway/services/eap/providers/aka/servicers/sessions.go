@@ -0,0 +1,423 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+type UserCtx struct {
+	mu         sync.Mutex
+	created    time.Time
+	state      aka.AkaState
+	stateTime  time.Time
+	locked     bool
+	Identity   string
+	Imsi       aka.IMSI
+	Profile    *protos.AuthenticationAnswer_UserProfile
+	Identifier uint8
+	Rand,
+	K_aut,
+	MSK,
+	Xres []byte
+	SessionId     string
+	AuthSessionId string
+
+	// Fast Re-authentication state
+	MK,
+	K_encr,
+	NonceS []byte
+	Counter      uint16
+	NextReauthId string
+}
+
+type SessionCtx struct {
+	*UserCtx
+	CleanupTimer *time.Timer
+}
+
+type touts struct {
+	challengeTimeout,
+	errorNotificationTimeout,
+	sessionTimeout,
+	sessionAuthenticatedTimeout time.Duration
+}
+
+var defaultTimeouts = touts{
+	challengeTimeout:            aka.DefaultChallengeTimeout,
+	errorNotificationTimeout:    aka.DefaultErrorNotificationTimeout,
+	sessionTimeout:              aka.DefaultSessionTimeout,
+	sessionAuthenticatedTimeout: aka.DefaultSessionAuthenticatedTimeout,
+}
+
+// Sessions is the UE session & user CTX store of EAP-AKA like methods, it's shared by EAP-AKA & EAP-AKA' services
+type Sessions struct {
+	rwl sync.RWMutex // R/W lock synchronizing maps access
+	// Map of UE Sessions keyed by sessionId
+	sessions map[string]*SessionCtx
+
+	timeouts touts
+
+	// EAP method name used in logs
+	method          string
+	sessionTimeouts prometheus.Counter
+}
+
+// NewSessions creates a session store with default timeouts, method is the EAP method name used in logs &
+// sessionTimeouts is incremented on every session timeout
+func NewSessions(method string, sessionTimeouts prometheus.Counter) *Sessions {
+	return &Sessions{
+		sessions:        map[string]*SessionCtx{},
+		timeouts:        defaultTimeouts,
+		method:          method,
+		sessionTimeouts: sessionTimeouts,
+	}
+}
+
+// Timeouts is implemented by the timeouts of EAP-AKA like methods' mconfigs
+type Timeouts interface {
+	GetChallengeMs() uint32
+	GetErrorNotificationMs() uint32
+	GetSessionMs() uint32
+	GetSessionAuthenticatedMs() uint32
+}
+
+// SetTimeouts sets configured (non zero) timeouts
+func (s *Sessions) SetTimeouts(config Timeouts) {
+	if ms := config.GetChallengeMs(); ms > 0 {
+		s.SetChallengeTimeout(time.Millisecond * time.Duration(ms))
+	}
+	if ms := config.GetErrorNotificationMs(); ms > 0 {
+		s.SetNotificationTimeout(time.Millisecond * time.Duration(ms))
+	}
+	if ms := config.GetSessionMs(); ms > 0 {
+		s.SetSessionTimeout(time.Millisecond * time.Duration(ms))
+	}
+	if ms := config.GetSessionAuthenticatedMs(); ms > 0 {
+		s.SetSessionAuthenticatedTimeout(time.Millisecond * time.Duration(ms))
+	}
+}
+
+func (s *Sessions) ChallengeTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.challengeTimeout)))
+}
+
+func (s *Sessions) SetChallengeTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.challengeTimeout), int64(tout))
+}
+
+func (s *Sessions) NotificationTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.errorNotificationTimeout)))
+}
+
+func (s *Sessions) SetNotificationTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.errorNotificationTimeout), int64(tout))
+}
+
+func (s *Sessions) SessionTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.sessionTimeout)))
+}
+
+func (s *Sessions) SetSessionTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.sessionTimeout), int64(tout))
+}
+
+func (s *Sessions) SessionAuthenticatedTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.sessionAuthenticatedTimeout)))
+}
+
+func (s *Sessions) SetSessionAuthenticatedTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.sessionAuthenticatedTimeout), int64(tout))
+}
+
+// Unlock - unlocks the CTX
+func (lockedCtx *UserCtx) Unlock() {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	lockedCtx.locked = false
+	lockedCtx.mu.Unlock()
+}
+
+// State returns current CTX state (CTX must be locked)
+func (lockedCtx *UserCtx) State() (aka.AkaState, time.Time) {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	return lockedCtx.state, lockedCtx.stateTime
+}
+
+// SetState updates current CTX state (CTX must be locked)
+func (lockedCtx *UserCtx) SetState(s aka.AkaState) {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	lockedCtx.state, lockedCtx.stateTime = s, time.Now()
+}
+
+// CreatedTime returns time of CTX creation
+func (lockedCtx *UserCtx) CreatedTime() time.Time {
+	return lockedCtx.created
+}
+
+// Lifetime returns duration in seconds of the CTX existence
+func (lockedCtx *UserCtx) Lifetime() float64 {
+	return time.Since(lockedCtx.created).Seconds()
+}
+
+// InitSession either creates new or updates existing session & user ctx,
+// it session ID into the CTX and initializes session map as well as users map
+// Returns Locked User Ctx
+func (s *Sessions) InitSession(sessionId string, imsi aka.IMSI) (lockedUserContext *UserCtx) {
+	var (
+		oldSessionTimer *time.Timer
+		oldSessionState aka.AkaState
+	)
+	// create new session with long session wide timeout
+	t := time.Now()
+	newSession := &SessionCtx{UserCtx: &UserCtx{
+		created: t, Imsi: imsi, state: aka.StateCreated, stateTime: t, locked: true, SessionId: sessionId}}
+
+	newSession.mu.Lock()
+
+	newSession.CleanupTimer = time.AfterFunc(s.SessionTimeout(), func() {
+		sessionTimeoutCleanup(s, sessionId, newSession)
+	})
+	uc := newSession.UserCtx
+
+	s.rwl.Lock()
+	if oldSession, ok := s.sessions[sessionId]; ok && oldSession != nil {
+		oldSessionTimer, oldSession.CleanupTimer = oldSession.CleanupTimer, nil
+		oldSessionState = oldSession.state
+	}
+	s.sessions[sessionId] = newSession
+	s.rwl.Unlock()
+
+	if oldSessionTimer != nil {
+		oldSessionTimer.Stop()
+		// Copy Redirected state to a new session to avoid auth thrashing between EAP methods
+		if oldSessionState == aka.StateRedirected {
+			newSession.state = aka.StateRedirected
+		}
+	}
+	return uc
+}
+
+// UpdateSessionUnlockCtx sets session ID into the CTX and initializes session map & session timeout
+func (s *Sessions) UpdateSessionUnlockCtx(lockedCtx *UserCtx, timeout time.Duration) {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	var (
+		oldSession, newSession *SessionCtx
+		exist                  bool
+		oldTimer               *time.Timer
+	)
+	newSession = &SessionCtx{UserCtx: lockedCtx}
+	sessionId := lockedCtx.SessionId
+	lockedCtx.Unlock()
+
+	newSession.CleanupTimer = time.AfterFunc(timeout, func() {
+		sessionTimeoutCleanup(s, sessionId, newSession)
+	})
+
+	s.rwl.Lock()
+
+	oldSession, exist = s.sessions[sessionId]
+	s.sessions[sessionId] = newSession
+	if exist && oldSession != nil {
+		oldSession.UserCtx = nil
+		if oldSession.CleanupTimer != nil {
+			oldTimer, oldSession.CleanupTimer = oldSession.CleanupTimer, nil
+		}
+	}
+	s.rwl.Unlock()
+
+	if oldTimer != nil {
+		oldTimer.Stop()
+	}
+}
+
+// UpdateSessionTimeout finds a session with specified ID, if found - cancels its current timeout
+// & schedules the new one. Returns true if the session was found
+func (s *Sessions) UpdateSessionTimeout(sessionId string, timeout time.Duration) bool {
+	var (
+		newSession *SessionCtx
+		exist      bool
+		oldTimer   *time.Timer
+	)
+
+	s.rwl.Lock()
+
+	oldSession, exist := s.sessions[sessionId]
+	if exist {
+		if oldSession == nil {
+			exist = false
+		} else {
+			oldTimer, oldSession.CleanupTimer = oldSession.CleanupTimer, nil
+			newSession, oldSession.UserCtx = &SessionCtx{UserCtx: oldSession.UserCtx}, nil
+			s.sessions[sessionId] = newSession
+			newSession.CleanupTimer = time.AfterFunc(timeout, func() {
+				sessionTimeoutCleanup(s, sessionId, newSession)
+			})
+		}
+	}
+	s.rwl.Unlock()
+
+	if oldTimer != nil {
+		oldTimer.Stop()
+	}
+	return exist
+}
+
+func sessionTimeoutCleanup(s *Sessions, sessionId string, mySessionCtx *SessionCtx) {
+	if s == nil {
+		glog.Errorf("nil EAP-AKA Sessions for session ID: %s", sessionId)
+		return
+	}
+	s.sessionTimeouts.Inc()
+	var (
+		imsi aka.IMSI
+		uc   *UserCtx
+	)
+
+	s.rwl.Lock()
+	sessionCtx, exist := s.sessions[sessionId]
+	if exist {
+		if sessionCtx != nil {
+			imsi = sessionCtx.Imsi
+			if sessionCtx == mySessionCtx {
+				delete(s.sessions, sessionId)
+				uc = sessionCtx.UserCtx
+			}
+		} else {
+			exist = false
+		}
+	}
+	s.rwl.Unlock()
+
+	if exist && uc != nil {
+		uc.mu.Lock()
+		state := uc.state
+		uc.mu.Unlock()
+		if state != aka.StateAuthenticated {
+			glog.Warningf("%s Session %s timeout for IMSI: %s", s.method, sessionId, imsi)
+		}
+	}
+}
+
+// FindSession finds and returns IMSI of a session and a flag indication if the find succeeded
+// If found, FindSession tries to stop outstanding session timer
+func (s *Sessions) FindSession(sessionId string) (aka.IMSI, *UserCtx, bool) {
+	var (
+		imsi      aka.IMSI
+		lockedCtx *UserCtx
+		timer     *time.Timer
+	)
+	s.rwl.RLock()
+	sessionCtx, exist := s.sessions[sessionId]
+	if exist && sessionCtx != nil {
+		lockedCtx, timer, sessionCtx.CleanupTimer = sessionCtx.UserCtx, sessionCtx.CleanupTimer, nil
+	}
+	s.rwl.RUnlock()
+
+	if lockedCtx != nil {
+		lockedCtx.mu.Lock()
+		lockedCtx.SessionId = sessionId // just in case - should always match
+		imsi = lockedCtx.Imsi
+		lockedCtx.locked = true
+	}
+
+	if timer != nil {
+		timer.Stop()
+	}
+	return imsi, lockedCtx, exist
+}
+
+// RemoveSession removes session ID from the session map and attempts to cancel corresponding timer
+// It also removes associated with the session user CTX if any
+// returns associated with the session IMSI or an empty string
+func (s *Sessions) RemoveSession(sessionId string) aka.IMSI {
+	var (
+		timer *time.Timer
+		imsi  aka.IMSI
+	)
+	s.rwl.Lock()
+	sessionCtx, exist := s.sessions[sessionId]
+	if exist {
+		delete(s.sessions, sessionId)
+		if sessionCtx != nil {
+			imsi, timer, sessionCtx.CleanupTimer, sessionCtx.UserCtx =
+				sessionCtx.Imsi, sessionCtx.CleanupTimer, nil, nil
+		}
+	}
+	s.rwl.Unlock()
+
+	if timer != nil {
+		timer.Stop()
+	}
+	return imsi
+}
+
+// FindAndRemoveSession finds returns IMSI of a session and a flag indication if the find succeeded
+// then it deletes the session ID from the map
+func (s *Sessions) FindAndRemoveSession(sessionId string) (aka.IMSI, bool) {
+	var (
+		imsi  aka.IMSI
+		timer *time.Timer
+	)
+	s.rwl.Lock()
+	sessionCtx, exist := s.sessions[sessionId]
+	if exist {
+		delete(s.sessions, sessionId)
+		if sessionCtx != nil {
+			imsi, timer, sessionCtx.CleanupTimer = sessionCtx.Imsi, sessionCtx.CleanupTimer, nil
+		}
+	}
+	s.rwl.Unlock()
+	if timer != nil {
+		timer.Stop()
+	}
+	return imsi, exist
+}
+
+// ResetSessionTimeout finds a session with specified ID, if found - attempts to cancel its current timeout
+// (best effort) & schedules the new one. ResetSessionTimeout does not guarantee that the old timeout cleanup
+// won't be executed
+func (s *Sessions) ResetSessionTimeout(sessionId string, newTimeout time.Duration) {
+	var oldTimer *time.Timer
+
+	s.rwl.Lock()
+	session, exist := s.sessions[sessionId]
+	if exist {
+		if session != nil {
+			oldTimer, session.CleanupTimer = session.CleanupTimer, time.AfterFunc(newTimeout, func() {
+				sessionTimeoutCleanup(s, sessionId, session)
+			})
+		}
+	}
+	s.rwl.Unlock()
+
+	if oldTimer != nil {
+		oldTimer.Stop()
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akaprime
+
+import (
+	"fmt"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+// NewKdfInputAttribute creates AT_KDF_INPUT attribute carrying the given Access Network Name,
+// see https://tools.ietf.org/html/rfc5448#section-3.1
+func NewKdfInputAttribute(networkName string) eap.Attribute {
+	return aka.NewIdentityAttribute(AT_KDF_INPUT, networkName)
+}
+
+// GetNetworkName returns the Access Network Name carried by AT_KDF_INPUT attribute
+func GetNetworkName(a eap.Attribute) (string, error) {
+	if a.Type() != AT_KDF_INPUT {
+		return "", fmt.Errorf("unexpected attribute type: %d, AT_KDF_INPUT expected", a.Type())
+	}
+	return aka.GetIdentity(a)
+}
+
+// NewKdfAttribute creates AT_KDF attribute for the given Key Derivation Function,
+// see https://tools.ietf.org/html/rfc5448#section-3.2
+func NewKdfAttribute(kdf uint16) eap.Attribute {
+	return eap.NewAttribute(AT_KDF, []byte{byte(kdf >> 8), byte(kdf)})
+}
+
+// GetKdf returns Key Derivation Function value of AT_KDF attribute
+func GetKdf(a eap.Attribute) (uint16, error) {
+	if a.Type() != AT_KDF || a.Len() < aka.ATT_HDR_LEN {
+		return 0, fmt.Errorf("invalid AT_KDF attribute: %s", a)
+	}
+	val := a.Value()
+	return uint16(val[0])<<8 + uint16(val[1]), nil
+}
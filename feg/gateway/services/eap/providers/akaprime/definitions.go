@@ -0,0 +1,58 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package akaprime implements EAP-AKA' EAP Method (RFC 5448 & RFC 9048).
+// EAP-AKA' shares packet format, subtypes & most attributes with EAP-AKA, see aka package for their definitions
+package akaprime
+
+import (
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+const (
+	TYPE           = uint8(protos.EapType_AKAPrime)
+	MIN_PACKET_LEN = eap.EapSubtype
+
+	EapAkaPrimeServiceName = "eap_aka_prime"
+
+	// DefaultNetworkName is the Access Network Identity of WLAN access, see TS 24.302, section 8.1.1.1
+	DefaultNetworkName = "WLAN"
+)
+
+const (
+	// AKA' Attributes, see https://tools.ietf.org/html/rfc5448#section-3.1
+	AT_KDF_INPUT eap.AttrType = 23
+	AT_KDF       eap.AttrType = 24
+)
+
+const (
+	// KDF_HMAC_SHA256 is the default (and only supported) AKA' Key Derivation Function, see RFC 5448, section 3.2
+	KDF_HMAC_SHA256 uint16 = 1
+)
+
+const (
+	// EAP-AKA' Identity prefixes, see 3GPP TS 23.003, section 19.3.2
+	PermanentIdentityPrefix = '6'
+	PseudonymPrefix         = '7'
+	ReauthIdentityPrefix    = '8'
+)
+
+const (
+	MAC_LEN         = aka.MAC_LEN
+	CK_PRIME_LEN    = 16
+	IK_PRIME_LEN    = 16
+	SQN_XOR_AK_LEN  = 6
+	AT_MAC_ATTR_LEN = MAC_LEN + aka.ATT_HDR_LEN
+)
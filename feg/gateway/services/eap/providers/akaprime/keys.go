@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akaprime
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+const (
+	fcCkIkPrime = 0x20 // FC value of CK' & IK' derivation, see TS 33.402, Annex A.2
+	mkLen       = 208  // K_encr (16) | K_aut (32) | K_re (32) | MSK (64) | EMSK (64)
+)
+
+// MakeCKIKPrime derives CK' & IK' from CK, IK, Access Network Name & SQN xor AK (first 6 bytes of AUTN)
+// as specified by TS 33.402, Annex A.2 & RFC 5448, section 3.3. The derivation is done by the HSS
+func MakeCKIKPrime(CK, IK []byte, networkName string, sqnXorAk []byte) (CKPrime, IKPrime []byte) {
+	key := make([]byte, 0, len(CK)+len(IK))
+	key = append(append(key, CK...), IK...)
+	s := []byte{fcCkIkPrime}
+	for _, p := range [][]byte{[]byte(networkName), sqnXorAk} {
+		l := make([]byte, 2)
+		binary.BigEndian.PutUint16(l, uint16(len(p)))
+		s = append(append(s, p...), l...)
+	}
+	h := hmac.New(sha256.New, key)
+	h.Write(s)
+	res := h.Sum(nil)
+	return res[:CK_PRIME_LEN], res[CK_PRIME_LEN:]
+}
+
+// MakeAKAPrimeKeys returns K_encr, K_aut, K_re, MSK & EMSK keys derived from the identity, IK' & CK':
+// MK = PRF'(IK'|CK',"EAP-AKA'"|Identity), see https://tools.ietf.org/html/rfc5448#section-3.3
+func MakeAKAPrimeKeys(identity, IKPrime, CKPrime []byte) (K_encr, K_aut, K_re, MSK, EMSK []byte) {
+	key := make([]byte, 0, len(IKPrime)+len(CKPrime))
+	key = append(append(key, IKPrime...), CKPrime...)
+	mk := PrfPrime(key, append([]byte("EAP-AKA'"), identity...), mkLen)
+	return mk[:16], mk[16:48], mk[48:80], mk[80:144], mk[144:208]
+}
+
+// PrfPrime implements AKA' PRF' function: PRF'(K,S) = T1 | T2 | T3 | ..., where T1 = HMAC-SHA-256(K, S | 0x01)
+// & Tn = HMAC-SHA-256(K, Tn-1 | S | n), see https://tools.ietf.org/html/rfc5448#section-3.4.1
+func PrfPrime(key, s []byte, length int) []byte {
+	res := make([]byte, 0, length+sha256.Size)
+	var t []byte
+	for n := byte(1); len(res) < length; n++ {
+		h := hmac.New(sha256.New, key)
+		h.Write(t)
+		h.Write(s)
+		h.Write([]byte{n})
+		t = h.Sum(nil)
+		res = append(res, t...)
+	}
+	return res[:length]
+}
+
+// GenMac calculates AKA' MAC (HMAC-SHA-256-128) given data & K_aut, see RFC 5448, section 3.4.2
+func GenMac(data, K_aut []byte) []byte {
+	h := hmac.New(sha256.New, K_aut)
+	h.Write(data)
+	return h.Sum(nil)[:MAC_LEN]
+}
+
+// AppendMac appends AT_MAC attribute to eap packet, signs the packet & returns the new, signed packet
+// returns error if provided EAP Packet was malformed
+func AppendMac(p eap.Packet, K_aut []byte) (eap.Packet, error) {
+	p = p.Truncate()
+	atMacOffset := len(p) + aka.ATT_HDR_LEN
+	p, err := p.Append(eap.NewAttribute(aka.AT_MAC, append([]byte{0, 0}, make([]byte, MAC_LEN)...)))
+	if err != nil {
+		return p, err
+	}
+	copy(p[atMacOffset:], GenMac(p, K_aut))
+	return p, nil
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package akaprime
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+// Test vectors from https://tools.ietf.org/html/rfc5448#appendix-C
+const (
+	testIdentity = "0555444333222111"
+	testRAND     = "81e92b6c0ee0e12ebceba8d92a99dfa5"
+	testAUTN     = "bb52e91c747ac3ab2a5c23d15ee351d5"
+	testIK       = "9744871ad32bf9bbd1dd5ce54e3e2e5a"
+	testCK       = "5349fbe098649f948f5d2e973a81c00f"
+)
+
+var keyTestCases = []struct {
+	networkName,
+	CKPrime, IKPrime,
+	K_encr, K_aut, K_re, MSK, EMSK string
+}{
+	{
+		networkName: "WLAN",
+		CKPrime:     "0093962d0dd84aa5684b045c9edffa04",
+		IKPrime:     "ccfc230ca74fcc96c0a5d61164f5a76c",
+		K_encr:      "766fa0a6c317174b812d52fbcd11a179",
+		K_aut:       "0842ea722ff6835bfa2032499fc3ec23c2f0e388b4f07543ffc677f1696d71ea",
+		K_re:        "cf83aa8bc7e0aced892acc98e76a9b2095b558c7795c7094715cb3393aa7d17a",
+		MSK: "67c42d9aa56c1b79e295e3459fc3d187d42be0bf818d3070e362c5e967a4d544" +
+			"e8ecfe19358ab3039aff03b7c930588c055babee58a02650b067ec4e9347c75a",
+		EMSK: "f861703cd775590e16c7679ea3874ada866311de290764d760cf76df647ea01c" +
+			"313f69924bdd7650ca9bac141ea075c4ef9e8029c0e290cdbad5638b63bc23fb",
+	},
+	{
+		networkName: "HRPD",
+		CKPrime:     "3820f0277fa5f77732b1fb1d90c1a0da",
+		IKPrime:     "db94a0ab557ef6c9ab48619ca05b9a9f",
+		K_encr:      "05ad73ac915fce89ac77e1520d82187b",
+		K_aut:       "5b4acaef62c6ebb8882b2f3d534c4b35277337a00184f20ff25d224c04be2afd",
+		K_re:        "3f90bf5c6e5ef325ff04eb5ef6539fa8cca8398194fbd00be425b3f40dba10ac",
+		MSK: "87b321570117cd6c95ab6c436fb5073ff15cf85505d2bc5bb7355fc21ea8a757" +
+			"57e8f86a2b138002e05752913bb43b82f868a96117e91a2d95f526677d572900",
+		EMSK: "c891d5f20f148a1007553e2dea555c9cb672e9675f4a66b4bafa027379f93aee" +
+			"539a5979d0a0042b9d2ae28bed3b17a31dc8ab75072b80bd0c1da612466e402c",
+	},
+}
+
+func TestAKAPrimeKeys(t *testing.T) {
+	CK, IK, AUTN := mustHex(t, testCK), mustHex(t, testIK), mustHex(t, testAUTN)
+	for _, tc := range keyTestCases {
+		CKPrime, IKPrime := MakeCKIKPrime(CK, IK, tc.networkName, AUTN[:SQN_XOR_AK_LEN])
+		assertHex(t, tc.networkName+" CK'", CKPrime, tc.CKPrime)
+		assertHex(t, tc.networkName+" IK'", IKPrime, tc.IKPrime)
+
+		K_encr, K_aut, K_re, MSK, EMSK := MakeAKAPrimeKeys([]byte(testIdentity), IKPrime, CKPrime)
+		assertHex(t, tc.networkName+" K_encr", K_encr, tc.K_encr)
+		assertHex(t, tc.networkName+" K_aut", K_aut, tc.K_aut)
+		assertHex(t, tc.networkName+" K_re", K_re, tc.K_re)
+		assertHex(t, tc.networkName+" MSK", MSK, tc.MSK)
+		assertHex(t, tc.networkName+" EMSK", EMSK, tc.EMSK)
+	}
+}
+
+func TestAppendMac(t *testing.T) {
+	K_aut := mustHex(t, keyTestCases[0].K_aut)
+	p := []byte{1, 2, 0, 8, TYPE, 1, 0, 0}
+	signed, err := AppendMac(p, K_aut)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signed) != len(p)+AT_MAC_ATTR_LEN {
+		t.Fatalf("Unexpected signed packet length: %d", len(signed))
+	}
+	mac := make([]byte, MAC_LEN)
+	copy(mac, signed[len(signed)-MAC_LEN:])
+	copy(signed[len(signed)-MAC_LEN:], make([]byte, MAC_LEN))
+	if expected := GenMac(signed, K_aut); !reflect.DeepEqual(mac, expected) {
+		t.Fatalf("MAC mismatch\n\tReceived: %x\n\tExpected: %x", mac, expected)
+	}
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func assertHex(t *testing.T, name string, actual []byte, expected string) {
+	if hex.EncodeToString(actual) != expected {
+		t.Errorf("%s mismatch\n\tReceived: %x\n\tExpected: %s", name, actual, expected)
+	}
+}
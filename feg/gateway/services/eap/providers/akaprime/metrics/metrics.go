@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Prometheus counters are monotonically increasing
+// Counters reset to zero on service restart
+var (
+	// Generic service counters
+	Requests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_requests_total",
+		Help: "Total number of EAP-AKA' Handle requests",
+	})
+	FailedRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_failed_requests_total",
+		Help: "Total number of failed EAP-AKA' Handle requests",
+	})
+	FailureNotifications = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_failure_notifications_total",
+		Help: "Total number of Notification Failures Returned to peers",
+	})
+	SwxRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_swx_requests_total",
+		Help: "Total number of SWx Proxy RPC Requests sent",
+	})
+	SwxFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_swx_failures_total",
+		Help: "Total number of SWx Proxy RPC Failures",
+	})
+	SessionTimeouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_session_timeouts_total",
+		Help: "Total number of EAP-AKA' Session Timeouts",
+	})
+
+	// Method Handlers metrics
+	IdentityRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_identity_requests_total",
+		Help: "Total number of calls to AKA' Identity Handler",
+	})
+	FailedIdentityRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_failed_identity_requests_total",
+		Help: "Total number of failed calls to AKA' Identity Handler",
+	})
+	ChallengeRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_challenge_requests_total",
+		Help: "Total number of calls to AKA' Challenge Handler",
+	})
+	FailedChallengeRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_failed_challenge_requests_total",
+		Help: "Total number of failed calls to AKA' Challenge Handler",
+	})
+	ResyncRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_resync_requests_total",
+		Help: "Total number of calls to AKA' Resync Handler",
+	})
+	FailedResyncRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_failed_resync_requests_total",
+		Help: "Total number of failed calls to AKA' Resync Handler",
+	})
+
+	// Peer initiated failures
+	PeerAuthReject = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_peer_auth_reject_total",
+		Help: "Total number of AKA' SubtypeAuthenticationReject calls from peer",
+	})
+	PeerClientError = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_peer_client_errors_total",
+		Help: "Total number of AKA' SubtypeClientError calls from peer",
+	})
+	PeerNotification = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_peer_notifications_total",
+		Help: "Total number of AKA' SubtypeNotification from peer",
+	})
+	PeerFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "eap_aka_prime_peer_failures_total",
+		Help: "Total number of AKA' Errors/Failures originated from peers",
+	})
+
+	// Latencies
+	SWxLatency = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "eap_aka_prime_swx_proxy_lat",
+		Help:       "Latency of SWx Proxy requests (seconds).",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+	})
+	AuthLatency = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "eap_aka_prime_auth_lat",
+		Help:       "Latency of EAP-AKA' Authentication round (seconds). Only calculated for completed authentications.",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+	})
+)
+
+func init() {
+	prometheus.MustRegister(Requests, FailedRequests, FailureNotifications,
+		SwxRequests, SwxFailures, SessionTimeouts, IdentityRequests, FailedIdentityRequests,
+		ChallengeRequests, FailedChallengeRequests, ResyncRequests, FailedResyncRequests,
+		PeerAuthReject, PeerClientError, PeerNotification, PeerFailures,
+		SWxLatency, AuthLatency)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package handlers provides AKA' Response handlers for supported AKA' subtypes
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/metrics"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+)
+
+func init() {
+	servicers.AddHandler(aka.SubtypeIdentity, identityResponse)
+}
+
+// identityResponse implements handler for EAP-Response/AKA'-Identity, see https://tools.ietf.org/html/rfc5448#section-3
+func identityResponse(s *servicers.EapAkaPrimeSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var success bool
+	metrics.IdentityRequests.Inc()
+	defer func() {
+		if !success {
+			metrics.FailedIdentityRequests.Inc()
+		}
+	}()
+	identifier := req.Identifier()
+	if ctx == nil {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		ctx.SessionId = eap.CreateSessionId()
+		glog.Warningf("Missing Session ID for EAP: %x; Generated new SID: %s", req, ctx.SessionId)
+	}
+	scanner, err := eap.NewAttributeScanner(req)
+	if err != nil {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Aborted, err.Error())
+	}
+	var a eap.Attribute
+
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		// Find first valid AT_IDENTITY attribute to get UE IMSI
+		if a.Type() == aka.AT_IDENTITY {
+			identity, imsi, err := getIMSIIdentity(a)
+			if err != nil {
+				glog.Warningf("Invalid AKA' AT_IDENTITY: %v", err)
+				continue
+			}
+			if !s.CheckPlmnId(imsi) {
+				s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+				return akaprime.EapErrorResPacket(
+					identifier,
+					aka.NOTIFICATION_FAILURE,
+					codes.PermissionDenied,
+					"PLMN ID of IMSI: %s is not permitted", imsi)
+			}
+			ctx.Imsi = string(imsi)                  // set IMSI
+			uc := s.InitSession(ctx.SessionId, imsi) // we have Locked User Ctx after this call
+			state, t := uc.State()
+			if state > aka.StateCreated {
+				glog.Errorf(
+					"EAP AKA' IdentityResponse: Unexpected user state: %d,%s for IMSI: %s, CTX Identity: %s",
+					state, t, imsi, uc.Identity)
+			}
+			uc.Identity = identity
+			uc.SetState(aka.StateIdentity)
+			p, err := createChallengeRequest(s, uc, identifier, nil)
+			if success = err == nil; success {
+				// Update state
+				uc.SetState(aka.StateChallenge)
+				s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+			} else {
+				s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+			}
+			return p, err
+		}
+	}
+	s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+	if err != nil && err != io.EOF {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, err.Error())
+	}
+	return akaprime.EapErrorResPacket(
+		identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition, "Missing AT_IDENTITY Attribute")
+}
+
+// getIMSIIdentity returns the full identity & IMSI of AKA' permanent identity (6<IMSI>@<realm>),
+// see TS 23.003, section 19.3.2
+func getIMSIIdentity(a eap.Attribute) (string, aka.IMSI, error) {
+	if a.Type() != aka.AT_IDENTITY {
+		return "", "", fmt.Errorf("Unexpected Attr Type: %d, AT_IDENTITY expected", a.Type())
+	}
+	fullIdentity, err := aka.GetIdentity(a)
+	if err != nil {
+		return "", "", err
+	}
+	username := fullIdentity
+	if atIdx := strings.Index(fullIdentity, "@"); atIdx > 0 {
+		username = fullIdentity[:atIdx]
+	}
+	if len(username) > 0 && username[0] == akaprime.PermanentIdentityPrefix {
+		username = username[1:]
+	} else {
+		glog.Warningf("AKA' AT_IDENTITY '%s' is not a permanent AKA' identity", fullIdentity)
+	}
+	imsi := aka.IMSI(username)
+	return fullIdentity, imsi, imsi.Validate()
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	swx_protos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/metrics"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+	"magma/feg/gateway/services/swx_proxy"
+)
+
+type tgppAuthResult struct {
+	rand, autn, xres,
+	ck, ik []byte // CK' & IK'
+	sid     string
+	profile *swx_protos.AuthenticationAnswer_UserProfile
+}
+
+// getSwxVector retrieves EAP-AKA' vector over SWx, the HSS derives CK' & IK' bound to the Access Network Identity
+// (RFC 5448, section 3.3 & TS 33.402, section 6.2). S6a vectors carry CK & IK which would have to be bound
+// by the service, so S6a isn't supported by EAP-AKA'
+func getSwxVector(s *servicers.EapAkaPrimeSrv, imsi string, resyncInfo []byte) (*tgppAuthResult, error) {
+	metrics.SwxRequests.Inc()
+	swxStartTime := time.Now()
+
+	ans, err := swx_proxy.Authenticate(
+		&swx_protos.AuthenticationRequest{
+			UserName:              imsi,
+			SipNumAuthVectors:     1,
+			AuthenticationScheme:  swx_protos.AuthenticationScheme_EAP_AKA_PRIME,
+			ResyncInfo:            resyncInfo,
+			RetrieveUserProfile:   true,
+			AccessNetworkIdentity: s.NetworkName(),
+		})
+
+	metrics.SWxLatency.Observe(time.Since(swxStartTime).Seconds())
+
+	if err != nil {
+		metrics.SwxFailures.Inc()
+		errCode := codes.Internal
+		if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			errCode = se.GRPCStatus().Code()
+		}
+		return nil, status.Errorf(errCode, "%v; IMSI: %s", err, imsi)
+	}
+	if ans == nil {
+		return nil, status.Error(codes.Internal, "Error: Nil SWx Response")
+	}
+	if len(ans.SipAuthVectors) == 0 {
+		return nil, status.Errorf(codes.Internal, "Error: Missing/empty SWx Auth Vector: %+v", ans)
+	}
+	av := ans.SipAuthVectors[0] // Use first vector for now
+	if av.GetAuthenticationScheme() != swx_protos.AuthenticationScheme_EAP_AKA_PRIME {
+		return nil, status.Errorf(codes.Internal,
+			"Unexpected SWx Auth Vector scheme %s, EAP_AKA_PRIME expected; IMSI: %s", av.GetAuthenticationScheme(), imsi)
+	}
+	ra := av.GetRandAutn()
+	if len(ra) < aka.RandAutnLen {
+		return nil, status.Errorf(codes.Internal,
+			"Invalid SWx RandAutn len (%d, expected: %d) in Response: %+v", len(ra), aka.RandAutnLen, ans)
+	}
+	return &tgppAuthResult{
+		rand:    ra[:aka.RAND_LEN],
+		autn:    ra[aka.RAND_LEN:aka.RandAutnLen],
+		xres:    av.GetXres(),
+		ck:      av.GetConfidentialityKey(),
+		ik:      av.GetIntegrityKey(),
+		sid:     ans.GetSessionId(),
+		profile: ans.GetUserProfile(),
+	}, nil
+}
+
+// createChallengeRequest retrieves a new vector for the UE & returns EAP-Request/AKA'-Challenge,
+// see https://tools.ietf.org/html/rfc5448#section-3
+func createChallengeRequest(
+	s *servicers.EapAkaPrimeSrv,
+	lockedCtx *servicers.UserCtx,
+	identifier uint8,
+	resyncInfo []byte) (eap.Packet, error) {
+
+	var (
+		authRes *tgppAuthResult
+		err     error
+	)
+	authRes, err = getSwxVector(s, string(lockedCtx.Imsi), resyncInfo)
+	if err == nil && (len(authRes.autn) < aka.AUTN_LEN ||
+		len(authRes.ck) != akaprime.CK_PRIME_LEN || len(authRes.ik) != akaprime.IK_PRIME_LEN) {
+		err = status.Errorf(codes.Internal, "Invalid Auth Vector for IMSI: %s", lockedCtx.Imsi)
+	}
+	if err != nil {
+		var (
+			code codes.Code
+			msg  string
+		)
+		if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			code = se.GRPCStatus().Code()
+			msg = se.GRPCStatus().Message()
+		} else {
+			code = codes.Internal
+			msg = err.Error()
+		}
+		glog.Errorf("AKA' RPC [%s] %s", code, msg)
+		return akaprime.NewNotificationReq(identifier, aka.NOTIFICATION_FAILURE), nil
+	}
+	identifier++
+
+	lockedCtx.Identifier = identifier
+	lockedCtx.Rand = authRes.rand
+	lockedCtx.Xres = authRes.xres
+	lockedCtx.AuthSessionId = authRes.sid
+	lockedCtx.Profile = authRes.profile
+
+	// SWx vector carries CK' & IK'
+	_, lockedCtx.K_aut, _, lockedCtx.MSK, _ = akaprime.MakeAKAPrimeKeys([]byte(lockedCtx.Identity), authRes.ik, authRes.ck)
+
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{akaprime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	for _, a := range []eap.Attribute{
+		eap.NewAttribute(aka.AT_RAND, append([]byte{0, 0}, authRes.rand...)),
+		eap.NewAttribute(aka.AT_AUTN, append([]byte{0, 0}, authRes.autn...)),
+		akaprime.NewKdfInputAttribute(s.NetworkName()),
+		akaprime.NewKdfAttribute(akaprime.KDF_HMAC_SHA256),
+	} {
+		if p, err = p.Append(a); err != nil {
+			return nil, err
+		}
+	}
+	return akaprime.AppendMac(p, lockedCtx.K_aut)
+}
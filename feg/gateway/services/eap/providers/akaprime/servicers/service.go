@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// package servicers implements EAP-AKA' GRPC service
+package servicers
+
+import (
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/plmn_filter"
+	"magma/feg/gateway/services/eap/providers/aka"
+	aka_servicers "magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/metrics"
+)
+
+// UserCtx & SessionCtx are shared with EAP-AKA service, EAP-AKA' doesn't use Fast Re-authentication state
+type (
+	UserCtx    = aka_servicers.UserCtx
+	SessionCtx = aka_servicers.SessionCtx
+)
+
+type EapAkaPrimeSrv struct {
+	*aka_servicers.Sessions
+
+	// PLMN IDs map, if not empty -> serve only IMSIs with specified PLMN IDs - Read Only
+	plmnFilter plmn_filter.PlmnIdVals
+
+	mncLen      int32
+	networkName string
+}
+
+// NewEapAkaPrimeService creates new AKA' Service 'object'
+func NewEapAkaPrimeService(config *mconfig.EapAkaPrimeConfig) (*EapAkaPrimeSrv, error) {
+	service := &EapAkaPrimeSrv{
+		Sessions:    aka_servicers.NewSessions("EAP-AKA'", metrics.SessionTimeouts),
+		plmnFilter:  plmn_filter.PlmnIdVals{},
+		mncLen:      3,
+		networkName: akaprime.DefaultNetworkName,
+	}
+	if config != nil {
+		service.SetTimeouts(config.GetTimeout())
+		service.plmnFilter = plmn_filter.GetPlmnVals(config.PlmnIds, "EAP-AKA'")
+		if mncLn := config.GetMncLen(); mncLn >= 2 && mncLn <= 3 {
+			service.mncLen = mncLn
+		}
+		if len(config.GetNetworkName()) > 0 {
+			service.networkName = config.GetNetworkName()
+		}
+	}
+	glog.Infof("EAP-AKA': Using SWx Auth Vectors, Access Network Name: '%s'", service.networkName)
+	return service, nil
+}
+
+// SetPlmnIdFilter resets the service's PLMN ID filter from given PLMN ID list
+func (s *EapAkaPrimeSrv) SetPlmnIdFilter(plmnIds []string) {
+	s.plmnFilter = plmn_filter.GetPlmnVals(plmnIds, "EAP-AKA'")
+}
+
+// CheckPlmnId returns true either if there is no PLMN ID filters (allowlist) configured or
+// one the configured PLMN IDs matches passed IMSI
+func (s *EapAkaPrimeSrv) CheckPlmnId(imsi aka.IMSI) bool {
+	return s == nil || s.plmnFilter.Check(string(imsi))
+}
+
+func (s *EapAkaPrimeSrv) MncLen() int {
+	if s != nil {
+		return int(s.mncLen)
+	}
+	return 3
+}
+
+// NetworkName returns Access Network Identity, the HSS binds CK' & IK' to it
+func (s *EapAkaPrimeSrv) NetworkName() string {
+	if s != nil {
+		return s.networkName
+	}
+	return akaprime.DefaultNetworkName
+}
@@ -16,10 +16,12 @@ package registry
 
 import (
 	aka_provider "magma/feg/gateway/services/eap/providers/aka/provider"
+	akaprime_provider "magma/feg/gateway/services/eap/providers/akaprime/provider"
 	sim_provider "magma/feg/gateway/services/eap/providers/sim/provider"
 )
 
 func init() {
 	Register(aka_provider.New())
 	Register(sim_provider.New())
+	Register(akaprime_provider.New())
 }
@@ -126,6 +126,8 @@ func challengeResponse(s *servicers.EapSimSrv, ctx *protos.Context, req eap.Pack
 	ctx.Imsi = string(imsi)
 	if uc.Profile != nil {
 		ctx.Msisdn = uc.Profile.Msisdn
+		ctx.AmbrUl = uc.Profile.GetAmbr().GetMaxBandwidthUl()
+		ctx.AmbrDl = uc.Profile.GetAmbr().GetMaxBandwidthDl()
 	}
 	ctx.Msk = uc.MSK
 	ctx.Identity = uc.Identity
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package n12_n8_proxy provides a thin client for using N12/N8 proxy service.
+// This can be used by apps to discover and contact the service, without knowing about
+// the RPC implementation.
+package n12_n8_proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+)
+
+// getN12N8ProxyClient is a utility function to get a RPC connection to the
+// N12/N8 Proxy service
+func getN12N8ProxyClient() (protos.N12N8ProxyClient, error) {
+	conn, err := registry.GetConnection(registry.N12_N8_PROXY)
+	if err != nil {
+		errMsg := fmt.Sprintf("N12/N8 Proxy client initialization error: %s", err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return protos.NewN12N8ProxyClient(conn), nil
+}
+
+// Authenticate starts UE authentication with AUSF (POST /ue-authentications),
+// waits (blocks) for the 5G AKA or EAP-AKA' challenge & returns its RPC representation
+func Authenticate(req *protos.UEAuthenticationRequest) (*protos.UEAuthenticationAnswer, error) {
+	if req == nil {
+		return nil, errors.New("Invalid UEAuthenticationRequest")
+	}
+	cli, err := getN12N8ProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.Authenticate(context.Background(), req)
+}
+
+// ConfirmAuthentication sends UE's RES* to AUSF, waits (blocks) for the 5G AKA
+// confirmation result & returns its RPC representation
+func ConfirmAuthentication(req *protos.AuthConfirmationRequest) (*protos.AuthConfirmationAnswer, error) {
+	if req == nil {
+		return nil, errors.New("Invalid AuthConfirmationRequest")
+	}
+	cli, err := getN12N8ProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.ConfirmAuthentication(context.Background(), req)
+}
+
+// EapSession relays UE's EAP-AKA' response to AUSF, waits (blocks) for the next
+// EAP message & returns its RPC representation
+func EapSession(req *protos.EapSessionRequest) (*protos.EapSessionAnswer, error) {
+	if req == nil {
+		return nil, errors.New("Invalid EapSessionRequest")
+	}
+	cli, err := getN12N8ProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.EapSession(context.Background(), req)
+}
+
+// RegisterAmf registers the serving AMF of the subscriber with UDM
+func RegisterAmf(req *protos.AmfRegistrationRequest) (*protos.AmfRegistrationAnswer, error) {
+	if req == nil {
+		return nil, errors.New("Invalid AmfRegistrationRequest")
+	}
+	cli, err := getN12N8ProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.RegisterAmf(context.Background(), req)
+}
+
+// DeregisterAmf purges the serving AMF registration of the subscriber from UDM
+func DeregisterAmf(req *protos.AmfDeregistrationRequest) (*protos.AmfDeregistrationAnswer, error) {
+	if req == nil {
+		return nil, errors.New("Invalid AmfDeregistrationRequest")
+	}
+	cli, err := getN12N8ProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.DeregisterAmf(context.Background(), req)
+}
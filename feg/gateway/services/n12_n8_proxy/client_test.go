@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package n12_n8_proxy_test
+
+import (
+	"encoding/hex"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/sbi"
+	"magma/feg/gateway/services/n12_n8_proxy"
+	"magma/feg/gateway/services/n12_n8_proxy/servicers"
+	"magma/feg/gateway/services/n12_n8_proxy/test_init"
+	"magma/feg/gateway/services/testcore/ausf_udm/mock_ausf_udm"
+)
+
+const (
+	TEST_IMSI       = "001010000000001"
+	SERVING_NETWORK = "5G:mnc001.mcc001.3gppnetwork.org"
+)
+
+func TestN12N8ProxyClient(t *testing.T) {
+	key, _ := hex.DecodeString("8baf473f2f8fd09487cccbd7097c6862")
+	opc, _ := hex.DecodeString("8e27b6af0e692e750f32667a3b14605d")
+	mock := mock_ausf_udm.NewMockAusfUdm()
+	require.NoError(t, mock.Start("127.0.0.1:0"))
+	defer mock.Stop()
+	mock.AddSubscriber(TEST_IMSI, mock_ausf_udm.Subscriber{Key: key, Opc: opc, Sqn: 1})
+
+	ausfApiRoot, _ := url.ParseRequestURI(mock.ApiRoot() + mock_ausf_udm.AusfBasePath)
+	udmApiRoot, _ := url.ParseRequestURI(mock.ApiRoot() + mock_ausf_udm.UdmUecmBasePath)
+	tokenUrl := mock.ApiRoot() + mock_ausf_udm.TokenPath
+	err := test_init.StartTestService(t, &servicers.N12N8ProxyConfig{
+		AusfConfig:     sbi.RemoteConfig{ApiRoot: *ausfApiRoot, TokenUrl: tokenUrl},
+		UdmConfig:      sbi.RemoteConfig{ApiRoot: *udmApiRoot, TokenUrl: tokenUrl},
+		RequestTimeout: 3 * time.Second,
+	})
+	require.NoError(t, err)
+
+	authAns, err := n12_n8_proxy.Authenticate(
+		&protos.UEAuthenticationRequest{SupiOrSuci: "imsi-" + TEST_IMSI, ServingNetworkName: SERVING_NETWORK})
+	require.NoError(t, err)
+	assert.Equal(t, protos.AuthType5G_FIVE_G_AKA, authAns.GetAuthType())
+
+	res, ck, ik, err := mock_ausf_udm.UeResponse(key, opc, authAns.GetRand())
+	require.NoError(t, err)
+	confirmAns, err := n12_n8_proxy.ConfirmAuthentication(&protos.AuthConfirmationRequest{
+		AuthCtxId: authAns.GetAuthCtxId(),
+		ResStar:   mock_ausf_udm.ResStar(ck, ik, SERVING_NETWORK, authAns.GetRand(), res),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, protos.AuthResult5G_AUTHENTICATION_SUCCESS, confirmAns.GetAuthResult())
+
+	guami := &protos.Guami{Mcc: "001", Mnc: "01", AmfId: "cafe00"}
+	regAns, err := n12_n8_proxy.RegisterAmf(&protos.AmfRegistrationRequest{
+		Supi:             "imsi-" + TEST_IMSI,
+		AmfInstanceId:    "a7b1c5f2-3a5e-4f3e-9c1d-0b7a1c2d3e4f",
+		Guami:            guami,
+		DeregCallbackUri: "https://amf.magma.com/namf-callback/v1/deregister",
+	})
+	require.NoError(t, err)
+	assert.True(t, regAns.GetCreated())
+
+	_, err = n12_n8_proxy.DeregisterAmf(&protos.AmfDeregistrationRequest{Supi: "imsi-" + TEST_IMSI, Guami: guami})
+	require.NoError(t, err)
+	_, found := mock.GetAmfRegistration(TEST_IMSI)
+	assert.False(t, found)
+
+	_, err = n12_n8_proxy.EapSession(nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Prometheus counters are monotonically increasing
+// Counters reset to zero on service restart
+var (
+	AusfAuthRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ausf_auth_requests_total",
+		Help: "Total number of UE Authentication requests sent to AUSF",
+	})
+	AusfAuthFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ausf_auth_failures_total",
+		Help: "Total number of UE Authentication requests that failed",
+	})
+	AusfConfirmRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ausf_confirm_requests_total",
+		Help: "Total number of 5G AKA confirmation requests sent to AUSF",
+	})
+	AusfConfirmFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ausf_confirm_failures_total",
+		Help: "Total number of 5G AKA confirmation requests that failed",
+	})
+	AusfEapRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ausf_eap_requests_total",
+		Help: "Total number of EAP session requests sent to AUSF",
+	})
+	AusfEapFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ausf_eap_failures_total",
+		Help: "Total number of EAP session requests that failed",
+	})
+	UdmRegistrationRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "udm_registration_requests_total",
+		Help: "Total number of AMF registration requests sent to UDM",
+	})
+	UdmRegistrationFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "udm_registration_failures_total",
+		Help: "Total number of AMF registration requests that failed",
+	})
+	UdmDeregistrationRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "udm_deregistration_requests_total",
+		Help: "Total number of AMF deregistration requests sent to UDM",
+	})
+	UdmDeregistrationFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "udm_deregistration_failures_total",
+		Help: "Total number of AMF deregistration requests that failed",
+	})
+	N12N8Timeouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "n12_n8_timeouts_total",
+		Help: "Total number of N12 & N8 request timeouts",
+	})
+	N12N8StatusCodes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "n12_n8_status_codes",
+			Help: "N12 & N8 accumulated HTTP status codes",
+		},
+		[]string{"code"},
+	)
+	// Latencies
+	AusfLatency = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "ausf_lat",
+		Help:       "Latency of AUSF requests (milliseconds).",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+	})
+	UdmLatency = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "udm_lat",
+		Help:       "Latency of UDM requests (milliseconds).",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+	})
+)
+
+func init() {
+	prometheus.MustRegister(AusfAuthRequests, AusfAuthFailures, AusfConfirmRequests, AusfConfirmFailures,
+		AusfEapRequests, AusfEapFailures, UdmRegistrationRequests, UdmRegistrationFailures,
+		UdmDeregistrationRequests, UdmDeregistrationFailures, N12N8Timeouts, N12N8StatusCodes,
+		AusfLatency, UdmLatency)
+}
+
+// ObserveLatency records the latency of a request started at startTime
+func ObserveLatency(summary prometheus.Summary, startTime time.Time) {
+	summary.Observe(float64(time.Since(startTime).Milliseconds()))
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Magma FeG N12/N8 Proxy Service
+// Relays AMF 5G authentication & registration requests to the partner's AUSF & UDM
+package main
+
+import (
+	"flag"
+
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/n12_n8_proxy/servicers"
+	"magma/orc8r/lib/go/service"
+)
+
+func init() {
+	flag.Parse()
+}
+
+func main() {
+	// Create the service
+	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.N12_N8_PROXY)
+	if err != nil {
+		glog.Fatalf("Error creating N12/N8 Proxy service: %s", err)
+	}
+
+	config, err := servicers.GetN12N8ProxyConfig()
+	if err != nil {
+		glog.Fatalf("Error fetching N12/N8 Proxy config: %s", err)
+	}
+	servicer, err := servicers.NewN12N8Proxy(config)
+	if err != nil {
+		glog.Fatalf("Failed to create N12/N8 Proxy: %s", err)
+	}
+	protos.RegisterN12N8ProxyServer(srv.GrpcServer, servicer)
+
+	// Run the service
+	err = srv.Run()
+	if err != nil {
+		glog.Fatalf("Error running N12/N8 Proxy service: %s", err)
+	}
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/feg/cloud/go/protos"
+	sbi_NudmUEAU "magma/feg/gateway/sbi/specs/TS29503NudmUEAU"
+	sbi_NausfUEAuthentication "magma/feg/gateway/sbi/specs/TS29509NausfUEAuthentication"
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	"magma/feg/gateway/services/n12_n8_proxy/metrics"
+)
+
+const (
+	authType5gAka       = "5G_AKA"
+	authTypeEapAkaPrime = "EAP_AKA_PRIME"
+)
+
+// Authenticate sends UE Authentication request (POST /ue-authentications) to AUSF & returns
+// the created authentication context with either 5G AKA vector or EAP-AKA' challenge
+func (srv *n12N8Proxy) Authenticate(
+	ctx context.Context, req *protos.UEAuthenticationRequest,
+) (*protos.UEAuthenticationAnswer, error) {
+	err := validateUEAuthenticationRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	metrics.AusfAuthRequests.Inc()
+	res, err := srv.authenticate(ctx, req)
+	if err != nil {
+		metrics.AusfAuthFailures.Inc()
+		glog.Errorf("UE Authentication for %s failed: %v", req.GetSupiOrSuci(), err)
+	}
+	return res, err
+}
+
+func (srv *n12N8Proxy) authenticate(
+	ctx context.Context, req *protos.UEAuthenticationRequest,
+) (*protos.UEAuthenticationAnswer, error) {
+	body := sbi_NausfUEAuthentication.PostUeAuthenticationsJSONRequestBody{
+		SupiOrSuci:         sbi_NudmUEAU.SupiOrSuci(req.GetSupiOrSuci()),
+		ServingNetworkName: sbi_NudmUEAU.ServingNetworkName(req.GetServingNetworkName()),
+	}
+	if len(req.GetPei()) > 0 {
+		pei := sbi_CommonData.Pei(req.GetPei())
+		body.Pei = &pei
+	}
+	if len(req.GetResyncRand()) > 0 {
+		body.ResynchronizationInfo = &sbi_NudmUEAU.ResynchronizationInfo{
+			Rand: sbi_NudmUEAU.Rand(toHex(req.GetResyncRand())),
+			Auts: sbi_NudmUEAU.Auts(toHex(req.GetResyncAuts())),
+		}
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, srv.config.RequestTimeout)
+	defer cancel()
+	startTime := time.Now()
+	resp, err := srv.ausfClient.PostUeAuthenticationsWithResponse(reqCtx, body)
+	metrics.ObserveLatency(metrics.AusfLatency, startTime)
+	if err != nil {
+		return nil, requestError("UE Authentication", err)
+	}
+	if resp.StatusCode() != http.StatusCreated {
+		return nil, sbiError("UE Authentication", resp.HTTPResponse, resp.Body)
+	}
+	recordStatusCode(resp.HTTPResponse)
+
+	authCtxId, err := authCtxIdFromLocation(resp.HTTPResponse.Header.Get("Location"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "UE Authentication: %v", err)
+	}
+	var authCtx sbi_NausfUEAuthentication.UEAuthenticationCtx
+	err = json.Unmarshal(resp.Body, &authCtx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "UE Authentication: error decoding UEAuthenticationCtx: %v", err)
+	}
+	res, err := convertUEAuthenticationCtx(&authCtx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "UE Authentication: %v", err)
+	}
+	res.AuthCtxId = authCtxId
+	return res, nil
+}
+
+// ConfirmAuthentication sends RES* received from the UE to AUSF (PUT /ue-authentications/{authCtxId}/5g-aka-confirmation)
+// & returns the authentication result with SUPI & K_SEAF on success
+func (srv *n12N8Proxy) ConfirmAuthentication(
+	ctx context.Context, req *protos.AuthConfirmationRequest,
+) (*protos.AuthConfirmationAnswer, error) {
+	if len(req.GetAuthCtxId()) == 0 || len(req.GetResStar()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing authentication context ID or RES*")
+	}
+	metrics.AusfConfirmRequests.Inc()
+	res, err := srv.confirmAuthentication(ctx, req)
+	if err != nil {
+		metrics.AusfConfirmFailures.Inc()
+		glog.Errorf("5G AKA confirmation of %s failed: %v", req.GetAuthCtxId(), err)
+	}
+	return res, err
+}
+
+func (srv *n12N8Proxy) confirmAuthentication(
+	ctx context.Context, req *protos.AuthConfirmationRequest,
+) (*protos.AuthConfirmationAnswer, error) {
+	resStar := sbi_NausfUEAuthentication.ResStar(toHex(req.GetResStar()))
+	body := sbi_NausfUEAuthentication.PutUeAuthenticationsAuthCtxId5gAkaConfirmationJSONRequestBody{ResStar: &resStar}
+
+	reqCtx, cancel := context.WithTimeout(ctx, srv.config.RequestTimeout)
+	defer cancel()
+	startTime := time.Now()
+	resp, err := srv.ausfClient.PutUeAuthenticationsAuthCtxId5gAkaConfirmationWithResponse(reqCtx, req.GetAuthCtxId(), body)
+	metrics.ObserveLatency(metrics.AusfLatency, startTime)
+	if err != nil {
+		return nil, requestError("5G AKA confirmation", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, sbiError("5G AKA confirmation", resp.HTTPResponse, resp.Body)
+	}
+	recordStatusCode(resp.HTTPResponse)
+
+	res := &protos.AuthConfirmationAnswer{AuthResult: convertAuthResult(&resp.JSON200.AuthResult)}
+	if resp.JSON200.Supi != nil {
+		res.Supi = string(*resp.JSON200.Supi)
+	}
+	if resp.JSON200.Kseaf != nil {
+		res.Kseaf, err = hex.DecodeString(string(*resp.JSON200.Kseaf))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "5G AKA confirmation: invalid K_SEAF: %v", err)
+		}
+	}
+	return res, nil
+}
+
+// EapSession sends EAP-Response received from the UE to AUSF (POST /ue-authentications/{authCtxId}/eap-session)
+// & returns the next EAP-Request or the final EAP result with SUPI & K_SEAF on success
+func (srv *n12N8Proxy) EapSession(ctx context.Context, req *protos.EapSessionRequest) (*protos.EapSessionAnswer, error) {
+	if len(req.GetAuthCtxId()) == 0 || len(req.GetEapPayload()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing authentication context ID or EAP payload")
+	}
+	metrics.AusfEapRequests.Inc()
+	res, err := srv.eapSession(ctx, req)
+	if err != nil {
+		metrics.AusfEapFailures.Inc()
+		glog.Errorf("EAP session of %s failed: %v", req.GetAuthCtxId(), err)
+	}
+	return res, err
+}
+
+func (srv *n12N8Proxy) eapSession(ctx context.Context, req *protos.EapSessionRequest) (*protos.EapSessionAnswer, error) {
+	body := sbi_NausfUEAuthentication.EapAuthMethodJSONRequestBody{
+		EapPayload: sbi_NausfUEAuthentication.EapPayload(base64.StdEncoding.EncodeToString(req.GetEapPayload())),
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, srv.config.RequestTimeout)
+	defer cancel()
+	startTime := time.Now()
+	resp, err := srv.ausfClient.EapAuthMethodWithResponse(reqCtx, req.GetAuthCtxId(), body)
+	metrics.ObserveLatency(metrics.AusfLatency, startTime)
+	if err != nil {
+		return nil, requestError("EAP session", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return nil, sbiError("EAP session", resp.HTTPResponse, resp.Body)
+	}
+	recordStatusCode(resp.HTTPResponse)
+
+	eapPayload, err := base64.StdEncoding.DecodeString(string(resp.JSON200.EapPayload))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "EAP session: invalid EAP payload: %v", err)
+	}
+	res := &protos.EapSessionAnswer{
+		EapPayload: eapPayload,
+		AuthResult: convertAuthResult(resp.JSON200.AuthResult),
+	}
+	if resp.JSON200.Supi != nil {
+		res.Supi = string(*resp.JSON200.Supi)
+	}
+	if resp.JSON200.KSeaf != nil {
+		res.Kseaf, err = hex.DecodeString(string(*resp.JSON200.KSeaf))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "EAP session: invalid K_SEAF: %v", err)
+		}
+	}
+	return res, nil
+}
+
+func validateUEAuthenticationRequest(req *protos.UEAuthenticationRequest) error {
+	if req == nil {
+		return fmt.Errorf("nil UEAuthenticationRequest")
+	}
+	if len(req.GetSupiOrSuci()) == 0 {
+		return fmt.Errorf("missing SUPI or SUCI")
+	}
+	if len(req.GetServingNetworkName()) == 0 {
+		return fmt.Errorf("missing serving network name")
+	}
+	if (len(req.GetResyncRand()) == 0) != (len(req.GetResyncAuts()) == 0) {
+		return fmt.Errorf("resync requires both RAND and AUTS")
+	}
+	return nil
+}
+
+func convertUEAuthenticationCtx(authCtx *sbi_NausfUEAuthentication.UEAuthenticationCtx) (*protos.UEAuthenticationAnswer, error) {
+	authType, _ := authCtx.AuthType.(string)
+	switch authType {
+	case authType5gAka:
+		// 5gAuthData is a oneOf Av5gAka & EapPayload, re-decode it as Av5gAka
+		data, err := json.Marshal(authCtx.N5gAuthData)
+		if err != nil {
+			return nil, err
+		}
+		var av sbi_NausfUEAuthentication.Av5gAka
+		err = json.Unmarshal(data, &av)
+		if err != nil {
+			return nil, fmt.Errorf("invalid 5G AKA vector: %v", err)
+		}
+		res := &protos.UEAuthenticationAnswer{AuthType: protos.AuthType5G_FIVE_G_AKA}
+		if res.Rand, err = hex.DecodeString(string(av.Rand)); err != nil {
+			return nil, fmt.Errorf("invalid RAND: %v", err)
+		}
+		if res.Autn, err = hex.DecodeString(string(av.Autn)); err != nil {
+			return nil, fmt.Errorf("invalid AUTN: %v", err)
+		}
+		if res.HxresStar, err = hex.DecodeString(string(av.HxresStar)); err != nil {
+			return nil, fmt.Errorf("invalid HXRES*: %v", err)
+		}
+		return res, nil
+	case authTypeEapAkaPrime:
+		eapPayload, ok := authCtx.N5gAuthData.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid EAP payload type: %T", authCtx.N5gAuthData)
+		}
+		payload, err := base64.StdEncoding.DecodeString(eapPayload)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EAP payload: %v", err)
+		}
+		return &protos.UEAuthenticationAnswer{AuthType: protos.AuthType5G_FIVE_G_EAP_AKA_PRIME, EapPayload: payload}, nil
+	default:
+		return nil, fmt.Errorf("unsupported authentication type: %v", authCtx.AuthType)
+	}
+}
+
+func convertAuthResult(result *sbi_NausfUEAuthentication.AuthResult) protos.AuthResult5G {
+	if result == nil {
+		return protos.AuthResult5G_AUTHENTICATION_ONGOING
+	}
+	switch *result {
+	case sbi_NausfUEAuthentication.AuthResultAUTHENTICATIONSUCCESS:
+		return protos.AuthResult5G_AUTHENTICATION_SUCCESS
+	case sbi_NausfUEAuthentication.AuthResultAUTHENTICATIONFAILURE:
+		return protos.AuthResult5G_AUTHENTICATION_FAILURE
+	default:
+		return protos.AuthResult5G_AUTHENTICATION_ONGOING
+	}
+}
+
+// authCtxIdFromLocation returns the authCtxId from {apiRoot}/nausf-auth/v1/ue-authentications/{authCtxId}
+func authCtxIdFromLocation(location string) (string, error) {
+	if len(location) == 0 {
+		return "", fmt.Errorf("Location header not found")
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("invalid Location header %s: %v", location, err)
+	}
+	authCtxId := path.Base(u.Path)
+	if path.Base(path.Dir(u.Path)) != "ue-authentications" {
+		return "", fmt.Errorf("unexpected Location header %s", location)
+	}
+	return authCtxId, nil
+}
+
+func toHex(b []byte) string {
+	return strings.ToUpper(hex.EncodeToString(b))
+}
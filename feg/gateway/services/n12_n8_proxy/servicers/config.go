@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"magma/feg/gateway/sbi"
+	"magma/feg/gateway/utils"
+)
+
+const (
+	N12N8ProxyServiceName = "n12_n8_proxy"
+
+	AusfApiRootEnv        = "AUSF_API_ROOT"
+	UdmApiRootEnv         = "UDM_API_ROOT"
+	SbiTokenUrlEnv        = "N12_N8_TOKEN_URL"
+	SbiClientIdEnv        = "N12_N8_CLIENT_ID"
+	SbiClientSecretEnv    = "N12_N8_CLIENT_SECRET"
+	RequestTimeoutEnv     = "N12_N8_REQUEST_TIMEOUT"
+	DefaultAusfApiRoot    = "https://localhost/nausf-auth/v1"
+	DefaultUdmApiRoot     = "https://localhost/nudm-uecm/v1"
+	DefaultTokenUrl       = "https://localhost/oauth2/token"
+	DefaultClientId       = "magma_client_id"
+	DefaultClientSecret   = "magma_client_secret"
+	DefaultRequestTimeout = 5 * time.Second
+)
+
+// N12N8ProxyConfig holds the partner's AUSF (N12) & UDM (N8) SBI endpoints. API roots include the
+// service base path (for example https://ausf.partner.com/nausf-auth/v1)
+type N12N8ProxyConfig struct {
+	AusfConfig     sbi.RemoteConfig
+	UdmConfig      sbi.RemoteConfig
+	RequestTimeout time.Duration
+}
+
+// GetN12N8ProxyConfig returns the proxy configuration from environment variables
+func GetN12N8ProxyConfig() (*N12N8ProxyConfig, error) {
+	tokenUrl := utils.GetValueOrEnv("", SbiTokenUrlEnv, DefaultTokenUrl)
+	clientId := utils.GetValueOrEnv("", SbiClientIdEnv, DefaultClientId)
+	clientSecret := utils.GetValueOrEnv("", SbiClientSecretEnv, DefaultClientSecret)
+
+	ausfApiRoot, err := url.ParseRequestURI(utils.GetValueOrEnv("", AusfApiRootEnv, DefaultAusfApiRoot))
+	if err != nil {
+		return nil, fmt.Errorf("invalid AUSF ApiRoot - %s", err)
+	}
+	udmApiRoot, err := url.ParseRequestURI(utils.GetValueOrEnv("", UdmApiRootEnv, DefaultUdmApiRoot))
+	if err != nil {
+		return nil, fmt.Errorf("invalid UDM ApiRoot - %s", err)
+	}
+	timeout, err := time.ParseDuration(utils.GetValueOrEnv("", RequestTimeoutEnv, DefaultRequestTimeout.String()))
+	if err != nil {
+		return nil, fmt.Errorf("invalid request timeout - %s", err)
+	}
+	conf := &N12N8ProxyConfig{
+		AusfConfig: sbi.RemoteConfig{
+			ApiRoot:      *ausfApiRoot,
+			TokenUrl:     tokenUrl,
+			ClientId:     clientId,
+			ClientSecret: clientSecret,
+		},
+		UdmConfig: sbi.RemoteConfig{
+			ApiRoot:      *udmApiRoot,
+			TokenUrl:     tokenUrl,
+			ClientId:     clientId,
+			ClientSecret: clientSecret,
+		},
+		RequestTimeout: timeout,
+	}
+	err = validateN12N8ProxyConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func validateN12N8ProxyConfig(config *N12N8ProxyConfig) error {
+	_, err := url.ParseRequestURI(config.AusfConfig.TokenUrl)
+	if err != nil {
+		return fmt.Errorf("invalid AUSF TokenUrl - %s", err)
+	}
+	_, err = url.ParseRequestURI(config.UdmConfig.TokenUrl)
+	if err != nil {
+		return fmt.Errorf("invalid UDM TokenUrl - %s", err)
+	}
+	if config.RequestTimeout <= 0 {
+		return fmt.Errorf("invalid request timeout - %v", config.RequestTimeout)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servicers implements N12/N8 GRPC proxy service which relays AMF 5G authentication
+// (Nausf_UEAuthentication) & registration (Nudm_UECM) requests to the partner's AUSF & UDM over SBI
+package servicers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sbi_NudmUECM "magma/feg/gateway/sbi/specs/TS29503NudmUECM"
+	sbi_NausfUEAuthentication "magma/feg/gateway/sbi/specs/TS29509NausfUEAuthentication"
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	"magma/feg/gateway/services/n12_n8_proxy/metrics"
+)
+
+type n12N8Proxy struct {
+	config     *N12N8ProxyConfig
+	ausfClient sbi_NausfUEAuthentication.ClientWithResponsesInterface
+	udmClient  sbi_NudmUECM.ClientWithResponsesInterface
+}
+
+// NewN12N8Proxy creates N12/N8 proxy with AUSF & UDM SBI clients authorized with OAuth2 client credentials
+func NewN12N8Proxy(config *N12N8ProxyConfig) (*n12N8Proxy, error) {
+	if config == nil {
+		return nil, fmt.Errorf("N12N8ProxyConfig is nil")
+	}
+	ausfClient, err := sbi_NausfUEAuthentication.NewClientWithResponses(
+		config.AusfConfig.ApiRoot.String(),
+		sbi_NausfUEAuthentication.WithHTTPClient(config.AusfConfig.BuildHttpClient()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating AUSF client: %s", err)
+	}
+	udmClient, err := sbi_NudmUECM.NewClientWithResponses(
+		config.UdmConfig.ApiRoot.String(),
+		sbi_NudmUECM.WithHTTPClient(config.UdmConfig.BuildHttpClient()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating UDM client: %s", err)
+	}
+	return NewN12N8ProxyWithClients(config, ausfClient, udmClient), nil
+}
+
+// NewN12N8ProxyWithClients creates N12/N8 proxy using the given AUSF & UDM SBI clients
+func NewN12N8ProxyWithClients(
+	config *N12N8ProxyConfig,
+	ausfClient sbi_NausfUEAuthentication.ClientWithResponsesInterface,
+	udmClient sbi_NudmUECM.ClientWithResponsesInterface,
+) *n12N8Proxy {
+	return &n12N8Proxy{config: config, ausfClient: ausfClient, udmClient: udmClient}
+}
+
+// sbiError converts unsuccessful SBI response into gRPC error, the ProblemDetails cause is added
+// to the error message if present
+func sbiError(procedure string, resp *http.Response, body []byte) error {
+	statusCode := resp.StatusCode
+	metrics.N12N8StatusCodes.WithLabelValues(strconv.Itoa(statusCode)).Inc()
+	cause := http.StatusText(statusCode)
+	var problem sbi_CommonData.ProblemDetails
+	if json.Unmarshal(body, &problem) == nil && problem.Cause != nil {
+		cause = *problem.Cause
+	}
+	return status.Errorf(
+		httpToGrpcCode(statusCode), "%s failure: status-code=%d, cause=%s", procedure, statusCode, cause)
+}
+
+// requestError converts SBI transport error into gRPC error
+func requestError(procedure string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		metrics.N12N8Timeouts.Inc()
+		return status.Errorf(codes.DeadlineExceeded, "%s timed out: %v", procedure, err)
+	}
+	return status.Errorf(codes.Unavailable, "%s request failure: %v", procedure, err)
+}
+
+func recordStatusCode(resp *http.Response) {
+	metrics.N12N8StatusCodes.WithLabelValues(strconv.Itoa(resp.StatusCode)).Inc()
+}
+
+func httpToGrpcCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusLengthRequired, http.StatusRequestEntityTooLarge,
+		http.StatusUnsupportedMediaType:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
+}
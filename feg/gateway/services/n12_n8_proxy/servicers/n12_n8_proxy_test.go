@@ -0,0 +1,237 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"encoding/hex"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/sbi"
+	"magma/feg/gateway/services/n12_n8_proxy/servicers"
+	"magma/feg/gateway/services/testcore/ausf_udm/mock_ausf_udm"
+)
+
+const (
+	IMSI_AKA           = "001010000000001"
+	IMSI_AKA_PRIME     = "001010000000002"
+	IMSI_UNKNOWN       = "001010000000009"
+	SERVING_NETWORK    = "5G:mnc001.mcc001.3gppnetwork.org"
+	AMF_INSTANCE_ID    = "a7b1c5f2-3a5e-4f3e-9c1d-0b7a1c2d3e4f"
+	DEREG_CALLBACK_URI = "https://amf.magma.com/namf-callback/v1/deregister"
+	KEY_HEX            = "8baf473f2f8fd09487cccbd7097c6862"
+	OPC_HEX            = "8e27b6af0e692e750f32667a3b14605d"
+)
+
+func startMockAusfUdm(t *testing.T) (*mock_ausf_udm.MockAusfUdm, []byte, []byte) {
+	key, _ := hex.DecodeString(KEY_HEX)
+	opc, _ := hex.DecodeString(OPC_HEX)
+	mock := mock_ausf_udm.NewMockAusfUdm()
+	require.NoError(t, mock.Start("127.0.0.1:0"))
+	t.Cleanup(mock.Stop)
+	mock.AddSubscriber(IMSI_AKA, mock_ausf_udm.Subscriber{Key: key, Opc: opc, Sqn: 32})
+	mock.AddSubscriber(IMSI_AKA_PRIME,
+		mock_ausf_udm.Subscriber{Key: key, Opc: opc, Sqn: 64, AuthMethod: mock_ausf_udm.EapAkaPrime})
+	return mock, key, opc
+}
+
+func newTestProxy(t *testing.T, mock *mock_ausf_udm.MockAusfUdm) protos.N12N8ProxyServer {
+	remoteConfig := func(basePath string) sbi.RemoteConfig {
+		apiRoot, err := url.ParseRequestURI(mock.ApiRoot() + basePath)
+		require.NoError(t, err)
+		return sbi.RemoteConfig{
+			ApiRoot:      *apiRoot,
+			TokenUrl:     mock.ApiRoot() + mock_ausf_udm.TokenPath,
+			ClientId:     servicers.DefaultClientId,
+			ClientSecret: servicers.DefaultClientSecret,
+		}
+	}
+	proxy, err := servicers.NewN12N8Proxy(&servicers.N12N8ProxyConfig{
+		AusfConfig:     remoteConfig(mock_ausf_udm.AusfBasePath),
+		UdmConfig:      remoteConfig(mock_ausf_udm.UdmUecmBasePath),
+		RequestTimeout: 3 * time.Second,
+	})
+	require.NoError(t, err)
+	return proxy
+}
+
+func TestN12N8Proxy_5gAka(t *testing.T) {
+	mock, key, opc := startMockAusfUdm(t)
+	proxy := newTestProxy(t, mock)
+
+	authReq := &protos.UEAuthenticationRequest{SupiOrSuci: "imsi-" + IMSI_AKA, ServingNetworkName: SERVING_NETWORK}
+	authAns, err := proxy.Authenticate(context.Background(), authReq)
+	require.NoError(t, err)
+	assert.Equal(t, protos.AuthType5G_FIVE_G_AKA, authAns.GetAuthType())
+	assert.NotEmpty(t, authAns.GetAuthCtxId())
+	require.Len(t, authAns.GetRand(), 16)
+	require.Len(t, authAns.GetAutn(), 16)
+
+	// UE side: RES* must match HXRES* & K_SEAF must match the key derived by the UE
+	res, ck, ik, err := mock_ausf_udm.UeResponse(key, opc, authAns.GetRand())
+	require.NoError(t, err)
+	resStar := mock_ausf_udm.ResStar(ck, ik, SERVING_NETWORK, authAns.GetRand(), res)
+	assert.Equal(t, mock_ausf_udm.HxresStar(authAns.GetRand(), resStar), authAns.GetHxresStar())
+
+	confirmAns, err := proxy.ConfirmAuthentication(
+		context.Background(), &protos.AuthConfirmationRequest{AuthCtxId: authAns.GetAuthCtxId(), ResStar: resStar})
+	require.NoError(t, err)
+	assert.Equal(t, protos.AuthResult5G_AUTHENTICATION_SUCCESS, confirmAns.GetAuthResult())
+	assert.Equal(t, "imsi-"+IMSI_AKA, confirmAns.GetSupi())
+	kausf := mock_ausf_udm.Kausf(ck, ik, SERVING_NETWORK, authAns.GetAutn()[:6])
+	assert.Equal(t, mock_ausf_udm.Kseaf(kausf, SERVING_NETWORK), confirmAns.GetKseaf())
+
+	sqn, _ := mock.GetSqn(IMSI_AKA)
+	assert.Equal(t, uint64(33), sqn)
+
+	// authentication context is consumed by the confirmation
+	_, err = proxy.ConfirmAuthentication(
+		context.Background(), &protos.AuthConfirmationRequest{AuthCtxId: authAns.GetAuthCtxId(), ResStar: resStar})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// wrong RES*
+	authAns, err = proxy.Authenticate(context.Background(), authReq)
+	require.NoError(t, err)
+	confirmAns, err = proxy.ConfirmAuthentication(
+		context.Background(), &protos.AuthConfirmationRequest{AuthCtxId: authAns.GetAuthCtxId(), ResStar: resStar})
+	require.NoError(t, err)
+	assert.Equal(t, protos.AuthResult5G_AUTHENTICATION_FAILURE, confirmAns.GetAuthResult())
+	assert.Empty(t, confirmAns.GetKseaf())
+}
+
+func TestN12N8Proxy_EapAkaPrime(t *testing.T) {
+	mock, key, opc := startMockAusfUdm(t)
+	proxy := newTestProxy(t, mock)
+
+	authAns, err := proxy.Authenticate(context.Background(),
+		&protos.UEAuthenticationRequest{SupiOrSuci: "imsi-" + IMSI_AKA_PRIME, ServingNetworkName: SERVING_NETWORK})
+	require.NoError(t, err)
+	assert.Equal(t, protos.AuthType5G_FIVE_G_EAP_AKA_PRIME, authAns.GetAuthType())
+	require.NotEmpty(t, authAns.GetEapPayload())
+
+	eapResponse, err := mock_ausf_udm.AkaPrimeChallengeResponse(key, opc, IMSI_AKA_PRIME, authAns.GetEapPayload())
+	require.NoError(t, err)
+	eapAns, err := proxy.EapSession(context.Background(),
+		&protos.EapSessionRequest{AuthCtxId: authAns.GetAuthCtxId(), EapPayload: eapResponse})
+	require.NoError(t, err)
+	assert.Equal(t, protos.AuthResult5G_AUTHENTICATION_SUCCESS, eapAns.GetAuthResult())
+	assert.Equal(t, "imsi-"+IMSI_AKA_PRIME, eapAns.GetSupi())
+	assert.Len(t, eapAns.GetKseaf(), 32)
+	require.NotEmpty(t, eapAns.GetEapPayload())
+	assert.Equal(t, byte(3), eapAns.GetEapPayload()[0]) // EAP-Success
+
+	// EAP-Response computed with a wrong key
+	authAns, err = proxy.Authenticate(context.Background(),
+		&protos.UEAuthenticationRequest{SupiOrSuci: "imsi-" + IMSI_AKA_PRIME, ServingNetworkName: SERVING_NETWORK})
+	require.NoError(t, err)
+	_, err = mock_ausf_udm.AkaPrimeChallengeResponse(opc, key, IMSI_AKA_PRIME, authAns.GetEapPayload())
+	assert.Error(t, err)
+	eapAns, err = proxy.EapSession(context.Background(),
+		&protos.EapSessionRequest{AuthCtxId: authAns.GetAuthCtxId(), EapPayload: eapResponse})
+	require.NoError(t, err)
+	assert.Equal(t, protos.AuthResult5G_AUTHENTICATION_FAILURE, eapAns.GetAuthResult())
+	assert.Empty(t, eapAns.GetKseaf())
+	assert.Equal(t, byte(4), eapAns.GetEapPayload()[0]) // EAP-Failure
+}
+
+func TestN12N8Proxy_AuthenticationErrors(t *testing.T) {
+	mock, _, _ := startMockAusfUdm(t)
+	proxy := newTestProxy(t, mock)
+
+	_, err := proxy.Authenticate(context.Background(),
+		&protos.UEAuthenticationRequest{SupiOrSuci: "imsi-" + IMSI_UNKNOWN, ServingNetworkName: SERVING_NETWORK})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Contains(t, err.Error(), "USER_NOT_FOUND")
+
+	_, err = proxy.Authenticate(context.Background(), &protos.UEAuthenticationRequest{SupiOrSuci: "imsi-" + IMSI_AKA})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = proxy.Authenticate(context.Background(), &protos.UEAuthenticationRequest{
+		SupiOrSuci: "imsi-" + IMSI_AKA, ServingNetworkName: SERVING_NETWORK, ResyncRand: make([]byte, 16)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = proxy.ConfirmAuthentication(context.Background(), &protos.AuthConfirmationRequest{AuthCtxId: "1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = proxy.EapSession(context.Background(), &protos.EapSessionRequest{EapPayload: []byte{2, 1, 0, 4}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestN12N8Proxy_AmfRegistration(t *testing.T) {
+	mock, _, _ := startMockAusfUdm(t)
+	proxy := newTestProxy(t, mock)
+
+	guami := &protos.Guami{Mcc: "001", Mnc: "01", AmfId: "cafe00"}
+	regReq := &protos.AmfRegistrationRequest{
+		Supi:                "imsi-" + IMSI_AKA,
+		AmfInstanceId:       AMF_INSTANCE_ID,
+		Guami:               guami,
+		DeregCallbackUri:    DEREG_CALLBACK_URI,
+		Pei:                 "imei-490154203237518",
+		InitialRegistration: true,
+	}
+	regAns, err := proxy.RegisterAmf(context.Background(), regReq)
+	require.NoError(t, err)
+	assert.True(t, regAns.GetCreated())
+
+	reg, found := mock.GetAmfRegistration(IMSI_AKA)
+	require.True(t, found)
+	assert.Equal(t, AMF_INSTANCE_ID, string(reg.AmfInstanceId))
+	assert.Equal(t, DEREG_CALLBACK_URI, string(reg.DeregCallbackUri))
+	assert.Equal(t, "cafe00", string(reg.Guami.AmfId))
+	assert.Equal(t, "NR", string(reg.RatType))
+	require.NotNil(t, reg.Pei)
+	assert.Equal(t, "imei-490154203237518", string(*reg.Pei))
+
+	// re-registration replaces the existing one
+	regAns, err = proxy.RegisterAmf(context.Background(), regReq)
+	require.NoError(t, err)
+	assert.False(t, regAns.GetCreated())
+
+	// deregistration with a different GUAMI is rejected
+	_, err = proxy.DeregisterAmf(context.Background(), &protos.AmfDeregistrationRequest{
+		Supi: "imsi-" + IMSI_AKA, Guami: &protos.Guami{Mcc: "001", Mnc: "01", AmfId: "beef00"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "INVALID_GUAMI")
+
+	_, err = proxy.DeregisterAmf(context.Background(),
+		&protos.AmfDeregistrationRequest{Supi: "imsi-" + IMSI_AKA, Guami: guami})
+	require.NoError(t, err)
+	_, found = mock.GetAmfRegistration(IMSI_AKA)
+	assert.False(t, found)
+
+	_, err = proxy.DeregisterAmf(context.Background(),
+		&protos.AmfDeregistrationRequest{Supi: "imsi-" + IMSI_AKA, Guami: guami})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// unknown subscriber & invalid requests
+	regReq.Supi = "imsi-" + IMSI_UNKNOWN
+	_, err = proxy.RegisterAmf(context.Background(), regReq)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = proxy.RegisterAmf(context.Background(), &protos.AmfRegistrationRequest{
+		Supi: "imsi-" + IMSI_AKA, AmfInstanceId: AMF_INSTANCE_ID, DeregCallbackUri: DEREG_CALLBACK_URI})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = proxy.DeregisterAmf(context.Background(), &protos.AmfDeregistrationRequest{Supi: "imsi-" + IMSI_AKA})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
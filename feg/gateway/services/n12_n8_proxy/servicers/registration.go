@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/feg/cloud/go/protos"
+	sbi_NudmUECM "magma/feg/gateway/sbi/specs/TS29503NudmUECM"
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+	"magma/feg/gateway/services/n12_n8_proxy/metrics"
+)
+
+const mergePatchContentType = "application/merge-patch+json"
+
+// RegisterAmf registers the serving AMF for 3GPP access of the subscriber in UDM
+// (PUT /{ueId}/registrations/amf-3gpp-access)
+func (srv *n12N8Proxy) RegisterAmf(
+	ctx context.Context, req *protos.AmfRegistrationRequest,
+) (*protos.AmfRegistrationAnswer, error) {
+	err := validateAmfRegistrationRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	metrics.UdmRegistrationRequests.Inc()
+	res, err := srv.registerAmf(ctx, req)
+	if err != nil {
+		metrics.UdmRegistrationFailures.Inc()
+		glog.Errorf("AMF registration for %s failed: %v", req.GetSupi(), err)
+	}
+	return res, err
+}
+
+func (srv *n12N8Proxy) registerAmf(
+	ctx context.Context, req *protos.AmfRegistrationRequest,
+) (*protos.AmfRegistrationAnswer, error) {
+	ratType := sbi_CommonData.RatTypeNR
+	if len(req.GetRatType()) > 0 {
+		ratType = sbi_CommonData.RatType(req.GetRatType())
+	}
+	body := sbi_NudmUECM.TGppRegistrationJSONRequestBody{
+		AmfInstanceId:    sbi_CommonData.NfInstanceId(req.GetAmfInstanceId()),
+		DeregCallbackUri: sbi_CommonData.Uri(req.GetDeregCallbackUri()),
+		Guami:            convertGuami(req.GetGuami()),
+		RatType:          ratType,
+	}
+	if len(req.GetPei()) > 0 {
+		pei := sbi_CommonData.Pei(req.GetPei())
+		body.Pei = &pei
+	}
+	if req.GetInitialRegistration() {
+		initialRegistration := true
+		body.InitialRegistrationInd = &initialRegistration
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, srv.config.RequestTimeout)
+	defer cancel()
+	startTime := time.Now()
+	resp, err := srv.udmClient.TGppRegistrationWithResponse(reqCtx, sbi_CommonData.Supi(req.GetSupi()), body)
+	metrics.ObserveLatency(metrics.UdmLatency, startTime)
+	if err != nil {
+		return nil, requestError("AMF registration", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusCreated:
+		recordStatusCode(resp.HTTPResponse)
+		return &protos.AmfRegistrationAnswer{Created: true}, nil
+	case http.StatusOK:
+		recordStatusCode(resp.HTTPResponse)
+		return &protos.AmfRegistrationAnswer{Created: false}, nil
+	default:
+		return nil, sbiError("AMF registration", resp.HTTPResponse, resp.Body)
+	}
+}
+
+// DeregisterAmf purges the serving AMF registration of the subscriber from UDM
+// (PATCH /{ueId}/registrations/amf-3gpp-access with purgeFlag)
+func (srv *n12N8Proxy) DeregisterAmf(
+	ctx context.Context, req *protos.AmfDeregistrationRequest,
+) (*protos.AmfDeregistrationAnswer, error) {
+	if len(req.GetSupi()) == 0 || req.GetGuami() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing SUPI or GUAMI")
+	}
+	metrics.UdmDeregistrationRequests.Inc()
+	res, err := srv.deregisterAmf(ctx, req)
+	if err != nil {
+		metrics.UdmDeregistrationFailures.Inc()
+		glog.Errorf("AMF deregistration for %s failed: %v", req.GetSupi(), err)
+	}
+	return res, err
+}
+
+func (srv *n12N8Proxy) deregisterAmf(
+	ctx context.Context, req *protos.AmfDeregistrationRequest,
+) (*protos.AmfDeregistrationAnswer, error) {
+	purge := sbi_NudmUECM.PurgeFlag(true)
+	body, err := json.Marshal(sbi_NudmUECM.Amf3GppAccessRegistrationModification{
+		Guami:     convertGuami(req.GetGuami()),
+		PurgeFlag: &purge,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "AMF deregistration: %v", err)
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, srv.config.RequestTimeout)
+	defer cancel()
+	startTime := time.Now()
+	resp, err := srv.udmClient.Update3GppRegistrationWithBodyWithResponse(
+		reqCtx, sbi_CommonData.Supi(req.GetSupi()), mergePatchContentType, bytes.NewReader(body))
+	metrics.ObserveLatency(metrics.UdmLatency, startTime)
+	if err != nil {
+		return nil, requestError("AMF deregistration", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
+		return nil, sbiError("AMF deregistration", resp.HTTPResponse, resp.Body)
+	}
+	recordStatusCode(resp.HTTPResponse)
+	return &protos.AmfDeregistrationAnswer{}, nil
+}
+
+func validateAmfRegistrationRequest(req *protos.AmfRegistrationRequest) error {
+	if req == nil {
+		return fmt.Errorf("nil AmfRegistrationRequest")
+	}
+	if len(req.GetSupi()) == 0 {
+		return fmt.Errorf("missing SUPI")
+	}
+	if len(req.GetAmfInstanceId()) == 0 {
+		return fmt.Errorf("missing AMF instance ID")
+	}
+	if len(req.GetDeregCallbackUri()) == 0 {
+		return fmt.Errorf("missing deregistration callback URI")
+	}
+	guami := req.GetGuami()
+	if len(guami.GetMcc()) == 0 || len(guami.GetMnc()) == 0 || len(guami.GetAmfId()) == 0 {
+		return fmt.Errorf("missing or incomplete GUAMI: %v", guami)
+	}
+	return nil
+}
+
+func convertGuami(guami *protos.Guami) sbi_CommonData.Guami {
+	return sbi_CommonData.Guami{
+		AmfId: sbi_CommonData.AmfId(guami.GetAmfId()),
+		PlmnId: sbi_CommonData.PlmnId{
+			Mcc: sbi_CommonData.Mcc(guami.GetMcc()),
+			Mnc: sbi_CommonData.Mnc(guami.GetMnc()),
+		},
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test_init
+
+import (
+	"testing"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/n12_n8_proxy/servicers"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+// StartTestService starts N12/N8 proxy test service with the given AUSF & UDM configuration
+func StartTestService(t *testing.T, config *servicers.N12N8ProxyConfig) error {
+	srv, lis, _ := test_utils.NewTestService(t, registry.ModuleName, registry.N12_N8_PROXY)
+	service, err := servicers.NewN12N8Proxy(config)
+	if err != nil {
+		return err
+	}
+	protos.RegisterN12N8ProxyServer(srv.GrpcServer, service)
+	go srv.RunTest(lis, nil)
+	return nil
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rx provides Rx (3GPP TS 29.214) diameter definitions & conversions
+package rx
+
+import (
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+)
+
+const (
+	// RxApplicationID is the Rx diameter application ID
+	RxApplicationID = uint32(16777236)
+)
+
+// Rx AVP codes missing in go-diameter avp package, all are 3GPP vendor specific
+const (
+	AVPAbortCause                = 500
+	AVPAFApplicationIdentifier   = 504
+	AVPFlowNumber                = 509
+	AVPFlowStatus                = 511
+	AVPSpecificAction            = 513
+	AVPMaxRequestedBandwidthDL   = 515
+	AVPMediaComponentDescription = 517
+	AVPMediaComponentNumber      = 518
+	AVPMediaSubComponent         = 519
+	AVPMediaType                 = 520
+	AVPMinRequestedBandwidthDL   = 534
+	AVPMinRequestedBandwidthUL   = 535
+)
+
+// Media-Type AVP values
+const (
+	MediaTypeAudio   = uint32(0)
+	MediaTypeVideo   = uint32(1)
+	MediaTypeData    = uint32(2)
+	MediaTypeControl = uint32(4)
+	MediaTypeText    = uint32(5)
+)
+
+// Flow-Status AVP values
+const (
+	FlowStatusEnabledUplink   = uint32(0)
+	FlowStatusEnabledDownlink = uint32(1)
+	FlowStatusEnabled         = uint32(2)
+	FlowStatusDisabled        = uint32(3)
+	FlowStatusRemoved         = uint32(4)
+)
+
+// Specific-Action AVP values
+const (
+	SpecificActionLossOfBearer     = uint32(2)
+	SpecificActionRecoveryOfBearer = uint32(3)
+	SpecificActionReleaseOfBearer  = uint32(4)
+)
+
+// Subscription-Id-Type AVP values
+const (
+	EndUserE164 = uint32(0)
+	EndUserIMSI = uint32(1)
+)
+
+// SubscriptionID -> Subscription-Id AVP
+type SubscriptionID struct {
+	SubscriptionIDType uint32 `avp:"Subscription-Id-Type"`
+	SubscriptionIDData string `avp:"Subscription-Id-Data"`
+}
+
+// MediaSubComponent -> Media-Sub-Component AVP
+type MediaSubComponent struct {
+	FlowNumber              uint32                  `avp:"Flow-Number"`
+	FlowDescriptions        []datatype.IPFilterRule `avp:"Flow-Description"`
+	FlowStatus              *uint32                 `avp:"Flow-Status"`
+	FlowUsage               uint32                  `avp:"Flow-Usage"`
+	MaxRequestedBandwidthUL uint32                  `avp:"Max-Requested-Bandwidth-UL"`
+	MaxRequestedBandwidthDL uint32                  `avp:"Max-Requested-Bandwidth-DL"`
+}
+
+// MediaComponentDescription -> Media-Component-Description AVP
+type MediaComponentDescription struct {
+	MediaComponentNumber    uint32               `avp:"Media-Component-Number"`
+	MediaSubComponents      []MediaSubComponent  `avp:"Media-Sub-Component"`
+	AFApplicationIdentifier datatype.OctetString `avp:"AF-Application-Identifier"`
+	MediaType               *uint32              `avp:"Media-Type"`
+	MaxRequestedBandwidthUL uint32               `avp:"Max-Requested-Bandwidth-UL"`
+	MaxRequestedBandwidthDL uint32               `avp:"Max-Requested-Bandwidth-DL"`
+	MinRequestedBandwidthUL uint32               `avp:"Min-Requested-Bandwidth-UL"`
+	MinRequestedBandwidthDL uint32               `avp:"Min-Requested-Bandwidth-DL"`
+	FlowStatus              *uint32              `avp:"Flow-Status"`
+}
+
+// AAR is Go representation of AA-Request message
+//
+//	<AA-Request> ::= < Diameter Header: 265, REQ, PXY >
+//	< Session-Id >
+//	{ Auth-Application-Id }
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	{ Destination-Realm }
+//	[ Destination-Host ]
+//	[ AF-Application-Identifier ]
+//	*[ Media-Component-Description ]
+//	[ AF-Charging-Identifier ]
+//	*[ Specific-Action ]
+//	*[ Subscription-Id ]
+//	[ Framed-IP-Address ]
+//	[ Framed-IPv6-Prefix ]
+//	[ Service-Info-Status ]
+//	[ Rx-Request-Type ]
+//	*[ AVP ]
+type AAR struct {
+	SessionID                  string                      `avp:"Session-Id"`
+	OriginHost                 datatype.DiameterIdentity   `avp:"Origin-Host"`
+	OriginRealm                datatype.DiameterIdentity   `avp:"Origin-Realm"`
+	AFApplicationIdentifier    datatype.OctetString        `avp:"AF-Application-Identifier"`
+	MediaComponentDescriptions []MediaComponentDescription `avp:"Media-Component-Description"`
+	AFChargingIdentifier       datatype.OctetString        `avp:"AF-Charging-Identifier"`
+	SpecificActions            []uint32                    `avp:"Specific-Action"`
+	SubscriptionIDs            []SubscriptionID            `avp:"Subscription-Id"`
+	FramedIPAddress            datatype.OctetString        `avp:"Framed-IP-Address"`
+	FramedIPv6Prefix           datatype.OctetString        `avp:"Framed-IPv6-Prefix"`
+	ServiceInfoStatus          uint32                      `avp:"Service-Info-Status"`
+	RxRequestType              uint32                      `avp:"Rx-Request-Type"`
+}
+
+// ExperimentalResult -> Experimental-Result AVP
+type ExperimentalResult struct {
+	VendorID               uint32 `avp:"Vendor-Id"`
+	ExperimentalResultCode uint32 `avp:"Experimental-Result-Code"`
+}
+
+// Answer is a Go representation of Rx answers (AAA, STA, RAA & ASA) fields used by the proxy
+type Answer struct {
+	SessionID          string                    `avp:"Session-Id"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+}
+
+// STR is Go representation of Session-Termination-Request message
+//
+//	<ST-Request> ::= < Diameter Header: 275, REQ, PXY >
+//	< Session-Id >
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	{ Destination-Realm }
+//	{ Auth-Application-Id }
+//	{ Termination-Cause }
+//	[ Destination-Host ]
+//	*[ AVP ]
+type STR struct {
+	SessionID        string                    `avp:"Session-Id"`
+	OriginHost       datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm      datatype.DiameterIdentity `avp:"Origin-Realm"`
+	TerminationCause uint32                    `avp:"Termination-Cause"`
+}
+
+// RAR is Go representation of Re-Auth-Request message sent by PCRF
+//
+//	<RA-Request> ::= < Diameter Header: 258, REQ, PXY >
+//	< Session-Id >
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	{ Destination-Realm }
+//	{ Destination-Host }
+//	{ Auth-Application-Id }
+//	{ Specific-Action }
+//	[ Abort-Cause ]
+//	*[ Flows ]
+//	*[ AVP ]
+type RAR struct {
+	SessionID       string                    `avp:"Session-Id"`
+	OriginHost      datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm     datatype.DiameterIdentity `avp:"Origin-Realm"`
+	SpecificActions []uint32                  `avp:"Specific-Action"`
+	AbortCause      uint32                    `avp:"Abort-Cause"`
+}
+
+// ASR is Go representation of Abort-Session-Request message sent by PCRF
+//
+//	<AS-Request> ::= < Diameter Header: 274, REQ, PXY >
+//	< Session-Id >
+//	{ Origin-Host }
+//	{ Origin-Realm }
+//	{ Destination-Realm }
+//	{ Destination-Host }
+//	{ Auth-Application-Id }
+//	{ Abort-Cause }
+//	*[ AVP ]
+type ASR struct {
+	SessionID   string                    `avp:"Session-Id"`
+	OriginHost  datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm datatype.DiameterIdentity `avp:"Origin-Realm"`
+	AbortCause  uint32                    `avp:"Abort-Cause"`
+}
+
+// GetIMSI returns subscriber's IMSI from the AAR Subscription-Id AVPs or an empty string if not present
+func (aar *AAR) GetIMSI() string {
+	if aar == nil {
+		return ""
+	}
+	for _, sid := range aar.SubscriptionIDs {
+		if sid.SubscriptionIDType == EndUserIMSI {
+			return sid.SubscriptionIDData
+		}
+	}
+	return ""
+}
+
+// GetResultCode returns answer's Result-Code or Experimental-Result-Code if Result-Code is not set
+func (a *Answer) GetResultCode() uint32 {
+	if a == nil {
+		return 0
+	}
+	if a.ResultCode != 0 {
+		return a.ResultCode
+	}
+	return a.ExperimentalResult.ExperimentalResultCode
+}
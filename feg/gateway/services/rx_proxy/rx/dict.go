@@ -0,0 +1,213 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rx
+
+import (
+	"bytes"
+	"log"
+
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+)
+
+func init() {
+	// go-diameter doesn't provide Rx dictionary, load it into the default parser
+	if err := dict.Default.Load(bytes.NewReader([]byte(rxDictionaryXML))); err != nil {
+		log.Fatalf("failed to load Rx diameter dictionary: %v", err)
+	}
+}
+
+// rxDictionaryXML contains Rx application commands & AVPs used by the Rx proxy
+// See 3GPP TS 29.214 Section 5.3 & 5.6
+const rxDictionaryXML = `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+	<application id="16777236" type="auth" name="TGPP Rx">
+		<vendor id="10415" name="TGPP"/>
+		<command code="265" short="AA" name="AA">
+			<request>
+				<rule avp="Session-Id" required="true" max="1"/>
+				<rule avp="Auth-Application-Id" required="true" max="1"/>
+				<rule avp="Origin-Host" required="true" max="1"/>
+				<rule avp="Origin-Realm" required="true" max="1"/>
+				<rule avp="Destination-Realm" required="true" max="1"/>
+				<rule avp="Destination-Host" required="false" max="1"/>
+				<rule avp="AF-Application-Identifier" required="false" max="1"/>
+				<rule avp="Media-Component-Description" required="false"/>
+				<rule avp="AF-Charging-Identifier" required="false" max="1"/>
+				<rule avp="Specific-Action" required="false"/>
+				<rule avp="Subscription-Id" required="false"/>
+				<rule avp="Framed-IP-Address" required="false" max="1"/>
+				<rule avp="Framed-IPv6-Prefix" required="false" max="1"/>
+				<rule avp="Service-Info-Status" required="false" max="1"/>
+				<rule avp="Rx-Request-Type" required="false" max="1"/>
+			</request>
+			<answer>
+				<rule avp="Session-Id" required="true" max="1"/>
+				<rule avp="Auth-Application-Id" required="true" max="1"/>
+				<rule avp="Origin-Host" required="true" max="1"/>
+				<rule avp="Origin-Realm" required="true" max="1"/>
+				<rule avp="Result-Code" required="false" max="1"/>
+				<rule avp="Experimental-Result" required="false" max="1"/>
+			</answer>
+		</command>
+
+		<avp name="Subscription-Id" code="443" must="M" may="P" must-not="V" may-encrypt="Y">
+			<data type="Grouped">
+				<rule avp="Subscription-Id-Type" required="true" max="1"/>
+				<rule avp="Subscription-Id-Data" required="true" max="1"/>
+			</data>
+		</avp>
+		<avp name="Subscription-Id-Data" code="444" must="M" may="P" must-not="V" may-encrypt="Y">
+			<data type="UTF8String"/>
+		</avp>
+		<avp name="Subscription-Id-Type" code="450" must="M" may="P" must-not="V" may-encrypt="Y">
+			<data type="Enumerated">
+				<item code="0" name="END_USER_E164"/>
+				<item code="1" name="END_USER_IMSI"/>
+				<item code="2" name="END_USER_SIP_URI"/>
+				<item code="3" name="END_USER_NAI"/>
+				<item code="4" name="END_USER_PRIVATE"/>
+			</data>
+		</avp>
+		<avp name="Framed-IP-Address" code="8" must="M" may="P" must-not="V" may-encrypt="Y">
+			<data type="OctetString"/>
+		</avp>
+		<avp name="Framed-IPv6-Prefix" code="97" must="M" may="P" must-not="V" may-encrypt="Y">
+			<data type="OctetString"/>
+		</avp>
+		<avp name="Abort-Cause" code="500" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Enumerated">
+				<item code="0" name="BEARER_RELEASED"/>
+				<item code="1" name="INSUFFICIENT_SERVER_RESOURCES"/>
+				<item code="2" name="INSUFFICIENT_BEARER_RESOURCES"/>
+				<item code="3" name="PS_TO_CS_HANDOVER"/>
+				<item code="4" name="SPONSORED_DATA_CONNECTIVITY_DISALLOWED"/>
+			</data>
+		</avp>
+		<avp name="AF-Application-Identifier" code="504" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="OctetString"/>
+		</avp>
+		<avp name="AF-Charging-Identifier" code="505" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="OctetString"/>
+		</avp>
+		<avp name="Flow-Description" code="507" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="IPFilterRule"/>
+		</avp>
+		<avp name="Flow-Number" code="509" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Unsigned32"/>
+		</avp>
+		<avp name="Flow-Status" code="511" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Enumerated">
+				<item code="0" name="ENABLED-UPLINK"/>
+				<item code="1" name="ENABLED-DOWNLINK"/>
+				<item code="2" name="ENABLED"/>
+				<item code="3" name="DISABLED"/>
+				<item code="4" name="REMOVED"/>
+			</data>
+		</avp>
+		<avp name="Flow-Usage" code="512" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Enumerated">
+				<item code="0" name="NO_INFORMATION"/>
+				<item code="1" name="RTCP"/>
+				<item code="2" name="AF_SIGNALLING"/>
+			</data>
+		</avp>
+		<avp name="Specific-Action" code="513" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Enumerated">
+				<item code="1" name="CHARGING_CORRELATION_EXCHANGE"/>
+				<item code="2" name="INDICATION_OF_LOSS_OF_BEARER"/>
+				<item code="3" name="INDICATION_OF_RECOVERY_OF_BEARER"/>
+				<item code="4" name="INDICATION_OF_RELEASE_OF_BEARER"/>
+				<item code="6" name="IP-CAN_CHANGE"/>
+				<item code="7" name="INDICATION_OF_OUT_OF_CREDIT"/>
+				<item code="8" name="INDICATION_OF_SUCCESSFUL_RESOURCES_ALLOCATION"/>
+				<item code="9" name="INDICATION_OF_FAILED_RESOURCES_ALLOCATION"/>
+				<item code="10" name="INDICATION_OF_LIMITED_PCC_DEPLOYMENT"/>
+				<item code="12" name="ACCESS_NETWORK_INFO_REPORT"/>
+			</data>
+		</avp>
+		<avp name="Max-Requested-Bandwidth-DL" code="515" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Unsigned32"/>
+		</avp>
+		<avp name="Max-Requested-Bandwidth-UL" code="516" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Unsigned32"/>
+		</avp>
+		<avp name="Media-Component-Description" code="517" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Grouped">
+				<rule avp="Media-Component-Number" required="true" max="1"/>
+				<rule avp="Media-Sub-Component" required="false"/>
+				<rule avp="AF-Application-Identifier" required="false" max="1"/>
+				<rule avp="Media-Type" required="false" max="1"/>
+				<rule avp="Max-Requested-Bandwidth-UL" required="false" max="1"/>
+				<rule avp="Max-Requested-Bandwidth-DL" required="false" max="1"/>
+				<rule avp="Min-Requested-Bandwidth-UL" required="false" max="1"/>
+				<rule avp="Min-Requested-Bandwidth-DL" required="false" max="1"/>
+				<rule avp="Flow-Status" required="false" max="1"/>
+				<rule avp="RR-Bandwidth" required="false" max="1"/>
+				<rule avp="RS-Bandwidth" required="false" max="1"/>
+				<rule avp="Codec-Data" required="false"/>
+			</data>
+		</avp>
+		<avp name="Media-Component-Number" code="518" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Unsigned32"/>
+		</avp>
+		<avp name="Media-Sub-Component" code="519" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Grouped">
+				<rule avp="Flow-Number" required="true" max="1"/>
+				<rule avp="Flow-Description" required="false" max="2"/>
+				<rule avp="Flow-Status" required="false" max="1"/>
+				<rule avp="Flow-Usage" required="false" max="1"/>
+				<rule avp="Max-Requested-Bandwidth-UL" required="false" max="1"/>
+				<rule avp="Max-Requested-Bandwidth-DL" required="false" max="1"/>
+			</data>
+		</avp>
+		<avp name="Media-Type" code="520" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Enumerated">
+				<item code="0" name="AUDIO"/>
+				<item code="1" name="VIDEO"/>
+				<item code="2" name="DATA"/>
+				<item code="3" name="APPLICATION"/>
+				<item code="4" name="CONTROL"/>
+				<item code="5" name="TEXT"/>
+				<item code="6" name="MESSAGE"/>
+			</data>
+		</avp>
+		<avp name="RR-Bandwidth" code="521" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Unsigned32"/>
+		</avp>
+		<avp name="RS-Bandwidth" code="522" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Unsigned32"/>
+		</avp>
+		<avp name="Codec-Data" code="524" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="OctetString"/>
+		</avp>
+		<avp name="Service-Info-Status" code="527" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Enumerated">
+				<item code="0" name="FINAL_SERVICE_INFORMATION"/>
+				<item code="1" name="PRELIMINARY_SERVICE_INFORMATION"/>
+			</data>
+		</avp>
+		<avp name="Rx-Request-Type" code="533" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Enumerated">
+				<item code="0" name="INITIAL_REQUEST"/>
+				<item code="1" name="UPDATE_REQUEST"/>
+			</data>
+		</avp>
+		<avp name="Min-Requested-Bandwidth-DL" code="534" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Unsigned32"/>
+		</avp>
+		<avp name="Min-Requested-Bandwidth-UL" code="535" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+			<data type="Unsigned32"/>
+		</avp>
+	</application>
+</diameter>`
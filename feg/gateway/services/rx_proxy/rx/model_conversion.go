@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/policydb"
+	"magma/lte/cloud/go/protos"
+)
+
+const (
+	// DedicatedBearerRulePriority is the priority of rules installed for Rx media components,
+	// it must be higher (lower value) than priorities of default bearer rules
+	DedicatedBearerRulePriority = uint32(1)
+
+	// rulePrefix is the prefix of all rule IDs generated from Rx media components
+	rulePrefix = "rx-"
+
+	imsiPrefix = "IMSI"
+
+	// default ARP priority level for IMS media bearers
+	mediaArpPriorityLevel = uint32(2)
+)
+
+// GetRuleID returns a dedicated bearer rule ID unique for the given Rx session & media component number
+func GetRuleID(rxSessionID string, mediaComponentNumber uint32) string {
+	return fmt.Sprintf("%s%s-%d", rulePrefix, rxSessionID, mediaComponentNumber)
+}
+
+// GetIMSIWithPrefix returns the IMSI in the format expected by gateway's session manager
+func GetIMSIWithPrefix(imsi string) string {
+	if len(imsi) == 0 || strings.HasPrefix(imsi, imsiPrefix) {
+		return imsi
+	}
+	return imsiPrefix + imsi
+}
+
+// GetQCI returns the QCI of the dedicated bearer to be used for the media component
+func (mcd *MediaComponentDescription) GetQCI() protos.FlowQos_Qci {
+	if mcd.MediaType != nil && *mcd.MediaType == MediaTypeVideo {
+		return protos.FlowQos_QCI_2
+	}
+	// Conversational voice is the default for IMS media
+	return protos.FlowQos_QCI_1
+}
+
+// IsRemoved returns true if the PCRF/AF requested removal of the media component flows
+func (mcd *MediaComponentDescription) IsRemoved() bool {
+	return mcd.FlowStatus != nil && *mcd.FlowStatus == FlowStatusRemoved
+}
+
+// ToPolicyRule converts the media component description into a dedicated bearer dynamic rule
+func (mcd *MediaComponentDescription) ToPolicyRule(rxSessionID string) *protos.PolicyRule {
+	maxUL, maxDL := mcd.MaxRequestedBandwidthUL, mcd.MaxRequestedBandwidthDL
+	var flowList []*protos.FlowDescription
+	for _, sub := range mcd.MediaSubComponents {
+		if sub.FlowStatus != nil && *sub.FlowStatus == FlowStatusRemoved {
+			continue
+		}
+		for _, flowDescription := range sub.FlowDescriptions {
+			flow, err := policydb.GetFlowDescriptionFromFlowString(string(flowDescription))
+			if err != nil {
+				glog.Errorf("Could not get flow for Rx description %s : %s", flowDescription, err)
+				continue
+			}
+			flowList = append(flowList, flow)
+		}
+		// fall back to the sum of sub-component bandwidths if not set for the whole component
+		if mcd.MaxRequestedBandwidthUL == 0 {
+			maxUL += sub.MaxRequestedBandwidthUL
+		}
+		if mcd.MaxRequestedBandwidthDL == 0 {
+			maxDL += sub.MaxRequestedBandwidthDL
+		}
+	}
+	gbrUL, gbrDL := mcd.MinRequestedBandwidthUL, mcd.MinRequestedBandwidthDL
+	if gbrUL == 0 {
+		gbrUL = maxUL
+	}
+	if gbrDL == 0 {
+		gbrDL = maxDL
+	}
+	return &protos.PolicyRule{
+		Id:       GetRuleID(rxSessionID, mcd.MediaComponentNumber),
+		Priority: DedicatedBearerRulePriority,
+		FlowList: flowList,
+		Qos: &protos.FlowQos{
+			MaxReqBwUl: maxUL,
+			MaxReqBwDl: maxDL,
+			GbrUl:      gbrUL,
+			GbrDl:      gbrDL,
+			Qci:        mcd.GetQCI(),
+			Arp: &protos.QosArp{
+				PriorityLevel:    mediaArpPriorityLevel,
+				PreCapability:    protos.QosArp_PRE_CAP_ENABLED,
+				PreVulnerability: protos.QosArp_PRE_VUL_DISABLED,
+			},
+		},
+		TrackingType: protos.PolicyRule_NO_TRACKING,
+	}
+}
+
+// GetPolicyRules converts media component descriptions into dedicated bearer rules to install and
+// IDs of rules to remove
+func GetPolicyRules(
+	rxSessionID string, mcds []MediaComponentDescription) (toInstall []*protos.PolicyRule, toRemove []string) {
+
+	for i := range mcds {
+		mcd := &mcds[i]
+		if mcd.IsRemoved() {
+			toRemove = append(toRemove, GetRuleID(rxSessionID, mcd.MediaComponentNumber))
+			continue
+		}
+		toInstall = append(toInstall, mcd.ToPolicyRule(rxSessionID))
+	}
+	return
+}
+
+// IsBearerReleased returns true if any of the specific actions reports loss or release of the bearer
+func IsBearerReleased(specificActions []uint32) bool {
+	for _, action := range specificActions {
+		if action == SpecificActionLossOfBearer || action == SpecificActionReleaseOfBearer {
+			return true
+		}
+	}
+	return false
+}
+
+// ToAVP converts the media component description into Media-Component-Description AVP
+func (mcd *MediaComponentDescription) ToAVP() *diam.AVP {
+	avps := []*diam.AVP{
+		diam.NewAVP(AVPMediaComponentNumber, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Unsigned32(mcd.MediaComponentNumber)),
+	}
+	for _, sub := range mcd.MediaSubComponents {
+		subAVPs := []*diam.AVP{
+			diam.NewAVP(AVPFlowNumber, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(sub.FlowNumber)),
+		}
+		for _, flowDescription := range sub.FlowDescriptions {
+			subAVPs = append(subAVPs,
+				diam.NewAVP(avp.FlowDescription, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, flowDescription))
+		}
+		if sub.FlowStatus != nil {
+			subAVPs = append(subAVPs,
+				diam.NewAVP(AVPFlowStatus, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(*sub.FlowStatus)))
+		}
+		avps = append(avps, diam.NewAVP(AVPMediaSubComponent, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			&diam.GroupedAVP{AVP: subAVPs}))
+	}
+	if mcd.MediaType != nil {
+		avps = append(avps,
+			diam.NewAVP(AVPMediaType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(*mcd.MediaType)))
+	}
+	if mcd.MaxRequestedBandwidthUL > 0 {
+		avps = append(avps, diam.NewAVP(avp.MaxRequestedBandwidthUL, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Unsigned32(mcd.MaxRequestedBandwidthUL)))
+	}
+	if mcd.MaxRequestedBandwidthDL > 0 {
+		avps = append(avps, diam.NewAVP(AVPMaxRequestedBandwidthDL, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+			datatype.Unsigned32(mcd.MaxRequestedBandwidthDL)))
+	}
+	if mcd.FlowStatus != nil {
+		avps = append(avps,
+			diam.NewAVP(AVPFlowStatus, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(*mcd.FlowStatus)))
+	}
+	return diam.NewAVP(AVPMediaComponentDescription, avp.Mbit|avp.Vbit, diameter.Vendor3GPP,
+		&diam.GroupedAVP{AVP: avps})
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Magma FeG Rx Proxy Service
+// The service accepts Rx sessions from AFs (P-CSCF), relays them to PCRF and
+// installs dedicated bearer rules for authorized media on subscriber's gateway
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/rx_proxy/servicers"
+	"magma/orc8r/lib/go/service"
+)
+
+func init() {
+	flag.Parse()
+}
+
+func main() {
+	// Create the service
+	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.RX_PROXY)
+	if err != nil {
+		log.Fatalf("Error creating Rx Proxy service: %s", err)
+	}
+
+	config := servicers.GetRxProxyConfigs()
+	servicer, err := servicers.NewRxProxy(config, servicers.NewRelayBearerRuleInstaller(registry.Get()))
+	if err != nil {
+		log.Fatalf("failed to create RxProxy: %v", err)
+	}
+	lis, err := servicer.StartListener()
+	if err != nil {
+		log.Fatalf("failed to start Rx AF listener on %s: %v", config.AFServerCfg.Addr, err)
+	}
+	go func() {
+		glog.Infof("Starting Rx AF server at %s", lis.Addr().String())
+		glog.Error(servicer.Start(lis)) // blocks
+	}()
+	protos.RegisterServiceHealthServer(srv.GrpcServer, servicer)
+
+	// Run the service
+	err = srv.Run()
+	if err != nil {
+		log.Fatalf("Error running service: %s", err)
+	}
+}
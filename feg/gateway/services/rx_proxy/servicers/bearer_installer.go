@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"context"
+	"fmt"
+
+	"magma/feg/gateway/services/session_proxy/relay"
+	"magma/gateway/service_registry"
+	"magma/lte/cloud/go/protos"
+)
+
+// BearerRuleInstaller installs & removes dedicated bearer rules for subscriber's sessions
+type BearerRuleInstaller interface {
+	// UpdateRules installs given dynamic rules and removes rules with given IDs from all IMSI's sessions
+	UpdateRules(imsi string, toInstall []*protos.PolicyRule, toRemove []string) error
+}
+
+type relayBearerRuleInstaller struct {
+	cloudRegistry service_registry.GatewayRegistry
+}
+
+// NewRelayBearerRuleInstaller returns BearerRuleInstaller which relays rule updates to
+// the subscriber's gateway via feg_relay SessionProxyResponder
+func NewRelayBearerRuleInstaller(cloudRegistry service_registry.GatewayRegistry) BearerRuleInstaller {
+	return &relayBearerRuleInstaller{cloudRegistry: cloudRegistry}
+}
+
+// UpdateRules sends a PolicyReAuth request with the rule changes to the subscriber's gateway
+func (r *relayBearerRuleInstaller) UpdateRules(imsi string, toInstall []*protos.PolicyRule, toRemove []string) error {
+	if len(toInstall) == 0 && len(toRemove) == 0 {
+		return nil
+	}
+	client, err := relay.GetSessionProxyResponderClient(r.cloudRegistry)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	dynamicRules := make([]*protos.DynamicRuleInstall, 0, len(toInstall))
+	for _, rule := range toInstall {
+		dynamicRules = append(dynamicRules, &protos.DynamicRuleInstall{PolicyRule: rule})
+	}
+	// empty session ID applies the changes to all IMSI's sessions
+	ans, err := client.PolicyReAuth(context.Background(), &protos.PolicyReAuthRequest{
+		Imsi:                  imsi,
+		RulesToRemove:         toRemove,
+		DynamicRulesToInstall: dynamicRules,
+	})
+	if err != nil {
+		return fmt.Errorf("error relaying Rx bearer rules for %s to gateway: %v", imsi, err)
+	}
+	if ans.GetResult() != protos.ReAuthResult_UPDATE_INITIATED {
+		return fmt.Errorf(
+			"gateway failed to apply Rx bearer rules for %s: %s; failed rules: %v",
+			imsi, ans.GetResult(), ans.GetFailedRules())
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers
+
+import (
+	"flag"
+	"time"
+
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/rx_proxy/rx"
+)
+
+// Rx Environment Variables to overwrite default configs
+const (
+	RxAFListenAddrEnv    = "RX_AF_LISTEN_ADDR"
+	RxAFNetworkEnv       = "RX_AF_NETWORK"
+	PCRFRxAddrEnv        = "PCRF_RX_ADDR"
+	RxNetworkEnv         = "RX_NETWORK"
+	RxDiamHostEnv        = "RX_DIAM_HOST"
+	RxDiamRealmEnv       = "RX_DIAM_REALM"
+	RxDiamProductEnv     = "RX_DIAM_PRODUCT"
+	RxLocalAddrEnv       = "RX_LOCAL_ADDR"
+	PCRFRxHostEnv        = "PCRF_RX_HOST"
+	PCRFRxRealmEnv       = "PCRF_RX_REALM"
+	DisableDestHostEnv   = "DISABLE_DEST_HOST"
+	OverwriteDestHostEnv = "OVERWRITE_DEST_HOST"
+	RxSessionTTLEnv      = "RX_SESSION_TTL"
+	AFListenAddrFlag     = "af_listen_addr"
+	AFNetworkFlag        = "af_network"
+	DefaultAFListenAddr  = ":3870"
+	DefaultRxNetwork     = "tcp"
+	DefaultRxDiamRealm   = "epc.mnc070.mcc722.3gppnetwork.org"
+	DefaultRxDiamHost    = "feg-rx.epc.mnc070.mcc722.3gppnetwork.org"
+	DefaultPCRFRxAddr    = "127.0.0.1:3871"
+	RxProxyServiceName   = "rx_proxy"
+
+	// DefaultSessionTTL is the time after which Rx sessions without any AF or PCRF activity are terminated
+	DefaultSessionTTL = time.Hour * 3
+	// DefaultSessionGcInterval is how often Rx sessions are checked for expiration
+	DefaultSessionGcInterval = time.Minute * 5
+)
+
+var (
+	_ = flag.String(AFListenAddrFlag, "", "Address (host:port) to accept AF (P-CSCF) Rx connections on")
+	_ = flag.String(AFNetworkFlag, "", "AF listener protocol (sctp/tcp)")
+)
+
+// RxProxyConfig holds configuration of Rx proxy's AF facing server & PCRF facing client
+type RxProxyConfig struct {
+	// AFServerCfg is the configuration of the diameter server AFs connect to,
+	// only Addr & Protocol are used
+	AFServerCfg *diameter.DiameterServerConfig
+	// ClientCfg holds the diameter identity of the proxy
+	ClientCfg *diameter.DiameterClientConfig
+	// PCRFServerCfg is the configuration of the PCRF Rx endpoint
+	PCRFServerCfg *diameter.DiameterServerConfig
+	// SessionTTL is the time after which idle sessions are terminated, e.g. when the AF never sent STR,
+	// 0 disables expiration
+	SessionTTL time.Duration
+	// SessionGcInterval is how often sessions are checked for expiration
+	SessionGcInterval time.Duration
+}
+
+// GetRxProxyConfigs returns the Rx proxy configuration based on the input flags and environment variables
+func GetRxProxyConfigs() *RxProxyConfig {
+	return &RxProxyConfig{
+		AFServerCfg: &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+			Addr:     diameter.GetValueOrEnv(AFListenAddrFlag, RxAFListenAddrEnv, DefaultAFListenAddr),
+			Protocol: diameter.GetValueOrEnv(AFNetworkFlag, RxAFNetworkEnv, DefaultRxNetwork)},
+		},
+		ClientCfg: &diameter.DiameterClientConfig{
+			Host:             diameter.GetValueOrEnv(diameter.HostFlag, RxDiamHostEnv, DefaultRxDiamHost),
+			Realm:            diameter.GetValueOrEnv(diameter.RealmFlag, RxDiamRealmEnv, DefaultRxDiamRealm),
+			ProductName:      diameter.GetValueOrEnv(diameter.ProductFlag, RxDiamProductEnv, diameter.DiamProductName),
+			AppID:            rx.RxApplicationID,
+			WatchdogInterval: diameter.DefaultWatchdogIntervalSeconds,
+			Retransmits:      uint(3),
+			RetryCount:       uint(2),
+			RequestTimeout:   uint(diameter.DefaultRequestTimeoutSeconds),
+		},
+		PCRFServerCfg: &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+			Addr:      diameter.GetValueOrEnv(diameter.AddrFlag, PCRFRxAddrEnv, DefaultPCRFRxAddr),
+			Protocol:  diameter.GetValueOrEnv(diameter.NetworkFlag, RxNetworkEnv, DefaultRxNetwork),
+			LocalAddr: diameter.GetValueOrEnv(diameter.LocalAddrFlag, RxLocalAddrEnv, "")},
+			DestHost:          diameter.GetValueOrEnv(diameter.DestHostFlag, PCRFRxHostEnv, ""),
+			DestRealm:         diameter.GetValueOrEnv(diameter.DestRealmFlag, PCRFRxRealmEnv, ""),
+			DisableDestHost:   diameter.GetBoolValueOrEnv(diameter.DisableDestHostFlag, DisableDestHostEnv, false),
+			OverwriteDestHost: diameter.GetBoolValueOrEnv(diameter.OverwriteDestHostFlag, OverwriteDestHostEnv, false),
+		},
+		SessionTTL:        getSessionTTL(),
+		SessionGcInterval: DefaultSessionGcInterval,
+	}
+}
+
+func getSessionTTL() time.Duration {
+	ttl, err := time.ParseDuration(diameter.GetValueOrEnv("", RxSessionTTLEnv, DefaultSessionTTL.String()))
+	if err != nil {
+		glog.Errorf("invalid %s, using default Rx session TTL of %s: %v", RxSessionTTLEnv, DefaultSessionTTL, err)
+		return DefaultSessionTTL
+	}
+	return ttl
+}
@@ -0,0 +1,567 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package servicers implements Rx proxy: a Diameter B2BUA between AFs (P-CSCF) and PCRF
+// which installs dedicated bearer rules for authorized media components on the subscriber's gateway
+package servicers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/rx_proxy/rx"
+	lteprotos "magma/lte/cloud/go/protos"
+	orcprotos "magma/orc8r/lib/go/protos"
+)
+
+// hop-by-hop AVPs which must not be copied between the AF & PCRF legs
+var hopByHopAVPs = map[uint32]bool{
+	avp.OriginHost:          true,
+	avp.OriginRealm:         true,
+	avp.OriginStateID:       true,
+	avp.DestinationHost:     true,
+	avp.DestinationRealm:    true,
+	avp.RouteRecord:         true,
+	avp.OCSupportedFeatures: true,
+	avp.OCOLR:               true,
+}
+
+// Termination-Cause the proxy terminates expired sessions with, see RFC 6733 8.15
+const terminationCauseSessionTimeout = 8
+
+// rxSession holds the state of an Rx session established by an AF
+type rxSession struct {
+	imsi           string
+	afConn         diam.Conn
+	afHost         datatype.DiameterIdentity
+	afRealm        datatype.DiameterIdentity
+	installedRules map[string]struct{}
+	lastUsed       time.Time
+}
+
+// RxProxy accepts Rx sessions from AFs, relays them to PCRF & installs
+// dedicated bearer rules for authorized media components
+type RxProxy struct {
+	config     *RxProxyConfig
+	afMux      *sm.StateMachine
+	pcrfClient *diameter.Client
+	installer  BearerRuleInstaller
+
+	sessionsMu sync.Mutex
+	sessions   map[string]*rxSession
+
+	// AF answers to relayed PCRF requests, keyed by hop-by-hop ID of the relayed request
+	afAnswersMu sync.Mutex
+	afAnswers   map[uint32]chan *diam.Message
+}
+
+// NewRxProxy creates a new Rx proxy & initiates connection to PCRF
+func NewRxProxy(config *RxProxyConfig, installer BearerRuleInstaller) (*RxProxy, error) {
+	if config == nil {
+		return nil, errors.New("nil Rx Proxy config")
+	}
+	if installer == nil {
+		return nil, errors.New("nil Rx bearer rule installer")
+	}
+	if err := config.ClientCfg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := config.PCRFServerCfg.Validate(); err != nil {
+		return nil, err
+	}
+	proxy := &RxProxy{
+		config:     config,
+		pcrfClient: diameter.NewClient(config.ClientCfg, config.PCRFServerCfg.LocalAddr),
+		installer:  installer,
+		sessions:   map[string]*rxSession{},
+		afAnswers:  map[uint32]chan *diam.Message{},
+	}
+	proxy.afMux = sm.New(&sm.Settings{
+		OriginHost:       datatype.DiameterIdentity(config.ClientCfg.Host),
+		OriginRealm:      datatype.DiameterIdentity(config.ClientCfg.Realm),
+		VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
+		ProductName:      datatype.UTF8String(config.ClientCfg.ProductName),
+		OriginStateID:    datatype.Unsigned32(proxy.pcrfClient.OriginStateID()),
+		FirmwareRevision: 1,
+	})
+	// AF requests
+	proxy.afMux.HandleIdx(
+		diam.CommandIndex{AppID: rx.RxApplicationID, Code: diam.AA, Request: true},
+		diam.HandlerFunc(func(c diam.Conn, m *diam.Message) { go proxy.handleAAR(c, m) }))
+	proxy.afMux.HandleIdx(
+		diam.CommandIndex{AppID: rx.RxApplicationID, Code: diam.SessionTermination, Request: true},
+		diam.HandlerFunc(func(c diam.Conn, m *diam.Message) { go proxy.handleSTR(c, m) }))
+	// AF answers to PCRF initiated requests relayed by the proxy
+	for _, cmd := range []uint32{diam.ReAuth, diam.AbortSession} {
+		proxy.afMux.HandleIdx(
+			diam.CommandIndex{AppID: rx.RxApplicationID, Code: cmd, Request: false},
+			diam.HandlerFunc(proxy.handleAFAnswer))
+	}
+	go logErrors("AF", proxy.afMux.ErrorReports())
+
+	// PCRF answers & requests
+	for _, cmd := range []uint32{diam.AA, diam.SessionTermination} {
+		proxy.pcrfClient.RegisterAnswerHandlerForAppID(cmd, rx.RxApplicationID, pcrfAnswerHandler)
+	}
+	proxy.pcrfClient.RegisterRequestHandlerForAppID(diam.ReAuth, rx.RxApplicationID, proxy.handleRAR)
+	proxy.pcrfClient.RegisterRequestHandlerForAppID(diam.AbortSession, rx.RxApplicationID, proxy.handleASR)
+
+	if err := proxy.pcrfClient.BeginConnection(config.PCRFServerCfg); err != nil {
+		glog.Errorf("failed to establish Rx connection with PCRF at %s: %v, will retry later",
+			config.PCRFServerCfg.Addr, err)
+	}
+	if config.SessionTTL > 0 {
+		go proxy.gc(config.SessionGcInterval, config.SessionTTL)
+	}
+	return proxy, nil
+}
+
+// StartListener starts AF listener based on the AF server configuration
+func (s *RxProxy) StartListener() (net.Listener, error) {
+	return diam.Listen(s.config.AFServerCfg.Protocol, s.config.AFServerCfg.Addr)
+}
+
+// Start serves AF connections on the given listener, it blocks until the listener is closed
+func (s *RxProxy) Start(lis net.Listener) error {
+	server := &diam.Server{
+		Network: s.config.AFServerCfg.Protocol,
+		Addr:    s.config.AFServerCfg.Addr,
+		Handler: s.afMux,
+		Dict:    dict.Default,
+	}
+	return server.Serve(lis)
+}
+
+// handleAAR relays AF's AAR to PCRF and installs dedicated bearer rules for the
+// media components of successfully authorized sessions, AF gets
+// DIAMETER_UNABLE_TO_COMPLY if the rules could not be installed
+func (s *RxProxy) handleAAR(c diam.Conn, m *diam.Message) {
+	var aar rx.AAR
+	if err := m.Unmarshal(&aar); err != nil {
+		glog.Errorf("received unparseable AAR from AF %s: %v", c.RemoteAddr(), err)
+		s.sendAnswer(c, m, diam.UnableToComply, nil)
+		return
+	}
+	s.sessionsMu.Lock()
+	session, found := s.sessions[aar.SessionID]
+	s.sessionsMu.Unlock()
+
+	imsi := aar.GetIMSI()
+	if !found && len(imsi) == 0 {
+		glog.Errorf("AAR for new Rx session %s is missing subscriber's IMSI", aar.SessionID)
+		s.sendAnswer(c, m, diam.MissingAVP, nil)
+		return
+	}
+	pcrfAnswer, resultCode := s.relayToPCRF(m)
+	if pcrfAnswer == nil {
+		s.sendAnswer(c, m, resultCode, nil)
+		return
+	}
+	var answer rx.Answer
+	if err := pcrfAnswer.Unmarshal(&answer); err != nil {
+		glog.Errorf("received unparseable AAA from PCRF for session %s: %v", aar.SessionID, err)
+		s.sendAnswer(c, m, diam.UnableToComply, nil)
+		return
+	}
+	if answer.GetResultCode() == diam.Success {
+		if !found {
+			session = &rxSession{imsi: rx.GetIMSIWithPrefix(imsi), installedRules: map[string]struct{}{}}
+		}
+		s.sessionsMu.Lock()
+		session.afConn, session.afHost, session.afRealm = c, aar.OriginHost, aar.OriginRealm
+		session.lastUsed = time.Now()
+		s.sessions[aar.SessionID] = session
+		s.sessionsMu.Unlock()
+
+		toInstall, toRemove := rx.GetPolicyRules(aar.SessionID, aar.MediaComponentDescriptions)
+		if err := s.updateRules(aar.SessionID, toInstall, toRemove); err != nil {
+			// AF must not assume the media is authorized if its bearers were not set up
+			s.sendAnswer(c, m, diam.UnableToComply, nil)
+			return
+		}
+	}
+	s.sendAnswer(c, m, 0, pcrfAnswer)
+}
+
+// handleSTR relays AF's STR to PCRF and removes all dedicated bearer rules of the session
+func (s *RxProxy) handleSTR(c diam.Conn, m *diam.Message) {
+	var str rx.STR
+	if err := m.Unmarshal(&str); err != nil {
+		glog.Errorf("received unparseable STR from AF %s: %v", c.RemoteAddr(), err)
+		s.sendAnswer(c, m, diam.UnableToComply, nil)
+		return
+	}
+	s.removeSessionRules(str.SessionID)
+
+	s.sessionsMu.Lock()
+	delete(s.sessions, str.SessionID)
+	s.sessionsMu.Unlock()
+
+	pcrfAnswer, resultCode := s.relayToPCRF(m)
+	s.sendAnswer(c, m, resultCode, pcrfAnswer)
+}
+
+// handleRAR processes PCRF's Rx RAR: it (re)installs or removes session's dedicated bearer rules
+// according to the media components & specific actions of the request and relays the RAR to the AF,
+// PCRF gets the AF's answer or DIAMETER_UNABLE_TO_DELIVER if the rules or the relay failed
+func (s *RxProxy) handleRAR(c diam.Conn, m *diam.Message) {
+	var rar struct {
+		rx.RAR
+		MediaComponentDescriptions []rx.MediaComponentDescription `avp:"Media-Component-Description"`
+	}
+	if err := m.Unmarshal(&rar); err != nil {
+		glog.Errorf("received unparseable RAR from PCRF %s: %v", c.RemoteAddr(), err)
+		s.sendAnswer(c, m, diam.UnableToComply, nil)
+		return
+	}
+	session := s.findSession(rar.SessionID)
+	if session == nil {
+		s.sendAnswer(c, m, diam.UnknownSessionID, nil)
+		return
+	}
+	var err error
+	if rx.IsBearerReleased(rar.SpecificActions) {
+		err = s.removeSessionRules(rar.SessionID)
+	} else {
+		toInstall, toRemove := rx.GetPolicyRules(rar.SessionID, rar.MediaComponentDescriptions)
+		err = s.updateRules(rar.SessionID, toInstall, toRemove)
+	}
+	afAnswer := s.relayToAF(session, m)
+	if err != nil || afAnswer == nil {
+		s.sendAnswer(c, m, diam.UnableToDeliver, nil)
+		return
+	}
+	s.sendAnswer(c, m, 0, afAnswer)
+}
+
+// handleASR processes PCRF's Rx ASR: it removes all session's dedicated bearer rules and relays the ASR
+// to the AF, the session itself is removed once the AF terminates it with STR
+func (s *RxProxy) handleASR(c diam.Conn, m *diam.Message) {
+	var asr rx.ASR
+	if err := m.Unmarshal(&asr); err != nil {
+		glog.Errorf("received unparseable ASR from PCRF %s: %v", c.RemoteAddr(), err)
+		s.sendAnswer(c, m, diam.UnableToComply, nil)
+		return
+	}
+	session := s.findSession(asr.SessionID)
+	if session == nil {
+		s.sendAnswer(c, m, diam.UnknownSessionID, nil)
+		return
+	}
+	err := s.removeSessionRules(asr.SessionID)
+	afAnswer := s.relayToAF(session, m)
+	if err != nil || afAnswer == nil {
+		s.sendAnswer(c, m, diam.UnableToDeliver, nil)
+		return
+	}
+	s.sendAnswer(c, m, 0, afAnswer)
+}
+
+// relayToPCRF sends a copy of the AF request to PCRF & waits for the answer,
+// it returns nil answer & a result code to send back to the AF on failure
+func (s *RxProxy) relayToPCRF(m *diam.Message) (*diam.Message, uint32) {
+	req := diameter.NewProxiableRequest(m.Header.CommandCode, m.Header.ApplicationID, dict.Default)
+	copyAVPs(req, m)
+	done := make(chan interface{}, 1)
+	key := req.Header.HopByHopID
+	err := s.pcrfClient.SendRequest(s.config.PCRFServerCfg, done, req, key)
+	if err != nil {
+		glog.Errorf("failed to relay Rx request %d to PCRF: %v", m.Header.CommandCode, err)
+		if err == diameter.ErrOverloadThrottled {
+			return nil, diam.TooBusy
+		}
+		return nil, diam.UnableToDeliver
+	}
+	select {
+	case ans := <-done:
+		return ans.(*diam.Message), 0
+	case <-time.After(time.Duration(s.config.ClientCfg.RequestTimeout) * time.Second):
+		s.pcrfClient.IgnoreAnswer(key)
+		glog.Errorf("timed out waiting for PCRF answer to Rx request %d", m.Header.CommandCode)
+		return nil, diam.UnableToDeliver
+	}
+}
+
+// relayToAF forwards a PCRF initiated request to the AF owning the session & waits for the AF answer,
+// it returns nil if the request cannot be delivered or the AF does not answer in time
+func (s *RxProxy) relayToAF(session *rxSession, m *diam.Message) *diam.Message {
+	s.sessionsMu.Lock()
+	conn, afHost, afRealm := session.afConn, session.afHost, session.afRealm
+	session.lastUsed = time.Now()
+	s.sessionsMu.Unlock()
+	if conn == nil {
+		return nil
+	}
+	req := diameter.NewProxiableRequest(m.Header.CommandCode, m.Header.ApplicationID, dict.Default)
+	settings := s.afMux.Settings()
+	req.NewAVP(avp.OriginHost, avp.Mbit, 0, settings.OriginHost)
+	req.NewAVP(avp.OriginRealm, avp.Mbit, 0, settings.OriginRealm)
+	if meta, ok := smpeer.FromContext(conn.Context()); ok && meta != nil {
+		afHost, afRealm = meta.OriginHost, meta.OriginRealm
+	}
+	req.NewAVP(avp.DestinationRealm, avp.Mbit, 0, afRealm)
+	req.NewAVP(avp.DestinationHost, avp.Mbit, 0, afHost)
+	copyAVPs(req, m)
+
+	key := req.Header.HopByHopID
+	done := make(chan *diam.Message, 1)
+	s.afAnswersMu.Lock()
+	s.afAnswers[key] = done
+	s.afAnswersMu.Unlock()
+	defer func() {
+		s.afAnswersMu.Lock()
+		delete(s.afAnswers, key)
+		s.afAnswersMu.Unlock()
+	}()
+	if _, err := req.WriteTo(conn); err != nil {
+		glog.Errorf("failed to relay Rx request %d to AF %s: %v", m.Header.CommandCode, conn.RemoteAddr(), err)
+		return nil
+	}
+	select {
+	case ans := <-done:
+		return ans
+	case <-time.After(time.Duration(s.config.ClientCfg.RequestTimeout) * time.Second):
+		glog.Errorf("timed out waiting for AF %s answer to Rx request %d", conn.RemoteAddr(), m.Header.CommandCode)
+		return nil
+	}
+}
+
+// handleAFAnswer delivers AF's answer to the relayed PCRF request waiting for it
+func (s *RxProxy) handleAFAnswer(c diam.Conn, m *diam.Message) {
+	logAFAnswer(c, m)
+	s.afAnswersMu.Lock()
+	done, found := s.afAnswers[m.Header.HopByHopID]
+	s.afAnswersMu.Unlock()
+	if !found {
+		glog.Warningf("received late or unexpected Rx answer %d from AF %s", m.Header.CommandCode, c.RemoteAddr())
+		return
+	}
+	done <- m
+}
+
+// sendAnswer answers a peer's request either with a copy of the answer relayed from the other leg
+// or with the given result code
+func (s *RxProxy) sendAnswer(c diam.Conn, req *diam.Message, resultCode uint32, relayedAnswer *diam.Message) {
+	var ans *diam.Message
+	if relayedAnswer != nil {
+		ans = req.Answer(0)
+		ans.Header.CommandFlags |= relayedAnswer.Header.CommandFlags & diam.ErrorFlag
+	} else {
+		ans = req.Answer(resultCode)
+	}
+	s.addAnswerIdentity(ans, req)
+	if relayedAnswer != nil {
+		copyAVPs(ans, relayedAnswer)
+	}
+	if _, err := ans.WriteTo(c); err != nil {
+		glog.Errorf("failed to send Rx answer %d to %s: %v", req.Header.CommandCode, c.RemoteAddr(), err)
+	}
+}
+
+func (s *RxProxy) addAnswerIdentity(ans, req *diam.Message) {
+	if sid, err := req.FindAVP(avp.SessionID, 0); err == nil && sid != nil {
+		ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, sid.Data))
+	}
+	ans.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Host))
+	ans.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Realm))
+}
+
+// updateRules installs & removes given dedicated bearer rules of the session & tracks installed rules
+func (s *RxProxy) updateRules(sessionID string, toInstall []*lteprotos.PolicyRule, toRemove []string) error {
+	session := s.findSession(sessionID)
+	if session == nil || (len(toInstall) == 0 && len(toRemove) == 0) {
+		return nil
+	}
+	err := s.installer.UpdateRules(session.imsi, toInstall, toRemove)
+	if err != nil {
+		glog.Errorf("failed to update dedicated bearer rules of Rx session %s: %v", sessionID, err)
+		return err
+	}
+	s.sessionsMu.Lock()
+	for _, ruleID := range toRemove {
+		delete(session.installedRules, ruleID)
+	}
+	for _, rule := range toInstall {
+		session.installedRules[rule.GetId()] = struct{}{}
+	}
+	s.sessionsMu.Unlock()
+	return nil
+}
+
+// removeSessionRules removes all dedicated bearer rules installed for the session
+func (s *RxProxy) removeSessionRules(sessionID string) error {
+	s.sessionsMu.Lock()
+	session, found := s.sessions[sessionID]
+	var ruleIDs []string
+	if found {
+		for ruleID := range session.installedRules {
+			ruleIDs = append(ruleIDs, ruleID)
+		}
+	}
+	s.sessionsMu.Unlock()
+	return s.updateRules(sessionID, nil, ruleIDs)
+}
+
+// gc terminates sessions which were not used for longer than ttl, e.g. sessions the AF never sent STR for:
+// their dedicated bearer rules are removed and PCRF is sent STR on the AF's behalf
+func (s *RxProxy) gc(interval, ttl time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, sessionID := range s.expiredSessions(time.Now().Add(-ttl)) {
+			glog.Infof("terminating Rx session %s unused for more than %s", sessionID, ttl)
+			s.terminateSession(sessionID)
+		}
+	}
+}
+
+func (s *RxProxy) expiredSessions(usedBefore time.Time) []string {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	var res []string
+	for sessionID, session := range s.sessions {
+		if session.lastUsed.Before(usedBefore) {
+			res = append(res, sessionID)
+		}
+	}
+	return res
+}
+
+func (s *RxProxy) terminateSession(sessionID string) {
+	s.removeSessionRules(sessionID)
+	s.sessionsMu.Lock()
+	delete(s.sessions, sessionID)
+	s.sessionsMu.Unlock()
+
+	str := diameter.NewProxiableRequest(diam.SessionTermination, rx.RxApplicationID, dict.Default)
+	str.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	str.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(rx.RxApplicationID))
+	str.NewAVP(avp.TerminationCause, avp.Mbit, 0, datatype.Enumerated(terminationCauseSessionTimeout))
+	sta, _ := s.relayToPCRF(str)
+	if sta == nil {
+		return
+	}
+	var answer rx.Answer
+	if err := sta.Unmarshal(&answer); err == nil && answer.GetResultCode() != diam.Success {
+		glog.Warningf("PCRF answered STR of expired Rx session %s with %d", sessionID, answer.GetResultCode())
+	}
+}
+
+func (s *RxProxy) findSession(sessionID string) *rxSession {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	return s.sessions[sessionID]
+}
+
+// InstalledRules returns IDs of dedicated bearer rules currently installed for the Rx session
+func (s *RxProxy) InstalledRules(sessionID string) []string {
+	s.sessionsMu.Lock()
+	defer s.sessionsMu.Unlock()
+	session, found := s.sessions[sessionID]
+	if !found {
+		return nil
+	}
+	res := make([]string, 0, len(session.installedRules))
+	for ruleID := range session.installedRules {
+		res = append(res, ruleID)
+	}
+	return res
+}
+
+// Disable closes PCRF connection and prevents new connections for the period of time
+func (s *RxProxy) Disable(_ context.Context, req *protos.DisableMessage) (*orcprotos.Void, error) {
+	if req == nil {
+		return nil, fmt.Errorf("Nil Disable Request")
+	}
+	s.pcrfClient.DisableConnectionCreation(time.Duration(req.DisablePeriodSecs) * time.Second)
+	return &orcprotos.Void{}, nil
+}
+
+// Enable enables PCRF connection creation and gets a connection to the PCRF
+func (s *RxProxy) Enable(_ context.Context, _ *orcprotos.Void) (*orcprotos.Void, error) {
+	s.pcrfClient.EnableConnectionCreation()
+	return &orcprotos.Void{}, s.pcrfClient.BeginConnection(s.config.PCRFServerCfg)
+}
+
+// GetHealthStatus retrieves a health status object which contains the current health of the service
+func (s *RxProxy) GetHealthStatus(_ context.Context, _ *orcprotos.Void) (*protos.HealthStatus, error) {
+	msg, blocked := diameter.OverloadHealthMessage("PCRF", s.pcrfClient.ActiveOverloadReports())
+	if blocked {
+		return &protos.HealthStatus{
+			Health:        protos.HealthStatus_UNHEALTHY,
+			HealthMessage: msg,
+		}, nil
+	}
+	if len(msg) == 0 {
+		msg = "All metrics appear healthy"
+	}
+	return &protos.HealthStatus{
+		Health:        protos.HealthStatus_HEALTHY,
+		HealthMessage: msg,
+	}, nil
+}
+
+// copyAVPs copies all end-to-end AVPs of the src message into dst
+func copyAVPs(dst, src *diam.Message) {
+	sid, err := dst.FindAVP(avp.SessionID, 0)
+	hasSessionID := err == nil && sid != nil
+	for _, a := range src.AVP {
+		if a == nil || hopByHopAVPs[a.Code] {
+			continue
+		}
+		if a.Code == avp.SessionID {
+			if !hasSessionID {
+				dst.InsertAVP(a) // Session-Id must be the first AVP
+				hasSessionID = true
+			}
+			continue
+		}
+		dst.AddAVP(a)
+	}
+}
+
+// pcrfAnswerHandler returns the raw PCRF answer keyed by its hop-by-hop ID
+func pcrfAnswerHandler(m *diam.Message) diameter.KeyAndAnswer {
+	glog.V(2).Infof("received Rx answer from PCRF:\n%s", m)
+	return diameter.KeyAndAnswer{Key: m.Header.HopByHopID, Answer: m}
+}
+
+func logAFAnswer(c diam.Conn, m *diam.Message) {
+	var ans rx.Answer
+	if err := m.Unmarshal(&ans); err != nil {
+		glog.Errorf("received unparseable Rx answer %d from AF %s: %v", m.Header.CommandCode, c.RemoteAddr(), err)
+		return
+	}
+	glog.V(2).Infof("received Rx answer %d from AF for session %s; result code: %d",
+		m.Header.CommandCode, ans.SessionID, ans.GetResultCode())
+}
+
+func logErrors(peer string, ec <-chan *diam.ErrorReport) {
+	for err := range ec {
+		glog.Errorf("Rx %s transmit error: %v", peer, err)
+	}
+}
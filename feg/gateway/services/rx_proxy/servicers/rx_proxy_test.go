@@ -0,0 +1,286 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicers_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/rx_proxy/rx"
+	"magma/feg/gateway/services/rx_proxy/servicers"
+	"magma/feg/gateway/services/testcore/rx/mock_rx"
+	"magma/lte/cloud/go/protos"
+)
+
+const (
+	testIMSI      = "001010000000001"
+	testSessionID = "pcscf.ims.magma.com;1234;5678"
+	audioFlowUL   = "permit out 17 from 10.0.0.1 5000 to 192.168.128.10 6000"
+	audioFlowDL   = "permit out 17 from 192.168.128.10 6000 to 10.0.0.1 5000"
+	videoFlow     = "permit out 17 from 10.0.0.1 5002 to 192.168.128.10 6002"
+)
+
+type testRuleInstaller struct {
+	sync.Mutex
+	imsi      string
+	installed map[string]*protos.PolicyRule
+	err       error
+}
+
+func (i *testRuleInstaller) UpdateRules(imsi string, toInstall []*protos.PolicyRule, toRemove []string) error {
+	i.Lock()
+	defer i.Unlock()
+	if i.err != nil {
+		return i.err
+	}
+	i.imsi = imsi
+	for _, ruleID := range toRemove {
+		delete(i.installed, ruleID)
+	}
+	for _, rule := range toInstall {
+		i.installed[rule.GetId()] = rule
+	}
+	return nil
+}
+
+func (i *testRuleInstaller) rules() map[string]*protos.PolicyRule {
+	i.Lock()
+	defer i.Unlock()
+	res := map[string]*protos.PolicyRule{}
+	for id, rule := range i.installed {
+		res[id] = rule
+	}
+	return res
+}
+
+func audioComponent() rx.MediaComponentDescription {
+	mediaType := rx.MediaTypeAudio
+	return rx.MediaComponentDescription{
+		MediaComponentNumber: 1,
+		MediaType:            &mediaType,
+		MediaSubComponents: []rx.MediaSubComponent{{
+			FlowNumber:       1,
+			FlowDescriptions: []datatype.IPFilterRule{audioFlowUL, audioFlowDL},
+		}},
+		MaxRequestedBandwidthUL: 64000,
+		MaxRequestedBandwidthDL: 64000,
+	}
+}
+
+func videoComponent() rx.MediaComponentDescription {
+	mediaType := rx.MediaTypeVideo
+	return rx.MediaComponentDescription{
+		MediaComponentNumber: 2,
+		MediaType:            &mediaType,
+		MediaSubComponents: []rx.MediaSubComponent{{
+			FlowNumber:       1,
+			FlowDescriptions: []datatype.IPFilterRule{videoFlow},
+		}},
+		MaxRequestedBandwidthUL: 512000,
+		MaxRequestedBandwidthDL: 1024000,
+	}
+}
+
+func startRxProxy(t *testing.T, installer servicers.BearerRuleInstaller) (*servicers.RxProxy, *mock_rx.PCRF, *mock_rx.AF) {
+	return startRxProxyWithTTL(t, installer, 0)
+}
+
+func startRxProxyWithTTL(
+	t *testing.T, installer servicers.BearerRuleInstaller, sessionTTL time.Duration) (*servicers.RxProxy, *mock_rx.PCRF, *mock_rx.AF) {
+
+	pcrf := mock_rx.NewPCRF("pcrf.magma.com", "magma.com")
+	pcrfLis, err := diam.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go pcrf.Start(pcrfLis)
+
+	config := &servicers.RxProxyConfig{
+		AFServerCfg: &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+			Addr: "127.0.0.1:0", Protocol: "tcp"},
+		},
+		ClientCfg: &diameter.DiameterClientConfig{
+			Host:             "feg-rx.magma.com",
+			Realm:            "magma.com",
+			ProductName:      "magma",
+			AppID:            rx.RxApplicationID,
+			WatchdogInterval: 1,
+			Retransmits:      1,
+			RetryCount:       1,
+			RequestTimeout:   3,
+		},
+		PCRFServerCfg: &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+			Addr: pcrfLis.Addr().String(), Protocol: "tcp"},
+		},
+		SessionTTL:        sessionTTL,
+		SessionGcInterval: sessionTTL / 4,
+	}
+	proxy, err := servicers.NewRxProxy(config, installer)
+	assert.NoError(t, err)
+	afLis, err := proxy.StartListener()
+	assert.NoError(t, err)
+	go proxy.Start(afLis)
+
+	af, err := mock_rx.NewAF("pcscf.ims.magma.com", "ims.magma.com", "tcp", afLis.Addr().String())
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		af.Close()
+		afLis.Close()
+		pcrfLis.Close()
+	})
+	return proxy, pcrf, af
+}
+
+func TestRxProxy_SessionLifecycle(t *testing.T) {
+	installer := &testRuleInstaller{installed: map[string]*protos.PolicyRule{}}
+	proxy, pcrf, af := startRxProxy(t, installer)
+
+	// AAR for a new session must carry subscriber's IMSI
+	resultCode, err := af.SendAAR("pcscf.ims.magma.com;1;2", "", []rx.MediaComponentDescription{audioComponent()})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.MissingAVP), resultCode)
+
+	resultCode, err = af.SendAAR(testSessionID, testIMSI, []rx.MediaComponentDescription{audioComponent()})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	assert.Equal(t, testIMSI, pcrf.LastAAR().GetIMSI())
+	assert.Len(t, pcrf.LastAAR().MediaComponentDescriptions, 1)
+
+	audioRuleID := rx.GetRuleID(testSessionID, 1)
+	rules := installer.rules()
+	assert.Len(t, rules, 1)
+	assert.Equal(t, "IMSI"+testIMSI, installer.imsi)
+	if assert.Contains(t, rules, audioRuleID) {
+		audioRule := rules[audioRuleID]
+		assert.Equal(t, protos.FlowQos_QCI_1, audioRule.GetQos().GetQci())
+		assert.Equal(t, uint32(64000), audioRule.GetQos().GetGbrUl())
+		assert.Len(t, audioRule.GetFlowList(), 2)
+	}
+	assert.ElementsMatch(t, []string{audioRuleID}, proxy.InstalledRules(testSessionID))
+
+	// PCRF adds video media
+	resultCode, err = pcrf.ReAuth(testSessionID, nil, []rx.MediaComponentDescription{videoComponent()})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	videoRuleID := rx.GetRuleID(testSessionID, 2)
+	rules = installer.rules()
+	assert.Len(t, rules, 2)
+	if assert.Contains(t, rules, videoRuleID) {
+		assert.Equal(t, protos.FlowQos_QCI_2, rules[videoRuleID].GetQos().GetQci())
+		assert.Equal(t, uint32(1024000), rules[videoRuleID].GetQos().GetMaxReqBwDl())
+	}
+	assertRelayedToAF(t, af, diam.ReAuth)
+
+	// PCRF reports bearer release
+	resultCode, err = pcrf.ReAuth(testSessionID, []uint32{rx.SpecificActionReleaseOfBearer}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	assert.Empty(t, installer.rules())
+	assert.Empty(t, proxy.InstalledRules(testSessionID))
+	assertRelayedToAF(t, af, diam.ReAuth)
+
+	// AF restores audio, PCRF aborts the session
+	resultCode, err = af.SendAAR(testSessionID, "", []rx.MediaComponentDescription{audioComponent()})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	assert.Len(t, installer.rules(), 1)
+
+	resultCode, err = pcrf.AbortSession(testSessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	assert.Empty(t, installer.rules())
+	assertRelayedToAF(t, af, diam.AbortSession)
+
+	resultCode, err = af.SendSTR(testSessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	assert.Nil(t, proxy.InstalledRules(testSessionID))
+}
+
+func TestRxProxy_PCRFReject(t *testing.T) {
+	installer := &testRuleInstaller{installed: map[string]*protos.PolicyRule{}}
+	proxy, pcrf, af := startRxProxy(t, installer)
+
+	pcrf.SetResultCode(diam.AuthorizationRejected)
+	resultCode, err := af.SendAAR(testSessionID, testIMSI, []rx.MediaComponentDescription{audioComponent()})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.AuthorizationRejected), resultCode)
+	assert.Empty(t, installer.rules())
+	assert.Nil(t, proxy.InstalledRules(testSessionID))
+}
+
+func TestRxProxy_RuleInstallFailure(t *testing.T) {
+	installer := &testRuleInstaller{installed: map[string]*protos.PolicyRule{}, err: errors.New("sessiond is unavailable")}
+	proxy, _, af := startRxProxy(t, installer)
+
+	// AF must not get PCRF's success if dedicated bearer rules were not installed
+	resultCode, err := af.SendAAR(testSessionID, testIMSI, []rx.MediaComponentDescription{audioComponent()})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.UnableToComply), resultCode)
+	assert.Empty(t, installer.rules())
+	assert.Empty(t, proxy.InstalledRules(testSessionID))
+}
+
+func TestRxProxy_RelayAFAnswer(t *testing.T) {
+	installer := &testRuleInstaller{installed: map[string]*protos.PolicyRule{}}
+	_, pcrf, af := startRxProxy(t, installer)
+
+	resultCode, err := af.SendAAR(testSessionID, testIMSI, []rx.MediaComponentDescription{audioComponent()})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+
+	// PCRF must get the AF's Result-Code rather than the proxy's
+	af.SetResultCode(diam.UnknownSessionID)
+	resultCode, err = pcrf.ReAuth(testSessionID, nil, []rx.MediaComponentDescription{videoComponent()})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.UnknownSessionID), resultCode)
+	assertRelayedToAF(t, af, diam.ReAuth)
+
+	resultCode, err = pcrf.AbortSession(testSessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.UnknownSessionID), resultCode)
+	assertRelayedToAF(t, af, diam.AbortSession)
+}
+
+func TestRxProxy_SessionExpiration(t *testing.T) {
+	installer := &testRuleInstaller{installed: map[string]*protos.PolicyRule{}}
+	proxy, pcrf, af := startRxProxyWithTTL(t, installer, 200*time.Millisecond)
+
+	resultCode, err := af.SendAAR(testSessionID, testIMSI, []rx.MediaComponentDescription{audioComponent()})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	assert.Len(t, installer.rules(), 1)
+
+	// AF never sends STR: rules are removed & the PCRF session is terminated
+	assert.Eventually(t, func() bool { return proxy.InstalledRules(testSessionID) == nil }, 3*time.Second, 50*time.Millisecond)
+	assert.Empty(t, installer.rules())
+	assert.Eventually(t, func() bool {
+		_, err := pcrf.ReAuth(testSessionID, nil, nil)
+		return err != nil
+	}, 3*time.Second, 50*time.Millisecond)
+}
+
+func assertRelayedToAF(t *testing.T, af *mock_rx.AF, cmd uint32) {
+	select {
+	case req := <-af.Requests:
+		assert.Equal(t, cmd, req.Header.CommandCode)
+	case <-time.After(3 * time.Second):
+		assert.Fail(t, "request was not relayed to AF", "command: %d", cmd)
+	}
+}
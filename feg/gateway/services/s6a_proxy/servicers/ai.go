@@ -66,11 +66,7 @@ func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequ
 			genAuthInfoAvp(req.NumRequestedUtranGeranVectors, irp, req.UtranGeranResyncInfo))
 	}
 	glog.V(2).Infof("Sending S6a AIR message\n%s\n", m)
-	err = c.SendRequest(m, retryCount)
-	if err != nil {
-		err = Error(codes.DataLoss, err)
-	}
-	return err
+	return s.sendDiamRequest(c, m, retryCount)
 }
 
 func genAuthInfoAvp(requestedVectorNum, irp uint32, resyncInfo []byte) *diam.GroupedAVP {
@@ -97,6 +93,7 @@ func genAuthInfoAvp(requestedVectorNum, irp uint32, resyncInfo []byte) *diam.Gro
 func handleAIA(s *s6aProxy) diam.HandlerFunc {
 	return func(c diam.Conn, m *diam.Message) {
 		glog.V(2).Infof("Received S6a AIA message:\n%s\n", m)
+		s.overload.HandleAnswer(m)
 		var aia AIA
 		err := m.Unmarshal(&aia)
 		if err != nil {
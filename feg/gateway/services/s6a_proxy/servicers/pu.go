@@ -41,16 +41,13 @@ func (s *s6aProxy) sendPUR(sid string, req *protos.PurgeUERequest, retryCount ui
 	s.addDiamOriginAVPs(m)
 	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(req.UserName))
 
-	err = c.SendRequest(m, retryCount)
-	if err != nil {
-		err = Error(codes.DataLoss, err)
-	}
-	return err
+	return s.sendDiamRequest(c, m, retryCount)
 }
 
 // S6a PUA
 func handlePUA(s *s6aProxy) diam.HandlerFunc {
 	return func(c diam.Conn, m *diam.Message) {
+		s.overload.HandleAnswer(m)
 		var pua PUA
 		err := m.Unmarshal(&pua)
 		if err != nil {
@@ -65,6 +65,7 @@ type s6aProxy struct {
 	connMan        *diameter.ConnectionManager
 	requestTracker *diameter.RequestTracker
 	healthTracker  *metrics.S6aHealthTracker
+	overload       *diameter.OverloadControl
 }
 
 func NewS6aProxy(
@@ -136,6 +137,7 @@ func NewS6aProxy(
 		connMan:        connMan,
 		requestTracker: diameter.NewRequestTracker(),
 		healthTracker:  metrics.NewS6aHealthTracker(),
+		overload:       diameter.NewOverloadControl(),
 	}
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.AuthenticationInformation, Request: false},
@@ -258,12 +260,28 @@ func (s *s6aProxy) GetHealthStatus(ctx context.Context, req *orcprotos.Void) (*p
 			HealthMessage: unhealthyMsg,
 		}, nil
 	}
+	if msg, blocked := diameter.OverloadHealthMessage("HSS", s.overload.ActiveOverloadReports()); blocked {
+		return &protos.HealthStatus{
+			Health:        protos.HealthStatus_UNHEALTHY,
+			HealthMessage: msg,
+		}, nil
+	} else if len(msg) > 0 {
+		return &protos.HealthStatus{
+			Health:        protos.HealthStatus_HEALTHY,
+			HealthMessage: "All metrics appear healthy; " + msg,
+		}, nil
+	}
 	return &protos.HealthStatus{
 		Health:        protos.HealthStatus_HEALTHY,
 		HealthMessage: "All metrics appear healthy",
 	}, nil
 }
 
+// ActiveOverloadReports returns DOIC overload reports currently in effect for the HSS
+func (s *s6aProxy) ActiveOverloadReports() []diameter.OverloadReport {
+	return s.overload.ActiveOverloadReports()
+}
+
 func (s *s6aProxy) genSID() string {
 	return s.config.ClientCfg.GenSessionID("s6a")
 }
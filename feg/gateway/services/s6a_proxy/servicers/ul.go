@@ -74,11 +74,7 @@ func (s *s6aProxy) sendULR(sid string, req *protos.UpdateLocationRequest, retryC
 	}
 
 	glog.V(2).Infof("Sending S6a ULR message\n%s\n", m)
-	err = c.SendRequest(m, retryCount)
-	if err != nil {
-		err = Error(codes.DataLoss, err)
-	}
-	return err
+	return s.sendDiamRequest(c, m, retryCount)
 }
 
 // createULR_Flags creates ULR Flags based on TS 29.272
@@ -125,6 +121,7 @@ func createFeatureListID2(featureList *protos.FeatureListId2) int {
 func handleULA(s *s6aProxy) diam.HandlerFunc {
 	return func(c diam.Conn, m *diam.Message) {
 		glog.V(2).Infof("Received S6a ULA message:\n%s\n", m)
+		s.overload.HandleAnswer(m)
 		var ula ULA
 		err := m.Unmarshal(&ula)
 		if err != nil {
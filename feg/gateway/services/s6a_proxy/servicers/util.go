@@ -19,9 +19,25 @@ import (
 	"github.com/fiorix/go-diameter/v4/diam"
 	"github.com/fiorix/go-diameter/v4/diam/avp"
 	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/diameter"
 )
 
 func (s *s6aProxy) addDiamOriginAVPs(m *diam.Message) {
 	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Host))
 	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Realm))
 }
+
+// sendDiamRequest applies DOIC overload abatement to the request, advertises
+// DOIC support & sends the request over the given connection
+func (s *s6aProxy) sendDiamRequest(c *diameter.Connection, m *diam.Message, retryCount uint) error {
+	if err := s.overload.AbateRequest(c, s.config.ServerCfg); err != nil {
+		return Error(codes.Unavailable, err)
+	}
+	err := c.SendRequest(s.overload.AddSupportedFeaturesToMessage(m), retryCount)
+	if err != nil {
+		err = Error(codes.DataLoss, err)
+	}
+	return err
+}
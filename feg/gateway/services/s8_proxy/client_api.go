@@ -53,6 +53,28 @@ func DeleteSession(req *protos.DeleteSessionRequestPgw) (*protos.DeleteSessionRe
 	return cli.DeleteSession(context.Background(), req)
 }
 
+func ModifyBearer(req *protos.ModifyBearerRequestPgw) (*protos.ModifyBearerResponsePgw, error) {
+	if req == nil {
+		return nil, errors.New("Invalid ModifyBearerRequestPgw")
+	}
+	cli, err := getS8ProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.ModifyBearer(context.Background(), req)
+}
+
+func ReleaseAccessBearers(req *protos.ReleaseAccessBearersRequestPgw) (*protos.ReleaseAccessBearersResponsePgw, error) {
+	if req == nil {
+		return nil, errors.New("Invalid ReleaseAccessBearersRequestPgw")
+	}
+	cli, err := getS8ProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.ReleaseAccessBearers(context.Background(), req)
+}
+
 func SendEcho(req *protos.EchoRequest) (*protos.EchoResponse, error) {
 	if req == nil {
 		return nil, errors.New("Invalid CreateSessionRequestPgw")
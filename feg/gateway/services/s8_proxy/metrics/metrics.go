@@ -64,6 +64,30 @@ var (
 		Name: "s8_bearer_delete_failures",
 		Help: "Total number of delete bearer requests that failed.",
 	})
+	BearerModifyRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_bearer_modify_requests_total",
+		Help: "Total number of modify bearer requests.",
+	})
+	BearerModifyFails = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_bearer_modify_failures",
+		Help: "Total number of modify bearer requests that failed.",
+	})
+	BearerUpdateRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_bearer_update_requests_total",
+		Help: "Total number of update bearer requests.",
+	})
+	BearerUpdateFails = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_bearer_update_failures",
+		Help: "Total number of update bearer requests that failed.",
+	})
+	ReleaseAccessBearersRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_release_access_bearers_requests_total",
+		Help: "Total number of release access bearers requests.",
+	})
+	ReleaseAccessBearersFails = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "s8_release_access_bearers_failures",
+		Help: "Total number of release access bearers requests that failed.",
+	})
 )
 
 type S8HealthTracker struct {
@@ -86,7 +110,9 @@ type S8HealthMetrics struct {
 func init() {
 	prometheus.MustRegister(SessionCreateRequests, SessionCreateFails,
 		SessionDeleteRequests, SessionDeleteFails, BearerCreateRequests,
-		BearerCreateFails, BearerDeleteRequests, BearerDeleteFails)
+		BearerCreateFails, BearerDeleteRequests, BearerDeleteFails,
+		BearerModifyRequests, BearerModifyFails, BearerUpdateRequests,
+		BearerUpdateFails, ReleaseAccessBearersRequests, ReleaseAccessBearersFails)
 }
 
 func NewS8HealthTracker() *S8HealthTracker {
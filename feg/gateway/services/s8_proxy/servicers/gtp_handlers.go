@@ -25,10 +25,13 @@ func addS8GtpHandlers(s8p *S8Proxy) {
 	// echo hanlders added by gtp_client. Use echoChannel for errors
 	s8p.gtpClient.AddHandlers(
 		map[uint8]gtpv2.HandlerFunc{
-			message.MsgTypeCreateSessionResponse: s8p.createSessionResponseHandler(),
-			message.MsgTypeDeleteSessionResponse: s8p.deleteSessionResponseHandler(),
-			message.MsgTypeCreateBearerRequest:   s8p.createBearerRequestHandler(),
-			message.MsgTypeDeleteBearerRequest:   s8p.deleteBearerRequestHandler(),
+			message.MsgTypeCreateSessionResponse:        s8p.createSessionResponseHandler(),
+			message.MsgTypeDeleteSessionResponse:        s8p.deleteSessionResponseHandler(),
+			message.MsgTypeModifyBearerResponse:         s8p.modifyBearerResponseHandler(),
+			message.MsgTypeReleaseAccessBearersResponse: s8p.releaseAccessBearersResponseHandler(),
+			message.MsgTypeCreateBearerRequest:          s8p.createBearerRequestHandler(),
+			message.MsgTypeDeleteBearerRequest:          s8p.deleteBearerRequestHandler(),
+			message.MsgTypeUpdateBearerRequest:          s8p.updateBearerRequestHandler(),
 		})
 }
 
@@ -46,6 +49,20 @@ func (s *S8Proxy) deleteSessionResponseHandler() gtpv2.HandlerFunc {
 	}
 }
 
+func (s *S8Proxy) modifyBearerResponseHandler() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+		mbRes, err := parseModifyBearerResponse(msg)
+		return s.gtpClient.PassMessage(msg.TEID(), senderAddr, msg, mbRes, err)
+	}
+}
+
+func (s *S8Proxy) releaseAccessBearersResponseHandler() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+		rabRes, err := parseReleaseAccessBearersResponse(msg)
+		return s.gtpClient.PassMessage(msg.TEID(), senderAddr, msg, rabRes, err)
+	}
+}
+
 func (s *S8Proxy) createBearerRequestHandler() gtpv2.HandlerFunc {
 	return func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) (err error) {
 		cbReq, gtpErr, err := parseCreateBearerRequest(msg, senderAddr)
@@ -79,3 +96,20 @@ func (s *S8Proxy) deleteBearerRequestHandler() gtpv2.HandlerFunc {
 		return nil
 	}
 }
+
+func (s *S8Proxy) updateBearerRequestHandler() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) (err error) {
+		ubReq, gtpErr, err := parseUpdateBearerRequest(msg, senderAddr)
+		if err != nil {
+			return err
+		}
+		if gtpErr != nil {
+			return fmt.Errorf(gtpErr.Msg)
+		}
+		_, err = GWS8ProxyUpdateBearerRequest(ubReq)
+		if err != nil {
+			return fmt.Errorf("failed while UpdateBearerRequest to feg relay: %s", err)
+		}
+		return nil
+	}
+}
@@ -51,6 +51,18 @@ func GWS8ProxyDeleteBearerRequest(in *protos.DeleteBearerRequestPgw) (*orc8r_pro
 	return client.DeleteBearerRequest(context.Background(), in)
 }
 
+// GWS8ProxyUpdateBearerRequest forwards Update Bearer Request to FegRelay and
+// FegRelay then to AGW
+func GWS8ProxyUpdateBearerRequest(in *protos.UpdateBearerRequestPgw) (*orc8r_protos.Void, error) {
+	conn, err := getCloudConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protos.NewS8ProxyResponderClient(conn)
+	return client.UpdateBearerRequest(context.Background(), in)
+}
+
 func getCloudConn() (*grpc.ClientConn, error) {
 	conn, err := registry.Get().GetCloudConnection(feg_relay.ServiceName)
 	if err != nil {
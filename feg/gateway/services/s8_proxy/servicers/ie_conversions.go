@@ -108,6 +108,39 @@ func buildDeleteSessionRequestMsg(cPgwUDPAddr *net.UDPAddr, req *protos.DeleteSe
 	return message.NewDeleteSessionRequest(req.CPgwTeid, 0, ies...), nil
 }
 
+func buildModifyBearerRequestMsg(req *protos.ModifyBearerRequestPgw) (message.Message, error) {
+	// SGW - User plane TEID (the new one in case of handover)
+	uAgwFTeidReq := req.BearerContext.GetUserPlaneFteid()
+	uAgwFTeid := ie.NewFullyQualifiedTEID(gtpv2.IFTypeS5S8SGWGTPU,
+		uAgwFTeidReq.Teid, uAgwFTeidReq.Ipv4Address, uAgwFTeidReq.Ipv6Address).WithInstance(1)
+
+	// bearer
+	bearerId := ie.NewEPSBearerID(uint8(req.BearerContext.Id))
+	bearer := ie.NewBearerContext(bearerId, uAgwFTeid)
+
+	ies := []*ie.IE{
+		bearer,
+		getUserLocationIndication(req.ServingNetwork, req.Uli),
+		ie.NewServingNetwork(req.ServingNetwork.Mcc, req.ServingNetwork.Mnc),
+		getRatType(req.RatType),
+	}
+
+	//timezone
+	if req.TimeZone != nil {
+		offset := time.Duration(req.TimeZone.DeltaSeconds) * time.Second
+		ies = append(ies, ie.NewUETimeZone(offset, uint8(req.TimeZone.DaylightSavingTime)))
+	}
+	return message.NewModifyBearerRequest(req.CPgwTeid, 0, ies...), nil
+}
+
+func buildReleaseAccessBearersRequestMsg(req *protos.ReleaseAccessBearersRequestPgw) message.Message {
+	var ies []*ie.IE
+	for _, ebi := range req.EpsBearerId {
+		ies = append(ies, ie.NewEPSBearerID(uint8(ebi)))
+	}
+	return message.NewReleaseAccessBearersRequest(req.CPgwTeid, 0, ies...)
+}
+
 func buildCreateBearerResMsg(res *protos.CreateBearerResponsePgw) (message.Message, error) {
 	if res.Cause != uint32(gtpv2.CauseRequestAccepted) {
 		return buildCreateBearerResWithErrorCauseMsg(res.Cause, res.CPgwTeid, res.SequenceNumber), nil
@@ -174,6 +207,42 @@ func buildDeleteBearerResMsg(res *protos.DeleteBearerResponsePgw) (message.Messa
 		res.CPgwTeid, res.SequenceNumber, response...), nil
 }
 
+func buildUpdateBearerResMsg(res *protos.UpdateBearerResponsePgw) (message.Message, error) {
+	if res.Cause != uint32(gtpv2.CauseRequestAccepted) {
+		return message.NewUpdateBearerResponse(
+			res.CPgwTeid, res.SequenceNumber, ie.NewCause(uint8(res.Cause), 0, 0, 0, nil)), nil
+	}
+	if res.BearerContext == nil {
+		return nil, fmt.Errorf("UpdateBearerResponse could not be sent. Missing Bearer Contex")
+	}
+
+	// bearer
+	bearerCause := ie.NewCause(uint8(res.BearerContext.Cause), 0, 0, 0, nil)
+	if res.BearerContext.Cause == 0 {
+		bearerCause = ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil)
+	}
+	bearerId := ie.NewEPSBearerID(uint8(res.BearerContext.Id))
+	bearer := ie.NewBearerContext(bearerId, bearerCause)
+
+	ies := []*ie.IE{
+		ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+		bearer,
+		getProtocolConfigurationOptions(res.ProtocolConfigurationOptions),
+	}
+
+	// location is optional on Update Bearer Response
+	if res.ServingNetwork != nil && res.Uli != nil {
+		ies = append(ies, getUserLocationIndication(res.ServingNetwork, res.Uli))
+	}
+
+	//timezone
+	if res.TimeZone != nil {
+		offset := time.Duration(res.TimeZone.DeltaSeconds) * time.Second
+		ies = append(ies, ie.NewUETimeZone(offset, uint8(res.TimeZone.DaylightSavingTime)))
+	}
+	return message.NewUpdateBearerResponse(res.CPgwTeid, res.SequenceNumber, ies...), nil
+}
+
 func buildCreateBearerResWithErrorCauseMsg(cause uint32, cPgwTeid uint32, seq uint32) message.Message {
 	return message.NewCreateBearerResponse(
 		cPgwTeid, seq, ie.NewCause(uint8(cause), 0, 0, 0, nil))
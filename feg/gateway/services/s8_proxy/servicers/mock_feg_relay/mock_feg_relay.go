@@ -29,6 +29,7 @@ type TestS8ProxyResponderServer struct {
 	protos.S8ProxyResponderServer
 	ReceivedCreateBearerRequest *protos.CreateBearerRequestPgw
 	ReceivedDeleteBearerRequest *protos.DeleteBearerRequestPgw
+	ReceivedUpdateBearerRequest *protos.UpdateBearerRequestPgw
 	ListAddr                    string
 	Ready                       chan struct{}
 }
@@ -67,6 +68,20 @@ func (ts *TestS8ProxyResponderServer) DeleteBearerRequest(
 	return &orc8r_protos.Void{}, nil
 }
 
+func (ts *TestS8ProxyResponderServer) UpdateBearerRequest(
+	_ context.Context,
+	ubReq *protos.UpdateBearerRequestPgw) (*orc8r_protos.Void, error) {
+	defer func() {
+		// comunicate through the channel that we are done processing the call
+		ts.Ready <- struct{}{}
+	}()
+	ts.ReceivedUpdateBearerRequest = ubReq
+	if ubReq == nil || ubReq.BearerContext == nil || ubReq.CAgwTeid == 0 {
+		return nil, fmt.Errorf("mock feg_relay Update Bearer Request missing Bearer Contexct or TEID")
+	}
+	return &orc8r_protos.Void{}, nil
+}
+
 // StartFegRelayTestService starts a grpc test service
 func StartFegRelayTestService(t *testing.T) (*TestS8ProxyResponderServer, string) {
 	labels := map[string]string{}
@@ -22,43 +22,111 @@ import (
 	"github.com/wmnsk/go-gtp/gtpv2/message"
 )
 
-// TODO
 func (mPgw *MockPgw) getHandleModifyBearerRequest() gtpv2.HandlerFunc {
 	return func(c *gtpv2.Conn, sgwAddr net.Addr, msg message.Message) error {
+		fmt.Println("mock PGW received a ModifyBearerRequest")
 		session, err := c.GetSessionByTEID(msg.TEID(), sgwAddr)
 		if err != nil {
-			dsr := message.NewDeleteSessionResponse(
-				0, 0,
-				ie.NewCause(gtpv2.CauseIMSIIMEINotKnown, 0, 0, 0, nil),
+			mbr := message.NewModifyBearerResponse(
+				0, msg.Sequence(),
+				ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil),
 			)
-			if err := c.RespondTo(sgwAddr, msg, dsr); err != nil {
+			if err := c.RespondTo(sgwAddr, msg, mbr); err != nil {
 				return err
 			}
 			return err
 		}
-		bearer := session.GetDefaultBearer()
+		sgwTeidC, err := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPC)
+		if err != nil {
+			return fmt.Errorf("Error: %w", err)
+		}
+
+		mbReqFromSGW := msg.(*message.ModifyBearerRequest)
+		if uliIE := mbReqFromSGW.ULI; uliIE != nil {
+			mPgw.LastULI, err = uliIE.UserLocationInformation()
+			if err != nil {
+				return err
+			}
+		}
 
-		mbReqFromMME := msg.(*message.ModifyBearerRequest)
-		if brCtxIE := mbReqFromMME.BearerContextsToBeModified[0]; brCtxIE != nil {
-			for _, childIE := range brCtxIE.ChildIEs {
-				switch childIE.Type {
-				case ie.Indication:
-					// TODO:
-					// do nothing in this example.
-					// S-GW should change its behavior based on indication flags like;
-					//  - pass Modify Bearer Request to P-GW if handover is indicated.
-					//  - XXX...
-				case ie.FullyQualifiedTEID:
-					if err := handleFTEIDU(childIE, session, bearer); err != nil {
-						return err
-					}
+		if len(mbReqFromSGW.BearerContextsToBeModified) == 0 {
+			mbr := message.NewModifyBearerResponse(
+				sgwTeidC, msg.Sequence(),
+				ie.NewCause(gtpv2.CauseMandatoryIEMissing, 0, 0, 0, ie.NewBearerContext()),
+			)
+			return c.RespondTo(sgwAddr, msg, mbr)
+		}
+
+		// TODO: handle multiple bearers
+		bearer := session.GetDefaultBearer()
+		for _, childIE := range mbReqFromSGW.BearerContextsToBeModified[0].ChildIEs {
+			switch childIE.Type {
+			case ie.EPSBearerID:
+				ebi, err := childIE.EPSBearerID()
+				if err != nil {
+					return err
+				}
+				bearer, err = session.LookupBearerByEBI(ebi)
+				if err != nil {
+					mbr := message.NewModifyBearerResponse(
+						sgwTeidC, msg.Sequence(),
+						ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil),
+					)
+					return c.RespondTo(sgwAddr, msg, mbr)
 				}
+			case ie.FullyQualifiedTEID:
+				if err := handleFTEIDU(childIE, session, bearer); err != nil {
+					return err
+				}
+				mPgw.LastAgwTEIDu = childIE.MustTEID()
 			}
 		}
+
+		// respond to S-GW with ModifyBearerResponse.
+		mbr := message.NewModifyBearerResponse(
+			sgwTeidC, msg.Sequence(),
+			ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+			ie.NewMSISDN(session.MSISDN),
+			ie.NewBearerContext(
+				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+				ie.NewEPSBearerID(bearer.EBI),
+				ie.NewChargingID(bearer.ChargingID),
+			),
+		)
+		if err := c.RespondTo(sgwAddr, msg, mbr); err != nil {
+			return err
+		}
+		fmt.Printf("mock PGW modified a bearer for: %s\n", session.IMSI)
 		return nil
 	}
 }
 
+func (mPgw *MockPgw) getHandleReleaseAccessBearersRequest() gtpv2.HandlerFunc {
+	return func(c *gtpv2.Conn, sgwAddr net.Addr, msg message.Message) error {
+		fmt.Println("mock PGW received a ReleaseAccessBearersRequest")
+		session, err := c.GetSessionByTEID(msg.TEID(), sgwAddr)
+		if err != nil {
+			rabr := message.NewReleaseAccessBearersResponse(
+				0, msg.Sequence(),
+				ie.NewCause(gtpv2.CauseContextNotFound, 0, 0, 0, nil),
+			)
+			if err := c.RespondTo(sgwAddr, msg, rabr); err != nil {
+				return err
+			}
+			return err
+		}
+		sgwTeidC, err := session.GetTEID(gtpv2.IFTypeS5S8SGWGTPC)
+		if err != nil {
+			return fmt.Errorf("Error: %w", err)
+		}
+		rabr := message.NewReleaseAccessBearersResponse(
+			sgwTeidC, msg.Sequence(),
+			ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+		)
+		return c.RespondTo(sgwAddr, msg, rabr)
+	}
+}
+
 func handleFTEIDU(fteiduIE *ie.IE, session *gtpv2.Session, bearer *gtpv2.Bearer) error {
 	if fteiduIE.Type != ie.FullyQualifiedTEID {
 		return &gtpv2.UnexpectedIEError{IEType: fteiduIE.Type}
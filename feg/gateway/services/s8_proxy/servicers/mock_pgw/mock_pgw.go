@@ -43,6 +43,7 @@ type MockPgw struct {
 type LastValues struct {
 	LastTEIDu          uint32
 	LastTEIDc          uint32
+	LastAgwTEIDu       uint32
 	LastQos            *protos.QosInformation
 	LastULI            *ie.UserLocationInformationFields
 	LastSequenceNumber uint32
@@ -77,11 +78,13 @@ func (mPgw *MockPgw) Start(ctx context.Context, pgwAddrsStr string) error {
 
 	// register handlers for ALL the message you expect remote endpoint to send.
 	mPgw.AddHandlers(map[uint8]gtpv2.HandlerFunc{
-		message.MsgTypeCreateSessionRequest:       mPgw.getHandleCreateSessionRequest(),
-		message.MsgTypeModifyAccessBearersRequest: mPgw.getHandleModifyBearerRequest(),
-		message.MsgTypeDeleteSessionRequest:       mPgw.getHandleDeleteSessionRequest(),
-		message.MsgTypeCreateBearerResponse:       mPgw.getHandleCreateBearerResponse(),
-		message.MsgTypeDeleteBearerResponse:       mPgw.getHandleDeleteBearerResponse(),
+		message.MsgTypeCreateSessionRequest:        mPgw.getHandleCreateSessionRequest(),
+		message.MsgTypeModifyBearerRequest:         mPgw.getHandleModifyBearerRequest(),
+		message.MsgTypeReleaseAccessBearersRequest: mPgw.getHandleReleaseAccessBearersRequest(),
+		message.MsgTypeDeleteSessionRequest:        mPgw.getHandleDeleteSessionRequest(),
+		message.MsgTypeCreateBearerResponse:        mPgw.getHandleCreateBearerResponse(),
+		message.MsgTypeDeleteBearerResponse:        mPgw.getHandleDeleteBearerResponse(),
+		message.MsgTypeUpdateBearerResponse:        mPgw.getHandleUpdateBearerResponse(),
 	})
 	return nil
 }
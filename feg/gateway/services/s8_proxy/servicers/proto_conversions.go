@@ -129,6 +129,79 @@ func parseDeleteSessionResponse(msg message.Message) (
 	return dsRes, nil
 }
 
+// parseModifyBearerResponse parses a gtp message into a ModifyBearerResponsePgw. In case
+// it there is an error it returns the cause of error
+func parseModifyBearerResponse(msg message.Message) (*protos.ModifyBearerResponsePgw, error) {
+	mbResGtp := msg.(*message.ModifyBearerResponse)
+	glog.V(2).Infof("Received Modify Bearer Response (gtp):\n%s", mbResGtp.String())
+
+	mbRes := &protos.ModifyBearerResponsePgw{}
+	var err error
+	// check Cause value first.
+	if causeIE := mbResGtp.Cause; causeIE != nil {
+		mbRes.GtpError, err = handleCause(causeIE, msg)
+		if err != nil || mbRes.GtpError != nil {
+			// return either GtpError or err
+			return mbRes, err
+		}
+		// If we get here, the message will be processed
+	} else {
+		mbRes.GtpError = errorIeMissing(ie.Cause)
+		// error is in GtpError
+		return mbRes, nil
+	}
+
+	// get C AGW Teid (is the same used on Create Session Request by MME)
+	mbRes.CAgwTeid = msg.TEID()
+
+	// MSISDN optional
+	if msisdnIE := mbResGtp.MSISDN; msisdnIE != nil {
+		mbRes.Msisdn, err = msisdnIE.MSISDN()
+		if err != nil {
+			return nil, fmt.Errorf("Couldn't get MSISDN: %s ", err)
+		}
+	}
+
+	// Protocol Configuration Options (PCO) optional
+	if pgwPcoIE := mbResGtp.PCO; pgwPcoIE != nil {
+		mbRes.ProtocolConfigurationOptions, err = handlePCO(pgwPcoIE)
+		if err != nil {
+			err = fmt.Errorf("Couldn't get Protocol Configuration Options: %s ", err)
+			return nil, err
+		}
+	}
+
+	// TODO: handle more than one bearer
+	if brCtxIE := mbResGtp.BearerContextsModified; brCtxIE != nil {
+		mbRes.BearerContext, mbRes.GtpError, err = handleBearerCtx(brCtxIE)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		mbRes.GtpError = errorIeMissing(ie.BearerContext)
+	}
+	return mbRes, nil
+}
+
+// parseReleaseAccessBearersResponse parses a gtp message into a ReleaseAccessBearersResponsePgw.
+// In case it there is an error it returns the cause of error
+func parseReleaseAccessBearersResponse(msg message.Message) (*protos.ReleaseAccessBearersResponsePgw, error) {
+	rabResGtp := msg.(*message.ReleaseAccessBearersResponse)
+	glog.V(2).Infof("Received Release Access Bearers Response (gtp):\n%s", rabResGtp.String())
+
+	rabRes := &protos.ReleaseAccessBearersResponsePgw{}
+	var err error
+	if causeIE := rabResGtp.Cause; causeIE != nil {
+		rabRes.GtpError, err = handleCause(causeIE, msg)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		rabRes.GtpError = errorIeMissing(ie.Cause)
+	}
+	return rabRes, nil
+}
+
 func parseCreateBearerRequest(msg message.Message, senderAddr net.Addr) (*protos.CreateBearerRequestPgw, *protos.GtpError, error) {
 	cbReqGtp := msg.(*message.CreateBearerRequest)
 	glog.V(2).Infof("Received Create Bearer Request (gtp):\n%s", cbReqGtp.String())
@@ -217,6 +290,53 @@ func parseDeleteBearerRequest(msg message.Message, senderAddr net.Addr) (*protos
 	return dbReq, nil, nil
 }
 
+func parseUpdateBearerRequest(msg message.Message, senderAddr net.Addr) (*protos.UpdateBearerRequestPgw, *protos.GtpError, error) {
+	ubReqGtp := msg.(*message.UpdateBearerRequest)
+	glog.V(2).Infof("Received Update Bearer Request (gtp):\n%s", ubReqGtp.String())
+	ubReq := &protos.UpdateBearerRequestPgw{}
+	ubReq.PgwAddrs = senderAddr.String()
+	ubReq.SequenceNumber = ubReqGtp.SequenceNumber
+
+	// cgw control plane teid
+	if !ubReqGtp.HasTEID() {
+		return nil, errorIeMissing(ie.FullyQualifiedTEID), nil
+	}
+	ubReq.CAgwTeid = ubReqGtp.TEID()
+
+	// TODO: handle more than one bearer
+	if brCtxIE := ubReqGtp.BearerContexts; brCtxIE != nil {
+		bearerContext, gtpError, err := handleBearerCtx(brCtxIE)
+		if err != nil || gtpError != nil {
+			return nil, gtpError, err
+		}
+		ubReq.BearerContext = bearerContext
+	} else {
+		return nil, errorIeMissing(ie.BearerContext), nil
+	}
+
+	if ambrIE := ubReqGtp.APNAMBR; ambrIE != nil {
+		ambr, err := ambrIE.AggregateMaximumBitRate()
+		if err != nil {
+			return nil, nil, fmt.Errorf("parseUpdateBearerRequest Couldn't get APN AMBR: %s ", err)
+		}
+		ubReq.ApnAmbr = &protos.Ambr{BrUl: uint64(ambr.APNAMBRForUplink), BrDl: uint64(ambr.APNAMBRForDownlink)}
+	} else {
+		return nil, errorIeMissing(ie.AggregateMaximumBitRate), nil
+	}
+
+	// Protocol Configuration Options (PCO) optional
+	if pgwPcoIE := ubReqGtp.PCO; pgwPcoIE != nil {
+		pco, err := handlePCO(pgwPcoIE)
+		if err != nil {
+			err = fmt.Errorf("parseUpdateBearerRequest Couldn't get Protocol Configuration Options: %s ", err)
+			return nil, nil, err
+		}
+		ubReq.ProtocolConfigurationOptions = pco
+	}
+
+	return ubReq, nil, nil
+}
+
 func handleCause(causeIE *ie.IE, msg message.Message) (*protos.GtpError, error) {
 	cause, err := causeIE.Cause()
 	if err != nil {
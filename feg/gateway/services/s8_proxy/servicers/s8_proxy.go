@@ -145,6 +145,68 @@ func (s *S8Proxy) DeleteSession(ctx context.Context, req *protos.DeleteSessionRe
 	return cdRes, nil
 }
 
+func (s *S8Proxy) ModifyBearer(_ context.Context, req *protos.ModifyBearerRequestPgw) (*protos.ModifyBearerResponsePgw, error) {
+	metrics.BearerModifyRequests.Inc()
+	err := validateModifyBearerRequest(req)
+	if err != nil {
+		metrics.BearerModifyFails.Inc()
+		err = fmt.Errorf("Modify Bearer failed for IMSI %s:, couldn't validate request: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	cPgwUDPAddr, err := s.configOrRequestedPgwAddress(req.PgwAddrs)
+	if err != nil {
+		metrics.BearerModifyFails.Inc()
+		err = fmt.Errorf("Modify Bearer failed for IMSI %s: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	mbReqMsg, err := buildModifyBearerRequestMsg(req)
+	if err != nil {
+		metrics.BearerModifyFails.Inc()
+		err = fmt.Errorf("Modify Bearer failed to build IEs for IMSI %s: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+
+	mbRes, err := s.sendAndReceiveModifyBearer(req, cPgwUDPAddr, mbReqMsg)
+	if err != nil {
+		metrics.BearerModifyFails.Inc()
+		err = fmt.Errorf("Modify Bearer failed for IMSI %s:, %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	return mbRes, nil
+}
+
+func (s *S8Proxy) ReleaseAccessBearers(
+	_ context.Context, req *protos.ReleaseAccessBearersRequestPgw) (*protos.ReleaseAccessBearersResponsePgw, error) {
+	metrics.ReleaseAccessBearersRequests.Inc()
+	if req.Imsi == "" {
+		metrics.ReleaseAccessBearersFails.Inc()
+		err := fmt.Errorf("Release Access Bearers failed: missing IMSI %+v", req)
+		glog.Error(err)
+		return nil, err
+	}
+	cPgwUDPAddr, err := s.configOrRequestedPgwAddress(req.PgwAddrs)
+	if err != nil {
+		metrics.ReleaseAccessBearersFails.Inc()
+		err = fmt.Errorf("Release Access Bearers failed for IMSI %s: %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	rabReqMsg := buildReleaseAccessBearersRequestMsg(req)
+
+	rabRes, err := s.sendAndReceiveReleaseAccessBearers(req, cPgwUDPAddr, rabReqMsg)
+	if err != nil {
+		metrics.ReleaseAccessBearersFails.Inc()
+		err = fmt.Errorf("Release Access Bearers failed for IMSI %s:, %s", req.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+	return rabRes, nil
+}
+
 func (s *S8Proxy) SendEcho(_ context.Context, req *protos.EchoRequest) (*protos.EchoResponse, error) {
 	cPgwUDPAddr, err := s.configOrRequestedPgwAddress(req.PgwAddrs)
 	if err != nil {
@@ -213,6 +275,33 @@ func (s *S8Proxy) DeleteBearerResponse(_ context.Context, res *protos.DeleteBear
 	return &orc8r_protos.Void{}, nil
 }
 
+func (s *S8Proxy) UpdateBearerResponse(_ context.Context, res *protos.UpdateBearerResponsePgw) (*orc8r_protos.Void, error) {
+	metrics.BearerUpdateRequests.Inc()
+	cPgwUDPAddr := ParseAddress(res.PgwAddrs)
+	if cPgwUDPAddr == nil {
+		metrics.BearerUpdateFails.Inc()
+		err := fmt.Errorf("UpdateBearerResponse to %s failed: couldnt parse address", res.PgwAddrs)
+		glog.Error(err)
+		return nil, err
+	}
+
+	ubResMsg, err := buildUpdateBearerResMsg(res)
+	if err != nil {
+		metrics.BearerUpdateFails.Inc()
+		return nil, err
+	}
+
+	_, err = s.sendAndReceiveUpdateBearerResponse(res, cPgwUDPAddr, ubResMsg)
+	if err != nil {
+		metrics.BearerUpdateFails.Inc()
+		err = fmt.Errorf("Update Bearer Response failed for IMSI %s:, %s", res.Imsi, err)
+		glog.Error(err)
+		return nil, err
+	}
+
+	return &orc8r_protos.Void{}, nil
+}
+
 // configOrRequestedPgwAddress returns an UDPAddrs if the passed string corresponds to a valid ip,
 // otherwise it uses the server address configured on s8_proxy
 func (s *S8Proxy) configOrRequestedPgwAddress(pgwAddrsFromRequest string) (*net.UDPAddr, error) {
@@ -242,6 +331,14 @@ func validateDeleteSessionRequest(dsr *protos.DeleteSessionRequestPgw) error {
 	return nil
 }
 
+func validateModifyBearerRequest(mbr *protos.ModifyBearerRequestPgw) error {
+	if mbr.Imsi == "" || mbr.BearerContext == nil || mbr.BearerContext.UserPlaneFteid == nil ||
+		mbr.BearerContext.Id == 0 || mbr.Uli == nil || mbr.ServingNetwork == nil {
+		return fmt.Errorf("ModifyBearerRequest missing fields %+v", mbr)
+	}
+	return nil
+}
+
 func (s *S8Proxy) Disable(ctx context.Context, req *protos.DisableMessage) (*orc8r_protos.Void, error) {
 	return &orc8r_protos.Void{}, nil
 }
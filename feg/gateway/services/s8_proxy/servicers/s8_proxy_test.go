@@ -644,7 +644,7 @@ func TestS8proxyCreateSessionNoProtocolConfigurationOptions(t *testing.T) {
 	assert.Nil(t, csRes.ProtocolConfigurationOptions)
 }
 
-func TestCreateAndDeleteBearerRequest(t *testing.T) {
+func TestCreateUpdateAndDeleteBearerRequest(t *testing.T) {
 	// set up client ans server
 	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
 	defer mockPgw.Close()
@@ -825,6 +825,69 @@ func TestCreateAndDeleteBearerRequest(t *testing.T) {
 		}
 	}
 
+	// ------------------------
+	// ---- Update Bearer ----
+	pgwUpdateBearerRequest := mock_pgw.UpdateBearerRequest{
+		Imsi:        IMSI1,
+		EpsBearerId: BEARER,
+		QosQCI:      8,
+		AmbrUl:      2000,
+		AmbrDl:      4000,
+	}
+	outUBR, err := mockPgw.UpdateBearerRequest(pgwUpdateBearerRequest)
+	require.NoError(t, err)
+
+	// wait for mock feg_relay to process the request
+	<-fegRelayTestSrv.Ready
+
+	ubReqReceived := fegRelayTestSrv.ReceivedUpdateBearerRequest
+	require.NotEmpty(t, ubReqReceived)
+	assert.Equal(t, AGWTeidC, ubReqReceived.CAgwTeid)
+	assert.Equal(t, mockPgw.LastSequenceNumber, ubReqReceived.SequenceNumber)
+	require.NotNil(t, ubReqReceived.BearerContext)
+	assert.Equal(t, uint32(BEARER), ubReqReceived.BearerContext.Id)
+	require.NotNil(t, ubReqReceived.BearerContext.Qos)
+	assert.Equal(t, uint32(pgwUpdateBearerRequest.QosQCI), ubReqReceived.BearerContext.Qos.Qci)
+	require.NotNil(t, ubReqReceived.ApnAmbr)
+	assert.Equal(t, uint64(pgwUpdateBearerRequest.AmbrUl), ubReqReceived.ApnAmbr.BrUl)
+	assert.Equal(t, uint64(pgwUpdateBearerRequest.AmbrDl), ubReqReceived.ApnAmbr.BrDl)
+
+	// send the response from agw to feg
+	ubResGrpc := &protos.UpdateBearerResponsePgw{
+		PgwAddrs:       ubReqReceived.PgwAddrs,
+		Imsi:           IMSI1,
+		SequenceNumber: ubReqReceived.SequenceNumber,
+		CPgwTeid:       PgwTEIDc,
+		Cause:          uint32(gtpv2.CauseRequestAccepted),
+		BearerContext: &protos.BearerContext{
+			Id:    BEARER,
+			Cause: uint32(gtpv2.CauseRequestAccepted),
+		},
+		ServingNetwork: csReq.ServingNetwork,
+		Uli:            csReq.Uli,
+		TimeZone:       csReq.TimeZone,
+	}
+	_, err = s8p.UpdateBearerResponse(context.Background(), ubResGrpc)
+	require.NoError(t, err)
+
+	// wait for the answer to be sent from agw to feg
+	ubResFromChan := <-outUBR
+	require.NoError(t, ubResFromChan.Err)
+	ubResGtp := ubResFromChan.Res
+	require.NotEmpty(t, ubResGtp)
+	assert.Equal(t, ubReqReceived.SequenceNumber, ubResGtp.SequenceNumber)
+	assert.Equal(t, PgwTEIDc, ubResGtp.TEID())
+	assert.Equal(t, gtpv2.CauseRequestAccepted, ubResGtp.Cause.MustCause())
+	require.NotEmpty(t, ubResGtp.BearerContexts)
+	for _, childIE := range ubResGtp.BearerContexts[0].ChildIEs {
+		switch childIE.Type {
+		case ie.EPSBearerID:
+			assert.Equal(t, uint8(BEARER), childIE.MustEPSBearerID())
+		case ie.Cause:
+			assert.Equal(t, gtpv2.CauseRequestAccepted, childIE.MustCause())
+		}
+	}
+
 	// ------------------------
 	// ---- Delete Bearer ----
 	outDBR, err := mockPgw.DeleteBearerRequest(mock_pgw.DeleteBearerRequest{Imsi: IMSI1, EpsBearerId: DEDICATEDBEARER_1})
@@ -868,6 +931,76 @@ func TestCreateAndDeleteBearerRequest(t *testing.T) {
 	assert.Equal(t, uint8(DEDICATEDBEARER_1), dbResGtp.BearerContexts[0].MustEPSBearerID())
 }
 
+func TestS8proxyModifyBearerAndReleaseAccessBearers(t *testing.T) {
+	s8p, mockPgw := startSgwAndPgw(t, GtpTimeoutForTest)
+	defer mockPgw.Close()
+
+	// ------------------------
+	// ---- Create Session ----
+	csReq := getDefaultCreateSessionRequest(mockPgw.LocalAddr().String())
+	csRes, err := s8p.CreateSession(context.Background(), csReq)
+	require.NoError(t, err)
+	require.Nil(t, csRes.GtpError)
+
+	// ------------------------
+	// ---- Modify Bearer ----
+	newAGWTeidU := uint32(1234)
+	mbReq := &protos.ModifyBearerRequestPgw{
+		PgwAddrs: mockPgw.LocalAddr().String(),
+		Imsi:     IMSI1,
+		CAgwTeid: AGWTeidC,
+		CPgwTeid: csRes.CPgwFteid.Teid,
+		BearerContext: &protos.BearerContext{
+			Id: BEARER,
+			UserPlaneFteid: &protos.Fteid{
+				Ipv4Address: DedicatedAGWuIP,
+				Teid:        newAGWTeidU,
+			},
+		},
+		ServingNetwork: csReq.ServingNetwork,
+		Uli:            &protos.UserLocationInformation{Tac: 15, Eci: 16},
+		RatType:        protos.RATType_EUTRAN,
+		TimeZone:       csReq.TimeZone,
+	}
+	mbRes, err := s8p.ModifyBearer(context.Background(), mbReq)
+	require.NoError(t, err)
+	require.Nil(t, mbRes.GtpError)
+	assert.Equal(t, AGWTeidC, mbRes.CAgwTeid)
+	assert.Equal(t, csReq.Msisdn, mbRes.Msisdn)
+	require.NotNil(t, mbRes.BearerContext)
+	assert.Equal(t, uint32(BEARER), mbRes.BearerContext.Id)
+
+	// check PGW received the new user plane TEID and location
+	assert.Equal(t, newAGWTeidU, mockPgw.LastAgwTEIDu)
+	require.NotNil(t, mockPgw.LastULI)
+	assert.Equal(t, mbReq.Uli.Tac, uint32(mockPgw.LastULI.TAI.TAC))
+	assert.Equal(t, mbReq.Uli.Eci, mockPgw.LastULI.ECGI.ECI)
+
+	// Modify Bearer for an unknown bearer
+	mbReq.BearerContext.Id = DEDICATEDBEARER_2
+	mbRes, err = s8p.ModifyBearer(context.Background(), mbReq)
+	require.NoError(t, err)
+	require.NotNil(t, mbRes.GtpError)
+	assert.Equal(t, uint32(gtpv2.CauseContextNotFound), mbRes.GtpError.Cause)
+
+	// Modify Bearer with missing parameters
+	_, err = s8p.ModifyBearer(context.Background(), &protos.ModifyBearerRequestPgw{Imsi: IMSI1})
+	assert.Error(t, err)
+
+	// ------------------------
+	// ---- Release Access Bearers ----
+	rabReq := &protos.ReleaseAccessBearersRequestPgw{
+		PgwAddrs: mockPgw.LocalAddr().String(),
+		Imsi:     IMSI1,
+		CAgwTeid: AGWTeidC,
+		CPgwTeid: csRes.CPgwFteid.Teid,
+	}
+	rabRes, err := s8p.ReleaseAccessBearers(context.Background(), rabReq)
+	require.NoError(t, err)
+	assert.Nil(t, rabRes.GtpError)
+
+}
+
 func TestS8proxyEcho(t *testing.T) {
 	s8p, mockPgw := startSgwAndPgw(t, 3*time.Second)
 	defer mockPgw.Close()
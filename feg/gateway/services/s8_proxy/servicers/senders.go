@@ -81,6 +81,50 @@ func (s *S8Proxy) sendAndReceiveDeleteSession(req *protos.DeleteSessionRequestPg
 	return dsRes, err
 }
 
+// sendAndReceiveModifyBearer sends modify bearer request GTP-C message to PGW and
+// waits for its answers.
+// Returns a GRPC message translated from the GTP-C modify bearer response
+func (s *S8Proxy) sendAndReceiveModifyBearer(req *protos.ModifyBearerRequestPgw,
+	cPgwUDPAddr *net.UDPAddr,
+	mbReqMsg message.Message) (*protos.ModifyBearerResponsePgw, error) {
+	glog.V(2).Infof("Send Modify Bearer Request (grpc) to %s:\n%s", cPgwUDPAddr,
+		req)
+	glog.V(2).Infof("Send Modify Bearer Request (gtp) to %s:\n%s",
+		cPgwUDPAddr.String(), mbReqMsg.(*message.ModifyBearerRequest).String())
+	grpcMessage, err := s.gtpClient.SendMessageAndExtractGrpc(req.Imsi, req.CAgwTeid, cPgwUDPAddr, mbReqMsg)
+	if err != nil {
+		return nil, fmt.Errorf("no response message to ModifyBearerRequest: %s", err)
+	}
+	mbRes, ok := grpcMessage.(*protos.ModifyBearerResponsePgw)
+	if !ok {
+		return nil, fmt.Errorf("Wrong response type (no ModifyBearerResponse), maybe received out of order response message: %s", err)
+	}
+	glog.V(2).Infof("Modify Bearer Response (grpc):\n%s", mbRes.String())
+	return mbRes, nil
+}
+
+// sendAndReceiveReleaseAccessBearers sends release access bearers request GTP-C message to PGW and
+// waits for its answers.
+// Returns a GRPC message translated from the GTP-C release access bearers response
+func (s *S8Proxy) sendAndReceiveReleaseAccessBearers(req *protos.ReleaseAccessBearersRequestPgw,
+	cPgwUDPAddr *net.UDPAddr,
+	rabReqMsg message.Message) (*protos.ReleaseAccessBearersResponsePgw, error) {
+	glog.V(2).Infof("Send Release Access Bearers Request (grpc) to %s:\n%s", cPgwUDPAddr,
+		req)
+	glog.V(2).Infof("Send Release Access Bearers Request (gtp) to %s:\n%s",
+		cPgwUDPAddr.String(), rabReqMsg.(*message.ReleaseAccessBearersRequest).String())
+	grpcMessage, err := s.gtpClient.SendMessageAndExtractGrpc(req.Imsi, req.CAgwTeid, cPgwUDPAddr, rabReqMsg)
+	if err != nil {
+		return nil, fmt.Errorf("no response message to ReleaseAccessBearersRequest: %s", err)
+	}
+	rabRes, ok := grpcMessage.(*protos.ReleaseAccessBearersResponsePgw)
+	if !ok {
+		return nil, fmt.Errorf("Wrong response type (no ReleaseAccessBearersResponse), maybe received out of order response message: %s", err)
+	}
+	glog.V(2).Infof("Release Access Bearers Response (grpc):\n%s", rabRes.String())
+	return rabRes, nil
+}
+
 // sendAndReceiveCreateBearerResponse
 func (s *S8Proxy) sendAndReceiveCreateBearerResponse(res *protos.CreateBearerResponsePgw,
 	cPgwUDPAddr *net.UDPAddr,
@@ -110,3 +154,18 @@ func (s *S8Proxy) sendAndReceiveDeleteBearerResponse(res *protos.DeleteBearerRes
 	}
 	return &orc8r_protos.Void{}, err
 }
+
+// sendAndReceiveUpdateBearerResponse
+func (s *S8Proxy) sendAndReceiveUpdateBearerResponse(res *protos.UpdateBearerResponsePgw,
+	cPgwUDPAddr *net.UDPAddr,
+	ubResMsg message.Message) (*orc8r_protos.Void, error) {
+	glog.V(2).Infof("Send Update Bearer Response (grpc) to %s:\n%s", cPgwUDPAddr,
+		res)
+	glog.V(2).Infof("Send Update Bearer Response (gtp) to %s:\n%s",
+		cPgwUDPAddr.String(), ubResMsg.(*message.UpdateBearerResponse).String())
+	err := s.gtpClient.SendMessageToWithoutIncSequence(ubResMsg, cPgwUDPAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed so send UpdateBearerResponse message: %s", err)
+	}
+	return &orc8r_protos.Void{}, err
+}
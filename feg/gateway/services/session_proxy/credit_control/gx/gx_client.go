@@ -177,6 +177,11 @@ func (gxClient *GxClient) DisableConnections(period time.Duration) {
 	gxClient.diamClient.DisableConnectionCreation(period)
 }
 
+// ActiveOverloadReports returns DOIC overload reports currently in effect for the PCRF
+func (gxClient *GxClient) ActiveOverloadReports() []diameter.OverloadReport {
+	return gxClient.diamClient.ActiveOverloadReports()
+}
+
 // Register reauth request handler
 func registerReAuthHandler(reAuthHandler PolicyReAuthHandler, diamClient *diameter.Client) {
 	reqHandler := func(conn diam.Conn, message *diam.Message) {
@@ -184,6 +184,11 @@ func (gyClient *GyClient) DisableConnections(period time.Duration) {
 	gyClient.diamClient.DisableConnectionCreation(period)
 }
 
+// ActiveOverloadReports returns DOIC overload reports currently in effect for the OCS
+func (gyClient *GyClient) ActiveOverloadReports() []diameter.OverloadReport {
+	return gyClient.diamClient.ActiveOverloadReports()
+}
+
 // RegisterReAuthHandler adds a handler to the client for responding to RAR
 // messages received from the OCS
 func registerReAuthHandler(reAuthHandler ChargingReAuthHandler, diamClient *diameter.Client) {
@@ -16,6 +16,7 @@ package servicers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -397,10 +398,46 @@ func (srv *CentralSessionController) GetHealthStatus(
 	if gyStatus.Health == fegprotos.HealthStatus_UNHEALTHY {
 		return gyStatus, nil
 	}
+	return srv.getHealthStatusForOverload(), nil
+}
+
+// getHealthStatusForOverload reports DOIC overload state of PCRF & OCS. Service is
+// considered unhealthy only if a peer requested to drop all of the traffic
+func (srv *CentralSessionController) getHealthStatusForOverload() *fegprotos.HealthStatus {
+	var messages []string
+	for _, peer := range []struct {
+		name     string
+		client   interface{}
+		disabled bool
+	}{
+		{"PCRF", srv.policyClient, srv.cfg.DisableGx},
+		{"OCS", srv.creditClient, srv.cfg.DisableGy},
+	} {
+		reporter, ok := peer.client.(diameter.OverloadReporter)
+		if peer.disabled || !ok {
+			continue
+		}
+		msg, blocked := diameter.OverloadHealthMessage(peer.name, reporter.ActiveOverloadReports())
+		if blocked {
+			return &fegprotos.HealthStatus{
+				Health:        fegprotos.HealthStatus_UNHEALTHY,
+				HealthMessage: msg,
+			}
+		}
+		if len(msg) > 0 {
+			messages = append(messages, msg)
+		}
+	}
+	if len(messages) > 0 {
+		return &fegprotos.HealthStatus{
+			Health:        fegprotos.HealthStatus_HEALTHY,
+			HealthMessage: "All metrics appear healthy; " + strings.Join(messages, "; "),
+		}
+	}
 	return &fegprotos.HealthStatus{
 		Health:        fegprotos.HealthStatus_HEALTHY,
 		HealthMessage: "All metrics appear healthy",
-	}, nil
+	}
 }
 
 func (srv *CentralSessionController) getHealthStatusForGxRequests(failures, total int64) *fegprotos.HealthStatus {
@@ -121,6 +121,19 @@ func (swxCache *Impl) Remove(imsi string) *protos.AuthenticationAnswer {
 	return nil
 }
 
+// UpdateUserProfile replaces the user profile of cached IMSI's auth vectors with the given profile,
+// returns true if the IMSI's vectors are cached and the profile was updated
+func (swxCache *Impl) UpdateUserProfile(imsi string, profile *protos.AuthenticationAnswer_UserProfile) bool {
+	swxCache.mu.Lock()
+	defer swxCache.mu.Unlock()
+	ent, found := swxCache.data.vectors[imsi]
+	if !found {
+		return false
+	}
+	ent.ans.UserProfile = profile
+	return true
+}
+
 // Put adds ans vectors into the cache after extracting the first neededNumber vectors from the list,
 // which it returns back to the caller in the returned AuthenticationAnswer
 func (swxCache *Impl) Put(ans *protos.AuthenticationAnswer, neededNumber int) *protos.AuthenticationAnswer {
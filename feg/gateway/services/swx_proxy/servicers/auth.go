@@ -217,10 +217,7 @@ func (s *swxProxy) retrieveUserProfile(userName, sid string) (*protos.Authentica
 			"Subscription ID type is not END_USER_E164; Cannot retrieve MSISDN",
 		)
 	}
-	userProfile := &protos.AuthenticationAnswer_UserProfile{
-		Msisdn: string(saa.UserData.SubscriptionId.SubscriptionIdData),
-	}
-	return userProfile, true, nil
+	return getUserProfile(&saa.UserData), true, nil
 }
 
 // registerUser sends SARs with ServerAssignmentType REGISTRATION
@@ -251,6 +248,9 @@ func (s *swxProxy) createMAR(sid string, req *protos.AuthenticationRequest) (*di
 	msg.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
 	msg.NewAVP(avp.SIPNumberAuthItems, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(req.GetSipNumAuthVectors()))
 	msg.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(RadioAccessTechnologyType_WLAN))
+	if req.GetAuthenticationScheme() == protos.AuthenticationScheme_EAP_AKA_PRIME {
+		msg.NewAVP(avp.ANID, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(req.GetAccessNetworkIdentity()))
+	}
 	authDataAvp := []*diam.AVP{
 		diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(authScheme)),
 	}
@@ -318,6 +318,9 @@ func validateAuthRequest(req *protos.AuthenticationRequest) error {
 	if len(req.GetUserName()) > 15 {
 		return fmt.Errorf("provided username %s is greater than 15 digits", req.GetUserName())
 	}
+	if req.GetAuthenticationScheme() == protos.AuthenticationScheme_EAP_AKA_PRIME && len(req.GetAccessNetworkIdentity()) == 0 {
+		return fmt.Errorf("access network identity must be provided for EAP-AKA' authentication request")
+	}
 	return nil
 }
 
@@ -0,0 +1,159 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package servicers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+)
+
+const (
+	// MaxDiamPPRetries - number of retries for responding to PPR
+	MaxDiamPPRetries = 1
+)
+
+func (r *fegRelayClient) RelayPPR(ppr *PPR) (protos.ErrorCode, error) {
+	var err error
+	if r == nil || r.registry == nil {
+		err = fmt.Errorf("No relay registry for PPR")
+		return protos.ErrorCode_UNABLE_TO_DELIVER, err
+	}
+	client, err := GetSwxGatewayServiceResponderClient(r.registry)
+	if err != nil {
+		return protos.ErrorCode_UNABLE_TO_DELIVER, err
+	}
+	defer client.Close()
+
+	_, err = client.PushProfile(context.Background(), &protos.PushProfileRequest{
+		UserName:    string(ppr.UserName),
+		UserProfile: getUserProfile(ppr.UserData),
+		SessionId:   string(ppr.SessionID),
+	})
+	if err != nil {
+		return protos.ErrorCode_LIMITED_SUCCESS, err
+	}
+	return protos.ErrorCode_SUCCESS, nil
+}
+
+func handlePPR(s *swxProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("handling PPR %v\n", m)
+		var ppr PPR
+		err := m.Unmarshal(&ppr)
+		if err != nil {
+			glog.Errorf("PPR Unmarshal failed for remote %s & message %s: %v", c.RemoteAddr(), m, err)
+			return
+		}
+		imsi := string(ppr.UserName)
+		if len(imsi) == 0 {
+			imsi, err = diameter.ExtractImsiFromSessionID(string(ppr.SessionID))
+			if err != nil {
+				err = fmt.Errorf("error retreiving IMSI from Session ID %s: %s", ppr.SessionID, err)
+				glog.Error(err)
+				err = s.sendPPA(c, m, protos.ErrorCode_UNKNOWN_SESSION_ID, &ppr, MaxDiamPPRetries)
+				if err != nil {
+					glog.Error(fmt.Errorf("error replying back (PPA): %s", err))
+				}
+				return
+			}
+			ppr.UserName = datatype.UTF8String(imsi)
+		}
+		if ppr.UserData == nil {
+			// Nothing to update, PPR may only carry flags this proxy doesn't support
+			err = s.sendPPA(c, m, protos.ErrorCode_SUCCESS, &ppr, MaxDiamPPRetries)
+			if err != nil {
+				glog.Errorf("Failed to send PPA: %v", err)
+			}
+			return
+		}
+		if s.cache != nil {
+			if ppr.UserData.Non3GPPIPAccess != datatype.Enumerated(Non3GPPIPAccess_ENABLED) {
+				// user is no longer authorized, cached vectors must not be used
+				s.cache.Remove(imsi)
+			} else {
+				s.cache.UpdateUserProfile(imsi, getUserProfile(ppr.UserData))
+			}
+		}
+		go func() {
+			code, err := s.Relay.RelayPPR(&ppr)
+			if err != nil {
+				glog.Error(err)
+			}
+
+			err = s.sendPPA(c, m, code, &ppr, MaxDiamPPRetries)
+			if err != nil {
+				glog.Errorf("Failed to send PPA: %v", err)
+			}
+		}()
+	}
+}
+
+func (s *swxProxy) sendPPA(c diam.Conn, m *diam.Message, code protos.ErrorCode, ppr *PPR, retries uint) error {
+	ans := m.Answer(uint32(code))
+	// SessionID is required to be the AVP in position 1
+	ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, ppr.SessionID))
+	ans.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.TGPP_SWX_APP_ID)),
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+		},
+	})
+	ans.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(ppr.AuthSessionState))
+	ans.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Host))
+	ans.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Realm))
+	if s.originStateID != 0 {
+		ans.NewAVP(avp.OriginStateID, avp.Mbit, 0, datatype.Unsigned32(s.originStateID))
+	}
+	_, err := ans.WriteToWithRetry(c, retries)
+	return err
+}
+
+// getUserProfile converts Non-3GPP-User-Data into its RPC representation
+func getUserProfile(userData *Non3GPPUserData) *protos.AuthenticationAnswer_UserProfile {
+	if userData == nil {
+		return nil
+	}
+	profile := &protos.AuthenticationAnswer_UserProfile{
+		Non_3GppIpAccessBarred:      userData.Non3GPPIPAccess != datatype.Enumerated(Non3GPPIPAccess_ENABLED),
+		Non_3GppIpAccessApnDisabled: userData.Non3GPPIPAccessAPN != datatype.Enumerated(Non3GPPIPAccessAPN_ENABLED),
+		Ambr: &protos.AuthenticationAnswer_UserProfile_AMBR{
+			MaxBandwidthUl: userData.AMBR.MaxRequestedBandwidthUL,
+			MaxBandwidthDl: userData.AMBR.MaxRequestedBandwidthDL,
+		},
+	}
+	if userData.SubscriptionId.SubscriptionIdType == END_USER_E164 {
+		profile.Msisdn = string(userData.SubscriptionId.SubscriptionIdData)
+	}
+	for _, apn := range userData.APNConfigurations {
+		profile.ApnConfigurations = append(profile.ApnConfigurations,
+			&protos.AuthenticationAnswer_UserProfile_APNConfiguration{
+				ContextId:        apn.ContextIdentifier,
+				ServiceSelection: string(apn.ServiceSelection),
+				PdnType:          apn.PDNType,
+				Ambr: &protos.AuthenticationAnswer_UserProfile_AMBR{
+					MaxBandwidthUl: apn.AMBR.MaxRequestedBandwidthUL,
+					MaxBandwidthDl: apn.AMBR.MaxRequestedBandwidthDL,
+				},
+			})
+	}
+	return profile
+}
@@ -35,6 +35,11 @@ const (
 
 	// 3GPP 29.273 8.2.3.4
 	Non3GPPIPAccess_ENABLED = 0
+	// 3GPP 29.273 8.2.3.5
+	Non3GPPIPAccessAPN_ENABLED = 0
+
+	// 3GPP 29.273 8.2.2.3 - Push-Profile command code
+	PushProfile = 305
 
 	// Value of AVP auth-session-state indicating that no state is maintained
 	// between calls.
@@ -50,6 +55,7 @@ type MAR struct {
 	AuthSessionState    datatype.UTF8String         `avp:"Auth-Session-State"`
 	UserName            string                      `avp:"User-Name"`
 	RATType             datatype.Enumerated         `avp:"RAT-Type"`
+	ANID                string                      `avp:"ANID"`
 	AuthData            SIPAuthDataItem             `avp:"SIP-Auth-Data-Item"`
 	NumberAuthItems     uint32                      `avp:"SIP-Number-Auth-Items"`
 }
@@ -112,8 +118,24 @@ type SAA struct {
 }
 
 type Non3GPPUserData struct {
-	SubscriptionId  SubscriptionId      `avp:"Subscription-Id"`
-	Non3GPPIPAccess datatype.Enumerated `avp:"Non-3GPP-IP-Access"`
+	SubscriptionId     SubscriptionId      `avp:"Subscription-Id"`
+	Non3GPPIPAccess    datatype.Enumerated `avp:"Non-3GPP-IP-Access"`
+	Non3GPPIPAccessAPN datatype.Enumerated `avp:"Non-3GPP-IP-Access-APN"`
+	AMBR               AMBR                `avp:"AMBR"`
+	ContextIdentifier  uint32              `avp:"Context-Identifier"`
+	APNConfigurations  []APNConfiguration  `avp:"APN-Configuration"`
+}
+
+type AMBR struct {
+	MaxRequestedBandwidthUL uint32 `avp:"Max-Requested-Bandwidth-UL"`
+	MaxRequestedBandwidthDL uint32 `avp:"Max-Requested-Bandwidth-DL"`
+}
+
+type APNConfiguration struct {
+	ContextIdentifier uint32              `avp:"Context-Identifier"`
+	PDNType           int32               `avp:"PDN-Type"`
+	ServiceSelection  datatype.UTF8String `avp:"Service-Selection"`
+	AMBR              AMBR                `avp:"AMBR"`
 }
 
 type SubscriptionId struct {
@@ -146,3 +168,24 @@ type DeregistrationReason struct {
 	ReasonCode datatype.Enumerated `avp:"Reason-Code"`
 	ReasonInfo datatype.UTF8String `avp:"Reason-Info"`
 }
+
+// 3GPP 29.273 8.2.2.3 - Push Profile Request
+type PPR struct {
+	SessionID           datatype.UTF8String         `avp:"Session-Id"`
+	VendorSpecificAppId VendorSpecificApplicationId `avp:"Vendor-Specific-Application-Id"`
+	OriginHost          datatype.DiameterIdentity   `avp:"Origin-Host"`
+	OriginRealm         datatype.DiameterIdentity   `avp:"Origin-Realm"`
+	AuthSessionState    datatype.Unsigned32         `avp:"Auth-Session-State"`
+	UserName            datatype.UTF8String         `avp:"User-Name"`
+	UserData            *Non3GPPUserData            `avp:"Non-3GPP-User-Data"`
+}
+
+// 3GPP 29.273 8.2.2.3 - Push Profile Answer
+type PPA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+}
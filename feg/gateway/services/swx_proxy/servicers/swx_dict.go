@@ -0,0 +1,66 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package servicers
+
+import (
+	"bytes"
+	"log"
+
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+)
+
+func init() {
+	// go-diameter SWx dictionary lacks Push-Profile command, add it to the default parser
+	if err := dict.Default.Load(bytes.NewReader([]byte(swxPushProfileXML))); err != nil {
+		log.Fatalf("failed to load SWx Push-Profile diameter dictionary: %v", err)
+	}
+}
+
+// swxPushProfileXML contains SWx Push-Profile command definition, see 3GPP TS 29.273 Section 8.2.2.3
+// All AVPs used by the command are already defined by go-diameter's SWx dictionary
+const swxPushProfileXML = `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+	<application id="16777265" type="auth" name="TGPP SWX">
+		<vendor id="10415" name="TGPP"/>
+		<command code="305" short="PP" name="Push-Profile">
+			<request>
+				<rule avp="Session-Id" required="true" max="1"/>
+				<rule avp="Vendor-Specific-Application-Id" required="true" max="1"/>
+				<rule avp="Auth-Session-State" required="true" max="1"/>
+				<rule avp="Origin-Host" required="true" max="1"/>
+				<rule avp="Origin-Realm" required="true" max="1"/>
+				<rule avp="Destination-Host" required="true" max="1"/>
+				<rule avp="Destination-Realm" required="true" max="1"/>
+				<rule avp="User-Name" required="true" max="1"/>
+				<rule avp="Non-3GPP-User-Data" required="false" max="1"/>
+				<rule avp="Supported-Features" required="false"/>
+				<rule avp="Proxy-Info" required="false"/>
+				<rule avp="Route-Record" required="false"/>
+			</request>
+			<answer>
+				<rule avp="Session-Id" required="true" max="1"/>
+				<rule avp="Vendor-Specific-Application-Id" required="true" max="1"/>
+				<rule avp="Result-Code" required="false" max="1"/>
+				<rule avp="Experimental-Result" required="false" max="1"/>
+				<rule avp="Auth-Session-State" required="true" max="1"/>
+				<rule avp="Origin-Host" required="true" max="1"/>
+				<rule avp="Origin-Realm" required="true" max="1"/>
+				<rule avp="Supported-Features" required="false"/>
+				<rule avp="Failed-AVP" required="false" max="1"/>
+				<rule avp="Proxy-Info" required="false"/>
+				<rule avp="Route-Record" required="false"/>
+			</answer>
+		</command>
+	</application>
+</diameter>`
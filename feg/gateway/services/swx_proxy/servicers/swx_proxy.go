@@ -45,6 +45,7 @@ const (
 type Relay interface {
 	RelayRTR(*RTR) (protos.ErrorCode, error)
 	RelayASR(*diameter.ASR) (protos.ErrorCode, error)
+	RelayPPR(*PPR) (protos.ErrorCode, error)
 }
 
 type swxProxy struct {
@@ -146,6 +147,9 @@ func NewSwxProxyWithCache(config *SwxProxyConfig, cache *cache.Impl) (*swxProxy,
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.RegistrationTermination, Request: true},
 		handleRTR(proxy))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: PushProfile, Request: true},
+		handlePPR(proxy))
 
 	return proxy, nil
 }
@@ -0,0 +1,238 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_ausf_udm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/magma/milenage"
+
+	sbi_NudmUEAU "magma/feg/gateway/sbi/specs/TS29503NudmUEAU"
+	ausf_server "magma/feg/gateway/sbi/specs/TS29509NausfUEAuthenticationServer"
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+)
+
+const (
+	authType5gAka       = "5G_AKA"
+	authTypeEapAkaPrime = "EAP_AKA_PRIME"
+)
+
+// PostUeAuthentications handles POST /ue-authentications
+func (srv *MockAusfUdm) PostUeAuthentications(ctx echo.Context) error {
+	var info ausf_server.AuthenticationInfo
+	err := ctx.Bind(&info)
+	if err != nil {
+		glog.Errorf("PostUeAuthentications error binding the request body: %s", err)
+		return problem(ctx, http.StatusBadRequest, "MANDATORY_IE_INCORRECT")
+	}
+	supi := string(info.SupiOrSuci)
+	servingNetworkName := string(info.ServingNetworkName)
+
+	srv.Lock()
+	defer srv.Unlock()
+	sub, found := srv.subscribers[supi]
+	if !found {
+		return problem(ctx, http.StatusNotFound, "USER_NOT_FOUND")
+	}
+	if info.ResynchronizationInfo != nil {
+		err = resync(sub, string(info.ResynchronizationInfo.Rand), string(info.ResynchronizationInfo.Auts))
+		if err != nil {
+			glog.Errorf("PostUeAuthentications resync failure for %s: %s", supi, err)
+			return problem(ctx, http.StatusForbidden, "SERVING_NETWORK_NOT_AUTHORIZED")
+		}
+	}
+	vector, err := generateHeVector(sub.Key, sub.Opc, sub.Sqn)
+	if err != nil {
+		glog.Errorf("PostUeAuthentications vector generation failure for %s: %s", supi, err)
+		return problem(ctx, http.StatusInternalServerError, "AV_GENERATION_PROBLEM")
+	}
+	sub.Sqn++
+
+	authCtxId := uuid.New().String()
+	authCtxUri := fmt.Sprintf("%s%s/ue-authentications/%s", srv.apiRoot, AusfBasePath, authCtxId)
+	authCtx := &authContext{supi: supi, servingNetworkName: servingNetworkName, method: sub.AuthMethod}
+	res := ausf_server.UEAuthenticationCtx{ServingNetworkName: &info.ServingNetworkName}
+
+	switch sub.AuthMethod {
+	case EapAkaPrime:
+		ckPrime, ikPrime := CkIkPrime(vector.ck, vector.ik, servingNetworkName, vector.sqnXorAk)
+		kAut, _, emsk := AkaPrimeKeys(ckPrime, ikPrime, imsiFromSupi(supi))
+		authCtx.xres, authCtx.kAut = vector.xres, kAut
+		// K_AUSF is the first 256 bits of EMSK (TS 33.501, 6.1.3.1)
+		authCtx.kseaf = Kseaf(emsk[:32], servingNetworkName)
+		challenge := newAkaPrimeChallenge(1, vector.rand, vector.autn, servingNetworkName, kAut)
+		res.AuthType = authTypeEapAkaPrime
+		res.N5gAuthData = ausf_server.EapPayload(base64.StdEncoding.EncodeToString(challenge))
+		res.Links.Set("eap-session", sbi_CommonData.Link{Href: uriPtr(authCtxUri + "/eap-session")})
+	default:
+		xresStar := ResStar(vector.ck, vector.ik, servingNetworkName, vector.rand, vector.xres)
+		authCtx.xresStar = xresStar
+		authCtx.kseaf = Kseaf(Kausf(vector.ck, vector.ik, servingNetworkName, vector.sqnXorAk), servingNetworkName)
+		res.AuthType = authType5gAka
+		res.N5gAuthData = ausf_server.Av5gAka{
+			Rand:      sbi_NudmUEAU.Rand(toHex(vector.rand)),
+			Autn:      sbi_NudmUEAU.Autn(toHex(vector.autn)),
+			HxresStar: ausf_server.HxresStar(hex.EncodeToString(HxresStar(vector.rand, xresStar))),
+		}
+		res.Links.Set("5g-aka", sbi_CommonData.Link{Href: uriPtr(authCtxUri + "/5g-aka-confirmation")})
+	}
+	srv.authContexts[authCtxId] = authCtx
+	ctx.Response().Header()["Location"] = []string{authCtxUri}
+	return ctx.JSON(http.StatusCreated, res)
+}
+
+// PutUeAuthenticationsAuthCtxId5gAkaConfirmation handles PUT /ue-authentications/{authCtxId}/5g-aka-confirmation
+func (srv *MockAusfUdm) PutUeAuthenticationsAuthCtxId5gAkaConfirmation(ctx echo.Context, authCtxId string) error {
+	var confirmation ausf_server.ConfirmationData
+	err := ctx.Bind(&confirmation)
+	if err != nil {
+		glog.Errorf("PutUeAuthenticationsAuthCtxId5gAkaConfirmation error binding the request body: %s", err)
+		return problem(ctx, http.StatusBadRequest, "MANDATORY_IE_INCORRECT")
+	}
+	authCtx, found := srv.popAuthContext(authCtxId, FiveGAka)
+	if !found {
+		return problem(ctx, http.StatusNotFound, "CONTEXT_NOT_FOUND")
+	}
+	res := ausf_server.ConfirmationDataResponse{AuthResult: ausf_server.AuthResultAUTHENTICATIONFAILURE}
+	if confirmation.ResStar != nil {
+		resStar, err := hex.DecodeString(string(*confirmation.ResStar))
+		if err == nil && bytes.Equal(resStar, authCtx.xresStar) {
+			supi := sbi_CommonData.Supi(authCtx.supi)
+			kseaf := ausf_server.Kseaf(hex.EncodeToString(authCtx.kseaf))
+			res = ausf_server.ConfirmationDataResponse{
+				AuthResult: ausf_server.AuthResultAUTHENTICATIONSUCCESS,
+				Supi:       &supi,
+				Kseaf:      &kseaf,
+			}
+		}
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// EapAuthMethod handles POST /ue-authentications/{authCtxId}/eap-session
+func (srv *MockAusfUdm) EapAuthMethod(ctx echo.Context, authCtxId string) error {
+	var session ausf_server.EapSession
+	err := ctx.Bind(&session)
+	if err != nil {
+		glog.Errorf("EapAuthMethod error binding the request body: %s", err)
+		return problem(ctx, http.StatusBadRequest, "MANDATORY_IE_INCORRECT")
+	}
+	authCtx, found := srv.popAuthContext(authCtxId, EapAkaPrime)
+	if !found {
+		return problem(ctx, http.StatusNotFound, "CONTEXT_NOT_FOUND")
+	}
+	packet, err := base64.StdEncoding.DecodeString(string(session.EapPayload))
+	if err != nil || len(packet) < eapHeaderLen {
+		return problem(ctx, http.StatusBadRequest, "MANDATORY_IE_INCORRECT")
+	}
+	failure := ausf_server.AuthResultAUTHENTICATIONFAILURE
+	res := ausf_server.EapSession{
+		AuthResult: &failure,
+		EapPayload: eapResult(eapCodeFailure, packet[1]),
+	}
+	err = verifyAkaPrimeChallengeResponse(packet, authCtx)
+	if err != nil {
+		glog.Errorf("EapAuthMethod authentication failure for %s: %s", authCtx.supi, err)
+		return ctx.JSON(http.StatusOK, res)
+	}
+	success := ausf_server.AuthResultAUTHENTICATIONSUCCESS
+	supi := sbi_CommonData.Supi(authCtx.supi)
+	kseaf := ausf_server.Kseaf(hex.EncodeToString(authCtx.kseaf))
+	res = ausf_server.EapSession{
+		AuthResult: &success,
+		EapPayload: eapResult(eapCodeSuccess, packet[1]),
+		KSeaf:      &kseaf,
+		Supi:       &supi,
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func (srv *MockAusfUdm) popAuthContext(authCtxId string, method AuthMethod) (*authContext, bool) {
+	srv.Lock()
+	defer srv.Unlock()
+	authCtx, found := srv.authContexts[authCtxId]
+	if !found || authCtx.method != method {
+		return nil, false
+	}
+	delete(srv.authContexts, authCtxId)
+	return authCtx, true
+}
+
+func verifyAkaPrimeChallengeResponse(packet []byte, authCtx *authContext) error {
+	subtype, attributes, err := parseAkaPrimePacket(packet)
+	if err != nil {
+		return err
+	}
+	if packet[0] != eapCodeResponse || subtype != subtypeChallenge {
+		return fmt.Errorf("unexpected EAP-AKA' code %d, subtype %d", packet[0], subtype)
+	}
+	if !verifyMac(packet, authCtx.kAut) {
+		return fmt.Errorf("invalid AT_MAC")
+	}
+	res := attributes[atRes]
+	if len(res) < 2 {
+		return fmt.Errorf("missing AT_RES")
+	}
+	resLen := (int(res[0])<<8 + int(res[1])) / 8
+	if resLen > len(res)-2 || !bytes.Equal(res[2:2+resLen], authCtx.xres) {
+		return fmt.Errorf("AT_RES mismatch")
+	}
+	return nil
+}
+
+// resync re-synchronizes subscriber's SQN from AUTS sent by the UE (TS 33.102, 6.3.5)
+func resync(sub *Subscriber, randHex, autsHex string) error {
+	rand, err := hex.DecodeString(randHex)
+	if err != nil {
+		return err
+	}
+	auts, err := hex.DecodeString(autsHex)
+	if err != nil {
+		return err
+	}
+	cipher, err := milenage.NewCipher(amf5G)
+	if err != nil {
+		return err
+	}
+	sqnMs, macS, err := cipher.GenerateResync(auts, sub.Key, sub.Opc, rand)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(macS[:], auts[6:]) {
+		return fmt.Errorf("invalid MAC-S")
+	}
+	sub.Sqn = sqnMs + 1
+	return nil
+}
+
+func eapResult(code, identifier byte) ausf_server.EapPayload {
+	return ausf_server.EapPayload(base64.StdEncoding.EncodeToString([]byte{code, identifier, 0, 4}))
+}
+
+func toHex(b []byte) string {
+	return strings.ToUpper(hex.EncodeToString(b))
+}
+
+func uriPtr(uri string) *sbi_CommonData.Uri {
+	res := sbi_CommonData.Uri(uri)
+	return &res
+}
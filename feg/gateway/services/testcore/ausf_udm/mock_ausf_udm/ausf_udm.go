@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mock_ausf_udm provides a mock 5G AUSF & UDM (Nausf_UEAuthentication & Nudm_UECM SBI servers)
+// for testing
+package mock_ausf_udm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+
+	"magma/feg/gateway/sbi"
+	uecm_server "magma/feg/gateway/sbi/specs/TS29503NudmUECMServer"
+	ausf_server "magma/feg/gateway/sbi/specs/TS29509NausfUEAuthenticationServer"
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+)
+
+const (
+	AusfBasePath    = "/nausf-auth/v1"
+	UdmUecmBasePath = "/nudm-uecm/v1"
+	TokenPath       = "/oauth2/token"
+
+	supiImsiPrefix = "imsi-"
+)
+
+// AuthMethod is the authentication method the UDM selects for a subscriber
+type AuthMethod int
+
+const (
+	FiveGAka AuthMethod = iota
+	EapAkaPrime
+)
+
+// Subscriber is the authentication subscription of a 5G subscriber
+type Subscriber struct {
+	Key        []byte
+	Opc        []byte
+	Sqn        uint64
+	AuthMethod AuthMethod
+}
+
+// authContext is an ongoing authentication created by POST /ue-authentications
+type authContext struct {
+	supi               string
+	servingNetworkName string
+	method             AuthMethod
+	// 5G AKA
+	xresStar []byte
+	// EAP-AKA'
+	xres []byte
+	kAut []byte
+
+	kseaf []byte
+}
+
+// MockAusfUdm is a mock AUSF & UDM, it authenticates subscribers using 5G AKA or EAP-AKA'
+// and stores serving AMF registrations
+type MockAusfUdm struct {
+	*sbi.EchoServer
+
+	sync.Mutex
+	apiRoot       string
+	subscribers   map[string]*Subscriber // SUPI -> subscription
+	authContexts  map[string]*authContext
+	registrations map[string]*uecm_server.Amf3GppAccessRegistration // SUPI -> AMF registration
+}
+
+// NewMockAusfUdm creates a mock AUSF/UDM, the server must be started with Start
+func NewMockAusfUdm() *MockAusfUdm {
+	srv := &MockAusfUdm{
+		EchoServer:    sbi.NewEchoServer(),
+		subscribers:   map[string]*Subscriber{},
+		authContexts:  map[string]*authContext{},
+		registrations: map[string]*uecm_server.Amf3GppAccessRegistration{},
+	}
+	ausf_server.RegisterHandlersWithBaseURL(srv.EchoServer, srv, AusfBasePath)
+	uecm_server.RegisterHandlersWithBaseURL(srv.EchoServer, &udmServer{srv}, UdmUecmBasePath)
+	srv.POST(TokenPath, handleTokenRequest)
+	return srv
+}
+
+// Start starts the mock AUSF/UDM on the given address (ip:port or :port) & waits for it to be ready
+func (srv *MockAusfUdm) Start(addr string) error {
+	err := srv.StartWithWait(addr)
+	if err != nil {
+		return fmt.Errorf("mock AUSF/UDM could not start: %s", err)
+	}
+	listenerAddr, err := srv.GetListenerAddr()
+	if err != nil {
+		return err
+	}
+	srv.Lock()
+	srv.apiRoot = fmt.Sprintf("http://%s", listenerAddr.String())
+	srv.Unlock()
+	return nil
+}
+
+// Stop stops the mock AUSF/UDM
+func (srv *MockAusfUdm) Stop() {
+	srv.Shutdown(sbi.ShutdownTimeout)
+}
+
+// ApiRoot returns the API root (scheme://host:port) of the started server
+func (srv *MockAusfUdm) ApiRoot() string {
+	srv.Lock()
+	defer srv.Unlock()
+	return srv.apiRoot
+}
+
+// AddSubscriber adds or replaces authentication subscription of the IMSI
+func (srv *MockAusfUdm) AddSubscriber(imsi string, subscriber Subscriber) {
+	srv.Lock()
+	defer srv.Unlock()
+	sub := subscriber
+	srv.subscribers[supiImsiPrefix+imsi] = &sub
+}
+
+// GetSqn returns the current SQN of the IMSI's subscription
+func (srv *MockAusfUdm) GetSqn(imsi string) (uint64, bool) {
+	srv.Lock()
+	defer srv.Unlock()
+	sub, found := srv.subscribers[supiImsiPrefix+imsi]
+	if !found {
+		return 0, false
+	}
+	return sub.Sqn, true
+}
+
+// GetAmfRegistration returns a copy of the IMSI's serving AMF registration for 3GPP access
+func (srv *MockAusfUdm) GetAmfRegistration(imsi string) (*uecm_server.Amf3GppAccessRegistration, bool) {
+	srv.Lock()
+	defer srv.Unlock()
+	reg, found := srv.registrations[supiImsiPrefix+imsi]
+	if !found {
+		return nil, false
+	}
+	res := *reg
+	return &res, true
+}
+
+// handleTokenRequest implements a permissive OAuth2 token endpoint for the client credentials grant
+func handleTokenRequest(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"access_token": "mock_ausf_udm_token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func problem(ctx echo.Context, status int, cause string) error {
+	return ctx.JSON(status, sbi_CommonData.ProblemDetails{Status: &status, Cause: &cause})
+}
+
+func imsiFromSupi(supi string) string {
+	return strings.TrimPrefix(supi, supiImsiPrefix)
+}
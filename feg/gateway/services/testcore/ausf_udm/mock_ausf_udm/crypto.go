@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_ausf_udm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/magma/milenage"
+)
+
+// TS 33.220 Annex B.2 FC values used by 5G AKA & EAP-AKA' (TS 33.501 Annex A)
+const (
+	fcCkIkPrime = 0x20
+	fcKausf     = 0x6A
+	fcResStar   = 0x6B
+	fcKseaf     = 0x6C
+)
+
+// 5G AMF with the "separation bit" set (TS 33.102 Annex H)
+var amf5G = []byte{0x80, 0x00}
+
+// heVector is the 5G home environment authentication vector with the keys
+// derived from it on the AUSF side
+type heVector struct {
+	rand, autn, xres, ck, ik, sqnXorAk []byte
+}
+
+// generateHeVector runs milenage for the given subscriber & SQN
+func generateHeVector(key, opc []byte, sqn uint64) (*heVector, error) {
+	cipher, err := milenage.NewCipher(amf5G)
+	if err != nil {
+		return nil, err
+	}
+	vector, err := cipher.GenerateSIPAuthVector(key, opc, sqn)
+	if err != nil {
+		return nil, err
+	}
+	return &heVector{
+		rand:     vector.Rand[:],
+		autn:     vector.Autn[:],
+		xres:     vector.Xres[:],
+		ck:       vector.ConfidentialityKey[:],
+		ik:       vector.IntegrityKey[:],
+		sqnXorAk: vector.Autn[:6],
+	}, nil
+}
+
+// kdf implements the generic key derivation function of TS 33.220 Annex B.2:
+// HMAC-SHA-256(key, FC || P0 || L0 || P1 || L1 || ...)
+func kdf(key []byte, fc byte, params ...[]byte) []byte {
+	s := []byte{fc}
+	for _, p := range params {
+		l := make([]byte, 2)
+		binary.BigEndian.PutUint16(l, uint16(len(p)))
+		s = append(s, p...)
+		s = append(s, l...)
+	}
+	h := hmac.New(sha256.New, key)
+	h.Write(s)
+	return h.Sum(nil)
+}
+
+// ResStar calculates RES*/XRES* (TS 33.501 Annex A.4)
+func ResStar(ck, ik []byte, servingNetworkName string, rand, res []byte) []byte {
+	return kdf(append(append([]byte{}, ck...), ik...), fcResStar, []byte(servingNetworkName), rand, res)[16:]
+}
+
+// HxresStar calculates HXRES* from RAND & XRES* (TS 33.501 Annex A.5)
+func HxresStar(rand, xresStar []byte) []byte {
+	h := sha256.New()
+	h.Write(rand)
+	h.Write(xresStar)
+	return h.Sum(nil)[16:]
+}
+
+// Kausf calculates K_AUSF for 5G AKA (TS 33.501 Annex A.2)
+func Kausf(ck, ik []byte, servingNetworkName string, sqnXorAk []byte) []byte {
+	return kdf(append(append([]byte{}, ck...), ik...), fcKausf, []byte(servingNetworkName), sqnXorAk)
+}
+
+// Kseaf calculates K_SEAF from K_AUSF (TS 33.501 Annex A.6)
+func Kseaf(kausf []byte, servingNetworkName string) []byte {
+	return kdf(kausf, fcKseaf, []byte(servingNetworkName))
+}
+
+// CkIkPrime calculates CK' & IK' for EAP-AKA' (TS 33.501 Annex A.3, TS 33.402 Annex A.2)
+func CkIkPrime(ck, ik []byte, servingNetworkName string, sqnXorAk []byte) (ckPrime, ikPrime []byte) {
+	out := kdf(append(append([]byte{}, ck...), ik...), fcCkIkPrime, []byte(servingNetworkName), sqnXorAk)
+	return out[:16], out[16:]
+}
+
+// AkaPrimeKeys derives K_aut, MSK & EMSK from CK' & IK' using PRF' (RFC 5448, section 3.3)
+func AkaPrimeKeys(ckPrime, ikPrime []byte, identity string) (kAut, msk, emsk []byte) {
+	key := append(append([]byte{}, ikPrime...), ckPrime...)
+	mk := prfPrime(key, append([]byte("EAP-AKA'"), identity...), 208)
+	// K_encr: mk[0:16], K_re: mk[48:80]
+	return mk[16:48], mk[80:144], mk[144:208]
+}
+
+// prfPrime implements PRF' (RFC 5448, section 3.4.1)
+func prfPrime(key, s []byte, length int) []byte {
+	var res, t []byte
+	for i := byte(1); len(res) < length; i++ {
+		h := hmac.New(sha256.New, key)
+		h.Write(t)
+		h.Write(s)
+		h.Write([]byte{i})
+		t = h.Sum(nil)
+		res = append(res, t...)
+	}
+	return res[:length]
+}
+
+// AkaPrimeMac calculates AT_MAC value over the EAP packet with zeroed AT_MAC (RFC 5448, section 3.4.2)
+func AkaPrimeMac(kAut, packet []byte) []byte {
+	h := hmac.New(sha256.New, kAut)
+	h.Write(packet)
+	return h.Sum(nil)[:16]
+}
+
+// UeResponse calculates the RES & keys a UE would derive from RAND with the given subscriber key & OPc
+func UeResponse(key, opc, rand []byte) (res, ck, ik []byte, err error) {
+	if len(rand) != milenage.RandChallengeBytes {
+		return nil, nil, nil, fmt.Errorf("invalid RAND length: %d", len(rand))
+	}
+	res, _, err = milenage.F2F5(key, rand, opc)
+	if err != nil {
+		return
+	}
+	ck, err = milenage.F3(key, rand, opc)
+	if err != nil {
+		return
+	}
+	ik, err = milenage.F4(key, rand, opc)
+	return
+}
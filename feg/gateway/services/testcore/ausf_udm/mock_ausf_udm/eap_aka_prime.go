@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_ausf_udm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// EAP-AKA' (RFC 5448) definitions used by the mock AUSF
+const (
+	eapCodeRequest  = 1
+	eapCodeResponse = 2
+	eapCodeSuccess  = 3
+	eapCodeFailure  = 4
+
+	eapTypeAkaPrime = 50
+	eapHeaderLen    = 8 // Code, Identifier, Length, Type, Subtype, Reserved
+
+	subtypeChallenge            = 1
+	subtypeAuthenticationReject = 2
+
+	atRand     = 1
+	atAutn     = 2
+	atRes      = 3
+	atMac      = 11
+	atKdfInput = 23
+	atKdf      = 24
+
+	kdfAkaPrimeSha256 = 1
+	macLen            = 16
+)
+
+// newAttribute returns an AKA attribute padded to 4 byte boundary
+func newAttribute(typ byte, value []byte) []byte {
+	l := 2 + len(value)
+	pad := (4 - l&3) & 3
+	res := make([]byte, 0, l+pad)
+	res = append(res, typ, byte((l+pad)>>2))
+	res = append(res, value...)
+	return append(res, make([]byte, pad)...)
+}
+
+// newAkaPrimePacket builds an EAP-AKA' packet with the given attributes
+func newAkaPrimePacket(code, identifier, subtype byte, attributes ...[]byte) []byte {
+	p := []byte{code, identifier, 0, 0, eapTypeAkaPrime, subtype, 0, 0}
+	for _, a := range attributes {
+		p = append(p, a...)
+	}
+	binary.BigEndian.PutUint16(p[2:], uint16(len(p)))
+	return p
+}
+
+// parseAkaPrimePacket validates an EAP-AKA' packet & returns its subtype and attribute values by type
+func parseAkaPrimePacket(p []byte) (byte, map[byte][]byte, error) {
+	if len(p) < eapHeaderLen {
+		return 0, nil, fmt.Errorf("EAP packet is too short: %d", len(p))
+	}
+	if l := int(binary.BigEndian.Uint16(p[2:])); l != len(p) {
+		return 0, nil, fmt.Errorf("invalid EAP packet length: header => %d, actual => %d", l, len(p))
+	}
+	if p[4] != eapTypeAkaPrime {
+		return 0, nil, fmt.Errorf("unexpected EAP method type: %d", p[4])
+	}
+	attributes := map[byte][]byte{}
+	for data := p[eapHeaderLen:]; len(data) > 0; {
+		if len(data) < 4 {
+			return 0, nil, fmt.Errorf("truncated EAP-AKA' attribute")
+		}
+		l := int(data[1]) << 2
+		if l == 0 || l > len(data) {
+			return 0, nil, fmt.Errorf("invalid length %d of EAP-AKA' attribute %d", l, data[0])
+		}
+		attributes[data[0]] = data[2:l]
+		data = data[l:]
+	}
+	return p[5], attributes, nil
+}
+
+// setMac calculates & sets AT_MAC value of the packet, AT_MAC must be the last attribute
+func setMac(p, kAut []byte) {
+	mac := AkaPrimeMac(kAut, p)
+	copy(p[len(p)-macLen:], mac)
+}
+
+// verifyMac verifies AT_MAC of the packet, AT_MAC must be the last attribute
+func verifyMac(p, kAut []byte) bool {
+	if len(p) < eapHeaderLen+macLen+4 {
+		return false
+	}
+	mac := append([]byte{}, p[len(p)-macLen:]...)
+	zeroed := append([]byte{}, p...)
+	copy(zeroed[len(zeroed)-macLen:], make([]byte, macLen))
+	return bytes.Equal(mac, AkaPrimeMac(kAut, zeroed))
+}
+
+func newAkaPrimeChallenge(identifier byte, rand, autn []byte, servingNetworkName string, kAut []byte) []byte {
+	kdfInput := make([]byte, 2, 2+len(servingNetworkName))
+	binary.BigEndian.PutUint16(kdfInput, uint16(len(servingNetworkName)))
+	kdfInput = append(kdfInput, servingNetworkName...)
+	p := newAkaPrimePacket(eapCodeRequest, identifier, subtypeChallenge,
+		newAttribute(atRand, append([]byte{0, 0}, rand...)),
+		newAttribute(atAutn, append([]byte{0, 0}, autn...)),
+		newAttribute(atKdf, []byte{0, kdfAkaPrimeSha256}),
+		newAttribute(atKdfInput, kdfInput),
+		newAttribute(atMac, make([]byte, 2+macLen)))
+	setMac(p, kAut)
+	return p
+}
+
+// AkaPrimeChallengeResponse simulates a UE with the given subscriber key & OPc and returns
+// its EAP-Response/AKA'-Challenge to the given EAP-Request/AKA'-Challenge
+func AkaPrimeChallengeResponse(key, opc []byte, identity string, challenge []byte) ([]byte, error) {
+	subtype, attributes, err := parseAkaPrimePacket(challenge)
+	if err != nil {
+		return nil, err
+	}
+	if challenge[0] != eapCodeRequest || subtype != subtypeChallenge {
+		return nil, fmt.Errorf("not an EAP-Request/AKA'-Challenge")
+	}
+	rand, autn, kdfInput := attributes[atRand], attributes[atAutn], attributes[atKdfInput]
+	if len(rand) != 18 || len(autn) != 18 || len(kdfInput) < 2 {
+		return nil, fmt.Errorf("missing or invalid AT_RAND, AT_AUTN or AT_KDF_INPUT")
+	}
+	rand, autn = rand[2:], autn[2:]
+	servingNetworkName := string(kdfInput[2 : 2+int(binary.BigEndian.Uint16(kdfInput))])
+
+	res, ck, ik, err := UeResponse(key, opc, rand)
+	if err != nil {
+		return nil, err
+	}
+	ckPrime, ikPrime := CkIkPrime(ck, ik, servingNetworkName, autn[:6])
+	kAut, _, _ := AkaPrimeKeys(ckPrime, ikPrime, identity)
+	if !verifyMac(challenge, kAut) {
+		return nil, fmt.Errorf("invalid AT_MAC of EAP-Request/AKA'-Challenge")
+	}
+	resValue := make([]byte, 2, 2+len(res))
+	binary.BigEndian.PutUint16(resValue, uint16(len(res)*8))
+	resValue = append(resValue, res...)
+	p := newAkaPrimePacket(eapCodeResponse, challenge[1], subtypeChallenge,
+		newAttribute(atRes, resValue),
+		newAttribute(atMac, make([]byte, 2+macLen)))
+	setMac(p, kAut)
+	return p, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_ausf_udm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/labstack/echo/v4"
+
+	uecm_server "magma/feg/gateway/sbi/specs/TS29503NudmUECMServer"
+	sbi_CommonData "magma/feg/gateway/sbi/specs/TS29571CommonData"
+)
+
+// udmServer implements Nudm_UECM handlers of the mock, only AMF registration for 3GPP access is supported
+type udmServer struct {
+	*MockAusfUdm
+}
+
+// TGppRegistration handles PUT /{ueId}/registrations/amf-3gpp-access
+func (srv *udmServer) TGppRegistration(ctx echo.Context, ueId sbi_CommonData.Supi) error {
+	var reg uecm_server.Amf3GppAccessRegistration
+	err := ctx.Bind(&reg)
+	if err != nil {
+		glog.Errorf("TGppRegistration error binding the request body: %s", err)
+		return problem(ctx, http.StatusBadRequest, "MANDATORY_IE_INCORRECT")
+	}
+	srv.Lock()
+	defer srv.Unlock()
+	if _, found := srv.subscribers[string(ueId)]; !found {
+		return problem(ctx, http.StatusNotFound, "USER_NOT_FOUND")
+	}
+	_, replaced := srv.registrations[string(ueId)]
+	srv.registrations[string(ueId)] = &reg
+	if replaced {
+		return ctx.JSON(http.StatusOK, reg)
+	}
+	ctx.Response().Header()["Location"] = []string{
+		fmt.Sprintf("%s%s/%s/registrations/amf-3gpp-access", srv.apiRoot, UdmUecmBasePath, ueId)}
+	return ctx.JSON(http.StatusCreated, reg)
+}
+
+// Update3GppRegistration handles PATCH /{ueId}/registrations/amf-3gpp-access
+func (srv *udmServer) Update3GppRegistration(ctx echo.Context, ueId sbi_CommonData.Supi) error {
+	// echo binder doesn't support application/merge-patch+json
+	var modification uecm_server.Amf3GppAccessRegistrationModification
+	err := json.NewDecoder(ctx.Request().Body).Decode(&modification)
+	if err != nil {
+		glog.Errorf("Update3GppRegistration error decoding the request body: %s", err)
+		return problem(ctx, http.StatusBadRequest, "MANDATORY_IE_INCORRECT")
+	}
+	srv.Lock()
+	defer srv.Unlock()
+	reg, found := srv.registrations[string(ueId)]
+	if !found {
+		return problem(ctx, http.StatusNotFound, "CONTEXT_NOT_FOUND")
+	}
+	if reg.Guami != modification.Guami {
+		return problem(ctx, http.StatusForbidden, "INVALID_GUAMI")
+	}
+	if modification.PurgeFlag != nil && bool(*modification.PurgeFlag) {
+		delete(srv.registrations, string(ueId))
+		return ctx.NoContent(http.StatusNoContent)
+	}
+	if modification.Pei != nil {
+		reg.Pei = modification.Pei
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// Get3GppRegistration handles GET /{ueId}/registrations/amf-3gpp-access
+func (srv *udmServer) Get3GppRegistration(
+	ctx echo.Context, ueId sbi_CommonData.VarUeId, _ uecm_server.Get3GppRegistrationParams,
+) error {
+	srv.Lock()
+	defer srv.Unlock()
+	reg, found := srv.registrations[string(ueId)]
+	if !found {
+		return problem(ctx, http.StatusNotFound, "CONTEXT_NOT_FOUND")
+	}
+	return ctx.JSON(http.StatusOK, reg)
+}
+
+// GetNon3GppRegistration is not supported by the mock
+func (srv *udmServer) GetNon3GppRegistration(
+	ctx echo.Context, _ sbi_CommonData.VarUeId, _ uecm_server.GetNon3GppRegistrationParams,
+) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// UpdateNon3GppRegistration is not supported by the mock
+func (srv *udmServer) UpdateNon3GppRegistration(ctx echo.Context, _ sbi_CommonData.Supi) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// Non3GppRegistration is not supported by the mock
+func (srv *udmServer) Non3GppRegistration(ctx echo.Context, _ sbi_CommonData.Supi) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// SmfDeregistration is not supported by the mock
+func (srv *udmServer) SmfDeregistration(ctx echo.Context, _ sbi_CommonData.Supi, _ sbi_CommonData.PduSessionId) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// Registration is not supported by the mock
+func (srv *udmServer) Registration(ctx echo.Context, _ sbi_CommonData.Supi, _ sbi_CommonData.PduSessionId) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// TGppSmsfDeregistration is not supported by the mock
+func (srv *udmServer) TGppSmsfDeregistration(ctx echo.Context, _ sbi_CommonData.Supi) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// Get3GppSmsfRegistration is not supported by the mock
+func (srv *udmServer) Get3GppSmsfRegistration(
+	ctx echo.Context, _ sbi_CommonData.Gpsi, _ uecm_server.Get3GppSmsfRegistrationParams,
+) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// TGppSmsfRegistration is not supported by the mock
+func (srv *udmServer) TGppSmsfRegistration(ctx echo.Context, _ sbi_CommonData.Supi) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// Non3GppSmsfDeregistration is not supported by the mock
+func (srv *udmServer) Non3GppSmsfDeregistration(ctx echo.Context, _ sbi_CommonData.Supi) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// GetNon3GppSmsfRegistration is not supported by the mock
+func (srv *udmServer) GetNon3GppSmsfRegistration(
+	ctx echo.Context, _ sbi_CommonData.Gpsi, _ uecm_server.GetNon3GppSmsfRegistrationParams,
+) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
+
+// Non3GppSmsfRegistration is not supported by the mock
+func (srv *udmServer) Non3GppSmsfRegistration(ctx echo.Context, _ sbi_CommonData.Supi) error {
+	return ctx.NoContent(http.StatusNotImplemented)
+}
@@ -27,6 +27,7 @@ import (
 
 	"magma/feg/cloud/go/protos/mconfig"
 	"magma/feg/gateway/diameter"
+	swx "magma/feg/gateway/services/swx_proxy/servicers"
 	"magma/feg/gateway/services/testcore/hss/storage"
 	lteprotos "magma/lte/cloud/go/protos"
 	"magma/orc8r/lib/go/protos"
@@ -155,6 +156,9 @@ func (srv *HomeSubscriberServer) Start(started chan string) error {
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.RegistrationTermination, Request: false},
 		handleRTA(srv))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: swx.PushProfile, Request: false},
+		handlePPA(srv))
 
 	clientCfg := diameter.DiameterClientConfig{}
 	clientCfg.FillInDefaults()
@@ -157,9 +157,111 @@ func TestRTR_UnknownIMSI(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPPR_SuccessfulProfileUpdate(t *testing.T) {
+	hss := getTestHSSDiameterServer(t)
+	relay := &successfulMockRelay{}
+	swxProxy := getTestSwxProxyWithRelay(t, hss, true, true, relay)
+	mar := &fegprotos.AuthenticationRequest{
+		UserName:             "sub1",
+		SipNumAuthVectors:    1,
+		AuthenticationScheme: fegprotos.AuthenticationScheme_EAP_AKA,
+	}
+	maa, err := swxProxy.Authenticate(context.Background(), mar)
+	assert.NoError(t, err)
+	assert.Equal(t, "12345", maa.GetUserProfile().GetMsisdn())
+
+	sub := &lteprotos.SubscriberID{Id: "sub1"}
+	subData, err := hss.GetSubscriberData(context.Background(), sub)
+	assert.NoError(t, err)
+	subData.Non_3Gpp.Msisdn = "67890"
+	subData.Non_3Gpp.Ambr = &lteprotos.AggregatedMaximumBitrate{MaxBandwidthUl: 1000, MaxBandwidthDl: 2000}
+	_, err = hss.UpdateSubscriber(context.Background(), &lteprotos.SubscriberUpdate{Data: subData})
+	assert.NoError(t, err)
+
+	err = hss.PushProfile(subData)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, len(relay.pprs))
+	ppr := relay.pprs[0]
+	assert.Equal(t, "sub1", string(ppr.UserName))
+	assert.NotNil(t, ppr.UserData)
+	assert.Equal(t, "67890", string(ppr.UserData.SubscriptionId.SubscriptionIdData))
+	assert.Equal(t, uint32(1000), ppr.UserData.AMBR.MaxRequestedBandwidthUL)
+	assert.Equal(t, uint32(2000), ppr.UserData.AMBR.MaxRequestedBandwidthDL)
+	assert.Equal(t, 1, len(ppr.UserData.APNConfigurations))
+
+	// the next authentication is served from the cache with the updated profile
+	maa, err = swxProxy.Authenticate(context.Background(), mar)
+	assert.NoError(t, err)
+	assert.Equal(t, "67890", maa.GetUserProfile().GetMsisdn())
+	assert.Equal(t, uint32(1000), maa.GetUserProfile().GetAmbr().GetMaxBandwidthUl())
+	assert.Equal(t, uint32(2000), maa.GetUserProfile().GetAmbr().GetMaxBandwidthDl())
+	assert.Equal(t, 1, len(maa.GetUserProfile().GetApnConfigurations()))
+}
+
+func TestPPR_AccessBarred(t *testing.T) {
+	hss := getTestHSSDiameterServer(t)
+	relay := &successfulMockRelay{}
+	swxProxy := getTestSwxProxyWithRelay(t, hss, true, true, relay)
+	mar := &fegprotos.AuthenticationRequest{
+		UserName:             "sub1",
+		SipNumAuthVectors:    1,
+		AuthenticationScheme: fegprotos.AuthenticationScheme_EAP_AKA,
+	}
+	_, err := swxProxy.Authenticate(context.Background(), mar)
+	assert.NoError(t, err)
+
+	sub := &lteprotos.SubscriberID{Id: "sub1"}
+	subData, err := hss.GetSubscriberData(context.Background(), sub)
+	assert.NoError(t, err)
+	subData.Non_3Gpp.Non_3GppIpAccess = lteprotos.Non3GPPUserProfile_NON_3GPP_SUBSCRIPTION_BARRED
+	_, err = hss.UpdateSubscriber(context.Background(), &lteprotos.SubscriberUpdate{Data: subData})
+	assert.NoError(t, err)
+
+	err = hss.PushProfile(subData)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(relay.pprs))
+
+	// cached vectors must be dropped & the new MAR rejected
+	_, err = swxProxy.Authenticate(context.Background(), mar)
+	assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = User sub1 is not authorized for Non-3GPP Subscription Access")
+}
+
+func TestPPR_UnsuccessfulRelay(t *testing.T) {
+	hss := getTestHSSDiameterServer(t)
+	swxProxy := getTestSwxProxy(t, hss, false, true, false)
+	_, err := swxProxy.Register(context.Background(), &fegprotos.RegistrationRequest{UserName: "sub1"})
+	assert.NoError(t, err)
+
+	subData, err := hss.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+	err = hss.PushProfile(subData)
+	assert.Error(t, err)
+}
+
+func TestPPR_UnknownAAAServer(t *testing.T) {
+	hss := getTestHSSDiameterServer(t)
+	subData, err := hss.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+	subData.State.TgppAaaServerName = ""
+	err = hss.PushProfile(subData)
+	assert.Error(t, err)
+}
+
 // getTestSwxProxy creates a SWx Proxy server and test HSS Diameter
 // server which are configured to communicate with each other.
 func getTestSwxProxy(t *testing.T, hss *hss.HomeSubscriberServer, verifyAuthr, wCache bool, successfulRelay bool) fegprotos.SwxProxyServer {
+	if successfulRelay {
+		return getTestSwxProxyWithRelay(t, hss, verifyAuthr, wCache, &successfulMockRelay{})
+	}
+	return getTestSwxProxyWithRelay(t, hss, verifyAuthr, wCache, &unsuccessfulMockRelay{})
+}
+
+// getTestSwxProxyWithRelay creates a SWx Proxy server with the given relay
+// and test HSS Diameter server which are configured to communicate with each other.
+func getTestSwxProxyWithRelay(
+	t *testing.T, hss *hss.HomeSubscriberServer, verifyAuthr, wCache bool, relay swx.Relay) fegprotos.SwxProxyServer {
+
 	serverCfg := hss.Config.Server
 
 	// Create an swx proxy server.
@@ -191,16 +293,14 @@ func getTestSwxProxy(t *testing.T, hss *hss.HomeSubscriberServer, verifyAuthr, w
 		vc = cache.New()
 	}
 	swxProxy, err := swx.NewSwxProxyWithCache(swxProxyConfig, vc)
-	if successfulRelay {
-		swxProxy.Relay = &successfulMockRelay{}
-	} else {
-		swxProxy.Relay = &unsuccessfulMockRelay{}
-	}
 	assert.NoError(t, err)
+	swxProxy.Relay = relay
 	return swxProxy
 }
 
-type successfulMockRelay struct{}
+type successfulMockRelay struct {
+	pprs []*swx.PPR
+}
 
 func (s *successfulMockRelay) RelayRTR(*swx.RTR) (fegprotos.ErrorCode, error) {
 	return fegprotos.ErrorCode_SUCCESS, nil
@@ -210,6 +310,11 @@ func (s *successfulMockRelay) RelayASR(*diameter.ASR) (fegprotos.ErrorCode, erro
 	return fegprotos.ErrorCode_SUCCESS, nil
 }
 
+func (s *successfulMockRelay) RelayPPR(ppr *swx.PPR) (fegprotos.ErrorCode, error) {
+	s.pprs = append(s.pprs, ppr)
+	return fegprotos.ErrorCode_SUCCESS, nil
+}
+
 type unsuccessfulMockRelay struct{}
 
 func (s *unsuccessfulMockRelay) RelayRTR(*swx.RTR) (fegprotos.ErrorCode, error) {
@@ -219,3 +324,7 @@ func (s *unsuccessfulMockRelay) RelayRTR(*swx.RTR) (fegprotos.ErrorCode, error)
 func (s *unsuccessfulMockRelay) RelayASR(*diameter.ASR) (fegprotos.ErrorCode, error) {
 	return fegprotos.ErrorCode_UNABLE_TO_DELIVER, nil
 }
+
+func (s *unsuccessfulMockRelay) RelayPPR(*swx.PPR) (fegprotos.ErrorCode, error) {
+	return fegprotos.ErrorCode_UNABLE_TO_DELIVER, nil
+}
@@ -25,6 +25,7 @@ import (
 
 	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/eap/providers/akaprime"
 	swx "magma/feg/gateway/services/swx_proxy/servicers"
 	"magma/feg/gateway/services/testcore/hss/storage"
 	lteprotos "magma/lte/cloud/go/protos"
@@ -78,7 +79,14 @@ func NewMAA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return ConvertAuthErrorToFailureMessage(err, msg, mar.SessionID, srv.Config.Server), err
 	}
 
-	if mar.AuthData.AuthScheme != swx.SipAuthScheme_EAP_AKA {
+	switch mar.AuthData.AuthScheme {
+	case swx.SipAuthScheme_EAP_AKA:
+	case swx.SipAuthScheme_EAP_AKA_PRIME:
+		if len(mar.ANID) == 0 {
+			return ConstructFailureAnswer(msg, mar.SessionID, srv.Config.Server, uint32(diam.MissingAVP)),
+				errors.New("ANID is required for EAP-AKA' authentication")
+		}
+	default:
 		err = fmt.Errorf("Unsupported SIP authentication scheme: %s", mar.AuthData.AuthScheme)
 		return ConstructFailureAnswer(msg, mar.SessionID, srv.Config.Server, uint32(diam.UnableToComply)), err
 	}
@@ -96,20 +104,35 @@ func NewMAA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		}
 	}
 
-	return srv.NewSuccessfulMAA(msg, mar.SessionID, datatype.UTF8String(mar.UserName), vectors), nil
+	if mar.AuthData.AuthScheme == swx.SipAuthScheme_EAP_AKA_PRIME {
+		for _, vector := range vectors {
+			ckPrime, ikPrime := akaprime.MakeCKIKPrime(
+				vector.ConfidentialityKey[:], vector.IntegrityKey[:], mar.ANID, vector.Autn[:akaprime.SQN_XOR_AK_LEN])
+			copy(vector.ConfidentialityKey[:], ckPrime)
+			copy(vector.IntegrityKey[:], ikPrime)
+		}
+	}
+	return srv.NewSuccessfulMAA(msg, mar.SessionID, datatype.UTF8String(mar.UserName), mar.AuthData.AuthScheme, vectors), nil
 }
 
 // NewSuccessfulMAA outputs a successful multimedia authentication answer (MAA) to reply to an
 // multimedia authentication request (MAR) message. It populates the MAA with all of the mandatory fields
 // and adds the authentication vectors. See 3GPP TS 29.273 table 8.1.2.1.1/5.
-func (srv *HomeSubscriberServer) NewSuccessfulMAA(msg *diam.Message, sessionID datatype.UTF8String, userName datatype.UTF8String, vectors []*milenage.SIPAuthVector) *diam.Message {
+// For EAP-AKA' the vectors must carry CK' & IK' instead of CK & IK.
+func (srv *HomeSubscriberServer) NewSuccessfulMAA(
+	msg *diam.Message,
+	sessionID datatype.UTF8String,
+	userName datatype.UTF8String,
+	authScheme string,
+	vectors []*milenage.SIPAuthVector) *diam.Message {
+
 	maa := ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_SWX_APP_ID)
 	for itemNumber, vector := range vectors {
 		authenticate := append(vector.Rand[:], vector.Autn[:]...)
 		maa.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 			AVP: []*diam.AVP{
 				diam.NewAVP(avp.SIPItemNumber, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(itemNumber)),
-				diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(swx.SipAuthScheme_EAP_AKA)),
+				diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(authScheme)),
 				diam.NewAVP(avp.SIPAuthenticate, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(authenticate)),
 				diam.NewAVP(avp.SIPAuthorization, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(vector.Xres[:])),
 				diam.NewAVP(avp.ConfidentialityKey, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(vector.ConfidentialityKey[:])),
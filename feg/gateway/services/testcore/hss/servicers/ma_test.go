@@ -26,6 +26,7 @@ import (
 
 	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/eap/providers/akaprime"
 	definitions "magma/feg/gateway/services/swx_proxy/servicers"
 	hss "magma/feg/gateway/services/testcore/hss/servicers"
 	"magma/feg/gateway/services/testcore/hss/servicers/test_utils"
@@ -110,6 +111,42 @@ func TestNewMAA_MultipleVectors(t *testing.T) {
 	checkSIPAuthVectors(t, maa, 3)
 }
 
+func TestNewMAA_EapAkaPrime(t *testing.T) {
+	server := test_utils.NewTestHomeSubscriberServer(t)
+	mar := createMARWithAuthScheme("sub1", definitions.SipAuthScheme_EAP_AKA_PRIME, "WLAN")
+	response, err := hss.NewMAA(server, mar)
+	assert.NoError(t, err)
+
+	var maa definitions.MAA
+	err = response.Unmarshal(&maa)
+	assert.NoError(t, err)
+	assert.Equal(t, diam.Success, int(maa.ResultCode))
+	assert.Len(t, maa.SIPAuthDataItems, 1)
+	vector := maa.SIPAuthDataItems[0]
+	assert.Equal(t, definitions.SipAuthScheme_EAP_AKA_PRIME, vector.AuthScheme)
+
+	// CK' & IK' are bound to the ANID
+	subscriber, err := server.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+	authenticate := vector.Authenticate.Serialize()
+	rand, autn := authenticate[:milenage.RandChallengeBytes], authenticate[milenage.RandChallengeBytes:]
+	ck, err := milenage.F3(subscriber.Lte.AuthKey, rand, subscriber.Lte.AuthOpc)
+	assert.NoError(t, err)
+	ik, err := milenage.F4(subscriber.Lte.AuthKey, rand, subscriber.Lte.AuthOpc)
+	assert.NoError(t, err)
+	ckPrime, ikPrime := akaprime.MakeCKIKPrime(ck, ik, "WLAN", autn[:akaprime.SQN_XOR_AK_LEN])
+	assert.Equal(t, ckPrime, []byte(vector.ConfidentialityKey))
+	assert.Equal(t, ikPrime, []byte(vector.IntegrityKey))
+
+	// ANID is mandatory for EAP-AKA'
+	mar = createMARWithAuthScheme("sub1", definitions.SipAuthScheme_EAP_AKA_PRIME, "")
+	response, err = hss.NewMAA(server, mar)
+	assert.EqualError(t, err, "ANID is required for EAP-AKA' authentication")
+	err = response.Unmarshal(&maa)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.MissingAVP), maa.ExperimentalResult.ExperimentalResultCode)
+}
+
 func TestNewMAA_MissingAVP(t *testing.T) {
 	mar := createBaseMAR()
 	mar.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(definitions.RadioAccessTechnologyType_WLAN))
@@ -246,6 +283,22 @@ func createMARExtended(userName string, numberAuthItems uint32, ratType uint32)
 	return mar
 }
 
+func createMARWithAuthScheme(userName string, authScheme string, anid string) *diam.Message {
+	mar := createBaseMAR()
+	mar.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(userName))
+	mar.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(definitions.RadioAccessTechnologyType_WLAN))
+	if len(anid) > 0 {
+		mar.NewAVP(avp.ANID, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(anid))
+	}
+	mar.NewAVP(avp.SIPNumberAuthItems, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(1))
+	mar.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(authScheme)),
+		},
+	})
+	return mar
+}
+
 func checkSIPAuthVectors(t *testing.T, maa definitions.MAA, expectedNumVectors uint32) {
 	assert.Equal(t, int(expectedNumVectors), len(maa.SIPAuthDataItems))
 	assert.Equal(t, expectedNumVectors, maa.SIPNumberAuthItems)
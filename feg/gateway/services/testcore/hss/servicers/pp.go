@@ -0,0 +1,119 @@
+/*
+ * Copyright 2020 The Magma Authors.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package servicers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"magma/feg/gateway/diameter"
+	swx "magma/feg/gateway/services/swx_proxy/servicers"
+	"magma/lte/cloud/go/protos"
+)
+
+// PushProfile sends the subscriber's current Non-3GPP profile to the AAA server serving the subscriber
+// using PPR and waits for PPA
+func (srv *HomeSubscriberServer) PushProfile(sub *protos.SubscriberData) error {
+	if sub.GetState().GetTgppAaaServerName() == "" {
+		return fmt.Errorf("No AAA server found for subscriber: %s. Cannot send PPR", sub.GetSid().GetId())
+	}
+	if len(sub.GetNon_3Gpp().GetApnConfig()) == 0 {
+		return fmt.Errorf("No Non-3GPP APN configuration found for subscriber: %s. Cannot send PPR", sub.GetSid().GetId())
+	}
+	aaaServerCfg, err := srv.genAAAServerConfig(sub.GetState().GetTgppAaaServerName())
+	if err != nil {
+		return fmt.Errorf("PushProfile error: %s", err)
+	}
+	sid := (&diameter.DiameterClientConfig{}).GenSessionID("swx")
+
+	ch := make(chan interface{})
+	srv.requestTracker.RegisterRequest(sid, ch)
+	// if request hasn't been removed by end of transaction, remove it
+	defer srv.requestTracker.DeregisterRequest(sid)
+
+	pprMsg := srv.createPPR(sid, sub)
+	err = srv.sendDiameterMsg(pprMsg, aaaServerCfg, maxDiamRetries)
+	if err != nil {
+		return err
+	}
+	select {
+	case resp, open := <-ch:
+		if !open {
+			err = status.Errorf(codes.Aborted, "PPA for Session ID: %s is canceled", sid)
+			glog.Error(err)
+			return err
+		}
+		ppa, ok := resp.(*swx.PPA)
+		if !ok {
+			err = status.Errorf(codes.Internal, "Invalid Response Type: %T, PPA expected.", resp)
+			glog.Error(err)
+			return err
+		}
+		if err = diameter.TranslateDiamResultCode(ppa.ResultCode); err != nil {
+			return err
+		}
+		// If there is no base diameter error, check that there is no experimental error either
+		return diameter.TranslateDiamResultCode(ppa.ExperimentalResult.ExperimentalResultCode)
+
+	case <-time.After(time.Second * timeoutSeconds):
+		err = status.Errorf(codes.DeadlineExceeded, "PPA Timed Out for Session ID: %s", sid)
+		glog.Error(err)
+		return err
+	}
+}
+
+// createPPR creates a Push Profile Request with provided SessionID (sid)
+// and subscriber's Non-3GPP-User-Data to be sent over diameter to AAA Server
+func (srv *HomeSubscriberServer) createPPR(sessionID string, sub *protos.SubscriberData) *diam.Message {
+	msg := diameter.NewProxiableRequest(swx.PushProfile, diam.TGPP_SWX_APP_ID, dict.Default)
+	msg.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	msg.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.TGPP_SWX_APP_ID)),
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+		},
+	})
+	msg.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
+	// Set origin host and realm to server's host and realm since PPR is sent from HSS
+	msg.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(srv.Config.Server.DestHost))
+	msg.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(srv.Config.Server.DestRealm))
+	msg.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(sub.GetSid().GetId()))
+	msg.AddAVP(getNon3GPPUserDataAVP(sub.GetNon_3Gpp()))
+	return msg
+}
+
+func handlePPA(srv *HomeSubscriberServer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var ppa swx.PPA
+		err := m.Unmarshal(&ppa)
+		if err != nil {
+			glog.Errorf("PPA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		ch := srv.requestTracker.DeregisterRequest(ppa.SessionID)
+		if ch != nil {
+			ch <- &ppa
+		} else {
+			glog.Errorf("PPA SessionID %s not found. Message: %s, Remote: %s", ppa.SessionID, m, c.RemoteAddr())
+		}
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mock_rx
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/rx_proxy/rx"
+)
+
+// AF is a mock Rx application function (P-CSCF) client
+type AF struct {
+	conn     diam.Conn
+	settings *sm.Settings
+	answers  chan *diam.Message
+	// Requests receives all PCRF initiated requests (RAR/ASR) delivered to the AF,
+	// the AF answers them with the Result-Code set by SetResultCode, success by default
+	Requests chan *diam.Message
+
+	mu         sync.Mutex
+	resultCode uint32
+}
+
+// NewAF creates a new mock AF connected to the Rx server at the given address
+func NewAF(host, realm, network, addr string) (*AF, error) {
+	af := &AF{
+		settings: &sm.Settings{
+			OriginHost:       datatype.DiameterIdentity(host),
+			OriginRealm:      datatype.DiameterIdentity(realm),
+			VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
+			ProductName:      "magma-mock-af",
+			OriginStateID:    datatype.Unsigned32(time.Now().Unix()),
+			FirmwareRevision: 1,
+		},
+		answers:    make(chan *diam.Message, 8),
+		Requests:   make(chan *diam.Message, 8),
+		resultCode: diam.Success,
+	}
+	mux := sm.New(af.settings)
+	for _, cmd := range []uint32{diam.AA, diam.SessionTermination} {
+		mux.HandleIdx(diam.CommandIndex{AppID: rx.RxApplicationID, Code: cmd, Request: false},
+			diam.HandlerFunc(func(_ diam.Conn, m *diam.Message) { af.answers <- m }))
+	}
+	for _, cmd := range []uint32{diam.ReAuth, diam.AbortSession} {
+		mux.HandleIdx(diam.CommandIndex{AppID: rx.RxApplicationID, Code: cmd, Request: true},
+			diam.HandlerFunc(func(c diam.Conn, m *diam.Message) {
+				af.mu.Lock()
+				resultCode := af.resultCode
+				af.mu.Unlock()
+				answerMessage(c, m, resultCode, af.settings)
+				af.Requests <- m
+			}))
+	}
+	go func() {
+		for range mux.ErrorReports() {
+		}
+	}()
+	cli := &sm.Client{
+		Dict:               dict.Default,
+		Handler:            mux,
+		MaxRetransmits:     1,
+		RetransmitInterval: time.Second,
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(rx.RxApplicationID)),
+		},
+		VendorSpecificApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+				AVP: []*diam.AVP{
+					diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(rx.RxApplicationID)),
+					diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+				},
+			}),
+		},
+	}
+	conn, err := cli.DialNetwork(network, addr)
+	if err != nil {
+		return nil, err
+	}
+	af.conn = conn
+	return af, nil
+}
+
+// Close closes AF's connection
+func (af *AF) Close() {
+	af.conn.Close()
+}
+
+// SetResultCode sets Result-Code the AF answers PCRF initiated requests with
+func (af *AF) SetResultCode(resultCode uint32) {
+	af.mu.Lock()
+	af.resultCode = resultCode
+	af.mu.Unlock()
+}
+
+// SendAAR sends AAR for the subscriber's session with the given media components and returns AAA Result-Code
+func (af *AF) SendAAR(sessionID, imsi string, mcds []rx.MediaComponentDescription) (uint32, error) {
+	m := af.newRequest(diam.AA, sessionID)
+	if len(imsi) > 0 {
+		m.NewAVP(avp.SubscriptionID, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.SubscriptionIDType, avp.Mbit, 0, datatype.Enumerated(rx.EndUserIMSI)),
+				diam.NewAVP(avp.SubscriptionIDData, avp.Mbit, 0, datatype.UTF8String(imsi)),
+			},
+		})
+	}
+	for i := range mcds {
+		m.AddAVP(mcds[i].ToAVP())
+	}
+	return af.send(m)
+}
+
+// SendSTR sends STR for the session and returns STA Result-Code
+func (af *AF) SendSTR(sessionID string) (uint32, error) {
+	m := af.newRequest(diam.SessionTermination, sessionID)
+	m.NewAVP(avp.TerminationCause, avp.Mbit, 0, datatype.Enumerated(1)) // DIAMETER_LOGOUT
+	return af.send(m)
+}
+
+func (af *AF) newRequest(cmd uint32, sessionID string) *diam.Message {
+	m := diameter.NewProxiableRequest(cmd, rx.RxApplicationID, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(rx.RxApplicationID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, af.settings.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, af.settings.OriginRealm)
+	if meta, ok := smpeer.FromContext(af.conn.Context()); ok {
+		m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, meta.OriginRealm)
+	}
+	return m
+}
+
+func (af *AF) send(m *diam.Message) (uint32, error) {
+	if _, err := m.WriteTo(af.conn); err != nil {
+		return 0, err
+	}
+	select {
+	case ans := <-af.answers:
+		var answer rx.Answer
+		if err := ans.Unmarshal(&answer); err != nil {
+			return 0, err
+		}
+		return answer.GetResultCode(), nil
+	case <-time.After(answerTimeout):
+		return 0, fmt.Errorf("timed out waiting for answer to Rx request %d", m.Header.CommandCode)
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mock_rx provides mock Rx PCRF & AF diameter peers for testing
+package mock_rx
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/rx_proxy/rx"
+)
+
+const answerTimeout = 5 * time.Second
+
+// PCRF is a mock Rx PCRF which authorizes all AF sessions & can initiate RAR/ASR
+type PCRF struct {
+	mux        *sm.StateMachine
+	resultCode uint32
+
+	sync.Mutex
+	sessions map[string]diam.Conn // Rx Session-Id -> connection the session was authorized on
+	answers  chan *diam.Message
+	lastAAR  *rx.AAR
+}
+
+// NewPCRF creates a new mock Rx PCRF with the given diameter identity
+func NewPCRF(host, realm string) *PCRF {
+	srv := &PCRF{
+		resultCode: diam.Success,
+		sessions:   map[string]diam.Conn{},
+		answers:    make(chan *diam.Message, 8),
+	}
+	srv.mux = sm.New(&sm.Settings{
+		OriginHost:       datatype.DiameterIdentity(host),
+		OriginRealm:      datatype.DiameterIdentity(realm),
+		VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
+		ProductName:      "magma-mock-rx-pcrf",
+		OriginStateID:    datatype.Unsigned32(time.Now().Unix()),
+		FirmwareRevision: 1,
+	})
+	srv.mux.HandleIdx(diam.CommandIndex{AppID: rx.RxApplicationID, Code: diam.AA, Request: true},
+		diam.HandlerFunc(srv.handleAAR))
+	srv.mux.HandleIdx(diam.CommandIndex{AppID: rx.RxApplicationID, Code: diam.SessionTermination, Request: true},
+		diam.HandlerFunc(srv.handleSTR))
+	for _, cmd := range []uint32{diam.ReAuth, diam.AbortSession} {
+		srv.mux.HandleIdx(diam.CommandIndex{AppID: rx.RxApplicationID, Code: cmd, Request: false},
+			diam.HandlerFunc(func(_ diam.Conn, m *diam.Message) { srv.answers <- m }))
+	}
+	go func() {
+		for err := range srv.mux.ErrorReports() {
+			glog.Errorf("mock Rx PCRF error: %v", err)
+		}
+	}()
+	return srv
+}
+
+// Start starts the PCRF on the given listener, it blocks until the listener is closed
+func (srv *PCRF) Start(lis net.Listener) error {
+	server := &diam.Server{Handler: srv.mux, Dict: dict.Default}
+	return server.Serve(lis)
+}
+
+// SetResultCode sets Result-Code the PCRF answers all AARs with
+func (srv *PCRF) SetResultCode(resultCode uint32) {
+	srv.Lock()
+	srv.resultCode = resultCode
+	srv.Unlock()
+}
+
+// LastAAR returns the last AAR received by the PCRF
+func (srv *PCRF) LastAAR() *rx.AAR {
+	srv.Lock()
+	defer srv.Unlock()
+	return srv.lastAAR
+}
+
+// ReAuth sends Rx RAR with given specific actions & media components for the session and returns RAA Result-Code
+func (srv *PCRF) ReAuth(
+	sessionID string, specificActions []uint32, mcds []rx.MediaComponentDescription) (uint32, error) {
+
+	extraAVPs := make([]*diam.AVP, 0, len(specificActions)+len(mcds))
+	for _, action := range specificActions {
+		extraAVPs = append(extraAVPs,
+			diam.NewAVP(rx.AVPSpecificAction, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(action)))
+	}
+	for i := range mcds {
+		extraAVPs = append(extraAVPs, mcds[i].ToAVP())
+	}
+	return srv.sendRequest(diam.ReAuth, sessionID, extraAVPs)
+}
+
+// AbortSession sends Rx ASR for the session and returns ASA Result-Code
+func (srv *PCRF) AbortSession(sessionID string) (uint32, error) {
+	return srv.sendRequest(diam.AbortSession, sessionID, []*diam.AVP{
+		diam.NewAVP(rx.AVPAbortCause, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(0)),
+	})
+}
+
+func (srv *PCRF) sendRequest(cmd uint32, sessionID string, extraAVPs []*diam.AVP) (uint32, error) {
+	srv.Lock()
+	conn, ok := srv.sessions[sessionID]
+	srv.Unlock()
+	if !ok {
+		return 0, fmt.Errorf("unknown Rx session %s", sessionID)
+	}
+	meta, ok := smpeer.FromContext(conn.Context())
+	if !ok {
+		return 0, fmt.Errorf("peer metadata unavailable")
+	}
+	settings := srv.mux.Settings()
+	m := diameter.NewProxiableRequest(cmd, rx.RxApplicationID, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, settings.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, settings.OriginRealm)
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, meta.OriginRealm)
+	m.NewAVP(avp.DestinationHost, avp.Mbit, 0, meta.OriginHost)
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(rx.RxApplicationID))
+	for _, a := range extraAVPs {
+		m.AddAVP(a)
+	}
+	if _, err := m.WriteTo(conn); err != nil {
+		return 0, err
+	}
+	select {
+	case ans := <-srv.answers:
+		var answer rx.Answer
+		if err := ans.Unmarshal(&answer); err != nil {
+			return 0, err
+		}
+		return answer.GetResultCode(), nil
+	case <-time.After(answerTimeout):
+		return 0, fmt.Errorf("timed out waiting for answer to Rx request %d", cmd)
+	}
+}
+
+func (srv *PCRF) handleAAR(c diam.Conn, m *diam.Message) {
+	var aar rx.AAR
+	if err := m.Unmarshal(&aar); err != nil {
+		glog.Errorf("mock Rx PCRF received unparseable AAR: %v", err)
+		return
+	}
+	srv.Lock()
+	resultCode := srv.resultCode
+	srv.lastAAR = &aar
+	if resultCode == diam.Success {
+		srv.sessions[aar.SessionID] = c
+	}
+	srv.Unlock()
+	srv.answer(c, m, resultCode)
+}
+
+func (srv *PCRF) handleSTR(c diam.Conn, m *diam.Message) {
+	var str rx.STR
+	if err := m.Unmarshal(&str); err != nil {
+		glog.Errorf("mock Rx PCRF received unparseable STR: %v", err)
+		return
+	}
+	srv.Lock()
+	_, found := srv.sessions[str.SessionID]
+	delete(srv.sessions, str.SessionID)
+	srv.Unlock()
+	if !found {
+		srv.answer(c, m, diam.UnknownSessionID)
+		return
+	}
+	srv.answer(c, m, diam.Success)
+}
+
+func (srv *PCRF) answer(c diam.Conn, m *diam.Message, resultCode uint32) {
+	answerMessage(c, m, resultCode, srv.mux.Settings())
+}
+
+func answerMessage(c diam.Conn, m *diam.Message, resultCode uint32, settings *sm.Settings) {
+	a := m.Answer(0)
+	if sid, err := m.FindAVP(avp.SessionID, 0); err == nil && sid != nil {
+		a.NewAVP(avp.SessionID, avp.Mbit, 0, sid.Data)
+	}
+	a.NewAVP(avp.ResultCode, avp.Mbit, 0, datatype.Unsigned32(resultCode))
+	a.NewAVP(avp.OriginHost, avp.Mbit, 0, settings.OriginHost)
+	a.NewAVP(avp.OriginRealm, avp.Mbit, 0, settings.OriginRealm)
+	a.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(rx.RxApplicationID))
+	if _, err := a.WriteTo(c); err != nil {
+		glog.Errorf("failed to send Rx answer %d: %v", m.Header.CommandCode, err)
+	}
+}
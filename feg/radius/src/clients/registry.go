@@ -0,0 +1,358 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clients implements the registry of RADIUS clients (NASes) allowed to
+// talk to the server, each with its own shared secret, allowed listeners and
+// rate limit.
+package clients
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"fbc/cwf/radius/config"
+
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+var (
+	// ErrUnknownClient no client matches the request source and NAS-Identifier
+	ErrUnknownClient = errors.New("unknown RADIUS client")
+	// ErrSecretMismatch the request was validated with a secret that belongs to another client
+	ErrSecretMismatch = errors.New("RADIUS client secret mismatch")
+	// ErrListenerNotAllowed the client is not allowed to use the listener
+	ErrListenerNotAllowed = errors.New("RADIUS client not allowed on listener")
+	// ErrRateLimited the client exceeded its rate limit
+	ErrRateLimited = errors.New("RADIUS client rate limit exceeded")
+)
+
+// Client a RADIUS client (NAS) as configured in the registry
+type Client struct {
+	Name           string
+	Networks       []*net.IPNet
+	NASIdentifiers map[string]bool
+	Secret         []byte
+	Listeners      map[string]bool
+	limiter        *tokenBucket
+	config         config.ClientConfig
+}
+
+// Registry holds the set of known RADIUS clients. When the registry is empty
+// it works in legacy mode: every peer is accepted and the default secret is
+// used for all of them
+type Registry struct {
+	mu            sync.RWMutex
+	defaultSecret []byte
+	clients       []*Client
+}
+
+// NewRegistry creates a client registry from configuration
+func NewRegistry(defaultSecret []byte, clientConfigs []config.ClientConfig) (*Registry, error) {
+	registry := &Registry{defaultSecret: defaultSecret}
+	err := registry.Update(clientConfigs)
+	if err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+// Update validates the given clients & atomically replaces the registry
+// content. Rate limiter state is kept for clients whose config didn't change
+func (r *Registry) Update(clientConfigs []config.ClientConfig) error {
+	newClients := make([]*Client, 0, len(clientConfigs))
+	names := map[string]bool{}
+	for _, clientConfig := range clientConfigs {
+		client, err := newClient(clientConfig)
+		if err != nil {
+			return err
+		}
+		if names[client.Name] {
+			return fmt.Errorf("duplicate RADIUS client name '%s'", client.Name)
+		}
+		names[client.Name] = true
+		newClients = append(newClients, client)
+	}
+	err := checkSecretsUnambiguous(newClients)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, client := range newClients {
+		for _, old := range r.clients {
+			if old.Name == client.Name && old.limiter != nil && client.limiter != nil &&
+				old.config.RateLimit == client.config.RateLimit && old.config.RateBurst == client.config.RateBurst {
+				client.limiter = old.limiter
+			}
+		}
+	}
+	r.clients = newClients
+	return nil
+}
+
+func newClient(clientConfig config.ClientConfig) (*Client, error) {
+	if clientConfig.Name == "" {
+		return nil, errors.New("RADIUS client must have a name")
+	}
+	if clientConfig.Secret == "" {
+		return nil, fmt.Errorf("RADIUS client '%s' must have a secret", clientConfig.Name)
+	}
+	if len(clientConfig.CIDRs) == 0 && len(clientConfig.NASIdentifiers) == 0 {
+		return nil, fmt.Errorf("RADIUS client '%s' must have CIDRs and/or NAS-Identifiers", clientConfig.Name)
+	}
+	if clientConfig.RateLimit < 0 || clientConfig.RateBurst < 0 {
+		return nil, fmt.Errorf("RADIUS client '%s' has a negative rate limit", clientConfig.Name)
+	}
+	client := &Client{
+		Name:           clientConfig.Name,
+		NASIdentifiers: map[string]bool{},
+		Secret:         []byte(clientConfig.Secret),
+		Listeners:      map[string]bool{},
+		config:         clientConfig,
+	}
+	for _, cidr := range clientConfig.CIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("RADIUS client '%s' has an invalid CIDR: %w", clientConfig.Name, err)
+		}
+		client.Networks = append(client.Networks, network)
+	}
+	for _, nasID := range clientConfig.NASIdentifiers {
+		client.NASIdentifiers[nasID] = true
+	}
+	for _, listener := range clientConfig.Listeners {
+		client.Listeners[listener] = true
+	}
+	if clientConfig.RateLimit > 0 {
+		client.limiter = newTokenBucket(clientConfig.RateLimit, clientConfig.RateBurst)
+	}
+	return client, nil
+}
+
+// checkSecretsUnambiguous rejects clients whose secret could differ from the
+// one the request was decoded with. The secret is selected by source address
+// before the NAS-Identifier is known, so wherever the networks of two clients
+// with different secrets overlap, the most specific one must win for every
+// NAS-Identifier, i.e. it must not be restricted to NAS-Identifiers. Clients
+// without CIDRs match any source
+func checkSecretsUnambiguous(clients []*Client) error {
+	for i, a := range clients {
+		for _, b := range clients[i+1:] {
+			if bytes.Equal(a.Secret, b.Secret) {
+				continue
+			}
+			for _, na := range a.sourceNetworks() {
+				for _, nb := range b.sourceNetworks() {
+					if na != nil && nb != nil && !na.Contains(nb.IP) && !nb.Contains(na.IP) {
+						continue
+					}
+					narrower, prefixA, prefixB := a, prefixLen(na), prefixLen(nb)
+					if prefixB > prefixA {
+						narrower = b
+					}
+					if prefixA == prefixB || len(narrower.NASIdentifiers) > 0 {
+						return fmt.Errorf(
+							"RADIUS clients '%s' & '%s' have different secrets but can't be told apart by source address",
+							a.Name, b.Name)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// sourceNetworks returns the client networks, or a single nil network
+// standing for any source when the client has none
+func (c *Client) sourceNetworks() []*net.IPNet {
+	if len(c.Networks) == 0 {
+		return []*net.IPNet{nil}
+	}
+	return c.Networks
+}
+
+func prefixLen(network *net.IPNet) int {
+	if network == nil {
+		return -1
+	}
+	ones, _ := network.Mask.Size()
+	return ones
+}
+
+// IsEmpty returns true when no clients are configured (legacy mode)
+func (r *Registry) IsEmpty() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.clients) == 0
+}
+
+// Lookup returns the best matching client for the source IP & NAS-Identifier.
+// Clients matching by CIDR win over NAS-Identifier only clients, & the longest
+// matching prefix wins among CIDR clients
+func (r *Registry) Lookup(ip net.IP, nasIdentifier string) *Client {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.lookup(ip, nasIdentifier, true)
+}
+
+func (r *Registry) lookup(ip net.IP, nasIdentifier string, matchNASIdentifier bool) *Client {
+	var (
+		best       *Client
+		bestPrefix = -2
+	)
+	for _, client := range r.clients {
+		if matchNASIdentifier && len(client.NASIdentifiers) > 0 && !client.NASIdentifiers[nasIdentifier] {
+			continue
+		}
+		prefix := client.matchPrefix(ip)
+		if prefix > bestPrefix {
+			best, bestPrefix = client, prefix
+		}
+	}
+	return best
+}
+
+// matchPrefix returns the longest prefix length of the client networks
+// containing ip, -1 when the client has no networks (matches any source) & -2
+// when ip is not in any of the networks
+func (c *Client) matchPrefix(ip net.IP) int {
+	if len(c.Networks) == 0 {
+		return -1
+	}
+	best := -2
+	for _, network := range c.Networks {
+		if ip != nil && network.Contains(ip) {
+			ones, _ := network.Mask.Size()
+			if ones > best {
+				best = ones
+			}
+		}
+	}
+	return best
+}
+
+// RADIUSSecret implements radius.SecretSource. The secret is selected by the
+// source address only, since the packet can't be parsed before its secret is
+// known. Update rejects clients sharing a source network with different secrets
+// unless the most specific one matches any NAS-Identifier
+func (r *Registry) RADIUSSecret(_ context.Context, remoteAddr net.Addr) ([]byte, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.clients) == 0 || remoteAddr == nil {
+		return r.defaultSecret, nil
+	}
+	if client := r.lookup(addrIP(remoteAddr), "", false); client != nil {
+		return client.Secret, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownClient, remoteAddr)
+}
+
+// Authorize matches the request to a client & checks the client is allowed to
+// send it on the listener. A nil client & nil error are returned in legacy mode
+func (r *Registry) Authorize(listener string, request *radius.Request) (*Client, error) {
+	return r.authorize(listener, request, true)
+}
+
+// AuthorizeAuthenticatedPeer is Authorize for transports which authenticate
+// the peer by other means than the RADIUS secret, e.g. RadSec mutual TLS where
+// the secret is fixed: the client's secret isn't compared with the request's
+func (r *Registry) AuthorizeAuthenticatedPeer(listener string, request *radius.Request) (*Client, error) {
+	return r.authorize(listener, request, false)
+}
+
+func (r *Registry) authorize(listener string, request *radius.Request, checkSecret bool) (*Client, error) {
+	r.mu.RLock()
+	if len(r.clients) == 0 {
+		r.mu.RUnlock()
+		return nil, nil
+	}
+	nasIdentifier := rfc2865.NASIdentifier_GetString(request.Packet)
+	client := r.lookup(addrIP(request.RemoteAddr), nasIdentifier, true)
+	r.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("%w: %s (NAS-Identifier '%s')", ErrUnknownClient, request.RemoteAddr, nasIdentifier)
+	}
+	if checkSecret && !bytes.Equal(client.Secret, request.Secret) {
+		return client, fmt.Errorf("%w: %s", ErrSecretMismatch, client.Name)
+	}
+	if len(client.Listeners) > 0 && !client.Listeners[listener] {
+		return client, fmt.Errorf("%w: %s on %s", ErrListenerNotAllowed, client.Name, listener)
+	}
+	if client.limiter != nil && !client.limiter.allow(time.Now()) {
+		return client, fmt.Errorf("%w: %s", ErrRateLimited, client.Name)
+	}
+	return client, nil
+}
+
+func addrIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case *net.UDPAddr:
+		return a.IP
+	case *net.TCPAddr:
+		return a.IP
+	case nil:
+		return nil
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		host = addr.String()
+	}
+	return net.ParseIP(host)
+}
+
+var (
+	defaultRegistry   *Registry
+	defaultRegistryMu sync.RWMutex
+)
+
+// SetDefault sets the process wide registry used by modules (e.g. CoA) which
+// need the secret of a NAS they send requests to
+func SetDefault(registry *Registry) {
+	defaultRegistryMu.Lock()
+	defer defaultRegistryMu.Unlock()
+	defaultRegistry = registry
+}
+
+// SecretFor returns the secret of the NAS at the given host from the default
+// registry, or fallback if there is no registry or no such client
+func SecretFor(host string, fallback []byte) []byte {
+	defaultRegistryMu.RLock()
+	registry := defaultRegistry
+	defaultRegistryMu.RUnlock()
+	ip := net.ParseIP(host)
+	if registry == nil || ip == nil {
+		return fallback
+	}
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+	if client := registry.lookup(ip, "", false); client != nil {
+		return client.Secret
+	}
+	return fallback
+}
+
+// PacketFor returns a shallow copy of packet using the secret of the NAS at
+// host, to be used when the server sends requests (e.g. CoA) to the NAS
+func PacketFor(host string, packet *radius.Packet) *radius.Packet {
+	result := *packet
+	result.Secret = SecretFor(host, packet.Secret)
+	return &result
+}
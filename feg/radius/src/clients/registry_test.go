@@ -0,0 +1,190 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"fbc/cwf/radius/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+var testClients = []config.ClientConfig{
+	{
+		Name:   "venue-1",
+		CIDRs:  []string{"10.1.0.0/16"},
+		Secret: "venue-1-secret",
+	},
+	{
+		Name:      "venue-1-lobby",
+		CIDRs:     []string{"10.1.2.0/24"},
+		Secret:    "lobby-secret",
+		Listeners: []string{"auth"},
+	},
+	{
+		Name:           "roaming-ap",
+		NASIdentifiers: []string{"ap-roaming-1"},
+		Secret:         "roaming-secret",
+		RateLimit:      1,
+		RateBurst:      2,
+	},
+}
+
+func newTestRequest(ip string, nasIdentifier string, secret string) *radius.Request {
+	packet := radius.New(radius.CodeAccessRequest, []byte(secret))
+	if nasIdentifier != "" {
+		rfc2865.NASIdentifier_SetString(packet, nasIdentifier)
+	}
+	return &radius.Request{
+		RemoteAddr: &net.UDPAddr{IP: net.ParseIP(ip), Port: 1812},
+		Packet:     packet,
+	}
+}
+
+func TestRegistryLookup(t *testing.T) {
+	registry, err := NewRegistry([]byte("default"), testClients)
+	require.NoError(t, err)
+	assert.False(t, registry.IsEmpty())
+
+	// longest prefix wins
+	assert.Equal(t, "venue-1-lobby", registry.Lookup(net.ParseIP("10.1.2.3"), "").Name)
+	assert.Equal(t, "venue-1", registry.Lookup(net.ParseIP("10.1.3.3"), "").Name)
+	// NAS-Identifier only client matches any source
+	assert.Equal(t, "roaming-ap", registry.Lookup(net.ParseIP("192.168.1.1"), "ap-roaming-1").Name)
+	assert.Nil(t, registry.Lookup(net.ParseIP("192.168.1.1"), "ap-other"))
+
+	// secret is selected by source address only
+	secret, err := registry.RADIUSSecret(context.Background(), &net.UDPAddr{IP: net.ParseIP("10.1.2.3")})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("lobby-secret"), secret)
+	secret, err = registry.RADIUSSecret(context.Background(), &net.UDPAddr{IP: net.ParseIP("172.16.0.1")})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("roaming-secret"), secret)
+	secret, err = registry.RADIUSSecret(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("default"), secret)
+}
+
+func TestRegistryAuthorize(t *testing.T) {
+	registry, err := NewRegistry([]byte("default"), testClients)
+	require.NoError(t, err)
+
+	client, err := registry.Authorize("auth", newTestRequest("10.1.2.3", "", "lobby-secret"))
+	require.NoError(t, err)
+	assert.Equal(t, "venue-1-lobby", client.Name)
+
+	_, err = registry.Authorize("acct", newTestRequest("10.1.2.3", "", "lobby-secret"))
+	assert.True(t, errors.Is(err, ErrListenerNotAllowed))
+
+	_, err = registry.Authorize("auth", newTestRequest("10.1.2.3", "", "venue-1-secret"))
+	assert.True(t, errors.Is(err, ErrSecretMismatch))
+
+	_, err = registry.Authorize("auth", newTestRequest("192.168.1.1", "ap-other", "roaming-secret"))
+	assert.True(t, errors.Is(err, ErrUnknownClient))
+
+	// rate limit: burst of 2 then rejected
+	for i := 0; i < 2; i++ {
+		_, err = registry.Authorize("auth", newTestRequest("192.168.1.1", "ap-roaming-1", "roaming-secret"))
+		require.NoError(t, err)
+	}
+	_, err = registry.Authorize("auth", newTestRequest("192.168.1.1", "ap-roaming-1", "roaming-secret"))
+	assert.True(t, errors.Is(err, ErrRateLimited))
+
+	// limiter state survives a reload with an unchanged config
+	require.NoError(t, registry.Update(testClients))
+	_, err = registry.Authorize("auth", newTestRequest("192.168.1.1", "ap-roaming-1", "roaming-secret"))
+	assert.True(t, errors.Is(err, ErrRateLimited))
+}
+
+func TestRegistryLegacyMode(t *testing.T) {
+	registry, err := NewRegistry([]byte("default"), nil)
+	require.NoError(t, err)
+	assert.True(t, registry.IsEmpty())
+
+	secret, err := registry.RADIUSSecret(context.Background(), &net.UDPAddr{IP: net.ParseIP("10.1.2.3")})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("default"), secret)
+	client, err := registry.Authorize("auth", newTestRequest("10.1.2.3", "", "default"))
+	assert.NoError(t, err)
+	assert.Nil(t, client)
+}
+
+func TestRegistryUpdateValidation(t *testing.T) {
+	registry, err := NewRegistry([]byte("default"), testClients)
+	require.NoError(t, err)
+
+	invalid := [][]config.ClientConfig{
+		{{CIDRs: []string{"10.0.0.0/8"}, Secret: "s"}},
+		{{Name: "a", CIDRs: []string{"10.0.0.0/8"}}},
+		{{Name: "a", Secret: "s"}},
+		{{Name: "a", CIDRs: []string{"10.0.0.0/33"}, Secret: "s"}},
+		{{Name: "a", CIDRs: []string{"10.0.0.0/8"}, Secret: "s", RateLimit: -1}},
+		{{Name: "a", CIDRs: []string{"10.0.0.0/8"}, Secret: "s"}, {Name: "a", CIDRs: []string{"10.0.0.0/8"}, Secret: "s"}},
+		// different secrets only told apart by NAS-Identifier
+		{{Name: "a", NASIdentifiers: []string{"ap-1"}, Secret: "s1"}, {Name: "b", NASIdentifiers: []string{"ap-2"}, Secret: "s2"}},
+		{{Name: "a", CIDRs: []string{"10.0.0.0/8"}, Secret: "s1"}, {Name: "b", CIDRs: []string{"10.0.0.0/8"}, Secret: "s2"}},
+		{{Name: "a", CIDRs: []string{"10.1.0.0/16"}, NASIdentifiers: []string{"ap-1"}, Secret: "s1"}, {Name: "b", CIDRs: []string{"10.0.0.0/8"}, Secret: "s2"}},
+		{{Name: "a", CIDRs: []string{"10.1.0.0/16"}, NASIdentifiers: []string{"ap-1"}, Secret: "s1"}, {Name: "b", NASIdentifiers: []string{"ap-2"}, Secret: "s2"}},
+	}
+	for _, clientConfigs := range invalid {
+		assert.Error(t, registry.Update(clientConfigs))
+	}
+	// NAS-Identifiers are fine with a shared secret or disjoint networks
+	valid := [][]config.ClientConfig{
+		{{Name: "a", NASIdentifiers: []string{"ap-1"}, Secret: "s"}, {Name: "b", NASIdentifiers: []string{"ap-2"}, Secret: "s"}},
+		{{Name: "a", CIDRs: []string{"10.1.0.0/16"}, NASIdentifiers: []string{"ap-1"}, Secret: "s1"}, {Name: "b", CIDRs: []string{"10.2.0.0/16"}, Secret: "s2"}},
+		{{Name: "a", CIDRs: []string{"10.1.0.0/16"}, Secret: "s1"}, {Name: "b", CIDRs: []string{"10.0.0.0/8"}, NASIdentifiers: []string{"ap-2"}, Secret: "s2"}},
+	}
+	for _, clientConfigs := range valid {
+		_, err := NewRegistry(nil, clientConfigs)
+		assert.NoError(t, err)
+	}
+
+	// invalid updates leave the registry untouched
+	assert.Equal(t, "venue-1", registry.Lookup(net.ParseIP("10.1.3.3"), "").Name)
+}
+
+func TestSecretFor(t *testing.T) {
+	defer SetDefault(nil)
+	fallback := []byte("fallback")
+	assert.Equal(t, fallback, SecretFor("10.1.2.3", fallback))
+
+	registry, err := NewRegistry([]byte("default"), testClients)
+	require.NoError(t, err)
+	SetDefault(registry)
+	assert.Equal(t, []byte("lobby-secret"), SecretFor("10.1.2.3", fallback))
+	assert.Equal(t, fallback, SecretFor("not-an-ip", fallback))
+
+	packet := radius.New(radius.CodeCoARequest, fallback)
+	assert.Equal(t, []byte("venue-1-secret"), PacketFor("10.1.3.3", packet).Secret)
+	assert.Equal(t, fallback, packet.Secret)
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(2, 0)
+	now := time.Now()
+	assert.True(t, bucket.allow(now))
+	assert.True(t, bucket.allow(now))
+	assert.False(t, bucket.allow(now))
+	assert.True(t, bucket.allow(now.Add(500*time.Millisecond)))
+	assert.False(t, bucket.allow(now.Add(500*time.Millisecond)))
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/monitoring"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultMconfigKey the gateway mconfig key holding the RADIUS clients
+	DefaultMconfigKey = config.DefaultMconfigKey
+	// DefaultPollInterval how often the clients file is checked for changes
+	DefaultPollInterval = 30 * time.Second
+)
+
+// clientsFile the clients section of a clients file or of an mconfig entry
+type clientsFile struct {
+	Clients []config.ClientConfig `json:"clients"`
+}
+
+// FileSource reloads a registry whenever its clients file changes. The
+// clients of the file are added to the static ones, which are always kept
+type FileSource struct {
+	config   config.ClientsSourceConfig
+	static   []config.ClientConfig
+	registry *Registry
+	logger   *zap.Logger
+	reload   monitoring.Operation
+
+	mu      sync.Mutex
+	modTime time.Time
+	stop    chan struct{}
+}
+
+// NewFileSource creates a clients file source for the registry. static are
+// the clients configured inline in the server config
+func NewFileSource(
+	sourceConfig config.ClientsSourceConfig,
+	static []config.ClientConfig,
+	registry *Registry,
+	logger *zap.Logger,
+) (*FileSource, error) {
+	if sourceConfig.File == "" {
+		return nil, errors.New("clients source requires a file")
+	}
+	if sourceConfig.MconfigKey == "" {
+		sourceConfig.MconfigKey = DefaultMconfigKey
+	}
+	if sourceConfig.PollInterval.Duration <= 0 {
+		sourceConfig.PollInterval.Duration = DefaultPollInterval
+	}
+	return &FileSource{
+		config:   sourceConfig,
+		static:   static,
+		registry: registry,
+		logger:   logger.With(zap.String("clients_file", sourceConfig.File)),
+		reload:   monitoring.NewOperation("radius_clients_reload"),
+	}, nil
+}
+
+// Reload updates the registry if the file changed since the last successful
+// reload. An invalid file, or one redefining a static client, leaves the
+// registry untouched
+func (s *FileSource) Reload() (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, err := os.Stat(s.config.File)
+	if err != nil {
+		return false, err
+	}
+	if info.ModTime().Equal(s.modTime) {
+		return false, nil
+	}
+	counter := s.reload.Start()
+	clientConfigs, err := ReadClientsFile(s.config.File, s.config.MconfigKey)
+	if err != nil {
+		counter.Failure("read_error")
+		return false, err
+	}
+	allConfigs := make([]config.ClientConfig, 0, len(s.static)+len(clientConfigs))
+	allConfigs = append(append(allConfigs, s.static...), clientConfigs...)
+	err = s.registry.Update(allConfigs)
+	if err != nil {
+		counter.Failure("invalid_clients")
+		return false, err
+	}
+	counter.Success()
+	s.modTime = info.ModTime()
+	s.logger.Info("RADIUS clients reloaded", zap.Int("num_clients", len(allConfigs)))
+	return true, nil
+}
+
+// Start loads the clients & polls the file for changes until Stop is called
+func (s *FileSource) Start() error {
+	_, err := s.Reload()
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.stop = make(chan struct{})
+	stop := s.stop
+	s.mu.Unlock()
+	go func() {
+		ticker := time.NewTicker(s.config.PollInterval.Duration)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if _, err := s.Reload(); err != nil {
+					s.logger.Error("failed to reload RADIUS clients", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop stops polling the file
+func (s *FileSource) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
+
+// ReadClientsFile reads RADIUS clients from either a clients file
+// ({"clients": [...]}) or a gateway mconfig (configsByKey[mconfigKey].clients)
+func ReadClientsFile(filename string, mconfigKey string) ([]config.ClientConfig, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	content, err = config.UnwrapMconfig(content, mconfigKey)
+	if err != nil {
+		return nil, err
+	}
+	var clients clientsFile
+	err = json.Unmarshal(content, &clients)
+	if err != nil {
+		return nil, err
+	}
+	return clients.Clients, nil
+}
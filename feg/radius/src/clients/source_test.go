@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"fbc/cwf/radius/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	clientsJSON = `{"clients": [{"name": "venue-1", "cidrs": ["10.1.0.0/16"], "secret": "s1"}]}`
+	mconfigJSON = `{
+		"configs_by_key": {
+			"radius": {
+				"@type": "type.googleapis.com/magma.mconfig.RadiusConfig",
+				"clients": [
+					{"name": "venue-2", "cidrs": ["10.2.0.0/16"], "secret": "s2", "listeners": ["auth"]}
+				]
+			}
+		}
+	}`
+)
+
+func TestReadClientsFile(t *testing.T) {
+	dir := t.TempDir()
+	clientsFile := filepath.Join(dir, "clients.json")
+	require.NoError(t, ioutil.WriteFile(clientsFile, []byte(clientsJSON), 0644))
+	clientConfigs, err := ReadClientsFile(clientsFile, DefaultMconfigKey)
+	require.NoError(t, err)
+	assert.Equal(t, []config.ClientConfig{{Name: "venue-1", CIDRs: []string{"10.1.0.0/16"}, Secret: "s1"}}, clientConfigs)
+
+	mconfigFile := filepath.Join(dir, "gateway.mconfig")
+	require.NoError(t, ioutil.WriteFile(mconfigFile, []byte(mconfigJSON), 0644))
+	clientConfigs, err = ReadClientsFile(mconfigFile, DefaultMconfigKey)
+	require.NoError(t, err)
+	require.Len(t, clientConfigs, 1)
+	assert.Equal(t, "venue-2", clientConfigs[0].Name)
+	assert.Equal(t, []string{"auth"}, clientConfigs[0].Listeners)
+
+	_, err = ReadClientsFile(mconfigFile, "other")
+	assert.Error(t, err)
+}
+
+func TestFileSourceReload(t *testing.T) {
+	logger, _ := zap.NewDevelopment()
+	filename := filepath.Join(t.TempDir(), "clients.json")
+	require.NoError(t, ioutil.WriteFile(filename, []byte(clientsJSON), 0644))
+
+	registry, err := NewRegistry([]byte("default"), nil)
+	require.NoError(t, err)
+	static := []config.ClientConfig{{Name: "static", CIDRs: []string{"10.9.0.0/16"}, Secret: "s9"}}
+	source, err := NewFileSource(config.ClientsSourceConfig{File: filename}, static, registry, logger)
+	require.NoError(t, err)
+	require.NoError(t, source.Start())
+	defer source.Stop()
+	assert.Equal(t, "venue-1", registry.Lookup(net.ParseIP("10.1.0.1"), "").Name)
+	assert.Equal(t, "static", registry.Lookup(net.ParseIP("10.9.0.1"), "").Name)
+
+	// unchanged file isn't reloaded
+	reloaded, err := source.Reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	// updated file replaces its clients & keeps the static ones
+	require.NoError(t, ioutil.WriteFile(filename, []byte(mconfigJSON), 0644))
+	modTime := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(filename, modTime, modTime))
+	reloaded, err = source.Reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.Nil(t, registry.Lookup(net.ParseIP("10.1.0.1"), ""))
+	assert.Equal(t, "venue-2", registry.Lookup(net.ParseIP("10.2.0.1"), "").Name)
+	assert.Equal(t, "static", registry.Lookup(net.ParseIP("10.9.0.1"), "").Name)
+
+	// a static client can't be redefined by the file
+	require.NoError(t, ioutil.WriteFile(filename, []byte(`{"clients": [{"name": "static", "cidrs": ["10.3.0.0/16"], "secret": "s3"}]}`), 0644))
+	modTime = modTime.Add(time.Second)
+	require.NoError(t, os.Chtimes(filename, modTime, modTime))
+	_, err = source.Reload()
+	assert.Error(t, err)
+	assert.Equal(t, "static", registry.Lookup(net.ParseIP("10.9.0.1"), "").Name)
+
+	// invalid file keeps the current clients
+	require.NoError(t, ioutil.WriteFile(filename, []byte(`{"clients": [{"name": "no-secret", "cidrs": ["10.3.0.0/16"]}]}`), 0644))
+	modTime = modTime.Add(time.Second)
+	require.NoError(t, os.Chtimes(filename, modTime, modTime))
+	_, err = source.Reload()
+	assert.Error(t, err)
+	assert.Equal(t, "venue-2", registry.Lookup(net.ParseIP("10.2.0.1"), "").Name)
+}
@@ -82,6 +82,28 @@ type (
 		Redis       RedisConfig `json:"redis"`
 	}
 
+	// ClientConfig a RADIUS client (NAS) identified by its source CIDRs and/or
+	// NAS-Identifiers, with its own shared secret and policy
+	ClientConfig struct {
+		Name           string   `json:"name"`
+		CIDRs          []string `json:"cidrs"`
+		NASIdentifiers []string `json:"nasIdentifiers"`
+		Secret         string   `json:"secret"`
+		Listeners      []string `json:"listeners"` // Allowed listeners, all when empty
+		RateLimit      float64  `json:"rateLimit"` // Requests per second, unlimited when 0
+		RateBurst      int      `json:"rateBurst"`
+	}
+
+	// ClientsSourceConfig a hot-reloadable source of RADIUS clients. The file
+	// is either a clients list or a gateway mconfig pushed by orc8r, in which
+	// case the clients are read from configsByKey[MconfigKey]. They are added
+	// to the inline ServerConfig.Clients, which they can't redefine
+	ClientsSourceConfig struct {
+		File         string   `json:"file"`
+		MconfigKey   string   `json:"mconfigKey"`
+		PollInterval Duration `json:"pollInterval"`
+	}
+
 	// ServerConfig Encapsulates the configuration of a radius server
 	ServerConfig struct {
 		Secret         string                `json:"secret"`
@@ -90,6 +112,8 @@ type (
 		Listeners      []ListenerConfig      `json:"listeners"`
 		Filters        []string              `json:"filters"`
 		SessionStorage *SessionStorageConfig `json:"sessionStorage"`
+		Clients        []ClientConfig        `json:"clients"`
+		ClientsSource  *ClientsSourceConfig  `json:"clientsSource"`
 	}
 
 	// MonitoringConfig ...
@@ -105,11 +129,22 @@ type (
 		Port    int  `json:"port"`
 	}
 
+	// ReloadConfig hot-reload of the server's filters & module chains. The
+	// watched file is either a RADIUS config file or a gateway mconfig pushed
+	// by orc8r, in which case the config is read from configsByKey[MconfigKey]
+	ReloadConfig struct {
+		File         string   `json:"file"` // The server's config file when empty
+		MconfigKey   string   `json:"mconfigKey"`
+		PollInterval Duration `json:"pollInterval"`
+		AdminPort    int      `json:"adminPort"` // Admin HTTP endpoint, disabled when 0
+	}
+
 	// RadiusConfig the configuration file format
 	RadiusConfig struct {
 		Debug      *DebugConfig      `json:"debug"`
 		Monitoring *MonitoringConfig `json:"monitoring"`
 		Server     ServerConfig      `json:"server"`
+		Reload     *ReloadConfig     `json:"reload"`
 	}
 )
 
@@ -119,9 +154,27 @@ func Read(filename string) (*RadiusConfig, error) {
 	if err != nil {
 		return nil, err
 	}
+	return Parse(configBytes)
+}
+
+// ReadMconfig reads a configuration file or a gateway mconfig holding the
+// configuration at configsByKey[mconfigKey] into a RadiusConfig
+func ReadMconfig(filename string, mconfigKey string) (*RadiusConfig, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	configBytes, err := UnwrapMconfig(content, mconfigKey)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(configBytes)
+}
 
+// Parse parses a configuration into a RadiusConfig
+func Parse(configBytes []byte) (*RadiusConfig, error) {
 	var config RadiusConfig
-	err = json.Unmarshal(configBytes, &config)
+	err := json.Unmarshal(configBytes, &config)
 	if err != nil {
 		return nil, err
 	}
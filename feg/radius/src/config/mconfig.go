@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// DefaultMconfigKey the gateway mconfig key holding the RADIUS server config
+const DefaultMconfigKey = "radius"
+
+// mconfigFile the subset of a gateway mconfig needed to find an entry,
+// orc8r streams it either with camelCase or snake_case keys
+type mconfigFile struct {
+	ConfigsByKey      map[string]json.RawMessage `json:"configsByKey"`
+	ConfigsByKeySnake map[string]json.RawMessage `json:"configs_by_key"`
+}
+
+// UnwrapMconfig returns configsByKey[mconfigKey] if content is a gateway
+// mconfig, or content itself otherwise
+func UnwrapMconfig(content []byte, mconfigKey string) ([]byte, error) {
+	var mconfig mconfigFile
+	err := json.Unmarshal(content, &mconfig)
+	if err != nil {
+		return nil, err
+	}
+	configsByKey := mconfig.ConfigsByKey
+	if configsByKey == nil {
+		configsByKey = mconfig.ConfigsByKeySnake
+	}
+	if configsByKey == nil {
+		return content, nil
+	}
+	entry, ok := configsByKey[mconfigKey]
+	if !ok {
+		return nil, fmt.Errorf("mconfig has no '%s' entry", mconfigKey)
+	}
+	return entry, nil
+}
+
+// Version returns a short, stable hash of the server config
+func Version(c ServerConfig) (string, error) {
+	serialized, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(serialized)
+	return hex.EncodeToString(sum[:6]), nil
+}
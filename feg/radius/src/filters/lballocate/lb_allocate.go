@@ -16,6 +16,7 @@ package lballocate
 import (
 	"errors"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
 	"fbc/cwf/radius/config"
@@ -39,32 +40,42 @@ var errServiceTierNotFound = errors.New(errServiceTierNotFoundText)
 var errListenerRoutingNotFound = errors.New(errListenerRoutingNotFoundText)
 var errNoUpstreamHosts = errors.New(errNoUpstreamHostsText)
 
-var r *rand.Rand
+// allocateConfig the filter's configuration, Init replaces it as a whole so
+// the filter can be re-initialized while requests are being processed
+type allocateConfig struct {
+	r            *rand.Rand
+	lbConfig     *config.LoadBalanceConfig
+	tierRoutings map[string]map[string]string
+	serviceTiers map[string][]string
+}
 
-var lbConfig *config.LoadBalanceConfig
-var tierRoutings map[string]map[string]string
-var serviceTiers map[string][]string
+var current atomic.Value // *allocateConfig
 
 // Init module interface implementation
 //
 //nolint:unparam
 func Init(c *config.ServerConfig) error {
-	lbConfig = &c.LoadBalance
+	lbConfig := &c.LoadBalance
 
 	// prepare map: tier -> (map: listener -> service tier)
-	tierRoutings = make(map[string]map[string]string)
+	tierRoutings := make(map[string]map[string]string)
 	tierRoutings[config.LiveTier] = getListenerServiceTierLookup(&lbConfig.LiveTier)
 	for _, canary := range lbConfig.Canaries {
 		tierRoutings[canary.Name] = getListenerServiceTierLookup(&canary.Routing)
 	}
 
 	// prepare ServiceTier.Name->UpstreamServers lookup
-	serviceTiers = make(map[string][]string)
+	serviceTiers := make(map[string][]string)
 	for _, serviceTier := range lbConfig.ServiceTiers {
 		serviceTiers[serviceTier.Name] = serviceTier.UpstreamHosts
 	}
 
-	r = rand.New(rand.NewSource(time.Now().Unix()))
+	current.Store(&allocateConfig{
+		r:            rand.New(rand.NewSource(time.Now().Unix())),
+		lbConfig:     lbConfig,
+		tierRoutings: tierRoutings,
+		serviceTiers: serviceTiers,
+	})
 	return nil
 }
 
@@ -124,35 +135,38 @@ func allocateUpstreamHost(c *modules.RequestContext, listenerName string) error
 }
 
 func pickRandomUpstreamHost(c *modules.RequestContext, state *session.State, listenerName string) (string, error) {
+	cfg := current.Load().(*allocateConfig)
 
-	tier, err := getTier(state)
+	tier, err := getTier(cfg, state)
 	if err != nil {
 		return "", err
 	}
 
 	var upstreamHost string
-	upstreamHosts, hostCount, err := getUpstreamHosts(c, tier, listenerName)
+	upstreamHosts, hostCount, err := getUpstreamHosts(cfg, c, tier, listenerName)
 	if err != nil {
 		return "", err
 	}
 
-	selection := r.Intn(hostCount)
+	selection := cfg.r.Intn(hostCount)
 	upstreamHost = upstreamHosts[selection]
 	return upstreamHost, nil
 }
 
-func getTier(state *session.State) (string, error) {
+func getTier(cfg *allocateConfig, state *session.State) (string, error) {
 	if state.Tier != "" {
 		return state.Tier, nil
 	}
-	if lbConfig.DefaultTier != "" {
-		return lbConfig.DefaultTier, nil
+	if cfg.lbConfig.DefaultTier != "" {
+		return cfg.lbConfig.DefaultTier, nil
 	}
 	return "", errRequiredTierNotSpecified
 }
 
-func getUpstreamHosts(c *modules.RequestContext, tier string, listenerName string) ([]string, int, error) {
-	listenerServiceTierLookup, found := tierRoutings[tier]
+func getUpstreamHosts(
+	cfg *allocateConfig, c *modules.RequestContext, tier string, listenerName string,
+) ([]string, int, error) {
+	listenerServiceTierLookup, found := cfg.tierRoutings[tier]
 	if !found {
 		c.Logger.Error(errCanaryNotFoundText,
 			zap.String("canary", tier))
@@ -167,7 +181,7 @@ func getUpstreamHosts(c *modules.RequestContext, tier string, listenerName strin
 		return nil, 0, errListenerRoutingNotFound
 	}
 
-	upstreamHosts, found := serviceTiers[serviceTier]
+	upstreamHosts, found := cfg.serviceTiers[serviceTier]
 	if !found {
 		c.Logger.Error(errServiceTierNotFoundText,
 			zap.String("service_tier", serviceTier))
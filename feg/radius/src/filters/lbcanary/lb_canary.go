@@ -16,6 +16,7 @@ package lbcanary
 import (
 	"errors"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
 	"fbc/cwf/radius/config"
@@ -29,16 +30,19 @@ import (
 
 const maxCanariesWeight = 50
 
-var r *rand.Rand
+// canaryConfig the filter's configuration, Init replaces it as a whole so
+// the filter can be re-initialized while requests are being processed
+type canaryConfig struct {
+	r        *rand.Rand
+	lbConfig *config.LoadBalanceConfig
+}
 
-var totalCanariesWeight int
-var lbConfig *config.LoadBalanceConfig
+var current atomic.Value // *canaryConfig
 
 // Init filter interface implementation
 func Init(c *config.ServerConfig) error {
-	lbConfig = &c.LoadBalance
-	r = rand.New(rand.NewSource(time.Now().Unix()))
-	totalCanariesWeight = 0
+	lbConfig := &c.LoadBalance
+	totalCanariesWeight := 0
 	for _, canary := range lbConfig.Canaries {
 		if canary.Name == config.LiveTier {
 			return errors.New("reserved canary name 'live' specified")
@@ -48,6 +52,10 @@ func Init(c *config.ServerConfig) error {
 	if totalCanariesWeight > maxCanariesWeight {
 		return errors.New("canaries are over allocated")
 	}
+	current.Store(&canaryConfig{
+		r:        rand.New(rand.NewSource(time.Now().Unix())),
+		lbConfig: lbConfig,
+	})
 	return nil
 }
 
@@ -94,9 +102,10 @@ func allocateTier(c *modules.RequestContext) error {
 }
 
 func pickRandomTier() string {
-	selection := 1 + r.Intn(100)
+	cfg := current.Load().(*canaryConfig)
+	selection := 1 + cfg.r.Intn(100)
 	tier := config.LiveTier
-	for _, canary := range lbConfig.Canaries {
+	for _, canary := range cfg.lbConfig.Canaries {
 		selection -= canary.TrafficSlicePercent
 		if selection <= 0 {
 			tier = canary.Name
@@ -23,6 +23,7 @@ import (
 	"fbc/cwf/radius/modules"
 	modalwaysaccept "fbc/cwf/radius/modules/alwaysaccept"
 	modan "fbc/cwf/radius/modules/analytics"
+	modcdrexport "fbc/cwf/radius/modules/cdrexport"
 	modcoadynamic "fbc/cwf/radius/modules/coadynamic"
 	modcoafixed "fbc/cwf/radius/modules/coafixedip"
 	modcoanas "fbc/cwf/radius/modules/coanas"
@@ -57,18 +58,23 @@ type StaticLoader struct {
 
 // CWFModuleMap the available CWF modules with their names, for use by the configuration file
 var CWFModuleMap = ModuleNameMap{
-	"analytics":          func() modules.Module { return NewModule(modan.Init, modan.Handle) },
-	"eap":                func() modules.Module { return NewModule(modeap.Init, modeap.Handle) },
-	"lbserve":            func() modules.Module { return NewModule(modlbserve.Init, modlbserve.Handle) },
-	"proxy":              func() modules.Module { return NewModule(modproxy.Init, modproxy.Handle) },
-	"ofpanalytics":       func() modules.Module { return NewModule(ofpanalytics.Init, ofpanalytics.Handle) },
-	"testloopback":       func() modules.Module { return NewModule(modloopback.Init, modloopback.Handle) },
-	"coafixedip":         func() modules.Module { return NewModule(modcoafixed.Init, modcoafixed.Handle) },
-	"coanas":             func() modules.Module { return NewModule(modcoanas.Init, modcoanas.Handle) },
-	"coadynamic":         func() modules.Module { return NewModule(modcoadynamic.Init, modcoadynamic.Handle) },
-	"alwaysaccept":       func() modules.Module { return NewModule(modalwaysaccept.Init, modalwaysaccept.Handle) },
-	"magmaacct":          func() modules.Module { return NewModule(modmagmaacct.Init, modmagmaacct.Handle) },
+	"analytics":    func() modules.Module { return NewModule(modan.Init, modan.Handle) },
+	"eap":          func() modules.Module { return NewModule(modeap.Init, modeap.Handle) },
+	"lbserve":      func() modules.Module { return NewModule(modlbserve.Init, modlbserve.Handle) },
+	"proxy":        func() modules.Module { return NewModule(modproxy.Init, modproxy.Handle) },
+	"ofpanalytics": func() modules.Module { return NewModule(ofpanalytics.Init, ofpanalytics.Handle) },
+	"testloopback": func() modules.Module { return NewModule(modloopback.Init, modloopback.Handle) },
+	"coafixedip":   func() modules.Module { return NewModule(modcoafixed.Init, modcoafixed.Handle) },
+	"coanas":       func() modules.Module { return NewModule(modcoanas.Init, modcoanas.Handle) },
+	"coadynamic":   func() modules.Module { return NewModule(modcoadynamic.Init, modcoadynamic.Handle) },
+	"alwaysaccept": func() modules.Module { return NewModule(modalwaysaccept.Init, modalwaysaccept.Handle) },
+	"magmaacct": func() modules.Module {
+		return NewClosableModule(modmagmaacct.Init, modmagmaacct.Handle, modmagmaacct.Close)
+	},
 	"testsessionstorage": func() modules.Module { return NewModule(testsessionstorage.Init, testsessionstorage.Handle) },
+	"cdrexport": func() modules.Module {
+		return NewClosableModule(modcdrexport.Init, modcdrexport.Handle, modcdrexport.Close)
+	},
 }
 
 var CWFFilterMap = FilterNameMap{
@@ -144,3 +150,25 @@ func NewModule(init modules.ModuleInitFunc, handle modules.ModuleHandleFunc) mod
 		handle: handle,
 	}
 }
+
+// closableModule modules.Module & modules.Closer instatiation
+type closableModule struct {
+	module
+	close modules.ModuleCloseFunc
+}
+
+func (m closableModule) Close(x modules.Context) error {
+	return m.close(x)
+}
+
+// NewClosableModule create a new module interface for a module which releases
+// the resources of its contexts on Close
+func NewClosableModule(init modules.ModuleInitFunc, handle modules.ModuleHandleFunc, close modules.ModuleCloseFunc) modules.Module {
+	return closableModule{
+		module: module{
+			init:   init,
+			handle: handle,
+		},
+		close: close,
+	}
+}
@@ -109,12 +109,36 @@ func main() {
 		return
 	}
 
+	// Watch the config for module chain changes
+	var configSource *server.ConfigSource
+	if config.Reload != nil {
+		configSource, err = server.NewConfigSource(*config.Reload, configFilename, radiusServer, logger)
+		if err != nil {
+			logger.Error("Failed creating config source", zap.Error(err))
+			return
+		}
+		configSource.Start()
+
+		if config.Reload.AdminPort != 0 {
+			logger.Info("Enabling admin endpoint", zap.Int("port", config.Reload.AdminPort))
+			go func() {
+				err := http.ListenAndServe(fmt.Sprintf(":%d", config.Reload.AdminPort), radiusServer.AdminHandler())
+				if err != nil {
+					logger.Error("Admin endpoint failed", zap.Error(err))
+				}
+			}()
+		}
+	}
+
 	// Capture CTRL+C
 	sigtermChannel := make(chan os.Signal, 1)
 	signal.Notify(sigtermChannel, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-sigtermChannel
 		logger.Info("Received SIGTERM, existing")
+		if configSource != nil {
+			configSource.Stop()
+		}
 		radiusServer.Stop()
 		logger.Sync()
 	}()
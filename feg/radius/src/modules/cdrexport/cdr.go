@@ -0,0 +1,186 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cdrexport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Record types of exported CDRs
+const (
+	RecordTypeStart   = "start"
+	RecordTypeInterim = "interim"
+	RecordTypeStop    = "stop"
+)
+
+// CDR a normalized accounting record of a CWF session
+type CDR struct {
+	RecordID        string    `json:"record_id"`
+	RecordType      string    `json:"record_type"`
+	Timestamp       time.Time `json:"timestamp"`
+	SessionID       string    `json:"session_id"`
+	AcctSessionID   string    `json:"acct_session_id"`
+	UserName        string    `json:"user_name"`
+	MSISDN          string    `json:"msisdn"`
+	MACAddress      string    `json:"mac_address"`
+	CalledStationID string    `json:"called_station_id"`
+	NASIdentifier   string    `json:"nas_identifier"`
+	NASIPAddress    string    `json:"nas_ip_address"`
+	FramedIPAddress string    `json:"framed_ip_address"`
+	SessionTime     uint32    `json:"session_time"`
+	InputOctets     uint64    `json:"input_octets"`
+	OutputOctets    uint64    `json:"output_octets"`
+	InputPackets    uint32    `json:"input_packets"`
+	OutputPackets   uint32    `json:"output_packets"`
+	TerminateCause  uint32    `json:"terminate_cause,omitempty"`
+}
+
+// csvHeader the column names of CSV CDR files, in the order written by csvRow
+var csvHeader = []string{
+	"record_id", "record_type", "timestamp", "session_id", "acct_session_id", "user_name", "msisdn",
+	"mac_address", "called_station_id", "nas_identifier", "nas_ip_address", "framed_ip_address",
+	"session_time", "input_octets", "output_octets", "input_packets", "output_packets", "terminate_cause",
+}
+
+func (c *CDR) csvRow() []string {
+	return []string{
+		c.RecordID, c.RecordType, c.Timestamp.UTC().Format(time.RFC3339Nano), c.SessionID, c.AcctSessionID,
+		c.UserName, c.MSISDN, c.MACAddress, c.CalledStationID, c.NASIdentifier, c.NASIPAddress,
+		c.FramedIPAddress, strconv.FormatUint(uint64(c.SessionTime), 10),
+		strconv.FormatUint(c.InputOctets, 10), strconv.FormatUint(c.OutputOctets, 10),
+		strconv.FormatUint(uint64(c.InputPackets), 10), strconv.FormatUint(uint64(c.OutputPackets), 10),
+		strconv.FormatUint(uint64(c.TerminateCause), 10),
+	}
+}
+
+func cdrFromCSVRow(row []string) (*CDR, error) {
+	if len(row) != len(csvHeader) {
+		return nil, fmt.Errorf("invalid CDR row with %d columns, expected %d", len(row), len(csvHeader))
+	}
+	var (
+		c    = &CDR{}
+		err  error
+		nums [6]uint64
+	)
+	c.Timestamp, err = time.Parse(time.RFC3339Nano, row[2])
+	if err != nil {
+		return nil, err
+	}
+	for i := range nums {
+		nums[i], err = strconv.ParseUint(row[12+i], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid '%s' value: %v", csvHeader[12+i], err)
+		}
+	}
+	c.RecordID, c.RecordType, c.SessionID, c.AcctSessionID, c.UserName, c.MSISDN = row[0], row[1], row[3], row[4], row[5], row[6]
+	c.MACAddress, c.CalledStationID, c.NASIdentifier, c.NASIPAddress, c.FramedIPAddress = row[7], row[8], row[9], row[10], row[11]
+	c.SessionTime, c.InputOctets, c.OutputOctets = uint32(nums[0]), nums[1], nums[2]
+	c.InputPackets, c.OutputPackets, c.TerminateCause = uint32(nums[3]), uint32(nums[4]), uint32(nums[5])
+	return c, nil
+}
+
+// Format of the CDR files
+type Format interface {
+	// Extension the file name extension of the format
+	Extension() string
+	// WriteHeader writes the beginning of a new CDR file
+	WriteHeader(w io.Writer) error
+	// WriteRecord appends a single CDR to the file
+	WriteRecord(w io.Writer, c *CDR) error
+	// ReadRecords reads back all CDRs of a file written in the format
+	ReadRecords(r io.Reader) ([]*CDR, error)
+}
+
+// NewFormat returns the Format for the given name ("csv" or "json")
+func NewFormat(name string) (Format, error) {
+	switch name {
+	case "", "csv":
+		return csvFormat{}, nil
+	case "json":
+		return jsonFormat{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported CDR file format '%s'", name)
+	}
+}
+
+type csvFormat struct{}
+
+func (csvFormat) Extension() string { return "csv" }
+
+func (csvFormat) WriteHeader(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write(csvHeader)
+	cw.Flush()
+	return cw.Error()
+}
+
+func (csvFormat) WriteRecord(w io.Writer, c *CDR) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write(c.csvRow())
+	cw.Flush()
+	return cw.Error()
+}
+
+func (csvFormat) ReadRecords(r io.Reader) ([]*CDR, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(csvHeader)
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	var result []*CDR
+	for _, row := range rows[1:] {
+		c, err := cdrFromCSVRow(row)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+// jsonFormat writes newline delimited JSON objects, one per CDR
+type jsonFormat struct{}
+
+func (jsonFormat) Extension() string { return "json" }
+
+func (jsonFormat) WriteHeader(io.Writer) error { return nil }
+
+func (jsonFormat) WriteRecord(w io.Writer, c *CDR) error {
+	return json.NewEncoder(w).Encode(c)
+}
+
+func (jsonFormat) ReadRecords(r io.Reader) ([]*CDR, error) {
+	var result []*CDR
+	dec := json.NewDecoder(r)
+	for {
+		c := &CDR{}
+		err := dec.Decode(c)
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cdrexport implements a RADIUS accounting module which writes normalized session CDRs to rotated
+// local CSV/JSON files and, optionally, ships the completed files to a Kafka topic.
+//
+// An Accounting-Request is answered only after its CDR was written to the active file, a failed write
+// leaves the request unanswered so the NAS retransmits it. Together with the per-file Kafka checkpoint
+// this provides at-least-once delivery of CDRs.
+package cdrexport
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"fbc/cwf/radius/modules"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+	"layeh.com/radius/rfc2869"
+)
+
+const (
+	defaultFilePrefix        = "cdr"
+	defaultRotateSizeBytes   = 64 * 1024 * 1024
+	defaultRotateIntervalSec = 300
+	defaultShipIntervalSec   = 10
+	defaultKafkaBatchSize    = 500
+	defaultKafkaTimeoutSec   = 10
+)
+
+// Config configuration structure for the CDR export module
+type Config struct {
+	Directory         string // CDR files directory, required
+	Format            string // "csv" (default) or "json"
+	FilePrefix        string
+	RotateSizeBytes   int64
+	RotateIntervalSec int
+	SyncWrites        bool // fsync the active file after every CDR
+	MaxFiles          int  // number of completed files to retain (0 - unlimited), unshipped files are always kept
+	KafkaBrokers      []string
+	KafkaTopic        string
+	KafkaBatchSize    int
+	KafkaTimeoutSec   int
+	ShipIntervalSec   int
+}
+
+// ModuleCtx ...
+type ModuleCtx struct {
+	exporter *exporter
+}
+
+// exporter owns the writer & shipper of a CDR directory. Exporters are shared by module instances with the
+// same configuration, so re-initializing the module on configuration reload keeps writing to the same file.
+// An exporter is closed when the last module instance using it is closed.
+type exporter struct {
+	config  Config
+	logger  *zap.Logger
+	writer  *FileWriter
+	shipper *Shipper
+	stop    chan struct{}
+	done    chan struct{}
+	refs    int // guarded by exportersMu
+}
+
+var (
+	exportersMu sync.Mutex
+	exporters   = map[string]*exporter{}
+)
+
+// Init module interface implementation
+func Init(logger *zap.Logger, config modules.ModuleConfig) (modules.Context, error) {
+	var cfg Config
+	err := mapstructure.Decode(config, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Directory == "" {
+		return nil, errors.New("cdrexport module cannot be initialized with empty Directory value")
+	}
+	if len(cfg.KafkaBrokers) > 0 && cfg.KafkaTopic == "" {
+		return nil, errors.New("cdrexport module cannot be initialized with KafkaBrokers and empty KafkaTopic value")
+	}
+	setDefaults(&cfg)
+	dir, err := filepath.Abs(cfg.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	exportersMu.Lock()
+	defer exportersMu.Unlock()
+	if e, ok := exporters[dir]; ok {
+		if reflect.DeepEqual(e.config, cfg) {
+			e.refs++
+			return ModuleCtx{exporter: e}, nil
+		}
+		e.close()
+		delete(exporters, dir)
+	}
+	e, err := newExporter(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+	e.refs = 1
+	exporters[dir] = e
+	return ModuleCtx{exporter: e}, nil
+}
+
+// Close module interface implementation, closes the exporter once no module instance uses it. Exporters
+// replaced by an Init with another configuration of their directory were already closed
+func Close(m modules.Context) error {
+	e := m.(ModuleCtx).exporter
+	exportersMu.Lock()
+	defer exportersMu.Unlock()
+	if exporters[e.writer.dir] != e {
+		return nil
+	}
+	e.refs--
+	if e.refs > 0 {
+		return nil
+	}
+	delete(exporters, e.writer.dir)
+	e.close()
+	return nil
+}
+
+// Handle module interface implementation
+func Handle(m modules.Context, ctx *modules.RequestContext, r *radius.Request, next modules.Middleware) (*modules.Response, error) {
+	if r.Code != radius.CodeAccountingRequest {
+		return next(ctx, r)
+	}
+	mCtx := m.(ModuleCtx)
+
+	cdr, err := newCDR(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if cdr != nil {
+		op := WriteRecord.Start()
+		if err = mCtx.exporter.writer.Write(cdr); err != nil {
+			op.Failure("write_failed")
+			return nil, err
+		}
+		op.Success()
+		ctx.Logger.Debug("CDR written", zap.Any("cdr", cdr))
+	}
+
+	resp, err := next(ctx, r)
+	if err != nil || resp != nil {
+		return resp, err
+	}
+	return &modules.Response{
+		Code: radius.CodeAccountingResponse,
+		Attributes: radius.Attributes{
+			&radius.AVP{
+				Type:      rfc2866.AcctSessionID_Type,
+				Attribute: radius.Attribute(ctx.SessionID),
+			},
+		},
+	}, nil
+}
+
+// newCDR builds the CDR of an Accounting-Request, it returns nil for Accounting-On/Off requests
+func newCDR(ctx *modules.RequestContext, r *radius.Request) (*CDR, error) {
+	acctTypeAttr, exists := r.Lookup(rfc2866.AcctStatusType_Type)
+	if !exists || len(acctTypeAttr) != 4 {
+		return nil, errors.New("invalid RADIUS request without Acct-Status-Type attribute")
+	}
+	cdr := &CDR{RecordID: uuid.New().String(), SessionID: ctx.SessionID}
+	switch rfc2866.AcctStatusType(binary.BigEndian.Uint32(acctTypeAttr)) {
+	case rfc2866.AcctStatusType_Value_Start:
+		cdr.RecordType = RecordTypeStart
+	case rfc2866.AcctStatusType_Value_InterimUpdate:
+		cdr.RecordType = RecordTypeInterim
+	case rfc2866.AcctStatusType_Value_Stop:
+		cdr.RecordType = RecordTypeStop
+	default:
+		return nil, nil
+	}
+
+	cdr.Timestamp = time.Now()
+	if ts, err := rfc2869.EventTimestamp_Lookup(r.Packet); err == nil {
+		cdr.Timestamp = ts
+	} else if delay, err := rfc2866.AcctDelayTime_Lookup(r.Packet); err == nil {
+		cdr.Timestamp = cdr.Timestamp.Add(-time.Duration(delay) * time.Second)
+	}
+	cdr.Timestamp = cdr.Timestamp.UTC()
+
+	if ctx.SessionStorage != nil {
+		if state, err := ctx.SessionStorage.Get(); err == nil {
+			cdr.MSISDN = state.MSISDN
+			cdr.MACAddress = state.MACAddress
+			cdr.CalledStationID = state.CalledStationID
+			cdr.AcctSessionID = state.AcctSessionID
+		}
+	}
+	if attr := r.Get(rfc2866.AcctSessionID_Type); attr != nil {
+		cdr.AcctSessionID = string(attr)
+	}
+	if v, err := rfc2865.CallingStationID_LookupString(r.Packet); err == nil {
+		cdr.MACAddress = v
+	}
+	if v, err := rfc2865.CalledStationID_LookupString(r.Packet); err == nil {
+		cdr.CalledStationID = v
+	}
+	cdr.UserName, _ = rfc2865.UserName_LookupString(r.Packet)
+	cdr.NASIdentifier, _ = rfc2865.NASIdentifier_LookupString(r.Packet)
+	if ip, err := rfc2865.NASIPAddress_Lookup(r.Packet); err == nil {
+		cdr.NASIPAddress = ip.String()
+	} else if r.RemoteAddr != nil {
+		cdr.NASIPAddress = strings.Split(r.RemoteAddr.String(), ":")[0]
+	}
+	if ip, err := rfc2865.FramedIPAddress_Lookup(r.Packet); err == nil {
+		cdr.FramedIPAddress = ip.String()
+	}
+	cdr.SessionTime = getValue(r, rfc2866.AcctSessionTime_Type)
+	cdr.InputOctets = uint64(getValue(r, rfc2869.AcctInputGigawords_Type))<<32 | uint64(getValue(r, rfc2866.AcctInputOctets_Type))
+	cdr.OutputOctets = uint64(getValue(r, rfc2869.AcctOutputGigawords_Type))<<32 | uint64(getValue(r, rfc2866.AcctOutputOctets_Type))
+	cdr.InputPackets = getValue(r, rfc2866.AcctInputPackets_Type)
+	cdr.OutputPackets = getValue(r, rfc2866.AcctOutputPackets_Type)
+	cdr.TerminateCause = getValue(r, rfc2866.AcctTerminateCause_Type)
+	return cdr, nil
+}
+
+func getValue(r *radius.Request, t radius.Type) uint32 {
+	valueAttr, exists := r.Lookup(t)
+	var value uint32
+	if exists && len(valueAttr) == 4 {
+		value = binary.BigEndian.Uint32(valueAttr)
+	}
+	return value
+}
+
+func setDefaults(cfg *Config) {
+	if cfg.FilePrefix == "" {
+		cfg.FilePrefix = defaultFilePrefix
+	}
+	if cfg.RotateSizeBytes <= 0 {
+		cfg.RotateSizeBytes = defaultRotateSizeBytes
+	}
+	if cfg.RotateIntervalSec <= 0 {
+		cfg.RotateIntervalSec = defaultRotateIntervalSec
+	}
+	if cfg.ShipIntervalSec <= 0 {
+		cfg.ShipIntervalSec = defaultShipIntervalSec
+	}
+	if cfg.KafkaBatchSize <= 0 {
+		cfg.KafkaBatchSize = defaultKafkaBatchSize
+	}
+	if cfg.KafkaTimeoutSec <= 0 {
+		cfg.KafkaTimeoutSec = defaultKafkaTimeoutSec
+	}
+}
+
+func newExporter(logger *zap.Logger, cfg Config) (*exporter, error) {
+	format, err := NewFormat(cfg.Format)
+	if err != nil {
+		return nil, err
+	}
+	writer, err := NewFileWriter(
+		cfg.Directory, cfg.FilePrefix, format, cfg.RotateSizeBytes,
+		time.Duration(cfg.RotateIntervalSec)*time.Second, cfg.SyncWrites)
+	if err != nil {
+		return nil, err
+	}
+	e := &exporter{
+		config: cfg,
+		logger: logger,
+		writer: writer,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	if len(cfg.KafkaBrokers) > 0 {
+		e.shipper = NewShipper(
+			writer, NewKafkaProducer(cfg.KafkaBrokers, cfg.KafkaTopic), cfg.KafkaBatchSize,
+			time.Duration(cfg.KafkaTimeoutSec)*time.Second, logger)
+	}
+	go e.run(time.Duration(cfg.ShipIntervalSec) * time.Second)
+	return e, nil
+}
+
+// run periodically completes aged files, ships them & prunes the directory until the exporter is closed
+func (e *exporter) run(interval time.Duration) {
+	defer close(e.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stop:
+			return
+		case <-ticker.C:
+			e.tick()
+		}
+	}
+}
+
+func (e *exporter) tick() {
+	if err := e.writer.RotateIfDue(); err != nil {
+		e.logger.Error("failed to rotate CDR file", zap.Error(err))
+	}
+	if e.shipper != nil {
+		if err := e.shipper.Ship(); err != nil {
+			e.logger.Warn("failed to ship CDR files, will retry", zap.Error(err))
+		}
+	}
+	if err := e.prune(); err != nil {
+		e.logger.Error("failed to prune CDR files", zap.Error(err))
+	}
+}
+
+// prune removes the oldest completed files beyond MaxFiles, files which were not shipped yet are kept
+func (e *exporter) prune() error {
+	if e.config.MaxFiles <= 0 {
+		return nil
+	}
+	files, err := e.writer.Completed()
+	if err != nil || len(files) <= e.config.MaxFiles {
+		return err
+	}
+	var shipped string
+	if e.shipper != nil {
+		shipped = e.shipper.Checkpoint()
+	}
+	for _, f := range files[:len(files)-e.config.MaxFiles] {
+		if e.shipper != nil && filepath.Base(f) > shipped {
+			break
+		}
+		if err = os.Remove(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close stops the background loop, completes the active file and makes a last shipping attempt
+func (e *exporter) close() {
+	close(e.stop)
+	<-e.done
+	if err := e.writer.Close(); err != nil {
+		e.logger.Error("failed to close CDR file", zap.Error(err))
+	}
+	if e.shipper != nil {
+		if err := e.shipper.Ship(); err != nil {
+			e.logger.Warn("failed to ship CDR files on close", zap.Error(err))
+		}
+		_ = e.shipper.Close()
+	}
+}
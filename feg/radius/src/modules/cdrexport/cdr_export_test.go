@@ -0,0 +1,219 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cdrexport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"fbc/cwf/radius/modules"
+	"fbc/cwf/radius/session"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+	"layeh.com/radius/rfc2866"
+	"layeh.com/radius/rfc2869"
+)
+
+func TestHandleWritesCDRs(t *testing.T) {
+	for _, format := range []string{"csv", "json"} {
+		t.Run(format, func(t *testing.T) {
+			dir := t.TempDir()
+			logger, _ := zap.NewDevelopment()
+			mCtx, err := Init(logger, modules.ModuleConfig{"Directory": dir, "Format": format})
+			require.NoError(t, err)
+			e := mCtx.(ModuleCtx).exporter
+
+			// Same configuration must reuse the exporter
+			mCtx2, err := Init(logger, modules.ModuleConfig{"Directory": dir, "Format": format})
+			require.NoError(t, err)
+			require.Equal(t, e, mCtx2.(ModuleCtx).exporter)
+
+			storage := session.NewSessionStorage(session.NewMultiSessionMemoryStorage(), "sid1")
+			require.NoError(t, storage.Set(session.State{MSISDN: "5551234", MACAddress: "aa-bb-cc-dd-ee-ff"}))
+			reqCtx := &modules.RequestContext{Logger: logger, SessionID: "sid1", SessionStorage: storage}
+			next := func(c *modules.RequestContext, r *radius.Request) (*modules.Response, error) {
+				return nil, nil
+			}
+
+			for _, r := range []*radius.Request{
+				createAcctRequest(rfc2866.AcctStatusType_Value_Start, 0, 0),
+				createAcctRequest(rfc2866.AcctStatusType_Value_AccountingOn, 0, 0),
+				createAcctRequest(rfc2866.AcctStatusType_Value_InterimUpdate, 1, 100),
+				createAcctRequest(rfc2866.AcctStatusType_Value_Stop, 2, 200),
+			} {
+				res, err := Handle(mCtx, reqCtx, r, next)
+				require.NoError(t, err)
+				require.Equal(t, radius.CodeAccountingResponse, res.Code)
+			}
+			// The exporter is closed with the last module instance using it
+			require.NoError(t, Close(mCtx2))
+			require.Equal(t, e, exporters[e.writer.dir])
+			require.NoError(t, Close(mCtx))
+			require.NotContains(t, exporters, e.writer.dir)
+
+			// A closed exporter must fail the request so the NAS retransmits it
+			_, err = Handle(mCtx, reqCtx, createAcctRequest(rfc2866.AcctStatusType_Value_Stop, 0, 0), next)
+			require.Error(t, err)
+
+			files, err := e.writer.Completed()
+			require.NoError(t, err)
+			require.Len(t, files, 1)
+			records := readFile(t, e.writer.Format(), files[0])
+			require.Len(t, records, 3)
+			require.Equal(t, []string{RecordTypeStart, RecordTypeInterim, RecordTypeStop},
+				[]string{records[0].RecordType, records[1].RecordType, records[2].RecordType})
+			stop := records[2]
+			require.Equal(t, "sid1", stop.SessionID)
+			require.Equal(t, "acct-1", stop.AcctSessionID)
+			require.Equal(t, "5551234", stop.MSISDN)
+			require.Equal(t, "AA-BB-CC-DD-EE-FF", stop.MACAddress)
+			require.Equal(t, "ap1:cwf", stop.CalledStationID)
+			require.Equal(t, "10.0.0.1", stop.NASIPAddress)
+			require.Equal(t, uint64(2)<<32|200, stop.InputOctets)
+			require.Equal(t, uint64(200), stop.OutputOctets)
+			require.Equal(t, time.Unix(1600000000, 0).UTC(), stop.Timestamp)
+		})
+	}
+}
+
+func TestFileRotation(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewFileWriter(dir, "cdr", csvFormat{}, 1, time.Hour, false)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, w.Write(&CDR{RecordID: "r", RecordType: RecordTypeStart}))
+	}
+	files, err := w.Completed()
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	// Active files of a previous run are completed by a new writer
+	w, err = NewFileWriter(dir, "cdr", csvFormat{}, 1<<20, time.Hour, false)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(&CDR{RecordID: "r", RecordType: RecordTypeStop}))
+	parts, _ := filepath.Glob(filepath.Join(dir, "*"+partSuffix))
+	require.Len(t, parts, 1)
+	_, err = NewFileWriter(dir, "cdr", csvFormat{}, 1<<20, time.Hour, false)
+	require.NoError(t, err)
+	files, err = w.Completed()
+	require.NoError(t, err)
+	require.Len(t, files, 4)
+}
+
+func TestShipper(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewFileWriter(dir, "cdr", jsonFormat{}, 1, time.Hour, false)
+	require.NoError(t, err)
+	for _, sid := range []string{"s1", "s2", "s3"} {
+		require.NoError(t, w.Write(&CDR{RecordID: sid, SessionID: sid, RecordType: RecordTypeStop}))
+	}
+	producer := &mockProducer{fail: true}
+	s := NewShipper(w, producer, 2, time.Second, zap.NewNop())
+
+	require.Error(t, s.Ship())
+	require.Empty(t, s.Checkpoint())
+	require.Empty(t, producer.msgs)
+
+	producer.fail = false
+	require.NoError(t, s.Ship())
+	require.Len(t, producer.msgs, 3)
+	for i, sid := range []string{"s1", "s2", "s3"} {
+		require.Equal(t, sid, string(producer.msgs[i].Key))
+		var c CDR
+		require.NoError(t, json.Unmarshal(producer.msgs[i].Value, &c))
+		require.Equal(t, sid, c.RecordID)
+	}
+	files, _ := w.Completed()
+	require.Equal(t, filepath.Base(files[2]), s.Checkpoint())
+
+	// Nothing new to ship
+	require.NoError(t, s.Ship())
+	require.Len(t, producer.msgs, 3)
+	require.NoError(t, w.Write(&CDR{RecordID: "s4", SessionID: "s4"}))
+	require.NoError(t, s.Ship())
+	require.Len(t, producer.msgs, 4)
+}
+
+func TestPrune(t *testing.T) {
+	dir := t.TempDir()
+	w, err := NewFileWriter(dir, "cdr", csvFormat{}, 1, time.Hour, false)
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, w.Write(&CDR{RecordID: "r"}))
+	}
+	files, _ := w.Completed()
+	e := &exporter{config: Config{MaxFiles: 1}, writer: w, shipper: NewShipper(w, &mockProducer{}, 10, time.Second, zap.NewNop())}
+	require.NoError(t, e.shipper.setCheckpoint(filepath.Base(files[1])))
+
+	// only shipped files may be removed
+	require.NoError(t, e.prune())
+	remaining, _ := w.Completed()
+	require.Equal(t, files[2:], remaining)
+
+	e.shipper = nil
+	require.NoError(t, e.prune())
+	remaining, _ = w.Completed()
+	require.Equal(t, files[3:], remaining)
+}
+
+type mockProducer struct {
+	fail bool
+	msgs []kafka.Message
+}
+
+func (p *mockProducer) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+	if p.fail {
+		return errors.New("broker not available")
+	}
+	p.msgs = append(p.msgs, msgs...)
+	return nil
+}
+
+func (p *mockProducer) Close() error {
+	return nil
+}
+
+func readFile(t *testing.T, format Format, name string) []*CDR {
+	f, err := os.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := format.ReadRecords(f)
+	require.NoError(t, err)
+	return records
+}
+
+func createAcctRequest(statusType rfc2866.AcctStatusType, gigawords, octets uint32) *radius.Request {
+	packet := radius.New(radius.CodeAccountingRequest, []byte{0x01, 0x02, 0x03})
+	rfc2866.AcctStatusType_Set(packet, statusType)
+	rfc2866.AcctSessionID_SetString(packet, "acct-1")
+	rfc2865.CallingStationID_SetString(packet, "AA-BB-CC-DD-EE-FF")
+	rfc2865.CalledStationID_SetString(packet, "ap1:cwf")
+	rfc2869.EventTimestamp_Set(packet, time.Unix(1600000000, 0))
+	rfc2869.AcctInputGigawords_Set(packet, rfc2869.AcctInputGigawords(gigawords))
+	rfc2866.AcctInputOctets_Set(packet, rfc2866.AcctInputOctets(octets))
+	rfc2866.AcctOutputOctets_Set(packet, rfc2866.AcctOutputOctets(octets))
+	req := &radius.Request{Packet: packet}
+	req.RemoteAddr = &net.UDPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1813}
+	return req
+}
@@ -0,0 +1,26 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cdrexport
+
+import (
+	"fbc/cwf/radius/monitoring"
+)
+
+var (
+	// WriteRecord writing a CDR to the local CDR file
+	WriteRecord = monitoring.NewOperation("cdr_write")
+
+	// ShipFile producing a completed CDR file to the Kafka topic
+	ShipFile = monitoring.NewOperation("cdr_ship_file")
+)
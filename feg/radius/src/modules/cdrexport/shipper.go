@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cdrexport
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// checkpointFile holds the name of the last CDR file fully acknowledged by Kafka
+const checkpointFile = ".kafka_checkpoint"
+
+// Producer the subset of kafka.Writer used for shipping CDRs
+type Producer interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// NewKafkaProducer creates a synchronous producer for the topic which waits for all in-sync replicas to acknowledge
+func NewKafkaProducer(brokers []string, topic string) Producer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+}
+
+// Shipper produces completed CDR files to Kafka, one JSON message per CDR keyed by the session ID.
+// A file is checkpointed only after all of its records were acknowledged, so a failure or restart
+// re-ships the whole file: consumers may see duplicates (with the same record_id) but never miss a CDR.
+type Shipper struct {
+	writer    *FileWriter
+	producer  Producer
+	batchSize int
+	timeout   time.Duration
+	logger    *zap.Logger
+}
+
+// NewShipper creates a Shipper for the completed files of the writer
+func NewShipper(writer *FileWriter, producer Producer, batchSize int, timeout time.Duration, logger *zap.Logger) *Shipper {
+	return &Shipper{writer: writer, producer: producer, batchSize: batchSize, timeout: timeout, logger: logger}
+}
+
+// Ship produces all completed files newer than the checkpoint, it stops at the first failure
+func (s *Shipper) Ship() error {
+	files, err := s.writer.Completed()
+	if err != nil {
+		return err
+	}
+	last := s.Checkpoint()
+	for _, f := range files {
+		if filepath.Base(f) <= last {
+			continue
+		}
+		op := ShipFile.Start()
+		if err = s.shipFile(f); err != nil {
+			op.Failure("produce_failed")
+			return err
+		}
+		if err = s.setCheckpoint(filepath.Base(f)); err != nil {
+			op.Failure("checkpoint_failed")
+			return err
+		}
+		op.Success()
+	}
+	return nil
+}
+
+// Checkpoint returns the base name of the last shipped file, empty if none
+func (s *Shipper) Checkpoint() string {
+	b, err := ioutil.ReadFile(filepath.Join(s.writer.dir, checkpointFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// Close closes the underlying producer
+func (s *Shipper) Close() error {
+	return s.producer.Close()
+}
+
+func (s *Shipper) shipFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	records, err := s.writer.Format().ReadRecords(f)
+	if err != nil {
+		return err
+	}
+	for len(records) > 0 {
+		n := s.batchSize
+		if n > len(records) {
+			n = len(records)
+		}
+		msgs := make([]kafka.Message, 0, n)
+		for _, c := range records[:n] {
+			value, err := json.Marshal(c)
+			if err != nil {
+				return err
+			}
+			msgs = append(msgs, kafka.Message{Key: []byte(c.SessionID), Value: value, Time: c.Timestamp})
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		err = s.producer.WriteMessages(ctx, msgs...)
+		cancel()
+		if err != nil {
+			return err
+		}
+		records = records[n:]
+	}
+	s.logger.Debug("shipped CDR file", zap.String("file", name))
+	return nil
+}
+
+func (s *Shipper) setCheckpoint(name string) error {
+	path := filepath.Join(s.writer.dir, checkpointFile)
+	if err := ioutil.WriteFile(path+".tmp", []byte(name+"\n"), 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cdrexport
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// partSuffix is appended to the name of the file being written, consumers must ignore such files
+	partSuffix = ".part"
+	// fileTimeFormat a fixed width, lexicographically sortable timestamp used in CDR file names
+	fileTimeFormat = "20060102T150405.000000000Z"
+)
+
+// FileWriter appends CDRs to the active CDR file of a directory & rotates it by size and age.
+// The active file carries a ".part" suffix which is dropped on rotation, completed files are
+// never modified again.
+type FileWriter struct {
+	mu         sync.Mutex
+	dir        string
+	prefix     string
+	format     Format
+	maxSize    int64
+	maxAge     time.Duration
+	syncWrites bool
+
+	closed  bool
+	file    *os.File
+	name    string // completed name of the active file
+	size    int64
+	created time.Time
+}
+
+// NewFileWriter creates a FileWriter for the given directory. Active files left over by a previous run are
+// completed, so their records are not lost.
+func NewFileWriter(dir, prefix string, format Format, maxSize int64, maxAge time.Duration, syncWrites bool) (*FileWriter, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	w := &FileWriter{
+		dir:        dir,
+		prefix:     prefix,
+		format:     format,
+		maxSize:    maxSize,
+		maxAge:     maxAge,
+		syncWrites: syncWrites,
+	}
+	leftovers, err := filepath.Glob(filepath.Join(dir, w.pattern()+partSuffix))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range leftovers {
+		if err = os.Rename(f, strings.TrimSuffix(f, partSuffix)); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// Write appends the CDR to the active file, the CDR is handed to the OS (and synced to disk if
+// configured) when Write returns without an error
+func (w *FileWriter) Write(c *CDR) error {
+	var buf bytes.Buffer
+	if err := w.format.WriteRecord(&buf, c); err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return errors.New("CDR file writer is closed")
+	}
+	if w.file != nil && time.Since(w.created) >= w.maxAge {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+	if w.file == nil {
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+	n, err := w.file.Write(buf.Bytes())
+	w.size += int64(n)
+	if err != nil {
+		return err
+	}
+	if w.syncWrites {
+		if err = w.file.Sync(); err != nil {
+			return err
+		}
+	}
+	if w.maxSize > 0 && w.size >= w.maxSize {
+		return w.rotate()
+	}
+	return nil
+}
+
+// RotateIfDue completes the active file if it's older than the configured maximum age
+func (w *FileWriter) RotateIfDue() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.file == nil || time.Since(w.created) < w.maxAge {
+		return nil
+	}
+	return w.rotate()
+}
+
+// Close completes the active file, following writes fail
+func (w *FileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	return w.rotate()
+}
+
+// Completed returns the names of completed CDR files, oldest first
+func (w *FileWriter) Completed() ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(w.dir, w.pattern()))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// Format returns the format of the written files
+func (w *FileWriter) Format() Format {
+	return w.format
+}
+
+func (w *FileWriter) pattern() string {
+	return fmt.Sprintf("%s-*.%s", w.prefix, w.format.Extension())
+}
+
+func (w *FileWriter) open() error {
+	now := time.Now().UTC()
+	name := filepath.Join(w.dir, fmt.Sprintf("%s-%s.%s", w.prefix, now.Format(fileTimeFormat), w.format.Extension()))
+	f, err := os.OpenFile(name+partSuffix, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err = w.format.WriteHeader(&buf); err == nil {
+		_, err = f.Write(buf.Bytes())
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(name + partSuffix)
+		return err
+	}
+	w.file, w.name, w.size, w.created = f, name, int64(buf.Len()), now
+	return nil
+}
+
+// rotate syncs, closes & completes the active file, must be called with the lock held
+func (w *FileWriter) rotate() error {
+	if w.file == nil {
+		return nil
+	}
+	f := w.file
+	w.file = nil
+	err := f.Sync()
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if rerr := os.Rename(w.name+partSuffix, w.name); err == nil {
+		err = rerr
+	}
+	return err
+}
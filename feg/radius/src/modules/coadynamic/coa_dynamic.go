@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"fbc/cwf/radius/clients"
 	"fbc/cwf/radius/modules"
 	"fbc/cwf/radius/modules/coadynamic/radiustracker"
 
@@ -70,7 +71,7 @@ func Handle(m modules.Context, c *modules.RequestContext, r *radius.Request, nex
 		destination := fmt.Sprintf("%s:%d", target, mod.port)
 		ctx, dispose := context.WithTimeout(context.Background(), time.Second*time.Duration(mod.timeout))
 		defer dispose()
-		res, err := radius.Exchange(ctx, r.Packet, destination)
+		res, err := radius.Exchange(ctx, clients.PacketFor(target, r.Packet), destination)
 		if err != nil {
 			c.Logger.Debug(
 				"failed sending CoA",
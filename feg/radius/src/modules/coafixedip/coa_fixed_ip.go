@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 
+	"fbc/cwf/radius/clients"
 	"fbc/cwf/radius/modules"
 
 	"github.com/mitchellh/mapstructure"
@@ -72,8 +73,9 @@ func Handle(m modules.Context, c *modules.RequestContext, r *radius.Request, nex
 		return next(c, r)
 	}
 
-	// Handling the coa request
-	res, err := radius.Exchange(context.Background(), r.Packet, mod.target)
+	// Handling the coa request, signed with the secret of the target NAS
+	host, _, _ := net.SplitHostPort(mod.target)
+	res, err := radius.Exchange(context.Background(), clients.PacketFor(host, r.Packet), mod.target)
 	if err != nil {
 		return nil, err
 	}
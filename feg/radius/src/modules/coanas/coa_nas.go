@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"fbc/cwf/radius/clients"
 	"fbc/cwf/radius/modules"
 
 	"github.com/mitchellh/mapstructure"
@@ -53,9 +54,10 @@ func Handle(m modules.Context, c *modules.RequestContext, r *radius.Request, nex
 		return next(c, r)
 	}
 
-	// Sending the request to the ip specified in the nas attribute
+	// Sending the request to the ip specified in the nas attribute, signed
+	// with the secret of that NAS
 	host := coaNasAttribute.String()
-	res, err := radius.Exchange(context.Background(), r.Packet, fmt.Sprintf("%s:%s", host, mCtx.port))
+	res, err := radius.Exchange(context.Background(), clients.PacketFor(host, r.Packet), fmt.Sprintf("%s:%s", host, mCtx.port))
 	if err != nil {
 		return nil, err
 	}
@@ -16,6 +16,7 @@ package eap
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	"fbc/cwf/radius/modules"
 	"fbc/cwf/radius/modules/eap/authstate"
@@ -41,7 +42,12 @@ type Config struct {
 	Methods []Method
 }
 
-// stateManager a state manage instance
+var (
+	stateManager     authstate.Manager
+	stateManagerOnce sync.Once
+)
+
+// ModuleCtx a module instance, with the shared state manager
 type ModuleCtx struct {
 	stateManager authstate.Manager
 	method       methods.EapMethod
@@ -60,11 +66,12 @@ func Init(logger *zap.Logger, config modules.ModuleConfig) (modules.Context, err
 		return nil, err
 	}
 
-	// Initialize State Manager singleton
-	// TODO: sync object
-	if mCtx.stateManager == nil {
-		mCtx.stateManager = authstate.NewMemoryManager()
-	}
+	// The state manager is shared by all module instances, so authentications
+	// in progress survive config reloads re-initializing the module
+	stateManagerOnce.Do(func() {
+		stateManager = authstate.NewMemoryManager()
+	})
+	mCtx.stateManager = stateManager
 
 	// TODO: handle multiple methods (currently assuming only one)
 	mCtx.method, err = getMethod(eapConfig.Methods[0])
@@ -40,6 +40,7 @@ type Config struct {
 
 // ModuleCtx ...
 type ModuleCtx struct {
+	conn   *grpc.ClientConn
 	client protos.AccountingClient
 }
 
@@ -61,7 +62,12 @@ func Init(logger *zap.Logger, config modules.ModuleConfig) (modules.Context, err
 		return nil, err
 	}
 
-	return ModuleCtx{client: protos.NewAccountingClient(conn)}, nil
+	return ModuleCtx{conn: conn, client: protos.NewAccountingClient(conn)}, nil
+}
+
+// Close module interface implementation
+func Close(m modules.Context) error {
+	return m.(ModuleCtx).conn.Close()
 }
 
 // Handle module interface implementation
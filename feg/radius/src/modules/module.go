@@ -60,9 +60,21 @@ type (
 		Handle(m Context, c *RequestContext, r *radius.Request, next Middleware) (*Response, error)
 	}
 
+	// Closer is implemented by modules whose context holds resources (e.g.
+	// connections, files or background routines). Close is called once for
+	// every context returned by Init, after the chain it belongs to was
+	// replaced by a config reload & its requests in flight completed, or when
+	// the server stops
+	Closer interface {
+		Close(m Context) error
+	}
+
 	// ModuleInitFunc type for module's Init function
 	ModuleInitFunc func(loggert *zap.Logger, config ModuleConfig) (Context, error)
 
 	// ModuleHandleFunc type for module's Handle function
 	ModuleHandleFunc func(m Context, c *RequestContext, r *radius.Request, next Middleware) (*Response, error)
+
+	// ModuleCloseFunc type for module's Close function
+	ModuleCloseFunc func(m Context) error
 )
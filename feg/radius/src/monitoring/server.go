@@ -16,15 +16,23 @@ type ServerCounters struct {
 
 	// DedupPacket RADIUS dedup logic counter
 	DedupPacket Operation
+
+	// ClientAuthorize RADIUS client (NAS) authorization counter
+	ClientAuthorize Operation
+
+	// ConfigReload counter for hot-reloads of filters & module chains
+	ConfigReload Operation
 }
 
 // CreateServerCounters ...
 func CreateServerCounters() *ServerCounters {
 	return &ServerCounters{
-		Init:         NewOperation("server_init"),
-		ListenerInit: NewOperation("listener_init"),
-		FilterInit:   NewOperation("filter_init"),
-		ModuleInit:   NewOperation("module_init"),
-		DedupPacket:  NewOperation("radius_dedup"),
+		Init:            NewOperation("server_init"),
+		ListenerInit:    NewOperation("listener_init"),
+		FilterInit:      NewOperation("filter_init"),
+		ModuleInit:      NewOperation("module_init"),
+		DedupPacket:     NewOperation("radius_dedup"),
+		ClientAuthorize: NewOperation("radius_client_authorize"),
+		ConfigReload:    NewOperation("server_config_reload"),
 	}
 }
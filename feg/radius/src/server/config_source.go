@@ -0,0 +1,124 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"fbc/cwf/radius/config"
+
+	"go.uber.org/zap"
+)
+
+// DefaultConfigPollInterval how often the config file is checked for changes
+const DefaultConfigPollInterval = 30 * time.Second
+
+// ConfigSource reloads the server whenever its config file changes
+type ConfigSource struct {
+	config config.ReloadConfig
+	server *Server
+	logger *zap.Logger
+
+	mu      sync.Mutex
+	modTime time.Time
+	stop    chan struct{}
+}
+
+// NewConfigSource creates a config source reloading the server, configFile is
+// watched unless the reload config sets another file
+func NewConfigSource(reloadConfig config.ReloadConfig, configFile string, server *Server, logger *zap.Logger) (*ConfigSource, error) {
+	if reloadConfig.File == "" {
+		reloadConfig.File = configFile
+	}
+	if reloadConfig.File == "" {
+		return nil, errors.New("config source requires a file")
+	}
+	if reloadConfig.MconfigKey == "" {
+		reloadConfig.MconfigKey = config.DefaultMconfigKey
+	}
+	if reloadConfig.PollInterval.Duration <= 0 {
+		reloadConfig.PollInterval.Duration = DefaultConfigPollInterval
+	}
+	return &ConfigSource{
+		config: reloadConfig,
+		server: server,
+		logger: logger.With(zap.String("config_file", reloadConfig.File)),
+	}, nil
+}
+
+// Reload reloads the server if the file changed since the last check, returns
+// true if the server is now running a new config
+func (s *ConfigSource) Reload() (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, err := os.Stat(s.config.File)
+	if err != nil {
+		return false, err
+	}
+	if info.ModTime().Equal(s.modTime) {
+		return false, nil
+	}
+	radiusConfig, err := config.ReadMconfig(s.config.File, s.config.MconfigKey)
+	if err != nil {
+		return false, err
+	}
+	// the file is considered checked even if it's invalid, there is no point
+	// in rebuilding the chains until it changes again
+	s.modTime = info.ModTime()
+	previous := s.server.ConfigVersion()
+	err = s.server.Reload(radiusConfig.Server)
+	if err != nil {
+		return false, err
+	}
+	return s.server.ConfigVersion().Generation != previous.Generation, nil
+}
+
+// Start applies the file if it differs from the config the server was started
+// with & polls the file for changes until Stop is called
+func (s *ConfigSource) Start() {
+	if _, err := s.Reload(); err != nil {
+		s.logger.Error("failed to reload config", zap.Error(err))
+	}
+	s.mu.Lock()
+	s.stop = make(chan struct{})
+	stop := s.stop
+	s.mu.Unlock()
+	go func() {
+		ticker := time.NewTicker(s.config.PollInterval.Duration)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if _, err := s.Reload(); err != nil {
+					s.logger.Error("failed to reload config", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops polling the file
+func (s *ConfigSource) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		close(s.stop)
+		s.stop = nil
+	}
+}
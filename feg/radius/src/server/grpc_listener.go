@@ -103,11 +103,6 @@ func (l *GRPCListener) ListenAndServe() error {
 	return nil
 }
 
-// GetHandleRequest override
-func (l *GRPCListener) GetHandleRequest() modules.Middleware {
-	return l.HandleRequest
-}
-
 // Shutdown override
 func (l *GRPCListener) Shutdown(ctx context.Context) error {
 	return nil
@@ -192,7 +187,7 @@ func (s *authorizationServer) handleCoaRequest(ctx *protos.Context, request *rad
 
 	// Handle
 	counter := monitoring.NewOperation("handle_grpc").Start()
-	res, err := s.Listener.HandleRequest(&requestContext, request)
+	res, err := s.Listener.GetHandleRequest()(&requestContext, request)
 	if err != nil {
 		requestContext.Logger.Error("failed to handle request", zap.Error(err))
 		counter.Failure("grpc_handle_error")
@@ -15,6 +15,8 @@ package server
 
 import (
 	"context"
+	"sync"
+
 	"fbc/cwf/radius/config"
 	"fbc/cwf/radius/modules"
 	"fbc/cwf/radius/monitoring"
@@ -56,29 +58,37 @@ type ListenerInterface interface {
 	ListenAndServe() error
 }
 
-// Listener base implementation of a listener
+// Listener base implementation of a listener. The modules & the request
+// handler may be replaced by a config reload while requests are served
 type Listener struct {
 	ListenerInterface
 	Config        config.ListenerConfig
-	Modules       []Module
-	HandleRequest modules.Middleware
 	Server        *Server
 	dupDropped    uint32
+	mu            sync.RWMutex
+	modules       []Module
+	handleRequest modules.Middleware
 }
 
 // GetModules ...
 func (l *Listener) GetModules() []Module {
-	return l.Modules
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.modules
 }
 
 // SetModules ...
 func (l *Listener) SetModules(m []Module) {
-	l.Modules = m
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.modules = m
 }
 
 // AppendModule ...
 func (l *Listener) AppendModule(m *Module) {
-	l.Modules = append(l.Modules, *m)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.modules = append(l.modules, *m)
 }
 
 // GetConfig ...
@@ -91,14 +101,19 @@ func (l *Listener) SetConfig(c config.ListenerConfig) {
 	l.Config = c
 }
 
-// GetHandleRequest ...
+// GetHandleRequest returns the request handler, requests must get it once so
+// they complete on the same module chain even if it's replaced meanwhile
 func (l *Listener) GetHandleRequest() modules.Middleware {
-	return l.HandleRequest
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.handleRequest
 }
 
 // SetHandleRequest ...
 func (l *Listener) SetHandleRequest(hr modules.Middleware) {
-	l.HandleRequest = hr
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.handleRequest = hr
 }
 
 // GetDupDropped override
@@ -0,0 +1,402 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/monitoring"
+
+	"github.com/mitchellh/mapstructure"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+)
+
+const (
+	// RadSecDefaultSecret the shared secret mandated by RFC 6614 section 2.3
+	RadSecDefaultSecret = "radsec"
+	// RadSecDefaultPort the IANA assigned RadSec port
+	RadSecDefaultPort = 2083
+	// RadSecDefaultIdleTimeout connections idle for longer than this are closed
+	RadSecDefaultIdleTimeout = 5 * time.Minute
+
+	radSecHeaderLen = 4
+)
+
+// RadSecListener listens to RADIUS packets over TLS/TCP (RadSec, RFC 6614)
+type RadSecListener struct {
+	Listener
+	Handler     radius.Handler
+	Addr        string
+	Secret      []byte
+	TLSConfig   *tls.Config
+	IdleTimeout time.Duration
+	// clients maps a peer certificate identity (subject CN or DNS SAN) to the
+	// configured client name. When empty, any peer with a trusted certificate
+	// is accepted
+	clients map[string]string
+	logger  *zap.Logger
+	ready   chan bool
+
+	mu           sync.Mutex
+	netListener  net.Listener
+	conns        map[net.Conn]struct{}
+	shuttingDown bool
+	active       sync.WaitGroup
+}
+
+// RadSecClientConfig maps a peer certificate identity to a RADIUS client
+type RadSecClientConfig struct {
+	Name       string `json:"name"`
+	CommonName string `json:"commonName"`
+}
+
+// RadSecListenerExtraConfig extra config for RadSec listener
+type RadSecListenerExtraConfig struct {
+	Port        int                  `json:"port"`
+	CertFile    string               `json:"certFile"`
+	KeyFile     string               `json:"keyFile"`
+	CAFile      string               `json:"caFile"`
+	Secret      string               `json:"secret"`
+	IdleTimeout string               `json:"idleTimeout"`
+	Clients     []RadSecClientConfig `json:"clients"`
+}
+
+// NewRadSecListener ...
+func NewRadSecListener() *RadSecListener {
+	return &RadSecListener{
+		ready: make(chan bool),
+		conns: make(map[net.Conn]struct{}),
+	}
+}
+
+// Init override
+func (l *RadSecListener) Init(
+	server *Server,
+	serverConfig config.ServerConfig,
+	listenerConfig config.ListenerConfig,
+	ctrs monitoring.ListenerCounters,
+) error {
+	if server == nil {
+		return errors.New("cannot initialize RadSec listener with null server")
+	}
+
+	// Parse configuration
+	var cfg RadSecListenerExtraConfig
+	err := mapstructure.Decode(listenerConfig.Extra, &cfg)
+	if err != nil {
+		return err
+	}
+	tlsConfig, err := newRadSecTLSConfig(cfg)
+	if err != nil {
+		return err
+	}
+	l.IdleTimeout = RadSecDefaultIdleTimeout
+	if cfg.IdleTimeout != "" {
+		l.IdleTimeout, err = time.ParseDuration(cfg.IdleTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid RadSec idle timeout: %w", err)
+		}
+	}
+	l.clients = make(map[string]string, len(cfg.Clients))
+	for _, client := range cfg.Clients {
+		if client.CommonName == "" {
+			return fmt.Errorf("RadSec client '%s' has no certificate common name", client.Name)
+		}
+		l.clients[client.CommonName] = client.Name
+	}
+	if cfg.Port == 0 {
+		cfg.Port = RadSecDefaultPort
+	}
+	if cfg.Secret == "" {
+		cfg.Secret = RadSecDefaultSecret
+	}
+
+	l.Server = server
+	l.logger = server.logger.With(zap.String("listener", listenerConfig.Name))
+	l.Addr = fmt.Sprintf(":%d", cfg.Port)
+	l.Secret = []byte(cfg.Secret)
+	l.TLSConfig = tlsConfig
+	// Peers are authenticated by their certificates, the clients registry
+	// still applies its listener allow-list & rate limits
+	l.Handler = radius.HandlerFunc(
+		generateClientAuthorizationHandler(l, server, true, generatePacketHandler(l, server, ctrs)),
+	)
+	return nil
+}
+
+// newRadSecTLSConfig builds a mutual TLS server configuration: clients must
+// present a certificate signed by the configured CA
+func newRadSecTLSConfig(cfg RadSecListenerExtraConfig) (*tls.Config, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" || cfg.CAFile == "" {
+		return nil, errors.New("RadSec listener requires certFile, keyFile & caFile")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load RadSec server certificate: %w", err)
+	}
+	caPEM, err := ioutil.ReadFile(cfg.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read RadSec CA file: %w", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no valid certificates found in RadSec CA file %s", cfg.CAFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ListenAndServe override
+func (l *RadSecListener) ListenAndServe() error {
+	lis, err := tls.Listen("tcp", l.Addr, l.TLSConfig)
+	if err != nil {
+		go func() {
+			l.ready <- false
+		}()
+		return fmt.Errorf("radsec listener: failed to listen on %s: %w", l.Addr, err)
+	}
+	l.mu.Lock()
+	l.netListener = lis
+	l.mu.Unlock()
+
+	go l.serve(lis)
+
+	// Signal listener is ready
+	go func() {
+		l.ready <- true
+	}()
+	return nil
+}
+
+// serve accepts connections until the listener is closed
+func (l *RadSecListener) serve(lis net.Listener) {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if l.isShuttingDown() {
+				return
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				l.logger.Warn("failed to accept RadSec connection", zap.Error(err))
+				continue
+			}
+			l.logger.Error("RadSec listener stopped accepting connections", zap.Error(err))
+			return
+		}
+		if !l.trackConn(conn, true) {
+			conn.Close()
+			return
+		}
+		l.active.Add(1)
+		go func() {
+			defer l.active.Done()
+			defer l.trackConn(conn, false)
+			defer conn.Close()
+			l.serveConn(conn.(*tls.Conn))
+		}()
+	}
+}
+
+// serveConn authorizes the peer and reads RADIUS packets off the TLS stream
+// until the peer disconnects, the connection is idle or a framing error occurs
+func (l *RadSecListener) serveConn(conn *tls.Conn) {
+	logger := l.logger.With(zap.Stringer("remote_addr", conn.RemoteAddr()))
+	handshakeCounter := monitoring.NewOperation("radsec_handshake").Start()
+	conn.SetDeadline(time.Now().Add(l.IdleTimeout))
+	err := conn.Handshake()
+	if err != nil {
+		logger.Warn("RadSec TLS handshake failed", zap.Error(err))
+		handshakeCounter.Failure("tls_handshake_failed")
+		return
+	}
+	clientName, err := l.authorizeClient(conn.ConnectionState())
+	if err != nil {
+		logger.Warn("RadSec client rejected", zap.Error(err))
+		handshakeCounter.Failure("unknown_client")
+		return
+	}
+	handshakeCounter.Success()
+	logger = logger.With(zap.String("client", clientName))
+	logger.Debug("RadSec client connected")
+
+	// The handshake deadline must not apply to responses, those get their own
+	// write deadline & the read deadline is refreshed per packet
+	conn.SetDeadline(time.Time{})
+	writer := &radSecResponseWriter{conn: conn, timeout: l.IdleTimeout}
+	for {
+		conn.SetReadDeadline(time.Now().Add(l.IdleTimeout))
+		buff, err := readRadSecPacket(conn)
+		if err != nil {
+			if err != io.EOF && !l.isShuttingDown() {
+				logger.Warn("closing RadSec connection", zap.Error(err))
+			}
+			return
+		}
+		if !radius.IsAuthenticRequest(buff, l.Secret) {
+			logger.Warn("RadSec packet validation failed; bad secret")
+			continue
+		}
+		packet, err := radius.Parse(buff, l.Secret)
+		if err != nil {
+			logger.Warn("unable to parse RadSec packet", zap.Error(err))
+			continue
+		}
+		request := &radius.Request{
+			LocalAddr:  conn.LocalAddr(),
+			RemoteAddr: conn.RemoteAddr(),
+			Packet:     packet,
+		}
+		// Requests on a connection are handled concurrently, same as UDP
+		l.active.Add(1)
+		go func() {
+			defer l.active.Done()
+			l.Handler.ServeRADIUS(writer, request)
+		}()
+	}
+}
+
+// authorizeClient maps the verified peer certificate to a configured client
+func (l *RadSecListener) authorizeClient(state tls.ConnectionState) (string, error) {
+	if len(state.PeerCertificates) == 0 {
+		return "", errors.New("no peer certificate")
+	}
+	cert := state.PeerCertificates[0]
+	if len(l.clients) == 0 {
+		return cert.Subject.CommonName, nil
+	}
+	if name, ok := l.clients[cert.Subject.CommonName]; ok {
+		return name, nil
+	}
+	for _, dnsName := range cert.DNSNames {
+		if name, ok := l.clients[dnsName]; ok {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("certificate '%s' is not mapped to any client", cert.Subject.CommonName)
+}
+
+// readRadSecPacket reads a single RADIUS packet from the stream, the packet
+// boundaries are determined by the RADIUS Length field (RFC 6614 section 2.5)
+func readRadSecPacket(r io.Reader) ([]byte, error) {
+	header := make([]byte, radSecHeaderLen)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return nil, err
+	}
+	length := int(binary.BigEndian.Uint16(header[2:4]))
+	if length < 20 || length > radius.MaxPacketLength {
+		return nil, fmt.Errorf("invalid RADIUS packet length %d", length)
+	}
+	buff := make([]byte, length)
+	copy(buff, header)
+	_, err = io.ReadFull(r, buff[radSecHeaderLen:])
+	if err != nil {
+		return nil, err
+	}
+	return buff, nil
+}
+
+func (l *RadSecListener) trackConn(conn net.Conn, add bool) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if add {
+		if l.shuttingDown {
+			return false
+		}
+		l.conns[conn] = struct{}{}
+	} else {
+		delete(l.conns, conn)
+	}
+	return true
+}
+
+func (l *RadSecListener) isShuttingDown() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.shuttingDown
+}
+
+// Shutdown override
+func (l *RadSecListener) Shutdown(ctx context.Context) error {
+	l.mu.Lock()
+	l.shuttingDown = true
+	if l.netListener != nil {
+		l.netListener.Close()
+	}
+	for conn := range l.conns {
+		conn.Close()
+	}
+	l.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		l.active.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Ready override
+func (l *RadSecListener) Ready() chan bool {
+	return l.ready
+}
+
+// SetConfig override
+func (l *RadSecListener) SetConfig(c config.ListenerConfig) {
+	l.Config = c
+}
+
+// radSecResponseWriter writes responses to the TLS stream, responses of
+// concurrently handled requests are serialized
+type radSecResponseWriter struct {
+	mu      sync.Mutex
+	conn    net.Conn
+	timeout time.Duration
+}
+
+// Write override
+func (w *radSecResponseWriter) Write(packet *radius.Packet) error {
+	encoded, err := packet.Encode()
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timeout > 0 {
+		w.conn.SetWriteDeadline(time.Now().Add(w.timeout))
+	}
+	_, err = w.conn.Write(encoded)
+	return err
+}
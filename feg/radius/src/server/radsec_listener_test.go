@@ -0,0 +1,339 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"math/rand"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/loader/loaderstest"
+	"fbc/cwf/radius/modules"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+type radSecTestPKI struct {
+	dir      string
+	caCert   *x509.Certificate
+	caKey    *ecdsa.PrivateKey
+	caFile   string
+	certFile string
+	keyFile  string
+}
+
+func TestRadSecListener(t *testing.T) {
+	// Arrange
+	pki := newRadSecTestPKI(t)
+	server, port := startRadSecTestServer(t, pki, nil)
+	defer server.Stop()
+
+	conn := dialRadSec(t, pki, port, "ap-1.venue-1.magma.com")
+	defer conn.Close()
+
+	// Act & Assert: several requests over the same connection
+	for id := byte(1); id <= 2; id++ {
+		request := newRadSecTestRequest(t, id)
+		response := exchangeRadSec(t, conn, request)
+		assert.Equal(t, radius.CodeAccessAccept, response.Code)
+		assert.Equal(t, id, response.Identifier)
+		assert.Equal(t, "hello", rfc2865.ReplyMessage_GetString(response))
+	}
+
+	// duplicate identifier on the same connection is dropped
+	duplicate, err := newRadSecTestRequest(t, 2).Encode()
+	require.NoError(t, err)
+	_, err = conn.Write(duplicate)
+	require.NoError(t, err)
+	response := exchangeRadSec(t, conn, newRadSecTestRequest(t, 3))
+	assert.Equal(t, byte(3), response.Identifier)
+	assert.Equal(t, uint32(1), server.GetDroppedCount())
+}
+
+func TestRadSecListenerRejectsUnknownClient(t *testing.T) {
+	pki := newRadSecTestPKI(t)
+	server, port := startRadSecTestServer(t, pki, nil)
+	defer server.Stop()
+
+	// Trusted certificate which is not mapped to any client
+	conn := dialRadSec(t, pki, port, "rogue.magma.com")
+	defer conn.Close()
+	assertRadSecConnectionClosed(t, conn)
+
+	// No client certificate at all
+	caPool := x509.NewCertPool()
+	caPool.AddCert(pki.caCert)
+	conn, err := tls.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port), &tls.Config{RootCAs: caPool, ServerName: "localhost"})
+	if err == nil {
+		defer conn.Close()
+		assertRadSecConnectionClosed(t, conn)
+	}
+}
+
+func TestRadSecListenerIdleTimeout(t *testing.T) {
+	pki := newRadSecTestPKI(t)
+	server, port := startRadSecTestServer(t, pki, func(serverConfig *config.ServerConfig) {
+		serverConfig.Listeners[0].Extra["IdleTimeout"] = "500ms"
+	})
+	defer server.Stop()
+
+	conn := dialRadSec(t, pki, port, "ap-1.venue-1.magma.com")
+	defer conn.Close()
+
+	// An active connection is served well past the idle timeout
+	for id := byte(1); id <= 4; id++ {
+		time.Sleep(300 * time.Millisecond)
+		response := exchangeRadSec(t, conn, newRadSecTestRequest(t, id))
+		assert.Equal(t, id, response.Identifier)
+	}
+	// & closed once idle
+	time.Sleep(time.Second)
+	assertRadSecConnectionClosed(t, conn)
+}
+
+func TestRadSecListenerWithClients(t *testing.T) {
+	pki := newRadSecTestPKI(t)
+	server, port := startRadSecTestServer(t, pki, func(serverConfig *config.ServerConfig) {
+		serverConfig.Clients = []config.ClientConfig{
+			{
+				Name:   "venue-1",
+				CIDRs:  []string{"127.0.0.0/8"},
+				Secret: "udp-secret", // not used over RadSec
+				// a single request until the bucket refills
+				RateLimit: 0.001,
+				RateBurst: 1,
+			},
+		}
+	})
+	defer server.Stop()
+
+	conn := dialRadSec(t, pki, port, "ap-1.venue-1.magma.com")
+	defer conn.Close()
+	response := exchangeRadSec(t, conn, newRadSecTestRequest(t, 1))
+	assert.Equal(t, radius.CodeAccessAccept, response.Code)
+
+	// Rate limited request is dropped
+	encoded, err := newRadSecTestRequest(t, 2).Encode()
+	require.NoError(t, err)
+	_, err = conn.Write(encoded)
+	require.NoError(t, err)
+	conn.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
+	_, err = readRadSecPacket(conn)
+	if assert.Error(t, err) {
+		ne, ok := err.(net.Error)
+		assert.True(t, ok && ne.Timeout(), "expected no response, got: %v", err)
+	}
+
+	// Client not allowed on the listener
+	serverConfig := []config.ClientConfig{{Name: "venue-1", CIDRs: []string{"127.0.0.0/8"}, Secret: "s", Listeners: []string{"udp.0"}}}
+	require.NoError(t, server.clients.Update(serverConfig))
+	conn2 := dialRadSec(t, pki, port, "ap-1.venue-1.magma.com")
+	defer conn2.Close()
+	_, err = conn2.Write(encoded)
+	require.NoError(t, err)
+	conn2.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
+	_, err = readRadSecPacket(conn2)
+	assert.Error(t, err)
+}
+
+func TestReadRadSecPacket(t *testing.T) {
+	packet, err := radius.New(radius.CodeAccessRequest, []byte(RadSecDefaultSecret)).Encode()
+	require.NoError(t, err)
+
+	// Two packets back to back in the stream
+	stream := bytes.NewReader(append(append([]byte{}, packet...), packet...))
+	for i := 0; i < 2; i++ {
+		read, err := readRadSecPacket(stream)
+		require.NoError(t, err)
+		assert.Equal(t, packet, read)
+	}
+	_, err = readRadSecPacket(stream)
+	assert.Error(t, err)
+
+	// Invalid length
+	_, err = readRadSecPacket(bytes.NewReader([]byte{1, 1, 0, 19}))
+	assert.Error(t, err)
+	_, err = readRadSecPacket(bytes.NewReader([]byte{1, 1, 0x10, 0x01}))
+	assert.Error(t, err)
+
+	// Truncated packet
+	_, err = readRadSecPacket(bytes.NewReader(packet[:len(packet)-1]))
+	assert.Error(t, err)
+}
+
+func startRadSecTestServer(t *testing.T, pki *radSecTestPKI, configure func(*config.ServerConfig)) (*Server, int) {
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err, "failed to get logger")
+	port := 2000 + rand.Intn(5000)
+	serverConfig := config.ServerConfig{
+		DedupWindow: config.Duration{Duration: time.Minute},
+		SessionStorage: &config.SessionStorageConfig{
+			StorageType: "memory",
+		},
+		Listeners: []config.ListenerConfig{
+			{
+				Name: "radsec.0",
+				Type: "radsec",
+				Extra: map[string]interface{}{
+					"Port":     port,
+					"CertFile": pki.certFile,
+					"KeyFile":  pki.keyFile,
+					"CAFile":   pki.caFile,
+					"Clients": []interface{}{
+						map[string]interface{}{"name": "venue-1", "commonName": "ap-1.venue-1.magma.com"},
+					},
+				},
+				Modules: []config.ModuleDescriptor{
+					{Name: "module.auth.1", Config: make(modules.ModuleConfig)},
+				},
+			},
+		},
+	}
+	if configure != nil {
+		configure(&serverConfig)
+	}
+	mModule := createMockHandlerWithReturn(&modules.Response{
+		Code: radius.CodeAccessAccept,
+		Attributes: radius.Attributes{
+			&radius.AVP{Type: rfc2865.ReplyMessage_Type, Attribute: radius.Attribute("hello")},
+		},
+	}, nil)
+	loader := loaderstest.MockLoader{}
+	loader.On("LoadModule", "module.auth.1").Return(mModule, nil)
+
+	server, err := New(serverConfig, logger, &loader)
+	require.NoError(t, err)
+	require.True(t, server.StartAndWait(), "failed to initialize the server")
+	return server, port
+}
+
+func newRadSecTestRequest(t *testing.T, identifier byte) *radius.Packet {
+	packet := radius.New(radius.CodeAccessRequest, []byte(RadSecDefaultSecret))
+	packet.Identifier = identifier
+	require.NoError(t, rfc2865.UserName_SetString(packet, "tim"))
+	return packet
+}
+
+func exchangeRadSec(t *testing.T, conn net.Conn, request *radius.Packet) *radius.Packet {
+	encoded, err := request.Encode()
+	require.NoError(t, err)
+	_, err = conn.Write(encoded)
+	require.NoError(t, err)
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	raw, err := readRadSecPacket(conn)
+	require.NoError(t, err)
+	assert.True(t, radius.IsAuthenticResponse(raw, encoded, []byte(RadSecDefaultSecret)))
+	response, err := radius.Parse(raw, []byte(RadSecDefaultSecret))
+	require.NoError(t, err)
+	return response
+}
+
+func assertRadSecConnectionClosed(t *testing.T, conn net.Conn) {
+	encoded, err := newRadSecTestRequest(t, 1).Encode()
+	require.NoError(t, err)
+	conn.Write(encoded)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = readRadSecPacket(conn)
+	assert.Error(t, err)
+	if ne, ok := err.(net.Error); ok {
+		assert.False(t, ne.Timeout(), "expected the server to close the connection")
+	}
+}
+
+func dialRadSec(t *testing.T, pki *radSecTestPKI, port int, clientName string) *tls.Conn {
+	certPEM, keyPEM := pki.issue(t, clientName, x509.ExtKeyUsageClientAuth)
+	clientCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	caPool := x509.NewCertPool()
+	caPool.AddCert(pki.caCert)
+	conn, err := tls.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port), &tls.Config{
+		Certificates: []tls.Certificate{clientCert},
+		RootCAs:      caPool,
+		ServerName:   "localhost",
+	})
+	require.NoError(t, err)
+	return conn
+}
+
+// newRadSecTestPKI creates a CA & a server certificate signed by it
+func newRadSecTestPKI(t *testing.T) *radSecTestPKI {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "radsec-test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(cryptorand.Reader, template, template, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pki := &radSecTestPKI{dir: t.TempDir(), caCert: caCert, caKey: caKey}
+	pki.caFile = pki.write(t, "ca.pem", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	certPEM, keyPEM := pki.issue(t, "localhost", x509.ExtKeyUsageServerAuth)
+	pki.certFile = pki.write(t, "server.pem", certPEM)
+	pki.keyFile = pki.write(t, "server.key", keyPEM)
+	return pki
+}
+
+func (pki *radSecTestPKI) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(cryptorand.Reader, template, pki.caCert, &key.PublicKey, pki.caKey)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func (pki *radSecTestPKI) write(t *testing.T, name string, content []byte) string {
+	path := filepath.Join(pki.dir, name)
+	require.NoError(t, ioutil.WriteFile(path, content, 0600))
+	return path
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/loader"
+	"fbc/cwf/radius/modules"
+
+	"go.uber.org/zap"
+	"layeh.com/radius"
+)
+
+// ConfigVersion describes the config the active filters & module chains were
+// built from
+type ConfigVersion struct {
+	Version     string     `json:"version"`    // Hash of the server config
+	Generation  int        `json:"generation"` // 1 for the startup config, incremented by every reload
+	LoadedAt    time.Time  `json:"loadedAt"`
+	LastError   string     `json:"lastError,omitempty"` // The last reload failure since LoadedAt
+	LastErrorAt *time.Time `json:"lastErrorAt,omitempty"`
+}
+
+// reloadState the hot-reloadable part of the server, shared by all copies of
+// the Server
+type reloadState struct {
+	loader  loader.Loader
+	filters atomic.Value // []*Filter
+
+	mu      sync.Mutex // serializes reloads, guards config, version & chains
+	config  *config.ServerConfig
+	version ConfigVersion
+	chains  map[string]*moduleChain // The active chain of every listener
+}
+
+// moduleChain a listener's module chain, which tracks the requests it serves
+// so the module contexts are closed once it was replaced & drained
+type moduleChain struct {
+	listener ListenerInterface
+	modules  []Module
+	handler  modules.Middleware
+	logger   *zap.Logger
+
+	mu       sync.Mutex
+	inFlight int
+	retired  bool
+	closed   bool
+}
+
+func newModuleChain(s Server, listener ListenerInterface, mods []Module, handler modules.Middleware) *moduleChain {
+	return &moduleChain{listener: listener, modules: mods, handler: handler, logger: s.logger}
+}
+
+// handle serves the request on the chain. Requests which got the chain before
+// it was replaced, but only reach it after its modules were closed, are served
+// by the listener's active chain
+func (c *moduleChain) handle(rc *modules.RequestContext, r *radius.Request) (*modules.Response, error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return c.listener.GetHandleRequest()(rc, r)
+	}
+	c.inFlight++
+	c.mu.Unlock()
+	defer c.done()
+	return c.handler(rc, r)
+}
+
+func (c *moduleChain) done() {
+	c.mu.Lock()
+	c.inFlight--
+	drained := c.retired && c.inFlight == 0 && !c.closed
+	c.closed = c.closed || drained
+	c.mu.Unlock()
+	if drained {
+		go closeModules(c.logger, c.modules)
+	}
+}
+
+// retire marks the chain as replaced, its modules are closed as soon as the
+// requests in flight complete
+func (c *moduleChain) retire() {
+	c.mu.Lock()
+	c.retired = true
+	drained := c.inFlight == 0 && !c.closed
+	c.closed = c.closed || drained
+	c.mu.Unlock()
+	if drained {
+		closeModules(c.logger, c.modules)
+	}
+}
+
+// closeModules closes the contexts of the modules implementing modules.Closer
+func closeModules(logger *zap.Logger, mods []Module) {
+	for _, mod := range mods {
+		closer, ok := mod.Code.(modules.Closer)
+		if !ok {
+			continue
+		}
+		if err := closer.Close(mod.Context); err != nil {
+			logger.Error("module failed to close", zap.String("module_name", mod.Name), zap.Error(err))
+		}
+	}
+}
+
+// activate records c as the active config, must be called with mu locked or
+// before the server is started
+func (r *reloadState) activate(c *config.ServerConfig) error {
+	version, err := config.Version(*c)
+	if err != nil {
+		return err
+	}
+	r.config = c
+	r.version = ConfigVersion{
+		Version:    version,
+		Generation: r.version.Generation + 1,
+		LoadedAt:   time.Now(),
+	}
+	return nil
+}
+
+// getFilters returns the active filters
+func (s Server) getFilters() []*Filter {
+	return s.reload.filters.Load().([]*Filter)
+}
+
+// ConfigVersion returns the version of the active config
+func (s Server) ConfigVersion() ConfigVersion {
+	s.reload.mu.Lock()
+	defer s.reload.mu.Unlock()
+	return s.reload.version
+}
+
+// Reload validates the config, builds new filters & module chains from it in
+// the background and swaps them in. Requests in flight complete on the chains
+// they started with. Listeners are bound at startup, adding or removing
+// listeners or changing their type or extra config requires a restart and
+// fails the reload. The other server settings are ignored until restart
+func (s Server) Reload(newConfig config.ServerConfig) error {
+	version, err := config.Version(newConfig)
+	if err != nil {
+		return err
+	}
+	s.reload.mu.Lock()
+	defer s.reload.mu.Unlock()
+	if version == s.reload.version.Version {
+		return nil
+	}
+
+	counter := s.counters.ConfigReload.Start()
+	err = s.swapChains(&newConfig)
+	if err != nil {
+		now := time.Now()
+		s.reload.version.LastError = err.Error()
+		s.reload.version.LastErrorAt = &now
+		counter.Failure("invalid_config")
+		s.logger.Error("config reload failed", zap.String("version", version), zap.Error(err))
+		return err
+	}
+	err = s.reload.activate(&newConfig)
+	if err != nil {
+		counter.Failure("invalid_config")
+		return err
+	}
+	counter.Success()
+	s.logger.Info(
+		"config reloaded",
+		zap.String("version", version),
+		zap.Int("generation", s.reload.version.Generation),
+	)
+	return nil
+}
+
+// swapChains builds the config's filters & module chains and, if all succeed,
+// swaps them in. The replaced chains are retired, while the chains built for a
+// failed reload are closed right away. Must be called with reload.mu locked
+func (s Server) swapChains(newConfig *config.ServerConfig) error {
+	if len(newConfig.Listeners) != len(s.listeners) {
+		return fmt.Errorf("listeners were added or removed, a restart is required")
+	}
+	chains := make(map[string]*moduleChain, len(newConfig.Listeners))
+	discard := func() {
+		for _, c := range chains {
+			c.retire()
+		}
+	}
+	for _, lconfig := range newConfig.Listeners {
+		listener, ok := s.listeners[lconfig.Name]
+		if !ok {
+			discard()
+			return fmt.Errorf("listener '%s' was added, a restart is required", lconfig.Name)
+		}
+		active := listener.GetConfig()
+		if active.Type != lconfig.Type || !sameExtra(active.Extra, lconfig.Extra) {
+			discard()
+			return fmt.Errorf("listener '%s' type or extra config changed, a restart is required", lconfig.Name)
+		}
+		mods, handler, err := s.buildChain(lconfig)
+		if err != nil {
+			discard()
+			return fmt.Errorf("listener '%s': %w", lconfig.Name, err)
+		}
+		chains[lconfig.Name] = newModuleChain(s, listener, mods, handler)
+	}
+
+	// Filters keep their state globally, so they are initialized last & are
+	// rolled back by re-initializing them with the active config
+	filters, err := s.loadFilters(newConfig)
+	if err != nil {
+		discard()
+		if _, rollbackErr := s.loadFilters(s.reload.config); rollbackErr != nil {
+			s.logger.Error("failed to restore filters of the active config", zap.Error(rollbackErr))
+		}
+		return err
+	}
+	s.reload.filters.Store(filters)
+	for name, c := range chains {
+		s.setChain(name, c)
+	}
+	return nil
+}
+
+// setChain makes c the active chain of the listener & retires the chain it
+// replaces, if any. Must be called with reload.mu locked or before the server
+// is started
+func (s Server) setChain(name string, c *moduleChain) {
+	c.listener.SetModules(c.modules)
+	c.listener.SetHandleRequest(c.handle)
+	replaced := s.reload.chains[name]
+	s.reload.chains[name] = c
+	if replaced != nil {
+		replaced.retire()
+	}
+}
+
+// retireChains retires the active chains, once the listeners are shut down
+func (s Server) retireChains() {
+	s.reload.mu.Lock()
+	defer s.reload.mu.Unlock()
+	for name, c := range s.reload.chains {
+		c.retire()
+		delete(s.reload.chains, name)
+	}
+}
+
+// sameExtra compares listener extra configs as they are serialized, since
+// numbers read from a file are float64 while they may be ints in code
+func sameExtra(a, b map[string]interface{}) bool {
+	serializedA, errA := json.Marshal(a)
+	serializedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(serializedA) == string(serializedB)
+}
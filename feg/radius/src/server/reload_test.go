@@ -0,0 +1,261 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/loader/loaderstest"
+	"fbc/cwf/radius/modules"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+)
+
+func TestReload(t *testing.T) {
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+	conf := getConfigWithAuthListener(t, []string{"auth"}, []int{1}, true)
+
+	loader := loaderstest.MockLoader{}
+	loader.On("LoadModule", "module.auth.1").Return(
+		createMockHandlerWithReturn(&modules.Response{Code: radius.CodeAccessAccept}, nil), nil)
+	loader.On("LoadModule", "module.reject.1").Return(
+		createMockHandlerWithReturn(&modules.Response{Code: radius.CodeAccessReject}, nil), nil)
+	loader.On("LoadModule", "module.broken.1").Return(modules.Module(nil), errors.New("failed to load"))
+
+	server, err := New(conf, logger, &loader)
+	require.NoError(t, err)
+	initial := server.ConfigVersion()
+	require.Equal(t, 1, initial.Generation)
+	require.NotEmpty(t, initial.Version)
+
+	listener := server.listeners["listener.0"]
+	replaced := listener.GetHandleRequest()
+	requireHandlerCode(t, replaced, radius.CodeAccessAccept)
+
+	// Same config, nothing to reload
+	require.NoError(t, server.Reload(conf))
+	require.Equal(t, initial, server.ConfigVersion())
+
+	// New module chain
+	newConf := withModule(conf, "module.reject.1")
+	require.NoError(t, server.Reload(newConf))
+	reloaded := server.ConfigVersion()
+	require.Equal(t, 2, reloaded.Generation)
+	require.NotEqual(t, initial.Version, reloaded.Version)
+	requireHandlerCode(t, listener.GetHandleRequest(), radius.CodeAccessReject)
+	require.Equal(t, "module.reject.1", listener.GetModules()[0].Name)
+	// the replaced chain had no requests in flight & was closed, late requests
+	// are served by the active chain (see TestReloadClosesReplacedModules)
+	requireHandlerCode(t, replaced, radius.CodeAccessReject)
+
+	// Invalid configs leave the active chain untouched
+	brokenConf := withModule(conf, "module.broken.1")
+	require.Error(t, server.Reload(brokenConf))
+	movedConf := withModule(conf, "module.auth.1")
+	movedConf.Listeners[0].Extra = map[string]interface{}{"Port": 1}
+	require.Error(t, server.Reload(movedConf))
+	addedConf := withModule(conf, "module.auth.1")
+	addedConf.Listeners = append(addedConf.Listeners, config.ListenerConfig{Name: "listener.1", Type: "udp"})
+	require.Error(t, server.Reload(addedConf))
+
+	failed := server.ConfigVersion()
+	require.Equal(t, reloaded.Version, failed.Version)
+	require.Equal(t, reloaded.Generation, failed.Generation)
+	require.NotEmpty(t, failed.LastError)
+	require.NotNil(t, failed.LastErrorAt)
+	requireHandlerCode(t, listener.GetHandleRequest(), radius.CodeAccessReject)
+
+	// Admin endpoint
+	recorder := httptest.NewRecorder()
+	server.AdminHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/config/version", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	var served ConfigVersion
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &served))
+	require.Equal(t, reloaded.Version, served.Version)
+	require.Equal(t, reloaded.Generation, served.Generation)
+	require.Equal(t, failed.LastError, served.LastError)
+}
+
+func TestConfigSource(t *testing.T) {
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+	conf := getConfigWithAuthListener(t, []string{"auth"}, []int{1}, true)
+
+	loader := loaderstest.MockLoader{}
+	loader.On("LoadModule", "module.auth.1").Return(
+		createMockHandlerWithReturn(&modules.Response{Code: radius.CodeAccessAccept}, nil), nil)
+	loader.On("LoadModule", "module.reject.1").Return(
+		createMockHandlerWithReturn(&modules.Response{Code: radius.CodeAccessReject}, nil), nil)
+
+	server, err := New(conf, logger, &loader)
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "radius_config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFile := filepath.Join(dir, "gateway.mconfig")
+	writeMconfig(t, configFile, conf)
+
+	source, err := NewConfigSource(config.ReloadConfig{}, configFile, server, logger)
+	require.NoError(t, err)
+	changed, err := source.Reload()
+	require.NoError(t, err)
+	require.False(t, changed, "the server already runs the file's config")
+
+	writeMconfig(t, configFile, withModule(conf, "module.reject.1"))
+	changed, err = source.Reload()
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, 2, server.ConfigVersion().Generation)
+	requireHandlerCode(t, server.listeners["listener.0"].GetHandleRequest(), radius.CodeAccessReject)
+
+	changed, err = source.Reload()
+	require.NoError(t, err)
+	require.False(t, changed)
+}
+
+func TestReloadClosesReplacedModules(t *testing.T) {
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err)
+	conf := withModule(getConfigWithAuthListener(t, []string{"auth"}, []int{1}, true), "module.closable.1")
+
+	closable := &closableModule{entered: make(chan struct{}), release: make(chan struct{})}
+	loader := loaderstest.MockLoader{}
+	loader.On("LoadModule", "module.closable.1").Return(closable, nil)
+	loader.On("LoadModule", "module.reject.1").Return(
+		createMockHandlerWithReturn(&modules.Response{Code: radius.CodeAccessReject}, nil), nil)
+	loader.On("LoadModule", "module.broken.1").Return(modules.Module(nil), errors.New("failed to load"))
+
+	server, err := New(conf, logger, &loader)
+	require.NoError(t, err)
+	listener := server.listeners["listener.0"]
+
+	// The replaced chain is closed once its request in flight completes
+	closable.blocking = true
+	inFlight := make(chan struct{})
+	go func() {
+		defer close(inFlight)
+		requireHandlerCode(t, listener.GetHandleRequest(), radius.CodeAccessAccept)
+	}()
+	<-closable.entered
+	require.NoError(t, server.Reload(withModule(conf, "module.reject.1")))
+	require.Empty(t, closable.getClosed())
+	close(closable.release)
+	<-inFlight
+	require.Eventually(t, func() bool { return len(closable.getClosed()) == 1 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, []int{1}, closable.getClosed())
+	closable.blocking = false
+
+	// Modules initialized for a failed reload are closed right away
+	brokenConf := withModule(conf, "module.closable.1")
+	brokenConf.Listeners[0].Modules = append(brokenConf.Listeners[0].Modules, config.ModuleDescriptor{Name: "module.broken.1"})
+	require.Error(t, server.Reload(brokenConf))
+	require.Equal(t, []int{1, 2}, closable.getClosed())
+	requireHandlerCode(t, listener.GetHandleRequest(), radius.CodeAccessReject)
+
+	// Requests reaching a closed chain are served by the active one
+	require.NoError(t, server.Reload(conf))
+	replaced := listener.GetHandleRequest()
+	requireHandlerCode(t, replaced, radius.CodeAccessAccept)
+	require.NoError(t, server.Reload(withModule(conf, "module.reject.1")))
+	require.Equal(t, []int{1, 2, 3}, closable.getClosed())
+	requireHandlerCode(t, replaced, radius.CodeAccessReject)
+
+	// Stopping the server closes the active chains
+	require.NoError(t, server.Reload(conf))
+	server.retireChains()
+	require.Equal(t, []int{1, 2, 3, 4}, closable.getClosed())
+}
+
+// closableModule a module accepting every request, whose contexts are numbered
+// by initialization order & recorded when closed
+type closableModule struct {
+	blocking bool
+	entered  chan struct{}
+	release  chan struct{}
+
+	mu     sync.Mutex
+	inits  int
+	closed []int
+}
+
+func (m *closableModule) Init(*zap.Logger, modules.ModuleConfig) (modules.Context, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inits++
+	return m.inits, nil
+}
+
+func (m *closableModule) Handle(modules.Context, *modules.RequestContext, *radius.Request, modules.Middleware) (*modules.Response, error) {
+	if m.blocking {
+		m.entered <- struct{}{}
+		<-m.release
+	}
+	return &modules.Response{Code: radius.CodeAccessAccept}, nil
+}
+
+func (m *closableModule) Close(ctx modules.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = append(m.closed, ctx.(int))
+	return nil
+}
+
+func (m *closableModule) getClosed() []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]int{}, m.closed...)
+}
+
+// withModule returns a copy of the config with the listener's chain replaced by the module
+func withModule(c config.ServerConfig, moduleName string) config.ServerConfig {
+	listener := c.Listeners[0]
+	listener.Modules = []config.ModuleDescriptor{{Name: moduleName, Config: modules.ModuleConfig{}}}
+	c.Listeners = []config.ListenerConfig{listener}
+	return c
+}
+
+func writeMconfig(t *testing.T, filename string, c config.ServerConfig) {
+	radiusConfig, err := json.Marshal(config.RadiusConfig{Server: c})
+	require.NoError(t, err)
+	mconfig, err := json.Marshal(map[string]interface{}{
+		"configsByKey": map[string]json.RawMessage{config.DefaultMconfigKey: radiusConfig},
+	})
+	require.NoError(t, err)
+	// make sure the modification time changes
+	if info, err := os.Stat(filename); err == nil {
+		defer os.Chtimes(filename, info.ModTime().Add(time.Second), info.ModTime().Add(time.Second))
+	}
+	require.NoError(t, ioutil.WriteFile(filename, mconfig, 0644))
+}
+
+func requireHandlerCode(t *testing.T, handler modules.Middleware, code radius.Code) {
+	packet := radius.New(radius.CodeAccessRequest, []byte("123456"))
+	res, err := handler(&modules.RequestContext{Logger: zap.NewNop()}, &radius.Request{Packet: packet})
+	require.NoError(t, err)
+	require.Equal(t, code, res.Code)
+}
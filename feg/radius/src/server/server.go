@@ -19,6 +19,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"fbc/cwf/radius/clients"
 	"fbc/cwf/radius/config"
 	"fbc/cwf/radius/filters"
 	"fbc/cwf/radius/loader"
@@ -65,12 +66,14 @@ type (
 		ready               chan bool // wait on this to wait for the server to be ready for work
 		terminate           chan bool
 		listeners           map[string]ListenerInterface
-		filters             []*Filter
 		config              config.ServerConfig
 		logger              *zap.Logger
 		multiSessionStorage session.GlobalStorage
 		dedupSet            *cache.Cache
 		counters            *monitoring.ServerCounters
+		clients             *clients.Registry
+		clientsSource       *clients.FileSource
+		reload              *reloadState
 	}
 )
 
@@ -82,17 +85,26 @@ func New(config config.ServerConfig, logger *zap.Logger, loader loader.Loader) (
 		return nil, err
 	}
 
+	// Init RADIUS clients registry
+	clientRegistry, err := clients.NewRegistry([]byte(config.Secret), config.Clients)
+	if err != nil {
+		logger.Error("invalid RADIUS clients config", zap.Error(err))
+		return nil, err
+	}
+	clients.SetDefault(clientRegistry)
+
 	// Init server object
 	server := Server{
 		listeners:           make(map[string]ListenerInterface), // Will be populated by "Start" method
 		ready:               make(chan bool, 1),
-		filters:             make([]*Filter, 0),
 		terminate:           make(chan bool, 1), // Internal channel used for termination of listeners
 		config:              config,             // The original config for later reference
 		logger:              logger,
 		multiSessionStorage: multiSessionStorage,
 		dedupSet:            cache.New(config.DedupWindow.Duration, time.Minute),
 		counters:            monitoring.CreateServerCounters(),
+		clients:             clientRegistry,
+		reload:              &reloadState{loader: loader, config: &config, chains: map[string]*moduleChain{}},
 	}
 
 	serverInitCounter := server.counters.Init.Start()
@@ -103,29 +115,11 @@ func New(config config.ServerConfig, logger *zap.Logger, loader loader.Loader) (
 	)
 
 	// Load filters from config
-	for _, filterName := range config.Filters {
-		server.counters.FilterInit.Start(
-			tag.Upsert(monitoring.FilterTag, filterName),
-		)
-		filter, err := loader.LoadFilter(filterName)
-		if err != nil {
-			logger.Error("filter failed to load", zap.String("filter_name", filterName), zap.Error(err))
-			server.counters.FilterInit.Failure("load_error")
-			return nil, err
-		}
-
-		err = filter.Init(&config)
-		if err != nil {
-			logger.Error("filter failed to init", zap.String("filter_name", filterName), zap.Error(err))
-			server.counters.FilterInit.Failure("init_error")
-			return nil, err
-		}
-		server.filters = append(server.filters, &Filter{
-			Name: filterName,
-			Code: filter,
-		})
-		server.counters.FilterInit.Success()
+	filters, err := server.loadFilters(&config)
+	if err != nil {
+		return nil, err
 	}
+	server.reload.filters.Store(filters)
 
 	// Load listeners from config
 	for _, lconfig := range config.Listeners {
@@ -140,6 +134,8 @@ func New(config config.ServerConfig, logger *zap.Logger, loader loader.Loader) (
 			listener = NewUDPListener()
 		case "grpc":
 			listener = NewGRPCListener()
+		case "radsec":
+			listener = NewRadSecListener()
 		default:
 			logger.Error(
 				fmt.Sprintf("failed to create listener, listener type '%s'", lconfig.Type),
@@ -150,58 +146,15 @@ func New(config config.ServerConfig, logger *zap.Logger, loader loader.Loader) (
 		// Set configuration for listener
 		listener.SetConfig(lconfig)
 
-		// Load modules
-		for _, modDesc := range lconfig.Modules {
-			moduleInitCounter := server.counters.ModuleInit.Start(
-				tag.Upsert(monitoring.ListenerTag, lconfig.Name),
-				tag.Upsert(monitoring.ModuleTag, modDesc.Name),
-			)
-
-			logger.Info("loading module", zap.String("module_name", modDesc.Name))
-			// Load module
-			module, err := loader.LoadModule(modDesc.Name)
-			if err != nil {
-				logger.Error("module failed to load", zap.String("module_name", modDesc.Name), zap.Error(err))
-				moduleInitCounter.Failure("load_error")
-				return nil, err
-			}
-			logger.Debug(
-				"Module loaded successfully",
-				zap.String("module_name", modDesc.Name),
-				zap.Int("precedence", len(listener.GetModules())),
-			)
-
-			// Init the module
-			logger.Debug("Initializing module", zap.String("module_name", modDesc.Name))
-			moduleCtx, err := module.Init(logger, modDesc.Config)
-			if err != nil {
-				logger.Error("module failed to init", zap.String("module_name", modDesc.Name), zap.Error(err))
-				moduleInitCounter.Failure("init_error")
-				return nil, err
-			}
-
-			listener.AppendModule(&Module{
-				Code:    module,
-				Context: moduleCtx,
-				Name:    modDesc.Name,
-			})
-			moduleInitCounter.Success()
-		}
-
-		// Wrap modules in call chain, leveraging the middleware pattern
-		// into the listener's HandleRequest method
-		handler := func(c *modules.RequestContext, r *radius.Request) (*modules.Response, error) {
-			return nil, nil
-		}
-
-		for idx := len(listener.GetModules()) - 1; idx >= 0; idx-- {
-			module := (listener.GetModules())[idx]
-			handler = wrapMiddleware(listener.GetConfig().Name, handler, module)
+		// Load modules & wrap them in the listener's call chain
+		mods, handler, err := server.buildChain(lconfig)
+		if err != nil {
+			return nil, err
 		}
+		server.setChain(lconfig.Name, newModuleChain(server, listener, mods, handler))
 
 		// Initialize the listener
-		listener.SetHandleRequest(handler)
-		err := listener.Init(
+		err = listener.Init(
 			&server,
 			config,
 			lconfig,
@@ -217,11 +170,110 @@ func New(config config.ServerConfig, logger *zap.Logger, loader loader.Loader) (
 		listenerInitCounter.Success()
 	}
 
+	// Load clients from the hot-reloadable source, if any
+	if config.ClientsSource != nil {
+		server.clientsSource, err = clients.NewFileSource(*config.ClientsSource, config.Clients, clientRegistry, logger)
+		if err != nil {
+			return nil, err
+		}
+		err = server.clientsSource.Start()
+		if err != nil {
+			logger.Error("failed to load RADIUS clients", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	err = server.reload.activate(&config)
+	if err != nil {
+		return nil, err
+	}
+
 	// Down we go!
 	serverInitCounter.Success()
 	return &server, nil
 }
 
+// loadFilters loads & initializes the filters of the config
+func (s Server) loadFilters(config *config.ServerConfig) ([]*Filter, error) {
+	filters := make([]*Filter, 0, len(config.Filters))
+	for _, filterName := range config.Filters {
+		s.counters.FilterInit.Start(
+			tag.Upsert(monitoring.FilterTag, filterName),
+		)
+		filter, err := s.reload.loader.LoadFilter(filterName)
+		if err != nil {
+			s.logger.Error("filter failed to load", zap.String("filter_name", filterName), zap.Error(err))
+			s.counters.FilterInit.Failure("load_error")
+			return nil, err
+		}
+
+		err = filter.Init(config)
+		if err != nil {
+			s.logger.Error("filter failed to init", zap.String("filter_name", filterName), zap.Error(err))
+			s.counters.FilterInit.Failure("init_error")
+			return nil, err
+		}
+		filters = append(filters, &Filter{
+			Name: filterName,
+			Code: filter,
+		})
+		s.counters.FilterInit.Success()
+	}
+	return filters, nil
+}
+
+// buildChain loads & initializes the listener's modules and wraps them in a
+// call chain, leveraging the middleware pattern
+func (s Server) buildChain(lconfig config.ListenerConfig) ([]Module, modules.Middleware, error) {
+	var mods []Module
+	for _, modDesc := range lconfig.Modules {
+		moduleInitCounter := s.counters.ModuleInit.Start(
+			tag.Upsert(monitoring.ListenerTag, lconfig.Name),
+			tag.Upsert(monitoring.ModuleTag, modDesc.Name),
+		)
+
+		s.logger.Info("loading module", zap.String("module_name", modDesc.Name))
+		// Load module
+		module, err := s.reload.loader.LoadModule(modDesc.Name)
+		if err != nil {
+			s.logger.Error("module failed to load", zap.String("module_name", modDesc.Name), zap.Error(err))
+			moduleInitCounter.Failure("load_error")
+			closeModules(s.logger, mods)
+			return nil, nil, err
+		}
+		s.logger.Debug(
+			"Module loaded successfully",
+			zap.String("module_name", modDesc.Name),
+			zap.Int("precedence", len(mods)),
+		)
+
+		// Init the module
+		s.logger.Debug("Initializing module", zap.String("module_name", modDesc.Name))
+		moduleCtx, err := module.Init(s.logger, modDesc.Config)
+		if err != nil {
+			s.logger.Error("module failed to init", zap.String("module_name", modDesc.Name), zap.Error(err))
+			moduleInitCounter.Failure("init_error")
+			closeModules(s.logger, mods)
+			return nil, nil, err
+		}
+
+		mods = append(mods, Module{
+			Code:    module,
+			Context: moduleCtx,
+			Name:    modDesc.Name,
+		})
+		moduleInitCounter.Success()
+	}
+
+	handler := func(c *modules.RequestContext, r *radius.Request) (*modules.Response, error) {
+		return nil, nil
+	}
+	for idx := len(mods) - 1; idx >= 0; idx-- {
+		handler = wrapMiddleware(lconfig.Name, handler, mods[idx])
+	}
+	return mods, handler, nil
+}
+
 func initSessionStorage(config config.ServerConfig, logger *zap.Logger) (session.GlobalStorage, error) {
 	var multiSessionStorage session.GlobalStorage
 	if config.SessionStorage == nil || config.SessionStorage.StorageType == "memory" {
@@ -264,7 +316,7 @@ func wrapMiddleware(listenerName string, next modules.Middleware, module Module)
 // Start listening and parsing incoming requests
 func (s Server) Start() {
 	var err error
-	s.logger.Debug("starting server", zap.Int("num_listeners", len(s.listeners)), zap.Int("num_filters", len(s.filters)))
+	s.logger.Debug("starting server", zap.Int("num_listeners", len(s.listeners)), zap.Int("num_filters", len(s.getFilters())))
 	for _, listener := range s.listeners {
 		// Create logger
 		logger := s.logger.With(zap.String("listener", listener.GetConfig().Name))
@@ -335,6 +387,11 @@ func (s Server) Stop() {
 		}
 	}
 
+	if s.clientsSource != nil {
+		s.clientsSource.Stop()
+	}
+	s.retireChains()
+
 	// Signal termination
 	s.logger.Debug("All listeners are now down, terminating server")
 	s.terminate <- true
@@ -20,8 +20,8 @@ import (
 	"math/rand"
 	"net"
 	"sync/atomic"
-	"time"
 
+	"fbc/cwf/radius/clients"
 	"fbc/cwf/radius/config"
 	"fbc/cwf/radius/modules"
 	"fbc/cwf/radius/monitoring"
@@ -67,68 +67,126 @@ func (l *UDPListener) Init(
 		return err
 	}
 
-	// Create packet server
+	// Create packet server, the secret & the client policy are resolved per
+	// peer from the server's RADIUS clients registry
 	l.Server = &radius.PacketServer{
 		Handler: radius.HandlerFunc(
-			generatePacketHandler(l, server, ctrs),
+			generateClientAuthorizationHandler(l, server, false, generatePacketHandler(l, server, ctrs)),
 		),
-		SecretSource: radius.StaticSecretSource([]byte(serverConfig.Secret)),
+		SecretSource: &clientSecretSource{listener: l, server: server},
 		Addr:         fmt.Sprintf(":%d", cfg.Port),
 	}
 	return nil
 }
 
-// ListenAndServe override
-func (l *UDPListener) ListenAndServe() error {
-	serverError := make(chan error, 1)
-	go func() {
-		err := l.Server.ListenAndServe()
-		serverError <- err
-	}()
-	dialError := make(chan error, 1)
-	go func() {
-		udpAddr := ":1812" // default port for radius packetServer
-		if l.Server.Addr != "" {
-			udpHostString, udpPortString, err := net.SplitHostPort(l.Server.Addr)
-			if err != nil {
-				dialError <- err
-				return
-			}
-			udpAddr = net.JoinHostPort(udpHostString, udpPortString)
+// clientSecretSource selects the secret by the peer address & accounts for
+// packets from unknown peers, which are dropped
+type clientSecretSource struct {
+	listener ListenerInterface
+	server   *Server
+}
+
+// RADIUSSecret implements radius.SecretSource
+func (s *clientSecretSource) RADIUSSecret(ctx context.Context, remoteAddr net.Addr) ([]byte, error) {
+	secret, err := s.server.clients.RADIUSSecret(ctx, remoteAddr)
+	if err != nil {
+		s.server.logger.Warn(
+			"Packet from unknown RADIUS client was dropped",
+			zap.String("listener", s.listener.GetConfig().Name),
+			zap.Stringer("source_ip", remoteAddr),
+		)
+		authorizeOperation := s.server.counters.ClientAuthorize.Start(
+			tag.Upsert(monitoring.ListenerTag, s.listener.GetConfig().Name),
+		)
+		authorizeOperation.Failure("unknown_client")
+	}
+	return secret, err
+}
+
+// generateClientAuthorizationHandler drops requests from peers not allowed by
+// the RADIUS clients registry before passing them to next. The client's secret
+// isn't checked for peers authenticated by the transport (peerAuthenticated)
+func generateClientAuthorizationHandler(
+	l ListenerInterface,
+	server *Server,
+	peerAuthenticated bool,
+	next func(radius.ResponseWriter, *radius.Request),
+) func(radius.ResponseWriter, *radius.Request) {
+	return func(w radius.ResponseWriter, r *radius.Request) {
+		if server.clients.IsEmpty() {
+			next(w, r)
+			return
 		}
-		deadline := time.Now().Add(20 * time.Millisecond)
-		retryBkp := radius.DefaultClient.Retry
-		radius.DefaultClient.Retry = 0
-		defer func() { radius.DefaultClient.Retry = retryBkp }()
-		for time.Now().Before(deadline) {
-			secret, _ := l.Server.SecretSource.RADIUSSecret(context.Background(), net.Addr(nil))
-			_, err := radius.Exchange(context.Background(), radius.New(radius.CodeAccessRequest, secret), udpAddr)
-			if err == nil {
-				dialError <- nil
-				return
-			}
+		authorizeOperation := server.counters.ClientAuthorize.Start(
+			tag.Upsert(monitoring.ListenerTag, l.GetConfig().Name),
+		)
+		authorize := server.clients.Authorize
+		if peerAuthenticated {
+			authorize = server.clients.AuthorizeAuthenticatedPeer
 		}
-		dialError <- errors.New("timeout for UDP listener server to come up")
-	}()
-
-	select {
-	case err := <-serverError:
-		l.ready <- false
-		return err
-	case err := <-dialError:
-		if err == nil {
-			l.ready <- true
-			return nil
-		} else {
-			l.ready <- false
-			return err
+		client, err := authorize(l.GetConfig().Name, r)
+		if err != nil {
+			server.logger.Warn(
+				"Packet from unauthorized RADIUS client was dropped",
+				zap.String("listener", l.GetConfig().Name),
+				zap.Stringer("source_ip", r.RemoteAddr),
+				zap.Error(err),
+			)
+			authorizeOperation.Failure(clientAuthorizationFailureCode(err))
+			return
 		}
+		authorizeOperation.Success()
+		server.logger.Debug(
+			"Packet from RADIUS client authorized",
+			zap.String("client", client.Name),
+			zap.Stringer("source_ip", r.RemoteAddr),
+		)
+		next(w, r)
+	}
+}
+
+func clientAuthorizationFailureCode(err error) string {
+	switch {
+	case errors.Is(err, clients.ErrUnknownClient):
+		return "unknown_client"
+	case errors.Is(err, clients.ErrSecretMismatch):
+		return "secret_mismatch"
+	case errors.Is(err, clients.ErrListenerNotAllowed):
+		return "listener_not_allowed"
+	case errors.Is(err, clients.ErrRateLimited):
+		return "rate_limited"
+	default:
+		return "unknown"
 	}
 }
 
-// GetHandleRequest override
-func (l *UDPListener) GetHandleRequest() modules.Middleware {
-	return l.HandleRequest
+// ListenAndServe override
+func (l *UDPListener) ListenAndServe() error {
+	// Bind before reporting the listener ready. Probing the server with a
+	// request isn't possible since it may be dropped by the clients registry
+	addr := l.Server.Addr
+	if addr == "" {
+		addr = ":1812" // default port for radius packetServer
+	}
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		go func() {
+			l.ready <- false
+		}()
+		return fmt.Errorf("udp listener: failed to listen on %s: %w", addr, err)
+	}
+	go func() {
+		err := l.Server.Serve(conn)
+		if err != nil && err != radius.ErrServerShutdown {
+			l.Server.ErrorLog.Printf("udp listener stopped serving: %v", err)
+		}
+	}()
+
+	// Signal listener is ready
+	go func() {
+		l.ready <- true
+	}()
+	return nil
 }
 
 // Shutdown override
@@ -191,7 +249,7 @@ func generatePacketHandler(
 
 		// Execute filters
 		filterProcessCounter := monitoring.NewOperation("filter_process").Start()
-		for _, filter := range server.filters {
+		for _, filter := range server.getFilters() {
 			err := filter.Code.Process(&requestContext, l.GetConfig().Name, r)
 			if err != nil {
 				server.logger.Error("Failed to process reqeust by filter", zap.Error(err), correlationField)
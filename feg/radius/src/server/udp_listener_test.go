@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"fbc/cwf/radius/config"
+	"fbc/cwf/radius/loader/loaderstest"
+	"fbc/cwf/radius/modules"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"layeh.com/radius"
+	"layeh.com/radius/rfc2865"
+)
+
+func TestUDPListenerWithClients(t *testing.T) {
+	// Arrange
+	logger, err := zap.NewDevelopment()
+	require.NoError(t, err, "failed to get logger")
+	serverConfig := getConfigWithAuthListener(t, []string{"auth"}, []int{1}, true)
+	serverConfig.Clients = []config.ClientConfig{
+		{
+			Name:           "local-ap",
+			CIDRs:          []string{"127.0.0.0/8"},
+			NASIdentifiers: []string{"ap-1", "ap-2"},
+			Secret:         "local-ap-secret",
+			Listeners:      []string{"listener.0"},
+		},
+	}
+	mModule := createMockHandlerWithReturn(&modules.Response{Code: radius.CodeAccessAccept}, nil)
+	loader := loaderstest.MockLoader{}
+	loader.On("LoadModule", "module.auth.1").Return(mModule, nil)
+	server, err := New(serverConfig, logger, &loader)
+	require.NoError(t, err)
+	require.True(t, server.StartAndWait(), "failed to initialize the server")
+	defer server.Stop()
+	addr := fmt.Sprintf(":%d", serverConfig.Listeners[0].Extra["Port"].(int))
+
+	exchange := func(secret string, nasIdentifier string) (*radius.Packet, error) {
+		packet := radius.New(radius.CodeAccessRequest, []byte(secret))
+		rfc2865.NASIdentifier_SetString(packet, nasIdentifier)
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+		defer cancel()
+		return radius.Exchange(ctx, packet, addr)
+	}
+
+	// Act & Assert
+	response, err := exchange("local-ap-secret", "ap-1")
+	require.NoError(t, err)
+	assert.Equal(t, radius.CodeAccessAccept, response.Code)
+
+	// Global secret isn't accepted from a registered client
+	_, err = exchange(serverConfig.Secret, "ap-1")
+	assert.Error(t, err)
+
+	// Unknown NAS-Identifier is rejected
+	_, err = exchange("local-ap-secret", "ap-3")
+	assert.Error(t, err)
+
+	// Clients are hot swapped, the client is no longer allowed on the listener
+	serverConfig.Clients[0].Listeners = []string{"listener.1"}
+	require.NoError(t, server.clients.Update(serverConfig.Clients))
+	_, err = exchange("local-ap-secret", "ap-2")
+	assert.Error(t, err)
+}
@@ -32,8 +32,11 @@ const (
 
 	UpgradeTierEntityType           = "upgrade_tier"
 	UpgradeReleaseChannelEntityType = "upgrade_release_channel"
+	UpgradeRolloutEntityType        = "upgrade_rollout"
 
 	CallTraceEntityType = "call_trace"
+
+	BatchCommandJobEntityType = "batch_command_job"
 )
 
 // K8s
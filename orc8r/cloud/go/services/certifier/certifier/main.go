@@ -131,6 +131,11 @@ func main() {
 				glog.Errorf("error collecting garbage for certifier: %v", err)
 			}
 			glog.Infof("removed %d stale certificates", count)
+			count, err = servicer.CollectExpiredTokensImpl(context.Background())
+			if err != nil {
+				glog.Errorf("error collecting expired tokens for certifier: %v", err)
+			}
+			glog.Infof("removed %d expired tokens", count)
 		}
 	}()
 
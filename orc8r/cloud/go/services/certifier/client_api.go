@@ -239,6 +239,15 @@ func GetPolicyDecision(ctx context.Context, getPDReq *certprotos.GetPolicyDecisi
 	return pd, nil
 }
 
+// GetPolicyDecisionForPolicies makes a policy decision for policies which are not stored in certifier.
+func GetPolicyDecisionForPolicies(ctx context.Context, req *certprotos.GetPolicyDecisionForPoliciesRequest) (*certprotos.GetPolicyDecisionResponse, error) {
+	client, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetPolicyDecisionForPolicies(ctx, req)
+}
+
 // CreateUser creates a new user with the specified password and policy
 func CreateUser(ctx context.Context, user *certprotos.User) error {
 	client, err := getCertifierClient()
@@ -23,4 +23,37 @@ type Config struct {
 	CertsDirectory string                       `yaml:"certsDirectory"`
 	Certs          []string                     `yaml:"orchestratorCerts"`
 	UseToken       bool                         `yaml:"useToken"`
+	OIDC           *OIDCConfig                  `yaml:"oidc"`
+}
+
+// OIDCConfig configures obsidian to accept bearer tokens (JWT) issued by
+// an OpenID Connect identity provider in addition to certifier user tokens.
+// It is only used when useToken is enabled.
+type OIDCConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Issuer has to match the iss claim of the tokens
+	Issuer string `yaml:"issuer"`
+	// Audience has to be one of the aud claims of the tokens
+	Audience string `yaml:"audience"`
+	// JWKSURL defaults to jwks_uri of the issuer discovery document
+	JWKSURL                string `yaml:"jwksUrl"`
+	JWKSRefreshIntervalSec int    `yaml:"jwksRefreshIntervalSec"`
+	UsernameClaim          string `yaml:"usernameClaim"`
+	GroupsClaim            string `yaml:"groupsClaim"`
+	// GroupPolicies grants policies to members of the identity provider groups
+	GroupPolicies []OIDCGroupPolicies `yaml:"groupPolicies"`
+}
+
+type OIDCGroupPolicies struct {
+	Group    string       `yaml:"group"`
+	Policies []OIDCPolicy `yaml:"policies"`
+}
+
+// OIDCPolicy has the same format as policies of certifier user tokens.
+type OIDCPolicy struct {
+	Effect       string   `yaml:"effect"`
+	Action       string   `yaml:"action"`
+	ResourceType string   `yaml:"resourceType"`
+	Path         string   `yaml:"path"`
+	ResourceIDs  []string `yaml:"resourceIDs"`
 }
@@ -9,6 +9,9 @@ const (
 
 	// PolicyType is the type of policy used in blobstore type fileds
 	PolicyType = "policy"
+
+	// TokenUseType is the type of token use records used in blobstore type fields
+	TokenUseType = "token_use"
 )
 
 type ResourceType string
@@ -17,9 +17,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"magma/orc8r/cloud/go/services/certifier"
 	"magma/orc8r/cloud/go/services/certifier/obsidian/models"
@@ -141,8 +146,25 @@ func addUserTokenHandler(c echo.Context) error {
 	req := &protos.AddUserTokenRequest{
 		Username: username,
 		Policies: policiesProto,
+		Label:    c.QueryParam("label"),
+	}
+	if expiresAt := c.QueryParam("expires_at"); expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid expires_at: %v", err))
+		}
+		req.ExpiresAt = timestamppb.New(t)
+	}
+	if scoped := c.QueryParam("scoped"); scoped != "" {
+		req.Scoped, err = strconv.ParseBool(scoped)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid scoped: %v", err))
+		}
 	}
 	err = certifier.AddUserToken(c.Request().Context(), req)
+	if status.Code(err) == codes.InvalidArgument {
+		return obsidian.MakeHTTPError(err, http.StatusBadRequest)
+	}
 	return err
 }
 
@@ -109,6 +109,41 @@ func TestUserEndpoints(t *testing.T) {
 	}
 	tests.RunUnitTest(t, e, tc)
 
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            testManageUserTokens + "?label=ci&scoped=true&expires_at=2100-01-01T00:00:00Z",
+		Handler:        addUserTokens,
+		ParamNames:     []string{"username"},
+		ParamValues:    []string{test_utils.TestRootUsername},
+		Payload:        tests.JSONMarshaler(writeAllResource),
+		ExpectedStatus: 200,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:                 "POST",
+		URL:                    testManageUserTokens + "?expires_at=tomorrow",
+		Handler:                addUserTokens,
+		ParamNames:             []string{"username"},
+		ParamValues:            []string{test_utils.TestRootUsername},
+		Payload:                tests.JSONMarshaler(writeAllResource),
+		ExpectedStatus:         400,
+		ExpectedErrorSubstring: "invalid expires_at",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:                 "POST",
+		URL:                    testManageUserTokens + "?expires_at=2000-01-01T00:00:00Z",
+		Handler:                addUserTokens,
+		ParamNames:             []string{"username"},
+		ParamValues:            []string{test_utils.TestRootUsername},
+		Payload:                tests.JSONMarshaler(writeAllResource),
+		ExpectedStatus:         400,
+		ExpectedErrorSubstring: "token expiration time has to be in the future",
+	}
+	tests.RunUnitTest(t, e, tc)
+
 	tc = tests.Test{
 		Method:         "GET",
 		URL:            testManageUserTokens,
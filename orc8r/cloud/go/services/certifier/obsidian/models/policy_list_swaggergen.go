@@ -15,15 +15,36 @@ import (
 )
 
 // PolicyList An object that defines a user's permissions to access resources
-// Example: {"policies":[{"action":"WRITE","effect":"ALLOW","path":"**","resourceType":"URI"},{"action":"WRITE","effect":"DENY","resourceIDs":["test_network1","test_network2"],"resourceType":"NETWORK_ID"},{"action":"WRITE","effect":"ALLOW","resourceIDs":[0,1,2],"resourceType":"TENANT_ID"}],"token":"op_6YHy0uT7DeuWyT3N9nkAOyoeyOI25fletJE69yHGGl4ifjfoq"}
+// Example: {"expires_at":"2023-01-01T00:00:00Z","label":"ci","policies":[{"action":"WRITE","effect":"ALLOW","path":"**","resourceType":"URI"},{"action":"WRITE","effect":"DENY","resourceIDs":["test_network1","test_network2"],"resourceType":"NETWORK_ID"},{"action":"WRITE","effect":"ALLOW","resourceIDs":[0,1,2],"resourceType":"TENANT_ID"}],"scoped":true,"token":"op_6YHy0uT7DeuWyT3N9nkAOyoeyOI25fletJE69yHGGl4ifjfoq"}
 //
 // swagger:model policyList
 type PolicyList struct {
 
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// expires at
+	// Format: date-time
+	ExpiresAt strfmt.DateTime `json:"expires_at,omitempty"`
+
+	// label
+	Label string `json:"label,omitempty"`
+
+	// last used at
+	// Format: date-time
+	LastUsedAt strfmt.DateTime `json:"last_used_at,omitempty"`
+
+	// last used ip
+	LastUsedIP string `json:"last_used_ip,omitempty"`
+
 	// policies
 	// Required: true
 	Policies Policies `json:"policies"`
 
+	// Token grants only its own policies, limited by permissions of the user
+	Scoped bool `json:"scoped,omitempty"`
+
 	// token
 	// Required: true
 	Token *string `json:"token"`
@@ -33,6 +54,18 @@ type PolicyList struct {
 func (m *PolicyList) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastUsedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePolicies(formats); err != nil {
 		res = append(res, err)
 	}
@@ -47,6 +80,42 @@ func (m *PolicyList) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PolicyList) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PolicyList) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expires_at", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PolicyList) validateLastUsedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastUsedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_used_at", "body", "date-time", m.LastUsedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PolicyList) validatePolicies(formats strfmt.Registry) error {
 
 	if err := validate.Required("policies", "body", m.Policies); err != nil {
@@ -20,12 +20,12 @@ package protos
 
 import (
 	context "context"
-	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	protos "magma/orc8r/lib/go/protos"
 	reflect "reflect"
 	sync "sync"
@@ -141,10 +141,10 @@ type CertificateInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        *protos.Identity     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	NotBefore *timestamp.Timestamp `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
-	NotAfter  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
-	CertType  protos.CertType      `protobuf:"varint,4,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
+	Id        *protos.Identity       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	CertType  protos.CertType        `protobuf:"varint,4,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
 }
 
 func (x *CertificateInfo) Reset() {
@@ -186,14 +186,14 @@ func (x *CertificateInfo) GetId() *protos.Identity {
 	return nil
 }
 
-func (x *CertificateInfo) GetNotBefore() *timestamp.Timestamp {
+func (x *CertificateInfo) GetNotBefore() *timestamppb.Timestamp {
 	if x != nil {
 		return x.NotBefore
 	}
 	return nil
 }
 
-func (x *CertificateInfo) GetNotAfter() *timestamp.Timestamp {
+func (x *CertificateInfo) GetNotAfter() *timestamppb.Timestamp {
 	if x != nil {
 		return x.NotAfter
 	}
@@ -424,7 +424,6 @@ type Request struct {
 	// resource_id is the network/tenant to which the request is scoped, if applicable
 	//
 	// Types that are assignable to ResourceId:
-	//
 	//	*Request_NetworkId
 	//	*Request_TenantId
 	ResourceId isRequest_ResourceId `protobuf_oneof:"resource_id"`
@@ -524,7 +523,6 @@ type Policy struct {
 	// action is the action allowed for a given resource, either READ or WRITE.
 	Action Action `protobuf:"varint,2,opt,name=action,proto3,enum=magma.orc8r.certifier.Action" json:"action,omitempty"`
 	// Types that are assignable to Resource:
-	//
 	//	*Policy_Path
 	//	*Policy_Network
 	//	*Policy_Tenant
@@ -775,6 +773,16 @@ type PolicyList struct {
 
 	Token    string    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Policies []*Policy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	// label is a human readable description of the token
+	Label     string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// expires_at is not set for tokens which never expire
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	LastUsedIp string                 `protobuf:"bytes,7,opt,name=last_used_ip,json=lastUsedIp,proto3" json:"last_used_ip,omitempty"`
+	// scoped tokens can only narrow down permissions granted by
+	// the policies of their owner's other (not scoped) tokens
+	Scoped bool `protobuf:"varint,8,opt,name=scoped,proto3" json:"scoped,omitempty"`
 }
 
 func (x *PolicyList) Reset() {
@@ -823,6 +831,105 @@ func (x *PolicyList) GetPolicies() []*Policy {
 	return nil
 }
 
+func (x *PolicyList) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PolicyList) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PolicyList) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PolicyList) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *PolicyList) GetLastUsedIp() string {
+	if x != nil {
+		return x.LastUsedIp
+	}
+	return ""
+}
+
+func (x *PolicyList) GetScoped() bool {
+	if x != nil {
+		return x.Scoped
+	}
+	return false
+}
+
+// TokenUse is stored apart from the token's PolicyList so that recording
+// the use of a token never rewrites its policies.
+type TokenUse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	LastUsedIp string                 `protobuf:"bytes,2,opt,name=last_used_ip,json=lastUsedIp,proto3" json:"last_used_ip,omitempty"`
+}
+
+func (x *TokenUse) Reset() {
+	*x = TokenUse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenUse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenUse) ProtoMessage() {}
+
+func (x *TokenUse) ProtoReflect() protoreflect.Message {
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenUse.ProtoReflect.Descriptor instead.
+func (*TokenUse) Descriptor() ([]byte, []int) {
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TokenUse) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *TokenUse) GetLastUsedIp() string {
+	if x != nil {
+		return x.LastUsedIp
+	}
+	return ""
+}
+
 // ===========================================================================
 // RPC function request and response message types
 // ===========================================================================
@@ -839,7 +946,7 @@ type AddCertRequest struct {
 func (x *AddCertRequest) Reset() {
 	*x = AddCertRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[11]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -852,7 +959,7 @@ func (x *AddCertRequest) String() string {
 func (*AddCertRequest) ProtoMessage() {}
 
 func (x *AddCertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[11]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,7 +972,7 @@ func (x *AddCertRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCertRequest.ProtoReflect.Descriptor instead.
 func (*AddCertRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{11}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AddCertRequest) GetId() *protos.Identity {
@@ -900,7 +1007,7 @@ type GetCARequest struct {
 func (x *GetCARequest) Reset() {
 	*x = GetCARequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[12]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -913,7 +1020,7 @@ func (x *GetCARequest) String() string {
 func (*GetCARequest) ProtoMessage() {}
 
 func (x *GetCARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[12]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -926,7 +1033,7 @@ func (x *GetCARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCARequest.ProtoReflect.Descriptor instead.
 func (*GetCARequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{12}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetCARequest) GetCertType() protos.CertType {
@@ -944,12 +1051,14 @@ type GetPolicyDecisionRequest struct {
 	Username string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Token    string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	Request  *Request `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	// source_ip is the address the request was sent from, recorded as last use of the token
+	SourceIp string `protobuf:"bytes,4,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
 }
 
 func (x *GetPolicyDecisionRequest) Reset() {
 	*x = GetPolicyDecisionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[13]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -962,7 +1071,7 @@ func (x *GetPolicyDecisionRequest) String() string {
 func (*GetPolicyDecisionRequest) ProtoMessage() {}
 
 func (x *GetPolicyDecisionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[13]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1084,7 @@ func (x *GetPolicyDecisionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPolicyDecisionRequest.ProtoReflect.Descriptor instead.
 func (*GetPolicyDecisionRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{13}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetPolicyDecisionRequest) GetUsername() string {
@@ -999,6 +1108,70 @@ func (x *GetPolicyDecisionRequest) GetRequest() *Request {
 	return nil
 }
 
+func (x *GetPolicyDecisionRequest) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+type GetPolicyDecisionForPoliciesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// policies are not stored in certifier, e.g. they are mapped from groups
+	// of a user authenticated by an external identity provider
+	Policies []*Policy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	Request  *Request  `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *GetPolicyDecisionForPoliciesRequest) Reset() {
+	*x = GetPolicyDecisionForPoliciesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPolicyDecisionForPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPolicyDecisionForPoliciesRequest) ProtoMessage() {}
+
+func (x *GetPolicyDecisionForPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPolicyDecisionForPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*GetPolicyDecisionForPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPolicyDecisionForPoliciesRequest) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *GetPolicyDecisionForPoliciesRequest) GetRequest() *Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
 type GetPolicyDecisionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1010,7 +1183,7 @@ type GetPolicyDecisionResponse struct {
 func (x *GetPolicyDecisionResponse) Reset() {
 	*x = GetPolicyDecisionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[14]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1023,7 +1196,7 @@ func (x *GetPolicyDecisionResponse) String() string {
 func (*GetPolicyDecisionResponse) ProtoMessage() {}
 
 func (x *GetPolicyDecisionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[14]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,7 +1209,7 @@ func (x *GetPolicyDecisionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPolicyDecisionResponse.ProtoReflect.Descriptor instead.
 func (*GetPolicyDecisionResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{14}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetPolicyDecisionResponse) GetEffect() Effect {
@@ -1057,7 +1230,7 @@ type CreateUserRequest struct {
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[15]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1070,7 +1243,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[15]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1256,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{15}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateUserRequest) GetUser() *User {
@@ -1102,7 +1275,7 @@ type CreateUserResponse struct {
 func (x *CreateUserResponse) Reset() {
 	*x = CreateUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[16]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1115,7 +1288,7 @@ func (x *CreateUserResponse) String() string {
 func (*CreateUserResponse) ProtoMessage() {}
 
 func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[16]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,7 +1301,7 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{16}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{18}
 }
 
 type ListUsersRequest struct {
@@ -1140,7 +1313,7 @@ type ListUsersRequest struct {
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[17]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1153,7 +1326,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[17]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,7 +1339,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{17}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{19}
 }
 
 type ListUsersResponse struct {
@@ -1180,7 +1353,7 @@ type ListUsersResponse struct {
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[18]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1193,7 +1366,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[18]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1206,7 +1379,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{18}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListUsersResponse) GetUsers() []*User {
@@ -1227,7 +1400,7 @@ type GetUserRequest struct {
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[19]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1240,7 +1413,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[19]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,7 +1426,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{19}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetUserRequest) GetUser() *User {
@@ -1274,7 +1447,7 @@ type GetUserResponse struct {
 func (x *GetUserResponse) Reset() {
 	*x = GetUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[20]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1287,7 +1460,7 @@ func (x *GetUserResponse) String() string {
 func (*GetUserResponse) ProtoMessage() {}
 
 func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[20]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,7 +1473,7 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{20}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetUserResponse) GetUser() *User {
@@ -1321,7 +1494,7 @@ type UpdateUserRequest struct {
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[21]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1334,7 +1507,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[21]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1520,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{21}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateUserRequest) GetUser() *User {
@@ -1366,7 +1539,7 @@ type UpdateUserResponse struct {
 func (x *UpdateUserResponse) Reset() {
 	*x = UpdateUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[22]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1379,7 +1552,7 @@ func (x *UpdateUserResponse) String() string {
 func (*UpdateUserResponse) ProtoMessage() {}
 
 func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[22]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +1565,7 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{22}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{24}
 }
 
 type DeleteUserRequest struct {
@@ -1406,7 +1579,7 @@ type DeleteUserRequest struct {
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[23]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1419,7 +1592,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[23]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1432,7 +1605,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{23}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DeleteUserRequest) GetUser() *User {
@@ -1451,7 +1624,7 @@ type DeleteUserResponse struct {
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[24]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1464,7 +1637,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[24]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1650,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{24}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{26}
 }
 
 type ListUserTokensRequest struct {
@@ -1491,7 +1664,7 @@ type ListUserTokensRequest struct {
 func (x *ListUserTokensRequest) Reset() {
 	*x = ListUserTokensRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[25]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1504,7 +1677,7 @@ func (x *ListUserTokensRequest) String() string {
 func (*ListUserTokensRequest) ProtoMessage() {}
 
 func (x *ListUserTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[25]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1517,7 +1690,7 @@ func (x *ListUserTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListUserTokensRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{25}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListUserTokensRequest) GetUser() *User {
@@ -1538,7 +1711,7 @@ type ListUserTokensResponse struct {
 func (x *ListUserTokensResponse) Reset() {
 	*x = ListUserTokensResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[26]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1551,7 +1724,7 @@ func (x *ListUserTokensResponse) String() string {
 func (*ListUserTokensResponse) ProtoMessage() {}
 
 func (x *ListUserTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[26]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,7 +1737,7 @@ func (x *ListUserTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListUserTokensResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{26}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ListUserTokensResponse) GetPolicyLists() []*PolicyList {
@@ -1579,14 +1752,17 @@ type AddUserTokenRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username string    `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Policies []*Policy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	Username  string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Policies  []*Policy              `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	Label     string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Scoped    bool                   `protobuf:"varint,5,opt,name=scoped,proto3" json:"scoped,omitempty"`
 }
 
 func (x *AddUserTokenRequest) Reset() {
 	*x = AddUserTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[27]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1599,7 +1775,7 @@ func (x *AddUserTokenRequest) String() string {
 func (*AddUserTokenRequest) ProtoMessage() {}
 
 func (x *AddUserTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[27]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1612,7 +1788,7 @@ func (x *AddUserTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserTokenRequest.ProtoReflect.Descriptor instead.
 func (*AddUserTokenRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{27}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AddUserTokenRequest) GetUsername() string {
@@ -1629,6 +1805,27 @@ func (x *AddUserTokenRequest) GetPolicies() []*Policy {
 	return nil
 }
 
+func (x *AddUserTokenRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddUserTokenRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AddUserTokenRequest) GetScoped() bool {
+	if x != nil {
+		return x.Scoped
+	}
+	return false
+}
+
 type AddUserTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1638,7 +1835,7 @@ type AddUserTokenResponse struct {
 func (x *AddUserTokenResponse) Reset() {
 	*x = AddUserTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[28]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1651,7 +1848,7 @@ func (x *AddUserTokenResponse) String() string {
 func (*AddUserTokenResponse) ProtoMessage() {}
 
 func (x *AddUserTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[28]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,7 +1861,7 @@ func (x *AddUserTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserTokenResponse.ProtoReflect.Descriptor instead.
 func (*AddUserTokenResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{28}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{30}
 }
 
 type DeleteUserTokenRequest struct {
@@ -1679,7 +1876,7 @@ type DeleteUserTokenRequest struct {
 func (x *DeleteUserTokenRequest) Reset() {
 	*x = DeleteUserTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[29]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1692,7 +1889,7 @@ func (x *DeleteUserTokenRequest) String() string {
 func (*DeleteUserTokenRequest) ProtoMessage() {}
 
 func (x *DeleteUserTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[29]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1705,7 +1902,7 @@ func (x *DeleteUserTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserTokenRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{29}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *DeleteUserTokenRequest) GetUsername() string {
@@ -1731,7 +1928,7 @@ type DeleteUserTokenResponse struct {
 func (x *DeleteUserTokenResponse) Reset() {
 	*x = DeleteUserTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[30]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1744,7 +1941,7 @@ func (x *DeleteUserTokenResponse) String() string {
 func (*DeleteUserTokenResponse) ProtoMessage() {}
 
 func (x *DeleteUserTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[30]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,7 +1954,7 @@ func (x *DeleteUserTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserTokenResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserTokenResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{30}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{32}
 }
 
 type LoginRequest struct {
@@ -1771,7 +1968,7 @@ type LoginRequest struct {
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[31]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1784,7 +1981,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[31]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1797,7 +1994,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{31}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *LoginRequest) GetUser() *User {
@@ -1818,7 +2015,7 @@ type LoginResponse struct {
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[32]
+		mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1831,7 +2028,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[32]
+	mi := &file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1844,7 +2041,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{32}
+	return file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *LoginResponse) GetPolicyLists() []*PolicyList {
@@ -1949,222 +2146,272 @@ var file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDesc = []by
 	0x6f, 0x72, 0x6b, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
-	0x22, 0x5d, 0x0a, 0x0a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
-	0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22,
-	0x86, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x65, 0x72,
-	0x74, 0x5f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x65, 0x72,
-	0x74, 0x44, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
-	0x63, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x42, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43,
-	0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x86, 0x01, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
-	0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63,
-	0x74, 0x52, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x22, 0x44, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
-	0x14, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x11, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x22, 0xe1, 0x02, 0x0a, 0x0a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x49, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x64, 0x22, 0x6a, 0x0a, 0x08, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20,
+	0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x49, 0x70,
+	0x22, 0x86, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x65,
+	0x72, 0x74, 0x5f, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x65,
+	0x72, 0x74, 0x44, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x08, 0x63, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x42, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x65, 0x72,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa3, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
 	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
-	0x73, 0x22, 0x41, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
-	0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14,
-	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x70, 0x22, 0x9a, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
 	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x48, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x52, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x06, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x22, 0x44, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
 	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x16, 0x4c, 0x69,
-	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x41, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0x42, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
+	0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x44, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x43, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
+	0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14,
+	0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x0c, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
 	0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x22, 0x6c, 0x0a, 0x13, 0x41, 0x64, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x08,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x0d,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a,
+	0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x73, 0x2a, 0x2a, 0x0a, 0x06, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e,
+	0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x27,
+	0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x32, 0xc3, 0x0e, 0x0a, 0x09, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x43, 0x41, 0x12, 0x23,
 	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x4a, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
-	0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x2a, 0x2a, 0x0a,
-	0x06, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x01, 0x12, 0x09,
-	0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x27, 0x0a, 0x06, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49, 0x54, 0x45,
-	0x10, 0x02, 0x32, 0xb2, 0x0d, 0x0a, 0x09, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x12, 0x43, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x43, 0x41, 0x12, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
-	0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43, 0x41, 0x43,
-	0x65, 0x72, 0x74, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x41, 0x64, 0x64,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43, 0x53, 0x52, 0x1a, 0x18, 0x2e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
+	0x72, 0x2e, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x53, 0x69,
+	0x67, 0x6e, 0x41, 0x64, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x10, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43,
+	0x53, 0x52, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x54,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x2e,
 	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x2e, 0x53, 0x4e, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
-	0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12,
-	0x45, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
-	0x38, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x53,
-	0x4e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e,
-	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x2e, 0x41, 0x64, 0x64, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a,
-	0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x6d, 0x61,
-	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x24,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x4e, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x2e, 0x53, 0x4e, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
+	0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0e, 0x41,
+	0x64, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x25, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
+	0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x10, 0x46, 0x69, 0x6e,
+	0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x15, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
+	0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
 	0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
-	0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x61, 0x70, 0x22, 0x00,
-	0x12, 0x38, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x47, 0x61, 0x72, 0x62, 0x61,
-	0x67, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
-	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
-	0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x2f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
+	0x6f, 0x69, 0x64, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
+	0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x06, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
+	0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x4d, 0x61, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x11, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12,
+	0x78, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
+	0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
+	0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8e, 0x01, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46,
+	0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x3a, 0x2e, 0x6d, 0x61, 0x67,
+	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
+	0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
 	0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x60, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x6d,
 	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x09, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
-	0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x28, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
-	0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
+	0x66, 0x69, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
+	0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x6d,
+	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
+	0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6d, 0x61,
+	0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
 	0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x67,
-	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
-	0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x6f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38,
-	0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x69, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x2a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72,
-	0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
-	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a,
-	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x2d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x63, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x28, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63,
 	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x54, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x61, 0x67,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x67,
 	0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65,
-	0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2f, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x6d, 0x61, 0x67, 0x6d,
+	0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e,
+	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
+	0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
+	0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
+	0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x23, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
+	0x63, 0x38, 0x72, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x30, 0x5a,
+	0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2f, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2180,59 +2427,61 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDescGZIP()
 }
 
 var file_orc8r_cloud_go_services_certifier_protos_certifier_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_orc8r_cloud_go_services_certifier_protos_certifier_proto_goTypes = []interface{}{
-	(Effect)(0),                       // 0: magma.orc8r.certifier.Effect
-	(Action)(0),                       // 1: magma.orc8r.certifier.Action
-	(*CertificateInfo)(nil),           // 2: magma.orc8r.certifier.CertificateInfo
-	(*CertificateInfoMap)(nil),        // 3: magma.orc8r.certifier.CertificateInfoMap
-	(*SerialNumbers)(nil),             // 4: magma.orc8r.certifier.SerialNumbers
-	(*TokenList)(nil),                 // 5: magma.orc8r.certifier.TokenList
-	(*User)(nil),                      // 6: magma.orc8r.certifier.User
-	(*Request)(nil),                   // 7: magma.orc8r.certifier.Request
-	(*Policy)(nil),                    // 8: magma.orc8r.certifier.Policy
-	(*PathResource)(nil),              // 9: magma.orc8r.certifier.PathResource
-	(*NetworkResource)(nil),           // 10: magma.orc8r.certifier.NetworkResource
-	(*TenantResource)(nil),            // 11: magma.orc8r.certifier.TenantResource
-	(*PolicyList)(nil),                // 12: magma.orc8r.certifier.PolicyList
-	(*AddCertRequest)(nil),            // 13: magma.orc8r.certifier.AddCertRequest
-	(*GetCARequest)(nil),              // 14: magma.orc8r.certifier.GetCARequest
-	(*GetPolicyDecisionRequest)(nil),  // 15: magma.orc8r.certifier.GetPolicyDecisionRequest
-	(*GetPolicyDecisionResponse)(nil), // 16: magma.orc8r.certifier.GetPolicyDecisionResponse
-	(*CreateUserRequest)(nil),         // 17: magma.orc8r.certifier.CreateUserRequest
-	(*CreateUserResponse)(nil),        // 18: magma.orc8r.certifier.CreateUserResponse
-	(*ListUsersRequest)(nil),          // 19: magma.orc8r.certifier.ListUsersRequest
-	(*ListUsersResponse)(nil),         // 20: magma.orc8r.certifier.ListUsersResponse
-	(*GetUserRequest)(nil),            // 21: magma.orc8r.certifier.GetUserRequest
-	(*GetUserResponse)(nil),           // 22: magma.orc8r.certifier.GetUserResponse
-	(*UpdateUserRequest)(nil),         // 23: magma.orc8r.certifier.UpdateUserRequest
-	(*UpdateUserResponse)(nil),        // 24: magma.orc8r.certifier.UpdateUserResponse
-	(*DeleteUserRequest)(nil),         // 25: magma.orc8r.certifier.DeleteUserRequest
-	(*DeleteUserResponse)(nil),        // 26: magma.orc8r.certifier.DeleteUserResponse
-	(*ListUserTokensRequest)(nil),     // 27: magma.orc8r.certifier.ListUserTokensRequest
-	(*ListUserTokensResponse)(nil),    // 28: magma.orc8r.certifier.ListUserTokensResponse
-	(*AddUserTokenRequest)(nil),       // 29: magma.orc8r.certifier.AddUserTokenRequest
-	(*AddUserTokenResponse)(nil),      // 30: magma.orc8r.certifier.AddUserTokenResponse
-	(*DeleteUserTokenRequest)(nil),    // 31: magma.orc8r.certifier.DeleteUserTokenRequest
-	(*DeleteUserTokenResponse)(nil),   // 32: magma.orc8r.certifier.DeleteUserTokenResponse
-	(*LoginRequest)(nil),              // 33: magma.orc8r.certifier.LoginRequest
-	(*LoginResponse)(nil),             // 34: magma.orc8r.certifier.LoginResponse
-	nil,                               // 35: magma.orc8r.certifier.CertificateInfoMap.CertificatesEntry
-	(*protos.Identity)(nil),           // 36: magma.orc8r.Identity
-	(*timestamp.Timestamp)(nil),       // 37: google.protobuf.Timestamp
-	(protos.CertType)(0),              // 38: magma.orc8r.CertType
-	(*protos.CSR)(nil),                // 39: magma.orc8r.CSR
-	(*protos.Certificate_SN)(nil),     // 40: magma.orc8r.Certificate.SN
-	(*protos.Void)(nil),               // 41: magma.orc8r.Void
-	(*protos.CACert)(nil),             // 42: magma.orc8r.CACert
-	(*protos.Certificate)(nil),        // 43: magma.orc8r.Certificate
+	(Effect)(0),                                 // 0: magma.orc8r.certifier.Effect
+	(Action)(0),                                 // 1: magma.orc8r.certifier.Action
+	(*CertificateInfo)(nil),                     // 2: magma.orc8r.certifier.CertificateInfo
+	(*CertificateInfoMap)(nil),                  // 3: magma.orc8r.certifier.CertificateInfoMap
+	(*SerialNumbers)(nil),                       // 4: magma.orc8r.certifier.SerialNumbers
+	(*TokenList)(nil),                           // 5: magma.orc8r.certifier.TokenList
+	(*User)(nil),                                // 6: magma.orc8r.certifier.User
+	(*Request)(nil),                             // 7: magma.orc8r.certifier.Request
+	(*Policy)(nil),                              // 8: magma.orc8r.certifier.Policy
+	(*PathResource)(nil),                        // 9: magma.orc8r.certifier.PathResource
+	(*NetworkResource)(nil),                     // 10: magma.orc8r.certifier.NetworkResource
+	(*TenantResource)(nil),                      // 11: magma.orc8r.certifier.TenantResource
+	(*PolicyList)(nil),                          // 12: magma.orc8r.certifier.PolicyList
+	(*TokenUse)(nil),                            // 13: magma.orc8r.certifier.TokenUse
+	(*AddCertRequest)(nil),                      // 14: magma.orc8r.certifier.AddCertRequest
+	(*GetCARequest)(nil),                        // 15: magma.orc8r.certifier.GetCARequest
+	(*GetPolicyDecisionRequest)(nil),            // 16: magma.orc8r.certifier.GetPolicyDecisionRequest
+	(*GetPolicyDecisionForPoliciesRequest)(nil), // 17: magma.orc8r.certifier.GetPolicyDecisionForPoliciesRequest
+	(*GetPolicyDecisionResponse)(nil),           // 18: magma.orc8r.certifier.GetPolicyDecisionResponse
+	(*CreateUserRequest)(nil),                   // 19: magma.orc8r.certifier.CreateUserRequest
+	(*CreateUserResponse)(nil),                  // 20: magma.orc8r.certifier.CreateUserResponse
+	(*ListUsersRequest)(nil),                    // 21: magma.orc8r.certifier.ListUsersRequest
+	(*ListUsersResponse)(nil),                   // 22: magma.orc8r.certifier.ListUsersResponse
+	(*GetUserRequest)(nil),                      // 23: magma.orc8r.certifier.GetUserRequest
+	(*GetUserResponse)(nil),                     // 24: magma.orc8r.certifier.GetUserResponse
+	(*UpdateUserRequest)(nil),                   // 25: magma.orc8r.certifier.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                  // 26: magma.orc8r.certifier.UpdateUserResponse
+	(*DeleteUserRequest)(nil),                   // 27: magma.orc8r.certifier.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                  // 28: magma.orc8r.certifier.DeleteUserResponse
+	(*ListUserTokensRequest)(nil),               // 29: magma.orc8r.certifier.ListUserTokensRequest
+	(*ListUserTokensResponse)(nil),              // 30: magma.orc8r.certifier.ListUserTokensResponse
+	(*AddUserTokenRequest)(nil),                 // 31: magma.orc8r.certifier.AddUserTokenRequest
+	(*AddUserTokenResponse)(nil),                // 32: magma.orc8r.certifier.AddUserTokenResponse
+	(*DeleteUserTokenRequest)(nil),              // 33: magma.orc8r.certifier.DeleteUserTokenRequest
+	(*DeleteUserTokenResponse)(nil),             // 34: magma.orc8r.certifier.DeleteUserTokenResponse
+	(*LoginRequest)(nil),                        // 35: magma.orc8r.certifier.LoginRequest
+	(*LoginResponse)(nil),                       // 36: magma.orc8r.certifier.LoginResponse
+	nil,                                         // 37: magma.orc8r.certifier.CertificateInfoMap.CertificatesEntry
+	(*protos.Identity)(nil),                     // 38: magma.orc8r.Identity
+	(*timestamppb.Timestamp)(nil),               // 39: google.protobuf.Timestamp
+	(protos.CertType)(0),                        // 40: magma.orc8r.CertType
+	(*protos.CSR)(nil),                          // 41: magma.orc8r.CSR
+	(*protos.Certificate_SN)(nil),               // 42: magma.orc8r.Certificate.SN
+	(*protos.Void)(nil),                         // 43: magma.orc8r.Void
+	(*protos.CACert)(nil),                       // 44: magma.orc8r.CACert
+	(*protos.Certificate)(nil),                  // 45: magma.orc8r.Certificate
 }
 var file_orc8r_cloud_go_services_certifier_protos_certifier_proto_depIdxs = []int32{
-	36, // 0: magma.orc8r.certifier.CertificateInfo.id:type_name -> magma.orc8r.Identity
-	37, // 1: magma.orc8r.certifier.CertificateInfo.not_before:type_name -> google.protobuf.Timestamp
-	37, // 2: magma.orc8r.certifier.CertificateInfo.not_after:type_name -> google.protobuf.Timestamp
-	38, // 3: magma.orc8r.certifier.CertificateInfo.cert_type:type_name -> magma.orc8r.CertType
-	35, // 4: magma.orc8r.certifier.CertificateInfoMap.certificates:type_name -> magma.orc8r.certifier.CertificateInfoMap.CertificatesEntry
+	38, // 0: magma.orc8r.certifier.CertificateInfo.id:type_name -> magma.orc8r.Identity
+	39, // 1: magma.orc8r.certifier.CertificateInfo.not_before:type_name -> google.protobuf.Timestamp
+	39, // 2: magma.orc8r.certifier.CertificateInfo.not_after:type_name -> google.protobuf.Timestamp
+	40, // 3: magma.orc8r.certifier.CertificateInfo.cert_type:type_name -> magma.orc8r.CertType
+	37, // 4: magma.orc8r.certifier.CertificateInfoMap.certificates:type_name -> magma.orc8r.certifier.CertificateInfoMap.CertificatesEntry
 	5,  // 5: magma.orc8r.certifier.User.tokens:type_name -> magma.orc8r.certifier.TokenList
 	1,  // 6: magma.orc8r.certifier.Request.action:type_name -> magma.orc8r.certifier.Action
 	0,  // 7: magma.orc8r.certifier.Policy.effect:type_name -> magma.orc8r.certifier.Effect
@@ -2241,66 +2490,75 @@ var file_orc8r_cloud_go_services_certifier_protos_certifier_proto_depIdxs = []in
 	10, // 10: magma.orc8r.certifier.Policy.network:type_name -> magma.orc8r.certifier.NetworkResource
 	11, // 11: magma.orc8r.certifier.Policy.tenant:type_name -> magma.orc8r.certifier.TenantResource
 	8,  // 12: magma.orc8r.certifier.PolicyList.policies:type_name -> magma.orc8r.certifier.Policy
-	36, // 13: magma.orc8r.certifier.AddCertRequest.id:type_name -> magma.orc8r.Identity
-	38, // 14: magma.orc8r.certifier.AddCertRequest.cert_type:type_name -> magma.orc8r.CertType
-	38, // 15: magma.orc8r.certifier.GetCARequest.cert_type:type_name -> magma.orc8r.CertType
-	7,  // 16: magma.orc8r.certifier.GetPolicyDecisionRequest.request:type_name -> magma.orc8r.certifier.Request
-	0,  // 17: magma.orc8r.certifier.GetPolicyDecisionResponse.effect:type_name -> magma.orc8r.certifier.Effect
-	6,  // 18: magma.orc8r.certifier.CreateUserRequest.user:type_name -> magma.orc8r.certifier.User
-	6,  // 19: magma.orc8r.certifier.ListUsersResponse.users:type_name -> magma.orc8r.certifier.User
-	6,  // 20: magma.orc8r.certifier.GetUserRequest.user:type_name -> magma.orc8r.certifier.User
-	6,  // 21: magma.orc8r.certifier.GetUserResponse.user:type_name -> magma.orc8r.certifier.User
-	6,  // 22: magma.orc8r.certifier.UpdateUserRequest.user:type_name -> magma.orc8r.certifier.User
-	6,  // 23: magma.orc8r.certifier.DeleteUserRequest.user:type_name -> magma.orc8r.certifier.User
-	6,  // 24: magma.orc8r.certifier.ListUserTokensRequest.user:type_name -> magma.orc8r.certifier.User
-	12, // 25: magma.orc8r.certifier.ListUserTokensResponse.policyLists:type_name -> magma.orc8r.certifier.PolicyList
-	8,  // 26: magma.orc8r.certifier.AddUserTokenRequest.policies:type_name -> magma.orc8r.certifier.Policy
-	6,  // 27: magma.orc8r.certifier.LoginRequest.user:type_name -> magma.orc8r.certifier.User
-	12, // 28: magma.orc8r.certifier.LoginResponse.policyLists:type_name -> magma.orc8r.certifier.PolicyList
-	2,  // 29: magma.orc8r.certifier.CertificateInfoMap.CertificatesEntry.value:type_name -> magma.orc8r.certifier.CertificateInfo
-	14, // 30: magma.orc8r.certifier.Certifier.GetCA:input_type -> magma.orc8r.certifier.GetCARequest
-	39, // 31: magma.orc8r.certifier.Certifier.SignAddCertificate:input_type -> magma.orc8r.CSR
-	40, // 32: magma.orc8r.certifier.Certifier.GetIdentity:input_type -> magma.orc8r.Certificate.SN
-	40, // 33: magma.orc8r.certifier.Certifier.RevokeCertificate:input_type -> magma.orc8r.Certificate.SN
-	13, // 34: magma.orc8r.certifier.Certifier.AddCertificate:input_type -> magma.orc8r.certifier.AddCertRequest
-	36, // 35: magma.orc8r.certifier.Certifier.FindCertificates:input_type -> magma.orc8r.Identity
-	41, // 36: magma.orc8r.certifier.Certifier.ListCertificates:input_type -> magma.orc8r.Void
-	41, // 37: magma.orc8r.certifier.Certifier.GetAll:input_type -> magma.orc8r.Void
-	41, // 38: magma.orc8r.certifier.Certifier.CollectGarbage:input_type -> magma.orc8r.Void
-	15, // 39: magma.orc8r.certifier.Certifier.GetPolicyDecision:input_type -> magma.orc8r.certifier.GetPolicyDecisionRequest
-	17, // 40: magma.orc8r.certifier.Certifier.CreateUser:input_type -> magma.orc8r.certifier.CreateUserRequest
-	19, // 41: magma.orc8r.certifier.Certifier.ListUsers:input_type -> magma.orc8r.certifier.ListUsersRequest
-	21, // 42: magma.orc8r.certifier.Certifier.GetUser:input_type -> magma.orc8r.certifier.GetUserRequest
-	23, // 43: magma.orc8r.certifier.Certifier.UpdateUser:input_type -> magma.orc8r.certifier.UpdateUserRequest
-	25, // 44: magma.orc8r.certifier.Certifier.DeleteUser:input_type -> magma.orc8r.certifier.DeleteUserRequest
-	27, // 45: magma.orc8r.certifier.Certifier.ListUserTokens:input_type -> magma.orc8r.certifier.ListUserTokensRequest
-	29, // 46: magma.orc8r.certifier.Certifier.AddUserToken:input_type -> magma.orc8r.certifier.AddUserTokenRequest
-	31, // 47: magma.orc8r.certifier.Certifier.DeleteUserToken:input_type -> magma.orc8r.certifier.DeleteUserTokenRequest
-	33, // 48: magma.orc8r.certifier.Certifier.Login:input_type -> magma.orc8r.certifier.LoginRequest
-	42, // 49: magma.orc8r.certifier.Certifier.GetCA:output_type -> magma.orc8r.CACert
-	43, // 50: magma.orc8r.certifier.Certifier.SignAddCertificate:output_type -> magma.orc8r.Certificate
-	2,  // 51: magma.orc8r.certifier.Certifier.GetIdentity:output_type -> magma.orc8r.certifier.CertificateInfo
-	41, // 52: magma.orc8r.certifier.Certifier.RevokeCertificate:output_type -> magma.orc8r.Void
-	41, // 53: magma.orc8r.certifier.Certifier.AddCertificate:output_type -> magma.orc8r.Void
-	4,  // 54: magma.orc8r.certifier.Certifier.FindCertificates:output_type -> magma.orc8r.certifier.SerialNumbers
-	4,  // 55: magma.orc8r.certifier.Certifier.ListCertificates:output_type -> magma.orc8r.certifier.SerialNumbers
-	3,  // 56: magma.orc8r.certifier.Certifier.GetAll:output_type -> magma.orc8r.certifier.CertificateInfoMap
-	41, // 57: magma.orc8r.certifier.Certifier.CollectGarbage:output_type -> magma.orc8r.Void
-	16, // 58: magma.orc8r.certifier.Certifier.GetPolicyDecision:output_type -> magma.orc8r.certifier.GetPolicyDecisionResponse
-	18, // 59: magma.orc8r.certifier.Certifier.CreateUser:output_type -> magma.orc8r.certifier.CreateUserResponse
-	20, // 60: magma.orc8r.certifier.Certifier.ListUsers:output_type -> magma.orc8r.certifier.ListUsersResponse
-	22, // 61: magma.orc8r.certifier.Certifier.GetUser:output_type -> magma.orc8r.certifier.GetUserResponse
-	24, // 62: magma.orc8r.certifier.Certifier.UpdateUser:output_type -> magma.orc8r.certifier.UpdateUserResponse
-	26, // 63: magma.orc8r.certifier.Certifier.DeleteUser:output_type -> magma.orc8r.certifier.DeleteUserResponse
-	28, // 64: magma.orc8r.certifier.Certifier.ListUserTokens:output_type -> magma.orc8r.certifier.ListUserTokensResponse
-	30, // 65: magma.orc8r.certifier.Certifier.AddUserToken:output_type -> magma.orc8r.certifier.AddUserTokenResponse
-	32, // 66: magma.orc8r.certifier.Certifier.DeleteUserToken:output_type -> magma.orc8r.certifier.DeleteUserTokenResponse
-	34, // 67: magma.orc8r.certifier.Certifier.Login:output_type -> magma.orc8r.certifier.LoginResponse
-	49, // [49:68] is the sub-list for method output_type
-	30, // [30:49] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	39, // 13: magma.orc8r.certifier.PolicyList.created_at:type_name -> google.protobuf.Timestamp
+	39, // 14: magma.orc8r.certifier.PolicyList.expires_at:type_name -> google.protobuf.Timestamp
+	39, // 15: magma.orc8r.certifier.PolicyList.last_used_at:type_name -> google.protobuf.Timestamp
+	39, // 16: magma.orc8r.certifier.TokenUse.last_used_at:type_name -> google.protobuf.Timestamp
+	38, // 17: magma.orc8r.certifier.AddCertRequest.id:type_name -> magma.orc8r.Identity
+	40, // 18: magma.orc8r.certifier.AddCertRequest.cert_type:type_name -> magma.orc8r.CertType
+	40, // 19: magma.orc8r.certifier.GetCARequest.cert_type:type_name -> magma.orc8r.CertType
+	7,  // 20: magma.orc8r.certifier.GetPolicyDecisionRequest.request:type_name -> magma.orc8r.certifier.Request
+	8,  // 21: magma.orc8r.certifier.GetPolicyDecisionForPoliciesRequest.policies:type_name -> magma.orc8r.certifier.Policy
+	7,  // 22: magma.orc8r.certifier.GetPolicyDecisionForPoliciesRequest.request:type_name -> magma.orc8r.certifier.Request
+	0,  // 23: magma.orc8r.certifier.GetPolicyDecisionResponse.effect:type_name -> magma.orc8r.certifier.Effect
+	6,  // 24: magma.orc8r.certifier.CreateUserRequest.user:type_name -> magma.orc8r.certifier.User
+	6,  // 25: magma.orc8r.certifier.ListUsersResponse.users:type_name -> magma.orc8r.certifier.User
+	6,  // 26: magma.orc8r.certifier.GetUserRequest.user:type_name -> magma.orc8r.certifier.User
+	6,  // 27: magma.orc8r.certifier.GetUserResponse.user:type_name -> magma.orc8r.certifier.User
+	6,  // 28: magma.orc8r.certifier.UpdateUserRequest.user:type_name -> magma.orc8r.certifier.User
+	6,  // 29: magma.orc8r.certifier.DeleteUserRequest.user:type_name -> magma.orc8r.certifier.User
+	6,  // 30: magma.orc8r.certifier.ListUserTokensRequest.user:type_name -> magma.orc8r.certifier.User
+	12, // 31: magma.orc8r.certifier.ListUserTokensResponse.policyLists:type_name -> magma.orc8r.certifier.PolicyList
+	8,  // 32: magma.orc8r.certifier.AddUserTokenRequest.policies:type_name -> magma.orc8r.certifier.Policy
+	39, // 33: magma.orc8r.certifier.AddUserTokenRequest.expires_at:type_name -> google.protobuf.Timestamp
+	6,  // 34: magma.orc8r.certifier.LoginRequest.user:type_name -> magma.orc8r.certifier.User
+	12, // 35: magma.orc8r.certifier.LoginResponse.policyLists:type_name -> magma.orc8r.certifier.PolicyList
+	2,  // 36: magma.orc8r.certifier.CertificateInfoMap.CertificatesEntry.value:type_name -> magma.orc8r.certifier.CertificateInfo
+	15, // 37: magma.orc8r.certifier.Certifier.GetCA:input_type -> magma.orc8r.certifier.GetCARequest
+	41, // 38: magma.orc8r.certifier.Certifier.SignAddCertificate:input_type -> magma.orc8r.CSR
+	42, // 39: magma.orc8r.certifier.Certifier.GetIdentity:input_type -> magma.orc8r.Certificate.SN
+	42, // 40: magma.orc8r.certifier.Certifier.RevokeCertificate:input_type -> magma.orc8r.Certificate.SN
+	14, // 41: magma.orc8r.certifier.Certifier.AddCertificate:input_type -> magma.orc8r.certifier.AddCertRequest
+	38, // 42: magma.orc8r.certifier.Certifier.FindCertificates:input_type -> magma.orc8r.Identity
+	43, // 43: magma.orc8r.certifier.Certifier.ListCertificates:input_type -> magma.orc8r.Void
+	43, // 44: magma.orc8r.certifier.Certifier.GetAll:input_type -> magma.orc8r.Void
+	43, // 45: magma.orc8r.certifier.Certifier.CollectGarbage:input_type -> magma.orc8r.Void
+	16, // 46: magma.orc8r.certifier.Certifier.GetPolicyDecision:input_type -> magma.orc8r.certifier.GetPolicyDecisionRequest
+	17, // 47: magma.orc8r.certifier.Certifier.GetPolicyDecisionForPolicies:input_type -> magma.orc8r.certifier.GetPolicyDecisionForPoliciesRequest
+	19, // 48: magma.orc8r.certifier.Certifier.CreateUser:input_type -> magma.orc8r.certifier.CreateUserRequest
+	21, // 49: magma.orc8r.certifier.Certifier.ListUsers:input_type -> magma.orc8r.certifier.ListUsersRequest
+	23, // 50: magma.orc8r.certifier.Certifier.GetUser:input_type -> magma.orc8r.certifier.GetUserRequest
+	25, // 51: magma.orc8r.certifier.Certifier.UpdateUser:input_type -> magma.orc8r.certifier.UpdateUserRequest
+	27, // 52: magma.orc8r.certifier.Certifier.DeleteUser:input_type -> magma.orc8r.certifier.DeleteUserRequest
+	29, // 53: magma.orc8r.certifier.Certifier.ListUserTokens:input_type -> magma.orc8r.certifier.ListUserTokensRequest
+	31, // 54: magma.orc8r.certifier.Certifier.AddUserToken:input_type -> magma.orc8r.certifier.AddUserTokenRequest
+	33, // 55: magma.orc8r.certifier.Certifier.DeleteUserToken:input_type -> magma.orc8r.certifier.DeleteUserTokenRequest
+	35, // 56: magma.orc8r.certifier.Certifier.Login:input_type -> magma.orc8r.certifier.LoginRequest
+	44, // 57: magma.orc8r.certifier.Certifier.GetCA:output_type -> magma.orc8r.CACert
+	45, // 58: magma.orc8r.certifier.Certifier.SignAddCertificate:output_type -> magma.orc8r.Certificate
+	2,  // 59: magma.orc8r.certifier.Certifier.GetIdentity:output_type -> magma.orc8r.certifier.CertificateInfo
+	43, // 60: magma.orc8r.certifier.Certifier.RevokeCertificate:output_type -> magma.orc8r.Void
+	43, // 61: magma.orc8r.certifier.Certifier.AddCertificate:output_type -> magma.orc8r.Void
+	4,  // 62: magma.orc8r.certifier.Certifier.FindCertificates:output_type -> magma.orc8r.certifier.SerialNumbers
+	4,  // 63: magma.orc8r.certifier.Certifier.ListCertificates:output_type -> magma.orc8r.certifier.SerialNumbers
+	3,  // 64: magma.orc8r.certifier.Certifier.GetAll:output_type -> magma.orc8r.certifier.CertificateInfoMap
+	43, // 65: magma.orc8r.certifier.Certifier.CollectGarbage:output_type -> magma.orc8r.Void
+	18, // 66: magma.orc8r.certifier.Certifier.GetPolicyDecision:output_type -> magma.orc8r.certifier.GetPolicyDecisionResponse
+	18, // 67: magma.orc8r.certifier.Certifier.GetPolicyDecisionForPolicies:output_type -> magma.orc8r.certifier.GetPolicyDecisionResponse
+	20, // 68: magma.orc8r.certifier.Certifier.CreateUser:output_type -> magma.orc8r.certifier.CreateUserResponse
+	22, // 69: magma.orc8r.certifier.Certifier.ListUsers:output_type -> magma.orc8r.certifier.ListUsersResponse
+	24, // 70: magma.orc8r.certifier.Certifier.GetUser:output_type -> magma.orc8r.certifier.GetUserResponse
+	26, // 71: magma.orc8r.certifier.Certifier.UpdateUser:output_type -> magma.orc8r.certifier.UpdateUserResponse
+	28, // 72: magma.orc8r.certifier.Certifier.DeleteUser:output_type -> magma.orc8r.certifier.DeleteUserResponse
+	30, // 73: magma.orc8r.certifier.Certifier.ListUserTokens:output_type -> magma.orc8r.certifier.ListUserTokensResponse
+	32, // 74: magma.orc8r.certifier.Certifier.AddUserToken:output_type -> magma.orc8r.certifier.AddUserTokenResponse
+	34, // 75: magma.orc8r.certifier.Certifier.DeleteUserToken:output_type -> magma.orc8r.certifier.DeleteUserTokenResponse
+	36, // 76: magma.orc8r.certifier.Certifier.Login:output_type -> magma.orc8r.certifier.LoginResponse
+	57, // [57:77] is the sub-list for method output_type
+	37, // [37:57] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() }
@@ -2442,7 +2700,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCertRequest); i {
+			switch v := v.(*TokenUse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2454,7 +2712,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCARequest); i {
+			switch v := v.(*AddCertRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2466,7 +2724,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPolicyDecisionRequest); i {
+			switch v := v.(*GetCARequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2478,7 +2736,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPolicyDecisionResponse); i {
+			switch v := v.(*GetPolicyDecisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2490,7 +2748,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+			switch v := v.(*GetPolicyDecisionForPoliciesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2502,7 +2760,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserResponse); i {
+			switch v := v.(*GetPolicyDecisionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2514,7 +2772,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUsersRequest); i {
+			switch v := v.(*CreateUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2526,7 +2784,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUsersResponse); i {
+			switch v := v.(*CreateUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2538,7 +2796,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRequest); i {
+			switch v := v.(*ListUsersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2550,7 +2808,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserResponse); i {
+			switch v := v.(*ListUsersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2562,7 +2820,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserRequest); i {
+			switch v := v.(*GetUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2574,7 +2832,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserResponse); i {
+			switch v := v.(*GetUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2586,7 +2844,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserRequest); i {
+			switch v := v.(*UpdateUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2598,7 +2856,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserResponse); i {
+			switch v := v.(*UpdateUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2610,7 +2868,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserTokensRequest); i {
+			switch v := v.(*DeleteUserRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2622,7 +2880,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserTokensResponse); i {
+			switch v := v.(*DeleteUserResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2634,7 +2892,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddUserTokenRequest); i {
+			switch v := v.(*ListUserTokensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2646,7 +2904,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddUserTokenResponse); i {
+			switch v := v.(*ListUserTokensResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2658,7 +2916,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserTokenRequest); i {
+			switch v := v.(*AddUserTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2670,7 +2928,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteUserTokenResponse); i {
+			switch v := v.(*AddUserTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2682,7 +2940,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+			switch v := v.(*DeleteUserTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2694,6 +2952,30 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			}
 		}
 		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteUserTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orc8r_cloud_go_services_certifier_protos_certifier_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LoginResponse); i {
 			case 0:
 				return &v.state
@@ -2721,7 +3003,7 @@ func file_orc8r_cloud_go_services_certifier_protos_certifier_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_orc8r_cloud_go_services_certifier_protos_certifier_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   34,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2752,12 +3034,15 @@ type CertifierClient interface {
 	GetCA(ctx context.Context, in *GetCARequest, opts ...grpc.CallOption) (*protos.CACert, error)
 	// Signs and adds a new certificate to the store.
 	// Returns signed certificate.
+	//
 	SignAddCertificate(ctx context.Context, in *protos.CSR, opts ...grpc.CallOption) (*protos.Certificate, error)
 	// Returns the CertificateInfo for a certificate.
 	// Throws NOT_FOUND if the certificate is missing.
+	//
 	GetIdentity(ctx context.Context, in *protos.Certificate_SN, opts ...grpc.CallOption) (*CertificateInfo, error)
 	// Revoke an existing certificate.
 	// If the certificate does not exist or is expired, this request is ignored.
+	//
 	RevokeCertificate(ctx context.Context, in *protos.Certificate_SN, opts ...grpc.CallOption) (*protos.Void, error)
 	// Add provided Certificate (AddCertRequest.cert_der) into Certifier table and
 	// associates its Serial Number with given Identity (AddCertRequest.id)
@@ -2774,6 +3059,9 @@ type CertifierClient interface {
 	// Returns a policy decision given a token, the request method's action
 	// (read/write), and the requested resource
 	GetPolicyDecision(ctx context.Context, in *GetPolicyDecisionRequest, opts ...grpc.CallOption) (*GetPolicyDecisionResponse, error)
+	// Returns a policy decision given policies, the request method's action
+	// (read/write), and the requested resource
+	GetPolicyDecisionForPolicies(ctx context.Context, in *GetPolicyDecisionForPoliciesRequest, opts ...grpc.CallOption) (*GetPolicyDecisionResponse, error)
 	// Create a new user with their username and password
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	// List all users and their information
@@ -2893,6 +3181,15 @@ func (c *certifierClient) GetPolicyDecision(ctx context.Context, in *GetPolicyDe
 	return out, nil
 }
 
+func (c *certifierClient) GetPolicyDecisionForPolicies(ctx context.Context, in *GetPolicyDecisionForPoliciesRequest, opts ...grpc.CallOption) (*GetPolicyDecisionResponse, error) {
+	out := new(GetPolicyDecisionResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/GetPolicyDecisionForPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certifierClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
 	out := new(CreateUserResponse)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/CreateUser", in, out, opts...)
@@ -2980,12 +3277,15 @@ type CertifierServer interface {
 	GetCA(context.Context, *GetCARequest) (*protos.CACert, error)
 	// Signs and adds a new certificate to the store.
 	// Returns signed certificate.
+	//
 	SignAddCertificate(context.Context, *protos.CSR) (*protos.Certificate, error)
 	// Returns the CertificateInfo for a certificate.
 	// Throws NOT_FOUND if the certificate is missing.
+	//
 	GetIdentity(context.Context, *protos.Certificate_SN) (*CertificateInfo, error)
 	// Revoke an existing certificate.
 	// If the certificate does not exist or is expired, this request is ignored.
+	//
 	RevokeCertificate(context.Context, *protos.Certificate_SN) (*protos.Void, error)
 	// Add provided Certificate (AddCertRequest.cert_der) into Certifier table and
 	// associates its Serial Number with given Identity (AddCertRequest.id)
@@ -3002,6 +3302,9 @@ type CertifierServer interface {
 	// Returns a policy decision given a token, the request method's action
 	// (read/write), and the requested resource
 	GetPolicyDecision(context.Context, *GetPolicyDecisionRequest) (*GetPolicyDecisionResponse, error)
+	// Returns a policy decision given policies, the request method's action
+	// (read/write), and the requested resource
+	GetPolicyDecisionForPolicies(context.Context, *GetPolicyDecisionForPoliciesRequest) (*GetPolicyDecisionResponse, error)
 	// Create a new user with their username and password
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	// List all users and their information
@@ -3057,6 +3360,9 @@ func (*UnimplementedCertifierServer) CollectGarbage(context.Context, *protos.Voi
 func (*UnimplementedCertifierServer) GetPolicyDecision(context.Context, *GetPolicyDecisionRequest) (*GetPolicyDecisionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyDecision not implemented")
 }
+func (*UnimplementedCertifierServer) GetPolicyDecisionForPolicies(context.Context, *GetPolicyDecisionForPoliciesRequest) (*GetPolicyDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyDecisionForPolicies not implemented")
+}
 func (*UnimplementedCertifierServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
@@ -3269,6 +3575,24 @@ func _Certifier_GetPolicyDecision_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Certifier_GetPolicyDecisionForPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyDecisionForPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).GetPolicyDecisionForPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/GetPolicyDecisionForPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).GetPolicyDecisionForPolicies(ctx, req.(*GetPolicyDecisionForPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Certifier_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
@@ -3475,6 +3799,10 @@ var _Certifier_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPolicyDecision",
 			Handler:    _Certifier_GetPolicyDecision_Handler,
 		},
+		{
+			MethodName: "GetPolicyDecisionForPolicies",
+			Handler:    _Certifier_GetPolicyDecisionForPolicies_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _Certifier_CreateUser_Handler,
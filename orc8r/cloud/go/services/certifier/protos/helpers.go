@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
 
 	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/services/certifier/obsidian/models"
@@ -15,6 +17,8 @@ const (
 	UserType = "user"
 	// PolicyType is the type of policy used in blobstore type fileds
 	PolicyType = "policy"
+	// TokenUseType is the type of token use records used in blobstore type fields
+	TokenUseType = "token_use"
 )
 
 func UserFromBlob(blob blobstore.Blob) (*User, error) {
@@ -53,19 +57,50 @@ func (p *PolicyList) PolicyToBlob(username string) (blobstore.Blob, error) {
 	return policyBlob, nil
 }
 
+func TokenUseFromBlob(blob blobstore.Blob) (*TokenUse, error) {
+	use := &TokenUse{}
+	err := proto.Unmarshal(blob.Value, use)
+	if err != nil {
+		return use, err
+	}
+	return use, nil
+}
+
+func (u *TokenUse) TokenUseToBlob(token string) (blobstore.Blob, error) {
+	marshalledUse, err := proto.Marshal(u)
+	if err != nil {
+		return blobstore.Blob{}, err
+	}
+	useBlob := blobstore.Blob{Type: TokenUseType, Key: token, Value: marshalledUse}
+	return useBlob, nil
+}
+
 func PolicyListProtoToModel(policyLists []*PolicyList) []models.PolicyList {
 	var policyListsModels []models.PolicyList
 	for _, pl := range policyLists {
 		policiesModel := policiesProtoToModel(pl.Policies)
 		policyListsModel := models.PolicyList{
-			Token:    &pl.Token,
-			Policies: policiesModel,
+			Token:      &pl.Token,
+			Policies:   policiesModel,
+			Label:      pl.Label,
+			CreatedAt:  timestampToModel(pl.CreatedAt),
+			ExpiresAt:  timestampToModel(pl.ExpiresAt),
+			LastUsedAt: timestampToModel(pl.LastUsedAt),
+			LastUsedIP: pl.LastUsedIp,
+			Scoped:     pl.Scoped,
 		}
 		policyListsModels = append(policyListsModels, policyListsModel)
 	}
 	return policyListsModels
 }
 
+func timestampToModel(ts *timestamp.Timestamp) strfmt.DateTime {
+	if ts == nil {
+		return strfmt.DateTime{}
+	}
+	return strfmt.DateTime(ts.AsTime())
+}
+
 func PoliciesModelToProto(policies *models.Policies) ([]*Policy, error) {
 	policyProtos := make([]*Policy, len(*policies))
 	for i, policyModel := range *policies {
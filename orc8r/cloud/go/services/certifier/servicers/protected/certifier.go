@@ -29,6 +29,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"magma/orc8r/cloud/go/clock"
 	"magma/orc8r/cloud/go/identity"
@@ -37,6 +38,7 @@ import (
 	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	"magma/orc8r/cloud/go/services/certifier/storage"
 	"magma/orc8r/cloud/go/services/tenants"
+	"magma/orc8r/lib/go/merrors"
 	"magma/orc8r/lib/go/protos"
 	"magma/orc8r/lib/go/registry"
 	"magma/orc8r/lib/go/security/cert"
@@ -45,6 +47,8 @@ import (
 var (
 	NumTrialsForSn      int
 	CollectGarbageAfter time.Duration // remove cert if expired for certain amount of time
+	// TokenUseRecordInterval limits how often last use of the same token from the same ip is stored
+	TokenUseRecordInterval = time.Minute
 )
 
 func init() {
@@ -289,6 +293,11 @@ func (srv *CertifierServer) GetAll(context.Context, *protos.Void) (*certprotos.C
 func (srv *CertifierServer) CollectGarbage(ctx context.Context, void *protos.Void) (*protos.Void, error) {
 	count, err := srv.CollectGarbageImpl(ctx)
 	glog.Infof("purged %d expired certificates", count)
+	tokenCount, tokenErr := srv.CollectExpiredTokensImpl(ctx)
+	glog.Infof("purged %d expired tokens", tokenCount)
+	if err == nil {
+		err = tokenErr
+	}
 	return &protos.Void{}, err
 }
 
@@ -322,10 +331,45 @@ func (srv *CertifierServer) CollectGarbageImpl(ctx context.Context) (int, error)
 	return count, nil
 }
 
+// CollectExpiredTokensImpl removes expired tokens and their policies from all users.
+func (srv *CertifierServer) CollectExpiredTokensImpl(ctx context.Context) (int, error) {
+	users, err := srv.store.ListUsers()
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	now := clock.Now()
+	errs := &multierror.Error{}
+	count := 0
+	for _, user := range users {
+		var expired []string
+		for _, token := range user.GetTokens().GetTokens() {
+			policyList, err := srv.store.GetPolicy(token)
+			if err == nil && isTokenExpired(policyList, now) {
+				expired = append(expired, token)
+			}
+		}
+		if len(expired) == 0 {
+			continue
+		}
+		if err := srv.store.DeleteUserTokens(user.Username, expired); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("user '%s' expired tokens delete error: %v", user.Username, err))
+			continue
+		}
+		count += len(expired)
+	}
+	if errs.ErrorOrNil() != nil {
+		glog.Errorf("Failed to delete expired token[s]: %v", errs)
+		return count, status.Error(codes.Internal, errs.Error())
+	}
+	return count, nil
+}
+
 // GetPolicyDecision makes a policy decision when a user attempts to access a resource.
 // For conflicting policy decisions from multiple tokens (e.g. one policy is ALLOW and the other DENY), the DENY effect
 // will take precedent.
 // For resources that do not have any policies addressing it, the policy decision defaults to DENY as well.
+// Expired tokens are rejected and not taken into account, scoped tokens additionally
+// have to allow the request with their own policies.
 func (srv *CertifierServer) GetPolicyDecision(ctx context.Context, getPDReq *certprotos.GetPolicyDecisionRequest) (*certprotos.GetPolicyDecisionResponse, error) {
 	if err := certifier.ValidateToken(getPDReq.Token); err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, err.Error())
@@ -342,11 +386,33 @@ func (srv *CertifierServer) GetPolicyDecision(ctx context.Context, getPDReq *cer
 		return nil, err
 	}
 
-	decision, err := srv.getPolicyDecisionFromTokenMany(ctx, user.Tokens, getPDReq)
+	policyList, err := srv.store.GetPolicy(getPDReq.Token)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch token policy from database: %v", err)
+	}
+	now := clock.Now()
+	if isTokenExpired(policyList, now) {
+		return nil, status.Errorf(codes.Unauthenticated, "token has expired")
+	}
+	srv.recordTokenUse(getPDReq.Token, getPDReq.SourceIp, now)
+
+	ownerTokens, err := srv.getOwnerTokens(user.Tokens, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch user %s tokens from database: %v", username, err)
+	}
+	decision, err := srv.getPolicyDecisionFromTokenMany(ctx, ownerTokens, getPDReq)
 	if err != nil {
 		return nil, err
 	}
+	if !policyList.Scoped || decision.Effect != certprotos.Effect_ALLOW {
+		return decision, nil
+	}
 
+	// Scoped token has to allow the request on its own as well
+	effect, err := srv.getPolicyDecisionFromToken(ctx, getPDReq.Token, getPDReq.Request)
+	if err != nil || effect != certprotos.Effect_ALLOW {
+		return &certprotos.GetPolicyDecisionResponse{Effect: certprotos.Effect_DENY}, nil
+	}
 	return decision, nil
 }
 
@@ -444,12 +510,25 @@ func (srv *CertifierServer) ListUserTokens(ctx context.Context, req *certprotos.
 	}
 	// Add empty policy list if nil for marshaling purposes
 	if policies == nil {
-		policies = new(certprotos.ListUserTokensResponse)
+		return new(certprotos.ListUserTokensResponse), nil
+	}
+	uses, err := srv.store.GetTokenUses(user.Tokens.Tokens)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting token uses: %v", err)
+	}
+	for _, policyList := range policies.PolicyLists {
+		if use, ok := uses[policyList.Token]; ok {
+			policyList.LastUsedAt = use.LastUsedAt
+			policyList.LastUsedIp = use.LastUsedIp
+		}
 	}
 	return policies, nil
 }
 
 func (srv *CertifierServer) AddUserToken(ctx context.Context, req *certprotos.AddUserTokenRequest) (*certprotos.AddUserTokenResponse, error) {
+	if req.ExpiresAt != nil && !req.ExpiresAt.AsTime().After(clock.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "token expiration time has to be in the future")
+	}
 	user, err := srv.store.GetUser(req.Username)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error getting user for adding token: %v", err)
@@ -475,8 +554,12 @@ func (srv *CertifierServer) AddUserToken(ctx context.Context, req *certprotos.Ad
 	}
 
 	policy := &certprotos.PolicyList{
-		Token:    token,
-		Policies: req.Policies,
+		Token:     token,
+		Policies:  req.Policies,
+		Label:     req.Label,
+		CreatedAt: timestamppb.New(clock.Now()),
+		ExpiresAt: req.ExpiresAt,
+		Scoped:    req.Scoped,
 	}
 	err = srv.store.PutPolicy(token, policy)
 	if err != nil {
@@ -723,13 +806,17 @@ func (srv *CertifierServer) getPolicyDecisionFromToken(ctx context.Context, toke
 	if err != nil {
 		return certprotos.Effect_DENY, status.Errorf(codes.Internal, "failed to get policyList from db %v", err)
 	}
+	return getPolicyDecisionFromPolicyList(ctx, policyList, req), nil
+}
+
+func getPolicyDecisionFromPolicyList(ctx context.Context, policyList *certprotos.PolicyList, req *certprotos.Request) certprotos.Effect {
 	effect := certprotos.Effect_UNKNOWN
 
 	// Networks are registered with tenants, hence any tenant scoped policies
 	// have additional policies for their networks.
 	tenantNetworkResource, err := getTenantPolicyNetworkResourceMany(ctx, policyList)
 	if err != nil {
-		return effect, nil
+		return effect
 	}
 	policyList.Policies = append(policyList.Policies, tenantNetworkResource...)
 
@@ -749,11 +836,11 @@ func (srv *CertifierServer) getPolicyDecisionFromToken(ctx context.Context, toke
 		// that the policy applies to that resource), thus both action and
 		// resource effect need deny in order for the final effect to be DENY.
 		if actionEffect == certprotos.Effect_DENY && resourceEffect == certprotos.Effect_DENY {
-			return certprotos.Effect_DENY, nil
+			return certprotos.Effect_DENY
 		}
 	}
 
-	return effect, nil
+	return effect
 }
 
 // getTenantPolicyNetworkResourceMany builds a set of network policies for each tenant policy
@@ -883,6 +970,60 @@ func getResourceAuthorization(req *certprotos.Request, policy *certprotos.Policy
 	return finalEffect
 }
 
+// GetPolicyDecisionForPolicies makes a policy decision for policies which are not
+// stored in certifier, e.g. mapped from groups of an externally authenticated user.
+// Same as for tokens, requests not addressed by any policy are denied.
+func (srv *CertifierServer) GetPolicyDecisionForPolicies(ctx context.Context, req *certprotos.GetPolicyDecisionForPoliciesRequest) (*certprotos.GetPolicyDecisionResponse, error) {
+	if req.Request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request must be provided")
+	}
+	policyList := &certprotos.PolicyList{Policies: req.Policies}
+	effect := getPolicyDecisionFromPolicyList(ctx, policyList, req.Request)
+	if effect != certprotos.Effect_ALLOW {
+		effect = certprotos.Effect_DENY
+	}
+	return &certprotos.GetPolicyDecisionResponse{Effect: effect}, nil
+}
+
+// getOwnerTokens returns tokens which define permissions of the user,
+// i.e. not scoped and not expired ones.
+func (srv *CertifierServer) getOwnerTokens(tokens *certprotos.TokenList, now time.Time) (*certprotos.TokenList, error) {
+	ret := &certprotos.TokenList{}
+	for _, token := range tokens.Tokens {
+		policyList, err := srv.store.GetPolicy(token)
+		if err != nil {
+			return nil, err
+		}
+		if policyList.Scoped || isTokenExpired(policyList, now) {
+			continue
+		}
+		ret.Tokens = append(ret.Tokens, token)
+	}
+	return ret, nil
+}
+
+// recordTokenUse stores time and source ip of the token authentication,
+// failures are only logged as they should not prevent access.
+func (srv *CertifierServer) recordTokenUse(token string, sourceIp string, now time.Time) {
+	uses, err := srv.store.GetTokenUses([]string{token})
+	if err != nil {
+		glog.Errorf("Failed to get token use: %v", err)
+		return
+	}
+	if use, ok := uses[token]; ok && use.LastUsedIp == sourceIp && now.Sub(use.LastUsedAt.AsTime()) < TokenUseRecordInterval {
+		return
+	}
+	use := &certprotos.TokenUse{LastUsedAt: timestamppb.New(now), LastUsedIp: sourceIp}
+	err = srv.store.PutTokenUse(token, use)
+	if err != nil && err != merrors.ErrNotFound {
+		glog.Errorf("Failed to record token use: %v", err)
+	}
+}
+
+func isTokenExpired(policyList *certprotos.PolicyList, now time.Time) bool {
+	return policyList.ExpiresAt != nil && !now.Before(policyList.ExpiresAt.AsTime())
+}
+
 func isTokenWithUser(token string, tokenList *certprotos.TokenList) error {
 	flag := false
 	for _, t := range tokenList.Tokens {
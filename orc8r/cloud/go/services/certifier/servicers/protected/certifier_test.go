@@ -23,10 +23,16 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	protected_servicers "magma/orc8r/cloud/go/services/certifier/servicers/protected"
 	"magma/orc8r/cloud/go/services/certifier/storage"
+	"magma/orc8r/cloud/go/services/certifier/test_utils"
 	"magma/orc8r/cloud/go/sqorc"
 	"magma/orc8r/lib/go/protos"
 	certifierTestUtils "magma/orc8r/lib/go/security/csr"
@@ -132,3 +138,166 @@ func testCertifierImpl(t *testing.T, store storage.CertifierStorage) {
 	assert.NoError(t, err)
 	assert.Equal(t, cert.Subject.CommonName, *csrMsg.Id.ToCommonName())
 }
+
+func TestUserTokens(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1e9, 0).UTC()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	caCert, caKey, err := certifierTestUtils.CreateSignedCertAndPrivKey(time.Hour * 24 * 10)
+	assert.NoError(t, err)
+	caMap := map[protos.CertType]*protected_servicers.CAInfo{
+		protos.CertType_DEFAULT: {caCert, caKey},
+	}
+	store := test_utils.GetCertifierBlobstore(t)
+	srv, err := protected_servicers.NewCertifierServer(store, caMap)
+	assert.NoError(t, err)
+
+	ownerToken := test_utils.CreateTestUser(t, store, test_utils.TestUsername, test_utils.TestPassword, []*certprotos.Policy{
+		networkPolicy(certprotos.Action_READ, "n1", "n2"),
+	})
+	_, err = srv.AddUserToken(ctx, &certprotos.AddUserTokenRequest{
+		Username: test_utils.TestUsername,
+		Policies: []*certprotos.Policy{networkPolicy(certprotos.Action_WRITE, "n1")},
+		Label:    "scoped",
+		Scoped:   true,
+	})
+	assert.NoError(t, err)
+	_, err = srv.AddUserToken(ctx, &certprotos.AddUserTokenRequest{
+		Username:  test_utils.TestUsername,
+		Policies:  []*certprotos.Policy{networkPolicy(certprotos.Action_READ, "n3")},
+		Label:     "expiring",
+		ExpiresAt: timestamppb.New(now.Add(time.Hour)),
+	})
+	assert.NoError(t, err)
+	_, err = srv.AddUserToken(ctx, &certprotos.AddUserTokenRequest{
+		Username:  test_utils.TestUsername,
+		ExpiresAt: timestamppb.New(now.Add(-time.Hour)),
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	tokens := getTokensByLabel(t, srv)
+	assert.Len(t, tokens, 3)
+	scoped := tokens["scoped"]
+	assert.True(t, scoped.Scoped)
+	assert.Equal(t, now, scoped.CreatedAt.AsTime())
+	assert.Nil(t, scoped.ExpiresAt)
+	expiring := tokens["expiring"]
+	assert.Equal(t, now.Add(time.Hour), expiring.ExpiresAt.AsTime())
+
+	testCases := []struct {
+		name     string
+		token    string
+		action   certprotos.Action
+		network  string
+		expected certprotos.Effect
+	}{
+		{"owner token", ownerToken, certprotos.Action_READ, "n2", certprotos.Effect_ALLOW},
+		{"owner token uses other unscoped tokens", ownerToken, certprotos.Action_READ, "n3", certprotos.Effect_ALLOW},
+		{"owner token ignores scoped tokens", ownerToken, certprotos.Action_WRITE, "n1", certprotos.Effect_DENY},
+		{"scoped token within own policies", scoped.Token, certprotos.Action_READ, "n1", certprotos.Effect_ALLOW},
+		{"scoped token outside own policies", scoped.Token, certprotos.Action_READ, "n2", certprotos.Effect_DENY},
+		{"scoped token beyond owner permissions", scoped.Token, certprotos.Action_WRITE, "n1", certprotos.Effect_DENY},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := srv.GetPolicyDecision(ctx, policyDecisionRequest(tc.token, tc.action, tc.network))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, res.Effect)
+		})
+	}
+
+	scoped = getTokensByLabel(t, srv)["scoped"]
+	assert.Equal(t, now, scoped.LastUsedAt.AsTime())
+	assert.Equal(t, "127.0.0.1", scoped.LastUsedIp)
+
+	clock.SetAndFreezeClock(t, now.Add(time.Hour))
+	_, err = srv.GetPolicyDecision(ctx, policyDecisionRequest(expiring.Token, certprotos.Action_READ, "n3"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	res, err := srv.GetPolicyDecision(ctx, policyDecisionRequest(ownerToken, certprotos.Action_READ, "n3"))
+	assert.NoError(t, err)
+	assert.Equal(t, certprotos.Effect_DENY, res.Effect)
+
+	count, err := srv.CollectExpiredTokensImpl(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	tokens = getTokensByLabel(t, srv)
+	assert.Len(t, tokens, 2)
+	assert.NotContains(t, tokens, "expiring")
+	_, err = store.GetPolicy(expiring.Token)
+	assert.Error(t, err)
+
+	// Recording the use doesn't rewrite the token's policies
+	policyList, err := store.GetPolicy(scoped.Token)
+	assert.NoError(t, err)
+	assert.Nil(t, policyList.LastUsedAt)
+	assert.Empty(t, policyList.LastUsedIp)
+}
+
+func TestGetPolicyDecisionForPolicies(t *testing.T) {
+	ctx := context.Background()
+	caCert, caKey, err := certifierTestUtils.CreateSignedCertAndPrivKey(time.Hour * 24 * 10)
+	assert.NoError(t, err)
+	caMap := map[protos.CertType]*protected_servicers.CAInfo{
+		protos.CertType_DEFAULT: {caCert, caKey},
+	}
+	srv, err := protected_servicers.NewCertifierServer(test_utils.GetCertifierBlobstore(t), caMap)
+	assert.NoError(t, err)
+
+	policies := []*certprotos.Policy{networkPolicy(certprotos.Action_READ, "n1")}
+	testCases := []struct {
+		name     string
+		policies []*certprotos.Policy
+		action   certprotos.Action
+		network  string
+		expected certprotos.Effect
+	}{
+		{"allowed", policies, certprotos.Action_READ, "n1", certprotos.Effect_ALLOW},
+		{"action not allowed", policies, certprotos.Action_WRITE, "n1", certprotos.Effect_DENY},
+		{"resource not allowed", policies, certprotos.Action_READ, "n2", certprotos.Effect_DENY},
+		{"no policies", nil, certprotos.Action_READ, "n1", certprotos.Effect_DENY},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := srv.GetPolicyDecisionForPolicies(ctx, &certprotos.GetPolicyDecisionForPoliciesRequest{
+				Policies: tc.policies,
+				Request:  policyDecisionRequest("", tc.action, tc.network).Request,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, res.Effect)
+		})
+	}
+}
+
+func networkPolicy(action certprotos.Action, networks ...string) *certprotos.Policy {
+	return &certprotos.Policy{
+		Effect:   certprotos.Effect_ALLOW,
+		Action:   action,
+		Resource: &certprotos.Policy_Network{Network: &certprotos.NetworkResource{Networks: networks}},
+	}
+}
+
+func policyDecisionRequest(token string, action certprotos.Action, network string) *certprotos.GetPolicyDecisionRequest {
+	return &certprotos.GetPolicyDecisionRequest{
+		Username: test_utils.TestUsername,
+		Token:    token,
+		SourceIp: "127.0.0.1",
+		Request: &certprotos.Request{
+			Action:     action,
+			ResourceId: &certprotos.Request_NetworkId{NetworkId: network},
+		},
+	}
+}
+
+func getTokensByLabel(t *testing.T, srv *protected_servicers.CertifierServer) map[string]*certprotos.PolicyList {
+	res, err := srv.ListUserTokens(context.Background(), &certprotos.ListUserTokensRequest{
+		User: &certprotos.User{Username: test_utils.TestUsername},
+	})
+	assert.NoError(t, err)
+	tokens := map[string]*certprotos.PolicyList{}
+	for _, pl := range res.PolicyLists {
+		tokens[pl.Label] = pl
+	}
+	return tokens
+}
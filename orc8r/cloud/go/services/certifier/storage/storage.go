@@ -59,6 +59,11 @@ type UserStorage interface {
 	// DeleteUser deletes a user based on its username
 	DeleteUser(username string) error
 
+	// DeleteUserTokens removes the tokens from the user, along with their
+	// policies and use records. The user is re-read within the transaction,
+	// so tokens added concurrently are kept.
+	DeleteUserTokens(username string, tokens []string) error
+
 	// GetUser gets a user based on its username
 	GetUser(username string) (*protos.User, error)
 }
@@ -73,4 +78,13 @@ type PolicyStorage interface {
 
 	// DeletePolicy deletes the token's policy form the policy db
 	DeletePolicy(token string) error
+
+	// GetTokenUses maps the passed tokens to their last use, tokens which
+	// were never used are omitted
+	GetTokenUses(tokens []string) (map[string]*protos.TokenUse, error)
+
+	// PutTokenUse records the last use of the token. Returns ErrNotFound
+	// from magma/orc8r/lib/go/merrors when the token's policy doesn't exist,
+	// so that deleted tokens are never written back.
+	PutTokenUse(token string, use *protos.TokenUse) error
 }
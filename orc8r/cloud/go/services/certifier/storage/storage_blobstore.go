@@ -308,11 +308,112 @@ func (c *certifierBlobstore) DeletePolicy(token string) error {
 	}
 	defer store.Rollback()
 
-	tk := storage.TK{Type: constants.PolicyType, Key: token}
-	err = store.Delete(placeholderNetworkID, storage.TKs{tk})
+	tks := storage.TKs{
+		{Type: constants.PolicyType, Key: token},
+		{Type: constants.TokenUseType, Key: token},
+	}
+	err = store.Delete(placeholderNetworkID, tks)
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to delete policy: %s", err)
 	}
 
 	return store.Commit()
 }
+
+func (c *certifierBlobstore) DeleteUserTokens(username string, tokens []string) error {
+	store, err := c.factory.StartTransaction(&storage.TxOptions{Isolation: storage.LevelSerializable})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to start transaction: %s", err)
+	}
+	defer store.Rollback()
+
+	userBlob, err := store.Get(placeholderNetworkID, storage.TK{Type: constants.UserType, Key: username})
+	if err != nil {
+		return err
+	}
+	user, err := protos.UserFromBlob(userBlob)
+	if err != nil {
+		return err
+	}
+
+	deleted := map[string]bool{}
+	tks := storage.TKs{}
+	for _, token := range tokens {
+		deleted[token] = true
+		tks = append(tks,
+			storage.TK{Type: constants.PolicyType, Key: token},
+			storage.TK{Type: constants.TokenUseType, Key: token},
+		)
+	}
+	var remaining []string
+	for _, token := range user.GetTokens().GetTokens() {
+		if !deleted[token] {
+			remaining = append(remaining, token)
+		}
+	}
+	user.Tokens = &protos.TokenList{Tokens: remaining}
+
+	userBlob, err = user.UserToBlob(username)
+	if err != nil {
+		return err
+	}
+	err = store.Write(placeholderNetworkID, blobstore.Blobs{userBlob})
+	if err != nil {
+		return fmt.Errorf("failed to update tokens for user %s: %w", username, err)
+	}
+	err = store.Delete(placeholderNetworkID, tks)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to delete policies: %s", err)
+	}
+
+	return store.Commit()
+}
+
+func (c *certifierBlobstore) GetTokenUses(tokens []string) (map[string]*protos.TokenUse, error) {
+	store, err := c.factory.StartTransaction(&storage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to start transaction: %s", err)
+	}
+	defer store.Rollback()
+
+	blobs, err := store.GetMany(placeholderNetworkID, storage.MakeTKs(constants.TokenUseType, tokens))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token uses: %w", err)
+	}
+	uses := make(map[string]*protos.TokenUse)
+	for _, blob := range blobs {
+		use, err := protos.TokenUseFromBlob(blob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal token use: %w", err)
+		}
+		uses[blob.Key] = use
+	}
+
+	return uses, store.Commit()
+}
+
+func (c *certifierBlobstore) PutTokenUse(token string, use *protos.TokenUse) error {
+	store, err := c.factory.StartTransaction(&storage.TxOptions{Isolation: storage.LevelSerializable})
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to start transaction: %s", err)
+	}
+	defer store.Rollback()
+
+	// Token deletion removes the policy, don't record the use of a token
+	// which has been deleted in the meantime
+	_, err = store.Get(placeholderNetworkID, storage.TK{Type: constants.PolicyType, Key: token})
+	if err != nil {
+		return err
+	}
+
+	useBlob, err := use.TokenUseToBlob(token)
+	if err != nil {
+		return err
+	}
+	err = store.Write(placeholderNetworkID, blobstore.Blobs{useBlob})
+	if err != nil {
+		return fmt.Errorf("failed to record use of token %s: %w", token, err)
+	}
+
+	return store.Commit()
+}
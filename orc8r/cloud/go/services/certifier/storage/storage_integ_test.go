@@ -36,6 +36,7 @@ func TestCertifierStorageBlobstore_Integation(t *testing.T) {
 	assert.NoError(t, err)
 	store := storage.NewCertifierBlobstore(fact)
 	testCertifierStorageImpl(t, store)
+	testCertifierTokenStorageImpl(t, store)
 }
 
 func testCertifierStorageImpl(t *testing.T, store storage.CertifierStorage) {
@@ -125,3 +126,61 @@ func testCertifierStorageImpl(t *testing.T, store storage.CertifierStorage) {
 	assert.True(t, proto.Equal(infos[sn1], info1))
 	assert.True(t, proto.Equal(infos[sn2], info2))
 }
+
+func testCertifierTokenStorageImpl(t *testing.T, store storage.CertifierStorage) {
+	username := "user"
+	err := store.PutUser(username, &protos.User{
+		Username: username,
+		Tokens:   &protos.TokenList{Tokens: []string{"token0", "token1"}},
+	})
+	assert.NoError(t, err)
+	for _, token := range []string{"token0", "token1", "token2"} {
+		err = store.PutPolicy(token, &protos.PolicyList{Token: token})
+		assert.NoError(t, err)
+	}
+
+	// Record uses, only tokens which were used are returned
+	use := &protos.TokenUse{LastUsedAt: &timestamp.Timestamp{Seconds: 0xdead}, LastUsedIp: "127.0.0.1"}
+	err = store.PutTokenUse("token0", use)
+	assert.NoError(t, err)
+	uses, err := store.GetTokenUses([]string{"token0", "token1"})
+	assert.NoError(t, err)
+	assert.Len(t, uses, 1)
+	assert.True(t, proto.Equal(uses["token0"], use))
+	policy, err := store.GetPolicy("token0")
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&protos.PolicyList{Token: "token0"}, policy))
+
+	// Token added after the user was read by the caller is kept
+	user, err := store.GetUser(username)
+	assert.NoError(t, err)
+	user.Tokens.Tokens = append(user.Tokens.Tokens, "token2")
+	err = store.PutUser(username, user)
+	assert.NoError(t, err)
+	err = store.DeleteUserTokens(username, []string{"token0"})
+	assert.NoError(t, err)
+	user, err = store.GetUser(username)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"token1", "token2"}, user.Tokens.Tokens)
+	_, err = store.GetPolicy("token0")
+	assert.EqualError(t, err, merrors.ErrNotFound.Error())
+	uses, err = store.GetTokenUses([]string{"token0"})
+	assert.NoError(t, err)
+	assert.Empty(t, uses)
+
+	// Use of a deleted token isn't written back
+	err = store.PutTokenUse("token0", use)
+	assert.EqualError(t, err, merrors.ErrNotFound.Error())
+	uses, err = store.GetTokenUses([]string{"token0"})
+	assert.NoError(t, err)
+	assert.Empty(t, uses)
+
+	// Deleting the policy removes the token's use as well
+	err = store.PutTokenUse("token1", use)
+	assert.NoError(t, err)
+	err = store.DeletePolicy("token1")
+	assert.NoError(t, err)
+	uses, err = store.GetTokenUses([]string{"token1"})
+	assert.NoError(t, err)
+	assert.Empty(t, uses)
+}
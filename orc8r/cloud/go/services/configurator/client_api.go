@@ -20,6 +20,8 @@ import (
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator/protos"
@@ -30,6 +32,10 @@ import (
 	"magma/orc8r/lib/go/registry"
 )
 
+// ErrVersionMismatch is returned when an update with an expected version
+// finds the entity deleted or at a different version.
+var ErrVersionMismatch = storage.ErrVersionMismatch
+
 // ListNetworkIDs loads a list of all networkIDs registered
 func ListNetworkIDs(ctx context.Context) ([]string, error) {
 	client, err := getNBConfiguratorClient()
@@ -285,6 +291,9 @@ func WriteEntities(ctx context.Context, networkID string, writes []EntityWriteOp
 	}
 
 	_, err = client.WriteEntities(ctx, req)
+	if status.Code(err) == codes.Aborted {
+		return ErrVersionMismatch
+	}
 	if err != nil {
 		return err
 	}
@@ -359,6 +368,9 @@ func UpdateEntities(ctx context.Context, networkID string, updates []EntityUpdat
 		req.Updates = append(req.Updates, upProto)
 	}
 	res, err := client.UpdateEntities(ctx, req)
+	if status.Code(err) == codes.Aborted {
+		return nil, ErrVersionMismatch
+	}
 	if err != nil {
 		return nil, err
 	}
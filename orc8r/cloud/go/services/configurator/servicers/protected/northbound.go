@@ -177,6 +177,10 @@ func (srv *nbConfiguratorServicer) WriteEntities(context context.Context, req *p
 			ret.CreatedEntities = append(ret.CreatedEntities, createdEnt)
 		case *protos.WriteEntityRequest_Update:
 			updatedEnt, err := store.UpdateEntity(req.NetworkID, op.Update)
+			if err == storage.ErrVersionMismatch {
+				storage.RollbackLogOnError(store)
+				return emptyRes, status.Error(codes.Aborted, err.Error())
+			}
 			if err != nil {
 				storage.RollbackLogOnError(store)
 				return emptyRes, status.Error(codes.Internal, err.Error())
@@ -219,6 +223,10 @@ func (srv *nbConfiguratorServicer) UpdateEntities(context context.Context, req *
 	updatedEntities := map[string]*storage.NetworkEntity{}
 	for _, update := range req.Updates {
 		updatedEntity, err := store.UpdateEntity(req.NetworkID, update)
+		if err == storage.ErrVersionMismatch {
+			storage.RollbackLogOnError(store)
+			return emptyRes, status.Error(codes.Aborted, err.Error())
+		}
 		if err != nil {
 			storage.RollbackLogOnError(store)
 			return emptyRes, err
@@ -526,6 +526,9 @@ func (store *sqlConfiguratorStorage) CreateEntity(networkID string, entity *Netw
 func (store *sqlConfiguratorStorage) UpdateEntity(networkID string, update *EntityUpdateCriteria) (*NetworkEntity, error) {
 	emptyRet := &NetworkEntity{Type: update.Type, Key: update.Key}
 	entToUpdate, err := store.loadEntToUpdate(networkID, update)
+	if err == ErrVersionMismatch {
+		return emptyRet, err
+	}
 	if err != nil && !update.DeleteEntity {
 		return emptyRet, fmt.Errorf("failed to load entity being updated: %w", err)
 	}
@@ -535,17 +538,22 @@ func (store *sqlConfiguratorStorage) UpdateEntity(networkID string, update *Enti
 
 	if update.DeleteEntity {
 		// Cascading FK relations in the schema will handle the other tables
-		_, err := store.builder.Delete(entityTable).
+		deleteBuilder := store.builder.Delete(entityTable).
 			Where(sq.And{
 				sq.Eq{entNidCol: networkID},
 				sq.Eq{entTypeCol: update.Type},
 				sq.Eq{entKeyCol: update.Key},
-			}).
-			RunWith(store.tx).
-			Exec()
+			})
+		if update.ExpectedVersion != nil {
+			deleteBuilder = deleteBuilder.Where(sq.Eq{entVerCol: update.ExpectedVersion.Value})
+		}
+		res, err := deleteBuilder.RunWith(store.tx).Exec()
 		if err != nil {
 			return emptyRet, fmt.Errorf("failed to delete entity (%s, %s): %w", update.Type, update.Key, err)
 		}
+		if err := checkExpectedVersion(res, update); err != nil {
+			return emptyRet, err
+		}
 
 		// Deleting a node could partition its graph
 		err = store.fixGraph(networkID, entToUpdate.GraphID, entToUpdate)
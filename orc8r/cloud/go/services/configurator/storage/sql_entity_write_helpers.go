@@ -188,6 +188,9 @@ func (store *sqlConfiguratorStorage) loadEntToUpdate(networkID string, update *E
 	if err != nil {
 		return nil, fmt.Errorf("failed to load entity to update: %w", err)
 	}
+	if len(loaded) != 1 && update.ExpectedVersion != nil {
+		return nil, ErrVersionMismatch
+	}
 	// don't error on deleting an entity which doesn't exist
 	if len(loaded) != 1 && !update.DeleteEntity {
 		return nil, fmt.Errorf("expected to load 1 ent for update, got %d", len(loaded))
@@ -206,12 +209,15 @@ func (store *sqlConfiguratorStorage) loadEntToUpdate(networkID string, update *E
 
 // entOut is an output parameter
 func (store *sqlConfiguratorStorage) processEntityFieldsUpdate(pk string, update *EntityUpdateCriteria, entOut *NetworkEntity) error {
-	_, err := store.getEntityUpdateQueryBuilder(pk, update).
+	res, err := store.getEntityUpdateQueryBuilder(pk, update).
 		RunWith(store.tx).
 		Exec()
 	if err != nil {
 		return fmt.Errorf("failed to update entity fields: %w", err)
 	}
+	if err := checkExpectedVersion(res, update); err != nil {
+		return err
+	}
 
 	if update.NewName != nil {
 		entOut.Name = (*update.NewName).Value
@@ -295,6 +301,9 @@ func (store *sqlConfiguratorStorage) getEntityUpdateQueryBuilder(pk string, upda
 	// UPDATE cfg_entities SET (name, description, physical_id, config, version) = ($1, $2, $3, $4, cfg_entities.version + 1)
 	// WHERE pk = $5
 	updateBuilder := store.builder.Update(entityTable).Where(sq.Eq{entPkCol: pk})
+	if update.ExpectedVersion != nil {
+		updateBuilder = updateBuilder.Where(sq.Eq{entVerCol: update.ExpectedVersion.Value})
+	}
 	if update.NewName != nil {
 		updateBuilder = updateBuilder.Set(entNameCol, update.NewName.Value)
 	}
@@ -311,6 +320,23 @@ func (store *sqlConfiguratorStorage) getEntityUpdateQueryBuilder(pk string, upda
 	return updateBuilder
 }
 
+// checkExpectedVersion returns ErrVersionMismatch when the update of an entity
+// at an expected version didn't match any row, i.e. the version was changed
+// since the entity was loaded.
+func checkExpectedVersion(res sql.Result, update *EntityUpdateCriteria) error {
+	if update.ExpectedVersion == nil {
+		return nil
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get updated rows: %w", err)
+	}
+	if rows != 1 {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
 // entToUpdateOut is an output parameter
 func (store *sqlConfiguratorStorage) deleteEdges(networkID string, edgesToDelete []*EntityID, entToUpdateOut *NetworkEntity) error {
 	if funk.IsEmpty(edgesToDelete) {
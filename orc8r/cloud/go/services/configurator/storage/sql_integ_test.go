@@ -41,6 +41,69 @@ func (g *mockIDGenerator) New() string {
 	return fmt.Sprintf("%d", g.count)
 }
 
+func TestSqlConfiguratorStorage_ExpectedVersion(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	assert.NoError(t, err)
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder(), integTestMaxLoadSize)
+	assert.NoError(t, factory.InitializeServiceStorage())
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(&storage.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", &storage.NetworkEntity{Type: "foo", Key: "bar", Config: []byte("v0")})
+	assert.NoError(t, err)
+
+	// Update at the expected version bumps the version
+	updated, err := store.UpdateEntity("n1", &storage.EntityUpdateCriteria{
+		Type:            "foo",
+		Key:             "bar",
+		NewConfig:       &wrappers.BytesValue{Value: []byte("v1")},
+		ExpectedVersion: &wrappers.UInt64Value{Value: 0},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), updated.Version)
+
+	// Stale version is rejected and nothing is written
+	_, err = store.UpdateEntity("n1", &storage.EntityUpdateCriteria{
+		Type:            "foo",
+		Key:             "bar",
+		NewConfig:       &wrappers.BytesValue{Value: []byte("stale")},
+		ExpectedVersion: &wrappers.UInt64Value{Value: 0},
+	})
+	assert.Equal(t, storage.ErrVersionMismatch, err)
+	loaded, err := store.LoadEntities("n1", &storage.EntityLoadFilter{}, &storage.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Len(t, loaded.Entities, 1)
+	assert.Equal(t, []byte("v1"), loaded.Entities[0].Config)
+
+	// Deletion at a stale version is rejected as well
+	_, err = store.UpdateEntity("n1", &storage.EntityUpdateCriteria{
+		Type:            "foo",
+		Key:             "bar",
+		DeleteEntity:    true,
+		ExpectedVersion: &wrappers.UInt64Value{Value: 0},
+	})
+	assert.Equal(t, storage.ErrVersionMismatch, err)
+	_, err = store.UpdateEntity("n1", &storage.EntityUpdateCriteria{
+		Type:            "foo",
+		Key:             "bar",
+		DeleteEntity:    true,
+		ExpectedVersion: &wrappers.UInt64Value{Value: 1},
+	})
+	assert.NoError(t, err)
+
+	// Entity which doesn't exist anymore doesn't match any version
+	_, err = store.UpdateEntity("n1", &storage.EntityUpdateCriteria{
+		Type:            "foo",
+		Key:             "bar",
+		NewConfig:       &wrappers.BytesValue{Value: []byte("v2")},
+		ExpectedVersion: &wrappers.UInt64Value{Value: 1},
+	})
+	assert.Equal(t, storage.ErrVersionMismatch, err)
+	assert.NoError(t, store.Commit())
+}
+
 func TestSqlConfiguratorStorage_Integration(t *testing.T) {
 	// sqlite's default behavior is to disable foreign keys: https://www.sqlite.org/draft/pragma.html#pragma_foreign_keys
 	// thankfully the sqlite3 driver supports the appropriate pragma: https://github.com/mattn/go-sqlite3/issues/255
@@ -15,6 +15,7 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -24,6 +25,10 @@ import (
 	"magma/orc8r/cloud/go/storage"
 )
 
+// ErrVersionMismatch is returned when an update expects a different version
+// of the entity than the stored one.
+var ErrVersionMismatch = errors.New("entity version does not match the expected version")
+
 // ConfiguratorStorageFactory creates ConfiguratorStorage implementations bound
 // to transactions.
 type ConfiguratorStorageFactory interface {
@@ -90,6 +95,8 @@ type ConfiguratorStorage interface {
 	// The updates to the specified entity will be returned as a NetworkEntity
 	// object. Apart from identity fields, only fields which were updated will
 	// be filled out, with system-generated IDs included.
+	// If the update has an expected version and the entity doesn't exist or
+	// is at a different version, ErrVersionMismatch is returned.
 	UpdateEntity(networkID string, update *EntityUpdateCriteria) (*NetworkEntity, error)
 
 	// =======================================================================
@@ -19,9 +19,9 @@
 package storage
 
 import (
-	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -137,7 +137,7 @@ type NetworkLoadFilter struct {
 	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 	// If TypeFilter is provided, the query will return all entities matching
 	// the given type.
-	TypeFilter *wrappers.StringValue `protobuf:"bytes,2,opt,name=type_filter,json=typeFilter,proto3" json:"type_filter,omitempty"`
+	TypeFilter *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=type_filter,json=typeFilter,proto3" json:"type_filter,omitempty"`
 }
 
 func (x *NetworkLoadFilter) Reset() {
@@ -179,7 +179,7 @@ func (x *NetworkLoadFilter) GetIds() []string {
 	return nil
 }
 
-func (x *NetworkLoadFilter) GetTypeFilter() *wrappers.StringValue {
+func (x *NetworkLoadFilter) GetTypeFilter() *wrapperspb.StringValue {
 	if x != nil {
 		return x.TypeFilter
 	}
@@ -311,9 +311,9 @@ type NetworkUpdateCriteria struct {
 	// Set NewName, NewDescription, or NewType to nil to indicate that no update is
 	// desired. To clear the value of name or description, set these fields to
 	// a wrapper to an empty string.
-	NewName        *wrappers.StringValue `protobuf:"bytes,20,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
-	NewDescription *wrappers.StringValue `protobuf:"bytes,21,opt,name=new_description,json=newDescription,proto3" json:"new_description,omitempty"`
-	NewType        *wrappers.StringValue `protobuf:"bytes,22,opt,name=new_type,json=newType,proto3" json:"new_type,omitempty"`
+	NewName        *wrapperspb.StringValue `protobuf:"bytes,20,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	NewDescription *wrapperspb.StringValue `protobuf:"bytes,21,opt,name=new_description,json=newDescription,proto3" json:"new_description,omitempty"`
+	NewType        *wrapperspb.StringValue `protobuf:"bytes,22,opt,name=new_type,json=newType,proto3" json:"new_type,omitempty"`
 	// New config values to add or existing ones to update
 	ConfigsToAddOrUpdate map[string][]byte `protobuf:"bytes,30,rep,name=configs_to_add_or_update,json=configsToAddOrUpdate,proto3" json:"configs_to_add_or_update,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Config values to delete
@@ -366,21 +366,21 @@ func (x *NetworkUpdateCriteria) GetDeleteNetwork() bool {
 	return false
 }
 
-func (x *NetworkUpdateCriteria) GetNewName() *wrappers.StringValue {
+func (x *NetworkUpdateCriteria) GetNewName() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NewName
 	}
 	return nil
 }
 
-func (x *NetworkUpdateCriteria) GetNewDescription() *wrappers.StringValue {
+func (x *NetworkUpdateCriteria) GetNewDescription() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NewDescription
 	}
 	return nil
 }
 
-func (x *NetworkUpdateCriteria) GetNewType() *wrappers.StringValue {
+func (x *NetworkUpdateCriteria) GetNewType() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NewType
 	}
@@ -618,19 +618,19 @@ type EntityLoadFilter struct {
 
 	// If TypeFilter is provided, the query will return all entities matching
 	// the given type.
-	TypeFilter *wrappers.StringValue `protobuf:"bytes,1,opt,name=type_filter,json=typeFilter,proto3" json:"type_filter,omitempty"`
+	TypeFilter *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=type_filter,json=typeFilter,proto3" json:"type_filter,omitempty"`
 	// If KeyFilter is provided, the query will return all entities matching the
 	// given ID.
-	KeyFilter *wrappers.StringValue `protobuf:"bytes,2,opt,name=key_filter,json=keyFilter,proto3" json:"key_filter,omitempty"`
+	KeyFilter *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=key_filter,json=keyFilter,proto3" json:"key_filter,omitempty"`
 	// If IDs is provided, the query will return all entities matching the
 	// provided TKs. TypeFilter and KeyFilter are ignored if IDs is
 	// provided.
 	IDs []*EntityID `protobuf:"bytes,3,rep,name=IDs,proto3" json:"IDs,omitempty"`
 	// Internal-only
-	GraphID *wrappers.StringValue `protobuf:"bytes,4,opt,name=graphID,proto3" json:"graphID,omitempty"`
+	GraphID *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=graphID,proto3" json:"graphID,omitempty"`
 	// If PhysicalID is provided, the query will return all entities matching
 	// the provided ID. All other fields are ignored if this is set.
-	PhysicalID *wrappers.StringValue `protobuf:"bytes,5,opt,name=physicalID,proto3" json:"physicalID,omitempty"`
+	PhysicalID *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=physicalID,proto3" json:"physicalID,omitempty"`
 }
 
 func (x *EntityLoadFilter) Reset() {
@@ -665,14 +665,14 @@ func (*EntityLoadFilter) Descriptor() ([]byte, []int) {
 	return file_orc8r_cloud_go_services_configurator_storage_storage_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *EntityLoadFilter) GetTypeFilter() *wrappers.StringValue {
+func (x *EntityLoadFilter) GetTypeFilter() *wrapperspb.StringValue {
 	if x != nil {
 		return x.TypeFilter
 	}
 	return nil
 }
 
-func (x *EntityLoadFilter) GetKeyFilter() *wrappers.StringValue {
+func (x *EntityLoadFilter) GetKeyFilter() *wrapperspb.StringValue {
 	if x != nil {
 		return x.KeyFilter
 	}
@@ -686,14 +686,14 @@ func (x *EntityLoadFilter) GetIDs() []*EntityID {
 	return nil
 }
 
-func (x *EntityLoadFilter) GetGraphID() *wrappers.StringValue {
+func (x *EntityLoadFilter) GetGraphID() *wrapperspb.StringValue {
 	if x != nil {
 		return x.GraphID
 	}
 	return nil
 }
 
-func (x *EntityLoadFilter) GetPhysicalID() *wrappers.StringValue {
+func (x *EntityLoadFilter) GetPhysicalID() *wrapperspb.StringValue {
 	if x != nil {
 		return x.PhysicalID
 	}
@@ -962,17 +962,20 @@ type EntityUpdateCriteria struct {
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	// Set DeleteEntity to true to mark the entity for deletion
-	DeleteEntity   bool                  `protobuf:"varint,10,opt,name=delete_entity,json=deleteEntity,proto3" json:"delete_entity,omitempty"`
-	NewName        *wrappers.StringValue `protobuf:"bytes,20,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
-	NewDescription *wrappers.StringValue `protobuf:"bytes,21,opt,name=new_description,json=newDescription,proto3" json:"new_description,omitempty"`
-	NewPhysicalID  *wrappers.StringValue `protobuf:"bytes,22,opt,name=new_physicalID,json=newPhysicalID,proto3" json:"new_physicalID,omitempty"`
+	DeleteEntity   bool                    `protobuf:"varint,10,opt,name=delete_entity,json=deleteEntity,proto3" json:"delete_entity,omitempty"`
+	NewName        *wrapperspb.StringValue `protobuf:"bytes,20,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	NewDescription *wrapperspb.StringValue `protobuf:"bytes,21,opt,name=new_description,json=newDescription,proto3" json:"new_description,omitempty"`
+	NewPhysicalID  *wrapperspb.StringValue `protobuf:"bytes,22,opt,name=new_physicalID,json=newPhysicalID,proto3" json:"new_physicalID,omitempty"`
 	// A nil value here indicates no update.
-	NewConfig *wrappers.BytesValue `protobuf:"bytes,23,opt,name=new_config,json=newConfig,proto3" json:"new_config,omitempty"`
+	NewConfig *wrapperspb.BytesValue `protobuf:"bytes,23,opt,name=new_config,json=newConfig,proto3" json:"new_config,omitempty"`
 	// Wrap the repeated field in a message because a nil struct and a struct
 	// with an empty associations list mean different things.
 	AssociationsToSet    *EntityAssociationsToSet `protobuf:"bytes,30,opt,name=associations_to_set,json=associationsToSet,proto3" json:"associations_to_set,omitempty"`
 	AssociationsToAdd    []*EntityID              `protobuf:"bytes,31,rep,name=associations_to_add,json=associationsToAdd,proto3" json:"associations_to_add,omitempty"`
 	AssociationsToDelete []*EntityID              `protobuf:"bytes,32,rep,name=associations_to_delete,json=associationsToDelete,proto3" json:"associations_to_delete,omitempty"`
+	// When set, the update is only applied if the entity is still at this
+	// version, otherwise the whole write fails.
+	ExpectedVersion *wrapperspb.UInt64Value `protobuf:"bytes,40,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
 func (x *EntityUpdateCriteria) Reset() {
@@ -1028,28 +1031,28 @@ func (x *EntityUpdateCriteria) GetDeleteEntity() bool {
 	return false
 }
 
-func (x *EntityUpdateCriteria) GetNewName() *wrappers.StringValue {
+func (x *EntityUpdateCriteria) GetNewName() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NewName
 	}
 	return nil
 }
 
-func (x *EntityUpdateCriteria) GetNewDescription() *wrappers.StringValue {
+func (x *EntityUpdateCriteria) GetNewDescription() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NewDescription
 	}
 	return nil
 }
 
-func (x *EntityUpdateCriteria) GetNewPhysicalID() *wrappers.StringValue {
+func (x *EntityUpdateCriteria) GetNewPhysicalID() *wrapperspb.StringValue {
 	if x != nil {
 		return x.NewPhysicalID
 	}
 	return nil
 }
 
-func (x *EntityUpdateCriteria) GetNewConfig() *wrappers.BytesValue {
+func (x *EntityUpdateCriteria) GetNewConfig() *wrapperspb.BytesValue {
 	if x != nil {
 		return x.NewConfig
 	}
@@ -1077,6 +1080,13 @@ func (x *EntityUpdateCriteria) GetAssociationsToDelete() []*EntityID {
 	return nil
 }
 
+func (x *EntityUpdateCriteria) GetExpectedVersion() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return nil
+}
+
 type EntityAssociationsToSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1416,7 +1426,7 @@ var file_orc8r_cloud_go_services_configurator_storage_storage_proto_rawDesc = []
 	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x63,
 	0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x8b, 0x05, 0x0a, 0x14, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xd4, 0x05, 0x0a, 0x14, 0x45, 0x6e, 0x74, 0x69, 0x74,
 	0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x12,
 	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
 	0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -1457,42 +1467,46 @@ var file_orc8r_cloud_go_services_configurator_storage_storage_proto_rawDesc = []
 	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x73, 0x74,
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x14,
 	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x22, 0x75, 0x0a, 0x17, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41, 0x73,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x53, 0x65, 0x74, 0x12,
-	0x5a, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
-	0x74, 0x6f, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d,
-	0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x11, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x53, 0x65, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x0b,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x4b, 0x0a, 0x08, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
-	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74,
-	0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x75, 0x0a,
+	0x17, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x53, 0x65, 0x74, 0x12, 0x5a, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72,
+	0x63, 0x38, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x52, 0x11, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54,
+	0x6f, 0x53, 0x65, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x12, 0x4b, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
+	0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x4f, 0x0a, 0x0d, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
+	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x44, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05,
+	0x65, 0x64, 0x67, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45,
+	0x64, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x2a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x0c, 0x72, 0x6f, 0x6f,
-	0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x65, 0x64, 0x67,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61,
-	0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a,
-	0x09, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x64, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x02, 0x74, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f,
-	0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x49, 0x44, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x3e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63,
-	0x38, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44,
-	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x34, 0x5a, 0x32, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f,
-	0x6f, 0x72, 0x63, 0x38, 0x72, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x3e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2e, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x42,
+	0x34, 0x5a, 0x32, 0x6d, 0x61, 0x67, 0x6d, 0x61, 0x2f, 0x6f, 0x72, 0x63, 0x38, 0x72, 0x2f, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x67, 0x6f, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1527,8 +1541,9 @@ var file_orc8r_cloud_go_services_configurator_storage_storage_proto_goTypes = []
 	(*GraphEdge)(nil),               // 15: magma.orc8r.configurator.storage.GraphEdge
 	nil,                             // 16: magma.orc8r.configurator.storage.Network.ConfigsEntry
 	nil,                             // 17: magma.orc8r.configurator.storage.NetworkUpdateCriteria.ConfigsToAddOrUpdateEntry
-	(*wrappers.StringValue)(nil),    // 18: google.protobuf.StringValue
-	(*wrappers.BytesValue)(nil),     // 19: google.protobuf.BytesValue
+	(*wrapperspb.StringValue)(nil),  // 18: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),   // 19: google.protobuf.BytesValue
+	(*wrapperspb.UInt64Value)(nil),  // 20: google.protobuf.UInt64Value
 }
 var file_orc8r_cloud_go_services_configurator_storage_storage_proto_depIdxs = []int32{
 	16, // 0: magma.orc8r.configurator.storage.Network.configs:type_name -> magma.orc8r.configurator.storage.Network.ConfigsEntry
@@ -1554,17 +1569,18 @@ var file_orc8r_cloud_go_services_configurator_storage_storage_proto_depIdxs = []
 	13, // 20: magma.orc8r.configurator.storage.EntityUpdateCriteria.associations_to_set:type_name -> magma.orc8r.configurator.storage.EntityAssociationsToSet
 	5,  // 21: magma.orc8r.configurator.storage.EntityUpdateCriteria.associations_to_add:type_name -> magma.orc8r.configurator.storage.EntityID
 	5,  // 22: magma.orc8r.configurator.storage.EntityUpdateCriteria.associations_to_delete:type_name -> magma.orc8r.configurator.storage.EntityID
-	5,  // 23: magma.orc8r.configurator.storage.EntityAssociationsToSet.associations_to_set:type_name -> magma.orc8r.configurator.storage.EntityID
-	6,  // 24: magma.orc8r.configurator.storage.EntityGraph.entities:type_name -> magma.orc8r.configurator.storage.NetworkEntity
-	5,  // 25: magma.orc8r.configurator.storage.EntityGraph.root_entities:type_name -> magma.orc8r.configurator.storage.EntityID
-	15, // 26: magma.orc8r.configurator.storage.EntityGraph.edges:type_name -> magma.orc8r.configurator.storage.GraphEdge
-	5,  // 27: magma.orc8r.configurator.storage.GraphEdge.to:type_name -> magma.orc8r.configurator.storage.EntityID
-	5,  // 28: magma.orc8r.configurator.storage.GraphEdge.from:type_name -> magma.orc8r.configurator.storage.EntityID
-	29, // [29:29] is the sub-list for method output_type
-	29, // [29:29] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	20, // 23: magma.orc8r.configurator.storage.EntityUpdateCriteria.expected_version:type_name -> google.protobuf.UInt64Value
+	5,  // 24: magma.orc8r.configurator.storage.EntityAssociationsToSet.associations_to_set:type_name -> magma.orc8r.configurator.storage.EntityID
+	6,  // 25: magma.orc8r.configurator.storage.EntityGraph.entities:type_name -> magma.orc8r.configurator.storage.NetworkEntity
+	5,  // 26: magma.orc8r.configurator.storage.EntityGraph.root_entities:type_name -> magma.orc8r.configurator.storage.EntityID
+	15, // 27: magma.orc8r.configurator.storage.EntityGraph.edges:type_name -> magma.orc8r.configurator.storage.GraphEdge
+	5,  // 28: magma.orc8r.configurator.storage.GraphEdge.to:type_name -> magma.orc8r.configurator.storage.EntityID
+	5,  // 29: magma.orc8r.configurator.storage.GraphEdge.from:type_name -> magma.orc8r.configurator.storage.EntityID
+	30, // [30:30] is the sub-list for method output_type
+	30, // [30:30] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_orc8r_cloud_go_services_configurator_storage_storage_proto_init() }
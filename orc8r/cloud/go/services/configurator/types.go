@@ -524,6 +524,10 @@ type EntityUpdateCriteria struct {
 	AssociationsToSet    storage2.TKs
 	AssociationsToAdd    storage2.TKs
 	AssociationsToDelete storage2.TKs
+
+	// When set, the update is only applied if the entity is still at this
+	// version, ErrVersionMismatch is returned otherwise.
+	ExpectedVersion *uint64
 }
 
 func (euc EntityUpdateCriteria) toProto(serdes serde.Registry) (*storage.EntityUpdateCriteria, error) {
@@ -559,6 +563,9 @@ func (euc EntityUpdateCriteria) toProto(serdes serde.Registry) (*storage.EntityU
 	if euc.DeleteConfig {
 		ret.NewConfig = &wrappers.BytesValue{Value: []byte{}}
 	}
+	if euc.ExpectedVersion != nil {
+		ret.ExpectedVersion = &wrappers.UInt64Value{Value: *euc.ExpectedVersion}
+	}
 
 	return ret, nil
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/golang/glog"
+	"google.golang.org/grpc/metadata"
 
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/services/configurator"
@@ -40,7 +41,9 @@ func getGWMagmadClient(ctx context.Context, networkID string, gatewayID string)
 		glog.Errorf(errMsg, err)
 		return nil, nil, errors.New(errMsg)
 	}
-	return protos.NewMagmadClient(conn), gatewayCtx, nil
+	// Keep the gateway ID header while honoring cancellation and deadline of the caller
+	md, _ := metadata.FromOutgoingContext(gatewayCtx)
+	return protos.NewMagmadClient(conn), metadata.NewOutgoingContext(ctx, md), nil
 }
 
 // GatewayReboot reboots a gateway.
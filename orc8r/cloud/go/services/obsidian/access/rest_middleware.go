@@ -15,12 +15,15 @@ package access
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/golang/glog"
 	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"magma/orc8r/cloud/go/services/accessd"
 	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
@@ -28,6 +31,7 @@ import (
 	"magma/orc8r/cloud/go/services/certifier/constants"
 	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	"magma/orc8r/cloud/go/services/obsidian"
+	"magma/orc8r/cloud/go/services/obsidian/oidc"
 	"magma/orc8r/lib/go/merrors"
 )
 
@@ -113,54 +117,116 @@ func makeErr(decorate logDecorator, status int, errFmt string, errArgs ...interf
 // TokenMiddleware parses the <username>:<token> from the header, validates the token,
 // then checks if the request is within the specified permissions granted to the user.
 func TokenMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
-	return func(c echo.Context) error {
-		req := c.Request()
+	return NewTokenMiddleware(nil)(next)
+}
 
-		// Skip middleware if request when there is no security requirement
-		// for an endpoint
-		if unprotectedPaths[req.RequestURI] {
-			return next(c)
-		}
+// NewTokenMiddleware returns TokenMiddleware which additionally accepts
+// bearer tokens issued by an OIDC identity provider when verifier is provided.
+// Permissions of such users are defined by policies mapped from their groups.
+func NewTokenMiddleware(verifier *oidc.Verifier) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
 
-		username, token, ok := req.BasicAuth()
-		if !ok {
-			return echo.NewHTTPError(http.StatusBadRequest, "failed to parse basic auth header")
-		}
+			// Skip middleware if request when there is no security requirement
+			// for an endpoint
+			if unprotectedPaths[req.RequestURI] {
+				return next(c)
+			}
 
-		resourceType, resourceVal := getResource(c)
-		getPDReq := certprotos.GetPolicyDecisionRequest{
-			Username: username,
-			Token:    token,
-			Request: &certprotos.Request{
-				Action:   getRequestAction(req, nil),
-				Resource: req.RequestURI,
-			},
-		}
-		switch resourceType {
-		case constants.NetworkID:
-			getPDReq.Request.ResourceId = &certprotos.Request_NetworkId{NetworkId: resourceVal}
-		case constants.TenantID:
-			id, err := strconv.ParseInt(resourceVal, 10, 64)
+			policyReq, err := getPolicyRequest(c)
 			if err != nil {
-				obsidian.MakeHTTPError(err, http.StatusBadRequest)
+				return err
+			}
+
+			var pd *certprotos.GetPolicyDecisionResponse
+			if bearerToken, ok := getBearerToken(req); ok && verifier != nil {
+				identity, err := verifier.Verify(bearerToken)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("invalid bearer token: %v", err))
+				}
+				glog.V(4).Infof("Token middleware authenticated OIDC user %s", identity.Username)
+				pd, err = certifier.GetPolicyDecisionForPolicies(req.Context(), &certprotos.GetPolicyDecisionForPoliciesRequest{
+					Policies: identity.Policies,
+					Request:  policyReq,
+				})
+				if err != nil {
+					return obsidian.MakeHTTPError(err, http.StatusInternalServerError)
+				}
+			} else {
+				username, token, ok := req.BasicAuth()
+				if !ok {
+					return echo.NewHTTPError(http.StatusBadRequest, "failed to parse basic auth header")
+				}
+				pd, err = certifier.GetPolicyDecision(req.Context(), &certprotos.GetPolicyDecisionRequest{
+					Username: username,
+					Token:    token,
+					SourceIp: getSourceIp(req),
+					Request:  policyReq,
+				})
+				if status.Code(err) == codes.Unauthenticated {
+					return obsidian.MakeHTTPError(err, http.StatusUnauthorized)
+				}
+				if err != nil {
+					return obsidian.MakeHTTPError(err, http.StatusInternalServerError)
+				}
+			}
+			if pd.Effect == certprotos.Effect_DENY {
+				return echo.NewHTTPError(http.StatusForbidden, "not authorized to view resource")
 			}
-			getPDReq.Request.ResourceId = &certprotos.Request_TenantId{TenantId: id}
+			if next != nil {
+				glog.V(4).Info("Token middleware successfully verified permissions. Sending request to the next middleware.")
+				return next(c)
+			}
+
+			return nil
 		}
+	}
+}
 
-		pd, err := certifier.GetPolicyDecision(req.Context(), &getPDReq)
+func getPolicyRequest(c echo.Context) (*certprotos.Request, error) {
+	req := c.Request()
+	policyReq := &certprotos.Request{
+		Action:   getRequestAction(req, nil),
+		Resource: req.RequestURI,
+	}
+	resourceType, resourceVal := getResource(c)
+	switch resourceType {
+	case constants.NetworkID:
+		policyReq.ResourceId = &certprotos.Request_NetworkId{NetworkId: resourceVal}
+	case constants.TenantID:
+		id, err := strconv.ParseInt(resourceVal, 10, 64)
 		if err != nil {
-			return obsidian.MakeHTTPError(err, http.StatusInternalServerError)
-		}
-		if pd.Effect == certprotos.Effect_DENY {
-			return echo.NewHTTPError(http.StatusForbidden, "not authorized to view resource")
-		}
-		if next != nil {
-			glog.V(4).Info("Token middleware successfully verified permissions. Sending request to the next middleware.")
-			return next(c)
+			return nil, obsidian.MakeHTTPError(err, http.StatusBadRequest)
 		}
+		policyReq.ResourceId = &certprotos.Request_TenantId{TenantId: id}
+	}
+	return policyReq, nil
+}
 
-		return nil
+func getBearerToken(req *http.Request) (string, bool) {
+	const prefix = "Bearer "
+	auth := req.Header.Get("Authorization")
+	if len(auth) <= len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return "", false
+	}
+	return auth[len(prefix):], true
+}
+
+// getSourceIp returns the client address recorded as the last use of a token.
+// Unlike X-Forwarded-For, which echo's RealIP trusts and clients can prepend
+// to, only X-Real-IP is read since the nginx proxy in front of obsidian
+// overwrites it with the address of its peer. Without the header the address
+// of the direct peer is used.
+func getSourceIp(req *http.Request) string {
+	if ip := req.Header.Get(echo.HeaderXRealIP); ip != "" {
+		return ip
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
 	}
+	return host
 }
 
 // getRequestType returns the required request permission (READ, WRITE
@@ -22,10 +22,13 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 
+	"magma/orc8r/cloud/go/services/certifier"
 	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	certifier_test_service "magma/orc8r/cloud/go/services/certifier/test_init"
 	"magma/orc8r/cloud/go/services/certifier/test_utils"
 	"magma/orc8r/cloud/go/services/obsidian/access"
+	"magma/orc8r/cloud/go/services/obsidian/oidc"
+	oidc_test_utils "magma/orc8r/cloud/go/services/obsidian/oidc/test_utils"
 	"magma/orc8r/cloud/go/services/tenants"
 	tenantsh "magma/orc8r/cloud/go/services/tenants/obsidian/handlers"
 	tenant_protos "magma/orc8r/cloud/go/services/tenants/protos"
@@ -177,6 +180,78 @@ func TestAuthMiddleware(t *testing.T) {
 	}
 }
 
+func TestOIDCAuthMiddleware(t *testing.T) {
+	certifier_test_service.StartTestService(t)
+	store := test_utils.GetCertifierBlobstore(t)
+	userToken := test_utils.CreateTestUser(t, store, test_utils.TestUsername, test_utils.TestPassword, []*certprotos.Policy{
+		{
+			Effect:   certprotos.Effect_ALLOW,
+			Action:   certprotos.Action_READ,
+			Resource: &certprotos.Policy_Path{Path: &certprotos.PathResource{Path: "**"}},
+		},
+	})
+
+	idp := oidc_test_utils.StartStubIdP(t)
+	verifier, err := oidc.NewVerifier(&certifier.OIDCConfig{
+		Issuer:   idp.Issuer,
+		Audience: "magma",
+		GroupPolicies: []certifier.OIDCGroupPolicies{
+			{
+				Group: "readers",
+				Policies: []certifier.OIDCPolicy{
+					{Effect: "ALLOW", Action: "READ", ResourceType: "NETWORK_ID", ResourceIDs: []string{TEST_NETWORK_ID}},
+				},
+			},
+			{
+				Group: "admins",
+				Policies: []certifier.OIDCPolicy{
+					{Effect: "ALLOW", Action: "WRITE", ResourceType: "URI", Path: "**"},
+				},
+			},
+		},
+	}, nil)
+	assert.NoError(t, err)
+
+	e := startTestMiddlewareServer(t)
+	e.Use(access.NewTokenMiddleware(verifier))
+	listener := WaitForTestServer(t, e)
+	if listener == nil {
+		return
+	}
+
+	urlPrefix := fmt.Sprintf("http://%s", listener.Addr().String())
+	networkURL := fmt.Sprintf("%s%s/%s", urlPrefix, RegisterNetworkV1, TEST_NETWORK_ID)
+	adminToken := idp.Sign(t, idp.Claims("magma", "admin@example.com", "admins"))
+	readerToken := idp.Sign(t, idp.Claims("magma", "reader@example.com", "readers"))
+	noGroupToken := idp.Sign(t, idp.Claims("magma", "other@example.com"))
+	otherAudienceToken := idp.Sign(t, idp.Claims("other", "admin@example.com", "admins"))
+	tests := []struct {
+		method   string
+		url      string
+		token    string
+		expected int
+	}{
+		{"GET", networkURL, adminToken, http.StatusOK},
+		{"PUT", networkURL, adminToken, http.StatusOK},
+		{"GET", networkURL, readerToken, http.StatusOK},
+		{"PUT", networkURL, readerToken, http.StatusForbidden},
+		{"GET", fmt.Sprintf("%s%s/%s", urlPrefix, RegisterNetworkV1, WRITE_TEST_NETWORK_ID), readerToken, http.StatusForbidden},
+		{"GET", networkURL, noGroupToken, http.StatusForbidden},
+		{"GET", networkURL, otherAudienceToken, http.StatusUnauthorized},
+		{"GET", networkURL, "not a token", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		s, err := SendRequestWithBearerToken(tt.method, tt.url, tt.token)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, s)
+	}
+
+	// Certifier user tokens are still accepted
+	s, err := SendRequestWithToken("GET", networkURL, test_utils.TestUsername, userToken)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, s)
+}
+
 func TestMiddleware(t *testing.T) {
 	operCertSn, superCertSn := MockAccessControl(t)
 
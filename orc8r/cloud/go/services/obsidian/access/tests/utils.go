@@ -207,3 +207,23 @@ func SendRequestWithToken(method, url, username string, token string) (int, erro
 	_, err = ioutil.ReadAll(response.Body)
 	return response.StatusCode, err
 }
+
+func SendRequestWithBearerToken(method, url, token string) (int, error) {
+	request, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+
+	var client = &http.Client{}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+	_, err = ioutil.ReadAll(response.Body)
+	return response.StatusCode, err
+}
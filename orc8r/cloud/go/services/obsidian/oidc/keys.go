@@ -0,0 +1,256 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"magma/orc8r/cloud/go/clock"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	// minRefreshInterval limits refetching of keys for tokens with unknown key ids
+	minRefreshInterval = time.Minute
+)
+
+// keySet caches signing keys of the identity provider.
+// Keys are refetched after refresh interval or when a token is signed
+// with an unknown key, which happens after the provider rotates its keys.
+// Fetching is done outside of the lock and shared by concurrent callers,
+// tokens signed with cached keys are verified while the keys are refreshed.
+type keySet struct {
+	client          *http.Client
+	issuer          string
+	refreshInterval time.Duration
+
+	mu        sync.Mutex
+	jwksURL   string
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	inFlight  *keyRefresh
+}
+
+// keyRefresh is a fetch of the keys in progress, err is set before done is closed.
+type keyRefresh struct {
+	done chan struct{}
+	err  error
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (s *keySet) getKey(kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	now := clock.Now()
+	key, ok := s.findKey(kid)
+	sinceFetch := now.Sub(s.fetchedAt)
+	if ok {
+		if sinceFetch >= s.refreshInterval {
+			s.startRefresh(now)
+		}
+		s.mu.Unlock()
+		return key, nil
+	}
+	if sinceFetch < minRefreshInterval {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("unknown signing key '%s'", kid)
+	}
+	refresh := s.startRefresh(now)
+	s.mu.Unlock()
+
+	<-refresh.done
+	if refresh.err != nil {
+		return nil, refresh.err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key, ok = s.findKey(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key '%s'", kid)
+	}
+	return key, nil
+}
+
+// startRefresh starts fetching the keys unless it is already in progress.
+// Has to be called with the lock held.
+func (s *keySet) startRefresh(now time.Time) *keyRefresh {
+	if s.inFlight != nil {
+		return s.inFlight
+	}
+	refresh := &keyRefresh{done: make(chan struct{})}
+	s.inFlight = refresh
+	jwksURL := s.jwksURL
+	go func() {
+		keys, jwksURL, err := s.fetchKeys(jwksURL)
+		s.mu.Lock()
+		if err == nil {
+			s.jwksURL = jwksURL
+			s.keys = keys
+			s.fetchedAt = now
+		} else if len(s.keys) > 0 {
+			glog.Errorf("Failed to refresh identity provider keys, using cached ones: %v", err)
+		}
+		s.inFlight = nil
+		s.mu.Unlock()
+		refresh.err = err
+		close(refresh.done)
+	}()
+	return refresh
+}
+
+// findKey returns the only key of the provider for tokens without key id.
+func (s *keySet) findKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+func (s *keySet) fetchKeys(jwksURL string) (map[string]crypto.PublicKey, string, error) {
+	if jwksURL == "" {
+		discovered, err := s.discoverJWKSURL()
+		if err != nil {
+			return nil, "", err
+		}
+		jwksURL = discovered
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := s.getJSON(jwksURL, &jwks); err != nil {
+		return nil, "", fmt.Errorf("fetch identity provider keys: %w", err)
+	}
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := parseKey(jwk)
+		if err != nil {
+			glog.Errorf("Skipping identity provider key '%s': %v", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, jwksURL, nil
+}
+
+func (s *keySet) discoverJWKSURL() (string, error) {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := s.getJSON(strings.TrimSuffix(s.issuer, "/")+discoveryPath, &discovery); err != nil {
+		return "", fmt.Errorf("discover identity provider configuration: %w", err)
+	}
+	if discovery.Issuer != s.issuer {
+		return "", fmt.Errorf("discovered issuer '%s' does not match configured '%s'", discovery.Issuer, s.issuer)
+	}
+	if discovery.JWKSURI == "" {
+		return "", fmt.Errorf("identity provider configuration does not contain jwks_uri")
+	}
+	return discovery.JWKSURI, nil
+}
+
+func (s *keySet) getJSON(url string, v interface{}) error {
+	resp, err := s.client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func parseKey(jwk jsonWebKey) (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curve, err := getCurve(jwk.Crv)
+		if err != nil {
+			return nil, err
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %s", jwk.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type '%s'", jwk.Kty)
+	}
+}
+
+func getCurve(crv string) (elliptic.Curve, error) {
+	switch crv {
+	case "P-256":
+		return elliptic.P256(), nil
+	case "P-384":
+		return elliptic.P384(), nil
+	case "P-521":
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unsupported curve '%s'", crv)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
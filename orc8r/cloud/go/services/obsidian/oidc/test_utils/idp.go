@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test_utils
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+const KeyID = "key-1"
+
+// StubIdP is a local OpenID Connect identity provider
+// serving discovery document and signing keys.
+type StubIdP struct {
+	Issuer string
+
+	mu          sync.Mutex
+	key         *rsa.PrivateKey
+	kid         string
+	keyRequests int
+	keysBlocked chan struct{}
+}
+
+// StartStubIdP starts the identity provider which is stopped with the test.
+func StartStubIdP(t *testing.T) *StubIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp := &StubIdP{key: key, kid: KeyID}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":   idp.Issuer,
+			"jwks_uri": idp.Issuer + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		idp.mu.Lock()
+		idp.keyRequests++
+		blocked := idp.keysBlocked
+		idp.mu.Unlock()
+		if blocked != nil {
+			<-blocked
+		}
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		writeJSON(w, map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"use": "sig",
+				"kid": idp.kid,
+				"n":   base64.RawURLEncoding.EncodeToString(idp.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(idp.key.E)).Bytes()),
+			}},
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	idp.Issuer = server.URL
+	return idp
+}
+
+// Claims returns valid claims of the user which can be modified before signing.
+func (i *StubIdP) Claims(audience string, email string, groups ...string) jwt.MapClaims {
+	var groupClaims []interface{}
+	for _, g := range groups {
+		groupClaims = append(groupClaims, g)
+	}
+	return jwt.MapClaims{
+		"iss":    i.Issuer,
+		"aud":    []interface{}{audience},
+		"sub":    email,
+		"email":  email,
+		"groups": groupClaims,
+		"iat":    time.Now().Unix(),
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+// Sign returns the token signed with the current key of the identity provider.
+func (i *StubIdP) Sign(t *testing.T, claims jwt.MapClaims) string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = i.kid
+	signed, err := token.SignedString(i.key)
+	require.NoError(t, err)
+	return signed
+}
+
+// RotateKey replaces the signing key with a new one with different key id.
+func (i *StubIdP) RotateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.key = key
+	i.kid = fmt.Sprintf("key-%d", i.keyRequests+1)
+}
+
+// BlockKeyRequests holds responses to key requests until the returned
+// function is called.
+func (i *StubIdP) BlockKeyRequests() func() {
+	blocked := make(chan struct{})
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.keysBlocked = blocked
+	return func() {
+		i.mu.Lock()
+		defer i.mu.Unlock()
+		i.keysBlocked = nil
+		close(blocked)
+	}
+}
+
+// KeyRequests returns how many times signing keys were requested.
+func (i *StubIdP) KeyRequests() int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.keyRequests
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oidc authenticates obsidian users with bearer tokens (JWT)
+// issued by an OpenID Connect identity provider.
+package oidc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang-jwt/jwt"
+
+	"magma/orc8r/cloud/go/services/certifier"
+	"magma/orc8r/cloud/go/services/certifier/obsidian/models"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+)
+
+const (
+	defaultJWKSRefreshInterval = time.Hour
+	defaultUsernameClaim       = "email"
+	defaultGroupsClaim         = "groups"
+	httpTimeout                = 10 * time.Second
+)
+
+var validMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+// Identity is a user authenticated by the identity provider.
+type Identity struct {
+	Username string
+	Groups   []string
+	// Policies are granted by all groups of the user
+	Policies []*certprotos.Policy
+}
+
+type Verifier struct {
+	issuer          string
+	audience        string
+	usernameClaim   string
+	groupsClaim     string
+	policiesByGroup map[string][]*certprotos.Policy
+	keys            *keySet
+}
+
+// NewVerifier validates the configuration and converts group policies,
+// keys of the identity provider are fetched when the first token is verified.
+func NewVerifier(config *certifier.OIDCConfig, client *http.Client) (*Verifier, error) {
+	if config.Issuer == "" {
+		return nil, errors.New("oidc issuer must be provided")
+	}
+	if config.Audience == "" {
+		return nil, errors.New("oidc audience must be provided")
+	}
+	if client == nil {
+		client = &http.Client{Timeout: httpTimeout}
+	}
+	policiesByGroup := map[string][]*certprotos.Policy{}
+	for _, group := range config.GroupPolicies {
+		policies, err := toPolicyProtos(group.Policies)
+		if err != nil {
+			return nil, fmt.Errorf("invalid policies of group '%s': %w", group.Group, err)
+		}
+		policiesByGroup[group.Group] = append(policiesByGroup[group.Group], policies...)
+	}
+	refreshInterval := time.Duration(config.JWKSRefreshIntervalSec) * time.Second
+	if refreshInterval <= 0 {
+		refreshInterval = defaultJWKSRefreshInterval
+	}
+	v := &Verifier{
+		issuer:          config.Issuer,
+		audience:        config.Audience,
+		usernameClaim:   getOrDefault(config.UsernameClaim, defaultUsernameClaim),
+		groupsClaim:     getOrDefault(config.GroupsClaim, defaultGroupsClaim),
+		policiesByGroup: policiesByGroup,
+		keys: &keySet{
+			client:          client,
+			issuer:          config.Issuer,
+			jwksURL:         config.JWKSURL,
+			refreshInterval: refreshInterval,
+		},
+	}
+	return v, nil
+}
+
+// Verify checks signature, issuer, audience and expiration of the token
+// and returns the user with policies mapped from their groups.
+func (v *Verifier) Verify(rawToken string) (*Identity, error) {
+	claims := jwt.MapClaims{}
+	parser := &jwt.Parser{ValidMethods: validMethods}
+	_, err := parser.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.keys.getKey(kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyIssuer(v.issuer, true) {
+		return nil, errors.New("invalid token issuer")
+	}
+	if !claims.VerifyAudience(v.audience, true) {
+		return nil, errors.New("invalid token audience")
+	}
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("token does not expire")
+	}
+	username, _ := claims[v.usernameClaim].(string)
+	if username == "" {
+		return nil, fmt.Errorf("token does not contain %s claim", v.usernameClaim)
+	}
+	identity := &Identity{
+		Username: username,
+		Groups:   getGroups(claims[v.groupsClaim]),
+	}
+	for _, group := range identity.Groups {
+		identity.Policies = append(identity.Policies, v.policiesByGroup[group]...)
+	}
+	return identity, nil
+}
+
+// getGroups accepts both a list of groups and a single group.
+func getGroups(claim interface{}) []string {
+	switch groups := claim.(type) {
+	case string:
+		return []string{groups}
+	case []interface{}:
+		var ret []string
+		for _, group := range groups {
+			if g, ok := group.(string); ok {
+				ret = append(ret, g)
+			}
+		}
+		return ret
+	default:
+		return nil
+	}
+}
+
+func toPolicyProtos(policies []certifier.OIDCPolicy) ([]*certprotos.Policy, error) {
+	policyModels := models.Policies{}
+	for _, p := range policies {
+		policyModels = append(policyModels, &models.Policy{
+			Effect:       p.Effect,
+			Action:       p.Action,
+			ResourceType: p.ResourceType,
+			Path:         p.Path,
+			ResourceIDs:  p.ResourceIDs,
+		})
+	}
+	if err := policyModels.Validate(strfmt.Default); err != nil {
+		return nil, err
+	}
+	return certprotos.PoliciesModelToProto(&policyModels)
+}
+
+func getOrDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
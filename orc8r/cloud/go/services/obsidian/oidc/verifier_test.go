@@ -0,0 +1,255 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/services/certifier"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+	"magma/orc8r/cloud/go/services/obsidian/oidc"
+	"magma/orc8r/cloud/go/services/obsidian/oidc/test_utils"
+)
+
+const (
+	audience = "magma"
+	username = "bob@example.com"
+)
+
+func TestVerify(t *testing.T) {
+	idp := test_utils.StartStubIdP(t)
+	verifier, err := oidc.NewVerifier(getConfig(idp.Issuer), nil)
+	require.NoError(t, err)
+
+	identity, err := verifier.Verify(idp.Sign(t, idp.Claims(audience, username, "admins", "other")))
+	require.NoError(t, err)
+
+	assert.Equal(t, username, identity.Username)
+	assert.Equal(t, []string{"admins", "other"}, identity.Groups)
+	require.Len(t, identity.Policies, 1)
+	assert.Equal(t, certprotos.Action_WRITE, identity.Policies[0].Action)
+	assert.Equal(t, "**", identity.Policies[0].GetPath().Path)
+}
+
+func TestVerifyMapsTenantPolicies(t *testing.T) {
+	idp := test_utils.StartStubIdP(t)
+	verifier, err := oidc.NewVerifier(getConfig(idp.Issuer), nil)
+	require.NoError(t, err)
+
+	identity, err := verifier.Verify(idp.Sign(t, idp.Claims(audience, username, "operators")))
+	require.NoError(t, err)
+
+	require.Len(t, identity.Policies, 1)
+	assert.Equal(t, []int64{1, 2}, identity.Policies[0].GetTenant().Tenants)
+}
+
+func TestVerifyRejectsInvalidTokens(t *testing.T) {
+	idp := test_utils.StartStubIdP(t)
+	verifier, err := oidc.NewVerifier(getConfig(idp.Issuer), nil)
+	require.NoError(t, err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		token  func() string
+		errMsg string
+	}{
+		{
+			name: "wrong issuer",
+			token: func() string {
+				claims := idp.Claims(audience, username)
+				claims["iss"] = "https://other.example.com"
+				return idp.Sign(t, claims)
+			},
+			errMsg: "invalid token issuer",
+		},
+		{
+			name:   "wrong audience",
+			token:  func() string { return idp.Sign(t, idp.Claims("other", username)) },
+			errMsg: "invalid token audience",
+		},
+		{
+			name: "expired",
+			token: func() string {
+				claims := idp.Claims(audience, username)
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+				return idp.Sign(t, claims)
+			},
+			errMsg: "Token is expired",
+		},
+		{
+			name: "without expiration",
+			token: func() string {
+				claims := idp.Claims(audience, username)
+				delete(claims, "exp")
+				return idp.Sign(t, claims)
+			},
+			errMsg: "token does not expire",
+		},
+		{
+			name: "without username",
+			token: func() string {
+				claims := idp.Claims(audience, username)
+				delete(claims, "email")
+				return idp.Sign(t, claims)
+			},
+			errMsg: "token does not contain email claim",
+		},
+		{
+			name: "signed with other key",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodRS256, idp.Claims(audience, username))
+				token.Header["kid"] = test_utils.KeyID
+				signed, err := token.SignedString(otherKey)
+				require.NoError(t, err)
+				return signed
+			},
+			errMsg: "crypto/rsa: verification error",
+		},
+		{
+			name: "unknown key",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodES256, idp.Claims(audience, username))
+				token.Header["kid"] = "unknown"
+				signed, err := token.SignedString(ecKey)
+				require.NoError(t, err)
+				return signed
+			},
+			errMsg: "unknown signing key 'unknown'",
+		},
+		{
+			name: "not signed",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodNone, idp.Claims(audience, username))
+				signed, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+				require.NoError(t, err)
+				return signed
+			},
+			errMsg: "signing method none is invalid",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := verifier.Verify(tc.token())
+			assert.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestVerifyAfterKeyRotation(t *testing.T) {
+	now := time.Unix(1e9, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	idp := test_utils.StartStubIdP(t)
+	verifier, err := oidc.NewVerifier(getConfig(idp.Issuer), nil)
+	require.NoError(t, err)
+	_, err = verifier.Verify(idp.Sign(t, idp.Claims(audience, username)))
+	require.NoError(t, err)
+
+	idp.RotateKey(t)
+	token := idp.Sign(t, idp.Claims(audience, username))
+	_, err = verifier.Verify(token)
+	assert.Error(t, err, "keys are not refetched too often")
+
+	clock.SetAndFreezeClock(t, now.Add(2*time.Minute))
+	_, err = verifier.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, idp.KeyRequests())
+}
+
+func TestVerifyDuringKeyRefresh(t *testing.T) {
+	now := time.Unix(1e9, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	idp := test_utils.StartStubIdP(t)
+	verifier, err := oidc.NewVerifier(getConfig(idp.Issuer), nil)
+	require.NoError(t, err)
+	token := idp.Sign(t, idp.Claims(audience, username))
+
+	// Concurrent verifications with an unknown key share a single fetch
+	release := idp.BlockKeyRequests()
+	errs := make(chan error)
+	for i := 0; i < 3; i++ {
+		go func() {
+			_, err := verifier.Verify(token)
+			errs <- err
+		}()
+	}
+	assert.Eventually(t, func() bool { return idp.KeyRequests() == 1 }, time.Second, 10*time.Millisecond)
+	// Let the other verifications wait for the fetch in progress
+	time.Sleep(50 * time.Millisecond)
+	release()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, <-errs)
+	}
+	assert.Equal(t, 1, idp.KeyRequests())
+
+	// Cached keys are used while the periodic refresh is in progress
+	release = idp.BlockKeyRequests()
+	clock.SetAndFreezeClock(t, now.Add(2*time.Hour))
+	for i := 0; i < 3; i++ {
+		_, err = verifier.Verify(token)
+		assert.NoError(t, err)
+	}
+	assert.Eventually(t, func() bool { return idp.KeyRequests() == 2 }, time.Second, 10*time.Millisecond)
+	release()
+}
+
+func TestNewVerifierInvalidConfig(t *testing.T) {
+	config := getConfig("https://idp.example.com")
+	config.GroupPolicies[0].Policies[0].Action = "EXECUTE"
+	_, err := oidc.NewVerifier(config, nil)
+	assert.Error(t, err)
+
+	_, err = oidc.NewVerifier(&certifier.OIDCConfig{Issuer: "https://idp.example.com"}, nil)
+	assert.EqualError(t, err, "oidc audience must be provided")
+}
+
+func getConfig(issuer string) *certifier.OIDCConfig {
+	return &certifier.OIDCConfig{
+		Enabled:  true,
+		Issuer:   issuer,
+		Audience: audience,
+		GroupPolicies: []certifier.OIDCGroupPolicies{
+			{
+				Group: "admins",
+				Policies: []certifier.OIDCPolicy{
+					{Effect: "ALLOW", Action: "WRITE", ResourceType: "URI", Path: "**"},
+				},
+			},
+			{
+				Group: "operators",
+				Policies: []certifier.OIDCPolicy{
+					{Effect: "ALLOW", Action: "WRITE", ResourceType: "TENANT_ID", ResourceIDs: []string{"1", "2"}},
+				},
+			},
+		},
+	}
+}
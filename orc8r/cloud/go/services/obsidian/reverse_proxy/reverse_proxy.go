@@ -26,6 +26,7 @@ import (
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/services/certifier"
 	"magma/orc8r/cloud/go/services/obsidian/access"
+	"magma/orc8r/cloud/go/services/obsidian/oidc"
 	"magma/orc8r/lib/go/registry"
 )
 
@@ -35,6 +36,7 @@ import (
 type ReverseProxyHandler struct {
 	proxyBackendsByPathPrefix map[string]*reverseProxyBackend
 	certifierServiceConfig    *certifier.Config
+	oidcVerifier              *oidc.Verifier
 }
 
 type reverseProxyBackend struct {
@@ -42,11 +44,13 @@ type reverseProxyBackend struct {
 	active    bool
 }
 
-// NewReverseProxyHandler initializes a ReverseProxyHandler
-func NewReverseProxyHandler(config *certifier.Config) *ReverseProxyHandler {
+// NewReverseProxyHandler initializes a ReverseProxyHandler,
+// verifier is optional and enables OIDC bearer tokens when tokens are used.
+func NewReverseProxyHandler(config *certifier.Config, verifier *oidc.Verifier) *ReverseProxyHandler {
 	return &ReverseProxyHandler{
 		proxyBackendsByPathPrefix: map[string]*reverseProxyBackend{},
 		certifierServiceConfig:    config,
+		oidcVerifier:              verifier,
 	}
 }
 
@@ -79,7 +83,7 @@ func (r *ReverseProxyHandler) AddReverseProxyPaths(server *echo.Echo, pathPrefix
 			}
 			g := server.Group(prefix)
 			if r.certifierServiceConfig != nil && r.certifierServiceConfig.UseToken {
-				g.Use(access.TokenMiddleware)
+				g.Use(access.NewTokenMiddleware(r.oidcVerifier))
 			}
 			g.Use(r.activeBackendMiddleware)
 			g.Use(middleware.Proxy(middleware.NewRoundRobinBalancer(target)))
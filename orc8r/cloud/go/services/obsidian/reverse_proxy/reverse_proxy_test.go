@@ -69,7 +69,7 @@ func TestReverseProxy(t *testing.T) {
 	startTestService(t, srv1, lis1, plis1)
 	startTestService(t, srv2, lis2, plis2)
 
-	handler := NewReverseProxyHandler(nil)
+	handler := NewReverseProxyHandler(nil, nil)
 	e, err := startTestServer(handler)
 	assert.NoError(t, err)
 
@@ -182,7 +182,7 @@ func TestReverseProxyPathCollision(t *testing.T) {
 	startTestService(t, srv1, lis1, plis1)
 	startTestService(t, srv2, lis2, plis2)
 
-	handler := NewReverseProxyHandler(nil)
+	handler := NewReverseProxyHandler(nil, nil)
 	e, err := startTestServer(handler)
 	assert.NoError(t, err)
 
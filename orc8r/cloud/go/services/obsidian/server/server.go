@@ -30,6 +30,7 @@ import (
 	"magma/orc8r/cloud/go/services/certifier"
 	"magma/orc8r/cloud/go/services/obsidian"
 	"magma/orc8r/cloud/go/services/obsidian/access"
+	"magma/orc8r/cloud/go/services/obsidian/oidc"
 	"magma/orc8r/cloud/go/services/obsidian/reverse_proxy"
 	"magma/orc8r/cloud/go/services/obsidian/swagger/handlers"
 	"magma/orc8r/lib/go/service/config"
@@ -124,7 +125,15 @@ func Start() {
 	if err != nil {
 		glog.Infof("Failed unmarshalling service config %v", err)
 	}
-	reverseProxyHandler := reverse_proxy.NewReverseProxyHandler(&serviceConfig)
+	var verifier *oidc.Verifier
+	if serviceConfig.UseToken && serviceConfig.OIDC != nil && serviceConfig.OIDC.Enabled {
+		verifier, err = oidc.NewVerifier(serviceConfig.OIDC, nil)
+		if err != nil {
+			log.Fatalf("Error configuring OIDC authentication: %s", err)
+		}
+		log.Printf("Accepting OIDC bearer tokens issued by %s", serviceConfig.OIDC.Issuer)
+	}
+	reverseProxyHandler := reverse_proxy.NewReverseProxyHandler(&serviceConfig, verifier)
 	pathPrefixesByAddr, err := reverse_proxy.GetEchoServerAddressToPathPrefixes()
 	if err != nil {
 		log.Fatalf("Error querying service registry for reverse proxy paths: %s", err)
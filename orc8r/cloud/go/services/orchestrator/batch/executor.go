@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-openapi/swag"
+
+	models2 "magma/orc8r/cloud/go/models"
+	"magma/orc8r/cloud/go/services/magmad"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/lib/go/protos"
+)
+
+// Executor runs a command on a single gateway.
+type Executor interface {
+	// Execute returns output of ping, generic and tail logs commands, nil otherwise.
+	Execute(ctx context.Context, networkID string, gatewayID string, command *models.BatchCommand) (interface{}, error)
+}
+
+type magmadExecutor struct{}
+
+// NewMagmadExecutor returns the executor which sends commands to magmad
+// of gateways through the dispatcher.
+func NewMagmadExecutor() Executor {
+	return magmadExecutor{}
+}
+
+func (magmadExecutor) Execute(ctx context.Context, networkID string, gatewayID string, command *models.BatchCommand) (interface{}, error) {
+	switch swag.StringValue(command.Type) {
+	case models.BatchCommandTypeReboot:
+		return nil, magmad.GatewayReboot(ctx, networkID, gatewayID)
+	case models.BatchCommandTypeRestartServices:
+		return nil, magmad.GatewayRestartServices(ctx, networkID, gatewayID, command.Services)
+	case models.BatchCommandTypePing:
+		response, err := magmad.GatewayPing(ctx, networkID, gatewayID, command.Ping.Packets, command.Ping.Hosts)
+		if err != nil {
+			return nil, err
+		}
+		pingResponse := &models.PingResponse{}
+		for _, ping := range response.Pings {
+			pingResponse.Pings = append(pingResponse.Pings, &models.PingResult{
+				HostOrIP:           swag.String(ping.HostOrIp),
+				NumPackets:         swag.Int32(ping.NumPackets),
+				PacketsTransmitted: ping.PacketsTransmitted,
+				PacketsReceived:    ping.PacketsReceived,
+				AvgResponseMs:      ping.AvgResponseMs,
+				Error:              ping.Error,
+			})
+		}
+		return pingResponse, nil
+	case models.BatchCommandTypeGeneric:
+		params, err := models2.JSONMapToProtobufStruct(command.Generic.Params)
+		if err != nil {
+			return nil, err
+		}
+		response, err := magmad.GatewayGenericCommand(ctx, networkID, gatewayID, &protos.GenericCommandParams{
+			Command: swag.StringValue(command.Generic.Command),
+			Params:  params,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return models2.ProtobufStructToJSONMap(response.Response)
+	case models.BatchCommandTypeTailLogs:
+		return tailLogs(ctx, networkID, gatewayID, command.TailLogs)
+	default:
+		return nil, fmt.Errorf("unsupported command type %s", swag.StringValue(command.Type))
+	}
+}
+
+// tailLogs collects up to max lines of the service's logs. The log stream of
+// magmad never ends, so lines are collected for at most 90 percent of the
+// time left until the command times out.
+func tailLogs(ctx context.Context, networkID string, gatewayID string, params *models.BatchTailLogsParams) (interface{}, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Until(deadline)*9/10)
+		defer cancel()
+	}
+	stream, err := magmad.TailGatewayLogs(ctx, networkID, gatewayID, swag.StringValue(params.Service))
+	if err != nil {
+		return nil, err
+	}
+	lines := []string{}
+	for len(lines) < params.GetMaxLines() {
+		line, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line.Line)
+	}
+	return map[string]interface{}{"lines": lines}, nil
+}
@@ -0,0 +1,451 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package batch runs commands on sets of gateways with limited concurrency
+// and stores per gateway results.
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/glog"
+	"github.com/hashicorp/go-multierror"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/orchestrator/lease"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/lib/go/merrors"
+)
+
+// Runner starts pending jobs of all networks.
+//
+// Jobs are created by the REST API in pending state. A runner claims a job
+// by acquiring its lease and moving it to running state, conditionally on
+// the job not having been changed since it was loaded, so a job is run by a
+// single orchestrator replica. While the job runs, progress is stored and
+// the lease renewed every progress interval. Running jobs whose lease
+// expired, e.g. because their runner was restarted, are completed with
+// gateways not finished yet marked as failed.
+type Runner struct {
+	executor         Executor
+	leases           lease.Store
+	owner            string
+	progressInterval time.Duration
+
+	mu     sync.Mutex
+	active map[string]bool
+	wg     sync.WaitGroup
+}
+
+func NewRunner(executor Executor, leases lease.Store, owner string, progressInterval time.Duration) *Runner {
+	return &Runner{
+		executor:         executor,
+		leases:           leases,
+		owner:            owner,
+		progressInterval: progressInterval,
+		active:           map[string]bool{},
+	}
+}
+
+// Run starts pending jobs every interval until the context is done.
+func (r *Runner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Reconcile(ctx); err != nil {
+				glog.Errorf("Failed to reconcile batch command jobs: %v", err)
+			}
+		}
+	}
+}
+
+// Reconcile starts pending jobs in the background and completes running
+// jobs whose lease expired.
+func (r *Runner) Reconcile(ctx context.Context) error {
+	networkIDs, err := configurator.ListNetworkIDs(ctx)
+	if err != nil {
+		return err
+	}
+	errs := &multierror.Error{}
+	for _, networkID := range networkIDs {
+		entities, _, err := configurator.LoadAllEntitiesOfType(
+			ctx, networkID, orc8r.BatchCommandJobEntityType,
+			configurator.EntityLoadCriteria{LoadConfig: true},
+			serdes.Entity,
+		)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("load batch command jobs of network %s: %w", networkID, err))
+			continue
+		}
+		for _, entity := range entities {
+			job := entity.Config.(*models.BatchCommandJob)
+			if job.Status == nil || r.isActive(networkID, job.ID) {
+				continue
+			}
+			var err error
+			switch job.Status.State {
+			case models.BatchCommandJobStatusStatePending:
+				err = r.start(ctx, networkID, job, entity.Version)
+			case models.BatchCommandJobStatusStateRunning:
+				err = r.interruptJob(ctx, networkID, job.ID)
+			}
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("job %s of network %s: %w", job.ID, networkID, err))
+			}
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// Wait blocks until all started jobs finish.
+func (r *Runner) Wait() {
+	r.wg.Wait()
+}
+
+// start claims the pending job loaded at version and runs it in the
+// background. Jobs claimed by another runner are skipped.
+func (r *Runner) start(ctx context.Context, networkID string, job *models.BatchCommandJob, version uint64) error {
+	leaseName := jobLease(networkID, job.ID)
+	acquired, err := r.leases.TryAcquire(leaseName, r.owner, r.leaseTTL())
+	if err != nil {
+		return fmt.Errorf("acquire job lease: %w", err)
+	}
+	if !acquired {
+		return nil
+	}
+	gatewayIDs, version, err := claimJob(ctx, networkID, job, version)
+	if err != nil || job.Status.State != models.BatchCommandJobStatusStateRunning {
+		r.release(leaseName)
+		if err == configurator.ErrVersionMismatch {
+			return nil
+		}
+		return err
+	}
+	glog.Infof("Started batch command job %s of network %s on %d gateways", job.ID, networkID, len(gatewayIDs))
+
+	key := jobKey(networkID, job.ID)
+	r.mu.Lock()
+	r.active[key] = true
+	r.mu.Unlock()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer func() {
+			r.release(leaseName)
+			r.mu.Lock()
+			delete(r.active, key)
+			r.mu.Unlock()
+		}()
+		if err := r.runJob(ctx, networkID, job, version, gatewayIDs); err != nil {
+			glog.Errorf("Batch command job %s of network %s: %v", job.ID, networkID, err)
+		}
+	}()
+	return nil
+}
+
+func (r *Runner) isActive(networkID string, jobID models.BatchCommandJobID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.active[jobKey(networkID, jobID)]
+}
+
+// claimJob moves the pending job loaded at version to running state and
+// returns its gateways and the version it was stored at. The job is
+// completed instead when its targets cannot be resolved.
+func claimJob(ctx context.Context, networkID string, job *models.BatchCommandJob, version uint64) ([]string, uint64, error) {
+	gatewayIDs, err := ResolveTargets(ctx, networkID, job.Target)
+	if err != nil {
+		job.Status.State = models.BatchCommandJobStatusStateCompleted
+		job.Status.FinishedAt = strfmt.DateTime(clock.Now())
+		job.Status.Message = fmt.Sprintf("failed to resolve target gateways: %v", err)
+		_, err = saveJob(ctx, networkID, job, version)
+		return nil, 0, err
+	}
+	job.Status.State = models.BatchCommandJobStatusStateRunning
+	job.Status.Total = int64(len(gatewayIDs))
+	job.Results = models.BatchCommandResults{}
+	for _, gatewayID := range gatewayIDs {
+		job.Results[gatewayID] = models.BatchCommandResult{State: models.BatchCommandResultStatePending}
+	}
+	version, err = saveJob(ctx, networkID, job, version)
+	if err != nil {
+		return nil, 0, err
+	}
+	return gatewayIDs, version, nil
+}
+
+func (r *Runner) runJob(ctx context.Context, networkID string, job *models.BatchCommandJob, version uint64, gatewayIDs []string) error {
+	// Job is canceled when its progress cannot be stored, e.g. after it was
+	// deleted, or its lease cannot be renewed
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	progress := &jobProgress{job: job, version: version}
+	stopHeartbeat := r.startHeartbeat(ctx, networkID, progress, cancel)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, job.GetMaxConcurrency())
+	for _, gatewayID := range gatewayIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-jobCtx.Done():
+		}
+		if jobCtx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(gatewayID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result := r.execute(jobCtx, networkID, gatewayID, job)
+			progress.addResult(gatewayID, result)
+		}(gatewayID)
+	}
+	wg.Wait()
+	if err := stopHeartbeat(); err != nil {
+		return err
+	}
+
+	job.Status.State = models.BatchCommandJobStatusStateCompleted
+	job.Status.FinishedAt = strfmt.DateTime(clock.Now())
+	glog.Infof("Completed batch command job %s of network %s: %d succeeded, %d failed", job.ID, networkID, job.Status.Succeeded, job.Status.Failed)
+	_, err := saveJob(ctx, networkID, job, progress.version)
+	return err
+}
+
+// jobProgress collects results of a running job until they are stored.
+type jobProgress struct {
+	sync.Mutex
+	job     *models.BatchCommandJob
+	version uint64
+	dirty   bool
+}
+
+func (p *jobProgress) addResult(gatewayID string, result models.BatchCommandResult) {
+	p.Lock()
+	defer p.Unlock()
+	p.job.Results[gatewayID] = result
+	if result.State == models.BatchCommandResultStateSucceeded {
+		p.job.Status.Succeeded++
+	} else {
+		p.job.Status.Failed++
+	}
+	p.dirty = true
+}
+
+// store saves the job if results were added since it was last stored.
+func (p *jobProgress) store(ctx context.Context, networkID string) error {
+	p.Lock()
+	defer p.Unlock()
+	if !p.dirty {
+		return nil
+	}
+	version, err := saveJob(ctx, networkID, p.job, p.version)
+	if err != nil {
+		return err
+	}
+	p.version, p.dirty = version, false
+	return nil
+}
+
+// startHeartbeat renews the job lease and stores progress of the job every
+// progress interval, canceling the job when either fails. The returned
+// function stops the heartbeat, stores the remaining progress and returns
+// the first error.
+func (r *Runner) startHeartbeat(ctx context.Context, networkID string, progress *jobProgress, cancel context.CancelFunc) func() error {
+	leaseName := jobLease(networkID, progress.job.ID)
+	done := make(chan struct{})
+	var heartbeatErr error
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(r.progressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			acquired, err := r.leases.TryAcquire(leaseName, r.owner, r.leaseTTL())
+			if err == nil && !acquired {
+				err = errors.New("job lease was taken over")
+			}
+			if err != nil {
+				heartbeatErr = fmt.Errorf("renew job lease: %w", err)
+				cancel()
+				return
+			}
+			if err := progress.store(ctx, networkID); err != nil {
+				heartbeatErr = fmt.Errorf("store progress: %w", err)
+				cancel()
+				return
+			}
+		}
+	}()
+	return func() error {
+		close(done)
+		wg.Wait()
+		if heartbeatErr != nil {
+			return heartbeatErr
+		}
+		if err := progress.store(ctx, networkID); err != nil {
+			return fmt.Errorf("store progress: %w", err)
+		}
+		return nil
+	}
+}
+
+func (r *Runner) execute(ctx context.Context, networkID string, gatewayID string, job *models.BatchCommandJob) models.BatchCommandResult {
+	result := models.BatchCommandResult{StartedAt: strfmt.DateTime(clock.Now())}
+	ctx, cancel := context.WithTimeout(ctx, job.GetTimeout())
+	defer cancel()
+
+	type response struct {
+		output interface{}
+		err    error
+	}
+	done := make(chan response, 1)
+	go func() {
+		output, err := r.executor.Execute(ctx, networkID, gatewayID, job.Command)
+		done <- response{output: output, err: err}
+	}()
+	select {
+	case res := <-done:
+		result.Output = res.output
+		if res.err != nil {
+			result.State = models.BatchCommandResultStateFailed
+			result.Error = res.err.Error()
+		} else {
+			result.State = models.BatchCommandResultStateSucceeded
+		}
+	case <-ctx.Done():
+		result.State = models.BatchCommandResultStateTimedOut
+		result.Error = fmt.Sprintf("command did not finish within %s", job.GetTimeout())
+	}
+	result.FinishedAt = strfmt.DateTime(clock.Now())
+	return result
+}
+
+// interruptJob completes the running job once its lease expired. Results
+// of gateways not finished yet are marked as failed.
+func (r *Runner) interruptJob(ctx context.Context, networkID string, jobID models.BatchCommandJobID) error {
+	leaseName := jobLease(networkID, jobID)
+	acquired, err := r.leases.TryAcquire(leaseName, r.owner, r.leaseTTL())
+	if err != nil {
+		return fmt.Errorf("acquire job lease: %w", err)
+	}
+	if !acquired {
+		return nil
+	}
+	defer r.release(leaseName)
+
+	// Job is loaded again, its runner may have completed it meanwhile
+	entity, err := configurator.LoadEntity(
+		ctx, networkID, orc8r.BatchCommandJobEntityType, string(jobID),
+		configurator.EntityLoadCriteria{LoadConfig: true},
+		serdes.Entity,
+	)
+	if err == merrors.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	job := entity.Config.(*models.BatchCommandJob)
+	if job.Status == nil || job.Status.State != models.BatchCommandJobStatusStateRunning {
+		return nil
+	}
+	now := strfmt.DateTime(clock.Now())
+	for gatewayID, result := range job.Results {
+		if result.State != models.BatchCommandResultStatePending {
+			continue
+		}
+		result.State = models.BatchCommandResultStateFailed
+		result.Error = "job was interrupted"
+		result.FinishedAt = now
+		job.Results[gatewayID] = result
+		job.Status.Failed++
+	}
+	job.Status.State = models.BatchCommandJobStatusStateCompleted
+	job.Status.FinishedAt = now
+	job.Status.Message = "job was interrupted before all gateways finished"
+	_, err = saveJob(ctx, networkID, job, entity.Version)
+	if err == configurator.ErrVersionMismatch {
+		return nil
+	}
+	return err
+}
+
+func LoadJobs(ctx context.Context, networkID string) ([]*models.BatchCommandJob, error) {
+	entities, _, err := configurator.LoadAllEntitiesOfType(
+		ctx, networkID, orc8r.BatchCommandJobEntityType,
+		configurator.EntityLoadCriteria{LoadConfig: true},
+		serdes.Entity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*models.BatchCommandJob
+	for _, entity := range entities {
+		ret = append(ret, entity.Config.(*models.BatchCommandJob))
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].ID < ret[j].ID })
+	return ret, nil
+}
+
+// saveJob stores the job loaded at version and returns its new version.
+// ErrVersionMismatch from configurator is returned when the job was changed
+// or deleted since it was loaded.
+func saveJob(ctx context.Context, networkID string, job *models.BatchCommandJob, version uint64) (uint64, error) {
+	update := job.ToUpdateCriteria()
+	update.ExpectedVersion = &version
+	entity, err := configurator.UpdateEntity(ctx, networkID, update, serdes.Entity)
+	if err != nil {
+		return 0, err
+	}
+	return entity.Version, nil
+}
+
+func (r *Runner) release(leaseName string) {
+	if err := r.leases.Release(leaseName, r.owner); err != nil {
+		glog.Errorf("Failed to release lease %s: %v", leaseName, err)
+	}
+}
+
+// leaseTTL leaves a running job to its runner for three missed heartbeats.
+func (r *Runner) leaseTTL() time.Duration {
+	return 3 * r.progressInterval
+}
+
+func jobKey(networkID string, jobID models.BatchCommandJobID) string {
+	return fmt.Sprintf("%s/%s", networkID, jobID)
+}
+
+func jobLease(networkID string, jobID models.BatchCommandJobID) string {
+	return fmt.Sprintf("batch_job/%s", jobKey(networkID, jobID))
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/orc8r/cloud/go/clock"
+	models1 "magma/orc8r/cloud/go/models"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/configurator/test_utils"
+	"magma/orc8r/cloud/go/services/orchestrator/batch"
+	"magma/orc8r/cloud/go/services/orchestrator/lease"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/storage"
+	orc8r_test_utils "magma/orc8r/cloud/go/test_utils"
+)
+
+const networkID = "n1"
+
+type stubExecutor struct {
+	mu         sync.Mutex
+	running    int
+	maxRunning int
+	executed   []string
+	failing    map[string]bool
+	hanging    map[string]bool
+	onExecute  func(gatewayID string)
+}
+
+func (s *stubExecutor) Execute(ctx context.Context, networkID string, gatewayID string, command *models.BatchCommand) (interface{}, error) {
+	s.mu.Lock()
+	s.running++
+	if s.running > s.maxRunning {
+		s.maxRunning = s.running
+	}
+	s.executed = append(s.executed, gatewayID)
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.running--
+		s.mu.Unlock()
+	}()
+
+	if s.onExecute != nil {
+		s.onExecute(gatewayID)
+	}
+	if s.hanging[gatewayID] {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if s.failing[gatewayID] {
+		return nil, errors.New("gateway is not connected")
+	}
+	return map[string]interface{}{"gateway": gatewayID, "command": swag.StringValue(command.Type)}, nil
+}
+
+// getExecuted returns gateways the command was sent to. Commands which
+// timed out may still be running.
+func (s *stubExecutor) getExecuted() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.executed...)
+}
+
+func TestRunJob(t *testing.T) {
+	setupGateways(t)
+	executor := &stubExecutor{
+		failing: map[string]bool{"g2": true},
+		hanging: map[string]bool{"g3": true},
+	}
+	// g1 waits for g2, so that gateways run concurrently
+	g2Started := make(chan struct{})
+	executor.onExecute = func(gatewayID string) {
+		switch gatewayID {
+		case "g1":
+			select {
+			case <-g2Started:
+			case <-time.After(time.Second):
+			}
+		case "g2":
+			close(g2Started)
+		}
+	}
+	runner := batch.NewRunner(executor, newLeaseStore(t), "owner", 10*time.Millisecond)
+
+	createJob(t, &models.BatchCommandJob{
+		ID:             "job1",
+		Command:        &models.BatchCommand{Type: swag.String(models.BatchCommandTypeGeneric), Generic: &models.GenericCommandParams{Command: swag.String("show_tech")}},
+		MaxConcurrency: 2,
+		TimeoutSec:     1,
+	})
+	require.NoError(t, runner.Reconcile(context.Background()))
+	runner.Wait()
+
+	job := loadJob(t, "job1")
+	assert.Equal(t, models.BatchCommandJobStatusStateCompleted, job.Status.State)
+	assert.Equal(t, int64(5), job.Status.Total)
+	assert.Equal(t, int64(3), job.Status.Succeeded)
+	assert.Equal(t, int64(2), job.Status.Failed)
+	assert.False(t, time.Time(job.Status.FinishedAt).IsZero())
+	executor.mu.Lock()
+	assert.Equal(t, 2, executor.maxRunning)
+	executor.mu.Unlock()
+
+	require.Len(t, job.Results, 5)
+	assert.Equal(t, models.BatchCommandResultStateSucceeded, job.Results["g1"].State)
+	assert.Equal(t, map[string]interface{}{"gateway": "g1", "command": "generic"}, job.Results["g1"].Output)
+	assert.Equal(t, models.BatchCommandResultStateFailed, job.Results["g2"].State)
+	assert.Equal(t, "gateway is not connected", job.Results["g2"].Error)
+	assert.Equal(t, models.BatchCommandResultStateTimedOut, job.Results["g3"].State)
+	assert.Equal(t, "command did not finish within 1s", job.Results["g3"].Error)
+
+	// Completed jobs are not started again
+	require.NoError(t, runner.Reconcile(context.Background()))
+	runner.Wait()
+	assert.Len(t, executor.getExecuted(), 5)
+}
+
+func TestRunJobTargets(t *testing.T) {
+	setupGateways(t)
+	testCases := []struct {
+		name     string
+		target   *models.BatchCommandTarget
+		expected []string
+	}{
+		{
+			name:     "network",
+			target:   nil,
+			expected: []string{"g1", "g2", "g3", "g4", "g5"},
+		},
+		{
+			name:     "tier",
+			target:   &models.BatchCommandTarget{Tier: "t1"},
+			expected: []string{"g1", "g2", "g3"},
+		},
+		{
+			name:     "labels",
+			target:   &models.BatchCommandTarget{Labels: map[string]string{"region": "west"}},
+			expected: []string{"g1", "g4"},
+		},
+		{
+			name:     "all conditions",
+			target:   &models.BatchCommandTarget{Tier: "t1", GatewayIds: []models1.GatewayID{"g2", "g3", "g4"}, Labels: map[string]string{"region": "east", "site": "a"}},
+			expected: []string{"g3"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gatewayIDs, err := batch.ResolveTargets(context.Background(), networkID, tc.target)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, gatewayIDs)
+		})
+	}
+
+	_, err := batch.ResolveTargets(context.Background(), networkID, &models.BatchCommandTarget{Tier: "t2"})
+	assert.EqualError(t, err, "load tier t2: Not found")
+}
+
+func TestDeleteRunningJob(t *testing.T) {
+	setupGateways(t)
+	executor := &stubExecutor{hanging: map[string]bool{"g2": true}}
+	executor.onExecute = func(gatewayID string) {
+		if gatewayID == "g1" {
+			err := configurator.DeleteEntity(context.Background(), networkID, orc8r.BatchCommandJobEntityType, "job1")
+			assert.NoError(t, err)
+		}
+	}
+	runner := batch.NewRunner(executor, newLeaseStore(t), "owner", 10*time.Millisecond)
+
+	createJob(t, &models.BatchCommandJob{
+		ID:             "job1",
+		Command:        &models.BatchCommand{Type: swag.String(models.BatchCommandTypeReboot)},
+		MaxConcurrency: 1,
+	})
+	require.NoError(t, runner.Reconcile(context.Background()))
+	runner.Wait()
+
+	// Command on g2 is canceled once progress of g1 cannot be stored
+	assert.Equal(t, []string{"g1", "g2"}, executor.getExecuted())
+}
+
+func TestJobClaimedOnce(t *testing.T) {
+	setupGateways(t)
+	leases := newLeaseStore(t)
+	release := make(chan struct{})
+	executor := &stubExecutor{onExecute: func(gatewayID string) {
+		if gatewayID == "g1" {
+			<-release
+		}
+	}}
+	runner := batch.NewRunner(executor, leases, "runner1", 10*time.Millisecond)
+	other := batch.NewRunner(&stubExecutor{}, leases, "runner2", 10*time.Millisecond)
+
+	createJob(t, &models.BatchCommandJob{
+		ID:             "job1",
+		Command:        &models.BatchCommand{Type: swag.String(models.BatchCommandTypeReboot)},
+		MaxConcurrency: 1,
+	})
+	require.NoError(t, runner.Reconcile(context.Background()))
+
+	// Job running on another runner is neither started nor interrupted
+	require.NoError(t, other.Reconcile(context.Background()))
+	other.Wait()
+	assert.Equal(t, models.BatchCommandJobStatusStateRunning, loadJob(t, "job1").Status.State)
+
+	close(release)
+	runner.Wait()
+	job := loadJob(t, "job1")
+	assert.Equal(t, models.BatchCommandJobStatusStateCompleted, job.Status.State)
+	assert.Empty(t, job.Status.Message)
+	assert.Equal(t, int64(5), job.Status.Succeeded)
+	assert.Len(t, executor.getExecuted(), 5)
+}
+
+func TestInterruptedJob(t *testing.T) {
+	setupGateways(t)
+	now := time.Unix(1e6, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+	leases := newLeaseStore(t)
+	runner := batch.NewRunner(&stubExecutor{}, leases, "owner", time.Second)
+
+	job := &models.BatchCommandJob{
+		ID:      "job1",
+		Command: &models.BatchCommand{Type: swag.String(models.BatchCommandTypeReboot)},
+		Results: models.BatchCommandResults{
+			"g1": {State: models.BatchCommandResultStateSucceeded},
+			"g2": {State: models.BatchCommandResultStatePending},
+		},
+	}
+	createJob(t, job)
+	job.Status = &models.BatchCommandJobStatus{State: models.BatchCommandJobStatusStateRunning, Total: 2, Succeeded: 1}
+	_, err := configurator.UpdateEntity(context.Background(), networkID, job.ToUpdateCriteria(), serdes.Entity)
+	require.NoError(t, err)
+	acquired, err := leases.TryAcquire("batch_job/n1/job1", "other", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	// Job is left to its runner until the lease expires
+	require.NoError(t, runner.Reconcile(context.Background()))
+	assert.Equal(t, models.BatchCommandJobStatusStateRunning, loadJob(t, "job1").Status.State)
+
+	clock.SetAndFreezeClock(t, now.Add(61*time.Second))
+	require.NoError(t, runner.Reconcile(context.Background()))
+	job = loadJob(t, "job1")
+	assert.Equal(t, models.BatchCommandJobStatusStateCompleted, job.Status.State)
+	assert.Equal(t, "job was interrupted before all gateways finished", job.Status.Message)
+	assert.Equal(t, int64(1), job.Status.Failed)
+	assert.Equal(t, models.BatchCommandResultStateSucceeded, job.Results["g1"].State)
+	assert.Equal(t, models.BatchCommandResultStateFailed, job.Results["g2"].State)
+}
+
+func newLeaseStore(t *testing.T) lease.Store {
+	return lease.NewBlobstoreStore(orc8r_test_utils.NewSQLBlobstore(t, lease.LeaseTableBlobstore))
+}
+
+func setupGateways(t *testing.T) {
+	test_init.StartTestService(t)
+	test_utils.RegisterNetwork(t, networkID, "network 1")
+	labels := map[string]map[string]string{
+		"g1": {"region": "west"},
+		"g2": {"region": "east"},
+		"g3": {"region": "east", "site": "a"},
+		"g4": {"region": "west", "site": "b"},
+		"g5": nil,
+	}
+	for gatewayID, gatewayLabels := range labels {
+		_, err := configurator.CreateEntity(context.Background(), networkID, configurator.NetworkEntity{
+			Type:   orc8r.MagmadGatewayType,
+			Key:    gatewayID,
+			Config: &models.MagmadGatewayConfigs{Labels: gatewayLabels},
+		}, serdes.Entity)
+		require.NoError(t, err)
+	}
+	version := models.TierVersion("1")
+	_, err := configurator.CreateEntity(context.Background(), networkID, configurator.NetworkEntity{
+		Type: orc8r.UpgradeTierEntityType,
+		Key:  "t1",
+		Config: &models.Tier{
+			ID:       "t1",
+			Version:  &version,
+			Images:   models.TierImages{},
+			Gateways: models.TierGateways{"g1", "g2", "g3"},
+		},
+		Associations: storage.TKs{
+			{Type: orc8r.MagmadGatewayType, Key: "g1"},
+			{Type: orc8r.MagmadGatewayType, Key: "g2"},
+			{Type: orc8r.MagmadGatewayType, Key: "g3"},
+		},
+	}, serdes.Entity)
+	require.NoError(t, err)
+}
+
+func createJob(t *testing.T, job *models.BatchCommandJob) {
+	job.Status = &models.BatchCommandJobStatus{State: models.BatchCommandJobStatusStatePending}
+	_, err := configurator.CreateEntity(context.Background(), networkID, job.ToNetworkEntity(), serdes.Entity)
+	require.NoError(t, err)
+}
+
+func loadJob(t *testing.T, jobID string) *models.BatchCommandJob {
+	config, err := configurator.LoadEntityConfig(context.Background(), networkID, orc8r.BatchCommandJobEntityType, jobID, serdes.Entity)
+	require.NoError(t, err)
+	return config.(*models.BatchCommandJob)
+}
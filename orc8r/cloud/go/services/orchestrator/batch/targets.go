@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+)
+
+// ResolveTargets returns sorted IDs of the network's gateways matching
+// all conditions of the target.
+func ResolveTargets(ctx context.Context, networkID string, target *models.BatchCommandTarget) ([]string, error) {
+	if target == nil {
+		target = &models.BatchCommandTarget{}
+	}
+	var tierGateways map[string]bool
+	if target.Tier != "" {
+		tier, err := configurator.LoadEntity(
+			ctx,
+			networkID, orc8r.UpgradeTierEntityType, string(target.Tier),
+			configurator.EntityLoadCriteria{LoadAssocsFromThis: true},
+			serdes.Entity,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("load tier %s: %w", target.Tier, err)
+		}
+		tierGateways = map[string]bool{}
+		for _, tk := range tier.Associations.Filter(orc8r.MagmadGatewayType) {
+			tierGateways[tk.Key] = true
+		}
+	}
+	var selectedGateways map[string]bool
+	if len(target.GatewayIds) > 0 {
+		selectedGateways = map[string]bool{}
+		for _, gatewayID := range target.GatewayIds {
+			selectedGateways[string(gatewayID)] = true
+		}
+	}
+
+	var ret []string
+	criteria := configurator.EntityLoadCriteria{LoadConfig: true}
+	for {
+		gateways, nextPageToken, err := configurator.LoadAllEntitiesOfType(ctx, networkID, orc8r.MagmadGatewayType, criteria, serdes.Entity)
+		if err != nil {
+			return nil, fmt.Errorf("load gateways: %w", err)
+		}
+		for _, gateway := range gateways {
+			if tierGateways != nil && !tierGateways[gateway.Key] {
+				continue
+			}
+			if selectedGateways != nil && !selectedGateways[gateway.Key] {
+				continue
+			}
+			if !matchLabels(gateway.Config, target.Labels) {
+				continue
+			}
+			ret = append(ret, gateway.Key)
+		}
+		if nextPageToken == "" {
+			break
+		}
+		criteria.PageToken = nextPageToken
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func matchLabels(config interface{}, selector map[string]string) bool {
+	if len(selector) == 0 {
+		return true
+	}
+	magmadConfig, ok := config.(*models.MagmadGatewayConfigs)
+	if !ok {
+		return false
+	}
+	for key, value := range selector {
+		if actual, exists := magmadConfig.Labels[key]; !exists || actual != value {
+			return false
+		}
+	}
+	return true
+}
@@ -14,6 +14,8 @@
 package orchestrator
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/services/analytics/calculations"
 )
 
@@ -23,4 +25,44 @@ type Config struct {
 	PrometheusGRPCPushAddress string                       `yaml:"prometheusGRPCPushAddress"`
 	PrometheusPushAddresses   []string                     `yaml:"prometheusPushAddresses"`
 	Analytics                 calculations.AnalyticsConfig `yaml:"analytics"`
+	Rollout                   RolloutConfig                `yaml:"rollout"`
+	BatchCommands             BatchCommandsConfig          `yaml:"batchCommands"`
+}
+
+// RolloutConfig configures the controller of gateway software rollouts
+type RolloutConfig struct {
+	ReconcileIntervalSec int `yaml:"reconcileIntervalSec"`
+}
+
+// GetReconcileInterval returns how often rollouts are reconciled,
+// a minute by default.
+func (c RolloutConfig) GetReconcileInterval() time.Duration {
+	if c.ReconcileIntervalSec <= 0 {
+		return time.Minute
+	}
+	return time.Duration(c.ReconcileIntervalSec) * time.Second
+}
+
+// BatchCommandsConfig configures the runner of batch gateway commands
+type BatchCommandsConfig struct {
+	PollIntervalSec     int `yaml:"pollIntervalSec"`
+	ProgressIntervalSec int `yaml:"progressIntervalSec"`
+}
+
+// GetPollInterval returns how often pending jobs are picked up,
+// 5 seconds by default.
+func (c BatchCommandsConfig) GetPollInterval() time.Duration {
+	if c.PollIntervalSec <= 0 {
+		return 5 * time.Second
+	}
+	return time.Duration(c.PollIntervalSec) * time.Second
+}
+
+// GetProgressInterval returns how often progress of running jobs is stored
+// and their leases renewed, 10 seconds by default.
+func (c BatchCommandsConfig) GetProgressInterval() time.Duration {
+	if c.ProgressIntervalSec <= 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(c.ProgressIntervalSec) * time.Second
 }
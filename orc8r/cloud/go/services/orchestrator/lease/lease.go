@@ -0,0 +1,37 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lease grants time-limited exclusive leases on named resources,
+// so that only one orchestrator replica works on a resource at a time.
+package lease
+
+import (
+	"time"
+)
+
+const (
+	// LeaseTableBlobstore is the table of the orchestrator leases blobstore
+	LeaseTableBlobstore = "orchestrator_lease_blobstore"
+)
+
+// Store holds leases, which are owned by a single owner until they expire
+// or are released.
+type Store interface {
+	// TryAcquire takes the lease for the owner or renews it when the owner
+	// already holds it. Returns false when another owner holds the lease
+	// and it has not expired yet.
+	TryAcquire(name string, owner string, ttl time.Duration) (bool, error)
+
+	// Release gives up the lease. Leases held by other owners are kept.
+	Release(name string, owner string) error
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lease
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/lib/go/merrors"
+)
+
+const (
+	leaseType = "lease"
+
+	// Blobstore needs a network ID, but leases are network-agnostic so we
+	// will use a placeholder value.
+	placeholderNetworkID = "placeholder_network"
+)
+
+type leaseBlobstore struct {
+	factory blobstore.StoreFactory
+}
+
+type leaseRecord struct {
+	Owner     string    `json:"owner"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewBlobstoreStore returns a lease store backed by the blobstore.
+func NewBlobstoreStore(factory blobstore.StoreFactory) Store {
+	return &leaseBlobstore{factory: factory}
+}
+
+func (l *leaseBlobstore) TryAcquire(name string, owner string, ttl time.Duration) (bool, error) {
+	store, err := l.factory.StartTransaction(&storage.TxOptions{Isolation: storage.LevelSerializable})
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer store.Rollback()
+
+	now := clock.Now()
+	current, err := getLease(store, name)
+	if err != nil && err != merrors.ErrNotFound {
+		return false, err
+	}
+	if err == nil && current.Owner != owner && now.Before(current.ExpiresAt) {
+		return false, nil
+	}
+
+	value, err := json.Marshal(leaseRecord{Owner: owner, ExpiresAt: now.Add(ttl)})
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal lease: %w", err)
+	}
+	err = store.Write(placeholderNetworkID, blobstore.Blobs{{Type: leaseType, Key: name, Value: value}})
+	if err != nil {
+		return false, fmt.Errorf("failed to write lease %s: %w", name, err)
+	}
+	return true, store.Commit()
+}
+
+func (l *leaseBlobstore) Release(name string, owner string) error {
+	store, err := l.factory.StartTransaction(&storage.TxOptions{Isolation: storage.LevelSerializable})
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer store.Rollback()
+
+	current, err := getLease(store, name)
+	if err == merrors.ErrNotFound {
+		return store.Commit()
+	}
+	if err != nil {
+		return err
+	}
+	if current.Owner != owner {
+		return store.Commit()
+	}
+	err = store.Delete(placeholderNetworkID, storage.TKs{{Type: leaseType, Key: name}})
+	if err != nil {
+		return fmt.Errorf("failed to delete lease %s: %w", name, err)
+	}
+	return store.Commit()
+}
+
+func getLease(store blobstore.Store, name string) (*leaseRecord, error) {
+	blob, err := store.Get(placeholderNetworkID, storage.TK{Type: leaseType, Key: name})
+	if err != nil {
+		return nil, err
+	}
+	record := &leaseRecord{}
+	if err := json.Unmarshal(blob.Value, record); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal lease %s: %w", name, err)
+	}
+	return record, nil
+}
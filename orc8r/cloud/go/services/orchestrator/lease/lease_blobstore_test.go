@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lease_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/services/orchestrator/lease"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+func TestLeaseBlobstore(t *testing.T) {
+	now := time.Unix(1e9, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	store := lease.NewBlobstoreStore(test_utils.NewSQLBlobstore(t, lease.LeaseTableBlobstore))
+
+	acquired, err := store.TryAcquire("l1", "a", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	// Held by another owner
+	acquired, err = store.TryAcquire("l1", "b", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	// Leases are independent
+	acquired, err = store.TryAcquire("l2", "b", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	// Renewed by the owner
+	clock.SetAndFreezeClock(t, now.Add(50*time.Second))
+	acquired, err = store.TryAcquire("l1", "a", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	clock.SetAndFreezeClock(t, now.Add(90*time.Second))
+	acquired, err = store.TryAcquire("l1", "b", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	// Taken over after expiration
+	clock.SetAndFreezeClock(t, now.Add(2*time.Minute))
+	acquired, err = store.TryAcquire("l1", "b", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	// Only the owner releases the lease
+	assert.NoError(t, store.Release("l1", "a"))
+	acquired, err = store.TryAcquire("l1", "a", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+	assert.NoError(t, store.Release("l1", "b"))
+	acquired, err = store.TryAcquire("l1", "a", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	assert.NoError(t, store.Release("unknown", "a"))
+}
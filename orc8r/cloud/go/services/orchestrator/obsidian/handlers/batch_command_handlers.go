@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/labstack/echo/v4"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/obsidian"
+	"magma/orc8r/cloud/go/services/orchestrator/batch"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/lib/go/merrors"
+)
+
+// listBatchCommandJobsHandler returns progress of the network's jobs,
+// per gateway results are only returned by getBatchCommandResultsHandler.
+func listBatchCommandJobsHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	jobs, err := batch.LoadJobs(c.Request().Context(), networkID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	ret := []*models.BatchCommandJob{}
+	for _, job := range jobs {
+		job.Results = nil
+		ret = append(ret, job)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+// createBatchCommandJobHandler stores the job as pending, it is started by
+// the batch command runner of the orchestrator.
+func createBatchCommandJobHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	payload, nerr := GetAndValidatePayload(c, &models.BatchCommandJob{})
+	if nerr != nil {
+		return nerr
+	}
+	job := payload.(*models.BatchCommandJob)
+	reqCtx := c.Request().Context()
+
+	if job.Target != nil && job.Target.Tier != "" {
+		exists, err := configurator.DoesEntityExist(reqCtx, networkID, orc8r.UpgradeTierEntityType, string(job.Target.Tier))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		if !exists {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("tier %s does not exist", job.Target.Tier))
+		}
+	}
+
+	job.Status = &models.BatchCommandJobStatus{
+		State:     models.BatchCommandJobStatusStatePending,
+		CreatedAt: strfmt.DateTime(clock.Now()),
+	}
+	job.Results = nil
+	_, err := configurator.CreateEntity(reqCtx, networkID, job.ToNetworkEntity(), serdes.Entity)
+	if err != nil {
+		return obsidian.MakeHTTPError(err, http.StatusInternalServerError)
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func readBatchCommandJobHandler(c echo.Context) error {
+	networkID, jobID, nerr := getNetworkAndJobIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	job, nerr := loadBatchCommandJob(c, networkID, jobID)
+	if nerr != nil {
+		return nerr
+	}
+	job.Results = nil
+	return c.JSON(http.StatusOK, job)
+}
+
+// deleteBatchCommandJobHandler removes the job. A running job stops
+// sending the command to further gateways when its progress is stored next.
+func deleteBatchCommandJobHandler(c echo.Context) error {
+	networkID, jobID, nerr := getNetworkAndJobIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := configurator.DeleteEntity(c.Request().Context(), networkID, orc8r.BatchCommandJobEntityType, jobID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func getBatchCommandResultsHandler(c echo.Context) error {
+	networkID, jobID, nerr := getNetworkAndJobIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	job, nerr := loadBatchCommandJob(c, networkID, jobID)
+	if nerr != nil {
+		return nerr
+	}
+	state := c.QueryParam("state")
+	ret := models.BatchCommandResults{}
+	for gatewayID, result := range job.Results {
+		if state == "" || result.State == state {
+			ret[gatewayID] = result
+		}
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func loadBatchCommandJob(c echo.Context, networkID string, jobID string) (*models.BatchCommandJob, *echo.HTTPError) {
+	entity, err := configurator.LoadEntity(
+		c.Request().Context(),
+		networkID, orc8r.BatchCommandJobEntityType, jobID,
+		configurator.EntityLoadCriteria{LoadConfig: true},
+		serdes.Entity,
+	)
+	if err == merrors.ErrNotFound {
+		return nil, echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return entity.Config.(*models.BatchCommandJob), nil
+}
+
+func getNetworkAndJobIDs(c echo.Context) (string, string, *echo.HTTPError) {
+	vals, err := obsidian.GetParamValues(c, "network_id", "job_id")
+	if err != nil {
+		return "", "", err
+	}
+	return vals[0], vals[1], nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/configurator/test_utils"
+	"magma/orc8r/cloud/go/services/obsidian"
+	"magma/orc8r/cloud/go/services/obsidian/tests"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+)
+
+func TestBatchCommandJobs(t *testing.T) {
+	test_init.StartTestService(t)
+	now := time.Unix(1e9, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	e := echo.New()
+	listJobsURL := "/magma/v1/networks/:network_id/batch_commands"
+	manageJobURL := listJobsURL + "/:job_id"
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	listJobs := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, listJobsURL, obsidian.GET).HandlerFunc
+	createJob := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, listJobsURL, obsidian.POST).HandlerFunc
+	getJob := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageJobURL, obsidian.GET).HandlerFunc
+	deleteJob := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageJobURL, obsidian.DELETE).HandlerFunc
+	getResults := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageJobURL+"/results", obsidian.GET).HandlerFunc
+
+	test_utils.RegisterNetwork(t, "n1", "network 1")
+
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/batch_commands",
+		Handler:        listJobs,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.BatchCommandJob{}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Restart without services
+	job := &models.BatchCommandJob{
+		ID:      "restart_sessiond",
+		Command: &models.BatchCommand{Type: swag.String(models.BatchCommandTypeRestartServices)},
+		Target:  &models.BatchCommandTarget{Tier: "t1"},
+	}
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/batch_commands",
+		Payload:        job,
+		Handler:        createJob,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 400,
+		ExpectedError:  "services to restart have to be provided",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Tail logs without params
+	tailLogsJob := &models.BatchCommandJob{
+		ID:      "tail_logs",
+		Command: &models.BatchCommand{Type: swag.String(models.BatchCommandTypeTailLogs)},
+	}
+	tailLogsTc := tc
+	tailLogsTc.Payload = tailLogsJob
+	tailLogsTc.ExpectedError = "tail logs params have to be provided"
+	tests.RunUnitTest(t, e, tailLogsTc)
+
+	tailLogsJob.Command.TailLogs = &models.BatchTailLogsParams{Service: swag.String("mme"), MaxLines: 100000}
+	tailLogsTc.ExpectedError = "command.tail_logs.max_lines in body should be less than or equal to 10000"
+	tests.RunUnitTest(t, e, tailLogsTc)
+
+	// Unknown tier
+	job.Command.Services = []string{"sessiond"}
+	tc.ExpectedError = "tier t1 does not exist"
+	tests.RunUnitTest(t, e, tc)
+
+	// Happy path
+	job.Target = &models.BatchCommandTarget{Labels: map[string]string{"region": "west"}}
+	tc.ExpectedStatus = 201
+	tc.ExpectedError = ""
+	tests.RunUnitTest(t, e, tc)
+
+	expected := &models.BatchCommandJob{
+		ID:      "restart_sessiond",
+		Command: job.Command,
+		Target:  job.Target,
+		Status: &models.BatchCommandJobStatus{
+			State:     models.BatchCommandJobStatusStatePending,
+			CreatedAt: strfmt.DateTime(now),
+		},
+	}
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/batch_commands/restart_sessiond",
+		Handler:        getJob,
+		ParamNames:     []string{"network_id", "job_id"},
+		ParamValues:    []string{"n1", "restart_sessiond"},
+		ExpectedStatus: 200,
+		ExpectedResult: expected,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Results are only returned by the results endpoint
+	expected.Status = &models.BatchCommandJobStatus{
+		State:     models.BatchCommandJobStatusStateCompleted,
+		CreatedAt: strfmt.DateTime(now),
+		Total:     2,
+		Succeeded: 1,
+		Failed:    1,
+	}
+	expected.Results = models.BatchCommandResults{
+		"g1": {State: models.BatchCommandResultStateSucceeded},
+		"g2": {State: models.BatchCommandResultStateTimedOut, Error: "command did not finish within 1m0s"},
+	}
+	_, err := configurator.UpdateEntity(context.Background(), "n1", expected.ToUpdateCriteria(), serdes.Entity)
+	require.NoError(t, err)
+	results := expected.Results
+	expected.Results = nil
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/batch_commands",
+		Handler:        listJobs,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.BatchCommandJob{expected}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/batch_commands/restart_sessiond/results",
+		Handler:        getResults,
+		ParamNames:     []string{"network_id", "job_id"},
+		ParamValues:    []string{"n1", "restart_sessiond"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler(results),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL += "?state=timed_out"
+	tc.ExpectedResult = tests.JSONMarshaler(models.BatchCommandResults{"g2": results["g2"]})
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "DELETE",
+		URL:            "/magma/v1/networks/n1/batch_commands/restart_sessiond",
+		Handler:        deleteJob,
+		ParamNames:     []string{"network_id", "job_id"},
+		ParamValues:    []string{"n1", "restart_sessiond"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/batch_commands/restart_sessiond",
+		Handler:        getJob,
+		ParamNames:     []string{"network_id", "job_id"},
+		ParamValues:    []string{"n1", "restart_sessiond"},
+		ExpectedStatus: 404,
+		ExpectedError:  "Not found",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Creating a job for a tier
+	version := models.TierVersion("1")
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{
+		Type:   orc8r.UpgradeTierEntityType,
+		Key:    "t1",
+		Config: &models.Tier{ID: "t1", Version: &version, Images: models.TierImages{}},
+	}, serdes.Entity)
+	require.NoError(t, err)
+	job.Target = &models.BatchCommandTarget{Tier: "t1"}
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/batch_commands",
+		Payload:        job,
+		Handler:        createJob,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 201,
+	}
+	tests.RunUnitTest(t, e, tc)
+}
@@ -36,6 +36,11 @@ const (
 	GatewayPingV1           = CommandRootV1 + "/ping"
 	GatewayGenericCommandV1 = CommandRootV1 + "/generic"
 	TailGatewayLogsV1       = CommandRootV1 + "/tail_logs"
+
+	BatchCommands              = "batch_commands"
+	ListBatchCommandJobsPath   = ManageNetworkPath + obsidian.UrlSep + BatchCommands
+	ManageBatchCommandJobPath  = ListBatchCommandJobsPath + obsidian.UrlSep + ":job_id"
+	BatchCommandJobResultsPath = ManageBatchCommandJobPath + obsidian.UrlSep + "results"
 )
 
 func rebootGateway(c echo.Context) error {
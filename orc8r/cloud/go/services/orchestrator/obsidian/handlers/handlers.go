@@ -63,6 +63,12 @@ const (
 	ManageTierGatewaysPath = ManageTiersPath + obsidian.UrlSep + "gateways"
 	ManageTierGatewayPath  = ManageTierGatewaysPath + obsidian.UrlSep + ":gateway_id"
 
+	Rollouts          = "rollouts"
+	ListRolloutsPath  = ManageNetworkPath + obsidian.UrlSep + Rollouts
+	ManageRolloutPath = ListRolloutsPath + obsidian.UrlSep + ":rollout_id"
+	PauseRolloutPath  = ManageRolloutPath + obsidian.UrlSep + "pause"
+	ResumeRolloutPath = ManageRolloutPath + obsidian.UrlSep + "resume"
+
 	About          = "about"
 	Version        = "version"
 	GetVersionPath = obsidian.V1Root + About + obsidian.UrlSep + Version
@@ -106,6 +112,12 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: ManageTierImagePath, Methods: obsidian.DELETE, HandlerFunc: deleteImage},
 		{Path: ManageTierGatewaysPath, Methods: obsidian.POST, HandlerFunc: createTierGateway},
 		{Path: ManageTierGatewayPath, Methods: obsidian.DELETE, HandlerFunc: deleteTierGateway},
+		{Path: ListRolloutsPath, Methods: obsidian.GET, HandlerFunc: listRolloutsHandler},
+		{Path: ListRolloutsPath, Methods: obsidian.POST, HandlerFunc: createRolloutHandler},
+		{Path: ManageRolloutPath, Methods: obsidian.GET, HandlerFunc: readRolloutHandler},
+		{Path: ManageRolloutPath, Methods: obsidian.DELETE, HandlerFunc: deleteRolloutHandler},
+		{Path: PauseRolloutPath, Methods: obsidian.POST, HandlerFunc: pauseRolloutHandler},
+		{Path: ResumeRolloutPath, Methods: obsidian.POST, HandlerFunc: resumeRolloutHandler},
 
 		// Magmad commands
 		{Path: RebootGatewayV1, Methods: obsidian.POST, HandlerFunc: rebootGateway},
@@ -113,6 +125,11 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: GatewayPingV1, Methods: obsidian.POST, HandlerFunc: gatewayPing},
 		{Path: GatewayGenericCommandV1, Methods: obsidian.POST, HandlerFunc: gatewayGenericCommand},
 		{Path: TailGatewayLogsV1, Methods: obsidian.POST, HandlerFunc: tailGatewayLogs},
+		{Path: ListBatchCommandJobsPath, Methods: obsidian.GET, HandlerFunc: listBatchCommandJobsHandler},
+		{Path: ListBatchCommandJobsPath, Methods: obsidian.POST, HandlerFunc: createBatchCommandJobHandler},
+		{Path: ManageBatchCommandJobPath, Methods: obsidian.GET, HandlerFunc: readBatchCommandJobHandler},
+		{Path: ManageBatchCommandJobPath, Methods: obsidian.DELETE, HandlerFunc: deleteBatchCommandJobHandler},
+		{Path: BatchCommandJobResultsPath, Methods: obsidian.GET, HandlerFunc: getBatchCommandResultsHandler},
 
 		// Version Info
 		{Path: GetVersionPath, Methods: obsidian.GET, HandlerFunc: getVersionHandler},
@@ -0,0 +1,235 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/labstack/echo/v4"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/obsidian"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/services/orchestrator/rollout"
+	"magma/orc8r/lib/go/merrors"
+)
+
+func listRolloutsHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	rollouts, err := rollout.LoadRollouts(c.Request().Context(), networkID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if rollouts == nil {
+		rollouts = []*models.Rollout{}
+	}
+	return c.JSON(http.StatusOK, rollouts)
+}
+
+func createRolloutHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	payload, nerr := GetAndValidatePayload(c, &models.Rollout{})
+	if nerr != nil {
+		return nerr
+	}
+	r := payload.(*models.Rollout)
+	reqCtx := c.Request().Context()
+
+	for _, step := range r.Steps {
+		exists, err := configurator.DoesEntityExist(reqCtx, networkID, orc8r.UpgradeTierEntityType, string(step.Tier))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		if !exists {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("tier %s does not exist", step.Tier))
+		}
+	}
+	existing, err := rollout.LoadRollouts(reqCtx, networkID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	tiers := getRolloutTiers(r)
+	for _, other := range existing {
+		if !other.IsActive() {
+			continue
+		}
+		for _, step := range other.Steps {
+			if tiers[string(step.Tier)] {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("tier %s is part of active rollout %s", step.Tier, other.ID))
+			}
+		}
+	}
+
+	r.Status = &models.RolloutStatus{State: models.RolloutStatusStateRunning}
+	_, err = configurator.CreateEntity(reqCtx, networkID, r.ToNetworkEntity(), serdes.Entity)
+	if err != nil {
+		return obsidian.MakeHTTPError(err, http.StatusInternalServerError)
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func readRolloutHandler(c echo.Context) error {
+	networkID, rolloutID, nerr := getNetworkAndRolloutIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	r, _, nerr := loadRollout(c, networkID, rolloutID)
+	if nerr != nil {
+		return nerr
+	}
+	return c.JSON(http.StatusOK, r)
+}
+
+// deleteRolloutHandler removes the rollout. An active rollout is paused
+// first, so that the controller leaves it alone, and its tiers are rolled
+// back to their previous versions.
+func deleteRolloutHandler(c echo.Context) error {
+	networkID, rolloutID, nerr := getNetworkAndRolloutIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	r, version, nerr := loadRollout(c, networkID, rolloutID)
+	if nerr != nil {
+		return nerr
+	}
+	reqCtx := c.Request().Context()
+	if r.IsActive() {
+		if r.Status.State == models.RolloutStatusStateRunning {
+			r.Status.State = models.RolloutStatusStatePaused
+			r.Status.Message = "deleted by user"
+			if nerr := saveRollout(c, networkID, r, version); nerr != nil {
+				return nerr
+			}
+		}
+		if err := rollout.Rollback(reqCtx, networkID, r); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+	err := configurator.DeleteEntity(reqCtx, networkID, orc8r.UpgradeRolloutEntityType, rolloutID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func pauseRolloutHandler(c echo.Context) error {
+	networkID, rolloutID, nerr := getNetworkAndRolloutIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	r, version, nerr := loadRollout(c, networkID, rolloutID)
+	if nerr != nil {
+		return nerr
+	}
+	if r.Status.State != models.RolloutStatusStateRunning {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("rollout is %s", r.Status.State))
+	}
+	r.Status.State = models.RolloutStatusStatePaused
+	r.Status.Message = "paused by user"
+	return updateRollout(c, networkID, r, version)
+}
+
+// resumeRolloutHandler continues the paused rollout. Grace period and bake
+// time of an already applied step start over.
+func resumeRolloutHandler(c echo.Context) error {
+	networkID, rolloutID, nerr := getNetworkAndRolloutIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	r, version, nerr := loadRollout(c, networkID, rolloutID)
+	if nerr != nil {
+		return nerr
+	}
+	if r.Status.State != models.RolloutStatusStatePaused {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("rollout is %s", r.Status.State))
+	}
+	r.Status.State = models.RolloutStatusStateRunning
+	r.Status.Message = ""
+	if !time.Time(r.Status.StepStartedAt).IsZero() {
+		r.Status.StepStartedAt = strfmt.DateTime(clock.Now())
+	}
+	return updateRollout(c, networkID, r, version)
+}
+
+// loadRollout returns the rollout with the version of its entity, which
+// updates are conditional on.
+func loadRollout(c echo.Context, networkID string, rolloutID string) (*models.Rollout, uint64, *echo.HTTPError) {
+	entity, err := configurator.LoadEntity(
+		c.Request().Context(),
+		networkID, orc8r.UpgradeRolloutEntityType, rolloutID,
+		configurator.EntityLoadCriteria{LoadConfig: true},
+		serdes.Entity,
+	)
+	if err == merrors.ErrNotFound {
+		return nil, 0, echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+	if err != nil {
+		return nil, 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	r := entity.Config.(*models.Rollout)
+	if r.Status == nil {
+		r.Status = &models.RolloutStatus{}
+	}
+	return r, entity.Version, nil
+}
+
+func updateRollout(c echo.Context, networkID string, r *models.Rollout, version uint64) error {
+	if nerr := saveRollout(c, networkID, r, version); nerr != nil {
+		return nerr
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// saveRollout stores the rollout loaded at version. Conflict is returned
+// when the rollout was changed meanwhile, e.g. by the rollout controller.
+func saveRollout(c echo.Context, networkID string, r *models.Rollout, version uint64) *echo.HTTPError {
+	update := r.ToUpdateCriteria()
+	update.ExpectedVersion = &version
+	_, err := configurator.UpdateEntity(c.Request().Context(), networkID, update, serdes.Entity)
+	if err == configurator.ErrVersionMismatch {
+		return echo.NewHTTPError(http.StatusConflict, "rollout was changed concurrently, try again")
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return nil
+}
+
+func getRolloutTiers(r *models.Rollout) map[string]bool {
+	ret := map[string]bool{}
+	for _, step := range r.Steps {
+		ret[string(step.Tier)] = true
+	}
+	return ret
+}
+
+func getNetworkAndRolloutIDs(c echo.Context) (string, string, *echo.HTTPError) {
+	vals, err := obsidian.GetParamValues(c, "network_id", "rollout_id")
+	if err != nil {
+		return "", "", err
+	}
+	return vals[0], vals[1], nil
+}
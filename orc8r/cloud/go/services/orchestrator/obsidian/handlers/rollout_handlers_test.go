@@ -0,0 +1,237 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/configurator/test_utils"
+	"magma/orc8r/cloud/go/services/obsidian"
+	"magma/orc8r/cloud/go/services/obsidian/tests"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/storage"
+)
+
+func TestRollouts(t *testing.T) {
+	test_init.StartTestService(t)
+	now := time.Unix(1e9, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	e := echo.New()
+	listRolloutsURL := "/magma/v1/networks/:network_id/rollouts"
+	manageRolloutURL := listRolloutsURL + "/:rollout_id"
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	listRollouts := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, listRolloutsURL, obsidian.GET).HandlerFunc
+	createRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, listRolloutsURL, obsidian.POST).HandlerFunc
+	getRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageRolloutURL, obsidian.GET).HandlerFunc
+	deleteRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageRolloutURL, obsidian.DELETE).HandlerFunc
+	pauseRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageRolloutURL+"/pause", obsidian.POST).HandlerFunc
+	resumeRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageRolloutURL+"/resume", obsidian.POST).HandlerFunc
+
+	test_utils.RegisterNetwork(t, "n1", "network 1")
+	test_utils.RegisterGateway(t, "n1", "g1", nil)
+	test_utils.RegisterGateway(t, "n1", "g2", nil)
+	version := models.TierVersion("1")
+	_, err := configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{
+		Type: orc8r.UpgradeTierEntityType, Key: "t1",
+		Config: &models.Tier{
+			ID:       "t1",
+			Version:  &version,
+			Images:   models.TierImages{},
+			Gateways: models.TierGateways{"g1", "g2"},
+		},
+		Associations: storage.TKs{{Type: orc8r.MagmadGatewayType, Key: "g1"}, {Type: orc8r.MagmadGatewayType, Key: "g2"}},
+	}, serdes.Entity)
+	require.NoError(t, err)
+
+	// List rollouts when none exist
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/rollouts",
+		Handler:        listRollouts,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.Rollout{}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Percentage of the tier's last step is not 100
+	newVersion := models.TierVersion("2")
+	rollout := &models.Rollout{
+		ID:      "r1",
+		Version: &newVersion,
+		Steps:   []*models.RolloutStep{{Tier: "t1", Percentage: 50}},
+	}
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/rollouts",
+		Payload:        rollout,
+		Handler:        createRollout,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 400,
+		ExpectedError:  "tier t1 has to be fully rolled out by the last of its steps",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Unknown tier
+	rollout.Steps = append(rollout.Steps, &models.RolloutStep{Tier: "t1"}, &models.RolloutStep{Tier: "t2"})
+	tc.ExpectedError = "tier t2 does not exist"
+	tests.RunUnitTest(t, e, tc)
+
+	// Happy path
+	rollout.Steps = rollout.Steps[:2]
+	tc.ExpectedStatus = 201
+	tc.ExpectedError = ""
+	tests.RunUnitTest(t, e, tc)
+
+	expected := &models.Rollout{
+		ID:      "r1",
+		Version: &newVersion,
+		Steps:   rollout.Steps,
+		Status:  &models.RolloutStatus{State: models.RolloutStatusStateRunning},
+	}
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/rollouts/r1",
+		Handler:        getRollout,
+		ParamNames:     []string{"network_id", "rollout_id"},
+		ParamValues:    []string{"n1", "r1"},
+		ExpectedStatus: 200,
+		ExpectedResult: expected,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/rollouts",
+		Handler:        listRollouts,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.Rollout{expected}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Tier is already part of an active rollout
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/rollouts",
+		Payload:        &models.Rollout{ID: "r2", Version: &newVersion, Steps: []*models.RolloutStep{{Tier: "t1"}}},
+		Handler:        createRollout,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 400,
+		ExpectedError:  "tier t1 is part of active rollout r1",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Resuming a running rollout fails
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/rollouts/r1/resume",
+		Handler:        resumeRollout,
+		ParamNames:     []string{"network_id", "rollout_id"},
+		ParamValues:    []string{"n1", "r1"},
+		ExpectedStatus: 400,
+		ExpectedError:  "rollout is running",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/rollouts/r1/pause",
+		Handler:        pauseRollout,
+		ParamNames:     []string{"network_id", "rollout_id"},
+		ParamValues:    []string{"n1", "r1"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+	r := loadRolloutConfig(t, "r1")
+	assert.Equal(t, models.RolloutStatusStatePaused, r.Status.State)
+	assert.Equal(t, "paused by user", r.Status.Message)
+
+	// Resume restarts bake time of the applied step
+	r.Status.StepStartedAt = strfmt.DateTime(now.Add(-time.Hour))
+	_, err = configurator.UpdateEntity(context.Background(), "n1", r.ToUpdateCriteria(), serdes.Entity)
+	require.NoError(t, err)
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/rollouts/r1/resume",
+		Handler:        resumeRollout,
+		ParamNames:     []string{"network_id", "rollout_id"},
+		ParamValues:    []string{"n1", "r1"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+	r = loadRolloutConfig(t, "r1")
+	assert.Equal(t, models.RolloutStatusStateRunning, r.Status.State)
+	assert.Empty(t, r.Status.Message)
+	assert.True(t, now.Equal(time.Time(r.Status.StepStartedAt)))
+
+	// Deleting an active rollout restores the tier
+	_, err = configurator.UpdateEntity(context.Background(), "n1", configurator.EntityUpdateCriteria{
+		Type: orc8r.UpgradeTierEntityType, Key: "t1",
+		NewConfig: &models.Tier{ID: "t1", Version: &newVersion, Images: models.TierImages{}},
+	}, serdes.Entity)
+	require.NoError(t, err)
+	r.Status.PreviousVersions = map[string]string{"t1": "1"}
+	_, err = configurator.UpdateEntity(context.Background(), "n1", r.ToUpdateCriteria(), serdes.Entity)
+	require.NoError(t, err)
+
+	tc = tests.Test{
+		Method:         "DELETE",
+		URL:            "/magma/v1/networks/n1/rollouts/r1",
+		Handler:        deleteRollout,
+		ParamNames:     []string{"network_id", "rollout_id"},
+		ParamValues:    []string{"n1", "r1"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+	tier, err := configurator.LoadEntityConfig(context.Background(), "n1", orc8r.UpgradeTierEntityType, "t1", serdes.Entity)
+	require.NoError(t, err)
+	assert.Equal(t, models.TierVersion("1"), *tier.(*models.Tier).Version)
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/rollouts/r1",
+		Handler:        getRollout,
+		ParamNames:     []string{"network_id", "rollout_id"},
+		ParamValues:    []string{"n1", "r1"},
+		ExpectedStatus: 404,
+		ExpectedError:  "Not found",
+	}
+	tests.RunUnitTest(t, e, tc)
+}
+
+func loadRolloutConfig(t *testing.T, rolloutID string) *models.Rollout {
+	config, err := configurator.LoadEntityConfig(context.Background(), "n1", orc8r.UpgradeRolloutEntityType, rolloutID, serdes.Entity)
+	require.NoError(t, err)
+	return config.(*models.Rollout)
+}
@@ -0,0 +1,39 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// BatchCommandJobID batch command job id
+// Example: restart_sessiond
+//
+// swagger:model batch_command_job_id
+type BatchCommandJobID string
+
+// Validate validates this batch command job id
+func (m BatchCommandJobID) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := validate.Pattern("", "body", string(m), `^[a-z][\da-z_]+$`); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this batch command job id based on context it is used
+func (m BatchCommandJobID) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
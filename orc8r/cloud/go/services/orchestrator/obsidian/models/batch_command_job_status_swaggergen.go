@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BatchCommandJobStatus batch command job status
+//
+// swagger:model batch_command_job_status
+type BatchCommandJobStatus struct {
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// Number of gateways where the command failed or timed out
+	Failed int64 `json:"failed,omitempty"`
+
+	// finished at
+	// Format: date-time
+	FinishedAt strfmt.DateTime `json:"finished_at,omitempty"`
+
+	// message
+	Message string `json:"message,omitempty"`
+
+	// state
+	// Enum: [pending running completed]
+	State string `json:"state,omitempty"`
+
+	// succeeded
+	Succeeded int64 `json:"succeeded,omitempty"`
+
+	// Number of targeted gateways
+	Total int64 `json:"total,omitempty"`
+}
+
+// Validate validates this batch command job status
+func (m *BatchCommandJobStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFinishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchCommandJobStatus) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJobStatus) validateFinishedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.FinishedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("finished_at", "body", "date-time", m.FinishedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var batchCommandJobStatusTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","running","completed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		batchCommandJobStatusTypeStatePropEnum = append(batchCommandJobStatusTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// BatchCommandJobStatusStatePending captures enum value "pending"
+	BatchCommandJobStatusStatePending string = "pending"
+
+	// BatchCommandJobStatusStateRunning captures enum value "running"
+	BatchCommandJobStatusStateRunning string = "running"
+
+	// BatchCommandJobStatusStateCompleted captures enum value "completed"
+	BatchCommandJobStatusStateCompleted string = "completed"
+)
+
+// prop value enum
+func (m *BatchCommandJobStatus) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, batchCommandJobStatusTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BatchCommandJobStatus) validateState(formats strfmt.Registry) error {
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this batch command job status based on context it is used
+func (m *BatchCommandJobStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchCommandJobStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchCommandJobStatus) UnmarshalBinary(b []byte) error {
+	var res BatchCommandJobStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
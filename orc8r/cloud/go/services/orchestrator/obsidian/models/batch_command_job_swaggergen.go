@@ -0,0 +1,337 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BatchCommandJob Command run on a set of gateways with limited concurrency
+//
+// swagger:model batch_command_job
+type BatchCommandJob struct {
+
+	// command
+	// Required: true
+	Command *BatchCommand `json:"command"`
+
+	// id
+	// Required: true
+	ID BatchCommandJobID `json:"id"`
+
+	// Maximum number of gateways running the command at the same time
+	// Maximum: 100
+	// Minimum: 1
+	MaxConcurrency int64 `json:"max_concurrency,omitempty"`
+
+	// results
+	Results BatchCommandResults `json:"results,omitempty"`
+
+	// status
+	Status *BatchCommandJobStatus `json:"status,omitempty"`
+
+	// target
+	Target *BatchCommandTarget `json:"target,omitempty"`
+
+	// Time after which the command on a single gateway is considered failed
+	// Maximum: 3600
+	// Minimum: 1
+	TimeoutSec int64 `json:"timeout_sec,omitempty"`
+}
+
+// Validate validates this batch command job
+func (m *BatchCommandJob) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCommand(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxConcurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResults(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTarget(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeoutSec(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchCommandJob) validateCommand(formats strfmt.Registry) error {
+
+	if err := validate.Required("command", "body", m.Command); err != nil {
+		return err
+	}
+
+	if m.Command != nil {
+		if err := m.Command.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("command")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("command")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", BatchCommandJobID(m.ID)); err != nil {
+		return err
+	}
+
+	if err := m.ID.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("id")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("id")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) validateMaxConcurrency(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxConcurrency) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_concurrency", "body", m.MaxConcurrency, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("max_concurrency", "body", m.MaxConcurrency, 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) validateResults(formats strfmt.Registry) error {
+	if swag.IsZero(m.Results) { // not required
+		return nil
+	}
+
+	if m.Results != nil {
+		if err := m.Results.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("results")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("results")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if m.Status != nil {
+		if err := m.Status.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("status")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) validateTarget(formats strfmt.Registry) error {
+	if swag.IsZero(m.Target) { // not required
+		return nil
+	}
+
+	if m.Target != nil {
+		if err := m.Target.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("target")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("target")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) validateTimeoutSec(formats strfmt.Registry) error {
+	if swag.IsZero(m.TimeoutSec) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("timeout_sec", "body", m.TimeoutSec, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("timeout_sec", "body", m.TimeoutSec, 3600, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this batch command job based on the context it is used
+func (m *BatchCommandJob) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCommand(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateResults(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTarget(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchCommandJob) contextValidateCommand(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Command != nil {
+		if err := m.Command.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("command")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("command")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.ID.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("id")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("id")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) contextValidateResults(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Results.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("results")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("results")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) contextValidateStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Status != nil {
+		if err := m.Status.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("status")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommandJob) contextValidateTarget(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Target != nil {
+		if err := m.Target.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("target")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("target")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchCommandJob) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchCommandJob) UnmarshalBinary(b []byte) error {
+	var res BatchCommandJob
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BatchCommandResult batch command result
+//
+// swagger:model batch_command_result
+type BatchCommandResult struct {
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// finished at
+	// Format: date-time
+	FinishedAt strfmt.DateTime `json:"finished_at,omitempty"`
+
+	// Response of ping, generic and tail_logs commands
+	// Example: {}
+	Output interface{} `json:"output,omitempty"`
+
+	// started at
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"started_at,omitempty"`
+
+	// state
+	// Enum: [pending succeeded failed timed_out]
+	State string `json:"state,omitempty"`
+}
+
+// Validate validates this batch command result
+func (m *BatchCommandResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFinishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchCommandResult) validateFinishedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.FinishedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("finished_at", "body", "date-time", m.FinishedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BatchCommandResult) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("started_at", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var batchCommandResultTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","succeeded","failed","timed_out"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		batchCommandResultTypeStatePropEnum = append(batchCommandResultTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// BatchCommandResultStatePending captures enum value "pending"
+	BatchCommandResultStatePending string = "pending"
+
+	// BatchCommandResultStateSucceeded captures enum value "succeeded"
+	BatchCommandResultStateSucceeded string = "succeeded"
+
+	// BatchCommandResultStateFailed captures enum value "failed"
+	BatchCommandResultStateFailed string = "failed"
+
+	// BatchCommandResultStateTimedOut captures enum value "timed_out"
+	BatchCommandResultStateTimedOut string = "timed_out"
+)
+
+// prop value enum
+func (m *BatchCommandResult) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, batchCommandResultTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BatchCommandResult) validateState(formats strfmt.Registry) error {
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this batch command result based on context it is used
+func (m *BatchCommandResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchCommandResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchCommandResult) UnmarshalBinary(b []byte) error {
+	var res BatchCommandResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
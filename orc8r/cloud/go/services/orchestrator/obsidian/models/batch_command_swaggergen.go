@@ -0,0 +1,263 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BatchCommand batch command
+//
+// swagger:model batch_command
+type BatchCommand struct {
+
+	// generic
+	Generic *GenericCommandParams `json:"generic,omitempty"`
+
+	// ping
+	Ping *PingRequest `json:"ping,omitempty"`
+
+	// Services to restart
+	// Example: ["sessiond"]
+	Services []string `json:"services"`
+
+	// tail logs
+	TailLogs *BatchTailLogsParams `json:"tail_logs,omitempty"`
+
+	// type
+	// Required: true
+	// Enum: [reboot restart_services ping generic tail_logs]
+	Type *string `json:"type"`
+}
+
+// Validate validates this batch command
+func (m *BatchCommand) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGeneric(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePing(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTailLogs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchCommand) validateGeneric(formats strfmt.Registry) error {
+	if swag.IsZero(m.Generic) { // not required
+		return nil
+	}
+
+	if m.Generic != nil {
+		if err := m.Generic.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("generic")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("generic")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommand) validatePing(formats strfmt.Registry) error {
+	if swag.IsZero(m.Ping) { // not required
+		return nil
+	}
+
+	if m.Ping != nil {
+		if err := m.Ping.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ping")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ping")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommand) validateTailLogs(formats strfmt.Registry) error {
+	if swag.IsZero(m.TailLogs) { // not required
+		return nil
+	}
+
+	if m.TailLogs != nil {
+		if err := m.TailLogs.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tail_logs")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tail_logs")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var batchCommandTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["reboot","restart_services","ping","generic","tail_logs"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		batchCommandTypeTypePropEnum = append(batchCommandTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// BatchCommandTypeReboot captures enum value "reboot"
+	BatchCommandTypeReboot string = "reboot"
+
+	// BatchCommandTypeRestartServices captures enum value "restart_services"
+	BatchCommandTypeRestartServices string = "restart_services"
+
+	// BatchCommandTypePing captures enum value "ping"
+	BatchCommandTypePing string = "ping"
+
+	// BatchCommandTypeGeneric captures enum value "generic"
+	BatchCommandTypeGeneric string = "generic"
+
+	// BatchCommandTypeTailLogs captures enum value "tail_logs"
+	BatchCommandTypeTailLogs string = "tail_logs"
+)
+
+// prop value enum
+func (m *BatchCommand) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, batchCommandTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BatchCommand) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this batch command based on the context it is used
+func (m *BatchCommand) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateGeneric(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePing(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTailLogs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchCommand) contextValidateGeneric(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Generic != nil {
+		if err := m.Generic.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("generic")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("generic")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommand) contextValidatePing(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Ping != nil {
+		if err := m.Ping.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("ping")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("ping")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchCommand) contextValidateTailLogs(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TailLogs != nil {
+		if err := m.TailLogs.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tail_logs")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tail_logs")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchCommand) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchCommand) UnmarshalBinary(b []byte) error {
+	var res BatchCommand
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	models1 "magma/orc8r/cloud/go/models"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BatchCommandTarget Gateways the command runs on, all conditions have to match. All gateways of the network are targeted when empty
+//
+// swagger:model batch_command_target
+type BatchCommandTarget struct {
+
+	// gateway ids
+	GatewayIds []models1.GatewayID `json:"gateway_ids"`
+
+	// Labels of the gateways' magmad configs
+	// Example: {"region":"west"}
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// tier
+	Tier TierID `json:"tier,omitempty"`
+}
+
+// Validate validates this batch command target
+func (m *BatchCommandTarget) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGatewayIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTier(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchCommandTarget) validateGatewayIds(formats strfmt.Registry) error {
+	if swag.IsZero(m.GatewayIds) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.GatewayIds); i++ {
+
+		if err := m.GatewayIds[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("gateway_ids" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("gateway_ids" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *BatchCommandTarget) validateTier(formats strfmt.Registry) error {
+	if swag.IsZero(m.Tier) { // not required
+		return nil
+	}
+
+	if err := m.Tier.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("tier")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("tier")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this batch command target based on the context it is used
+func (m *BatchCommandTarget) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateGatewayIds(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTier(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchCommandTarget) contextValidateGatewayIds(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.GatewayIds); i++ {
+
+		if err := m.GatewayIds[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("gateway_ids" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("gateway_ids" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *BatchCommandTarget) contextValidateTier(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Tier.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("tier")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("tier")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchCommandTarget) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchCommandTarget) UnmarshalBinary(b []byte) error {
+	var res BatchCommandTarget
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,103 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BatchTailLogsParams batch tail logs params
+//
+// swagger:model batch_tail_logs_params
+type BatchTailLogsParams struct {
+
+	// Number of log lines to collect, 100 by default
+	// Example: 100
+	// Maximum: 10000
+	// Minimum: 1
+	MaxLines int64 `json:"max_lines,omitempty"`
+
+	// service
+	// Example: magmad
+	// Required: true
+	// Min Length: 1
+	Service *string `json:"service"`
+}
+
+// Validate validates this batch tail logs params
+func (m *BatchTailLogsParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMaxLines(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateService(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchTailLogsParams) validateMaxLines(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxLines) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_lines", "body", m.MaxLines, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("max_lines", "body", m.MaxLines, 10000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BatchTailLogsParams) validateService(formats strfmt.Registry) error {
+
+	if err := validate.Required("service", "body", m.Service); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("service", "body", *m.Service, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this batch tail logs params based on context it is used
+func (m *BatchTailLogsParams) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchTailLogsParams) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchTailLogsParams) UnmarshalBinary(b []byte) error {
+	var res BatchTailLogsParams
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -470,6 +470,83 @@ func (m *Tier) FromBackendModel(entity configurator.NetworkEntity) *Tier {
 	return tier
 }
 
+func (m *Rollout) ToNetworkEntity() configurator.NetworkEntity {
+	return configurator.NetworkEntity{
+		Type:   orc8r.UpgradeRolloutEntityType,
+		Key:    string(m.ID),
+		Config: m,
+	}
+}
+
+func (m *Rollout) ToUpdateCriteria() configurator.EntityUpdateCriteria {
+	return configurator.EntityUpdateCriteria{
+		Type:      orc8r.UpgradeRolloutEntityType,
+		Key:       string(m.ID),
+		NewConfig: m,
+	}
+}
+
+// GetOnFailure returns the action taken on failed health gates, pause by default.
+func (m *Rollout) GetOnFailure() string {
+	if m.OnFailure == nil {
+		return RolloutOnFailurePause
+	}
+	return *m.OnFailure
+}
+
+// IsActive returns true for rollouts which did not finish yet.
+func (m *Rollout) IsActive() bool {
+	return m.Status != nil && (m.Status.State == RolloutStatusStateRunning || m.Status.State == RolloutStatusStatePaused)
+}
+
+// GetPercentage returns share of the tier's gateways upgraded in the step, 100 by default.
+func (m *RolloutStep) GetPercentage() int64 {
+	if m.Percentage == 0 {
+		return 100
+	}
+	return m.Percentage
+}
+
+func (m *BatchCommandJob) ToNetworkEntity() configurator.NetworkEntity {
+	return configurator.NetworkEntity{
+		Type:   orc8r.BatchCommandJobEntityType,
+		Key:    string(m.ID),
+		Config: m,
+	}
+}
+
+func (m *BatchCommandJob) ToUpdateCriteria() configurator.EntityUpdateCriteria {
+	return configurator.EntityUpdateCriteria{
+		Type:      orc8r.BatchCommandJobEntityType,
+		Key:       string(m.ID),
+		NewConfig: m,
+	}
+}
+
+// GetMaxConcurrency returns how many gateways run the command at once, 10 by default.
+func (m *BatchCommandJob) GetMaxConcurrency() int {
+	if m.MaxConcurrency == 0 {
+		return 10
+	}
+	return int(m.MaxConcurrency)
+}
+
+// GetTimeout returns the timeout of the command on a single gateway, a minute by default.
+func (m *BatchCommandJob) GetTimeout() time.Duration {
+	if m.TimeoutSec == 0 {
+		return time.Minute
+	}
+	return time.Duration(m.TimeoutSec) * time.Second
+}
+
+// GetMaxLines returns how many log lines are collected, 100 by default.
+func (m *BatchTailLogsParams) GetMaxLines() int {
+	if m.MaxLines == 0 {
+		return 100
+	}
+	return int(m.MaxLines)
+}
+
 func (m *TierName) ToUpdateCriteria(ctx context.Context, networkID string, key string) ([]configurator.EntityUpdateCriteria, error) {
 	return []configurator.EntityUpdateCriteria{
 		{
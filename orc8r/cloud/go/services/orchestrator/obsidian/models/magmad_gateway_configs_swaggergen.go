@@ -51,6 +51,10 @@ type MagmadGatewayConfigs struct {
 	// Example: {"newfeature1":true,"newfeature2":false}
 	FeatureFlags map[string]bool `json:"feature_flags,omitempty"`
 
+	// Operator defined labels used to select gateways for batch commands
+	// Example: {"region":"west"}
+	Labels map[string]string `json:"labels,omitempty"`
+
 	// logging
 	Logging *GatewayLoggingConfigs `json:"logging,omitempty"`
 
@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RolloutHealthGates rollout health gates
+//
+// swagger:model rollout_health_gates
+type RolloutHealthGates struct {
+
+	// Time after a step starts during which failed gates are ignored while gateways upgrade
+	// Example: 900
+	// Minimum: 0
+	GracePeriodSec *int64 `json:"grace_period_sec,omitempty"`
+
+	// Upgraded gateways have to check in within this time, 0 disables the gate
+	// Example: 300
+	// Minimum: 0
+	MaxCheckinAgeSec *int64 `json:"max_checkin_age_sec,omitempty"`
+
+	// prometheus queries
+	PrometheusQueries []*RolloutPrometheusGate `json:"prometheus_queries"`
+
+	// Gateway services which have to report alive and not unhealthy service303 status
+	// Example: ["magmad","mme"]
+	Services []string `json:"services"`
+}
+
+// Validate validates this rollout health gates
+func (m *RolloutHealthGates) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateGracePeriodSec(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxCheckinAgeSec(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePrometheusQueries(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RolloutHealthGates) validateGracePeriodSec(formats strfmt.Registry) error {
+	if swag.IsZero(m.GracePeriodSec) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("grace_period_sec", "body", *m.GracePeriodSec, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutHealthGates) validateMaxCheckinAgeSec(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxCheckinAgeSec) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_checkin_age_sec", "body", *m.MaxCheckinAgeSec, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutHealthGates) validatePrometheusQueries(formats strfmt.Registry) error {
+	if swag.IsZero(m.PrometheusQueries) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PrometheusQueries); i++ {
+		if swag.IsZero(m.PrometheusQueries[i]) { // not required
+			continue
+		}
+
+		if m.PrometheusQueries[i] != nil {
+			if err := m.PrometheusQueries[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("prometheus_queries" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("prometheus_queries" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this rollout health gates based on the context it is used
+func (m *RolloutHealthGates) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidatePrometheusQueries(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RolloutHealthGates) contextValidatePrometheusQueries(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.PrometheusQueries); i++ {
+
+		if m.PrometheusQueries[i] != nil {
+			if err := m.PrometheusQueries[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("prometheus_queries" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("prometheus_queries" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RolloutHealthGates) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RolloutHealthGates) UnmarshalBinary(b []byte) error {
+	var res RolloutHealthGates
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,39 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// RolloutID rollout id
+// Example: release_1_8
+//
+// swagger:model rollout_id
+type RolloutID string
+
+// Validate validates this rollout id
+func (m RolloutID) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := validate.Pattern("", "body", string(m), `^[a-z][\da-z_]+$`); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this rollout id based on context it is used
+func (m RolloutID) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,90 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RolloutPrometheusGate rollout prometheus gate
+//
+// swagger:model rollout_prometheus_gate
+type RolloutPrometheusGate struct {
+
+	// max value
+	// Example: 0.1
+	// Required: true
+	MaxValue *float64 `json:"max_value"`
+
+	// Gate fails when the query returns no samples or any sample of its result exceeds max_value
+	// Example: sum(rate(s1_setup{networkID=\"network1\",result=\"failure\"}[5m]))
+	// Required: true
+	Query *string `json:"query"`
+}
+
+// Validate validates this rollout prometheus gate
+func (m *RolloutPrometheusGate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMaxValue(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateQuery(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RolloutPrometheusGate) validateMaxValue(formats strfmt.Registry) error {
+
+	if err := validate.Required("max_value", "body", m.MaxValue); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutPrometheusGate) validateQuery(formats strfmt.Registry) error {
+
+	if err := validate.Required("query", "body", m.Query); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this rollout prometheus gate based on context it is used
+func (m *RolloutPrometheusGate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RolloutPrometheusGate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RolloutPrometheusGate) UnmarshalBinary(b []byte) error {
+	var res RolloutPrometheusGate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RolloutStatus rollout status
+//
+// swagger:model rollout_status
+type RolloutStatus struct {
+
+	// Index of the step in progress
+	CurrentStep int64 `json:"current_step,omitempty"`
+
+	// Reason of the last pause or rollback
+	Message string `json:"message,omitempty"`
+
+	// Versions of tiers before the rollout, restored on rollback
+	PreviousVersions map[string]string `json:"previous_versions,omitempty"`
+
+	// state
+	// Enum: [running paused completed rolled_back]
+	State string `json:"state,omitempty"`
+
+	// step started at
+	// Format: date-time
+	StepStartedAt strfmt.DateTime `json:"step_started_at,omitempty"`
+}
+
+// Validate validates this rollout status
+func (m *RolloutStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStepStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var rolloutStatusTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["running","paused","completed","rolled_back"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		rolloutStatusTypeStatePropEnum = append(rolloutStatusTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// RolloutStatusStateRunning captures enum value "running"
+	RolloutStatusStateRunning string = "running"
+
+	// RolloutStatusStatePaused captures enum value "paused"
+	RolloutStatusStatePaused string = "paused"
+
+	// RolloutStatusStateCompleted captures enum value "completed"
+	RolloutStatusStateCompleted string = "completed"
+
+	// RolloutStatusStateRolledBack captures enum value "rolled_back"
+	RolloutStatusStateRolledBack string = "rolled_back"
+)
+
+// prop value enum
+func (m *RolloutStatus) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, rolloutStatusTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *RolloutStatus) validateState(formats strfmt.Registry) error {
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutStatus) validateStepStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StepStartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("step_started_at", "body", "date-time", m.StepStartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this rollout status based on context it is used
+func (m *RolloutStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RolloutStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RolloutStatus) UnmarshalBinary(b []byte) error {
+	var res RolloutStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
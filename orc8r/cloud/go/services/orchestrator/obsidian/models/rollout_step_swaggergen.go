@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RolloutStep rollout step
+//
+// swagger:model rollout_step
+type RolloutStep struct {
+
+	// Time health gates have to pass before the next step starts
+	// Example: 3600
+	// Minimum: 0
+	BakeTimeSec *int64 `json:"bake_time_sec,omitempty"`
+
+	// Share of the tier's gateways moved to the version in this step, gateways are moved to a separate tier until the tier reaches 100 percent
+	//
+	// Maximum: 100
+	// Minimum: 1
+	Percentage int64 `json:"percentage,omitempty"`
+
+	// tier
+	// Required: true
+	Tier TierID `json:"tier"`
+}
+
+// Validate validates this rollout step
+func (m *RolloutStep) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBakeTimeSec(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePercentage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTier(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RolloutStep) validateBakeTimeSec(formats strfmt.Registry) error {
+	if swag.IsZero(m.BakeTimeSec) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("bake_time_sec", "body", *m.BakeTimeSec, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutStep) validatePercentage(formats strfmt.Registry) error {
+	if swag.IsZero(m.Percentage) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("percentage", "body", m.Percentage, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("percentage", "body", m.Percentage, 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutStep) validateTier(formats strfmt.Registry) error {
+
+	if err := validate.Required("tier", "body", TierID(m.Tier)); err != nil {
+		return err
+	}
+
+	if err := m.Tier.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("tier")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("tier")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this rollout step based on the context it is used
+func (m *RolloutStep) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTier(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RolloutStep) contextValidateTier(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Tier.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("tier")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("tier")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RolloutStep) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RolloutStep) UnmarshalBinary(b []byte) error {
+	var res RolloutStep
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,365 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Rollout Rollout of a version through a sequence of tiers
+//
+// swagger:model rollout
+type Rollout struct {
+
+	// health gates
+	HealthGates *RolloutHealthGates `json:"health_gates,omitempty"`
+
+	// id
+	// Required: true
+	ID RolloutID `json:"id"`
+
+	// Action taken when health gates fail
+	// Enum: [pause rollback]
+	OnFailure *string `json:"on_failure,omitempty"`
+
+	// status
+	Status *RolloutStatus `json:"status,omitempty"`
+
+	// steps
+	// Required: true
+	// Min Items: 1
+	Steps []*RolloutStep `json:"steps"`
+
+	// version
+	// Required: true
+	Version *TierVersion `json:"version"`
+}
+
+// Validate validates this rollout
+func (m *Rollout) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHealthGates(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOnFailure(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSteps(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Rollout) validateHealthGates(formats strfmt.Registry) error {
+	if swag.IsZero(m.HealthGates) { // not required
+		return nil
+	}
+
+	if m.HealthGates != nil {
+		if err := m.HealthGates.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("health_gates")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("health_gates")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Rollout) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", RolloutID(m.ID)); err != nil {
+		return err
+	}
+
+	if err := m.ID.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("id")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("id")
+		}
+		return err
+	}
+
+	return nil
+}
+
+var rolloutTypeOnFailurePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pause","rollback"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		rolloutTypeOnFailurePropEnum = append(rolloutTypeOnFailurePropEnum, v)
+	}
+}
+
+const (
+
+	// RolloutOnFailurePause captures enum value "pause"
+	RolloutOnFailurePause string = "pause"
+
+	// RolloutOnFailureRollback captures enum value "rollback"
+	RolloutOnFailureRollback string = "rollback"
+)
+
+// prop value enum
+func (m *Rollout) validateOnFailureEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, rolloutTypeOnFailurePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Rollout) validateOnFailure(formats strfmt.Registry) error {
+	if swag.IsZero(m.OnFailure) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateOnFailureEnum("on_failure", "body", *m.OnFailure); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Rollout) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if m.Status != nil {
+		if err := m.Status.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("status")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Rollout) validateSteps(formats strfmt.Registry) error {
+
+	if err := validate.Required("steps", "body", m.Steps); err != nil {
+		return err
+	}
+
+	iStepsSize := int64(len(m.Steps))
+
+	if err := validate.MinItems("steps", "body", iStepsSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Steps); i++ {
+		if swag.IsZero(m.Steps[i]) { // not required
+			continue
+		}
+
+		if m.Steps[i] != nil {
+			if err := m.Steps[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("steps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("steps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Rollout) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	if m.Version != nil {
+		if err := m.Version.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("version")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("version")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this rollout based on the context it is used
+func (m *Rollout) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateHealthGates(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSteps(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVersion(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Rollout) contextValidateHealthGates(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.HealthGates != nil {
+		if err := m.HealthGates.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("health_gates")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("health_gates")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Rollout) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.ID.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("id")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("id")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *Rollout) contextValidateStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Status != nil {
+		if err := m.Status.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("status")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Rollout) contextValidateSteps(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Steps); i++ {
+
+		if m.Steps[i] != nil {
+			if err := m.Steps[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("steps" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("steps" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Rollout) contextValidateVersion(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Version != nil {
+		if err := m.Version.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("version")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("version")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Rollout) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Rollout) UnmarshalBinary(b []byte) error {
+	var res Rollout
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
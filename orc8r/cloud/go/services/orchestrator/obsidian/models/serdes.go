@@ -32,5 +32,7 @@ var (
 		configurator.NewNetworkEntityConfigSerde(orc8r.MagmadGatewayType, &MagmadGatewayConfigs{}),
 		configurator.NewNetworkEntityConfigSerde(orc8r.UpgradeReleaseChannelEntityType, &ReleaseChannel{}),
 		configurator.NewNetworkEntityConfigSerde(orc8r.UpgradeTierEntityType, &Tier{}),
+		configurator.NewNetworkEntityConfigSerde(orc8r.UpgradeRolloutEntityType, &Rollout{}),
+		configurator.NewNetworkEntityConfigSerde(orc8r.BatchCommandJobEntityType, &BatchCommandJob{}),
 	)
 )
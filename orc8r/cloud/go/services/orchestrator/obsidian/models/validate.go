@@ -124,6 +124,54 @@ func (m *TierImage) ValidateModel(context.Context) error {
 	return m.Validate(strfmt.Default)
 }
 
+func (m *Rollout) ValidateModel(context.Context) error {
+	if err := m.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	percentagesByTier := map[TierID]int64{}
+	for i, step := range m.Steps {
+		previous, exists := percentagesByTier[step.Tier]
+		if previous == 100 {
+			return fmt.Errorf("step %d: tier %s is already fully rolled out", i, step.Tier)
+		}
+		if exists && step.GetPercentage() <= previous {
+			return fmt.Errorf("step %d: percentage of tier %s has to increase", i, step.Tier)
+		}
+		percentagesByTier[step.Tier] = step.GetPercentage()
+	}
+	for tier, percentage := range percentagesByTier {
+		if percentage != 100 {
+			return fmt.Errorf("tier %s has to be fully rolled out by the last of its steps", tier)
+		}
+	}
+	return nil
+}
+
+func (m *BatchCommandJob) ValidateModel(context.Context) error {
+	if err := m.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	switch *m.Command.Type {
+	case BatchCommandTypeRestartServices:
+		if len(m.Command.Services) == 0 {
+			return errors.New("services to restart have to be provided")
+		}
+	case BatchCommandTypePing:
+		if m.Command.Ping == nil {
+			return errors.New("ping request has to be provided")
+		}
+	case BatchCommandTypeGeneric:
+		if m.Command.Generic == nil {
+			return errors.New("generic command params have to be provided")
+		}
+	case BatchCommandTypeTailLogs:
+		if m.Command.TailLogs == nil {
+			return errors.New("tail logs params have to be provided")
+		}
+	}
+	return nil
+}
+
 func (m *GatewayStatus) ValidateModel(context.Context) error {
 	return m.Validate(strfmt.Default)
 }
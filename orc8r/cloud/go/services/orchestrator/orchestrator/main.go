@@ -14,9 +14,13 @@
 package main
 
 import (
+	"context"
+
 	"github.com/golang/glog"
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 
+	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/analytics"
@@ -29,11 +33,16 @@ import (
 	swagger "magma/orc8r/cloud/go/services/obsidian/swagger/servicers/protected"
 	"magma/orc8r/cloud/go/services/orchestrator"
 	analytics_service "magma/orc8r/cloud/go/services/orchestrator/analytics"
+	"magma/orc8r/cloud/go/services/orchestrator/batch"
+	"magma/orc8r/cloud/go/services/orchestrator/lease"
 	"magma/orc8r/cloud/go/services/orchestrator/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/orchestrator/rollout"
 	"magma/orc8r/cloud/go/services/orchestrator/servicers"
 	protected_servicers "magma/orc8r/cloud/go/services/orchestrator/servicers/protected"
 	indexer_protos "magma/orc8r/cloud/go/services/state/protos"
 	streamer_protos "magma/orc8r/cloud/go/services/streamer/protos"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
 	"magma/orc8r/lib/go/service/config"
 )
 
@@ -84,6 +93,25 @@ func main() {
 	)
 	analytics_protos.RegisterAnalyticsCollectorServer(srv.ProtectedGrpcServer, collectorServicer)
 
+	// Leases elect the replica which runs background controllers
+	db, err := sqorc.Open(storage.GetSQLDriver(), storage.GetDatabaseSource())
+	if err != nil {
+		glog.Fatalf("Failed to connect to database: %s", err)
+	}
+	leaseFactory := blobstore.NewSQLStoreFactory(lease.LeaseTableBlobstore, db, sqorc.GetSqlBuilder())
+	err = leaseFactory.InitializeFactory()
+	if err != nil {
+		glog.Fatalf("Error initializing lease database: %s", err)
+	}
+	leases := lease.NewBlobstoreStore(leaseFactory)
+	leaseOwner := uuid.New().String()
+
+	rolloutController := rollout.NewController(rollout.NewHealthChecker(analytics.GetPrometheusClient()), leases, leaseOwner)
+	go rolloutController.Run(context.Background(), serviceConfig.Rollout.GetReconcileInterval())
+
+	batchRunner := batch.NewRunner(batch.NewMagmadExecutor(), leases, leaseOwner, serviceConfig.BatchCommands.GetProgressInterval())
+	go batchRunner.Run(context.Background(), serviceConfig.BatchCommands.GetPollInterval())
+
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("Error while running service and echo server: %s", err)
@@ -0,0 +1,404 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rollout moves gateway software versions through a sequence of
+// upgrade tiers, waiting for health gates between the steps.
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/glog"
+	"github.com/hashicorp/go-multierror"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/orchestrator/lease"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/lib/go/merrors"
+)
+
+// HealthChecker evaluates health gates of a rollout for upgraded gateways.
+type HealthChecker interface {
+	// Check returns an error describing the first failed gate.
+	Check(ctx context.Context, networkID string, gatewayIDs []string, gates *models.RolloutHealthGates) error
+}
+
+// controllerLease is held by the orchestrator replica reconciling rollouts
+const controllerLease = "rollout_controller"
+
+// Controller reconciles active rollouts of all networks.
+//
+// A step applies the version to a share of a tier's gateways by moving them
+// to a separate canary tier; when the tier reaches 100 percent, the version
+// is set on the tier itself and the canary tier is removed. The next step
+// starts once health gates pass for the bake time of the step.
+//
+// Only the replica holding the controller lease reconciles rollouts. Rollouts
+// are stored at the version they were loaded at, so changes made meanwhile
+// through the REST API, e.g. pausing or deleting the rollout, are not lost.
+type Controller struct {
+	checker HealthChecker
+	leases  lease.Store
+	owner   string
+}
+
+func NewController(checker HealthChecker, leases lease.Store, owner string) *Controller {
+	return &Controller{checker: checker, leases: leases, owner: owner}
+}
+
+// Run reconciles rollouts every interval until the context is done.
+func (c *Controller) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.ReconcileIfLeader(ctx, 3*interval); err != nil {
+				glog.Errorf("Failed to reconcile gateway rollouts: %v", err)
+			}
+		}
+	}
+}
+
+// ReconcileIfLeader reconciles rollouts if the controller acquires or renews
+// the controller lease for ttl. Returns false when another replica holds it.
+func (c *Controller) ReconcileIfLeader(ctx context.Context, ttl time.Duration) (bool, error) {
+	acquired, err := c.leases.TryAcquire(controllerLease, c.owner, ttl)
+	if err != nil {
+		return false, fmt.Errorf("acquire rollout controller lease: %w", err)
+	}
+	if !acquired {
+		glog.V(2).Infof("Rollout controller lease is held by another replica")
+		return false, nil
+	}
+	return true, c.Reconcile(ctx)
+}
+
+func (c *Controller) Reconcile(ctx context.Context) error {
+	networkIDs, err := configurator.ListNetworkIDs(ctx)
+	if err != nil {
+		return err
+	}
+	errs := &multierror.Error{}
+	for _, networkID := range networkIDs {
+		entities, _, err := configurator.LoadAllEntitiesOfType(
+			ctx, networkID, orc8r.UpgradeRolloutEntityType,
+			configurator.EntityLoadCriteria{LoadConfig: true},
+			serdes.Entity,
+		)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("load rollouts of network %s: %w", networkID, err))
+			continue
+		}
+		for _, entity := range entities {
+			r := entity.Config.(*models.Rollout)
+			if r.Status == nil || r.Status.State != models.RolloutStatusStateRunning {
+				continue
+			}
+			err := c.reconcileRollout(ctx, networkID, r, entity.Version)
+			if err == configurator.ErrVersionMismatch {
+				glog.Infof("Rollout %s of network %s was changed while being reconciled, skipping", r.ID, networkID)
+				continue
+			}
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("rollout %s of network %s: %w", r.ID, networkID, err))
+			}
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// reconcileRollout moves the rollout loaded at version forward. Returns
+// ErrVersionMismatch from configurator when the rollout was changed since.
+func (c *Controller) reconcileRollout(ctx context.Context, networkID string, r *models.Rollout, version uint64) error {
+	status := r.Status
+	if int(status.CurrentStep) >= len(r.Steps) {
+		status.State = models.RolloutStatusStateCompleted
+		return saveRollout(ctx, networkID, r, version)
+	}
+	step := r.Steps[status.CurrentStep]
+	now := clock.Now()
+	started := time.Time(status.StepStartedAt)
+	if started.IsZero() {
+		if err := checkRolloutVersion(ctx, networkID, r, version); err != nil {
+			return err
+		}
+		if err := applyStep(ctx, networkID, r, step); err != nil {
+			return err
+		}
+		glog.Infof("Rollout %s of network %s applied step %d to tier %s", r.ID, networkID, status.CurrentStep, step.Tier)
+		status.StepStartedAt = strfmt.DateTime(now)
+		return saveRollout(ctx, networkID, r, version)
+	}
+
+	gatewayIDs, err := getUpgradedGateways(ctx, networkID, r)
+	if err != nil {
+		return err
+	}
+	if err := c.checker.Check(ctx, networkID, gatewayIDs, r.HealthGates); err != nil {
+		if now.Sub(started) < getGracePeriod(r.HealthGates) {
+			glog.V(2).Infof("Rollout %s of network %s in grace period: %v", r.ID, networkID, err)
+			return nil
+		}
+		return failRollout(ctx, networkID, r, version, fmt.Sprintf("step %d failed health gates: %v", status.CurrentStep, err))
+	}
+	if now.Sub(started) < time.Duration(swag.Int64Value(step.BakeTimeSec))*time.Second {
+		return nil
+	}
+	status.CurrentStep++
+	status.StepStartedAt = strfmt.DateTime{}
+	if int(status.CurrentStep) == len(r.Steps) {
+		status.State = models.RolloutStatusStateCompleted
+		glog.Infof("Rollout %s of network %s completed", r.ID, networkID)
+	}
+	return saveRollout(ctx, networkID, r, version)
+}
+
+func failRollout(ctx context.Context, networkID string, r *models.Rollout, version uint64, message string) error {
+	glog.Errorf("Rollout %s of network %s: %s", r.ID, networkID, message)
+	r.Status.Message = message
+	if r.GetOnFailure() != models.RolloutOnFailureRollback {
+		r.Status.State = models.RolloutStatusStatePaused
+		return saveRollout(ctx, networkID, r, version)
+	}
+	if err := checkRolloutVersion(ctx, networkID, r, version); err != nil {
+		return err
+	}
+	if err := Rollback(ctx, networkID, r); err != nil {
+		return err
+	}
+	r.Status.State = models.RolloutStatusStateRolledBack
+	return saveRollout(ctx, networkID, r, version)
+}
+
+// Rollback restores versions of the rollout's tiers and moves gateways
+// from canary tiers back to their tiers.
+func Rollback(ctx context.Context, networkID string, r *models.Rollout) error {
+	for tierID, version := range r.Status.PreviousVersions {
+		tier, err := loadTier(ctx, networkID, tierID)
+		if err != nil {
+			return err
+		}
+		canary, err := loadTier(ctx, networkID, CanaryTierID(tierID, r.ID))
+		if err != nil && err != merrors.ErrNotFound {
+			return err
+		}
+		tierVersion := models.TierVersion(version)
+		tier.Version = &tierVersion
+		if err := moveBackFromCanary(ctx, networkID, tier, canary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyStep(ctx context.Context, networkID string, r *models.Rollout, step *models.RolloutStep) error {
+	tierID := string(step.Tier)
+	tier, err := loadTier(ctx, networkID, tierID)
+	if err != nil {
+		return fmt.Errorf("load tier %s: %w", tierID, err)
+	}
+	if r.Status.PreviousVersions == nil {
+		r.Status.PreviousVersions = map[string]string{}
+	}
+	if _, ok := r.Status.PreviousVersions[tierID]; !ok {
+		r.Status.PreviousVersions[tierID] = string(*tier.Version)
+	}
+	canary, err := loadTier(ctx, networkID, CanaryTierID(tierID, r.ID))
+	if err != nil && err != merrors.ErrNotFound {
+		return err
+	}
+
+	if step.GetPercentage() == 100 {
+		tier.Version = r.Version
+		return moveBackFromCanary(ctx, networkID, tier, canary)
+	}
+
+	var canaryGateways models.TierGateways
+	if canary != nil {
+		canaryGateways = canary.Gateways
+	}
+	total := len(tier.Gateways) + len(canaryGateways)
+	target := (total*int(step.GetPercentage()) + 99) / 100
+	toMove := target - len(canaryGateways)
+	if toMove <= 0 {
+		return nil
+	}
+	sort.Slice(tier.Gateways, func(i, j int) bool { return tier.Gateways[i] < tier.Gateways[j] })
+	moved := tier.Gateways[:toMove]
+
+	var writes []configurator.EntityWriteOperation
+	writes = append(writes, configurator.EntityUpdateCriteria{
+		Type:                 orc8r.UpgradeTierEntityType,
+		Key:                  tierID,
+		AssociationsToDelete: gatewayTKs(moved),
+	})
+	if canary == nil {
+		canary = &models.Tier{
+			ID:       models.TierID(CanaryTierID(tierID, r.ID)),
+			Name:     models.TierName(fmt.Sprintf("%s (rollout %s)", tierID, r.ID)),
+			Version:  r.Version,
+			Images:   tier.Images,
+			Gateways: moved,
+		}
+		writes = append(writes, canary.ToNetworkEntity())
+	} else {
+		writes = append(writes, configurator.EntityUpdateCriteria{
+			Type:              orc8r.UpgradeTierEntityType,
+			Key:               string(canary.ID),
+			AssociationsToAdd: gatewayTKs(moved),
+		})
+	}
+	return configurator.WriteEntities(ctx, networkID, writes, serdes.Entity)
+}
+
+// moveBackFromCanary stores the tier with gateways of the canary tier
+// and removes the canary tier.
+func moveBackFromCanary(ctx context.Context, networkID string, tier *models.Tier, canary *models.Tier) error {
+	update := configurator.EntityUpdateCriteria{
+		Type:      orc8r.UpgradeTierEntityType,
+		Key:       string(tier.ID),
+		NewConfig: tier,
+	}
+	if canary == nil {
+		_, err := configurator.UpdateEntity(ctx, networkID, update, serdes.Entity)
+		return err
+	}
+	err := configurator.WriteEntities(ctx, networkID, []configurator.EntityWriteOperation{
+		configurator.EntityUpdateCriteria{
+			Type:                 orc8r.UpgradeTierEntityType,
+			Key:                  string(canary.ID),
+			AssociationsToDelete: gatewayTKs(canary.Gateways),
+		},
+		update,
+		configurator.EntityUpdateCriteria{
+			Type:              orc8r.UpgradeTierEntityType,
+			Key:               string(tier.ID),
+			AssociationsToAdd: gatewayTKs(canary.Gateways),
+		},
+	}, serdes.Entity)
+	if err != nil {
+		return err
+	}
+	return configurator.DeleteEntity(ctx, networkID, orc8r.UpgradeTierEntityType, string(canary.ID))
+}
+
+// getUpgradedGateways returns gateways of all tiers the rollout
+// has reached so far, which are subject to health gates.
+func getUpgradedGateways(ctx context.Context, networkID string, r *models.Rollout) ([]string, error) {
+	var ret []string
+	seen := map[models.TierID]bool{}
+	for _, step := range r.Steps[:r.Status.CurrentStep+1] {
+		if seen[step.Tier] {
+			continue
+		}
+		seen[step.Tier] = true
+		tier, err := loadTier(ctx, networkID, CanaryTierID(string(step.Tier), r.ID))
+		if err == merrors.ErrNotFound {
+			tier, err = loadTier(ctx, networkID, string(step.Tier))
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, gatewayID := range tier.Gateways {
+			ret = append(ret, string(gatewayID))
+		}
+	}
+	return ret, nil
+}
+
+// CanaryTierID returns the tier with gateways already upgraded
+// by the rollout before the tier reaches 100 percent.
+func CanaryTierID(tierID string, rolloutID models.RolloutID) string {
+	return fmt.Sprintf("%s_%s", tierID, rolloutID)
+}
+
+func LoadRollouts(ctx context.Context, networkID string) ([]*models.Rollout, error) {
+	entities, _, err := configurator.LoadAllEntitiesOfType(
+		ctx, networkID, orc8r.UpgradeRolloutEntityType,
+		configurator.EntityLoadCriteria{LoadConfig: true},
+		serdes.Entity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*models.Rollout
+	for _, entity := range entities {
+		ret = append(ret, entity.Config.(*models.Rollout))
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].ID < ret[j].ID })
+	return ret, nil
+}
+
+// saveRollout stores the rollout unless it was changed since it was loaded
+// at version, in which case ErrVersionMismatch from configurator is returned.
+func saveRollout(ctx context.Context, networkID string, r *models.Rollout, version uint64) error {
+	update := r.ToUpdateCriteria()
+	update.ExpectedVersion = &version
+	_, err := configurator.UpdateEntity(ctx, networkID, update, serdes.Entity)
+	return err
+}
+
+// checkRolloutVersion returns ErrVersionMismatch from configurator when the
+// rollout was changed since it was loaded at version. It is checked before
+// tiers are changed, so that e.g. a rollout paused meanwhile doesn't move on.
+func checkRolloutVersion(ctx context.Context, networkID string, r *models.Rollout, version uint64) error {
+	entity, err := configurator.LoadEntity(
+		ctx, networkID, orc8r.UpgradeRolloutEntityType, string(r.ID),
+		configurator.EntityLoadCriteria{},
+		serdes.Entity,
+	)
+	if err == merrors.ErrNotFound || (err == nil && entity.Version != version) {
+		return configurator.ErrVersionMismatch
+	}
+	return err
+}
+
+func loadTier(ctx context.Context, networkID string, tierID string) (*models.Tier, error) {
+	entity, err := configurator.LoadEntity(
+		ctx,
+		networkID, orc8r.UpgradeTierEntityType, tierID,
+		configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true, LoadMetadata: true},
+		serdes.Entity,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return (&models.Tier{}).FromBackendModel(entity), nil
+}
+
+func gatewayTKs(gatewayIDs models.TierGateways) storage.TKs {
+	var tks storage.TKs
+	for _, gatewayID := range gatewayIDs {
+		tks = append(tks, storage.TK{Type: orc8r.MagmadGatewayType, Key: string(gatewayID)})
+	}
+	return tks
+}
+
+func getGracePeriod(gates *models.RolloutHealthGates) time.Duration {
+	if gates == nil {
+		return 0
+	}
+	return time.Duration(swag.Int64Value(gates.GracePeriodSec)) * time.Second
+}
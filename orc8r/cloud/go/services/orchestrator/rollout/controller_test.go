@@ -0,0 +1,309 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"magma/orc8r/cloud/go/clock"
+	models1 "magma/orc8r/cloud/go/models"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serdes"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/configurator/test_utils"
+	"magma/orc8r/cloud/go/services/orchestrator/lease"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/services/orchestrator/rollout"
+	"magma/orc8r/cloud/go/storage"
+	orc8r_test_utils "magma/orc8r/cloud/go/test_utils"
+	"magma/orc8r/lib/go/merrors"
+)
+
+const networkID = "n1"
+
+type stubChecker struct {
+	err        error
+	gatewayIDs []string
+	onCheck    func()
+}
+
+func (s *stubChecker) Check(ctx context.Context, networkID string, gatewayIDs []string, gates *models.RolloutHealthGates) error {
+	s.gatewayIDs = gatewayIDs
+	if s.onCheck != nil {
+		s.onCheck()
+	}
+	return s.err
+}
+
+func TestReconcile(t *testing.T) {
+	setupTiers(t)
+	now := time.Unix(1e9, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	createRollout(t, &models.Rollout{
+		ID:      "r1",
+		Version: tierVersion("2"),
+		Steps: []*models.RolloutStep{
+			{Tier: "t1", Percentage: 50, BakeTimeSec: swag.Int64(60)},
+			{Tier: "t1", Percentage: 100, BakeTimeSec: swag.Int64(60)},
+			{Tier: "t2"},
+		},
+	})
+	checker := &stubChecker{}
+	controller := rollout.NewController(checker, newLeaseStore(t), "owner")
+
+	// First step moves half of the tier to the canary tier
+	require.NoError(t, controller.Reconcile(context.Background()))
+	assertTier(t, "t1", "1", "g3", "g4")
+	assertTier(t, "t1_r1", "2", "g1", "g2")
+	r := loadRollout(t)
+	assert.Equal(t, int64(0), r.Status.CurrentStep)
+	assert.Equal(t, map[string]string{"t1": "1"}, r.Status.PreviousVersions)
+
+	// Nothing happens during bake time
+	clock.SetAndFreezeClock(t, now.Add(30*time.Second))
+	require.NoError(t, controller.Reconcile(context.Background()))
+	assert.Equal(t, []string{"g1", "g2"}, checker.gatewayIDs)
+	assert.Equal(t, int64(0), loadRollout(t).Status.CurrentStep)
+
+	// Whole tier is upgraded after bake time
+	clock.SetAndFreezeClock(t, now.Add(61*time.Second))
+	require.NoError(t, controller.Reconcile(context.Background()))
+	assert.Equal(t, int64(1), loadRollout(t).Status.CurrentStep)
+	require.NoError(t, controller.Reconcile(context.Background()))
+	assertTier(t, "t1", "2", "g1", "g2", "g3", "g4")
+	assertTierNotFound(t, "t1_r1")
+
+	clock.SetAndFreezeClock(t, now.Add(130*time.Second))
+	require.NoError(t, controller.Reconcile(context.Background()))
+	require.NoError(t, controller.Reconcile(context.Background()))
+	assertTier(t, "t2", "2", "g5")
+	assert.Equal(t, models.RolloutStatusStateRunning, loadRollout(t).Status.State)
+
+	require.NoError(t, controller.Reconcile(context.Background()))
+	assert.ElementsMatch(t, []string{"g1", "g2", "g3", "g4", "g5"}, checker.gatewayIDs)
+	r = loadRollout(t)
+	assert.Equal(t, models.RolloutStatusStateCompleted, r.Status.State)
+	assert.Equal(t, map[string]string{"t1": "1", "t2": "1"}, r.Status.PreviousVersions)
+}
+
+func TestReconcileFailedHealthGates(t *testing.T) {
+	testCases := []struct {
+		name          string
+		onFailure     string
+		expectedState string
+		expectedTiers map[string][]string
+	}{
+		{
+			name:          "pause",
+			onFailure:     models.RolloutOnFailurePause,
+			expectedState: models.RolloutStatusStatePaused,
+			expectedTiers: map[string][]string{"t1": {"1", "g3", "g4"}, "t1_r1": {"2", "g1", "g2"}},
+		},
+		{
+			name:          "rollback",
+			onFailure:     models.RolloutOnFailureRollback,
+			expectedState: models.RolloutStatusStateRolledBack,
+			expectedTiers: map[string][]string{"t1": {"1", "g1", "g2", "g3", "g4"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setupTiers(t)
+			now := time.Unix(1e9, 0)
+			clock.SetAndFreezeClock(t, now)
+			defer clock.UnfreezeClock(t)
+
+			createRollout(t, &models.Rollout{
+				ID:          "r1",
+				Version:     tierVersion("2"),
+				Steps:       []*models.RolloutStep{{Tier: "t1", Percentage: 50}, {Tier: "t1"}},
+				HealthGates: &models.RolloutHealthGates{GracePeriodSec: swag.Int64(60)},
+				OnFailure:   swag.String(tc.onFailure),
+			})
+			controller := rollout.NewController(&stubChecker{err: errors.New("gateway g1 did not report status")}, newLeaseStore(t), "owner")
+			require.NoError(t, controller.Reconcile(context.Background()))
+
+			// Failures are ignored during grace period
+			clock.SetAndFreezeClock(t, now.Add(30*time.Second))
+			require.NoError(t, controller.Reconcile(context.Background()))
+			assert.Equal(t, models.RolloutStatusStateRunning, loadRollout(t).Status.State)
+
+			clock.SetAndFreezeClock(t, now.Add(61*time.Second))
+			require.NoError(t, controller.Reconcile(context.Background()))
+			r := loadRollout(t)
+			assert.Equal(t, tc.expectedState, r.Status.State)
+			assert.Equal(t, "step 0 failed health gates: gateway g1 did not report status", r.Status.Message)
+			for tierID, expected := range tc.expectedTiers {
+				assertTier(t, tierID, expected[0], expected[1:]...)
+			}
+			if tc.onFailure == models.RolloutOnFailureRollback {
+				assertTierNotFound(t, "t1_r1")
+			}
+
+			// Stopped rollouts are not reconciled
+			require.NoError(t, controller.Reconcile(context.Background()))
+			assert.Equal(t, tc.expectedState, loadRollout(t).Status.State)
+		})
+	}
+}
+
+func TestReconcileConcurrentPause(t *testing.T) {
+	setupTiers(t)
+	now := time.Unix(1e9, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	createRollout(t, &models.Rollout{
+		ID:      "r1",
+		Version: tierVersion("2"),
+		Steps:   []*models.RolloutStep{{Tier: "t1", Percentage: 50}, {Tier: "t1"}},
+	})
+	checker := &stubChecker{}
+	controller := rollout.NewController(checker, newLeaseStore(t), "owner")
+	require.NoError(t, controller.Reconcile(context.Background()))
+
+	// Rollout is paused through the API while health gates are checked
+	checker.onCheck = func() {
+		r := loadRollout(t)
+		r.Status.State = models.RolloutStatusStatePaused
+		_, err := configurator.UpdateEntity(context.Background(), networkID, r.ToUpdateCriteria(), serdes.Entity)
+		require.NoError(t, err)
+	}
+	clock.SetAndFreezeClock(t, now.Add(time.Minute))
+	require.NoError(t, controller.Reconcile(context.Background()))
+	r := loadRollout(t)
+	assert.Equal(t, models.RolloutStatusStatePaused, r.Status.State)
+	assert.Equal(t, int64(0), r.Status.CurrentStep)
+
+	// Paused rollout doesn't move on
+	checker.onCheck = nil
+	require.NoError(t, controller.Reconcile(context.Background()))
+	assertTier(t, "t1", "1", "g3", "g4")
+	assertTier(t, "t1_r1", "2", "g1", "g2")
+}
+
+func TestReconcileIfLeader(t *testing.T) {
+	setupTiers(t)
+	leases := newLeaseStore(t)
+	createRollout(t, &models.Rollout{
+		ID:      "r1",
+		Version: tierVersion("2"),
+		Steps:   []*models.RolloutStep{{Tier: "t2"}},
+	})
+	leader := rollout.NewController(&stubChecker{}, leases, "leader")
+	follower := rollout.NewController(&stubChecker{}, leases, "follower")
+
+	reconciled, err := leader.ReconcileIfLeader(context.Background(), time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reconciled)
+	assertTier(t, "t2", "2", "g5")
+
+	reconciled, err = follower.ReconcileIfLeader(context.Background(), time.Minute)
+	require.NoError(t, err)
+	assert.False(t, reconciled)
+	assert.Equal(t, int64(0), loadRollout(t).Status.CurrentStep)
+
+	// Lease is renewed by its owner
+	reconciled, err = leader.ReconcileIfLeader(context.Background(), time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reconciled)
+}
+
+func newLeaseStore(t *testing.T) lease.Store {
+	return lease.NewBlobstoreStore(orc8r_test_utils.NewSQLBlobstore(t, lease.LeaseTableBlobstore))
+}
+
+func setupTiers(t *testing.T) {
+	test_init.StartTestService(t)
+	test_utils.RegisterNetwork(t, networkID, "network 1")
+	for _, gatewayID := range []string{"g1", "g2", "g3", "g4", "g5"} {
+		test_utils.RegisterGateway(t, networkID, gatewayID, nil)
+	}
+	createTier(t, "t1", "g4", "g2", "g3", "g1")
+	createTier(t, "t2", "g5")
+}
+
+func createTier(t *testing.T, tierID string, gatewayIDs ...string) {
+	var gateways models.TierGateways
+	var assocs storage.TKs
+	for _, gatewayID := range gatewayIDs {
+		gateways = append(gateways, models1.GatewayID(gatewayID))
+		assocs = append(assocs, storage.TK{Type: orc8r.MagmadGatewayType, Key: gatewayID})
+	}
+	tier := &models.Tier{
+		ID:       models.TierID(tierID),
+		Name:     models.TierName(tierID),
+		Version:  tierVersion("1"),
+		Images:   models.TierImages{},
+		Gateways: gateways,
+	}
+	_, err := configurator.CreateEntity(context.Background(), networkID, configurator.NetworkEntity{
+		Type:         orc8r.UpgradeTierEntityType,
+		Key:          tierID,
+		Name:         tierID,
+		Config:       tier,
+		Associations: assocs,
+	}, serdes.Entity)
+	require.NoError(t, err)
+}
+
+func createRollout(t *testing.T, r *models.Rollout) {
+	r.Status = &models.RolloutStatus{State: models.RolloutStatusStateRunning}
+	_, err := configurator.CreateEntity(context.Background(), networkID, r.ToNetworkEntity(), serdes.Entity)
+	require.NoError(t, err)
+}
+
+func loadRollout(t *testing.T) *models.Rollout {
+	rollouts, err := rollout.LoadRollouts(context.Background(), networkID)
+	require.NoError(t, err)
+	require.Len(t, rollouts, 1)
+	return rollouts[0]
+}
+
+func assertTier(t *testing.T, tierID string, version string, gatewayIDs ...string) {
+	entity, err := configurator.LoadEntity(
+		context.Background(),
+		networkID, orc8r.UpgradeTierEntityType, tierID,
+		configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true},
+		serdes.Entity,
+	)
+	require.NoError(t, err)
+	tier := (&models.Tier{}).FromBackendModel(entity)
+	assert.Equal(t, version, string(*tier.Version))
+	var actual []string
+	for _, gatewayID := range tier.Gateways {
+		actual = append(actual, string(gatewayID))
+	}
+	assert.ElementsMatch(t, gatewayIDs, actual)
+}
+
+func assertTierNotFound(t *testing.T, tierID string) {
+	_, err := configurator.LoadEntity(context.Background(), networkID, orc8r.UpgradeTierEntityType, tierID, configurator.EntityLoadCriteria{}, serdes.Entity)
+	assert.Equal(t, merrors.ErrNotFound, err)
+}
+
+func tierVersion(version string) *models.TierVersion {
+	v := models.TierVersion(version)
+	return &v
+}
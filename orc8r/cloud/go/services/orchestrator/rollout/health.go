@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/swag"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
+	"magma/orc8r/cloud/go/services/dispatcher/gw_client_apis/service303"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/services/state/wrappers"
+	"magma/orc8r/lib/go/protos"
+)
+
+// serviceInfoGetter returns service303 info of a gateway service
+type serviceInfoGetter func(service gateway_registry.GwServiceType, hwID string) (*protos.ServiceInfo, error)
+
+type healthChecker struct {
+	prometheus     v1.API
+	getServiceInfo serviceInfoGetter
+}
+
+// NewHealthChecker returns the checker which evaluates gateway checkins,
+// service303 status of gateway services and prometheus queries.
+func NewHealthChecker(prometheus v1.API) HealthChecker {
+	return &healthChecker{prometheus: prometheus, getServiceInfo: service303.GWService303GetServiceInfo}
+}
+
+func (h *healthChecker) Check(ctx context.Context, networkID string, gatewayIDs []string, gates *models.RolloutHealthGates) error {
+	if gates == nil {
+		return nil
+	}
+	maxCheckinAge := time.Duration(swag.Int64Value(gates.MaxCheckinAgeSec)) * time.Second
+	if len(gatewayIDs) > 0 && (maxCheckinAge > 0 || len(gates.Services) > 0) {
+		if err := h.checkGateways(ctx, networkID, gatewayIDs, maxCheckinAge, gates.Services); err != nil {
+			return err
+		}
+	}
+	for _, gate := range gates.PrometheusQueries {
+		if err := h.checkQuery(ctx, gate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *healthChecker) checkGateways(ctx context.Context, networkID string, gatewayIDs []string, maxCheckinAge time.Duration, services []string) error {
+	hwIDs := make(map[string]string, len(gatewayIDs))
+	var allHwIDs []string
+	for _, gatewayID := range gatewayIDs {
+		hwID, err := configurator.GetPhysicalIDOfEntity(ctx, networkID, orc8r.MagmadGatewayType, gatewayID)
+		if err != nil {
+			return fmt.Errorf("get hardware id of gateway %s: %w", gatewayID, err)
+		}
+		hwIDs[gatewayID] = hwID
+		allHwIDs = append(allHwIDs, hwID)
+	}
+	statuses, err := wrappers.GetGatewayStatuses(ctx, networkID, allHwIDs)
+	if err != nil {
+		return fmt.Errorf("get gateway statuses: %w", err)
+	}
+	now := clock.Now()
+	for _, gatewayID := range gatewayIDs {
+		hwID := hwIDs[gatewayID]
+		if maxCheckinAge > 0 {
+			status := statuses[hwID]
+			if status == nil {
+				return fmt.Errorf("gateway %s did not report status", gatewayID)
+			}
+			checkin := time.Unix(0, int64(status.CheckinTime)*int64(time.Millisecond))
+			if now.Sub(checkin) > maxCheckinAge {
+				return fmt.Errorf("gateway %s did not check in since %s", gatewayID, checkin.UTC().Format(time.RFC3339))
+			}
+		}
+		for _, service := range services {
+			info, err := h.getServiceInfo(gateway_registry.GwServiceType(service), hwID)
+			if err != nil {
+				return fmt.Errorf("get %s status of gateway %s: %w", service, gatewayID, err)
+			}
+			if info.State != protos.ServiceInfo_ALIVE || info.Health == protos.ServiceInfo_APP_UNHEALTHY {
+				return fmt.Errorf("%s of gateway %s is %s and %s", service, gatewayID, info.State, info.Health)
+			}
+		}
+	}
+	return nil
+}
+
+func (h *healthChecker) checkQuery(ctx context.Context, gate *models.RolloutPrometheusGate) error {
+	query, maxValue := swag.StringValue(gate.Query), swag.Float64Value(gate.MaxValue)
+	res, _, err := h.prometheus.Query(ctx, query, clock.Now())
+	if err != nil {
+		return fmt.Errorf("query '%s': %w", query, err)
+	}
+	var values []model.SampleValue
+	switch v := res.(type) {
+	case model.Vector:
+		for _, sample := range v {
+			values = append(values, sample.Value)
+		}
+	case *model.Scalar:
+		values = append(values, v.Value)
+	default:
+		return fmt.Errorf("query '%s' returned unsupported %s result", query, res.Type())
+	}
+	if len(values) == 0 {
+		// a gate over missing metrics (typo in the query, scrape failure) must not pass the rollout
+		return fmt.Errorf("query '%s' returned no samples", query)
+	}
+	for _, value := range values {
+		if float64(value) > maxValue {
+			return fmt.Errorf("query '%s' returned %v, more than %v", query, value, maxValue)
+		}
+	}
+	return nil
+}
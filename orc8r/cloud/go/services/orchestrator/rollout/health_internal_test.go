@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/configurator/test_utils"
+	device_test_init "magma/orc8r/cloud/go/services/device/test_init"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	state_test_init "magma/orc8r/cloud/go/services/state/test_init"
+	state_test_utils "magma/orc8r/cloud/go/services/state/test_utils"
+	"magma/orc8r/lib/go/protos"
+)
+
+func TestHealthCheckerGateways(t *testing.T) {
+	test_init.StartTestService(t)
+	device_test_init.StartTestService(t)
+	state_test_init.StartTestService(t)
+	test_utils.RegisterNetwork(t, "n1", "network 1")
+	test_utils.RegisterGateway(t, "n1", "g1", &models.GatewayDevice{HardwareID: "hw1", Key: &models.ChallengeKey{KeyType: "ECHO"}})
+	test_utils.RegisterGateway(t, "n1", "g2", &models.GatewayDevice{HardwareID: "hw2", Key: &models.ChallengeKey{KeyType: "ECHO"}})
+
+	now := time.Unix(1e6, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+	state_test_utils.ReportGatewayStatus(t, state_test_utils.GetContextWithCertificate(t, "hw1"), models.NewDefaultGatewayStatus("hw1"))
+
+	serviceInfos := map[string]*protos.ServiceInfo{
+		"hw1/magmad":   {State: protos.ServiceInfo_ALIVE, Health: protos.ServiceInfo_APP_HEALTHY},
+		"hw1/mme":      {State: protos.ServiceInfo_ALIVE, Health: protos.ServiceInfo_APP_UNHEALTHY},
+		"hw1/sessiond": {State: protos.ServiceInfo_STOPPING, Health: protos.ServiceInfo_APP_UNKNOWN},
+	}
+	checker := &healthChecker{getServiceInfo: func(service gateway_registry.GwServiceType, hwID string) (*protos.ServiceInfo, error) {
+		info, ok := serviceInfos[fmt.Sprintf("%s/%s", hwID, service)]
+		if !ok {
+			return nil, errors.New("gateway not connected")
+		}
+		return info, nil
+	}}
+	checkinGates := &models.RolloutHealthGates{MaxCheckinAgeSec: swag.Int64(60)}
+	serviceGates := func(services ...string) *models.RolloutHealthGates {
+		return &models.RolloutHealthGates{Services: services}
+	}
+
+	// Checkin age
+	assert.NoError(t, checker.Check(context.Background(), "n1", []string{"g1"}, checkinGates))
+	assert.EqualError(t, checker.Check(context.Background(), "n1", []string{"g1", "g2"}, checkinGates), "gateway g2 did not report status")
+	clock.SetAndFreezeClock(t, now.Add(61*time.Second))
+	assert.EqualError(t, checker.Check(context.Background(), "n1", []string{"g1"}, checkinGates), "gateway g1 did not check in since 1970-01-12T13:46:40Z")
+
+	// Service303 status
+	assert.NoError(t, checker.Check(context.Background(), "n1", []string{"g1"}, serviceGates("magmad")))
+	assert.EqualError(t, checker.Check(context.Background(), "n1", []string{"g1"}, serviceGates("magmad", "mme")), "mme of gateway g1 is ALIVE and APP_UNHEALTHY")
+	assert.EqualError(t, checker.Check(context.Background(), "n1", []string{"g1"}, serviceGates("sessiond")), "sessiond of gateway g1 is STOPPING and APP_UNKNOWN")
+	assert.EqualError(t, checker.Check(context.Background(), "n1", []string{"g2"}, serviceGates("magmad")), "get magmad status of gateway g2: gateway not connected")
+
+	// Unknown gateway
+	assert.Error(t, checker.Check(context.Background(), "n1", []string{"g3"}, serviceGates("magmad")))
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Magma Authors.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+
+	"magma/orc8r/cloud/go/services/orchestrator/obsidian/models"
+	"magma/orc8r/cloud/go/services/orchestrator/rollout"
+)
+
+type stubPrometheus struct {
+	v1.API
+	results map[string]model.Value
+}
+
+func (s *stubPrometheus) Query(ctx context.Context, query string, ts time.Time) (model.Value, v1.Warnings, error) {
+	res, ok := s.results[query]
+	if !ok {
+		return nil, nil, errors.New("bad query")
+	}
+	return res, nil, nil
+}
+
+func TestHealthCheckerPrometheusQueries(t *testing.T) {
+	prometheus := &stubPrometheus{results: map[string]model.Value{
+		"restarts": model.Vector{{Value: 0}, {Value: 2}},
+		"errors":   &model.Scalar{Value: 0.5},
+		"matrix":   model.Matrix{},
+		"absent":   model.Vector{},
+	}}
+	checker := rollout.NewHealthChecker(prometheus)
+
+	gates := func(query string, maxValue float64) *models.RolloutHealthGates {
+		return &models.RolloutHealthGates{PrometheusQueries: []*models.RolloutPrometheusGate{
+			{Query: swag.String(query), MaxValue: swag.Float64(maxValue)},
+		}}
+	}
+	assert.NoError(t, checker.Check(context.Background(), "n1", nil, nil))
+	assert.NoError(t, checker.Check(context.Background(), "n1", nil, gates("restarts", 2)))
+	assert.EqualError(t, checker.Check(context.Background(), "n1", nil, gates("restarts", 1)), "query 'restarts' returned 2, more than 1")
+	assert.NoError(t, checker.Check(context.Background(), "n1", nil, gates("errors", 1)))
+	assert.EqualError(t, checker.Check(context.Background(), "n1", nil, gates("errors", 0)), "query 'errors' returned 0.5, more than 0")
+	assert.EqualError(t, checker.Check(context.Background(), "n1", nil, gates("matrix", 0)), "query 'matrix' returned unsupported matrix result")
+	assert.EqualError(t, checker.Check(context.Background(), "n1", nil, gates("absent", 1)), "query 'absent' returned no samples")
+	assert.EqualError(t, checker.Check(context.Background(), "n1", nil, gates("other", 0)), "query 'other': bad query")
+}
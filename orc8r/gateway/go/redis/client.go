@@ -17,6 +17,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/go-redis/redis"
 	"github.com/golang/protobuf/proto"
@@ -103,6 +104,38 @@ func (r *RedisStateClient) Get(key string) (interface{}, error) {
 	return r.stateSerde.Deserialize([]byte(serializedState))
 }
 
+// GetAll fetches all non garbage objects of the serde's state type, the
+// returned map is keyed by the objects' keys
+func (r *RedisStateClient) GetAll() (map[string]interface{}, error) {
+	suffix := r.makeCompositeKey("", r.stateSerde.GetStateType())
+	res := map[string]interface{}{}
+	iter := r.redisClient.Scan(0, "*"+suffix, 0).Iterator()
+	for iter.Next() {
+		compositeKey := iter.Val()
+		serializedState, err := r.redisClient.Get(compositeKey).Result()
+		if err == redis.Nil {
+			continue // deleted since the scan
+		}
+		if err != nil {
+			return nil, err
+		}
+		redisState := &protos.RedisState{}
+		err = proto.Unmarshal([]byte(serializedState), redisState)
+		if err != nil {
+			return nil, err
+		}
+		if redisState.GetIsGarbage() {
+			continue
+		}
+		obj, err := r.stateSerde.Deserialize([]byte(serializedState))
+		if err != nil {
+			return nil, err
+		}
+		res[strings.TrimSuffix(compositeKey, suffix)] = obj
+	}
+	return res, iter.Err()
+}
+
 // Delete removes the key from redis. The function MarkAsGarbage should be
 // preferred as the Delete function won't allow for any garbage collection.
 func (r *RedisStateClient) Delete(key string) (bool, error) {
@@ -67,6 +67,17 @@ func TestRedisStateClientTest(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(2), version)
 
+	err = client.Set("mock2", mockJsonObject{Foo: "foo2"})
+	assert.NoError(t, err)
+	all, err := client.GetAll()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"mock1": &expectedObj, "mock2": &mockJsonObject{Foo: "foo2"}}, all)
+	err = client.MarkAsGarbage("mock2")
+	assert.NoError(t, err)
+	all, err = client.GetAll()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"mock1": &expectedObj}, all)
+
 	err = client.MarkAsGarbage("mock1")
 	assert.NoError(t, err)
 